@@ -0,0 +1,353 @@
+package pdf2txt
+
+// The glyph widths of the standard 14 fonts, in thousandths of a unit of text space, from
+// the Core 14 AFM files version 4.1 (copyright Adobe Systems, redistributed with their
+// ReadMe by pdfcpu and others). The widths of the Latin fonts are by the Unicode of the
+// glyph names, those of Symbol and ZapfDingbats by their codes in the fonts' built-in
+// encodings, since Symbol has serif and sans serif glyphs for the same characters. The
+// oblique and italic styles of Helvetica have the same widths as the upright ones, and
+// every Courier glyph is 600 wide.
+
+var helveticaWidths = map[rune]int{
+	0x0020: 278, 0x0021: 278, 0x0022: 355, 0x0023: 556, 0x0024: 556, 0x0025: 889, 0x0026: 667,
+	0x0027: 191, 0x0028: 333, 0x0029: 333, 0x002a: 389, 0x002b: 584, 0x002c: 278, 0x002d: 333,
+	0x002e: 278, 0x002f: 278, 0x0030: 556, 0x0031: 556, 0x0032: 556, 0x0033: 556, 0x0034: 556,
+	0x0035: 556, 0x0036: 556, 0x0037: 556, 0x0038: 556, 0x0039: 556, 0x003a: 278, 0x003b: 278,
+	0x003c: 584, 0x003d: 584, 0x003e: 584, 0x003f: 556, 0x0040: 1015, 0x0041: 667, 0x0042: 667,
+	0x0043: 722, 0x0044: 722, 0x0045: 667, 0x0046: 611, 0x0047: 778, 0x0048: 722, 0x0049: 278,
+	0x004a: 500, 0x004b: 667, 0x004c: 556, 0x004d: 833, 0x004e: 722, 0x004f: 778, 0x0050: 667,
+	0x0051: 778, 0x0052: 722, 0x0053: 667, 0x0054: 611, 0x0055: 722, 0x0056: 667, 0x0057: 944,
+	0x0058: 667, 0x0059: 667, 0x005a: 611, 0x005b: 278, 0x005c: 278, 0x005d: 278, 0x005e: 469,
+	0x005f: 556, 0x0060: 333, 0x0061: 556, 0x0062: 556, 0x0063: 500, 0x0064: 556, 0x0065: 556,
+	0x0066: 278, 0x0067: 556, 0x0068: 556, 0x0069: 222, 0x006a: 222, 0x006b: 500, 0x006c: 222,
+	0x006d: 833, 0x006e: 556, 0x006f: 556, 0x0070: 556, 0x0071: 556, 0x0072: 333, 0x0073: 500,
+	0x0074: 278, 0x0075: 556, 0x0076: 500, 0x0077: 722, 0x0078: 500, 0x0079: 500, 0x007a: 500,
+	0x007b: 334, 0x007c: 260, 0x007d: 334, 0x007e: 584, 0x00a1: 333, 0x00a2: 556, 0x00a3: 556,
+	0x00a4: 556, 0x00a5: 556, 0x00a6: 260, 0x00a7: 556, 0x00a8: 333, 0x00a9: 737, 0x00aa: 370,
+	0x00ab: 556, 0x00ac: 584, 0x00ae: 737, 0x00af: 333, 0x00b0: 400, 0x00b1: 584, 0x00b2: 333,
+	0x00b3: 333, 0x00b4: 333, 0x00b5: 556, 0x00b6: 537, 0x00b7: 278, 0x00b8: 333, 0x00b9: 333,
+	0x00ba: 365, 0x00bb: 556, 0x00bc: 834, 0x00bd: 834, 0x00be: 834, 0x00bf: 611, 0x00c0: 667,
+	0x00c1: 667, 0x00c2: 667, 0x00c3: 667, 0x00c4: 667, 0x00c5: 667, 0x00c6: 1000, 0x00c7: 722,
+	0x00c8: 667, 0x00c9: 667, 0x00ca: 667, 0x00cb: 667, 0x00cc: 278, 0x00cd: 278, 0x00ce: 278,
+	0x00cf: 278, 0x00d0: 722, 0x00d1: 722, 0x00d2: 778, 0x00d3: 778, 0x00d4: 778, 0x00d5: 778,
+	0x00d6: 778, 0x00d7: 584, 0x00d8: 778, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 667, 0x00de: 667, 0x00df: 611, 0x00e0: 556, 0x00e1: 556, 0x00e2: 556, 0x00e3: 556,
+	0x00e4: 556, 0x00e5: 556, 0x00e6: 889, 0x00e7: 500, 0x00e8: 556, 0x00e9: 556, 0x00ea: 556,
+	0x00eb: 556, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 556, 0x00f1: 556,
+	0x00f2: 556, 0x00f3: 556, 0x00f4: 556, 0x00f5: 556, 0x00f6: 556, 0x00f7: 584, 0x00f8: 611,
+	0x00f9: 556, 0x00fa: 556, 0x00fb: 556, 0x00fc: 556, 0x00fd: 500, 0x00fe: 556, 0x00ff: 500,
+	0x0100: 667, 0x0101: 556, 0x0102: 667, 0x0103: 556, 0x0104: 667, 0x0105: 556, 0x0106: 722,
+	0x0107: 500, 0x010c: 722, 0x010d: 500, 0x010e: 722, 0x010f: 643, 0x0110: 722, 0x0111: 556,
+	0x0112: 667, 0x0113: 556, 0x0116: 667, 0x0117: 556, 0x0118: 667, 0x0119: 556, 0x011a: 667,
+	0x011b: 556, 0x011e: 778, 0x011f: 556, 0x0122: 778, 0x0123: 556, 0x012a: 278, 0x012b: 278,
+	0x012e: 278, 0x012f: 222, 0x0130: 278, 0x0131: 278, 0x0136: 667, 0x0137: 500, 0x0139: 556,
+	0x013a: 222, 0x013b: 556, 0x013c: 222, 0x013d: 556, 0x013e: 299, 0x0141: 556, 0x0142: 222,
+	0x0143: 722, 0x0144: 556, 0x0145: 722, 0x0146: 556, 0x0147: 722, 0x0148: 556, 0x014c: 778,
+	0x014d: 556, 0x0150: 778, 0x0151: 556, 0x0152: 1000, 0x0153: 944, 0x0154: 722, 0x0155: 333,
+	0x0156: 722, 0x0157: 333, 0x0158: 722, 0x0159: 333, 0x015a: 667, 0x015b: 500, 0x015e: 667,
+	0x015f: 500, 0x0160: 667, 0x0161: 500, 0x0162: 611, 0x0163: 278, 0x0164: 611, 0x0165: 317,
+	0x016a: 722, 0x016b: 556, 0x016e: 722, 0x016f: 556, 0x0170: 722, 0x0171: 556, 0x0172: 722,
+	0x0173: 556, 0x0178: 667, 0x0179: 611, 0x017a: 500, 0x017b: 611, 0x017c: 500, 0x017d: 611,
+	0x017e: 500, 0x0192: 556, 0x0218: 667, 0x0219: 500, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 556, 0x2014: 1000,
+	0x2018: 222, 0x2019: 222, 0x201a: 222, 0x201c: 333, 0x201d: 333, 0x201e: 333, 0x2020: 556,
+	0x2021: 556, 0x2022: 350, 0x2026: 1000, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 556, 0x2122: 1000, 0x2202: 476, 0x2206: 612, 0x2211: 600, 0x2212: 584, 0x221a: 453,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 471, 0xf6c3: 250, 0xfb01: 500, 0xfb02: 500,
+}
+
+var helveticaBoldWidths = map[rune]int{
+	0x0020: 278, 0x0021: 333, 0x0022: 474, 0x0023: 556, 0x0024: 556, 0x0025: 889, 0x0026: 722,
+	0x0027: 238, 0x0028: 333, 0x0029: 333, 0x002a: 389, 0x002b: 584, 0x002c: 278, 0x002d: 333,
+	0x002e: 278, 0x002f: 278, 0x0030: 556, 0x0031: 556, 0x0032: 556, 0x0033: 556, 0x0034: 556,
+	0x0035: 556, 0x0036: 556, 0x0037: 556, 0x0038: 556, 0x0039: 556, 0x003a: 333, 0x003b: 333,
+	0x003c: 584, 0x003d: 584, 0x003e: 584, 0x003f: 611, 0x0040: 975, 0x0041: 722, 0x0042: 722,
+	0x0043: 722, 0x0044: 722, 0x0045: 667, 0x0046: 611, 0x0047: 778, 0x0048: 722, 0x0049: 278,
+	0x004a: 556, 0x004b: 722, 0x004c: 611, 0x004d: 833, 0x004e: 722, 0x004f: 778, 0x0050: 667,
+	0x0051: 778, 0x0052: 722, 0x0053: 667, 0x0054: 611, 0x0055: 722, 0x0056: 667, 0x0057: 944,
+	0x0058: 667, 0x0059: 667, 0x005a: 611, 0x005b: 333, 0x005c: 278, 0x005d: 333, 0x005e: 584,
+	0x005f: 556, 0x0060: 333, 0x0061: 556, 0x0062: 611, 0x0063: 556, 0x0064: 611, 0x0065: 556,
+	0x0066: 333, 0x0067: 611, 0x0068: 611, 0x0069: 278, 0x006a: 278, 0x006b: 556, 0x006c: 278,
+	0x006d: 889, 0x006e: 611, 0x006f: 611, 0x0070: 611, 0x0071: 611, 0x0072: 389, 0x0073: 556,
+	0x0074: 333, 0x0075: 611, 0x0076: 556, 0x0077: 778, 0x0078: 556, 0x0079: 556, 0x007a: 500,
+	0x007b: 389, 0x007c: 280, 0x007d: 389, 0x007e: 584, 0x00a1: 333, 0x00a2: 556, 0x00a3: 556,
+	0x00a4: 556, 0x00a5: 556, 0x00a6: 280, 0x00a7: 556, 0x00a8: 333, 0x00a9: 737, 0x00aa: 370,
+	0x00ab: 556, 0x00ac: 584, 0x00ae: 737, 0x00af: 333, 0x00b0: 400, 0x00b1: 584, 0x00b2: 333,
+	0x00b3: 333, 0x00b4: 333, 0x00b5: 611, 0x00b6: 556, 0x00b7: 278, 0x00b8: 333, 0x00b9: 333,
+	0x00ba: 365, 0x00bb: 556, 0x00bc: 834, 0x00bd: 834, 0x00be: 834, 0x00bf: 611, 0x00c0: 722,
+	0x00c1: 722, 0x00c2: 722, 0x00c3: 722, 0x00c4: 722, 0x00c5: 722, 0x00c6: 1000, 0x00c7: 722,
+	0x00c8: 667, 0x00c9: 667, 0x00ca: 667, 0x00cb: 667, 0x00cc: 278, 0x00cd: 278, 0x00ce: 278,
+	0x00cf: 278, 0x00d0: 722, 0x00d1: 722, 0x00d2: 778, 0x00d3: 778, 0x00d4: 778, 0x00d5: 778,
+	0x00d6: 778, 0x00d7: 584, 0x00d8: 778, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 667, 0x00de: 667, 0x00df: 611, 0x00e0: 556, 0x00e1: 556, 0x00e2: 556, 0x00e3: 556,
+	0x00e4: 556, 0x00e5: 556, 0x00e6: 889, 0x00e7: 556, 0x00e8: 556, 0x00e9: 556, 0x00ea: 556,
+	0x00eb: 556, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 611, 0x00f1: 611,
+	0x00f2: 611, 0x00f3: 611, 0x00f4: 611, 0x00f5: 611, 0x00f6: 611, 0x00f7: 584, 0x00f8: 611,
+	0x00f9: 611, 0x00fa: 611, 0x00fb: 611, 0x00fc: 611, 0x00fd: 556, 0x00fe: 611, 0x00ff: 556,
+	0x0100: 722, 0x0101: 556, 0x0102: 722, 0x0103: 556, 0x0104: 722, 0x0105: 556, 0x0106: 722,
+	0x0107: 556, 0x010c: 722, 0x010d: 556, 0x010e: 722, 0x010f: 743, 0x0110: 722, 0x0111: 611,
+	0x0112: 667, 0x0113: 556, 0x0116: 667, 0x0117: 556, 0x0118: 667, 0x0119: 556, 0x011a: 667,
+	0x011b: 556, 0x011e: 778, 0x011f: 611, 0x0122: 778, 0x0123: 611, 0x012a: 278, 0x012b: 278,
+	0x012e: 278, 0x012f: 278, 0x0130: 278, 0x0131: 278, 0x0136: 722, 0x0137: 556, 0x0139: 611,
+	0x013a: 278, 0x013b: 611, 0x013c: 278, 0x013d: 611, 0x013e: 400, 0x0141: 611, 0x0142: 278,
+	0x0143: 722, 0x0144: 611, 0x0145: 722, 0x0146: 611, 0x0147: 722, 0x0148: 611, 0x014c: 778,
+	0x014d: 611, 0x0150: 778, 0x0151: 611, 0x0152: 1000, 0x0153: 944, 0x0154: 722, 0x0155: 389,
+	0x0156: 722, 0x0157: 389, 0x0158: 722, 0x0159: 389, 0x015a: 667, 0x015b: 556, 0x015e: 667,
+	0x015f: 556, 0x0160: 667, 0x0161: 556, 0x0162: 611, 0x0163: 333, 0x0164: 611, 0x0165: 389,
+	0x016a: 722, 0x016b: 611, 0x016e: 722, 0x016f: 611, 0x0170: 722, 0x0171: 611, 0x0172: 722,
+	0x0173: 611, 0x0178: 667, 0x0179: 611, 0x017a: 500, 0x017b: 611, 0x017c: 500, 0x017d: 611,
+	0x017e: 500, 0x0192: 556, 0x0218: 667, 0x0219: 556, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 556, 0x2014: 1000,
+	0x2018: 278, 0x2019: 278, 0x201a: 278, 0x201c: 500, 0x201d: 500, 0x201e: 500, 0x2020: 556,
+	0x2021: 556, 0x2022: 350, 0x2026: 1000, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 556, 0x2122: 1000, 0x2202: 494, 0x2206: 612, 0x2211: 600, 0x2212: 584, 0x221a: 549,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 494, 0xf6c3: 250, 0xfb01: 611, 0xfb02: 611,
+}
+
+var timesRomanWidths = map[rune]int{
+	0x0020: 250, 0x0021: 333, 0x0022: 408, 0x0023: 500, 0x0024: 500, 0x0025: 833, 0x0026: 778,
+	0x0027: 180, 0x0028: 333, 0x0029: 333, 0x002a: 500, 0x002b: 564, 0x002c: 250, 0x002d: 333,
+	0x002e: 250, 0x002f: 278, 0x0030: 500, 0x0031: 500, 0x0032: 500, 0x0033: 500, 0x0034: 500,
+	0x0035: 500, 0x0036: 500, 0x0037: 500, 0x0038: 500, 0x0039: 500, 0x003a: 278, 0x003b: 278,
+	0x003c: 564, 0x003d: 564, 0x003e: 564, 0x003f: 444, 0x0040: 921, 0x0041: 722, 0x0042: 667,
+	0x0043: 667, 0x0044: 722, 0x0045: 611, 0x0046: 556, 0x0047: 722, 0x0048: 722, 0x0049: 333,
+	0x004a: 389, 0x004b: 722, 0x004c: 611, 0x004d: 889, 0x004e: 722, 0x004f: 722, 0x0050: 556,
+	0x0051: 722, 0x0052: 667, 0x0053: 556, 0x0054: 611, 0x0055: 722, 0x0056: 722, 0x0057: 944,
+	0x0058: 722, 0x0059: 722, 0x005a: 611, 0x005b: 333, 0x005c: 278, 0x005d: 333, 0x005e: 469,
+	0x005f: 500, 0x0060: 333, 0x0061: 444, 0x0062: 500, 0x0063: 444, 0x0064: 500, 0x0065: 444,
+	0x0066: 333, 0x0067: 500, 0x0068: 500, 0x0069: 278, 0x006a: 278, 0x006b: 500, 0x006c: 278,
+	0x006d: 778, 0x006e: 500, 0x006f: 500, 0x0070: 500, 0x0071: 500, 0x0072: 333, 0x0073: 389,
+	0x0074: 278, 0x0075: 500, 0x0076: 500, 0x0077: 722, 0x0078: 500, 0x0079: 500, 0x007a: 444,
+	0x007b: 480, 0x007c: 200, 0x007d: 480, 0x007e: 541, 0x00a1: 333, 0x00a2: 500, 0x00a3: 500,
+	0x00a4: 500, 0x00a5: 500, 0x00a6: 200, 0x00a7: 500, 0x00a8: 333, 0x00a9: 760, 0x00aa: 276,
+	0x00ab: 500, 0x00ac: 564, 0x00ae: 760, 0x00af: 333, 0x00b0: 400, 0x00b1: 564, 0x00b2: 300,
+	0x00b3: 300, 0x00b4: 333, 0x00b5: 500, 0x00b6: 453, 0x00b7: 250, 0x00b8: 333, 0x00b9: 300,
+	0x00ba: 310, 0x00bb: 500, 0x00bc: 750, 0x00bd: 750, 0x00be: 750, 0x00bf: 444, 0x00c0: 722,
+	0x00c1: 722, 0x00c2: 722, 0x00c3: 722, 0x00c4: 722, 0x00c5: 722, 0x00c6: 889, 0x00c7: 667,
+	0x00c8: 611, 0x00c9: 611, 0x00ca: 611, 0x00cb: 611, 0x00cc: 333, 0x00cd: 333, 0x00ce: 333,
+	0x00cf: 333, 0x00d0: 722, 0x00d1: 722, 0x00d2: 722, 0x00d3: 722, 0x00d4: 722, 0x00d5: 722,
+	0x00d6: 722, 0x00d7: 564, 0x00d8: 722, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 722, 0x00de: 556, 0x00df: 500, 0x00e0: 444, 0x00e1: 444, 0x00e2: 444, 0x00e3: 444,
+	0x00e4: 444, 0x00e5: 444, 0x00e6: 667, 0x00e7: 444, 0x00e8: 444, 0x00e9: 444, 0x00ea: 444,
+	0x00eb: 444, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 500, 0x00f1: 500,
+	0x00f2: 500, 0x00f3: 500, 0x00f4: 500, 0x00f5: 500, 0x00f6: 500, 0x00f7: 564, 0x00f8: 500,
+	0x00f9: 500, 0x00fa: 500, 0x00fb: 500, 0x00fc: 500, 0x00fd: 500, 0x00fe: 500, 0x00ff: 500,
+	0x0100: 722, 0x0101: 444, 0x0102: 722, 0x0103: 444, 0x0104: 722, 0x0105: 444, 0x0106: 667,
+	0x0107: 444, 0x010c: 667, 0x010d: 444, 0x010e: 722, 0x010f: 588, 0x0110: 722, 0x0111: 500,
+	0x0112: 611, 0x0113: 444, 0x0116: 611, 0x0117: 444, 0x0118: 611, 0x0119: 444, 0x011a: 611,
+	0x011b: 444, 0x011e: 722, 0x011f: 500, 0x0122: 722, 0x0123: 500, 0x012a: 333, 0x012b: 278,
+	0x012e: 333, 0x012f: 278, 0x0130: 333, 0x0131: 278, 0x0136: 722, 0x0137: 500, 0x0139: 611,
+	0x013a: 278, 0x013b: 611, 0x013c: 278, 0x013d: 611, 0x013e: 344, 0x0141: 611, 0x0142: 278,
+	0x0143: 722, 0x0144: 500, 0x0145: 722, 0x0146: 500, 0x0147: 722, 0x0148: 500, 0x014c: 722,
+	0x014d: 500, 0x0150: 722, 0x0151: 500, 0x0152: 889, 0x0153: 722, 0x0154: 667, 0x0155: 333,
+	0x0156: 667, 0x0157: 333, 0x0158: 667, 0x0159: 333, 0x015a: 556, 0x015b: 389, 0x015e: 556,
+	0x015f: 389, 0x0160: 556, 0x0161: 389, 0x0162: 611, 0x0163: 278, 0x0164: 611, 0x0165: 326,
+	0x016a: 722, 0x016b: 500, 0x016e: 722, 0x016f: 500, 0x0170: 722, 0x0171: 500, 0x0172: 722,
+	0x0173: 500, 0x0178: 722, 0x0179: 611, 0x017a: 444, 0x017b: 611, 0x017c: 444, 0x017d: 611,
+	0x017e: 444, 0x0192: 500, 0x0218: 556, 0x0219: 389, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 500, 0x2014: 1000,
+	0x2018: 333, 0x2019: 333, 0x201a: 333, 0x201c: 444, 0x201d: 444, 0x201e: 444, 0x2020: 500,
+	0x2021: 500, 0x2022: 350, 0x2026: 1000, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 500, 0x2122: 980, 0x2202: 476, 0x2206: 612, 0x2211: 600, 0x2212: 564, 0x221a: 453,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 471, 0xf6c3: 250, 0xfb01: 556, 0xfb02: 556,
+}
+
+var timesBoldWidths = map[rune]int{
+	0x0020: 250, 0x0021: 333, 0x0022: 555, 0x0023: 500, 0x0024: 500, 0x0025: 1000, 0x0026: 833,
+	0x0027: 278, 0x0028: 333, 0x0029: 333, 0x002a: 500, 0x002b: 570, 0x002c: 250, 0x002d: 333,
+	0x002e: 250, 0x002f: 278, 0x0030: 500, 0x0031: 500, 0x0032: 500, 0x0033: 500, 0x0034: 500,
+	0x0035: 500, 0x0036: 500, 0x0037: 500, 0x0038: 500, 0x0039: 500, 0x003a: 333, 0x003b: 333,
+	0x003c: 570, 0x003d: 570, 0x003e: 570, 0x003f: 500, 0x0040: 930, 0x0041: 722, 0x0042: 667,
+	0x0043: 722, 0x0044: 722, 0x0045: 667, 0x0046: 611, 0x0047: 778, 0x0048: 778, 0x0049: 389,
+	0x004a: 500, 0x004b: 778, 0x004c: 667, 0x004d: 944, 0x004e: 722, 0x004f: 778, 0x0050: 611,
+	0x0051: 778, 0x0052: 722, 0x0053: 556, 0x0054: 667, 0x0055: 722, 0x0056: 722, 0x0057: 1000,
+	0x0058: 722, 0x0059: 722, 0x005a: 667, 0x005b: 333, 0x005c: 278, 0x005d: 333, 0x005e: 581,
+	0x005f: 500, 0x0060: 333, 0x0061: 500, 0x0062: 556, 0x0063: 444, 0x0064: 556, 0x0065: 444,
+	0x0066: 333, 0x0067: 500, 0x0068: 556, 0x0069: 278, 0x006a: 333, 0x006b: 556, 0x006c: 278,
+	0x006d: 833, 0x006e: 556, 0x006f: 500, 0x0070: 556, 0x0071: 556, 0x0072: 444, 0x0073: 389,
+	0x0074: 333, 0x0075: 556, 0x0076: 500, 0x0077: 722, 0x0078: 500, 0x0079: 500, 0x007a: 444,
+	0x007b: 394, 0x007c: 220, 0x007d: 394, 0x007e: 520, 0x00a1: 333, 0x00a2: 500, 0x00a3: 500,
+	0x00a4: 500, 0x00a5: 500, 0x00a6: 220, 0x00a7: 500, 0x00a8: 333, 0x00a9: 747, 0x00aa: 300,
+	0x00ab: 500, 0x00ac: 570, 0x00ae: 747, 0x00af: 333, 0x00b0: 400, 0x00b1: 570, 0x00b2: 300,
+	0x00b3: 300, 0x00b4: 333, 0x00b5: 556, 0x00b6: 540, 0x00b7: 250, 0x00b8: 333, 0x00b9: 300,
+	0x00ba: 330, 0x00bb: 500, 0x00bc: 750, 0x00bd: 750, 0x00be: 750, 0x00bf: 500, 0x00c0: 722,
+	0x00c1: 722, 0x00c2: 722, 0x00c3: 722, 0x00c4: 722, 0x00c5: 722, 0x00c6: 1000, 0x00c7: 722,
+	0x00c8: 667, 0x00c9: 667, 0x00ca: 667, 0x00cb: 667, 0x00cc: 389, 0x00cd: 389, 0x00ce: 389,
+	0x00cf: 389, 0x00d0: 722, 0x00d1: 722, 0x00d2: 778, 0x00d3: 778, 0x00d4: 778, 0x00d5: 778,
+	0x00d6: 778, 0x00d7: 570, 0x00d8: 778, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 722, 0x00de: 611, 0x00df: 556, 0x00e0: 500, 0x00e1: 500, 0x00e2: 500, 0x00e3: 500,
+	0x00e4: 500, 0x00e5: 500, 0x00e6: 722, 0x00e7: 444, 0x00e8: 444, 0x00e9: 444, 0x00ea: 444,
+	0x00eb: 444, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 500, 0x00f1: 556,
+	0x00f2: 500, 0x00f3: 500, 0x00f4: 500, 0x00f5: 500, 0x00f6: 500, 0x00f7: 570, 0x00f8: 500,
+	0x00f9: 556, 0x00fa: 556, 0x00fb: 556, 0x00fc: 556, 0x00fd: 500, 0x00fe: 556, 0x00ff: 500,
+	0x0100: 722, 0x0101: 500, 0x0102: 722, 0x0103: 500, 0x0104: 722, 0x0105: 500, 0x0106: 722,
+	0x0107: 444, 0x010c: 722, 0x010d: 444, 0x010e: 722, 0x010f: 672, 0x0110: 722, 0x0111: 556,
+	0x0112: 667, 0x0113: 444, 0x0116: 667, 0x0117: 444, 0x0118: 667, 0x0119: 444, 0x011a: 667,
+	0x011b: 444, 0x011e: 778, 0x011f: 500, 0x0122: 778, 0x0123: 500, 0x012a: 389, 0x012b: 278,
+	0x012e: 389, 0x012f: 278, 0x0130: 389, 0x0131: 278, 0x0136: 778, 0x0137: 556, 0x0139: 667,
+	0x013a: 278, 0x013b: 667, 0x013c: 278, 0x013d: 667, 0x013e: 394, 0x0141: 667, 0x0142: 278,
+	0x0143: 722, 0x0144: 556, 0x0145: 722, 0x0146: 556, 0x0147: 722, 0x0148: 556, 0x014c: 778,
+	0x014d: 500, 0x0150: 778, 0x0151: 500, 0x0152: 1000, 0x0153: 722, 0x0154: 722, 0x0155: 444,
+	0x0156: 722, 0x0157: 444, 0x0158: 722, 0x0159: 444, 0x015a: 556, 0x015b: 389, 0x015e: 556,
+	0x015f: 389, 0x0160: 556, 0x0161: 389, 0x0162: 667, 0x0163: 333, 0x0164: 667, 0x0165: 416,
+	0x016a: 722, 0x016b: 556, 0x016e: 722, 0x016f: 556, 0x0170: 722, 0x0171: 556, 0x0172: 722,
+	0x0173: 556, 0x0178: 722, 0x0179: 667, 0x017a: 444, 0x017b: 667, 0x017c: 444, 0x017d: 667,
+	0x017e: 444, 0x0192: 500, 0x0218: 556, 0x0219: 389, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 500, 0x2014: 1000,
+	0x2018: 333, 0x2019: 333, 0x201a: 333, 0x201c: 500, 0x201d: 500, 0x201e: 500, 0x2020: 500,
+	0x2021: 500, 0x2022: 350, 0x2026: 1000, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 500, 0x2122: 1000, 0x2202: 494, 0x2206: 612, 0x2211: 600, 0x2212: 570, 0x221a: 549,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 494, 0xf6c3: 250, 0xfb01: 556, 0xfb02: 556,
+}
+
+var timesItalicWidths = map[rune]int{
+	0x0020: 250, 0x0021: 333, 0x0022: 420, 0x0023: 500, 0x0024: 500, 0x0025: 833, 0x0026: 778,
+	0x0027: 214, 0x0028: 333, 0x0029: 333, 0x002a: 500, 0x002b: 675, 0x002c: 250, 0x002d: 333,
+	0x002e: 250, 0x002f: 278, 0x0030: 500, 0x0031: 500, 0x0032: 500, 0x0033: 500, 0x0034: 500,
+	0x0035: 500, 0x0036: 500, 0x0037: 500, 0x0038: 500, 0x0039: 500, 0x003a: 333, 0x003b: 333,
+	0x003c: 675, 0x003d: 675, 0x003e: 675, 0x003f: 500, 0x0040: 920, 0x0041: 611, 0x0042: 611,
+	0x0043: 667, 0x0044: 722, 0x0045: 611, 0x0046: 611, 0x0047: 722, 0x0048: 722, 0x0049: 333,
+	0x004a: 444, 0x004b: 667, 0x004c: 556, 0x004d: 833, 0x004e: 667, 0x004f: 722, 0x0050: 611,
+	0x0051: 722, 0x0052: 611, 0x0053: 500, 0x0054: 556, 0x0055: 722, 0x0056: 611, 0x0057: 833,
+	0x0058: 611, 0x0059: 556, 0x005a: 556, 0x005b: 389, 0x005c: 278, 0x005d: 389, 0x005e: 422,
+	0x005f: 500, 0x0060: 333, 0x0061: 500, 0x0062: 500, 0x0063: 444, 0x0064: 500, 0x0065: 444,
+	0x0066: 278, 0x0067: 500, 0x0068: 500, 0x0069: 278, 0x006a: 278, 0x006b: 444, 0x006c: 278,
+	0x006d: 722, 0x006e: 500, 0x006f: 500, 0x0070: 500, 0x0071: 500, 0x0072: 389, 0x0073: 389,
+	0x0074: 278, 0x0075: 500, 0x0076: 444, 0x0077: 667, 0x0078: 444, 0x0079: 444, 0x007a: 389,
+	0x007b: 400, 0x007c: 275, 0x007d: 400, 0x007e: 541, 0x00a1: 389, 0x00a2: 500, 0x00a3: 500,
+	0x00a4: 500, 0x00a5: 500, 0x00a6: 275, 0x00a7: 500, 0x00a8: 333, 0x00a9: 760, 0x00aa: 276,
+	0x00ab: 500, 0x00ac: 675, 0x00ae: 760, 0x00af: 333, 0x00b0: 400, 0x00b1: 675, 0x00b2: 300,
+	0x00b3: 300, 0x00b4: 333, 0x00b5: 500, 0x00b6: 523, 0x00b7: 250, 0x00b8: 333, 0x00b9: 300,
+	0x00ba: 310, 0x00bb: 500, 0x00bc: 750, 0x00bd: 750, 0x00be: 750, 0x00bf: 500, 0x00c0: 611,
+	0x00c1: 611, 0x00c2: 611, 0x00c3: 611, 0x00c4: 611, 0x00c5: 611, 0x00c6: 889, 0x00c7: 667,
+	0x00c8: 611, 0x00c9: 611, 0x00ca: 611, 0x00cb: 611, 0x00cc: 333, 0x00cd: 333, 0x00ce: 333,
+	0x00cf: 333, 0x00d0: 722, 0x00d1: 667, 0x00d2: 722, 0x00d3: 722, 0x00d4: 722, 0x00d5: 722,
+	0x00d6: 722, 0x00d7: 675, 0x00d8: 722, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 556, 0x00de: 611, 0x00df: 500, 0x00e0: 500, 0x00e1: 500, 0x00e2: 500, 0x00e3: 500,
+	0x00e4: 500, 0x00e5: 500, 0x00e6: 667, 0x00e7: 444, 0x00e8: 444, 0x00e9: 444, 0x00ea: 444,
+	0x00eb: 444, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 500, 0x00f1: 500,
+	0x00f2: 500, 0x00f3: 500, 0x00f4: 500, 0x00f5: 500, 0x00f6: 500, 0x00f7: 675, 0x00f8: 500,
+	0x00f9: 500, 0x00fa: 500, 0x00fb: 500, 0x00fc: 500, 0x00fd: 444, 0x00fe: 500, 0x00ff: 444,
+	0x0100: 611, 0x0101: 500, 0x0102: 611, 0x0103: 500, 0x0104: 611, 0x0105: 500, 0x0106: 667,
+	0x0107: 444, 0x010c: 667, 0x010d: 444, 0x010e: 722, 0x010f: 544, 0x0110: 722, 0x0111: 500,
+	0x0112: 611, 0x0113: 444, 0x0116: 611, 0x0117: 444, 0x0118: 611, 0x0119: 444, 0x011a: 611,
+	0x011b: 444, 0x011e: 722, 0x011f: 500, 0x0122: 722, 0x0123: 500, 0x012a: 333, 0x012b: 278,
+	0x012e: 333, 0x012f: 278, 0x0130: 333, 0x0131: 278, 0x0136: 667, 0x0137: 444, 0x0139: 556,
+	0x013a: 278, 0x013b: 556, 0x013c: 278, 0x013d: 611, 0x013e: 300, 0x0141: 556, 0x0142: 278,
+	0x0143: 667, 0x0144: 500, 0x0145: 667, 0x0146: 500, 0x0147: 667, 0x0148: 500, 0x014c: 722,
+	0x014d: 500, 0x0150: 722, 0x0151: 500, 0x0152: 944, 0x0153: 667, 0x0154: 611, 0x0155: 389,
+	0x0156: 611, 0x0157: 389, 0x0158: 611, 0x0159: 389, 0x015a: 500, 0x015b: 389, 0x015e: 500,
+	0x015f: 389, 0x0160: 500, 0x0161: 389, 0x0162: 556, 0x0163: 278, 0x0164: 556, 0x0165: 300,
+	0x016a: 722, 0x016b: 500, 0x016e: 722, 0x016f: 500, 0x0170: 722, 0x0171: 500, 0x0172: 722,
+	0x0173: 500, 0x0178: 556, 0x0179: 556, 0x017a: 389, 0x017b: 556, 0x017c: 389, 0x017d: 556,
+	0x017e: 389, 0x0192: 500, 0x0218: 500, 0x0219: 389, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 500, 0x2014: 889,
+	0x2018: 333, 0x2019: 333, 0x201a: 333, 0x201c: 556, 0x201d: 556, 0x201e: 556, 0x2020: 500,
+	0x2021: 500, 0x2022: 350, 0x2026: 889, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 500, 0x2122: 980, 0x2202: 476, 0x2206: 612, 0x2211: 600, 0x2212: 675, 0x221a: 453,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 471, 0xf6c3: 250, 0xfb01: 500, 0xfb02: 500,
+}
+
+var timesBoldItalicWidths = map[rune]int{
+	0x0020: 250, 0x0021: 389, 0x0022: 555, 0x0023: 500, 0x0024: 500, 0x0025: 833, 0x0026: 778,
+	0x0027: 278, 0x0028: 333, 0x0029: 333, 0x002a: 500, 0x002b: 570, 0x002c: 250, 0x002d: 333,
+	0x002e: 250, 0x002f: 278, 0x0030: 500, 0x0031: 500, 0x0032: 500, 0x0033: 500, 0x0034: 500,
+	0x0035: 500, 0x0036: 500, 0x0037: 500, 0x0038: 500, 0x0039: 500, 0x003a: 333, 0x003b: 333,
+	0x003c: 570, 0x003d: 570, 0x003e: 570, 0x003f: 500, 0x0040: 832, 0x0041: 667, 0x0042: 667,
+	0x0043: 667, 0x0044: 722, 0x0045: 667, 0x0046: 667, 0x0047: 722, 0x0048: 778, 0x0049: 389,
+	0x004a: 500, 0x004b: 667, 0x004c: 611, 0x004d: 889, 0x004e: 722, 0x004f: 722, 0x0050: 611,
+	0x0051: 722, 0x0052: 667, 0x0053: 556, 0x0054: 611, 0x0055: 722, 0x0056: 667, 0x0057: 889,
+	0x0058: 667, 0x0059: 611, 0x005a: 611, 0x005b: 333, 0x005c: 278, 0x005d: 333, 0x005e: 570,
+	0x005f: 500, 0x0060: 333, 0x0061: 500, 0x0062: 500, 0x0063: 444, 0x0064: 500, 0x0065: 444,
+	0x0066: 333, 0x0067: 500, 0x0068: 556, 0x0069: 278, 0x006a: 278, 0x006b: 500, 0x006c: 278,
+	0x006d: 778, 0x006e: 556, 0x006f: 500, 0x0070: 500, 0x0071: 500, 0x0072: 389, 0x0073: 389,
+	0x0074: 278, 0x0075: 556, 0x0076: 444, 0x0077: 667, 0x0078: 500, 0x0079: 444, 0x007a: 389,
+	0x007b: 348, 0x007c: 220, 0x007d: 348, 0x007e: 570, 0x00a1: 389, 0x00a2: 500, 0x00a3: 500,
+	0x00a4: 500, 0x00a5: 500, 0x00a6: 220, 0x00a7: 500, 0x00a8: 333, 0x00a9: 747, 0x00aa: 266,
+	0x00ab: 500, 0x00ac: 606, 0x00ae: 747, 0x00af: 333, 0x00b0: 400, 0x00b1: 570, 0x00b2: 300,
+	0x00b3: 300, 0x00b4: 333, 0x00b5: 576, 0x00b6: 500, 0x00b7: 250, 0x00b8: 333, 0x00b9: 300,
+	0x00ba: 300, 0x00bb: 500, 0x00bc: 750, 0x00bd: 750, 0x00be: 750, 0x00bf: 500, 0x00c0: 667,
+	0x00c1: 667, 0x00c2: 667, 0x00c3: 667, 0x00c4: 667, 0x00c5: 667, 0x00c6: 944, 0x00c7: 667,
+	0x00c8: 667, 0x00c9: 667, 0x00ca: 667, 0x00cb: 667, 0x00cc: 389, 0x00cd: 389, 0x00ce: 389,
+	0x00cf: 389, 0x00d0: 722, 0x00d1: 722, 0x00d2: 722, 0x00d3: 722, 0x00d4: 722, 0x00d5: 722,
+	0x00d6: 722, 0x00d7: 570, 0x00d8: 722, 0x00d9: 722, 0x00da: 722, 0x00db: 722, 0x00dc: 722,
+	0x00dd: 611, 0x00de: 611, 0x00df: 500, 0x00e0: 500, 0x00e1: 500, 0x00e2: 500, 0x00e3: 500,
+	0x00e4: 500, 0x00e5: 500, 0x00e6: 722, 0x00e7: 444, 0x00e8: 444, 0x00e9: 444, 0x00ea: 444,
+	0x00eb: 444, 0x00ec: 278, 0x00ed: 278, 0x00ee: 278, 0x00ef: 278, 0x00f0: 500, 0x00f1: 556,
+	0x00f2: 500, 0x00f3: 500, 0x00f4: 500, 0x00f5: 500, 0x00f6: 500, 0x00f7: 570, 0x00f8: 500,
+	0x00f9: 556, 0x00fa: 556, 0x00fb: 556, 0x00fc: 556, 0x00fd: 444, 0x00fe: 500, 0x00ff: 444,
+	0x0100: 667, 0x0101: 500, 0x0102: 667, 0x0103: 500, 0x0104: 667, 0x0105: 500, 0x0106: 667,
+	0x0107: 444, 0x010c: 667, 0x010d: 444, 0x010e: 722, 0x010f: 608, 0x0110: 722, 0x0111: 500,
+	0x0112: 667, 0x0113: 444, 0x0116: 667, 0x0117: 444, 0x0118: 667, 0x0119: 444, 0x011a: 667,
+	0x011b: 444, 0x011e: 722, 0x011f: 500, 0x0122: 722, 0x0123: 500, 0x012a: 389, 0x012b: 278,
+	0x012e: 389, 0x012f: 278, 0x0130: 389, 0x0131: 278, 0x0136: 667, 0x0137: 500, 0x0139: 611,
+	0x013a: 278, 0x013b: 611, 0x013c: 278, 0x013d: 611, 0x013e: 382, 0x0141: 611, 0x0142: 278,
+	0x0143: 722, 0x0144: 556, 0x0145: 722, 0x0146: 556, 0x0147: 722, 0x0148: 556, 0x014c: 722,
+	0x014d: 500, 0x0150: 722, 0x0151: 500, 0x0152: 944, 0x0153: 722, 0x0154: 667, 0x0155: 389,
+	0x0156: 667, 0x0157: 389, 0x0158: 667, 0x0159: 389, 0x015a: 556, 0x015b: 389, 0x015e: 556,
+	0x015f: 389, 0x0160: 556, 0x0161: 389, 0x0162: 611, 0x0163: 278, 0x0164: 611, 0x0165: 366,
+	0x016a: 722, 0x016b: 556, 0x016e: 722, 0x016f: 556, 0x0170: 722, 0x0171: 556, 0x0172: 722,
+	0x0173: 556, 0x0178: 611, 0x0179: 611, 0x017a: 389, 0x017b: 611, 0x017c: 389, 0x017d: 611,
+	0x017e: 389, 0x0192: 500, 0x0218: 556, 0x0219: 389, 0x02c6: 333, 0x02c7: 333, 0x02d8: 333,
+	0x02d9: 333, 0x02da: 333, 0x02db: 333, 0x02dc: 333, 0x02dd: 333, 0x2013: 500, 0x2014: 1000,
+	0x2018: 333, 0x2019: 333, 0x201a: 333, 0x201c: 500, 0x201d: 500, 0x201e: 500, 0x2020: 500,
+	0x2021: 500, 0x2022: 350, 0x2026: 1000, 0x2030: 1000, 0x2039: 333, 0x203a: 333, 0x2044: 167,
+	0x20ac: 500, 0x2122: 1000, 0x2202: 494, 0x2206: 612, 0x2211: 600, 0x2212: 606, 0x221a: 549,
+	0x2260: 549, 0x2264: 549, 0x2265: 549, 0x25ca: 494, 0xf6c3: 250, 0xfb01: 556, 0xfb02: 556,
+}
+
+var symbolWidths = &[256]int{
+	0x20: 250, 0x21: 333, 0x22: 713, 0x23: 500, 0x24: 549, 0x25: 833, 0x26: 778, 0x27: 439,
+	0x28: 333, 0x29: 333, 0x2a: 500, 0x2b: 549, 0x2c: 250, 0x2d: 549, 0x2e: 250, 0x2f: 278,
+	0x30: 500, 0x31: 500, 0x32: 500, 0x33: 500, 0x34: 500, 0x35: 500, 0x36: 500, 0x37: 500,
+	0x38: 500, 0x39: 500, 0x3a: 278, 0x3b: 278, 0x3c: 549, 0x3d: 549, 0x3e: 549, 0x3f: 444,
+	0x40: 549, 0x41: 722, 0x42: 667, 0x43: 722, 0x44: 612, 0x45: 611, 0x46: 763, 0x47: 603,
+	0x48: 722, 0x49: 333, 0x4a: 631, 0x4b: 722, 0x4c: 686, 0x4d: 889, 0x4e: 722, 0x4f: 722,
+	0x50: 768, 0x51: 741, 0x52: 556, 0x53: 592, 0x54: 611, 0x55: 690, 0x56: 439, 0x57: 768,
+	0x58: 645, 0x59: 795, 0x5a: 611, 0x5b: 333, 0x5c: 863, 0x5d: 333, 0x5e: 658, 0x5f: 500,
+	0x60: 500, 0x61: 631, 0x62: 549, 0x63: 549, 0x64: 494, 0x65: 439, 0x66: 521, 0x67: 411,
+	0x68: 603, 0x69: 329, 0x6a: 603, 0x6b: 549, 0x6c: 549, 0x6d: 576, 0x6e: 521, 0x6f: 549,
+	0x70: 549, 0x71: 521, 0x72: 549, 0x73: 603, 0x74: 439, 0x75: 576, 0x76: 713, 0x77: 686,
+	0x78: 493, 0x79: 686, 0x7a: 494, 0x7b: 480, 0x7c: 200, 0x7d: 480, 0x7e: 549, 0xa0: 750,
+	0xa1: 620, 0xa2: 247, 0xa3: 549, 0xa4: 167, 0xa5: 713, 0xa6: 500, 0xa7: 753, 0xa8: 753,
+	0xa9: 753, 0xaa: 753, 0xab: 1042, 0xac: 987, 0xad: 603, 0xae: 987, 0xaf: 603, 0xb0: 400,
+	0xb1: 549, 0xb2: 411, 0xb3: 549, 0xb4: 549, 0xb5: 713, 0xb6: 494, 0xb7: 460, 0xb8: 549,
+	0xb9: 549, 0xba: 549, 0xbb: 549, 0xbc: 1000, 0xbd: 603, 0xbe: 1000, 0xbf: 658, 0xc0: 823,
+	0xc1: 686, 0xc2: 795, 0xc3: 987, 0xc4: 768, 0xc5: 768, 0xc6: 823, 0xc7: 768, 0xc8: 768,
+	0xc9: 713, 0xca: 713, 0xcb: 713, 0xcc: 713, 0xcd: 713, 0xce: 713, 0xcf: 713, 0xd0: 768,
+	0xd1: 713, 0xd2: 790, 0xd3: 790, 0xd4: 890, 0xd5: 823, 0xd6: 549, 0xd7: 250, 0xd8: 713,
+	0xd9: 603, 0xda: 603, 0xdb: 1042, 0xdc: 987, 0xdd: 603, 0xde: 987, 0xdf: 603, 0xe0: 494,
+	0xe1: 329, 0xe2: 790, 0xe3: 790, 0xe4: 786, 0xe5: 713, 0xe6: 384, 0xe7: 384, 0xe8: 384,
+	0xe9: 384, 0xea: 384, 0xeb: 384, 0xec: 494, 0xed: 494, 0xee: 494, 0xef: 494, 0xf1: 329,
+	0xf2: 274, 0xf3: 686, 0xf4: 686, 0xf5: 686, 0xf6: 384, 0xf7: 384, 0xf8: 384, 0xf9: 384,
+	0xfa: 384, 0xfb: 384, 0xfc: 494, 0xfd: 494, 0xfe: 494,
+}
+
+var zapfDingbatsWidths = &[256]int{
+	0x20: 278, 0x21: 974, 0x22: 961, 0x23: 974, 0x24: 980, 0x25: 719, 0x26: 789, 0x27: 790,
+	0x28: 791, 0x29: 690, 0x2a: 960, 0x2b: 939, 0x2c: 549, 0x2d: 855, 0x2e: 911, 0x2f: 933,
+	0x30: 911, 0x31: 945, 0x32: 974, 0x33: 755, 0x34: 846, 0x35: 762, 0x36: 761, 0x37: 571,
+	0x38: 677, 0x39: 763, 0x3a: 760, 0x3b: 759, 0x3c: 754, 0x3d: 494, 0x3e: 552, 0x3f: 537,
+	0x40: 577, 0x41: 692, 0x42: 786, 0x43: 788, 0x44: 788, 0x45: 790, 0x46: 793, 0x47: 794,
+	0x48: 816, 0x49: 823, 0x4a: 789, 0x4b: 841, 0x4c: 823, 0x4d: 833, 0x4e: 816, 0x4f: 831,
+	0x50: 923, 0x51: 744, 0x52: 723, 0x53: 749, 0x54: 790, 0x55: 792, 0x56: 695, 0x57: 776,
+	0x58: 768, 0x59: 792, 0x5a: 759, 0x5b: 707, 0x5c: 708, 0x5d: 682, 0x5e: 701, 0x5f: 826,
+	0x60: 815, 0x61: 789, 0x62: 789, 0x63: 707, 0x64: 687, 0x65: 696, 0x66: 689, 0x67: 786,
+	0x68: 787, 0x69: 713, 0x6a: 791, 0x6b: 785, 0x6c: 791, 0x6d: 873, 0x6e: 761, 0x6f: 762,
+	0x70: 762, 0x71: 759, 0x72: 759, 0x73: 892, 0x74: 892, 0x75: 788, 0x76: 784, 0x77: 438,
+	0x78: 138, 0x79: 277, 0x7a: 415, 0x7b: 392, 0x7c: 392, 0x7d: 668, 0x7e: 668, 0x80: 390,
+	0x81: 390, 0x82: 317, 0x83: 317, 0x84: 276, 0x85: 276, 0x86: 509, 0x87: 509, 0x88: 410,
+	0x89: 410, 0x8a: 234, 0x8b: 234, 0x8c: 334, 0x8d: 334, 0xa1: 732, 0xa2: 544, 0xa3: 544,
+	0xa4: 910, 0xa5: 667, 0xa6: 760, 0xa7: 760, 0xa8: 776, 0xa9: 595, 0xaa: 694, 0xab: 626,
+	0xac: 788, 0xad: 788, 0xae: 788, 0xaf: 788, 0xb0: 788, 0xb1: 788, 0xb2: 788, 0xb3: 788,
+	0xb4: 788, 0xb5: 788, 0xb6: 788, 0xb7: 788, 0xb8: 788, 0xb9: 788, 0xba: 788, 0xbb: 788,
+	0xbc: 788, 0xbd: 788, 0xbe: 788, 0xbf: 788, 0xc0: 788, 0xc1: 788, 0xc2: 788, 0xc3: 788,
+	0xc4: 788, 0xc5: 788, 0xc6: 788, 0xc7: 788, 0xc8: 788, 0xc9: 788, 0xca: 788, 0xcb: 788,
+	0xcc: 788, 0xcd: 788, 0xce: 788, 0xcf: 788, 0xd0: 788, 0xd1: 788, 0xd2: 788, 0xd3: 788,
+	0xd4: 894, 0xd5: 838, 0xd6: 1016, 0xd7: 458, 0xd8: 748, 0xd9: 924, 0xda: 748, 0xdb: 918,
+	0xdc: 927, 0xdd: 928, 0xde: 928, 0xdf: 834, 0xe0: 873, 0xe1: 828, 0xe2: 924, 0xe3: 924,
+	0xe4: 917, 0xe5: 930, 0xe6: 931, 0xe7: 463, 0xe8: 883, 0xe9: 836, 0xea: 836, 0xeb: 867,
+	0xec: 867, 0xed: 696, 0xee: 696, 0xef: 874, 0xf1: 874, 0xf2: 760, 0xf3: 946, 0xf4: 771,
+	0xf5: 865, 0xf6: 771, 0xf7: 888, 0xf8: 967, 0xf9: 888, 0xfa: 831, 0xfb: 873, 0xfc: 927,
+	0xfd: 970, 0xfe: 918,
+}
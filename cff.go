@@ -0,0 +1,363 @@
+package pdf2txt
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf8"
+)
+
+// the Top DICT operators that are needed, with two byte operators as 1200 plus their
+// second byte
+const (
+	cffCharset     = 15
+	cffEncoding    = 16
+	cffCharStrings = 17
+	cffROS         = 1230
+)
+
+// cffStandardStrings is the number of strings every CFF font has, which the String INDEX
+// comes after
+const cffStandardStrings = 391
+
+var errInvalidCFF = errors.New("invalid CFF font")
+
+// cff holds what's needed from an embedded CFF font (/FontFile3) to find the Unicode of its
+// glyphs: the glyph names its charset gives by string ID and its built-in encoding. The
+// charset of a CID-keyed font gives CIDs rather than names.
+type cff struct {
+	strings  [][]byte    // the String INDEX
+	charset  []int       // the SID of each glyph, or its CID in a CID-keyed font
+	cidKeyed bool        // whether the Top DICT starts with ROS
+	glyphs   map[int]int // glyph by CID, for CID-keyed fonts
+	encoding *encoding   // the built-in encoding of a font that isn't CID-keyed
+}
+
+// parseCFF reads the charset and encoding of the first font in a CFF font program
+func parseCFF(data []byte) (*cff, error) {
+	if len(data) < 4 || data[0] != 1 {
+		return nil, errInvalidCFF
+	}
+	_, off, err := readCFFIndex(data, int(data[2])) // Name INDEX
+	if err != nil {
+		return nil, err
+	}
+	topDicts, off, err := readCFFIndex(data, off)
+	if err != nil {
+		return nil, err
+	}
+	if len(topDicts) == 0 {
+		return nil, errInvalidCFF
+	}
+	strings, _, err := readCFFIndex(data, off)
+	if err != nil {
+		return nil, err
+	}
+
+	top := readCFFDict(topDicts[0])
+	c := &cff{strings: strings}
+	_, c.cidKeyed = top[cffROS]
+	nGlyphs := 0
+	if off := cffOperand(top, cffCharStrings); off > 0 {
+		if charStrings, _, err := readCFFIndex(data, off); err == nil {
+			nGlyphs = len(charStrings)
+		}
+	}
+	c.charset = readCFFCharset(data, cffOperand(top, cffCharset), nGlyphs, c.cidKeyed)
+	if c.cidKeyed {
+		c.glyphs = make(map[int]int)
+		for g, cid := range c.charset {
+			c.glyphs[cid] = g
+		}
+	} else {
+		c.encoding = c.readEncoding(data, cffOperand(top, cffEncoding))
+	}
+	return c, nil
+}
+
+// readCFFIndex reads the objects of an INDEX at an offset and returns them with the offset
+// after it
+func readCFFIndex(data []byte, off int) ([][]byte, int, error) {
+	if off < 0 || off+2 > len(data) {
+		return nil, 0, errInvalidCFF
+	}
+	count := int(binary.BigEndian.Uint16(data[off:]))
+	off += 2
+	if count == 0 {
+		return nil, off, nil
+	}
+	if off >= len(data) {
+		return nil, 0, errInvalidCFF
+	}
+	offSize := int(data[off])
+	off++
+	if offSize < 1 || offSize > 4 || off+(count+1)*offSize > len(data) {
+		return nil, 0, errInvalidCFF
+	}
+	offsets := make([]int, count+1)
+	for i := range offsets {
+		for _, b := range data[off+i*offSize : off+(i+1)*offSize] {
+			offsets[i] = offsets[i]<<8 | int(b)
+		}
+	}
+	base := off + (count+1)*offSize - 1 // offsets start at 1
+	objects := make([][]byte, count)
+	for i := range objects {
+		start, end := base+offsets[i], base+offsets[i+1]
+		if offsets[i] < 1 || start > end || end > len(data) {
+			return nil, 0, errInvalidCFF
+		}
+		objects[i] = data[start:end]
+	}
+	return objects, base + offsets[count], nil
+}
+
+// readCFFDict reads the operands of each operator in a DICT. Only integers are needed, so
+// real numbers are read as 0.
+func readCFFDict(data []byte) map[int][]int {
+	dict := make(map[int][]int)
+	var operands []int
+	for i := 0; i < len(data); {
+		b, v := data[i], 0
+		switch {
+		case b <= 21: // an operator
+			op := int(b)
+			if i++; b == 12 && i < len(data) {
+				op = 1200 + int(data[i])
+				i++
+			}
+			dict[op] = operands
+			operands = nil
+			continue
+		case b == 28 && i+3 <= len(data):
+			v = int(int16(binary.BigEndian.Uint16(data[i+1:])))
+			i += 3
+		case b == 29 && i+5 <= len(data):
+			v = int(int32(binary.BigEndian.Uint32(data[i+1:])))
+			i += 5
+		case b == 30: // a real number in nibbles, up to the nibble 0xf
+			for i++; i < len(data); i++ {
+				if data[i]&0x0f == 0x0f || data[i]>>4 == 0x0f {
+					i++
+					break
+				}
+			}
+		case b >= 32 && b <= 246:
+			v = int(b) - 139
+			i++
+		case b >= 247 && b <= 250 && i+2 <= len(data):
+			v = (int(b)-247)*256 + int(data[i+1]) + 108
+			i += 2
+		case b >= 251 && b <= 254 && i+2 <= len(data):
+			v = -(int(b)-251)*256 - int(data[i+1]) - 108
+			i += 2
+		default: // reserved or cut short
+			return dict
+		}
+		operands = append(operands, v)
+	}
+	return dict
+}
+
+// cffOperand returns the last operand of an operator in a DICT, or 0 if it isn't there
+func cffOperand(dict map[int][]int, op int) int {
+	if operands := dict[op]; len(operands) > 0 {
+		return operands[len(operands)-1]
+	}
+	return 0
+}
+
+// readCFFCharset reads the charset at an offset, or the predefined charset an offset of
+// 0, 1 or 2 stands for. CID-keyed fonts don't have predefined charsets. Glyph 0 is always
+// .notdef and isn't in the charset data.
+func readCFFCharset(data []byte, off, nGlyphs int, cidKeyed bool) []int {
+	charset := make([]int, 1, nGlyphs+1)
+	if !cidKeyed && off >= 0 && off <= 2 {
+		predefined := [][]int{isoAdobeCharset, expertCharset, expertSubsetCharset}[off]
+		for g := 1; g < nGlyphs && g < len(predefined); g++ {
+			charset = append(charset, predefined[g])
+		}
+		return charset
+	}
+	if off <= 0 || off >= len(data) {
+		return charset
+	}
+	format, p := data[off], off+1
+	for len(charset) < nGlyphs {
+		var first, nLeft int
+		switch {
+		case format == 0 && p+2 <= len(data):
+			first = int(binary.BigEndian.Uint16(data[p:]))
+			p += 2
+		case format == 1 && p+3 <= len(data):
+			first, nLeft = int(binary.BigEndian.Uint16(data[p:])), int(data[p+2])
+			p += 3
+		case format == 2 && p+4 <= len(data):
+			first, nLeft = int(binary.BigEndian.Uint16(data[p:])), int(binary.BigEndian.Uint16(data[p+2:]))
+			p += 4
+		default:
+			return charset
+		}
+		for i := 0; i <= nLeft && len(charset) < nGlyphs; i++ {
+			charset = append(charset, first+i)
+		}
+	}
+	return charset
+}
+
+// readEncoding reads the built-in encoding at an offset, where 0 stands for
+// StandardEncoding. The predefined Expert encoding (1) isn't supported. Codes map to
+// glyphs in order, from glyph 1 on, and supplements map more codes to glyphs by name.
+func (c *cff) readEncoding(data []byte, off int) *encoding {
+	if off == 0 {
+		return standardEncoding
+	}
+	if off < 0 || off+2 > len(data) {
+		return nil
+	}
+	var e encoding
+	set := func(code int, glyphName string) {
+		if text := glyphText(glyphName); utf8.RuneCountInString(text) == 1 {
+			e[code], _ = utf8.DecodeRuneInString(text)
+		}
+	}
+	format, p := data[off], off+1
+	switch format & 0x7f {
+	case 0:
+		nCodes := int(data[p])
+		p++
+		for g := 1; g <= nCodes && p < len(data); g, p = g+1, p+1 {
+			set(int(data[p]), c.glyphName(g))
+		}
+	case 1:
+		nRanges := int(data[p])
+		p++
+		for i, g := 0, 1; i < nRanges && p+2 <= len(data); i, p = i+1, p+2 {
+			for code := int(data[p]); code <= int(data[p])+int(data[p+1]) && code < 256; code, g = code+1, g+1 {
+				set(code, c.glyphName(g))
+			}
+		}
+	default:
+		return nil
+	}
+	if format&0x80 != 0 && p < len(data) { // supplements
+		nSups := int(data[p])
+		p++
+		for i := 0; i < nSups && p+3 <= len(data); i, p = i+1, p+3 {
+			set(int(data[p]), c.stringName(int(binary.BigEndian.Uint16(data[p+1:]))))
+		}
+	}
+	return &e
+}
+
+// glyphName returns the name of a glyph, or "" if it isn't known. The glyphs of CID-keyed
+// fonts don't have names.
+func (c *cff) glyphName(g int) string {
+	if c.cidKeyed || g < 0 || g >= len(c.charset) {
+		return ""
+	}
+	return c.stringName(c.charset[g])
+}
+
+// stringName returns the string for a string ID: one of the standard strings, or one from
+// the String INDEX after them
+func (c *cff) stringName(sid int) string {
+	if sid >= 0 && sid < cffStandardStrings {
+		return cffStrings[sid]
+	}
+	if i := sid - cffStandardStrings; i >= 0 && i < len(c.strings) {
+		return string(c.strings[i])
+	}
+	return ""
+}
+
+// glyph returns the glyph for a CID and whether the font has one. The CIDs of a font that
+// isn't CID-keyed are its glyph IDs.
+func (c *cff) glyph(cid int) (int, bool) {
+	if !c.cidKeyed {
+		return cid, cid >= 0 && cid < len(c.charset)
+	}
+	g, ok := c.glyphs[cid]
+	return g, ok
+}
+
+// sidRange returns the string IDs from first to last
+func sidRange(first, last int) []int {
+	sids := make([]int, 0, last-first+1)
+	for sid := first; sid <= last; sid++ {
+		sids = append(sids, sid)
+	}
+	return sids
+}
+
+// concat joins lists of string IDs
+func concat(lists ...[]int) []int {
+	var sids []int
+	for _, l := range lists {
+		sids = append(sids, l...)
+	}
+	return sids
+}
+
+// the predefined charsets (Appendix C of the CFF specification), by glyph ID
+var (
+	isoAdobeCharset = sidRange(0, 228)
+	expertCharset   = concat([]int{0, 1}, sidRange(229, 238), []int{13, 14, 15, 99}, sidRange(239, 248), []int{27, 28},
+		sidRange(249, 266), []int{109, 110}, sidRange(267, 318), []int{158, 155, 163}, sidRange(319, 326), []int{150, 164, 169},
+		sidRange(327, 378))
+	expertSubsetCharset = concat([]int{0, 1, 231, 232}, sidRange(235, 238), []int{13, 14, 15, 99}, sidRange(239, 248),
+		[]int{27, 28}, sidRange(249, 251), sidRange(253, 266), []int{109, 110}, sidRange(267, 270), []int{272, 300, 301, 302, 305, 314, 315},
+		[]int{158, 155, 163}, sidRange(320, 326), []int{150, 164, 169}, sidRange(327, 346))
+)
+
+// cffStrings are the standard strings (Appendix A of the CFF specification), by string ID
+var cffStrings = [cffStandardStrings]string{
+	".notdef", "space", "exclam", "quotedbl", "numbersign", "dollar", "percent", "ampersand",
+	"quoteright", "parenleft", "parenright", "asterisk", "plus", "comma", "hyphen", "period",
+	"slash", "zero", "one", "two", "three", "four", "five", "six",
+	"seven", "eight", "nine", "colon", "semicolon", "less", "equal", "greater",
+	"question", "at", "A", "B", "C", "D", "E", "F",
+	"G", "H", "I", "J", "K", "L", "M", "N",
+	"O", "P", "Q", "R", "S", "T", "U", "V",
+	"W", "X", "Y", "Z", "bracketleft", "backslash", "bracketright", "asciicircum",
+	"underscore", "quoteleft", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "braceleft", "bar", "braceright", "asciitilde",
+	"exclamdown", "cent", "sterling", "fraction", "yen", "florin", "section", "currency",
+	"quotesingle", "quotedblleft", "guillemotleft", "guilsinglleft", "guilsinglright", "fi", "fl", "endash",
+	"dagger", "daggerdbl", "periodcentered", "paragraph", "bullet", "quotesinglbase", "quotedblbase", "quotedblright",
+	"guillemotright", "ellipsis", "perthousand", "questiondown", "grave", "acute", "circumflex", "tilde",
+	"macron", "breve", "dotaccent", "dieresis", "ring", "cedilla", "hungarumlaut", "ogonek",
+	"caron", "emdash", "AE", "ordfeminine", "Lslash", "Oslash", "OE", "ordmasculine",
+	"ae", "dotlessi", "lslash", "oslash", "oe", "germandbls", "onesuperior", "logicalnot",
+	"mu", "trademark", "Eth", "onehalf", "plusminus", "Thorn", "onequarter", "divide",
+	"brokenbar", "degree", "thorn", "threequarters", "twosuperior", "registered", "minus", "eth",
+	"multiply", "threesuperior", "copyright", "Aacute", "Acircumflex", "Adieresis", "Agrave", "Aring",
+	"Atilde", "Ccedilla", "Eacute", "Ecircumflex", "Edieresis", "Egrave", "Iacute", "Icircumflex",
+	"Idieresis", "Igrave", "Ntilde", "Oacute", "Ocircumflex", "Odieresis", "Ograve", "Otilde",
+	"Scaron", "Uacute", "Ucircumflex", "Udieresis", "Ugrave", "Yacute", "Ydieresis", "Zcaron",
+	"aacute", "acircumflex", "adieresis", "agrave", "aring", "atilde", "ccedilla", "eacute",
+	"ecircumflex", "edieresis", "egrave", "iacute", "icircumflex", "idieresis", "igrave", "ntilde",
+	"oacute", "ocircumflex", "odieresis", "ograve", "otilde", "scaron", "uacute", "ucircumflex",
+	"udieresis", "ugrave", "yacute", "ydieresis", "zcaron", "exclamsmall", "Hungarumlautsmall", "dollaroldstyle",
+	"dollarsuperior", "ampersandsmall", "Acutesmall", "parenleftsuperior", "parenrightsuperior", "twodotenleader", "onedotenleader", "zerooldstyle",
+	"oneoldstyle", "twooldstyle", "threeoldstyle", "fouroldstyle", "fiveoldstyle", "sixoldstyle", "sevenoldstyle", "eightoldstyle",
+	"nineoldstyle", "commasuperior", "threequartersemdash", "periodsuperior", "questionsmall", "asuperior", "bsuperior", "centsuperior",
+	"dsuperior", "esuperior", "isuperior", "lsuperior", "msuperior", "nsuperior", "osuperior", "rsuperior",
+	"ssuperior", "tsuperior", "ff", "ffi", "ffl", "parenleftinferior", "parenrightinferior", "Circumflexsmall",
+	"hyphensuperior", "Gravesmall", "Asmall", "Bsmall", "Csmall", "Dsmall", "Esmall", "Fsmall",
+	"Gsmall", "Hsmall", "Ismall", "Jsmall", "Ksmall", "Lsmall", "Msmall", "Nsmall",
+	"Osmall", "Psmall", "Qsmall", "Rsmall", "Ssmall", "Tsmall", "Usmall", "Vsmall",
+	"Wsmall", "Xsmall", "Ysmall", "Zsmall", "colonmonetary", "onefitted", "rupiah", "Tildesmall",
+	"exclamdownsmall", "centoldstyle", "Lslashsmall", "Scaronsmall", "Zcaronsmall", "Dieresissmall", "Brevesmall", "Caronsmall",
+	"Dotaccentsmall", "Macronsmall", "figuredash", "hypheninferior", "Ogoneksmall", "Ringsmall", "Cedillasmall", "questiondownsmall",
+	"oneeighth", "threeeighths", "fiveeighths", "seveneighths", "onethird", "twothirds", "zerosuperior", "foursuperior",
+	"fivesuperior", "sixsuperior", "sevensuperior", "eightsuperior", "ninesuperior", "zeroinferior", "oneinferior", "twoinferior",
+	"threeinferior", "fourinferior", "fiveinferior", "sixinferior", "seveninferior", "eightinferior", "nineinferior", "centinferior",
+	"dollarinferior", "periodinferior", "commainferior", "Agravesmall", "Aacutesmall", "Acircumflexsmall", "Atildesmall", "Adieresissmall",
+	"Aringsmall", "AEsmall", "Ccedillasmall", "Egravesmall", "Eacutesmall", "Ecircumflexsmall", "Edieresissmall", "Igravesmall",
+	"Iacutesmall", "Icircumflexsmall", "Idieresissmall", "Ethsmall", "Ntildesmall", "Ogravesmall", "Oacutesmall", "Ocircumflexsmall",
+	"Otildesmall", "Odieresissmall", "OEsmall", "Oslashsmall", "Ugravesmall", "Uacutesmall", "Ucircumflexsmall", "Udieresissmall",
+	"Yacutesmall", "Thornsmall", "Ydieresissmall", "001.000", "001.001", "001.002", "001.003", "Black",
+	"Bold", "Book", "Light", "Medium", "Regular", "Roman", "Semibold",
+}
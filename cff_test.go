@@ -0,0 +1,169 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+// cffIndex builds an INDEX of objects
+func cffIndex(objects ...[]byte) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint16(len(objects)))
+	if len(objects) == 0 {
+		return b.Bytes()
+	}
+	b.WriteByte(4)
+	offset := uint32(1)
+	binary.Write(&b, binary.BigEndian, offset)
+	for _, o := range objects {
+		offset += uint32(len(o))
+		binary.Write(&b, binary.BigEndian, offset)
+	}
+	for _, o := range objects {
+		b.Write(o)
+	}
+	return b.Bytes()
+}
+
+// cffInt encodes a DICT operand in five bytes, so offsets don't change the size of the
+// Top DICT
+func cffInt(v int) []byte {
+	return []byte{29, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// buildCFF builds a CFF font with nGlyphs glyphs. A nil charset or encoding leaves the
+// predefined ISOAdobe charset or StandardEncoding.
+func buildCFF(strings []string, charset, encoding []byte, nGlyphs int, cidKeyed bool) []byte {
+	var stringIndex, charStrings [][]byte
+	for _, s := range strings {
+		stringIndex = append(stringIndex, []byte(s))
+	}
+	for g := 0; g < nGlyphs; g++ {
+		charStrings = append(charStrings, []byte{14}) // endchar
+	}
+	topDict := func(offset int) []byte {
+		var top []byte
+		if cidKeyed {
+			top = append(append(append(top, cffInt(cffStandardStrings)...), cffInt(cffStandardStrings)...), 139, 12, 30)
+		}
+		if charset != nil {
+			top = append(append(top, cffInt(offset)...), cffCharset)
+		}
+		if encoding != nil {
+			top = append(append(top, cffInt(offset+len(charset))...), cffEncoding)
+		}
+		return append(append(top, cffInt(offset+len(charset)+len(encoding))...), cffCharStrings)
+	}
+	head := append([]byte{1, 0, 4, 4}, cffIndex([]byte("Test"))...)
+	offset := len(head) + len(cffIndex(topDict(0))) + len(cffIndex(stringIndex...)) + len(cffIndex())
+	data := append(head, cffIndex(topDict(offset))...)
+	for _, b := range [][]byte{cffIndex(stringIndex...), cffIndex(), charset, encoding, cffIndex(charStrings...)} {
+		data = append(data, b...)
+	}
+	return data
+}
+
+// customCFF has the glyphs A, alpha, uni2713 and bullet for A to D, and A again for E
+func customCFF() []byte {
+	return buildCFF([]string{"alpha", "uni2713"}, []byte{0, 0, 34, 1, 0x87, 1, 0x88, 0, 116},
+		[]byte{0x80, 4, 'A', 'B', 'C', 'D', 1, 'E', 0, 34}, 5, false)
+}
+
+func TestParseCFF(t *testing.T) {
+	c, err := parseCFF(customCFF())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for code, expected := range map[byte]rune{'A': 'A', 'B': 'α', 'C': '✓', 'D': '•', 'E': 'A', 'F': 0} {
+		if c.encoding[code] != expected {
+			t.Errorf("code %#x: expected %q, got %q", code, expected, c.encoding[code])
+		}
+	}
+
+	c, err = parseCFF(buildCFF(nil, []byte{1, 0, 34, 2}, []byte{1, 1, 'a', 2}, 4, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := string(c.encoding['a':'d']); text != "ABC" {
+		t.Errorf("expected ranges to give %q, got %q", "ABC", text)
+	}
+
+	c, err = parseCFF(buildCFF(nil, nil, nil, 40, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.encoding != standardEncoding || c.glyphName(34) != "A" || c.glyphName(40) != "" {
+		t.Errorf("expected the ISOAdobe charset and StandardEncoding, got glyph %q", c.glyphName(34))
+	}
+
+	c, err = parseCFF(buildCFF(nil, []byte{2, 0x03, 0xe8, 0, 1}, nil, 3, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, ok := c.glyph(1001); !ok || g != 2 || c.glyphName(g) != "" || c.encoding != nil {
+		t.Errorf("expected CID 1001 to be glyph 2 without a name, got %d", g)
+	}
+	if _, ok := c.glyph(5); ok {
+		t.Error("expected CID 5 not to have a glyph")
+	}
+
+	for _, data := range [][]byte{nil, {2, 0, 4, 4}, customCFF()[:12]} {
+		if _, err := parseCFF(data); err == nil {
+			t.Errorf("% x: expected an invalid font", data)
+		}
+	}
+}
+
+func TestCFFStrings(t *testing.T) {
+	for sid, expected := range map[int]string{0: ".notdef", 116: "bullet", 228: "zcaron", 299: "Zsmall", 379: "001.000", 390: "Semibold"} {
+		if cffStrings[sid] != expected {
+			t.Errorf("SID %d: expected %q, got %q", sid, expected, cffStrings[sid])
+		}
+	}
+	if len(expertCharset) != 166 || len(expertSubsetCharset) != 87 {
+		t.Errorf("unexpected predefined charset sizes %d and %d", len(expertCharset), len(expertSubsetCharset))
+	}
+}
+
+func TestCFFFonts(t *testing.T) {
+	fontFile := func(subtype string, data []byte) string {
+		return fmt.Sprintf("<</Subtype %s /Length %d>> stream\n%s\nendstream", subtype, len(data), data)
+	}
+	openType := buildTrueType(map[string][]byte{
+		"CFF ": buildCFF(nil, []byte{2, 0x03, 0xe8, 0, 1}, nil, 3, true),
+		"cmap": cmapTable(map[[2]uint16][]byte{
+			{3, 1}: cmapFormat4(cmapSegment{start: 0x41, end: 0x41, delta: 1 - 0x41}, cmapSegment{start: 0x4e9c, end: 0x4e9c, delta: 2 - 0x4e9c}),
+		}),
+	})
+	pdf := singlePagePDF("BT /F1 12 Tf (ABCDE) Tj /F2 12 Tf <0001000200030004> Tj /F3 12 Tf <03e803e9> Tj ET", "/F1 5 0 R /F2 8 0 R /F3 12 0 R",
+		"<</Type /Font /Subtype /Type1 /BaseFont /ABCDEF+Custom /FontDescriptor 6 0 R>>",
+		"<</Type /FontDescriptor /Flags 4 /FontFile3 7 0 R>>",
+		fontFile("/Type1C", customCFF()),
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [9 0 R]>>",
+		"<</Type /Font /Subtype /CIDFontType0 /FontDescriptor 10 0 R>>",
+		"<</Type /FontDescriptor /Flags 4 /FontFile3 11 0 R>>",
+		fontFile("/CIDFontType0C", customCFF()),
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [13 0 R]>>",
+		"<</Type /Font /Subtype /CIDFontType0 /FontDescriptor 14 0 R>>",
+		"<</Type /FontDescriptor /Flags 4 /FontFile3 15 0 R>>",
+		fontFile("/OpenType", openType))
+	if text := pageText(t, pdf); text != "Aα✓•AAα✓•A亜\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func FuzzCFF(f *testing.F) {
+	f.Add(customCFF())
+	f.Add(buildCFF(nil, []byte{2, 0x03, 0xe8, 0, 1}, nil, 3, true))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if c, err := parseCFF(data); err == nil {
+			for cid := 0; cid < 256; cid++ {
+				if g, ok := c.glyph(cid); ok {
+					c.glyphName(g)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The predefined CMaps that map codes to the CIDs of the Adobe-Japan1, Adobe-GB1,
+// Adobe-CNS1 and Adobe-Korea1 character collections, and the CID-to-Unicode tables of
+// those collections, are packed in cjkdata.go by gencjk.go. Each is unpacked the first time
+// it's used and then kept for every document.
+var cjk struct {
+	sync.Mutex
+	cmaps       map[name]*predefinedCMap
+	collections map[string]*collection
+}
+
+// cidRun is a run of codes of the same length that map to consecutive CIDs
+type cidRun struct {
+	length    int
+	low, high uint64
+	cid       int // the CID of low
+}
+
+// collection is the CID-to-Unicode table of an Adobe character collection
+type collection struct {
+	chars     []rune         // the character of each CID, or 0 if it has none
+	sequences map[int]string // the text of the CIDs that are more than one character
+}
+
+// text returns the Unicode for a CID, or "" if the collection doesn't have it
+func (c *collection) text(cid int) string {
+	if c == nil || cid < 0 {
+		return ""
+	}
+	if s, ok := c.sequences[cid]; ok {
+		return s
+	}
+	if cid < len(c.chars) && c.chars[cid] != 0 {
+		return string(c.chars[cid])
+	}
+	return ""
+}
+
+// lookupCollection returns the CID-to-Unicode table of the Adobe character collection with
+// an ordering, or nil if it isn't bundled
+func lookupCollection(ordering string) *collection {
+	cjk.Lock()
+	defer cjk.Unlock()
+	if c, ok := cjk.collections[ordering]; ok {
+		return c
+	}
+	var c *collection
+	if data, ok := collectionData[ordering]; ok {
+		c = readCollection(data)
+	}
+	if cjk.collections == nil {
+		cjk.collections = make(map[string]*collection)
+	}
+	cjk.collections[ordering] = c
+	return c
+}
+
+// lookupCIDCMap returns the predefined CMap with a name that maps codes to CIDs, with the
+// CMap it uses, or nil if it isn't bundled
+func lookupCIDCMap(n name) *predefinedCMap {
+	cjk.Lock()
+	defer cjk.Unlock()
+	return cidCMap(n, 0)
+}
+
+func cidCMap(n name, depth int) *predefinedCMap {
+	if c, ok := cjk.cmaps[n]; ok {
+		return c
+	}
+	data, ok := cidCMapData[n]
+	if !ok || depth >= maxDepth {
+		return nil
+	}
+	c, use := readCIDCMap(data)
+	if c != nil && use != "" {
+		c.parent = cidCMap(name("/"+use), depth+1)
+		if c.parent != nil {
+			c.codespace = append(c.codespace, c.parent.codespace...)
+		}
+	}
+	if cjk.cmaps == nil {
+		cjk.cmaps = make(map[name]*predefinedCMap)
+	}
+	cjk.cmaps[n] = c
+	return c
+}
+
+// readCIDCMap unpacks a predefined CMap that maps codes to CIDs and returns it with the
+// name of the CMap it uses. See packCMap in gencjk.go.
+func readCIDCMap(data string) (*predefinedCMap, string) {
+	u, ok := newUnpacker(data)
+	if !ok {
+		return nil, ""
+	}
+	use := u.string()
+	c := &predefinedCMap{collection: u.string()}
+	for i := u.uvarint(); i > 0 && !u.failed; i-- {
+		n := int(u.byte())
+		if low, high := u.bytes(n), u.bytes(n); validRange(low, high) {
+			c.codespace = append(c.codespace, codespaceRange{low: low, high: high})
+		}
+	}
+	var end uint64
+	length, nextCID := 0, 0
+	for i := u.uvarint(); i > 0 && !u.failed; i-- {
+		if n := int(u.byte()); n != length {
+			end, length = 0, n
+		}
+		r := cidRun{length: length, low: end + u.uvarint()}
+		r.high = r.low + u.uvarint()
+		r.cid = nextCID + int(u.varint())
+		c.cids = append(c.cids, r)
+		end, nextCID = r.high+1, r.cid+int(r.high-r.low)+1
+	}
+	if u.failed {
+		return nil, ""
+	}
+	return c, use
+}
+
+// readCollection unpacks a CID-to-Unicode table. See packCollection in gencjk.go.
+func readCollection(data string) *collection {
+	u, ok := newUnpacker(data)
+	if !ok {
+		return nil
+	}
+	c := &collection{sequences: make(map[int]string)}
+	end := 0
+	var last rune
+	for len(u.data) > 0 && !u.failed {
+		cid := end + int(u.uvarint())
+		n := int(u.uvarint())
+		if n == 0 {
+			var chars []rune
+			for i := u.uvarint(); i > 0 && !u.failed; i-- {
+				chars = append(chars, rune(u.uvarint()))
+			}
+			c.sequences[cid] = string(chars)
+			end = cid + 1
+			continue
+		}
+		r := last + rune(u.varint())
+		if len(c.chars) < cid+n {
+			c.chars = append(c.chars, make([]rune, cid+n-len(c.chars))...)
+		}
+		for i := 0; i < n; i++ {
+			c.chars[cid+i] = r + rune(i)
+		}
+		end, last = cid+n, r+rune(n)-1
+	}
+	if u.failed {
+		return nil
+	}
+	return c
+}
+
+// unpacker reads the packed tables, which are generated, so reading past their end only
+// marks them as failed
+type unpacker struct {
+	data   []byte
+	failed bool
+}
+
+func newUnpacker(data string) (*unpacker, bool) {
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(flate.NewReader(strings.NewReader(data))); err != nil {
+		return nil, false
+	}
+	return &unpacker{data: out.Bytes()}, true
+}
+
+func (u *unpacker) uvarint() uint64 {
+	v, n := binary.Uvarint(u.data)
+	if n <= 0 {
+		u.failed, u.data = true, nil
+		return 0
+	}
+	u.data = u.data[n:]
+	return v
+}
+
+func (u *unpacker) varint() int64 {
+	v, n := binary.Varint(u.data)
+	if n <= 0 {
+		u.failed, u.data = true, nil
+		return 0
+	}
+	u.data = u.data[n:]
+	return v
+}
+
+func (u *unpacker) byte() byte {
+	b := u.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (u *unpacker) bytes(n int) []byte {
+	if n > len(u.data) {
+		u.failed, u.data = true, nil
+		return nil
+	}
+	b := u.data[:n:n]
+	u.data = u.data[n:]
+	return b
+}
+
+func (u *unpacker) string() string {
+	return string(u.bytes(int(u.uvarint())))
+}
+
+// cid returns the CID a predefined CMap, or the CMap it uses, maps a code to and whether
+// it has one. Its runs are sorted by code length and then by code.
+func (c *predefinedCMap) cid(code []byte) (int, bool) {
+	v := codeValue(code)
+	for depth := 0; c != nil && depth < maxDepth; c, depth = c.parent, depth+1 {
+		runs := c.cids
+		i := sort.Search(len(runs), func(i int) bool {
+			return runs[i].length > len(code) || runs[i].length == len(code) && runs[i].high >= v
+		})
+		if i < len(runs) && runs[i].length == len(code) && runs[i].low <= v {
+			return runs[i].cid + int(v-runs[i].low), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,5145 @@
+// Code generated by gencjk.go from Adobe's cmap-resources; DO NOT EDIT.
+
+package pdf2txt
+
+// cidCMapData are the predefined CMaps that map codes to CIDs, by name. Each is packed by
+// gencjk.go and compressed with flate, and is read by readCIDCMap.
+var cidCMapData = map[name]string{
+	"/83pv-RKSJ-H": "" +
+		"\xbc\xd3\xb9\x4f\x54\x6d\x14\xc7\xf1\xef\xf9\xdd\xb9\x33\xc3\x3a\x81\x02\x78\xf3\x3a\x48\x8c\x95\xa1\xd1\x3f\x60\x24\x31\x36\x9a" +
+		"\x50\x4c\x63\x85\x09\x31\x14\x66\xee\x4c\xee\xd8\xd0\x58\x00\xb5\x5b\x65\x83\x8a\x5b\xa3\x09\xb8\xef\x02\xa3\x08\x62\xc4\xad\xb2" +
+		"\x30\x51\x3b\x69\xb5\x72\x19\xd4\x9c\xdc\xa9\x28\x34\x31\xd1\xe2\x7c\xce\xef\x24\x67\x9e\x3c\x93\x3c\x97\xf4\xae\xe1\x78\xb8\xb2" +
+		"\x35\x34\xc6\x34\x3e\x30\x55\xb7\xd3\xef\xf4\x7e\xa0\x5e\xb7\xb5\x1f\x6f\xcd\xfa\xf6\xca\x0c\xd9\xc6\xed\xcb\x81\xe5\x79\x13\x18" +
+		"\x1c\x37\x83\x57\xa6\xda\x84\x0a\x9f\x33\xb2\x7e\xd4\x92\x41\xd9\x34\x6a\x69\x47\x99\x0c\x4a\x81\x8a\x4d\x28\xf3\x5f\x52\xa9\x22" +
+		"\x1a\x2c\x20\xeb\x42\xd9\xee\xa4\xf6\xf7\xa1\x5c\x3b\xb2\x0e\xd4\xc6\xf9\x7d\x02\x09\x3a\x05\x69\x41\xbb\xa0\xa9\xd1\x7b\x05\x1d" +
+		"\x8d\xee\xf3\x68\x63\xb7\x6b\xdd\x6e\xd7\xba\x5d\xaf\x3d\x82\x0d\x82\x5c\xa3\xfb\xee\x4e\xc1\x66\x41\x77\xa3\x77\x6a\xdc\x0a\xe7" +
+		"\x0e\xc8\x98\x33\x91\x9f\x35\x51\x90\xe8\x95\x76\x14\x92\x0b\xc2\x54\x20\x5a\x4e\x05\x0a\x76\xaf\x46\x2a\xe6\x93\xff\xd2\x9c\x43" +
+		"\x30\x59\x12\x04\xc9\xd9\xe9\x43\x3e\x9c\x2c\x09\x3b\x51\xd2\x84\x86\x5e\x8f\x24\x87\x1c\xe4\xdf\xf7\x6d\xa8\x7f\xe8\xcf\x7f\xbf" +
+		"\x30\x66\x7f\xe3\x66\x9b\x10\xb6\xd2\xaa\x49\xe3\xc9\xa8\xb0\x8b\x55\xd1\x7a\xa1\x2a\xec\x58\x55\x64\x8f\x56\x85\xbe\xc7\xc2\xd6" +
+		"\x62\x91\xfa\x1a\x8b\xe0\x4b\x2c\x3a\x3e\x79\xfa\x18\xcb\x40\xb0\x1a\x8b\x2d\x1f\xe2\xdf\x3f\x3d\x96\x2b\x82\xc7\xce\x92\xb3\xe8" +
+		"\x3c\x72\x16\x9c\x87\xce\x03\xa7\x56\x11\x3d\xf3\x9e\x8e\x54\x44\xef\x61\x4f\x2f\xcb\x82\x17\xce\x73\xe7\x99\xb3\x52\x16\xe1\x53" +
+		"\x4f\xb5\xb2\x48\xcd\x97\x35\xc8\x4d\x1f\x6f\x38\xd7\x9d\x6b\xce\x55\xe7\x8a\x73\xd9\xb9\xe4\xcc\x94\x45\xcf\xb4\xa7\x7a\x24\xfe" +
+		"\xff\x16\xc9\x84\x60\x2e\x12\xcc\x3a\xf7\x9d\x7b\xce\xdd\x48\x84\x77\x3c\xcd\x78\x9a\x8e\x84\x9d\x8d\x94\xed\x3e\x13\x25\x1f\x50" +
+		"\xbe\x36\x22\xcb\x2d\x85\x22\xbd\x18\xaa\xd9\x6e\x87\xa2\xed\x56\xf8\xab\x17\xf9\x73\x00",
+	"/90ms-RKSJ-H": "" +
+		"\xbc\x92\xbd\xab\x13\x41\x14\xc5\x7f\xf7\xec\xbe\xd9\x5d\x57\xf3\x78\x29\x36\x08\x2a\x31\xd8\x99\x46\xed\xd7\x80\x88\x60\xa1\x90" +
+		"\xc6\x2a\x45\x90\x14\x1a\x11\x63\xa1\x8d\x45\x12\x3f\x1a\xad\x2c\xb4\x88\xc1\x08\x16\xa2\xff\x84\x90\x36\xd8\x5a\x2a\x44\xec\xc4" +
+		"\x4a\x11\x5c\x45\xc6\x5d\x41\x62\x27\x68\x71\x38\xbf\x3b\x73\xe6\x83\xcb\xc5\x9d\xee\x5f\xe9\x5f\x3e\x12\x1a\x63\x4d\x3a\x8f\x0b" +
+		"\x5b\xbc\xd1\xdb\x4e\x51\xbc\x30\x6b\xf6\x5e\x05\x06\xef\x42\x3b\x78\x7c\x1d\xea\xe5\x54\xf9\xfb\x40\xd6\x46\x69\x84\x62\x87\xd2" +
+		"\x1a\x8a\x22\x14\x82\xba\x09\x8a\xf6\x96\x0a\xbb\xe8\x4c\x8e\x2c\x43\x71\xa3\xd4\x85\x26\xda\xae\x21\xdb\x41\x7b\x78\x7a\x5e\x20" +
+		"\x41\x5d\xe0\x04\x35\x41\x52\xf9\x01\xc1\x4e\xe5\xbe\xbe\x5e\x65\xb3\x8d\x6c\xb6\x91\xf5\x3a\x27\xd8\x27\xd8\xae\xdc\x67\x4f\x0a" +
+		"\x0e\x09\x1a\x95\xd7\x35\xd9\xda\x5f\x97\x19\x82\x3b\x91\xe0\x76\xf4\x07\xd9\x4f\xba\xe5\xe9\xa6\xa7\xc2\x09\xfb\xea\x04\xdf\x9d" +
+		"\x70\xdf\x9c\x62\x1e\xa4\xb2\xe0\x61\x2a\xf8\xec\xc4\xae\x4f\x7e\x77\x36\x14\x04\xe5\xcb\xee\x9e\x2f\xe6\x43\x61\x8f\x86\x9a\xaa" +
+		"\xf7\x7a\xa0\x13\x39\xb2\x1b\xfc\x7f\x3f\x8a\xda\xbd\xbf\x3f\xbf\x1c\xdb\xbf\xf8\x59\x0b\x61\xab\xdd\x9a\x6d\xe5\xf7\x4d\x76\x0c" +
+		"\xc1\x93\x81\x38\xb5\xa8\x5a\x75\x09\x29\x59\x27\x82\x0f\x57\x85\x9e\x8f\xb4\xcc\x92\x67\x26\x92\x79\x2c\x58\x5f\x2c\x17\xe1\xae" +
+		"\xab\x46\xe1\x8b\x87\x8f\x23\xd1\x5a\x5d\x93\x9d\xfd\x75\xe3\xe1\xc5\x6f\xcd\x4f\xf9\x31\x00",
+	"/90ms-RKSJ-V": "" +
+		"\x5c\x8a\xc1\x2a\x44\x61\x1c\x47\xcf\xff\x98\xfb\x69\x4c\xd1\xdd\x90\x12\x12\x76\x13\x93\x85\x6c\x2c\x2c\xa4\x61\x65\x9e\x60\x1e" +
+		"\x80\xa6\x64\x65\x47\x64\x47\x71\x91\x85\xbc\xca\xbc\x85\xe7\xb0\xb0\xd6\x37\xcd\x14\x77\xf1\x9d\xef\xf7\x3f\x9d\xd6\xde\xf6\xd9" +
+		"\x45\xfb\xf4\xb8\xd7\x6d\x1f\xa5\x6e\x7f\xd0\x3f\xef\x70\xe2\xf0\xda\xf8\xb8\x12\x7e\x0a\x61\xc6\x56\xdc\x24\x9b\xa2\x0d\x6c\x94" +
+		"\x98\xc0\x4d\xaa\x81\x30\x25\x51\x08\x5f\x97\x7e\x07\xc3\xc2\x80\x7f\x6f\x11\x5c\xf9\x73\x27\xb0\x57\x6b\x26\xdd\x6a\xad\x4b\x81" +
+		"\xf7\x52\x4d\x0b\x85\xb0\x2c\xcc\x0a\x4d\xa1\x14\xf6\xc7\x7f\xf6\x1d\x83\x9d\x71\xb7\x56\xeb\x0e\x6b\xdd\xbc\x30\x27\x2c\x09\x5b" +
+		"\xc2\x81\xb0\x21\x2c\x08\xeb\xc2\xee\xc8\x7d\x16\xf1\x9e\x84\xbb\x8c\xdb\x8c\x97\x8c\x2a\xe3\x31\x49\x3c\x4c\x5c\x8c\xdc\x5b\x5e" +
+		"\xaf\x79\x3d\x27\xcb\x78\x4a\xbf\x03\x00",
+	"/90msp-RKSJ-H": "" +
+		"\xbc\x92\xbd\xab\x13\x41\x14\xc5\x7f\xf7\x6c\xde\xec\xae\xab\x79\x24\xc5\x06\x41\x25\x06\x3b\xd3\xa8\xfd\x1a\x10\x11\x2c\x14\xd2" +
+		"\x58\x45\x08\x92\x42\x23\x62\x2c\xb4\xb1\x48\xe2\x47\xa3\x95\x85\x16\x31\x18\xc1\x42\xf2\x57\x08\x69\xd3\x5b\x2a\x04\xec\xc4\x4a" +
+		"\x11\x5c\x45\xc6\x5d\x41\x62\x27\xf8\x8a\xc3\xf9\xdd\x99\x33\x1f\x5c\x2e\xee\x7c\xff\x66\xff\xc6\x89\x8a\x31\xd6\xa4\xf3\x22\xb7" +
+		"\xc5\x3b\xbd\xef\xe4\xf9\xd2\xac\x79\x59\x76\xf4\xf4\x3a\xd0\x9b\xa9\xb2\x0f\x81\xac\x8d\x92\x10\x45\x0e\x25\x55\x14\x86\xa8\x02" +
+		"\xea\xc6\x28\x3c\x58\xa8\xd2\x45\x17\x32\x64\x29\x8a\x1a\x85\xae\x36\xd1\x6e\x15\x59\x0d\x1d\xe0\xd5\x15\x81\x04\x75\x81\x13\x54" +
+		"\x05\x71\xe9\x47\x04\xb5\xd2\x7d\x7d\xa7\xcc\xa6\x5b\xd9\x74\x2b\xeb\x75\x49\x70\x48\xb0\x5b\xba\xcf\x9e\x15\x1c\x13\x34\x4a\xaf" +
+		"\x6b\xb2\x73\xb8\x2e\x33\x04\x0f\x43\xc1\x83\xf0\x2f\xb2\x5f\x74\xdf\xd3\x3d\x4f\xb9\x13\xf6\xcd\x09\x7e\x38\xe1\xbe\x3b\x45\x3c" +
+		"\x4d\x64\xc1\xb3\x44\xf0\xc5\x89\x7d\x9f\xfd\xee\x6c\x28\x08\x8a\x97\xdd\x63\x5f\xcc\x87\xc2\x9e\x0f\x35\x55\xef\xed\x40\x67\x32" +
+		"\x64\x77\xd9\x7b\x3f\x89\xda\xbd\x7f\x3f\xbf\x1a\xdb\xff\xf8\x59\x0b\x61\xeb\xfd\x9a\xed\x64\x4f\x4c\x76\x0a\xc1\xcb\x81\x38\xb7" +
+		"\x28\x5b\x75\x1d\x29\xde\xc4\x82\x8f\xb7\x84\x96\x23\xad\xd2\xf8\xb5\x89\x78\x1e\x09\x36\xd7\x8a\x45\x78\xe4\xca\x51\xf8\xea\xe1" +
+		"\xd3\x48\xb4\xd6\xb7\x65\x17\x7f\xdf\x78\x7c\xf1\x47\xf3\x13\x7e\x0e\x00",
+	"/90msp-RKSJ-V": "" +
+		"\x5c\x8a\xc1\x2a\x44\x61\x1c\x47\xcf\xff\x98\xfb\x69\x10\xcd\x86\x94\x90\xb0\x9b\x98\x2c\x64\x63\x61\x21\x0d\x2b\xf3\x04\xf3\x00" +
+		"\x74\x4b\x56\x76\x44\x76\x14\x17\x59\xc8\xab\xcc\x5b\x78\x0e\x0b\x6b\x7d\xd3\x4c\x71\x17\xdf\xf9\x7e\xff\xd3\x99\xd9\xdb\x3e\xbb" +
+		"\x28\xdb\xa7\xc7\xbd\x6e\xfb\x28\x75\xfb\x65\xff\xbc\xc3\x89\x83\x6b\xe3\xe3\x4a\xf8\x29\x84\x29\xa7\xe3\x26\xd9\x14\x6d\x60\xa3" +
+		"\x85\x09\xdc\xa4\x2a\x85\x09\x89\x42\xf8\xba\xf4\x3b\x18\x14\x06\xfc\x7b\x8b\xe0\xca\x9f\x3b\x81\xbd\x5a\x33\xee\x56\x6b\x5d\x0a" +
+		"\xbc\x97\x6a\x52\x28\x84\x65\x61\x56\x68\x0a\x2d\x61\x7f\xf4\x67\xdf\x31\xd8\x19\x75\x6b\xb5\xee\xb0\xd6\xcd\x0b\x73\xc2\x92\xb0" +
+		"\x25\x1c\x08\x1b\xc2\x82\xb0\x2e\xec\x0e\xdd\x67\x11\xef\x49\xb8\xcb\xb8\xcd\x78\xc9\xa8\x32\x1e\x93\xc4\xc3\xd8\xc5\xd0\xbd\xe5" +
+		"\xf5\x9a\xd7\x73\xb2\x15\x4f\xe9\x77\x00",
+	"/90pv-RKSJ-H": "" +
+		"\xbc\x90\xbf\x6b\x54\x4f\x14\xc5\x3f\xf7\xbc\xd9\x79\xef\xed\xe6\x07\xbb\xf9\x92\xb0\x7c\xd5\x04\xb5\x8c\x45\xac\x6c\x8c\x81\x60" +
+		"\xa3\x60\x11\x0b\xab\x08\xc1\x2a\x0a\x92\xce\xc6\x62\xf3\x43\x93\x46\x50\x50\x9b\x98\x18\x24\x9d\x82\x51\x1b\x2d\x14\x31\x16\x0a" +
+		"\x36\x22\x56\x09\x24\x7f\x80\x8d\x85\xd1\x62\x93\xc8\xec\x4e\x40\x16\x6c\x04\x2d\xee\x39\x73\xcf\x39\x73\xb9\x5c\xfc\xa9\xb1\x89" +
+		"\xb1\xcb\x03\x05\xa3\xa6\xc9\xa1\xfb\x75\x5b\xda\xd0\xe6\x50\xbd\x6e\xdb\xbb\xb3\xb2\xbe\xf3\x32\x43\xd6\x7b\xe2\x5d\x62\xfb\x59" +
+		"\x4b\x0c\xee\x9a\xc1\x47\xd3\xeb\x29\x0d\xfe\x48\x65\xfd\xa8\x94\xa2\xcc\xa3\x52\x07\x4a\x53\xe4\x40\x23\x39\x4a\xab\xcd\x72\x23" +
+		"\xe8\xcc\x20\xb2\x6e\x94\xf5\x34\x6b\xbc\x0f\x75\x76\x20\x2b\xa3\x76\x96\x2f\x08\x24\xa8\x08\xbc\xa0\x43\x90\x47\x3e\x20\x28\x47" +
+		"\x0e\xfd\x95\x98\xed\x6e\xc9\x76\xb7\x64\x43\x9d\x13\xec\x13\x74\x46\x0e\xd9\x93\x82\xc3\x82\x9e\xc8\x15\x4d\x5a\x57\x45\xc5\xae" +
+		"\xcd\x54\x45\x5d\x4f\x64\x05\x54\xca\x3f\x25\x72\xf9\x46\x2a\xac\x56\x14\x9a\x33\x15\xf2\xf9\x44\xb8\xb9\x44\xd5\xea\xb6\x74\x9a" +
+		"\x27\x99\xe0\x66\x2e\xb8\x15\xe0\x8d\x8f\x9b\xad\x38\xc1\xd7\xa2\xe0\x55\x90\xde\xfb\x3d\x73\x35\xc0\x56\x51\xd8\xf7\xe0\xae\x7b" +
+		"\xe1\xff\x17\x2c\x87\x0f\xf3\x4e\x58\x41\x28\x17\x2c\x39\x1d\x57\x63\xc4\x97\x00\xab\xd1\x43\x82\x1d\xc5\x47\xd2\xe0\x36\x5e\x48" +
+		"\x10\xdc\xad\x44\xe5\xe4\x99\x89\xe4\x91\x09\x3e\xbb\x18\x8a\x95\xcc\x24\x1a\xf7\x55\xc1\x25\xc1\xc5\x78\xcb\x4e\xc1\x8d\x2c\x6c" +
+		"\x11\xe0\x61\x80\xc5\x00\x25\xc1\x9d\xf0\x78\x90\xc5\xf1\xb9\x20\x6b\xb2\x05\x57\x3a\x66\xeb\x12\x6e\x3a\x74\x2b\xc1\xfa\x4f\xf0" +
+		"\x54\x61\x63\x09\x86\x04\xb5\x46\x67\x82\xed\x5c\x30\x97\x0a\xae\xa5\x71\x62\xa3\x5b\x94\xb0\x00\xbc\x74\xd1\xb4\x1d\x2f\x98\x0d" +
+		"\x6e\x90\x8a\x82\xba\x8f\x7f\xa6\x83\x3a\xb5\x37\x61\x37\xc8\x33\x69\x53\x4f\x1b\xba\x39\x61\xa9\x0e\x69\x2d\x9e\xbb\xdc\xbc\xed" +
+		"\x6d\xa7\x61\xbe\x85\xeb\x67\x2a\xdb\x11\x55\x78\x5e\x90\x25\x6f\x0b\x1a\x18\x7d\x3c\xa1\xe1\x41\x64\x57\xf9\xf7\x7c\x14\xf5\x8f" +
+		"\xfe\xf9\xff\xd5\x9a\xfd\x8d\xcd\x0e\x22\xec\x43\x9b\xee\x99\x2d\x78\xb5\x1b\xca\x85\xe4\x90\x2b\x23\x0f\x5a\x10\xc8\x5a\xaa\x0a" +
+		"\xea\xfd\xa5\xf7\xa0\xb3\xbf\xc9\xf5\xb5\xe4\xbc\xf1\x73\x00",
+	"/90pv-RKSJ-V": "" +
+		"\x74\x8b\x3d\xca\xc2\x60\x10\x06\xe7\x7d\xbe\x7c\xeb\x1f\x16\x96\x56\x7a\x81\x80\x62\xa3\xb5\x8d\xc4\xce\x9c\x20\x17\x90\x54\x36" +
+		"\x76\x82\x90\x4a\x2b\x31\x2a\x7a\x17\x6f\x26\xeb\x0f\x48\xc0\xe2\x19\x98\x65\xb6\x35\x19\xe4\xab\x78\x31\x4f\x93\x78\x66\x49\x96" +
+		"\x67\xcb\x21\x23\xdd\x37\x0a\xb7\xb5\xda\x01\x35\x84\x14\xa1\xa8\x83\x0c\x74\x15\x28\x54\xd6\x05\xf5\xbe\xdc\x40\xe9\x8f\xae\x5f" +
+		"\xe9\x2c\xa0\xa2\x0e\x82\xc2\x04\x5b\xc7\xff\xdb\x42\xf4\x1a\x7b\x97\x9d\x09\x8e\x0e\x3f\x35\x45\x78\x7e\x1c\x4c\x84\xd2\x04\x17" +
+		"\xc7\xd9\x71\x32\xd5\x28\x3f\xa9\x4f\x82\x3f\x8d\x35\x7d\x0c\x00",
+	"/Add-RKSJ-H": "" +
+		"\x5c\x96\x79\x74\x55\xd5\xf5\xc7\x3f\xe7\x7b\xdf\x70\x5f\x12\x5e\x26\x4d\xcc\x8f\x47\x98\x44\x40\xf3\x13\x83\x4c\x22\x09\x81\x4b" +
+		"\x42\xe0\x19\x94\xc0\x02\x09\x32\x04\xd0\xb5\x74\xd5\x2e\x59\xab\x5d\xfd\x37\x08\x48\x10\x6b\x04\x14\xab\x0c\x21\x01\x07\xd4\x96" +
+		"\xc1\x02\x26\xa0\x52\x11\xa8\x85\x40\xb5\xc5\x56\xad\xad\x56\x05\xa7\x0e\x20\x83\x42\x20\x5d\xfb\xe5\xfd\xe5\x1f\xf7\x7b\x3f\x67" +
+		"\xbf\x7d\xcf\xde\x67\x9f\x7d\xcf\x7d\x44\x26\xd7\x3f\x54\xff\xd3\xd2\x90\xa3\x41\x4b\x2a\x9a\x3b\xdd\xe6\x7f\xe8\x93\x8a\xce\xce" +
+		"\xcb\x21\xd7\x67\xee\x71\xcf\xf5\x1d\x8b\x0e\x3c\xac\xf2\x53\x9e\x5c\x09\xca\x8c\x22\x3f\x82\x32\xe3\x28\x1a\x45\x21\x50\x6d\x0c" +
+		"\x45\x8b\xba\xaf\x50\x2d\x42\x67\x1f\x54\xb2\xfc\xe2\x83\x72\x05\xc8\x2f\xec\xbe\xee\xef\x83\xb2\xe3\xc8\xe5\xa2\x1e\x6c\x5d\x28" +
+		"\x90\x20\x4f\x10\x11\xc4\x05\xb1\xf4\xbd\x58\x90\x9b\xbe\xdb\xf8\x17\x69\xdf\x82\x1f\xf9\x16\xfc\xc8\xd7\xae\x99\x82\x84\x20\x3b" +
+		"\x7d\x37\xdf\x4a\xc1\xf5\x82\xc2\xf4\x3d\x4f\x9f\x44\xd9\xb6\x48\xf0\xfe\xbd\x22\x7e\xf2\x5e\xc1\xe1\x07\x44\xf4\xd0\x03\x82\xe7" +
+		"\xef\x15\xa5\xcf\x99\xed\xad\x45\xc2\xfd\xce\xfc\xfe\x70\xbf\xe0\x1d\x93\xf6\x45\x22\xda\x66\xb6\x97\xec\xd7\x6d\x8b\x14\xf8\x08" +
+		"\x9e\x34\xfb\x5a\xb3\x3f\x66\x6e\xab\x4c\x1a\x16\x09\x75\xd9\x4a\x1f\xbe\x5f\x68\x89\xd9\x2e\x2c\x14\xde\x79\xb3\xfd\x7b\xa1\x48" +
+		"\xfc\x6b\xa1\x5c\x06\x82\x4d\x0b\x45\xd1\x46\xb3\x7f\xbd\x40\xf0\x95\xc9\x97\x0b\xc4\xb0\xd3\x46\x57\xeb\x45\xf8\x4a\xbd\xe0\xdb" +
+		"\xfb\x44\xee\x37\xf7\x09\x8e\xd5\x0b\xef\x68\xbd\x02\x10\xbc\x5d\x2f\xc6\x1c\xac\x4f\xcf\xb6\x67\xbe\x88\xec\x9e\x2f\x78\x71\xbe" +
+		"\x08\xbf\x60\xb4\x61\xbe\xb8\x7e\xbd\xd1\x7b\xf3\x44\xec\xdd\x79\x82\x37\xe6\x89\x82\xd7\x8d\x9e\x98\x27\xae\x6b\x9a\xa7\x20\x86" +
+		"\xad\x77\xae\xc8\x7f\x67\xae\xa0\x75\xae\x28\x6e\x99\x2b\xe7\x52\x51\x16\x88\xd0\x41\xcb\xa8\x7d\x81\x28\x68\x33\xda\x3a\x47\x44" +
+		"\xb6\xcc\x11\xac\x9b\x23\x0a\x9e\x9a\x93\xce\x2d\xf3\xa8\xe5\x7b\xfc\x1e\x91\xe8\xb8\xc7\x2a\x74\x8f\x08\xaf\x35\x6a\x34\x5a\x61" +
+		"\xd4\x39\x5b\x70\xd9\xe4\x92\xc9\x0f\xb3\x15\x5c\x8b\x3d\x35\x5b\x14\x75\x98\x69\xd3\x6c\x91\xb5\x71\xb6\x5c\x0e\x82\x53\x75\x22" +
+		"\xe3\x8b\x3a\x5b\x8d\x25\x7d\x34\x24\xf2\x4e\xd8\xf0\xa5\x3a\x91\xb9\xcd\x68\x4d\x9d\x18\xb3\xba\x2e\xbd\x90\x65\xb3\xc4\xad\x4b" +
+		"\x67\xc9\x65\x21\x78\xe4\x6e\x11\x5e\x7e\xb7\x85\x9b\x29\xbc\x1f\x66\x0a\xce\xce\x14\x23\xce\x18\x2d\x9b\x29\xf2\x96\x1a\xfd\x7d" +
+		"\x86\xc8\xfc\x78\x86\xe0\xf0\x0c\x11\x3b\x34\x43\x41\x18\x6b\x8d\x19\xc2\x3d\x67\xf6\x56\x93\x16\x93\xcd\xe6\xdb\x6c\xd4\x38\x43" +
+		"\x44\x56\x18\x3d\x5f\x27\x7a\x3d\x57\x27\x57\x89\x60\xd7\x74\x91\xbf\x73\xba\x70\x8f\x4d\x17\xbd\x57\x4d\x4f\xe7\xf6\xda\x34\x11" +
+		"\xdb\x3b\xcd\x4a\x38\x4d\x0c\xda\x32\x4d\x2e\x13\x41\x7b\xad\x08\xb5\xd5\x0a\xb7\xab\x56\xc4\x77\xd6\x5a\xf1\x6a\x45\xd6\x5a\xa3" +
+		"\x4b\x53\x45\x9f\x1f\xa6\x9a\xdf\x54\xe1\xb7\x19\xbd\x38\x55\x78\x2f\x18\x6d\x9e\x2a\x8a\x9a\xa7\xa6\x9b\xf2\xc3\xbb\xc4\xff\x7f" +
+		"\x70\x97\xe5\x36\xdd\x4a\x3e\x5d\x8e\xb3\x77\x8a\xe2\x33\x77\x0a\xf6\xdc\x29\xc6\xef\x36\xda\x35\x45\xc4\x76\x4e\xb1\x72\x4f\x11" +
+		"\x91\x8d\x46\x6b\x4c\x2e\x79\x82\x55\x96\xca\xa3\x16\x7b\x65\x6d\xba\x10\x57\x6b\x44\xce\x95\x1a\xc1\xdf\x6a\x44\xee\x47\x46\xaf" +
+		"\xd5\x08\xb7\xd7\xe8\xb7\x35\x22\xfe\xaa\xd1\xba\x1a\x39\xff\x29\xa3\x65\x35\xe2\xc6\xa5\x46\x9b\xef\x12\xa1\x66\x4b\xaa\xf5\x0e" +
+		"\x11\x6f\xb9\xc3\x7e\xbd\x43\xf4\x58\x6a\xf4\x65\x52\x84\x4f\x27\x6d\xe6\xa4\xc8\xf8\xc8\xe8\x70\x52\x64\x1d\x32\xda\x61\xb2\x3d" +
+		"\x99\xce\xa2\xd5\x46\x2d\x26\x9b\x93\x42\xcd\x49\xe1\x36\x24\x45\x8f\xf5\x66\x6b\x48\x8a\xbe\x5d\x93\xe5\xb2\x11\x6c\x98\x2c\xbc" +
+		"\xf5\x93\xad\x94\x93\xad\x0f\x8d\x1a\x27\x8b\x5e\x2b\x8c\x8e\x4d\x12\xb1\xa3\x93\x6c\x15\x26\x7b\x4d\xf6\x4c\x12\xde\x6e\xa3\x1d" +
+		"\x93\xc4\xcd\xdb\x27\xa5\xdf\xb4\x3f\x56\x0b\xef\x44\xb5\xe0\xf7\xd5\x22\x7c\xc4\x68\x7f\xb5\x88\xec\x33\xfa\x4d\xb5\x88\xfe\xda" +
+		"\x68\x93\xd1\x46\xa3\xc7\xab\x85\xfb\xa5\xd1\xa3\xd5\x22\xb4\xd2\xa8\x3d\x29\x68\xf3\x44\x56\xd7\x44\x39\x0f\xeb\xba\x89\x22\xef" +
+		"\xe3\x89\x96\xc4\x44\x71\xc3\x5e\xa3\x6f\xab\x44\xce\x37\x55\xf6\x4a\x56\x89\xf0\x3b\x46\x07\xaa\x44\xec\xcd\x2a\xe1\x76\x98\x6c" +
+		"\x37\xdb\x2b\x55\x22\xe3\x65\xa3\xcf\x27\x8a\xe8\x67\x13\x15\x44\x11\x9c\xab\xb4\x4c\x3c\xd1\x63\xb5\xad\xe4\xfd\x4a\x91\x38\x69" +
+		"\xb6\x0d\x95\x22\xba\xbe\x52\x2e\x92\xda\xcf\x09\x82\x2b\x26\x9d\x13\x84\x2e\x1b\x5d\x98\x20\xf2\xcf\x1b\x1d\x9f\x20\xfc\x0e\xa3" +
+		"\xfd\x26\xfb\x4c\xda\x27\x88\x78\x9b\xd1\x2b\x96\xf6\xcb\x96\xec\xb9\x40\xf4\xfe\x2e\xb0\xda\x05\xc2\xdf\x1d\x74\x57\xcc\xb5\x06" +
+		"\x22\xa3\xc5\xec\x8f\x05\xc2\xad\x32\x6a\x34\x5a\x61\xb4\x2c\x10\xb1\xa5\x46\xff\x1d\x2f\xb2\xfe\x33\xde\xf2\x1c\x2f\x72\x4e\x8e" +
+		"\x97\xab\xc0\xfa\x69\x9c\xf0\x5f\x1d\x27\x68\x1d\x27\x42\x2d\x46\x4f\x4c\x10\xe1\x26\x8b\xff\xe8\x38\xd1\x6b\xa5\xd9\x8e\x57\x88" +
+		"\x50\x47\x45\x7a\xe9\x7b\x2a\x44\x9f\xdd\x15\x96\xd7\x58\x91\xff\xdd\xd8\x74\x27\xbc\x32\x56\x44\x5e\x1e\x6b\xd9\x8c\x17\xa5\xab" +
+		"\x2c\xde\x6b\xe5\x22\x63\x6f\xb9\x45\x28\xb7\x08\x46\xbf\x2a\x17\xee\x69\xa3\x27\xcb\x45\xc1\x5a\xa3\x53\x65\x82\x2f\x4c\x3e\x2f" +
+		"\x13\xee\xb3\x32\x05\x39\xd8\x96\x94\x89\xc4\x9b\x66\x7f\xa4\x4c\x68\xb9\x51\x43\x99\xc8\xe8\x1a\x23\xe7\x23\xf8\xeb\x18\xf1\xe0" +
+		"\x5f\xc6\x08\x77\x75\xb4\xe0\xcf\x12\xee\xdd\x32\x05\x7c\x3f\x5a\xc4\x2f\x9a\xed\xc3\xd1\x22\xf2\x81\xd1\xb1\xd1\xa2\xe8\xe8\x68" +
+		"\x95\xcc\x21\xdd\x74\x2f\xdd\x2e\x8a\xb6\xdd\x2e\xd8\x3a\x4a\xc4\xb7\x8c\xb2\x85\x8f\x12\xb9\x2b\x47\xc9\x0d\x4f\x1d\xcd\xb7\x89" +
+		"\xca\x83\xb7\x29\x88\x23\x38\x3e\xdc\xb6\x6c\xb8\x6d\xd9\x70\x91\xb5\xcf\xe8\xeb\x91\xe2\x9a\xaf\x46\x0a\xbe\x1f\x26\xb8\x38\x2c" +
+		"\xfd\xb1\xf8\xc4\x46\x6f\x4b\x0c\xdb\x62\x3f\x9e\xbb\x35\x3d\x1c\xb0\x77\x84\x75\xe0\x70\xfb\xe0\x0c\x57\xd0\x13\x6b\xf9\xa1\xa2" +
+		"\xef\x91\xa1\x72\x4a\xb5\x4d\xa9\xc8\xbf\x52\x2a\x78\x76\x98\x88\x3c\x63\x13\x1d\x2e\x15\xb3\x0e\x95\x0a\xf7\xfe\x50\x05\xe5\x27" +
+		"\x87\xca\xf5\x4d\x65\x74\xb3\x98\xd5\x71\xb3\x82\x48\xea\x25\x1a\x22\x46\x9c\x18\x22\xc7\x86\x12\x91\xb1\xbe\xc4\x4a\x57\x22\x06" +
+		"\x2f\x37\xda\x75\x93\x18\xbb\xf3\x26\xc1\xd9\x12\xe1\x9d\x29\x51\xd0\x1f\xc1\x3f\x07\x8b\xa2\x4f\x07\xa7\xf3\x7e\x76\xb0\x78\xe8" +
+		"\x99\xc1\x0a\xca\x91\x03\xf3\x1e\x24\x92\x67\x06\x09\x36\x0f\xb0\x43\x66\x80\xc5\x1d\x28\x8a\x3b\x06\x0a\x9e\x18\x28\xa2\x4d\x03" +
+		"\x75\xb0\xc1\xd9\x13\x79\x98\x5f\x7f\x91\xdd\xdc\x5f\xd0\xd0\x5f\x8c\xec\xea\x67\x7e\xfd\x44\x71\x53\xbf\xf4\x29\xfd\x46\x5f\x71" +
+		"\xeb\xeb\x7d\xe5\x7e\x82\xe0\xe1\xde\x22\xbe\xa4\xb7\x82\x41\x08\xd6\x14\x0b\x7f\x75\xb1\x3d\x5d\x2c\xbc\xae\x5e\x36\x63\x1f\x11" +
+		"\x6e\xee\x23\x37\x02\x41\x67\x42\x84\x2f\x27\xac\x9d\x7a\x8b\x7e\x4f\xf7\x16\xbc\x98\x10\xfe\x0b\x66\x5b\x97\x10\xd9\x4f\x25\x14" +
+		"\x14\x62\x95\xeb\x29\x38\x64\xf2\x76\x4f\x11\x3b\x68\xb4\xa3\xa7\xb8\x6e\x7b\x4f\xb9\x2a\x2c\xb3\x84\x18\xd6\x94\xe8\x5e\xb1\x87" +
+		"\xc5\xbd\xce\xe2\x16\xda\x9e\x16\x8a\xe8\x45\xa3\x2f\x0b\xc5\x80\xd3\x46\x9b\x0a\x45\xce\x46\xa3\x35\x45\xe2\x96\xd5\x45\xdd\x4f" +
+		"\xf6\x42\x70\xa1\x40\xfc\xdf\xf9\x02\xe1\x0e\x14\x88\x5b\xde\x2c\xb0\xdd\xcd\x17\xf1\x23\xf9\xe9\xa6\x6e\xcc\x17\x25\x2b\xf2\xe5" +
+		"\xba\x0f\xdb\x3c\x31\xaf\x25\xcf\x5a\x3f\x47\x5c\xf3\x59\x8e\x82\x92\xd4\x9e\x64\x8b\x9c\x4f\xb3\xe5\x6e\x48\xcd\x1a\x17\x79\xe7" +
+		"\xe3\xb6\xc3\x71\x51\x71\x22\xde\x1d\x71\x0a\x82\xf6\x4c\x51\xd2\x96\xa9\x60\x64\xea\x53\x9e\x29\xc2\x5f\x64\xca\xdd\x98\x5a\x59" +
+		"\x4c\xf4\x68\x8a\x09\xce\xf9\x22\xe7\x3b\x5f\xf0\x9e\x2f\x86\xbc\xeb\x2b\x18\x92\xaa\x75\xd4\x9a\xd1\x89\xac\x2b\xbe\x5c\x02\xc1" +
+		"\xfe\x88\x08\xed\x8b\x08\x77\x2c\x4b\xeb\x3d\xed\x0c\xc9\x03\xc1\xea\xc5\x82\x1e\x02\x4f\x5e\xc6\xa6\xc5\xf2\x74\x28\x22\x38\x60" +
+		"\x62\xfe\x6a\x37\x39\x12\x11\xb1\xb7\xcc\xf6\xba\xf9\xef\x5f\x9c\x26\xb7\x7f\xb1\x1c\xc8\xb1\x34\x2a\xb0\xab\xd1\x64\x65\x54\xa8" +
+		"\x31\x2a\xc7\x0a\x1b\x3e\x62\xb2\x3c\x9a\xfe\x4f\xda\x69\x72\x39\xd2\xed\xef\x1a\xcc\x7c\x25\x22\x22\x9d\x11\x79\xa9\xfa\x2d\x31" +
+		"\x53\x43\x54\x02\xf9\x31\xe4\xf2\xed\xda\xee\xdb\xec\xbe\x88\xad\xf4\xe5\xe5\x5f\x88\x2a\x28\x42\x19\x9c\x96\x3c\x77\x4a\x82\x26" +
+		"\x5f\xe8\x71\x73\x7b\xda\x17\xa1\x75\x46\x5b\x7c\xe1\xb5\xfa\xf2\x44\xea\x5d\x08\x65\xa3\x16\xcf\x75\x84\x6c\x07\xc2\x82\x0c\x3b" +
+		"\x52\x23\x82\xab\xe1\xf4\x7f\x61\xbf\x3b\xb3\xdc\xf3\x61\x71\xad\x84\x6b\x0c\x2b\xd7\xfb\xd3\xcf\x85\xb7\xf5\x67\xff\x1b\x00",
+	"/Add-RKSJ-V": "" +
+		"\x6c\x8d\x3d\x6e\xc2\x40\x14\x84\xbf\x9d\x78\x37\xb1\x23\x25\x4e\x99\x2a\xb9\x80\x8b\x94\x29\xdd\x45\x76\x97\x9c\xc0\x92\xeb\x28" +
+		"\x7d\xba\xa4\x81\x0a\x04\x85\xa9\x10\x12\x5c\x84\x23\x70\x04\x0e\x01\x15\x42\xe8\xf1\x27\x10\x14\x33\xfa\x66\x77\x46\x2f\xc9\xeb" +
+		"\x3a\xfb\x2c\xbf\x8a\xec\x23\x14\xd5\x4f\xf5\xfd\xc6\xbb\xa6\x7f\x72\xc3\x5f\xc1\xd2\x0b\x12\xdd\xbb\xff\xa0\x58\x48\x11\x82\xb5" +
+		"\xbd\x3e\x0a\xee\x04\xb7\xe2\x69\xe1\x35\x11\x92\x83\x33\x3d\x83\x5e\x4e\x72\x30\xb9\x91\x57\xc9\xd8\x5f\x6d\xbf\x5e\xb4\xd1\x7c" +
+		"\xe6\x08\xbb\x4b\xb4\x0c\xda\x61\x4f\x2e\x95\xa3\xb1\xd4\x35\xeb\x98\x99\x06\x66\xcd\x61\xd3\x33\x88\x04\xfd\xe3\x2e\x11\x3c\x08" +
+		"\x6e\x04\xf1\xf6\x37\x22\xd7\xca\x93\x6e\x06\x00",
+	"/B5pc-H": "" +
+		"\xb4\x92\x4b\x4b\x54\x61\x18\xc7\x7f\xef\xef\xcc\x9c\x66\x0e\xb9\xd0\x30\x65\xe8\x32\xbe\x51\x43\x45\x82\x52\x42\x54\xd2\xc1\x0a" +
+		"\xda\xd4\xc2\x29\xa1\x7b\xdf\xa0\x0f\xd0\x22\xba\xe8\x42\xa9\xcf\x10\xd4\x77\xe8\xb2\xa9\x56\xb5\xb1\x02\x97\xba\xac\x0f\x50\x8b" +
+		"\xa0\xb4\x42\xcf\x81\x06\x2f\x04\x85\xab\x07\x9e\x87\xff\x85\xdf\xfb\x52\x19\x3b\x37\x3e\x94\x04\xee\xf8\xf8\xe4\xe2\xcf\xb0\xf4" +
+		"\xeb\x5b\x08\xcd\x6b\x86\xc0\xe9\x70\xdb\x53\xbe\x7a\x63\x3f\x92\x7c\x9e\x0b\x32\xf0\x69\x2e\x18\xdb\x08\x0a\x89\xa4\x9a\x8f\x62" +
+		"\xbc\x4a\x31\x1b\x18\x44\x9a\xb7\xbc\xde\xb9\xff\xd7\x79\x05\xe1\x43\x6a\x3e\xfa\x31\x5d\x7b\x1d\x44\x06\xfd\xbf\x84\xbf\xcd\x7d" +
+		"\xc8\xc8\x3a\x19\xdd\x08\x53\xa9\x1c\x9c\x4c\x37\x56\xd7\x91\xf1\x52\xbd\x1f\xe1\x87\x32\xfc\x5d\xf3\x26\xb2\x53\x63\x5f\x07\xcd" +
+		"\x31\xcd\x7b\x11\x9e\x2a\xad\x27\xae\xf1\x7b\x5d\x5d\x36\xe4\x5e\x5b\x9a\x77\xdb\xd2\xa3\x71\x07\xc2\x4d\x39\x74\x43\xba\xcb\xa8" +
+		"\x0b\x08\xef\x95\x74\x76\x93\xf1\x1c\x40\x98\xc9\x64\x68\x3a\x33\x3f\x8a\xb0\x58\x97\x64\xa9\xbe\xb1\xe6\x04\xc2\x74\x26\xbb\x66" +
+		"\xb2\x62\x53\x45\x26\x56\x35\xbd\x84\xf0\x28\x91\xf0\x30\xd9\x9c\xee\x01\xb9\xac\xf9\x11\xa4\xb6\x0a\x76\x03\xd9\xa3\xf1\xfc\x0a" +
+		"\xc9\x9a\x6c\x9d\xad\x15\xd7\xe3\x08\x2f\x6b\xb2\xfb\x45\xb9\xa9\x21\xed\xb2\xfd\x59\x84\xf9\xaa\xf4\x2c\x54\xff\xb8\xad\x3c\xea" +
+		"\xbb\x54\x5a\x6f\xd3\xf2\x23\x3c\xef\x92\xe1\x67\x5d\xe6\x11\xd9\xa2\x30\xb9\xac\x9a\xaa\x16\x8a\x16\xc2\x97\x8a\xf4\x7e\xad\x18" +
+		"\x07\x90\x63\x9a\x1f\x46\xea\x65\xd3\xbd\x48\xbf\xc6\x6d\x08\x0b\x15\x39\x33\x5f\x91\xd0\x41\x31\x22\x3c\x48\xa4\x71\x3f\x59\x9f" +
+		"\xc2\x48\x91\x1d\x23\xc2\x84\x70\x51\xd8\x2e\x59\x9f\x24\xfe\x1e\x00",
+	"/B5pc-V": "" +
+		"\x62\x73\x32\x2d\x48\xd6\xf5\x60\x71\xf6\x0b\x36\x64\xe0\x61\x3a\x7d\x88\x89\x61\xce\x55\x46\x26\x3e\x86\x03\x8c\x4c\x8c\x0c\x4c" +
+		"\x4c\x0c\x8c\xf7\xaf\x30\x32\x31\x31\xb2\xe0\xc4\x19\x0c\xf7\x2e\x31\x02\x06\x00",
+	"/CNS-EUC-H": "" +
+		"\xec\xd3\xcf\x4a\x54\x61\x18\x06\xf0\xe7\x7d\xce\x33\x7f\xd0\x95\x17\x10\x08\xb9\x12\x5a\x78\x0d\x2d\x8d\x1c\xb5\x69\x11\xd9\x1d" +
+		"\xb8\x51\xc8\xcd\x28\x41\x66\x51\x31\x15\x81\x41\x7f\xa0\x6f\xdc\xb4\x4a\x4b\xa2\xb6\x65\x64\x90\x96\x8d\x09\xd5\xa6\x72\x61\x74" +
+		"\x01\xe9\xee\x10\xcf\x71\x5c\xd5\x05\xb4\x70\xf3\xf0\xce\x3b\xef\x39\xdf\xcb\x77\xf8\x41\xc7\x4f\x8e\x0e\x94\x02\x17\xd4\x4c\x29" +
+		"\x35\x53\x9e\xab\xd9\x4a\xa9\xd9\x72\xb1\x90\x52\x73\x21\xcf\x99\x52\x9e\x5f\xcb\xa2\xf7\xdc\xc3\xad\x60\x5a\xe1\xd8\x9d\xad\x60" +
+		"\x2b\xc6\xc0\x56\x1c\x03\xdb\xfb\xd5\x20\x18\x04\x97\xe2\x08\xb8\x1d\x38\x4f\x70\x8a\xc0\x04\x81\x49\x02\x0d\x02\xd3\x44\xd6\x20" +
+		"\x78\x31\x08\xcc\x10\xb8\x7f\x8a\xc0\x03\x07\x09\x5c\x72\x7b\xd6\x31\x5f\xf4\xeb\x44\x14\x3f\x2f\x3b\xee\xd6\x09\xdc\xab\x1f\x8c" +
+		"\xf4\x11\xd8\x1d\x25\x62\x6f\x94\xc0\x62\x10\xd9\x63\xf7\x9f\xb9\x7a\xe1\xea\xbb\xff\xf8\xe1\x78\x19\x44\xbc\x72\x88\xe0\x7a\x10" +
+		"\x7c\xeb\x5f\xeb\xc5\x59\x75\x22\xfb\x30\xd2\xd9\x61\x93\x44\xd6\x76\xf5\x85\x44\xf9\xb3\xab\xf6\x08\x91\x6d\x7a\xe2\xfd\x08\xa1" +
+		"\x62\xf6\x8d\x7b\xab\xae\x36\x82\xad\x28\xa1\xf3\x7c\x99\x88\x5f\x22\x2a\x3b\x22\xd0\x1c\x26\x78\x63\x98\xc8\x6e\xfa\xcf\xa5\x1a" +
+		"\xc1\x27\x35\x42\x24\xaa\x22\x70\xb5\x46\xa0\x4a\x64\xb7\x5d\x5c\xaf\x11\xa5\x85\x13\x04\x9e\x0e\x11\x95\xe5\x21\x02\xef\xba\x09" +
+		"\x7c\xea\x26\xe8\xb1\x9d\x12\x81\x8f\x3d\x44\xf6\xb5\x87\xe8\x9a\x2b\x13\xbc\x52\x61\x2b\xb0\xeb\x17\xec\x39\x5c\x85\xab\xd2\x5a" +
+		"\x85\xe0\xa3\x0a\x81\xd7\xa7\x09\x6c\x77\x11\x5c\x3c\xe3\x5b\x2d\x7b\x39\xef\xba\x21\x9f\x36\xce\x9f\x03\xbd\xcf\x97\x83\xdf\xb2" +
+		"\xe2\x5b\x1e\xc6\xbf\xa3\x1f\x4a\x2b\x73\xbf\xab\x63\xb7\xce\xca\x2d\x19\x80\xda\xfb\xd5\x20\x14\x84\x0c\x40\x06\x20\x70\x4a\xc0" +
+		"\x84\x80\x49\x01\x0d\x01\xd3\x42\xd6\x90\x01\x08\x98\x91\x01\xc8\x00\x04\x50\x06\x20\x60\xd6\x31\x5f\xf4\xeb\x32\x00\x19\x80\x0c" +
+		"\x40\x06\x70\x30\xd2\x27\x03\x90\x01\xc8\x00\x64\x00\x32\x00\x19\x80\x0c\x40\x06\x20\x03\x90\x01\x08\x21\x19\x80\x0c\x40\x06\xe0" +
+		"\xb3\xea\x32\x80\xce\x0e\x9b\x94\x01\xc8\x00\x64\x00\x32\x00\x19\x80\x0c\x40\x06\x20\x03\x90\x01\xc8\x00\x64\x00\x9d\xe7\xcb\x32" +
+		"\x00\x19\x80\x0c\x40\x06\x20\x03\x90\x01\xc8\x00\x04\x51\xa8\x4a\x06\x20\xa0\x2a\x03\x90\x01\xc8\x00\x64\x00\x32\x00\x19\x80\x0c" +
+		"\x40\xa0\xc7\x76\x4a\x32\x00\x19\x80\x0c\x40\x06\xa0\x02\x80\x0c\xc0\xb7\x52\xf3\xad\xf8\x55\x6b\x15\x19\x80\x0c\x40\x06\x20\x03" +
+		"\xf0\xad\x96\xbd\x9c\x77\xdd\x90\x4f\x1b\xd7\x3e\x00\x19\xc0\xc1\xa7\x3d\x8c\xbf\xa2\x1f\xda\x5a\xe5\xe1\x4d\xfc\x87\x71\x14\x7f" +
+		"\x06\x00",
+	"/CNS-EUC-V": "" +
+		"\xec\xd3\x3d\x4f\x54\x79\x14\x06\xf0\xe7\x3c\xf7\x99\x97\x0c\xd9\x82\x0f\xb0\x09\x61\xa9\x48\xb6\xa0\xdc\x7a\xcb\xdd\x2c\xc3\xcb" +
+		"\x6c\xb1\xd9\xdd\x6f\x40\x03\xc9\xd2\x0c\x64\x93\x05\xd6\x18\x33\x6a\x4c\x30\xf1\x25\xf1\x5e\x1a\x2b\x41\x89\x51\x2b\x5f\x30\x62" +
+		"\x22\x28\x30\x8e\x51\x1b\x95\x02\xc3\x07\x10\xba\x89\x79\xee\x0c\x1d\x0d\x9d\x05\xc5\x3d\xff\x73\xff\x73\xee\xfd\x9f\x7b\x26\x3f" +
+		"\xe8\xe7\xdf\xc6\x86\x0a\x81\x7f\xd5\x48\xd3\xb4\x91\xb6\xdb\x6a\x64\x69\xda\xc8\x9c\x2c\xa5\x69\x63\xa9\xdd\x66\x9a\xb6\xdb\x0f" +
+		"\x92\xe8\xfb\xfb\x46\x2b\x98\xae\xb1\x72\xb9\x15\x04\x1e\x35\x83\xf8\xee\xa1\x23\x7c\xb1\xb3\x46\x91\x88\x84\x08\x9d\x7c\xed\x4f" +
+		"\x98\x45\x3f\x08\xbc\xd9\x0e\xe2\xa7\xd6\x76\x30\x8b\x1f\xc1\x9d\xf8\x0b\xcc\xe2\x17\x30\x08\xae\xc4\xf7\xe0\x6e\xe0\x1f\x82\xd3" +
+		"\x04\x26\x09\x4c\x11\xa8\x13\x98\x21\x92\x3a\xc1\xff\x82\xc0\x2c\x81\x6b\xe3\x04\xae\x8f\x77\x1b\x9c\x0f\x02\x73\x0e\x8b\xf9\x7e" +
+		"\x8d\x88\xfc\xf6\x7f\x87\x2b\x35\x02\x57\x6b\x47\x25\x03\x04\x0e\xc6\x88\x38\x1c\x23\xb0\x1c\x44\x72\x2b\x08\xdc\x75\x76\xdf\xd9" +
+		"\x47\xff\xf0\xc9\xe1\x71\x10\xf1\x24\x3a\xdf\xc1\xcd\x20\xf8\xdc\x77\x9b\xf9\x59\x35\x22\x79\x35\xda\xed\xa1\x49\x22\xd9\x71\xf6" +
+		"\x8e\x44\xf1\xad\xb3\x9d\x51\x22\x69\xba\xe2\xe5\x28\xa1\xbc\xf6\x99\xf7\xd6\x9d\x6d\x05\xb3\x28\xa0\xfb\xbc\x07\xbc\x2f\xa2\xb4" +
+		"\x27\x02\x8d\x11\x82\xe7\x47\x88\xe4\x02\x09\xac\x54\x09\xde\xae\x12\x22\x51\x16\x81\xb3\x55\x02\x65\x22\xb9\xe4\xe4\x5c\x95\x28" +
+		"\x2c\xfd\x4a\xe0\xce\x30\x51\x5a\x1d\x26\xf0\xa2\x87\xc0\xeb\x1e\x82\x2e\xdb\x2b\x10\xd8\xee\x25\x92\xf7\xbd\x44\x65\xa1\x48\xf0" +
+		"\x4c\x89\x59\xe0\xc0\x2f\x38\x74\x70\x16\xce\x0a\x1b\x25\x82\x37\x4b\x04\x9e\xfe\x4e\x60\xb7\x42\x70\xf9\x0f\x4f\xb5\xe8\xe6\xdc" +
+		"\xeb\x96\x7c\xda\x04\x3f\x0f\xf5\xdd\x5b\x0d\x7e\x48\xf2\xff\xf2\x34\x1c\x1f\x06\xa1\x74\x6d\xe1\x4b\xb9\x72\xf1\x4f\xe5\xc8\x94" +
+		"\x23\x13\xe0\x8b\x9d\x35\x8a\x42\x24\x42\xe8\xe4\x6b\x7f\x22\x23\x53\x8e\x4c\x39\x32\x19\x99\x8c\x4c\x46\xa6\x20\x64\x64\x32\x32" +
+		"\x81\xd3\x02\x26\x05\x4c\x09\xa8\x0b\x98\x11\x92\xba\x8c\x4c\xc0\xac\x8c\x4c\x46\xd6\x6d\x70\x3e\x04\xcc\x39\x2c\xe6\xfb\x35\x19" +
+		"\x99\x8c\x4c\x46\x26\x23\x3b\x2a\x19\x90\x91\xc9\xc8\x64\x64\x32\x32\x19\x99\x8c\x4c\x46\x26\x23\x93\x91\xc9\xc8\x3a\xdf\xc1\xcd" +
+		"\x90\x91\xc9\xc8\x7c\x56\x4d\x46\xd6\xed\xa1\x49\x19\x99\x8c\x4c\x46\x26\x23\x93\x91\xc9\xc8\x64\x64\x32\x32\x19\x99\x8c\x4c\x46" +
+		"\xd6\x7d\xde\x03\xde\x97\x8c\x4c\x46\x26\x23\x93\x91\xc9\xc8\x64\x64\x82\x28\x94\x25\x23\x13\x50\x96\x91\xc9\xc8\x64\x64\x32\x32" +
+		"\x19\x99\x8c\x4c\x46\x26\xd0\x65\x7b\x05\x19\x99\x8c\x4c\x46\x26\x23\x53\x8e\x4c\x46\xe6\xa9\x54\x3d\x15\xbf\x6a\xa3\x24\x23\x93" +
+		"\x91\xc9\xc8\x64\x64\x9e\x6a\xd1\xcd\xb9\xd7\x2d\xf9\xb4\x09\x75\x90\xc9\xc8\x94\xc5\x69\x38\x3e\x0c\x42\xad\x75\x9e\x4e\xe2\x1b" +
+		"\x0c\x3f\xe0\xeb\x00",
+	"/ETen-B5-H": "" +
+		"\xb4\x92\x4b\x4b\x94\x6f\x18\xc6\x7f\xcf\xef\x9d\x79\xff\x33\xc3\xdf\x85\x86\x29\x92\xa6\x6f\xd4\x74\x20\xc1\xa1\x84\xa8\x86\x5e" +
+		"\x84\xa0\x4d\x2d\x1c\x13\x3a\x51\xfb\x3e\x80\x9b\x40\xac\x74\xa1\xd4\xb2\x6d\x41\x7d\x81\x68\xd1\x61\x13\xad\x3a\xc0\x54\xa0\x3b" +
+		"\x5d\x45\x7d\x80\x5a\x04\x1d\x86\x70\xe6\x05\xcd\x03\x41\xe1\xea\x86\xeb\x79\xae\x03\xd7\x7d\x93\x1b\x39\x3d\x3a\x64\x60\xd2\x7b" +
+		"\x27\x1a\x8d\x46\x08\xfd\x97\xee\x2e\x04\x9f\xbf\xb0\xfb\xce\x42\x90\xe8\xd3\x7c\x90\x81\x8f\xf3\xc1\xa4\x86\xa0\x10\x49\xac\x69" +
+		"\x15\x93\x8b\xb4\x66\x0f\x06\x71\x32\x54\x1f\x86\x15\xf4\x6f\xe7\x05\x84\x77\xb1\x69\xf5\x7d\xbc\xfe\x75\x10\x19\xf4\xdf\x1c\xfe" +
+		"\x34\xf7\x20\xc3\x1b\x78\xb4\x23\xcc\xc4\x72\x60\x3a\xde\x9c\x5d\x44\x46\x33\xf6\x3e\x84\xef\x4a\xe5\x9b\xa6\xfd\x48\xaf\x26\x5d" +
+		"\xab\xba\x1c\xd1\xb4\x13\xe1\x81\x52\xbe\xef\x7a\xbd\xde\x0f\x63\xd2\xad\xc4\x53\xf5\x20\xbc\x99\x90\xbe\xd7\x13\xbf\x7f\xaa\xe0" +
+		"\xd5\xe2\xd7\x6b\xc2\xf5\x9a\xf4\x4f\xd5\xa4\x43\x93\x1d\x08\x57\xe4\xe0\x65\x69\xcf\x32\x8d\x21\xbc\x5d\xd6\xab\x6f\x71\x8f\xfb" +
+		"\x11\xe6\x4a\x32\x34\x5b\x32\x3d\x82\xf0\xa3\x28\xd1\xcf\xe2\xe6\x9c\xe3\x08\xb3\x25\xe9\x9b\x2b\xb5\x90\x3c\x32\xbe\x26\xe9\x39" +
+		"\x84\xdb\x91\x84\x5b\xd1\xd6\x64\x0f\xc8\x79\x4d\x0f\x23\x85\x35\x5b\xe9\x41\x76\x69\x72\xa6\xd9\x64\x41\xfe\xaf\x17\x5a\xaf\xc7" +
+		"\x10\x9e\x15\x64\xe7\xd3\x0c\x29\x20\xb5\x2c\xfd\x29\x84\xc5\xbc\x74\x2c\xe5\x57\xd4\x9a\xdb\x7f\x15\x4b\xf9\x65\x9c\x5d\xcc\x93" +
+		"\x36\xa9\x3c\x6e\x33\x4d\x90\xff\x14\xa6\x97\x59\x33\xf9\x16\xa3\x8c\xf0\x39\x27\x9d\x5f\x72\x26\x03\xc8\x51\x4d\x0f\x21\xc5\x2c" +
+		"\xe9\x6e\x9a\x27\x93\x6c\x43\x58\xca\xc9\xc9\xc5\x9c\x84\x55\x2d\x26\x08\x37\x23\xe9\xb9\x11\x6d\xdc\xc2\x70\xcb\x3b\x49\x10\xc6" +
+		"\x85\xb3\xc2\x76\x29\x75\x49\xa4\xec\x7d\x14\xff\x1a\x00",
+	"/ETen-B5-V": "" +
+		"\xe2\x74\x0d\x49\xcd\xd3\x75\x32\xd5\xf5\x60\x71\xf6\x0b\x36\x64\xe0\x65\x3a\x7d\x88\x89\x61\xce\x55\x46\x26\x3e\x86\x03\x8c\x4c" +
+		"\x8c\x0c\x4c\x4c\x0c\x8c\xf7\xaf\x30\x32\x31\x31\xb2\xe0\xc4\x19\x0c\xf7\x2e\x31\x32\x35\x78\x31\x36\xb1\x03\x06\x00",
+	"/ETenms-B5-H": "" +
+		"\xe2\x74\x0d\x49\xcd\xd3\x75\x32\xd5\xf5\x60\x71\xf6\x0b\x36\x64\x60\x64\x54\x88\x63\x02\x0c\x00",
+	"/ETenms-B5-V": "" +
+		"\xe2\x76\x0d\x49\xcd\xcb\x2d\xd6\x75\x32\xd5\xf5\x60\x71\xf6\x0b\x36\x64\x10\x62\x3a\x7d\x88\x89\x61\xce\x55\x46\x26\x06\x86\xc3" +
+		"\x97\x19\x99\x38\x19\xa6\x30\x33\x31\x32\xfc\x65\x62\x62\x64\x78\x71\x85\x91\x89\x91\x81\x89\x89\x81\xf1\xfe\x15\x46\x26\x26\x46" +
+		"\x16\x3c\xd8\x9a\x49\x89\x91\x85\x89\x81\x91\x85\x69\xbf\x17\x63\xd7\x2d\x46\xc0\x00",
+	"/EUC-H": "" +
+		"\xcc\x8e\x31\x4a\x03\x61\x14\x06\xe7\xcd\xca\x6e\x42\x34\x8b\x29\xb2\x04\x44\xb6\xd0\x03\xe8\x05\xac\x6c\x2c\x05\xb1\x5a\x21\x68" +
+		"\x2d\x76\x5a\x7a\x82\xdc\x40\x48\x82\x08\x0a\x22\x1e\xc1\xce\x42\xbd\x82\x56\x1e\x23\xc8\xc2\x5f\xa5\xb0\x4e\xf1\x66\x78\x30\xc5" +
+		"\x47\x7e\x34\xbe\x1a\x5f\xee\x65\xc1\xad\x93\xe9\xe4\xdb\xd9\x6c\xb1\xb8\x89\xfa\xec\x2b\x73\x7a\xe7\x01\xbe\xed\x36\xbf\x99\xf3" +
+		"\xd8\xc0\x5e\x81\x9d\x1c\x7b\x7d\x2c\x0a\x5c\x03\x5f\xa3\x8b\xc5\x08\xf3\x11\x3e\xc4\x31\x3e\xc7\x09\x3e\x45\x85\x9d\x0a\xdf\xa3" +
+		"\xc6\xb2\xc6\x97\xe0\xfe\x5c\x50\x18\x08\xb9\xd0\x17\xba\xc9\xdb\xc2\x66\x72\xfb\x5f\xa7\x76\xb8\xd4\x0e\x97\xda\xf6\x4e\x85\x2d" +
+		"\xa1\x4c\x6e\xdb\x43\x61\x47\xa8\x92\x07\xfe\x94\xcd\xe3\x85\xf3\x68\x58\x45\xec\xe3\xe7\x8a\x4e\xfb\x1f\x19\x12\x1f\xeb\x7f\x03" +
+		"\x00",
+	"/EUC-V": "" +
+		"\x62\x75\x0d\x75\xd6\xf5\x60\xf3\x4a\x2c\x48\xcc\x33\x64\x90\x66\x5a\x74\x98\x89\x71\x5e\x35\x13\x2f\x23\x03\x13\x27\x13\x03\x13" +
+		"\x13\x0b\x03\x13\x8b\x20\x03\x13\x2b\x03\x03\xd3\x7e\x10\xc1\x88\x86\x25\x19\x18\x98\xe4\x91\xf8\x6c\x0c\x0c\x4c\x9b\x18\x89\x54" +
+		"\xc8\xc6\xc8\x00\x18\x00",
+	"/Ext-RKSJ-H": "" +
+		"\x34\x96\x7b\x54\x95\x55\xde\xc7\x3f\xfb\x7b\x0e\x9c\x73\x38\x78\x40\x10\xf1\x72\x44\xd1\x7a\x5f\x6f\xf5\x6a\x6a\xb0\x30\x01\x3d" +
+		"\x82\x28\x09\x82\x2e\x54\x28\x94\x57\x5c\xef\x72\xd5\x7a\xd7\xcc\xfc\x3d\x7f\x90\x5a\x78\x9b\xf1\x96\x36\x85\x80\x60\xe5\xa5\xc9" +
+		"\x5b\x21\x89\xa6\x76\x31\xed\xe2\xa5\x66\xac\xb4\x31\x33\x2b\x9d\x9a\x2e\xde\x53\x31\x67\xfd\x0e\x67\xfe\x78\xbe\xeb\xf3\x7c\x9f" +
+		"\xbd\x9e\xbd\xf7\x6f\xff\xf6\xfe\x6d\xe2\xa7\xd4\xfc\xae\xe6\xff\x47\x7a\x1d\x75\x7a\x2a\xbf\xb9\xd3\x6d\x3c\xa7\xaf\xf2\x3b\x3b" +
+		"\x9f\x8f\x73\x03\xaa\x8f\x7b\x5c\x66\x1e\x3a\xb4\x50\xb9\xdf\x79\xe4\xfc\x08\xbe\x5f\x20\x06\xfe\x73\x81\x5a\x5d\xa0\x44\xbe\xde" +
+		"\x44\x1f\x6f\x39\x2a\xc9\x45\xae\x27\xf2\xa7\x77\x3d\x0b\x06\xa0\xa4\x10\x72\xdd\xd1\x76\x97\x7b\xab\x9b\x1c\x07\x9c\xe8\xf7\xa6" +
+		"\x13\xb9\x12\x19\x52\x24\xb7\xeb\x3b\x34\x7b\x44\xb0\xc9\x23\x4f\xf1\xa5\x27\x55\xde\x2f\xf6\x2b\xd6\x07\xe5\x92\x9e\x0b\x0a\x1a" +
+		"\x9e\x10\x78\x04\x21\x11\xbf\xc2\x5e\x1a\x9f\x10\x6e\xc3\x13\x5a\x28\x3e\x9b\x2f\xf8\x74\xbe\x48\x3a\x15\xa5\xb9\x22\xfe\xd4\x5c" +
+		"\xc1\xcb\xf3\xc5\xc8\x97\xcc\x7b\xbb\x56\x78\xde\xaa\x15\x74\xd4\x0a\xdf\x5e\xa3\x6d\xb5\xc2\x6d\xad\x55\x24\x3a\xb3\x67\x6b\x45" +
+		"\x60\xad\xf9\x75\xb5\x42\xf7\xe6\x09\x6e\xcf\x13\xee\x96\xc9\x8d\x79\xc2\x73\xdd\xbc\x9f\xe6\x89\xf0\x8f\xf3\xe4\x12\x10\x34\xcd" +
+		"\x13\xbd\x1b\xcd\xff\xfe\x7f\x05\x5b\xbd\x82\x2d\x5e\x31\xfa\xa2\xbd\xfe\x56\x23\x7a\xdd\xad\x11\x7c\x54\x23\xdc\x87\x46\x47\x4d" +
+		"\x8e\xd4\x28\x02\x82\x77\x6b\x44\xc6\x3b\x66\x2d\x2c\x15\x71\x4f\x95\x0a\x7e\xc9\x12\x69\x3f\x67\xc9\x79\x11\x1c\x9a\x2b\xbc\x07" +
+		"\x6d\x36\x7b\x6c\x5e\x6d\x46\x5b\xe6\x0a\xb7\xd9\xa8\xa3\x40\x68\x6f\x81\x60\xc3\x5c\x71\x5f\x83\x79\x9f\xcc\x11\xfc\x90\x29\xfc" +
+		"\xdf\x8e\x13\x1c\x98\x23\x3c\x6f\x9a\xf7\xe2\x34\xd1\x7d\xd3\x34\xc1\xaa\x39\xa2\xd7\xca\x39\x8a\x04\x10\x7c\x50\x2d\x52\xdf\xaf" +
+		"\x16\xb4\x56\x8b\x8c\x96\x6a\xb9\xfe\x58\xfb\xc7\x45\xfc\xa6\xc7\x05\xeb\x1f\x17\x7d\xd6\x19\x5d\x9a\x2d\x7c\x17\x67\x0b\x8e\x3f" +
+		"\x26\xc2\xc7\x1e\xb3\xd0\x3d\x26\xe2\xd6\x1a\x2d\x31\xaa\x37\xea\xac\x12\xdc\x31\xb9\x6d\x72\xab\x4a\x11\x21\xb8\x52\x25\xba\x5f" +
+		"\x36\xeb\x78\x95\x48\x3c\x66\xf4\x7a\x95\xf0\xbd\x66\xf4\x62\x95\xd0\x26\xa3\xa6\x2a\xe1\x1a\x8d\x5e\xa8\x12\xde\xe7\x8d\xde\x98" +
+		"\x2e\x3c\xed\xd3\xe5\x3c\x58\x70\x2b\x45\xf0\x6e\xa5\xe0\xbb\x4a\x11\xff\xad\xd1\xe7\x95\xc2\xf3\x99\xd1\xc9\x4a\x91\x72\xc2\x68" +
+		"\x9b\xb5\xdb\x6a\xb4\xa6\x52\x3c\xb2\xba\x32\x36\xeb\xc5\xb3\x45\xc2\x22\x9b\xc9\x4f\xb3\x44\xe0\xc7\x59\x82\x33\xb3\x44\xaf\xd3" +
+		"\x46\x5f\x8e\x15\x3a\x3b\x56\x2e\x11\xc1\x33\xb3\x44\xdc\xd3\xe6\xdf\x9e\x29\x3c\xb7\x66\xda\x4c\x66\x8a\x87\x2f\x1b\x2d\x9e\x29" +
+		"\x52\x16\x19\x7d\x59\x21\x82\x67\x2b\x04\xef\x55\x88\xc0\xe1\x0a\x45\xe2\xb0\x44\xac\x10\xee\x25\xf3\x5b\x4d\x5a\x4c\x36\x5a\xdb" +
+		"\x66\xa3\x25\x15\xe2\xfe\xfa\x0a\xb9\x31\xd8\xc8\x67\x88\xd0\x89\x19\x82\xdd\x26\xef\xa7\x8a\xc4\x8b\xf9\xb6\x08\x33\x84\x77\xdd" +
+		"\x0c\xe1\x56\xcc\x10\xfd\x97\xcf\x88\x4d\xc4\xc2\x12\x68\x9f\x6e\xe1\x9b\x2e\x06\x6f\x9a\x2e\x17\x44\xb8\x8e\x72\xe1\xd9\x5b\x2e" +
+		"\xdc\xee\x72\x11\xda\x55\x6e\x4b\x55\x2e\x12\xd7\x1a\xdd\x2e\x13\x09\xb7\xca\x04\xdf\x94\x89\x1e\x17\x8c\x3a\xca\x84\x7f\xaf\xd1" +
+		"\x96\x32\xe1\xd9\x6c\xb4\xb1\x4c\xf4\x6e\x2e\x8b\xed\x92\x33\xd3\xc4\x03\xa7\xa7\x59\xf8\x2d\x5f\xef\x96\xca\x71\xa5\x54\xf8\x2e" +
+		"\x97\x0a\xbe\x2e\x15\x69\xe7\x8d\xf6\x94\x8a\x8c\x36\xa3\x5f\x4b\xc4\xc0\x9b\x25\x36\x9d\x12\x11\xd8\x55\x22\x5c\x53\x89\x88\x6b" +
+		"\x34\x5a\x53\x22\x3c\xab\x4b\x62\x61\xfa\x6d\xaa\xe0\x7a\x48\x24\xd5\xe7\x09\xfe\x31\x55\x74\xff\xc2\xbc\x37\xa6\x0a\xd7\x6e\xf4" +
+		"\xfa\x54\x11\x7a\xcd\x68\xfd\x54\x39\xff\x3a\xa3\xc5\x53\xc5\x88\x45\x46\xad\x8f\x8a\x50\xcb\xa3\xe6\x3d\x2a\xba\x2d\x32\xba\x54" +
+		"\x2c\xe2\x2e\x16\xdb\xff\x8a\x45\xc2\x17\x46\xef\x15\x0b\xef\xe1\x62\xe1\xf6\xdb\xd7\x7d\xe6\xed\x34\xd9\x51\x1c\x1b\x4a\xab\xbd" +
+		"\xb5\x58\x8b\x8d\x26\xcd\x26\x1b\x8a\x45\xb7\x06\xfb\x50\x57\x2c\x32\xef\x4d\x91\x4b\x42\xb0\x61\x8a\xf0\x34\x4c\xb1\xf0\x4e\xb1" +
+		"\x9d\x60\xb4\x64\x8a\xe8\x57\x6f\xf4\xd1\x64\x11\xf8\x70\xb2\xcd\xc2\xa4\xdd\x64\xcf\x64\xe1\xda\x8c\x76\x9b\xec\x32\xd9\x39\x59" +
+		"\x24\xec\x30\xea\xec\x29\x06\xde\xe9\x19\x3b\x22\x4e\x16\x09\xcf\x89\x22\xc1\xd1\x22\x11\x77\xc4\x68\x7f\x91\x88\xdf\x67\xb4\xbd" +
+		"\x48\xf8\x5e\x35\x6a\x32\x6a\x34\xfa\x73\x91\x70\x7f\x32\x5a\x56\x24\xbc\x4b\xed\xb5\xce\xfe\x72\x6f\x92\x8d\x76\xa8\xf0\x35\x0c" +
+		"\x8d\xed\xa2\x2f\x27\x09\xff\x59\xf3\x4f\xf6\xb7\x7e\xfa\x0b\xd6\x0c\xb2\x45\x19\x64\x23\x9e\x24\x06\xb6\xdb\xd7\x2b\x85\x42\x97" +
+		"\x0b\x05\xff\x2a\x14\xc9\x3f\x14\x0a\xf7\x41\xa1\xf0\xbe\x6f\x74\xa8\x50\x78\x0e\xda\xd7\x37\x8c\xda\xcd\xdb\x69\xb2\xc3\xbc\xbf" +
+		"\x16\x8a\x84\x57\x8c\x0e\x64\x0b\xdf\x9b\xd9\x8a\xf8\x10\x5c\x2b\x10\xa1\xab\x05\x82\x4f\x0b\x44\xf8\x54\xf4\x18\x2b\xb0\xd1\x15" +
+		"\xc8\xc5\x47\x13\x62\xa2\x60\x73\xaa\xed\xa0\x54\xa1\x3b\xf6\x7a\x63\xa2\x48\xbd\x6e\x74\x7c\xa2\xf0\x1f\x33\xda\x6f\xb2\xcf\xa4" +
+		"\x63\xa2\x18\xb8\xd7\xe8\x5a\x44\x24\x5e\x8d\x08\xce\x44\x44\xf7\xd3\x46\x7b\x22\xc2\xdf\x16\xe9\x8a\xac\x6b\x8d\x88\x84\x16\xf3" +
+		"\x57\x98\x9c\x1e\x6c\x4b\xd1\xc7\x16\x2f\x22\x5c\xbd\x79\x8b\x23\x22\xb0\xc8\xe8\x97\x09\x22\xf1\xe7\x09\x36\xd8\x09\xc2\x9d\x32" +
+		"\xfa\x64\x82\xe8\xf6\xf1\x04\xb9\x7c\x2c\x35\xc7\x0b\xff\x6b\xe3\x05\xad\xe3\x45\xb0\xc5\x68\xd9\x78\xd1\x6f\xa9\xd1\xf1\x7c\xe1" +
+		"\x3d\x96\xdf\xd5\x35\x6f\xe7\x8b\xb8\xb7\xf2\x6d\x48\xf9\x62\x40\x9b\xd1\xb5\x3c\x91\x7a\x35\x4f\x2e\x80\xf9\x39\xc2\xdb\x96\x63" +
+		"\xe1\xcb\x13\xbe\x57\xf2\x04\xab\xe2\xc5\x88\x95\xf1\x16\xe6\x5c\x91\xd0\x9e\x6b\x7d\xe5\x0a\x6f\x8b\xd1\x5f\x72\x85\x7b\xce\xe8" +
+		"\xd9\x5c\x91\xb6\xd6\xe8\x2b\x9b\xd3\x33\x69\x82\x68\x2d\xf8\xc6\xe4\x82\xc9\xd7\xe3\x14\x49\x3e\x6f\x74\x68\x9c\x08\x1f\x34\x7a" +
+		"\x66\x9c\xd0\xd3\x46\x75\xe3\x84\xf7\xde\x23\xf6\x83\x2c\xe1\x3d\x97\x15\x2b\xff\x9f\x3f\x22\x72\x3f\x33\xff\xcc\x58\x3b\x08\xc6" +
+		"\x0a\xf7\x5b\x8e\xd0\xdd\x1c\x45\xf8\x35\x47\x84\x6e\xda\x90\xcf\xe4\x08\xcf\x69\xa3\x3d\x19\x96\xe9\x19\xb6\x0f\x72\x44\xef\x0f" +
+		"\x73\x34\x7c\x34\x02\x9b\xc5\x7f\xaf\x8a\x57\xa4\x6f\xb4\xd2\x64\x0b\xff\xa6\x6c\xc1\x9a\x6c\xa1\xd5\x46\x2b\x4c\x96\x9b\x2c\xcb" +
+		"\x16\xdd\x97\x66\xcb\xfd\x11\x45\x42\x58\x30\xc7\xd8\xca\x8f\xb1\x95\x1f\x23\x7a\xec\x33\x5a\x33\x46\x24\xae\x36\xfa\x75\xb4\xe0" +
+		"\xe6\xe8\x58\x89\xfe\x6a\xb4\x18\x73\xce\xac\x6b\xa3\x44\xc6\xd5\x51\x82\x3b\x3d\x45\xef\xce\x9e\xb1\xde\x8f\x3e\x24\x32\x8f\x3c" +
+		"\x24\xa7\x68\xce\x8d\x14\x7d\xef\x8e\x14\xbc\x37\x52\x94\x1c\x36\xda\x32\x42\x04\x37\x8f\xe8\xba\xb1\x64\x46\x47\xf0\xa0\x98\x7d" +
+		"\xec\xc1\x2e\x87\x0d\xc3\x45\x42\xc3\x70\x8b\xa1\xd1\xd3\x46\x3f\x67\x89\x7e\x56\xcb\xd9\x3d\x4c\xa4\xec\x1a\x66\x73\x1a\x26\x46" +
+		"\x2c\x1f\xa6\xc8\x20\x6c\x0d\x86\x08\xcf\xf9\x21\x16\xaf\x21\x22\xf1\xf4\x90\x58\xfe\xc5\xef\xee\x13\x1b\xfb\x0b\x66\x3d\x6f\x62" +
+		"\x1b\x33\xce\xb6\x28\xcb\x86\x88\xff\x5b\x3a\xa4\xab\xe7\x87\x11\xd8\x56\x4d\xb3\x4d\xcb\xc6\xfb\xc5\xa0\xe6\xfb\x63\xcb\xed\xff" +
+		"\x6e\x9c\xde\xa9\x73\xd6\x2e\x05\xfb\x3a\x48\x24\x35\x5b\xbb\xba\x41\x22\xeb\xde\x40\xc1\xaa\x81\x22\x6d\xa5\xd1\x8a\xb0\xf0\x2d" +
+		"\x0f\xc7\x8a\xca\x81\x4c\xbb\x35\x64\x0a\xb7\x27\x53\x3c\xd0\x96\x29\x37\x18\xc1\x92\x01\x62\x6c\xfd\x00\xc1\xd9\xb1\xb1\x32\x49" +
+		"\xc3\x50\xe1\xb1\x63\x85\x85\xfd\x45\xe8\xa9\xfe\x8a\x44\xdb\xae\xc9\x10\xfe\xd5\x19\xd6\x9f\xd1\xbd\x7e\x82\xe8\x5d\xa5\xa3\x20" +
+		"\x36\xf2\xce\xb0\x08\xde\x09\x0b\x2c\x45\xc2\x96\x2c\x6c\x09\x0b\xff\x66\xf3\xd6\x87\x45\xd2\xba\xb0\x22\xe9\xd8\x6a\xf4\x15\x1c" +
+		"\x36\x79\xb7\xaf\x08\xbc\x63\xb4\xb3\xaf\xe8\xb5\xa3\xaf\x5c\x04\xc1\x8d\xde\x22\xeb\x7a\xef\xae\xc8\x78\xb0\x7e\x7b\xd9\xa9\x97" +
+		"\x6e\x39\x91\x2e\x7c\x37\x8d\x2e\xa5\x8b\x8c\x8b\x46\xdb\xd2\x45\xdc\x56\xa3\xa6\x74\x91\xd5\x68\xb4\xbd\xa7\x08\xbc\xfa\x9f\xb3" +
+		"\xd7\xea\x6e\xc2\xa5\x7c\xc1\xb9\xc1\x22\x31\xba\x9b\xbe\x49\x13\xe1\x0b\x69\x02\xbb\xad\x79\x17\x96\xc6\x32\xe2\xd4\x48\xe1\x3e" +
+		"\x1d\x29\xf8\x7b\x0f\xd1\xe3\x6f\x3d\xac\x87\x1e\x62\xc4\x56\xa3\xa3\xa9\x22\x74\x24\x55\x91\xe4\x68\x14\x53\xc5\x90\xfa\x54\x81" +
+		"\x5d\xc7\xdc\x8b\xd3\xe4\xba\xaa\x4e\x8a\xd5\xaf\x14\xc1\xe2\x14\x31\x6a\x91\x91\x95\xc2\x3e\x4b\xf2\xac\xe7\x64\x11\xb8\x90\x2c" +
+		"\x38\x69\x74\x22\x59\x91\xe1\xd1\x3c\x4a\x12\xc9\xe7\x93\xba\xca\x3e\x5b\x92\x04\x9b\x4d\x5e\x4e\x12\xe9\x2f\x19\xdd\x08\x89\x14" +
+		"\x2b\xac\x9c\x0c\x89\x5e\x27\x8c\x9a\x42\xe2\xbf\x1a\x43\x5d\xd1\xf2\x46\xf7\x41\xa2\x18\x7a\x24\x51\x70\x25\x28\x92\x2f\x07\xad" +
+		"\x7d\x50\x24\x9c\x30\xea\x08\x0a\xef\x5e\xa3\x9d\x41\xd1\x77\x87\x51\xf4\xd6\xb1\xbd\x5a\xf8\xaf\x27\x74\xfd\x68\x28\x82\x55\x01" +
+		"\xd1\x6d\x65\x40\x70\xcd\x2f\x92\xae\xfa\x2d\x3c\xd1\x8b\xeb\x02\x71\xdf\xc7\xf6\x7a\xb0\x5a\x04\x0e\x55\x2b\xf2\x3f\xd1\x4c\xf1" +
+		"\x89\x6e\xab\x7d\x72\x61\x04\xfb\xe3\x05\xfb\xe2\xd5\x12\x97\xfb\x7d\x37\xb9\x51\x08\x5a\xe6\x8b\x49\x1b\xe7\x77\x75\xf2\x24\x52" +
+		"\xe0\x82\xfd\xff\xc7\x3f\x08\x6d\xfb\xfd\xbf\x07\x00",
+	"/Ext-RKSJ-V": "" +
+		"\x5c\xca\xb1\x09\xc2\x00\x14\x84\xe1\xff\x9d\x79\x4f\x88\x45\x8c\x9d\x08\x6a\x67\x95\xc2\x21\x04\x89\x9d\x99\x20\x0b\x88\x85\x85" +
+		"\x60\xe7\x20\xce\x62\x3a\xe7\xb1\x50\x04\x0b\x51\x10\x34\xc5\x71\x1c\xf7\xa5\x8b\xfd\xae\x58\xaf\xaa\xb2\x58\x46\x59\x6f\xeb\xcd" +
+		"\x9c\x99\x9a\xa3\xec\x74\x10\xdc\x5c\x90\xca\x79\x77\x47\x99\x1e\x2e\x25\x08\x9e\x2e\xe8\x09\x92\xcf\x43\x7e\x77\xf5\x39\xbb\x20" +
+		"\x04\x03\x8d\x19\xe9\x6a\x34\x2e\x83\xbf\x0c\x41\x93\x9f\x1d\xa0\xaa\x65\xbe\x6e\xda\x72\x61\xe8\xd2\xcd\x50\x6e\xbc\x06\x00",
+	"/GB-EUC-H": "" +
+		"\x62\x60\x76\x77\x32\x64\x62\x64\x68\x60\x5a\xb8\xf0\xdf\xbf\x28\x46\x05\x86\x13\x15\x8c\x0c\xb1\x6f\xb3\x99\x16\x1e\x66\x8a\xbd" +
+		"\xce\xc3\xb4\x89\xd1\x90\x81\x89\x89\x93\x81\x89\x89\x9b\x81\x69\x09\x63\x2c\x03\xd3\x22\xc6\x20\x06\xa6\xb5\x8c\xa1\x0c\x4c\xab" +
+		"\x18\xc5\x19\x98\x38\x4c\x18\x98\x56\x33\x2a\x30\x30\xf1\x2b\x30\x30\xad\x67\x94\x67\x60\x62\x51\x65\x60\xda\xc9\xe8\xcd\xc0\xb4" +
+		"\x11\xa2\x5c\x9e\x81\xe9\x01\x27\x98\x35\xb4\x89\x08\x06\xa6\xe5\x83\xdb\x91\x80\x01\x00",
+	"/GB-EUC-V": "" +
+		"\x1c\xc7\xbd\x09\xc2\x70\x14\x00\xf1\x7b\x17\x09\x11\xb1\x10\xec\x2c\xad\x23\xb8\x80\x45\x44\xe2\x02\xce\xf1\x76\x10\xec\xb4\x71" +
+		"\x84\x6c\xe0\x07\x08\xb6\xee\x25\xf2\xb7\x39\xee\xd7\xf4\x5d\xbb\x3b\x6c\xdb\x7d\xd5\x77\x6b\xe6\x0e\x1f\xf9\x36\x42\x65\xcd\x4a" +
+		"\xe2\x98\xc2\x29\x1d\x4d\x97\x6e\x38\xa7\x43\xc5\x2d\xad\x43\x65\x66\xf0\x4e\x27\xf1\x4a\x83\x67\xc9\x23\x5d\x70\x2f\x87\xc1\xa5" +
+		"\x68\xfc\x07\x5c\xf3\x37\x00",
+	"/GBK-EUC-H": "" +
+		"\x64\xbc\x77\x7c\x55\xd7\x99\x35\xbc\xf6\xba\x57\x57\x12\x92\x10\x60\xc0\xdd\xd8\x13\x3b\x8e\xed\xbc\xe9\xb1\xe3\x99\x49\x26\x72" +
+		"\x26\x65\x9c\xd8\xce\x3b\x33\x4e\x26\xb1\x95\x4c\xc6\x6d\x26\x4e\xec\x64\x32\x99\x99\x74\x9a\x41\x74\xd1\x85\x84\x40\x02\x21\x40" +
+		"\x02\x51\x05\x6a\x08\xd1\x44\xef\x20\x7a\xef\x20\x7a\xef\xdf\x6f\xad\x7b\x75\xc9\x97\xf7\x9f\xf5\x7b\xce\x3e\xcf\x7e\xf6\xd3\xf7" +
+		"\x3e\xe7\x1e\x09\x91\x6f\xbe\xf4\x59\x06\x74\x67\x8f\xaf\xde\xb9\x73\xac\x5b\x78\x1c\x2b\xfe\x37\x20\xf7\xf4\xfb\x6c\xea\xc9\x2f" +
+		"\x15\x0c\x09\x04\xce\x3e\x42\x44\xcf\x3c\xc2\x90\x02\x02\x5b\xbb\x12\xef\x6c\xe9\x4a\x60\xfc\xe3\x44\xea\xb8\xc7\x09\xec\x78\x8c" +
+		"\x48\xdd\xfe\x18\x73\x3a\x81\xc0\xd0\x18\x91\x95\x1f\x23\x70\xf3\x41\xa2\xc3\x8d\x07\x25\xa3\x8b\x64\x74\x21\x70\xe4\x71\x22\x1c" +
+		"\xd6\xac\x8d\x4f\x13\xd8\xf0\x34\x03\xb6\x3d\x49\x84\x96\x27\x09\x14\x77\x23\x32\xc6\x76\xd3\xdc\x6e\xc4\x7d\x37\x44\xcd\x79\x98" +
+		"\x78\x62\xf6\xc3\x5a\xfb\x13\x04\xe6\x75\x20\xba\x8e\x7d\x40\x37\xee\x27\x30\x5b\x50\xff\x18\x81\x31\x19\x44\xea\xa6\x67\x08\x1c" +
+		"\x48\x23\x22\xfb\xd3\x08\x0c\x78\x88\x88\xf4\x7f\x88\xc0\x12\xcd\x3d\xde\x81\xc0\xc2\x40\x60\x94\xb4\x5a\xf2\x18\xc1\xc5\x8f\x31" +
+		"\x07\x20\x30\xb5\x1b\xc1\x29\x5a\x71\xdb\xfd\xd2\x47\x82\x87\x3e\x49\x30\x5f\x9a\xed\xb8\x9f\x48\xd9\xae\xb1\x6d\xe2\x6b\x11\xdf" +
+		"\xe8\xc7\x89\x94\x51\xb2\xe5\xf6\x23\x44\xca\xad\x47\x08\xec\x12\xf3\x9a\x4c\xa2\xdd\x40\xe9\xb8\xfc\x29\x22\xda\xfc\x54\xc2\x0c" +
+		"\xd8\x8c\xf9\xba\x31\x4f\x30\x4b\x97\x33\x1f\x8e\x2f\x14\x30\x35\x10\xd1\x9a\xa7\x12\x2c\xc1\x2c\xcb\x05\x29\x44\x58\x25\xe2\x44" +
+		"\x3a\x91\x72\x3c\x5d\x96\x3d\x4b\xa4\xf5\x7f\x96\xc0\xe4\x87\x89\x58\xb9\x84\x9c\x7f\x86\x88\x9c\x93\x07\x6e\x6a\xfe\x0d\xcd\xb8" +
+		"\x9c\x98\x8f\x74\x22\xad\xbb\xac\x3e\xf5\x09\x22\xeb\xa4\xdc\xd1\xf7\x49\xe2\xb9\x3e\x4f\x26\xa4\xa5\xf6\x7f\x96\x39\xd9\x90\xc4" +
+		"\x8f\x13\xdd\xca\x3f\x4e\xa0\xdf\xc3\x44\xc8\x93\xec\x6d\x8f\xc8\x27\xb2\xb0\x28\x93\x88\x15\x66\x32\x04\x10\xf8\xe8\x59\xa2\x5d" +
+		"\x6f\xe9\x71\xf1\x19\x82\x17\xb4\x7a\xbd\x26\x1c\xea\x40\xa4\x8d\xfd\xa4\xdc\xac\x1b\x8b\x75\x63\xa5\x42\xbe\x26\x10\xb8\x1e\x21" +
+		"\x60\xe6\x6d\x62\x3e\x90\x29\x7b\xa5\x53\xaf\x67\x88\x87\x7a\xea\xc6\xe4\x4f\x10\x99\xe5\x1a\x9b\x28\x29\x13\x04\xa7\xe5\xec\x56" +
+		"\xc1\x65\x05\xe0\x92\xe0\xf2\x5f\x49\x72\x94\x08\x0d\x12\x7f\xe8\xaf\x88\x8c\x83\x1a\x1b\xf3\x30\xc1\x02\x89\x5f\xf2\x34\x11\x16" +
+		"\xeb\xee\xdd\x8f\x11\x99\x77\x3e\xc6\x9c\x0e\x90\x29\x8f\x12\x58\x9f\x45\xe0\xf8\xb3\x89\xcb\xb4\xc2\x47\x13\x51\x8c\xed\x94\x67" +
+		"\x26\x3f\x41\xdc\x5f\xfe\x04\x43\x0c\xd2\x4e\x19\xd3\x53\xd9\xb6\xe4\xd3\x44\xca\xe2\x4f\x13\x98\xf4\x04\xc1\xb2\x27\x94\x05\x52" +
+		"\xf6\x96\xa0\xe8\x31\x49\x12\xdf\xed\x4f\x13\xed\x6f\x89\x6f\xc0\xa7\x89\x6f\xf7\xff\x34\x73\xd2\x41\xa0\x54\x6b\x95\x68\xad\xbb" +
+		"\x4f\x10\x9d\xee\x68\xfe\xae\x47\x89\x2e\x3b\x1f\x65\xe8\x02\x02\x9b\x9f\x22\xc2\xa6\xa7\x12\x72\x63\x96\xbb\xe0\x49\xe2\xe9\x06" +
+		"\xe9\xb5\x4d\x97\x2d\x82\x39\x72\xd6\x6c\x41\xf1\xe7\x88\x30\xf6\x73\xa2\x9e\x24\xd2\xc7\x3a\x6f\x75\x63\x5d\x2a\xc1\x3c\xf9\x64" +
+		"\xd6\x73\x44\xca\xcc\xe7\x74\xe3\xe3\x44\x74\xbb\x82\x3c\xa7\x1b\x11\x9d\x2d\x57\xae\xee\x46\xa4\xaf\xea\xc6\x1c\x9c\x7d\x9a\xe0" +
+		"\x19\x79\x6c\x63\x37\x22\xb2\x41\x77\xcf\xcb\x47\xe7\x04\xfb\x74\x39\xa8\x33\x11\x4e\x7e\x9e\xc0\x89\x6e\x44\xec\xb8\xc6\xd6\x7e" +
+		"\x86\x08\x6b\x3e\xa3\x00\xeb\x72\x73\x8c\xc0\x4c\x41\x81\x62\xd6\x4f\x90\x27\x28\x7a\x9c\x88\x16\x8a\x1a\xd2\x99\xe8\x34\xb8\x33" +
+		"\x81\x85\x8f\x13\xa1\x51\x63\x8b\x04\x4d\x8f\x33\x00\x04\x6a\x75\x25\x03\x7c\x6f\xba\x60\x9a\x60\x99\x60\xa9\xa9\xe7\x88\xb0\x54" +
+		"\x46\xdd\x96\xf1\xd3\x1e\x23\x22\xeb\x24\x72\xdf\xe3\x44\x64\xaf\x58\xb6\x3e\x4d\x44\xb6\xc8\x9e\x03\x5a\x7a\xbf\x1b\xd0\xe3\x04" +
+		"\x37\x88\x1a\xff\x49\xa2\xdd\x38\x25\x58\x91\xe2\x50\x28\xe8\xf1\x29\x22\xda\xfd\x53\x52\xac\xb3\x16\x97\xbc\x7e\x4f\x24\xfa\x1b" +
+		"\x0a\x24\xaa\xd7\x13\x44\xac\xa7\xc6\x8a\x05\x51\x02\x47\x62\x2a\x3c\xaf\xf3\x31\x22\xec\xff\x18\x81\xe5\xba\xd9\x90\x29\xdb\xbb" +
+		"\x10\x38\xdb\x99\x88\x9c\x91\xb8\x43\x4f\x12\x0f\x1f\x74\x34\xc5\x32\xa8\x23\x81\x83\xb2\x60\x91\x0a\xb9\xe9\x19\xe6\x60\x97\x02" +
+		"\x44\x95\x9e\x88\xb3\x0a\xe6\x19\xc1\xe9\x67\x88\x17\x5a\x9f\x61\x78\x11\x32\xfb\x49\xa2\xc3\x2d\x09\xda\xf3\x34\x11\xdb\xad\xf5" +
+		"\xaf\x7e\x9e\x08\x57\x14\x9e\xfa\xa7\x88\x58\xdd\x53\x09\x2a\x6a\x6a\xd1\x53\x44\x46\x93\xa8\x4a\x67\x7e\xb6\x3c\xf8\xa2\x42\xf6" +
+		"\x25\x75\xd1\x2f\x31\xa7\x1d\x08\x8c\xd1\xfc\x8a\x2c\x02\xad\xe2\x1d\x20\x25\xfa\xbb\x31\x7c\x9c\x60\x9e\xa8\x53\x9f\x24\x52\x4f" +
+		"\xca\x77\x95\x82\x0a\x41\xf1\xc7\x89\xcc\xb1\xba\x5b\xf4\x22\xc1\x42\x09\x3e\xfb\x69\x22\xfd\x8c\x0a\xa1\xf2\x4b\x44\xa8\xf8\x12" +
+		"\x83\x97\x38\xa2\x32\x3f\xac\x44\x9e\xf4\x25\x22\x52\xf6\x25\x25\xcf\x0b\x44\xec\xda\x0b\xf2\x9d\x72\xa9\x59\xb0\xe8\x0b\x44\x4a" +
+		"\xd3\x17\x08\xd4\x7e\x8a\x48\xaf\x51\x64\x16\x7d\x92\x60\x93\x56\x1c\xfe\x85\x36\x1f\x47\x09\xae\x73\x74\x34\x7f\x43\x84\xc0\xf6" +
+		"\x0c\x02\x2d\x1a\xdb\xf5\x34\x11\xdd\x29\x6a\xcf\xdf\x10\x61\xf7\xdf\x68\xec\x19\x22\x65\xa7\x9c\x3a\xeb\x05\x22\xcc\xd4\xb4\x6d" +
+		"\x9f\x26\xee\x6f\x91\xb2\x97\x3f\x47\xa4\x5f\xfa\x5c\x62\x8f\x18\xf9\x02\xd1\x75\x84\x38\x3e\x92\xc6\x37\x15\xb1\x3b\xb2\xee\xfa" +
+		"\xf3\x04\xae\x09\x56\xbf\x48\x3c\xb8\x4a\x63\x63\x9e\x23\xa2\x05\xcf\x31\xbc\x05\x59\xf2\x3c\xf1\x60\xf3\xf3\xcc\x89\xd8\xea\xbf" +
+		"\x21\x22\x87\xb5\xfc\xe8\x4f\x10\x9f\x1e\x25\x69\xe7\x3f\xa1\xf6\xfd\x09\x86\x0c\x10\x98\xff\x02\xd1\x61\x9e\x96\x5a\xf0\x0c\x71" +
+		"\x5f\x83\x14\x3c\xf6\x0c\x11\x3d\x2a\xea\x90\x32\xe4\xa0\xa8\xcb\xcf\x10\xb1\x4b\xa2\xee\x0a\xee\x08\xae\x0b\xa6\x77\x24\xd2\xcb" +
+		"\xb4\x44\xdf\x67\x89\x58\x1f\xc5\x79\x8c\xfa\x7c\x81\x77\x8d\x67\x89\x68\xb9\xa8\xb3\x9f\x55\x3e\x7e\x56\xa2\xbe\x48\x64\x5d\xfa" +
+		"\x62\x42\xc5\x63\xcf\x12\xe9\x47\xc5\xb1\xe0\x8b\x44\xac\xe1\x8b\x04\xaa\x3f\x4f\x44\xe6\x2a\x29\x76\xbc\x48\x3c\xb1\xfd\xc5\x44" +
+		"\x10\x47\x2b\x6a\xa3\x04\x1f\x49\xe3\x31\x24\x70\x23\x10\x28\xb1\x01\x0a\xce\xbe\x54\x22\xa5\xf0\x6f\xe5\xfa\x1c\xad\x1f\x54\x3b" +
+		"\x5f\x23\xb0\xe4\xcb\x04\x06\x2b\x74\xb7\x49\xa0\x4a\xcc\x6b\xbf\x4a\xe0\x6b\xc4\xfd\x83\xc5\x7b\xfd\x53\x44\x87\x6b\x0a\xf8\x1c" +
+		"\x99\xb3\x39\x95\x40\x6f\x4d\x3d\xad\xf8\xb7\x2a\xfe\xfb\x5e\x20\x1e\xdb\xab\xc5\x4e\x7f\x95\x88\xb6\x7e\x95\x39\x9f\x83\xaa\x40" +
+		"\x31\xbc\x22\x28\xfe\x1a\x11\x1d\xab\x59\xd3\x5f\x20\x38\xed\x05\x86\x54\x10\x18\xf4\x19\x22\x65\xa0\x32\xac\xd7\x4b\x44\x56\xcf" +
+		"\x97\xa4\xf0\x67\x88\x07\x1b\x34\x76\xf1\x33\xc4\xd3\x17\x44\x55\xcb\x43\x73\x05\x57\xbf\xa8\xca\x92\x3b\xd6\x7e\x96\x68\xbf\x46" +
+		"\x63\xfb\x3e\x4b\xa4\xec\x15\x75\xfa\xb3\xcc\x49\x69\x15\x75\x53\x77\x6f\x88\x1a\xaa\xa2\xce\x17\xac\xfd\x3b\x02\x6b\x04\x0b\x5e" +
+		"\x20\x32\x1b\xa4\xf1\x6d\x69\x7c\x4b\x16\xcf\xd0\x8d\x2a\xc1\xfc\xcf\x11\x59\xf3\x3e\xc7\x80\x19\x5f\x20\x1e\xb4\x53\x26\xfd\x2d" +
+		"\xc1\x32\x79\x70\xdf\xdf\xaa\xaf\x89\x5a\xad\x94\x5d\x25\x29\x67\xbf\xa1\x30\x7e\x83\x40\xbd\x2e\xeb\x04\x0b\x3f\x4f\x64\x35\x2a" +
+		"\x5c\x5b\x05\x11\x22\xd2\xf2\xf9\xc4\x2c\x78\xd6\x91\x6f\x11\xb1\xc3\xdf\x52\x08\xff\x81\x40\x59\x17\x22\x76\x4e\x2c\x87\xbe\x4e" +
+		"\xa0\x31\x10\xf7\x4d\xf8\x7b\xe5\xe6\x4b\x44\xc7\x73\x2f\x31\xa7\x23\x08\x9c\x92\xb2\xe3\xd3\x08\x9c\xec\xac\x9b\x9d\x08\xfc\x97" +
+		"\xce\x42\x5a\xfe\x66\x0e\x91\x72\x23\x87\x40\xe9\x97\x89\x07\x4b\xbe\x9c\x38\x39\x1e\x93\xd8\xa3\xde\x2f\x5e\x24\x32\x8e\xab\x44" +
+		"\x86\x28\xbd\x06\x0b\x06\xfd\x03\xc1\x81\xd2\xe1\xb4\xa6\xb6\x0a\x8e\x7c\x99\xe0\x61\xa5\x47\xaf\x67\x75\x2a\x70\xde\x7e\x9d\x48" +
+		"\x29\x97\x72\x37\xc5\x7c\x43\x30\xe6\x79\x22\xa5\x40\xc5\x57\xfd\x2d\x22\x7d\xae\xcc\x39\xf6\x32\xf1\xd4\xd1\x97\x99\xf3\x15\x30" +
+		"\x3c\x02\xf1\x3b\x3b\x32\x89\x87\x8e\xca\xa2\xcd\xdf\x26\xa2\x9b\xbe\xad\xf4\xd2\xe5\xaa\x4c\x82\xd3\x94\xc1\xf3\xbf\x44\x74\x9e" +
+		"\x27\xaa\xd7\x6b\x04\xa6\x74\x25\xb2\xb7\xeb\xb2\xdf\x8b\x44\xc8\x93\xda\xbd\x5e\x24\x52\x7a\x8a\x2a\x7e\x91\x88\x8d\x7d\x91\x39" +
+		"\x01\x04\x36\xbe\x44\xa4\x6e\x50\x06\x9d\x78\x95\xc0\x71\xc1\xe9\x57\x09\xb6\x8a\x5a\xa8\xf9\x8d\x2f\x26\xd6\xc6\xde\xae\x04\x2f" +
+		"\x28\xb0\x9b\x35\xb6\x32\x9d\x08\x85\xca\xcf\xd1\xdf\x24\x38\xea\x9b\x1a\xd3\x8d\x15\x82\xd3\x7f\x4f\xa4\xb7\x4a\xd1\x8b\x7f\x47" +
+		"\xc4\x2e\x28\x47\x8e\xbd\x46\xf0\xe8\x6b\x09\xb3\xd1\x44\x02\x3d\x33\x89\x70\xe6\x45\x86\x08\x08\x14\xbd\x4a\xa4\x16\x6a\xed\xa1" +
+		"\xaf\x10\xcc\x7f\x45\x6e\x50\x8c\x6e\x08\xa6\xff\xb5\x4c\xfe\x6b\x39\xff\x25\x39\x5f\x7a\x5f\xfc\x2a\x91\x79\x41\xe1\xbd\x2e\xcf" +
+		"\xe6\xa5\x13\x28\xd3\x81\x7e\x85\xf8\x6a\x55\x48\x7b\x62\x04\x47\xc8\xdb\xa3\x9f\x97\xa2\xa2\x4e\xff\x35\x91\xd6\x2a\x96\xd3\xaf" +
+		"\x11\xa1\x55\x4a\x5d\x95\x80\x5d\x24\x30\xae\x1d\x81\x99\x12\x3f\x52\xf5\x3b\xc2\x5d\xf7\x65\x02\x3b\x05\x0b\x1c\xcc\x08\x11\x9d" +
+		"\xa7\x19\x8b\x9e\x27\x42\x93\x84\x2e\xd2\x2e\xd0\xa4\xa8\xad\x7d\x81\x88\xae\x11\x55\x2b\xd5\x6a\x04\x8b\xbe\xa6\x1d\xec\x6b\xf1" +
+		"\x00\x7f\x11\x04\x7a\x7c\x9d\xe8\xda\x5d\xd2\xc6\xc8\x1f\x05\x82\x31\x7f\x47\xb0\x40\xde\xda\xaa\x46\xbe\x45\x35\xb8\xd9\xf9\x41" +
+		"\xe2\x91\x1a\xc5\x61\xd0\x6b\xcc\xc9\x1e\x28\x8d\x57\x7e\x9d\xc8\x58\x21\x01\x33\xbe\x4b\x74\xac\xfa\xae\x96\xfe\x96\x96\xd6\x8c" +
+		"\xd2\x7f\x22\x52\x4b\xfe\x29\xd1\x9e\xef\xbe\x4c\x64\xdf\x91\xfe\x23\x73\x88\xd8\x08\xe7\xbb\xa0\xc4\x99\xfb\x0a\x91\x71\x58\xee" +
+		"\x3e\xf0\xf7\x04\xf7\x2b\x70\xcb\xe4\x99\xa5\x5a\x67\xb5\x58\x56\x09\xaa\x5f\x27\x52\xe7\xbe\x2e\x29\xaf\x11\xa9\x23\x74\xb7\xd7" +
+		"\x2b\xea\x8c\x9a\x7b\x3d\x87\xe0\x35\xcb\xfb\x67\x02\xb3\x02\x11\x19\xf1\x3d\x4d\xfb\x27\x22\xcc\xfd\x27\xa9\xfc\x1d\x02\x8b\xdb" +
+		"\x11\xec\x2d\xff\x0e\x91\x42\x83\x5f\x6e\x2b\x67\x97\x68\x83\xbc\x7f\x45\x01\x5f\xa6\x4c\x58\xea\x74\xf8\x06\x11\xf2\x35\x36\xe0" +
+		"\x1f\x09\x9c\x22\x11\xb9\xa4\x35\x86\xbc\x4e\x70\xb0\x14\x5a\xff\x5d\xe6\x44\xd6\x7d\x37\xc1\x92\xda\x5f\x7c\xeb\x5f\x21\xc2\x3a" +
+		"\xa9\xb6\x4f\x6a\x4c\x48\x6f\x3b\x0e\x1d\xce\x12\xc8\x73\x95\xdf\x25\x42\x85\xa6\x2d\x7c\x89\x08\x8d\xd2\x6a\xce\xf7\x09\xac\xc8" +
+		"\x24\x50\x2e\x85\x9a\x34\x56\xf4\x1d\x22\x52\xf8\x9d\x44\xd1\x60\xc3\x4b\x09\xaf\xc5\xec\xb5\x19\x4a\xed\x2a\x79\x63\x91\x44\x35" +
+		"\x09\x8e\x89\xe5\xe8\x4b\x6d\x8b\xef\x15\x1c\xd1\xe5\x61\xc1\xcd\x6f\x13\x91\x1b\xdf\x66\xc0\x00\x55\x4e\x5f\x2d\x54\x10\x25\x22" +
+		"\x57\xe4\x8d\x8b\xff\x97\x40\x59\x20\xee\x5f\x23\x51\x63\xfe\x85\xe8\x50\xf0\x2f\xf2\x90\xa4\x8c\x13\x8c\xfc\x0e\x91\x36\x42\x0a" +
+		"\x9d\x15\xcb\xfc\x54\xa2\x5d\x99\x58\xc6\x7c\x85\x68\x57\xf0\x15\xc5\xe3\x9b\x3a\x96\x48\xfc\xfc\xef\x11\x8f\xce\xfb\x1e\x73\x3e" +
+		"\x0b\xd9\xfc\x2a\x91\x51\xf1\x2a\x03\x41\xe0\xf4\xb7\x88\x07\x5b\x95\x31\x95\x2f\x27\x9c\xd4\xa9\x4f\x8e\xfc\xf8\x43\x22\xa5\xff" +
+		"\x0f\x09\x6c\x7d\x99\xb8\x6f\x8b\xee\x1e\xd2\x8d\x83\xde\x10\x55\x3d\x8b\x52\x65\xa3\x2e\xc7\x28\x83\x0a\x5e\x49\xf4\xd3\xa8\xfb" +
+		"\x69\xbf\xd7\x89\xb4\x3c\x05\x67\xd9\x77\x89\x94\xa5\xd2\xf3\xf6\x6b\xda\x3c\xe4\xa9\xe5\xdf\x67\x4e\x97\x66\xb9\x7a\xe2\x4b\xc4" +
+		"\x93\x13\xe4\x95\x3d\x3f\x24\xc2\xee\x1f\x32\xfc\x11\xcc\xf9\x38\x08\x9c\xd7\x36\x71\x4e\x91\x3f\xa4\x88\x1e\x14\x5c\xfe\x06\x91" +
+		"\x7d\xe9\x1b\x89\xe7\x8e\x65\x32\x70\x4c\x1a\x11\xdd\x2d\xb1\xfd\x7e\x44\x44\xf3\x7e\x44\x60\xf5\x6b\xc4\x43\xab\x34\x76\xf7\x9b" +
+		"\xc4\xff\xb9\x23\xbe\x65\xca\x8d\xa5\x12\xb2\xf5\x47\x44\x6c\xcb\x8f\x98\x13\x75\x79\x7c\x8b\xe0\x1d\x39\x61\xd7\x3f\xea\xc8\x25" +
+		"\x8e\xa2\x5c\xe2\xf1\xc2\x5c\x02\xa7\x94\x9c\x27\x05\x0b\xbe\x4f\xb4\x6b\xf8\x3e\x03\x40\xe0\xc4\x3f\x10\x69\xc7\xdd\xd2\x7f\xac" +
+		"\x10\xc7\x88\x4e\x35\x8a\xc1\xc8\x97\x15\x1c\x39\x6c\x4f\x2e\xd1\x6e\xb7\x84\x1c\x50\x4c\x57\xb7\x23\x42\xc5\x9b\xba\x7c\x99\xc8" +
+		"\xd8\x2f\x96\xad\x6f\x10\xdc\xf2\x06\x81\x85\x6f\x11\x91\xc6\xb7\xb4\xe2\xf7\x89\x70\x52\xce\xd9\xf5\xcf\x44\xfb\x9d\xca\xf5\x9b" +
+		"\x3f\x20\x70\x2d\x10\x68\xcd\x24\xd2\x86\xa9\x19\x54\x7e\x5b\xf2\x44\xed\xf9\x01\x91\xb1\xfb\x07\xcc\x21\x08\x1c\x91\x3e\x87\x05" +
+		"\xd3\xbf\x4f\x74\x9b\x26\x49\xc3\xbf\x43\xc4\x86\x29\x63\x8a\xde\xd2\xd9\x46\xeb\x94\x2a\xad\x4b\x34\xd6\xeb\x5f\x09\x9c\xbb\x8f" +
+		"\x60\xfe\x77\x18\xec\x94\xab\x5f\x23\x78\x45\x2d\xbe\x52\x6c\x15\x62\xbb\xfc\x06\x11\x2e\x49\xd9\xd1\xff\x4a\xc4\x46\x69\xd6\x8e" +
+		"\x9f\x10\xed\xb7\xff\x44\x4a\x7c\x87\x48\xd9\x2d\xbe\x8b\x82\xa7\x08\x8c\x52\x4e\xdc\xd5\x55\x67\x02\xcf\x49\x05\x8d\xdc\xd6\xc8" +
+		"\xd3\x04\x26\xe8\x6a\x8e\x60\xb6\xa0\xdf\x2b\x44\x4a\x9e\xa8\xea\x57\x74\x76\x7b\x25\x91\x5b\xa1\xa0\xad\x8c\x53\x5d\x49\xcb\x7e" +
+		"\x4c\x44\x97\xca\xc0\x8d\x6f\xe8\x81\x4f\x1a\x2d\x17\x5f\xb3\xf8\x36\xbf\x42\x70\xd3\x2b\x89\x16\x10\x5b\xf7\x4a\xa2\x5a\xd3\x0f" +
+		"\xbf\x92\x78\x9e\xad\x7f\x93\xc8\xa8\x53\x20\x16\xe6\x12\x99\x8d\xb9\x04\x8a\xc5\x36\x56\x30\xe4\x2d\x22\x6d\xb0\x1c\x34\x52\xd2" +
+		"\x47\x48\xfa\x54\x99\xba\x28\xa2\x47\xc7\xb7\x12\x67\xb1\xea\xf7\x08\xce\x7d\x4f\xc9\xf9\xaa\xce\xb7\x6d\xfb\x67\xd4\xfb\xe7\xf9" +
+		"\x57\x89\x70\x4e\xd4\xd5\x57\x13\x4f\x45\x17\x44\xf4\x7d\x8b\x60\x1f\x09\xbf\xac\xf5\x4f\x44\xe5\x33\x79\x6f\xd1\x8f\x88\x27\x9b" +
+		"\x7e\x94\xd8\x8e\xa3\xc7\x5f\x4d\x94\x3e\x0b\xfe\x25\x21\x24\x76\x45\x63\x17\xc5\x72\x41\x50\x2f\x98\x9b\x4d\x3c\xd0\xfb\xb5\xf8" +
+		"\xce\xf2\x5d\x10\x98\xf8\x3a\x91\x3e\x41\x85\x57\xfb\x3a\xf1\x44\xcd\xeb\x89\x57\x5d\xc5\x6f\xeb\x4c\xf9\x36\x81\x5d\x5a\xb9\x2e" +
+		"\xd6\xd6\x4c\x16\xfc\x1b\x71\x7f\xc3\xbf\x11\xd8\xf6\x63\x82\x2d\xf2\xeb\xfa\x9f\x11\xd1\x75\x3f\x4b\x9c\x86\x4e\xfd\x3b\xd1\xe5" +
+		"\xe4\xbf\x4b\xf8\xf7\x89\xcc\x09\x4a\xa8\xd2\x9f\x10\x8f\x96\x48\xf3\x95\x6f\x12\x91\x15\x92\x79\xf6\xa7\x44\x97\x33\x3f\xd5\xdd" +
+		"\x0f\x08\xac\xd0\xab\xad\x96\x77\x64\xeb\xcf\x08\x5c\x12\x9c\xfd\x21\x91\x71\x46\x7d\xe5\xe2\x4f\xe5\x13\x31\x9f\xc8\x65\x4e\x38" +
+		"\x9e\x2b\x51\xf2\xce\x0a\x79\xa7\xaf\x1c\x5b\xd5\x9e\x88\xb6\xc8\xfe\x39\xef\x12\x98\x2d\x98\xf1\x63\xa2\x43\x95\x74\x3c\xf4\x0e" +
+		"\x91\x72\x50\xe2\xd7\xbf\x2f\x4f\xe8\xc4\x58\xf0\xef\x71\x79\x88\xcb\x3b\xff\x23\x82\xe7\xe4\xa7\xe9\x3f\x25\xa2\xd3\xb4\xda\x0c" +
+		"\x41\x95\x60\x97\x8a\x72\xe7\xff\x65\x70\x52\xf4\x7b\x97\x08\x79\x5a\xa2\xf4\xa7\x04\x4b\xc4\x71\xe0\x07\x44\x6c\xbf\x4a\x6f\xf8" +
+		"\x3b\x4a\x5e\x2d\x76\x42\x97\xa7\xb2\x89\xc8\xa6\xff\x50\x56\xfe\x40\xc7\x5b\x8d\x5d\x16\x54\x66\x10\x91\x71\xe2\xbb\x2a\x01\x75" +
+		"\x81\x48\xaf\x13\x5f\xf1\xfb\x3a\x7c\x49\xd1\x22\xf5\xb9\x42\xb9\xa0\xf4\x5f\xb5\x90\x52\xab\xf2\x7b\xaa\x31\x45\xa3\x5e\x77\xeb" +
+		"\xec\xa0\x0f\x88\x76\x17\xe4\xc8\x49\x32\xb7\x28\x4d\xe7\x63\xb9\x65\xdb\x3b\x44\xb0\x5f\x8f\xbd\x47\x84\xa3\x1a\x1b\xaf\xad\x6c" +
+		"\x9c\x0f\x01\x3f\x23\xc2\x1a\xf9\xfa\x90\x82\x7d\xf0\xed\x44\x97\x5b\x2e\x7f\x37\x4b\xa9\x4a\x8d\xf7\xca\x22\x52\xa7\xfd\x52\xd2" +
+		"\xc5\x5b\x26\xa8\x54\xaa\x57\x08\xea\xff\x83\x48\xb1\xde\x45\xba\xcc\x20\x42\x99\xab\x4c\xb1\x69\x16\x4c\x7e\x83\x60\xb9\x79\x55" +
+		"\x25\x75\xa2\x0e\xfd\x92\x08\x07\x25\x73\xb3\x3a\xc5\x26\x8d\x1d\xd0\x8a\xfb\x05\x73\x74\xa9\xa3\xd3\x5c\x11\x47\x04\xcf\x12\xa1" +
+		"\xe6\x8d\x44\xc7\xe9\xab\x70\xf4\x71\xa3\x7c\x83\x48\x5b\x25\x86\x25\xef\x13\x91\xc5\xf2\xda\x70\x25\xd8\x5f\xe9\xb1\xdc\x7a\xa8" +
+		"\xf8\x9a\xdf\x4b\x70\x04\x73\x0c\x7d\x97\x88\xe4\x2b\x80\x7d\x95\x91\x7d\x34\x61\x97\xbc\x74\xc8\xef\xdb\xa4\xc2\xc4\x37\x89\xbf" +
+		"\x9d\xa0\x1b\x93\xde\x25\xd2\xca\xde\x65\x4e\x16\x74\xf5\x1e\xd1\xad\x4c\xe2\x86\xbc\x47\xb4\x1f\xfc\x1e\x83\x9f\x1d\xb6\xfd\x5c" +
+		"\x09\xf8\x73\x02\xd7\x7f\x48\x64\x5e\x53\x58\x56\xff\x84\x08\xab\x94\xf8\xc7\xfe\x53\xde\xff\x4f\x05\xf7\x0d\xe2\xe1\xb1\x52\x6c" +
+		"\xc6\xbb\x04\xab\xa4\xc4\xb6\x37\x88\x4e\x2d\x1a\x9b\xf3\x3e\x91\x36\x5b\x2a\xce\xf8\x35\x11\xaa\x7e\x2d\x65\xdf\x24\xa2\xf9\xd2" +
+		"\x64\xdb\x7f\x11\xa9\x2d\xff\x45\x60\xaa\x58\xa6\xbc\x9f\xe8\xe2\x03\x14\xaf\xfe\xd2\xba\xfa\x4d\x22\xcc\x15\x6f\xf1\x7b\x44\x64" +
+		"\xac\xf4\xac\xfe\x6f\x39\xf2\xbf\x09\x2c\x7c\x87\x88\x36\xca\xce\xb5\xff\x49\xa4\xac\x91\x3e\x45\x8a\x41\xa1\xe0\xc0\x9b\x44\xe6" +
+		"\xfe\x37\x13\x09\x18\x9c\x80\x93\x3f\x24\xd2\xcb\x3f\x64\xc0\xf2\x5f\x10\xd9\xcd\xbf\xf8\xff\x65\xc7\x2e\x95\xd0\x4e\x41\xe9\xaf" +
+		"\x89\x8e\x25\xd2\x76\xc0\x8f\x88\xce\xfd\x55\x47\x13\x3f\x20\xb2\x26\x28\x29\xb7\xbd\x4d\xa4\xb7\x28\x95\x56\xfe\xb7\x1a\x80\x74" +
+		"\x29\x7a\x9f\x68\x5f\xa8\x35\xe6\xbc\x47\x64\xcf\x7e\x8f\x13\x42\xee\xf9\x73\x81\x13\x42\x7a\xcb\xe8\xc0\xd8\x67\x4f\x8c\x0e\x64" +
+		"\x3a\xc8\x0c\xb0\x2c\xe4\x82\x13\xc2\x3f\x82\xd3\xc3\xeb\x60\x45\xb8\x1f\x4c\xfb\x02\x58\x19\x1e\x07\xb3\x1f\x07\x5f\xfd\x4a\x55" +
+		"\x7e\xf0\x4e\x9f\xd1\x6d\xdb\xd0\xc0\xe8\x53\xe0\xeb\xf7\xaf\xd1\x58\x00\x03\xc0\xc8\x63\xf0\x7d\xa0\xc7\x53\xcc\xfa\xf6\xa9\x9f" +
+		"\xf1\xbb\x99\x2d\x47\x82\xea\x52\x5a\x5c\xcc\x22\x9e\xd8\xf0\x1b\x02\x93\x64\x5a\xd9\x4f\x13\x55\xc1\x83\xd2\xfb\xf4\xdb\x44\x68" +
+		"\x15\x55\xec\xb2\x88\x12\x6c\xfe\x80\xe1\x41\x10\x98\xf5\xbf\x04\x66\x0a\x2a\xe5\x5b\x12\x28\x17\x31\x52\x5e\x1d\xf1\x4b\x22\xf7" +
+		"\xee\xd1\xc0\x1c\xcc\xfc\x90\x08\xc3\x64\x7c\xbf\x5f\x11\xc8\x13\xd4\x8a\x71\x7e\x3a\x81\x33\x2e\x5f\xc1\xe2\x20\x79\xa9\x44\xa8" +
+		"\xd2\xdd\xea\x77\x88\xcf\xcc\x7d\x87\x32\x15\xdd\x2e\x1e\x0d\xfc\x53\xca\xdc\xa3\x81\xc0\xe8\x0f\x88\x67\x46\x7d\x90\xa8\xd3\xf4" +
+		"\x0a\xe9\xb7\x5c\x9a\x36\xbf\xcd\x90\x09\x59\xf0\x0b\x22\x7a\x50\x51\xdb\xa3\xd4\xbc\x1a\x23\x32\x36\xfd\x17\xc7\x87\x34\x10\x18" +
+		"\xf2\x0e\x71\xdf\x60\xad\xb1\x44\x7d\x62\xf1\x3b\x09\x85\x52\x6a\x44\x1d\xf8\x0d\xd1\x6e\xbf\x3c\x72\xe0\x1d\x22\x7d\xff\x3b\x0c" +
+		"\x01\x72\xd7\xaf\xd4\xc6\xa4\xfc\x47\xca\xe0\xde\xca\xe0\xbb\xca\xac\x3b\x9a\xd5\xf7\x5d\xa2\x7d\x9f\x77\x39\x3e\xdc\x07\x02\xab" +
+		"\xdf\x21\x32\x57\xb9\xf3\xfe\x91\x78\x78\xdd\x1f\x19\xfe\x2c\x09\x4e\xfd\x89\x88\x9e\xfc\x93\xd4\xfb\x1d\x91\xba\xfb\x77\x04\x26" +
+		"\xff\x91\x68\x57\xfe\x47\x79\xe9\x4f\x1c\x1f\x3e\x96\xa7\xdb\xbb\x7e\xab\x57\xc8\xbf\x95\xaf\xde\x22\x38\xef\xad\x84\x2e\x45\xe2" +
+		"\xbb\x95\xa1\xb7\xc2\x4a\xe6\xb5\x6f\x89\xe3\x11\x02\x3d\xbb\x07\x49\xfd\x1f\x22\x7d\xf7\xff\xc8\x84\xb7\x88\xb0\x5f\xb7\x6f\xca" +
+		"\xa2\x1b\xbf\xe1\xf8\x00\x10\xe1\xbc\xc6\xce\x08\xce\xbe\xa5\x96\x21\x6a\xd0\xdb\x44\x64\xa0\xbc\xb9\xb1\x47\x20\xc2\x06\x21\x4e" +
+		"\x6b\x85\x0d\xed\x65\x54\x26\x81\xc3\xf2\xea\x18\x31\x15\x08\xfa\x09\xf2\x04\xb3\x7e\x45\x84\x92\x6c\xc9\x11\xf3\xc8\x08\x81\x09" +
+		"\x1d\x89\x98\xef\xce\xf9\x93\xb2\xdd\x36\xf5\x0c\x04\x76\xf6\x0c\x0c\x5d\x41\x60\xab\x6e\x6f\x11\x4c\xfc\x25\x11\x9b\xa0\xc4\x19" +
+		"\x74\x3a\x30\xa7\xdd\xa6\x53\x81\xc0\x78\xad\x3f\x4e\xd0\xeb\x0f\xb2\x50\x70\x55\xb0\x33\x4a\xa0\x50\x66\xdd\xfc\x2d\xf1\xdc\x8d" +
+		"\xdf\x26\x04\xde\xfd\x37\x22\xed\xce\xbf\x11\xb9\x1f\x49\x0c\x56\x59\xcc\x82\xff\x20\x9e\x6c\x50\xbf\xee\xa7\x8d\x2e\x4f\xf9\xdc" +
+		"\xf7\xf7\x44\x4a\x9f\xdf\xcb\x48\x39\xa1\xf5\x2d\x06\x6f\x7e\xa9\xde\xfc\x3e\x7a\x9b\xc8\xee\x2d\xc5\xee\xfe\x8a\x48\xb9\xf3\x2b" +
+		"\x22\xf7\x66\x6b\x60\x0e\x9b\x2c\xf1\xe6\x9f\xb4\xaa\x4c\xda\xf1\x36\x91\xb5\xfd\xed\xc4\xb1\xa7\xd7\xcf\x08\xf6\xfc\x19\x81\xa1" +
+		"\x82\x7c\xc1\x89\xff\x21\x3a\x1e\xff\x1f\x22\xf7\xb2\x24\xdc\x57\x67\x09\x63\x7e\x47\xb0\x40\xd1\x3f\xa6\xd3\xc4\x51\x71\x9e\x16" +
+		"\xec\x93\x1b\x57\x49\xb1\x23\x7f\x24\x3a\x1d\x56\xb8\x87\xff\x37\x91\xea\x32\x1a\xf3\x73\x06\x16\x28\xb1\x4b\xd5\x7d\x4b\x44\xd5" +
+		"\xff\x9c\x48\xab\x13\xb5\xf0\xe7\x3a\xb0\xfd\x9c\xc8\x3d\xa2\xa5\x50\xe1\xa5\x06\xf4\x0d\xfa\x85\x49\x88\x8b\x79\x81\x48\xbf\x20" +
+		"\x44\x3f\x45\x83\x79\x42\x0c\x72\x49\xdd\xa7\x84\xd2\xa3\x56\xcf\x3e\x41\xcb\x7e\x40\x3c\x32\xec\x03\x86\x87\x21\xf6\x8f\x02\x81" +
+		"\x93\x5d\x08\x8e\xfd\x90\xc8\xdd\xa6\x25\x3a\x8e\xf5\x12\xf5\xff\x4e\x84\x3a\xb5\xc5\x95\x1f\x12\x5c\xf1\xa1\xac\xb1\xe0\x56\xe3" +
+		"\xd6\x0f\x13\x3f\x80\x3d\x51\x98\x17\xe2\x65\x9d\xbb\x51\xf3\xb3\x46\x79\x7e\xaf\xdf\x12\xa1\xa7\x92\x7d\x56\xff\x40\xc4\x66\x0a" +
+		"\xb1\xf1\xa7\x44\xfa\x06\xc5\x63\x80\x3c\x73\xf5\x21\x02\x3d\x33\x88\xec\x5b\xf2\xdb\x65\xa9\xbc\xf2\x01\x22\xa5\xa1\x6f\x50\x8d" +
+		"\xc9\x53\x2b\x04\x7d\xdf\x27\x1e\xed\xf3\x3e\x91\xbb\x48\x6b\xb0\x4f\x7c\x0d\x85\xeb\x8b\x04\xe6\x89\x38\xa5\x04\x4f\x39\xd9\xc3" +
+		"\xdd\xf0\x03\x82\x7b\x25\xae\x97\xec\x4e\x8d\x5b\x3f\x7e\x80\xf0\x5a\x1a\x81\x32\x1b\x71\x5d\x1c\xb3\xb2\x08\x4c\x51\x78\x26\x6b" +
+		"\xb0\x4b\xb9\x10\x23\x25\x27\x36\xa2\x47\x68\x2b\x52\x39\xfb\xd1\x42\x61\xee\x74\xe9\x90\x7d\xe1\xa4\x75\x18\x1c\x88\xec\x9e\x83" +
+		"\x5d\xa9\x1f\x12\x0f\xec\x96\x63\x56\xf7\x0b\x04\x57\xf5\x0b\x89\xf7\x5b\xb3\x74\x1d\x66\xf6\x73\xee\x0e\x94\x8c\x53\x3f\x66\x4e" +
+		"\x68\xb5\x88\x6d\xca\xff\x16\xc1\xc6\x5f\x10\x1d\x36\xa8\x26\x6f\xff\x82\x08\xb7\x44\x4d\xea\x2d\xb3\xca\x84\xe8\xfb\x4b\x22\xad" +
+		"\xcf\x2f\x09\x8c\x16\x35\x4a\xd4\x65\xa9\x94\x7e\xa9\x6f\x48\xbc\x57\x5a\xa8\xc1\x46\xc1\x55\xbb\xfc\x4a\xff\x90\x18\x8d\x78\x74" +
+		"\xe5\x2f\x89\xec\x15\xa2\xc6\xf7\x09\x89\x97\x2d\xe8\x2d\xcd\x72\x87\xca\x2e\xf4\xfc\x89\x7e\x65\xd0\x00\xe6\x0f\x09\x7a\xd5\x29" +
+		"\x44\x91\x76\xff\x42\x55\xee\x48\xc1\x89\xae\x04\x6f\x39\xeb\x36\x7e\xa8\x87\x12\x99\x5d\xad\x3b\xed\x09\x94\x89\x38\x6b\x19\x67" +
+		"\x8c\xb5\xe2\x64\x8d\x10\x4b\x3e\x24\xb2\x17\x7f\x98\x48\x9a\xbb\xa7\x94\x74\x4b\xed\x89\x49\x6a\xc8\x17\x1f\xd6\xdb\x4a\xaf\xb9" +
+		"\x59\xba\xa7\x6c\x12\xe2\xbc\x9c\x71\x4e\xb0\x44\x5c\x8b\x05\xd7\x15\xf7\x6b\x82\x65\xba\x5c\xea\xb1\xdf\x12\xbc\xa6\xc4\x9b\x24" +
+		"\x47\xa3\xcc\xb8\x68\x90\xb0\xc9\x78\x57\x37\xef\x08\x26\x3a\xd4\xfd\xfc\xf6\xe3\x3e\x22\x50\x9c\xba\x9a\x4b\x02\x7d\x5c\x1e\x45" +
+		"\x0e\x5d\x61\xbf\xb6\x5a\x0b\xe1\x42\x5e\x52\xca\xd0\xae\x04\x06\x0a\xba\xbb\xed\xbb\x22\xcb\x8d\x33\x9c\x88\xb5\x92\x76\x31\x10" +
+		"\xd8\xd0\x99\x60\x1f\x71\x9d\x90\x6d\xe9\xc7\x85\xe8\xe1\x35\xfc\xab\x71\x84\xc0\x80\x4c\x82\x13\x9c\x64\x8b\xe4\x97\xd0\xc7\x7e" +
+		"\xa9\xd5\x08\x86\x49\x56\x99\x24\x8c\xff\x1d\x11\xc6\xb5\x51\x9e\x59\x22\x62\xad\xd7\x5c\x63\xbc\xe9\xcd\xe3\x86\x71\xf4\xf0\xa0" +
+		"\xe7\xd7\xe1\xd6\x4b\x8c\x55\x9e\x2b\x6f\x64\x8c\x13\x62\xb5\x06\xae\x77\x24\xc2\x62\x5f\x6f\x8d\xe7\x4e\x0a\xc1\xdd\xbd\x43\x9b" +
+		"\xd2\x88\x2b\x5d\x29\x07\xc4\x2a\xe2\xc1\x74\x12\xbd\x23\xef\x67\x10\x61\xbf\xe4\x8c\x1e\x14\x18\x30\x6a\x50\x68\x5b\x24\x16\x5f" +
+		"\xe4\x84\x15\x38\x6e\xbc\x28\x13\x2e\x78\x55\xc1\x35\xc1\x55\x41\x65\xba\xba\xa0\xdd\x32\x4b\x66\x73\xa6\x10\x87\x24\x21\x7a\x50" +
+		"\x98\x3b\x48\xae\xe1\xa6\x13\x81\xc0\x47\x0e\x61\xef\x78\xcb\xfb\x3d\xc1\x81\xaa\xe4\x43\xc3\x02\x01\x7d\x0a\x91\xe2\xeb\x1e\xd6" +
+		"\xf2\x60\x7a\xe2\xad\x04\x8e\xfa\x7a\x8c\x6e\x2d\xe9\x4c\xe0\xb0\xee\x8c\xf1\x8b\xb2\x8e\x04\xe3\x2d\x60\xab\xdb\xc5\xcc\x6c\x02" +
+		"\xba\x73\x3b\x46\xa0\xa2\x97\x0b\x7d\x58\x48\xbc\x7d\xc6\x8e\xf6\xfa\x8a\xc1\x9c\x73\x7e\x4f\x84\xd9\x92\x59\x29\xaa\x42\xd4\x7c" +
+		"\x37\x86\x2b\x1d\x75\x66\xd5\x75\x5f\x67\xe3\x30\x89\xba\x60\x1b\x57\x8a\x75\x85\x6e\xd5\x0b\x4e\x74\x92\x0e\x9d\x18\xd8\xa0\xcb" +
+		"\x7d\xf6\xf7\x74\x12\xe8\xef\x35\x17\x1a\xfb\x6b\x65\xaf\x34\x5d\xc1\x09\xd3\x7a\x3b\xc7\x35\xd0\xe4\x55\x47\x04\x02\x63\xa3\x44" +
+		"\x28\xc8\x77\xcf\x91\x82\xa1\x41\x88\x52\xfb\xb3\xa4\x6f\xdb\xea\x90\xed\x1b\x44\x0c\x70\x94\xfa\x1b\xd7\x4b\xaf\x75\x1a\xdd\x25" +
+		"\xd8\xf9\x7b\x22\xb7\xf6\x64\x60\x0e\xce\xfd\x9a\xc8\x18\xa8\x52\xdb\xea\xe4\x3a\x13\x21\x90\x46\x40\xcf\x88\xc7\x6d\xfe\x1f\x08" +
+		"\x7c\x8b\x48\xdb\xef\xdd\x78\xa8\x77\x0d\xe3\x5d\x0d\x30\x9e\xb2\xbc\xa5\x8b\x21\x8e\xf1\xe0\xbe\xd6\xd2\x4d\xa2\x21\xcf\xb1\xb1" +
+		"\xa1\x05\xc6\x41\x92\xb7\x47\xe9\x75\xdc\xf7\x66\x38\x08\x53\x62\x0a\xfc\x1f\x12\x3b\x3a\x06\xc8\x8c\x35\x1a\x5b\x2d\xef\xf4\x1e" +
+		"\x94\x98\x19\xc2\x40\xf1\xd4\x7a\x4e\x51\x96\x7e\x58\xb0\x4b\x86\xff\x21\xae\x46\xfa\x08\x11\xa5\x82\x12\x41\xbd\x7d\x79\x2c\x45" +
+		"\xef\x8a\x5c\x04\xd3\xff\x40\x70\xda\x1f\xd4\xaa\x4e\xc8\xfe\xbd\xb9\x04\x0f\xfb\xd6\x81\x3f\x10\x29\xfb\x3d\xdf\xd7\x03\x52\x15" +
+		"\xec\x47\x14\x1c\x3d\xe0\xec\xf2\x42\xa3\xee\x4b\xfc\xfe\x1c\x21\x42\xa3\x98\x47\x6b\x3c\x3a\x2a\xdf\x11\x50\x26\x86\x15\x7d\xac" +
+		"\xad\x7d\x54\x26\xfe\x4e\xea\xc1\xe2\x3d\x25\x38\x29\xd8\xac\x8d\x70\x93\x60\x9b\xbd\xb5\x4a\xa9\xd2\xff\xd7\x52\x79\x64\x20\x58" +
+		"\x37\xd2\x49\x6b\x49\xa3\x3a\x12\x68\xf8\x75\x5b\xad\x22\x5e\xab\xa7\xff\x40\x84\x56\xc9\x9a\xac\xbc\x0a\xe5\x43\x02\xc3\x43\x20" +
+		"\x50\xa4\x83\x7f\xa1\x60\xfa\xaf\x65\xed\xaf\x89\xdc\xf5\xb2\x36\x7b\xc4\x71\xa7\xb6\x12\x8a\xb3\x85\xa8\x1e\x1d\xf4\x43\xce\x68" +
+		"\x17\x82\xd3\x7b\xac\x34\x71\x7a\x2f\xfc\x3d\xd1\xd1\xd4\xa1\xff\x25\xc2\x41\x49\x2c\xd5\xda\x29\x25\x6a\x9d\x9d\x41\x60\x92\x5c" +
+		"\xd5\xae\x4c\x98\xbb\x44\x6b\x64\xe5\x79\x8d\x8b\x8a\x58\x97\x0b\x83\x42\xe2\xa8\x0c\x1f\x95\xf7\xfd\x96\xe8\xbc\x57\x54\xbd\xef" +
+		"\xd4\x0d\x6a\xdb\xe8\x0e\x29\x9b\xb3\x0e\x0e\xf0\x2e\xec\x3c\x39\x9b\x42\x20\xaf\x1d\x81\x57\xe5\x03\xf3\x57\xfe\x8e\xc0\x7a\xd5" +
+		"\xf8\xf6\x54\x02\xe3\xf2\xda\x7a\x20\xdd\x03\x57\x7a\x33\x3e\xf4\x26\x73\x50\xf6\x3d\x22\xac\x72\x08\x46\x6a\xea\xf3\x23\x06\x79" +
+		"\x77\x18\xe8\x33\xd5\xc0\xb6\x65\xa7\x2b\xe9\xbb\x4c\x13\xe2\x72\xbf\x40\x44\x2f\x09\x73\x27\xc9\x94\xb4\x93\xc7\x9c\xc8\x76\x6f" +
+		"\x83\x10\xb7\xc5\x19\xbb\xd5\xdd\x6a\xf6\x08\x04\x7b\xf6\xb0\xb9\x5a\x29\x5c\x18\x6a\x5a\x12\x62\x17\xfa\xb9\xc2\x0b\x03\xd1\xa9" +
+		"\x51\x88\xe5\x05\x81\x08\xcd\x05\x4e\x8e\x51\x41\x07\xfa\x51\x81\x01\x90\x0d\x32\xb9\x7d\xd5\x30\xef\x9b\xe6\x9e\xfb\x00\x81\x45" +
+		"\x1d\x88\x48\x7c\x74\xa5\xc3\xb5\xc2\x78\xd7\xeb\xdc\x31\x5e\xd5\xfa\x91\x2b\xc2\xdc\x01\xd2\x3a\xb2\xe1\x58\x68\x4b\x23\xc4\xd3" +
+		"\x68\x68\x4f\xf1\xe4\x0b\x71\xca\x2e\xee\x4e\xbd\xa6\xb3\x8a\xdb\x24\x32\xa3\x65\x84\xfb\xaf\xb2\x98\xbd\xf3\x3d\x2e\x57\x61\x99" +
+		"\xa0\x38\x8d\x40\x0a\x71\x7f\x9d\x14\xee\x04\x15\xcb\xd8\x40\xa4\xee\x1f\xeb\x2c\x55\x1a\x6f\x11\x7c\x64\x13\x7b\x0b\x73\x2f\x1f" +
+		"\x0f\xcc\x41\x9d\x95\xd9\xf5\x47\x22\xec\x14\xc7\x0c\x73\x54\x15\xf8\x40\xa0\x7c\x7b\x68\xd3\x60\xe7\xa1\x32\x28\x6b\xae\x10\x53" +
+		"\x85\x19\x53\xfa\xb7\x9d\x44\xcf\x4a\xd2\x53\x73\x2d\xe9\x88\xc2\x98\x7a\x58\x88\xb5\x63\x82\xde\x19\x8c\x71\xee\xca\x69\x91\xdd" +
+		"\x85\x3e\x08\xca\x44\x5e\x1b\xd0\x36\xff\x94\x35\x99\xe9\xf9\x43\xa4\x71\xe6\xe0\xb1\xd6\x4a\x16\x86\x9d\x03\x2d\x57\x52\x3a\x1d" +
+		"\x1e\x63\xcb\xbd\xc1\xb6\x0c\xf7\x2e\xa7\xbb\xed\x8f\x0f\x6c\xcb\xcb\x10\xeb\xe9\x70\x14\x49\x83\xf4\xc2\x41\xee\x29\xc6\x69\xc6" +
+		"\xd1\xf2\x63\x64\xd4\x08\xf3\x77\x77\x82\x74\xf7\x61\xaf\x38\x10\xec\x23\xcc\xdd\x21\x7d\xda\x8f\xb3\x3e\x5b\x3d\x2b\x83\x78\x64" +
+		"\x6f\x7c\x57\x2d\xf5\xae\x6a\x2c\x1d\xec\x5a\x1b\xec\x06\x37\x5e\x3a\x0d\x1b\x1f\x18\xda\x83\xc0\x9c\xa2\x40\xa0\x46\x9f\xbf\x5d" +
+		"\x33\x43\x0f\xe5\x67\xa4\xbb\x30\x77\xad\x16\xc8\x1a\xe6\x05\x16\x2a\xea\xb1\xc6\xf8\xd9\x48\x77\x43\xde\x10\x97\x97\x54\x09\x67" +
+		"\x8a\xbd\xed\x7b\xee\xe2\x21\x4e\x01\xa7\x43\xef\x11\xde\x36\x34\xc2\x75\x43\x9c\x4f\xe6\xa9\x33\x7d\x4c\xc8\xa3\x43\x42\x72\x53" +
+		"\x7b\x9a\x40\x3c\x6d\x36\xab\x0d\x61\x93\x47\x2f\x0b\x63\x97\x4c\xef\xf0\xfc\xed\xa6\xc7\x4c\xf0\x06\x9e\x41\x84\xb9\xa3\xac\x8c" +
+		"\xd2\x34\x76\x66\x64\xf2\x6c\x92\xe2\xb3\x49\xee\x0c\x19\xc2\x4b\x47\x9d\x15\x16\x3f\xc5\xb8\x50\xde\x40\xa3\x71\xa5\x46\xb8\x22" +
+		"\x3f\xb9\x97\x84\xaa\x5e\x2e\x12\xc5\x32\xf5\xce\x18\x8f\xbb\x47\xeb\x7d\x0c\x50\xe9\x59\x15\xc6\x45\xba\x9b\xde\x34\xc6\x19\xa0" +
+		"\xbb\xd9\x87\xf3\x1d\xdd\x71\xee\x84\x59\x44\xb8\x95\x1f\x12\x9f\xa4\x9d\x97\x7a\x29\xe7\x46\x3a\x1c\x52\x9b\xc3\x46\xd9\x4a\x4d" +
+		"\x49\xbb\x94\xef\x4a\xf3\xdd\x7c\xe3\x36\xf7\x84\x16\x61\xee\x70\x19\x82\xc3\x5f\x25\xb0\xbf\x33\x11\xcb\x1f\xea\xba\x32\x4e\xcf" +
+		"\x24\x50\x12\x25\x58\x1e\x4f\x65\x85\x36\x63\x77\x91\xcf\xec\xe2\xe0\xbc\xa1\x2e\x45\xd3\x4b\x4d\xf7\x53\x12\xa6\xe6\x0d\x73\x7f" +
+		"\x93\x29\xed\x46\x8c\x77\x37\x30\xcf\x95\xa1\xde\xcf\x1c\xdb\xfd\xc5\x81\x21\x0b\x04\x46\x4f\xf2\x46\x25\x44\xad\x0d\xac\x31\xae" +
+		"\x54\x34\x52\x56\x4c\xb0\xdc\x12\xf7\x47\x61\xee\xd5\x63\x81\x39\x5f\x69\x38\x1a\x18\xb2\x21\x3e\xf7\xb5\x15\xfd\xac\xa3\x30\x75" +
+		"\x77\xbf\x24\xdf\xc7\x1b\x1c\xa4\x41\x5a\x33\x7d\x60\xb1\xa3\x6c\x3b\x0a\x8a\xda\x7a\xdc\x2e\x5d\x47\x77\x16\x39\xc8\x2a\xf1\x4e" +
+		"\xc7\xfb\x3b\xf8\x25\xce\xc4\x92\x64\xd0\x82\x83\x96\x7b\x56\x72\xb3\xe2\xef\x95\x2a\x55\xd2\x29\x15\x03\x9c\xe9\xe6\xe9\x3e\xc6" +
+		"\x89\xa0\x11\x36\x0e\x48\xd2\xed\xe3\xf4\x65\xf3\x5f\x32\xbd\x75\xaa\x8f\x8f\xc6\xab\x1a\x09\x57\x06\xf8\x30\xab\x11\x8e\x9b\xea" +
+		"\xac\x77\x2b\xe8\x3d\xb0\x4d\xd7\x22\x69\x19\x2b\x2c\x72\x3b\x71\x53\xd9\xde\x5e\x1f\xe1\x0e\x74\x5d\x1a\xa9\xaf\x2e\x4d\x0d\x29" +
+		"\x13\x0e\x36\x2e\xf4\x16\x34\xf2\x0d\xe6\x64\x16\x58\xf3\xf9\x93\x1d\xc1\xc9\xae\x81\x8a\x40\x70\x7b\x85\xd3\xd9\x5e\x9f\x32\xc1" +
+		"\x3b\x86\x6c\xe1\x85\x31\x6e\x14\x7e\x4a\xea\xd3\xdf\x4d\x51\x31\x8d\xcc\x1d\xef\xd3\x88\x63\xd5\xdf\x38\x5d\x12\xb2\xa6\x55\x38" +
+		"\x85\xad\x5d\x53\x61\x5b\xb3\x5b\x26\xaf\x75\xee\x7f\x34\xd9\x2c\x19\x6f\x96\xd5\x8a\x7b\x6c\xee\x24\x7b\x73\x9a\xb0\xbc\x23\xd1" +
+		"\xb1\xa7\x4d\x9c\x35\xc0\x4f\xf5\x03\xee\x59\x57\x32\xb0\xed\x69\xb7\xef\xe4\x90\xf8\xfa\x37\xb8\xaa\x73\x6b\xb5\x02\xa6\x69\x83" +
+		"\xdd\x30\xc4\x3d\x4f\x32\xd2\x46\x15\x25\xab\x37\x2b\xf1\x64\x51\xe5\xa0\x55\xb9\x60\x5c\x1e\x29\x04\x47\xe5\x27\xaf\x23\xc3\x4c" +
+		"\x0f\x70\xb7\xeb\x6f\x5c\x26\x65\xc3\xd2\x41\xd6\x64\xba\xe8\x92\xe9\x4e\xd2\x12\xad\xb1\xa2\x24\x24\x7e\xf0\x28\x76\xf0\xc6\x4e" +
+		"\xf5\x96\x66\xb7\x9e\xac\xf0\x9e\x20\x03\xc3\x9a\x49\x3e\x21\xd9\xb9\xad\xc6\x53\x23\x82\xbe\x9e\x1b\xe1\x24\x18\xe1\x2d\xfe\x1f" +
+		"\x99\x13\xd9\x7f\xc4\x4f\x57\x1a\xe0\x8d\x11\x76\x85\xc3\x38\xd3\xd8\x6f\xa4\xfb\xe5\x48\x67\x88\xe9\xde\xa6\xfb\x0a\x23\x7d\x4c" +
+		"\x0f\xf2\xf8\x40\xd3\xa7\xa6\x88\x3e\x39\xc5\xd9\x6f\x2c\x30\x8e\xf7\xdd\xe2\xae\xc4\x03\x75\x95\x0e\x86\xed\x9e\x9b\xff\x97\xf4" +
+		"\x44\xf9\x2a\x4c\xa8\x0a\x89\x5f\x15\x8a\x5c\x48\x53\x3b\x10\xe8\xe9\x44\x77\xe0\xce\xdb\xd2\x73\x15\x7f\xe9\xbb\x52\x73\x97\x18" +
+		"\xfb\x4e\x14\xf6\x31\x4e\x2e\xf6\xf9\xb0\xd8\xb1\x32\xe7\x28\xe3\x54\x8f\xcc\x48\x23\xd0\x62\x87\xdd\xad\x70\xf5\x55\x78\xd8\x6e" +
+		"\xaf\x32\x0e\x2d\xf5\xb6\xf5\x75\xe6\xa0\xca\x0e\xdb\x26\xb9\x6c\x99\x68\xda\x31\x68\x31\x56\x17\x3b\x61\x8b\x6d\xcb\x74\xdb\x32" +
+		"\xdd\x51\x91\x3b\xb9\xa6\xcc\xfc\xa5\x6e\x8b\xa5\x8e\x90\x25\xb4\x1a\x57\x16\xbb\x8f\xc7\x5b\x87\x52\x3e\x5a\x30\xde\xbb\xb4\x65" +
+		"\xee\x2c\xf6\x66\x23\x4c\xd9\x6b\xfa\xa2\xf1\x82\xf1\x84\x39\x8f\x1b\x4f\x78\xe4\x78\x71\x72\x93\xc3\x8e\x34\x22\x34\x97\xdb\x75" +
+		"\x1a\x08\xe7\x8a\xbd\xbb\x8d\x13\xbd\x6e\x9c\x2d\x9c\x11\x18\x42\xfe\x0c\x57\x8d\xef\x8e\x8f\x12\x78\x93\x08\x65\xd6\xf4\x98\x74" +
+		"\x0f\x47\xcb\xdc\x80\xec\xac\xee\xc6\x01\x33\x85\xc3\x3a\x29\xad\xda\x13\xb8\x22\xb8\x64\x09\x77\x8d\x0b\x35\xe0\x33\xe2\x99\x76" +
+		"\x04\xd6\x38\x1b\x6f\xeb\x56\xf4\x56\xb1\x93\xcc\x6a\xe4\xc5\xd5\x30\xe6\x0b\x73\x27\xeb\x0d\x3d\x5b\x0f\xdf\xdb\x8f\x6c\x9e\xcb" +
+		"\x7d\x64\x26\xc1\x99\x8e\xd3\x5d\xa9\xc1\x3b\xc2\x30\xc4\x6c\x03\x8d\x93\x64\x4b\x28\x9b\xe1\xe9\x36\xa1\xb0\x34\x29\x8a\x85\xe3" +
+		"\x2c\xc6\x23\x23\x8c\x83\x6c\xc8\xd1\x28\x81\x32\xe7\xe8\x6a\x47\x45\x3a\x17\xa6\xc8\x5b\x31\xe5\x9c\xed\xdf\xaa\x2c\xe4\x96\x69" +
+		"\x9e\xe6\xe5\x07\x4e\x0a\x6d\x1b\x0c\x46\x4d\xfa\xcb\x9a\x9c\x6c\x0b\xcb\xc7\xf9\xa8\xe1\x0c\x5f\x5c\x65\x3f\x4e\x70\x23\x9f\xe0" +
+		"\x5c\xb4\xba\x53\x66\xb4\x6d\x4e\x21\xb1\x39\x6d\x96\xd1\x61\xd3\x78\x67\x8b\x57\x6f\x35\x2e\xf7\xdd\x66\xe3\x59\x87\xf6\x8c\x71" +
+		"\x8f\x47\x76\x1b\x37\x1a\x37\xfc\x19\xad\x1f\x38\x79\xc3\xa9\x3a\xc4\xa8\x4f\x76\x18\xe7\xd8\x11\x97\xa5\x5a\x6b\xb6\x1a\x0b\xc7" +
+		"\xb9\xe4\xc6\x39\xd9\x8c\x17\x1c\x98\x5d\x47\x02\x73\xb0\xe6\xeb\x04\x36\x79\x78\x6d\x7c\x66\x94\x60\x9d\x9d\x76\xc0\x03\xfb\x8d" +
+		"\xbb\x8c\x31\x19\x31\xee\x2f\xeb\xca\xca\x86\xb8\xb2\x67\x8d\xb7\xb3\x09\xcc\x6c\x4f\xa0\x26\xbe\x1f\xda\x39\x77\x8c\xc7\xcc\x71" +
+		"\xd4\x58\xea\xbe\x52\x62\xdc\x67\x6c\x4d\x97\xc0\x14\x02\xf1\x32\x2b\x72\x18\xd6\x44\x09\xae\xb1\xa8\xad\xd3\x1d\xb5\xe9\x6e\x66" +
+		"\x36\xbe\xb7\xf1\x84\xcd\x3c\x3e\xce\xb9\x68\x3f\xe7\x19\x2f\x7b\xa4\xfa\x3e\x02\x53\x66\xdb\x07\xde\x08\x6e\xa5\xc9\xa8\x2c\x02" +
+		"\xeb\x9c\x00\xa7\x26\x3a\xd0\x13\x9d\xbe\xc2\x90\x3f\x31\x30\x10\x04\x06\x39\x78\x03\xc7\xbb\x21\xc5\x77\xb1\x76\x04\xfa\xb7\x23" +
+		"\xa2\xbd\x7d\x7d\x56\x18\x3d\x63\x7a\xb5\xe4\x45\x56\x4d\x4b\x36\x95\x98\x9b\x4a\xee\x48\xf9\x9b\x3b\x0f\x99\xa7\xd4\x3c\xa5\xde" +
+		"\x59\x3d\x6b\x5b\x7b\xbd\x16\x72\x3e\x2d\xf7\x40\xb3\x71\xa8\x95\x5d\x91\x2a\x65\x63\x04\x4a\xca\x93\x0d\x3a\x33\xde\xa0\xf7\x58" +
+		"\xb9\xdd\xe3\xed\xc2\x89\x6d\xbb\xd3\x41\x53\xa7\xc5\x11\x5a\xa7\x58\x71\x25\x43\xb4\xcf\x0c\x17\xd5\x44\x17\xd5\x44\x9f\xee\x67" +
+		"\x05\x7d\x9b\x38\xab\xcd\xd8\xa9\x73\x02\xc1\x29\x73\x6c\xa6\x37\x94\xfe\x23\x2c\xcb\xce\x1b\x21\xc3\x5b\x2c\x70\xb3\x2d\xac\xcb" +
+		"\xd4\x61\xcc\x91\x99\x6f\x9c\x67\x9c\x2c\x4c\x2b\x17\xfe\xcb\xa9\xc3\x81\xdf\xc8\xac\xb3\xe1\xe7\xfd\x27\x2b\xe7\xa6\xda\x36\xad" +
+		"\xfc\x6c\xfe\xac\xb6\x83\xd9\x80\x6a\xf7\xfa\x8e\x04\x7a\x3a\xf6\xa7\x66\x25\xdf\x05\x8f\x88\xe8\xd4\xee\xeb\xd1\x2e\xeb\xca\x14" +
+		"\x31\x38\x93\xd2\x15\xfd\x34\x02\xfb\x33\xe5\x2b\x4d\xcd\xdd\x77\x38\xe8\xe9\xf9\xd0\xbd\x0e\x90\x2f\x8e\x9a\xa2\x90\x7c\xe8\x18" +
+		"\x72\x3f\x01\x2b\x3b\xce\x72\x7b\x79\xf9\x75\x62\x1b\x9a\x4a\x60\xb1\x5d\xb0\xc0\xbc\xa7\xba\x2a\x65\xd2\x13\x5f\xfe\xa0\xce\x83" +
+		"\xa5\x76\xc0\xe9\xa8\x16\xed\x42\x60\xa1\x34\x7f\x51\xba\x45\x08\x54\x68\x68\x9e\x19\x2b\xad\xc0\x79\xcd\x2c\x74\x7b\xda\x28\x1d" +
+		"\x53\x36\x94\xb9\x93\x98\x65\x86\x7f\xd0\xca\x50\x36\x5b\x8d\x45\x1a\x8d\x34\x15\x59\x7f\xe3\x2a\xe3\x65\x2b\x75\xc9\xf8\x91\xbb" +
+		"\x52\x6f\xe3\x7a\x3b\xe5\x92\x04\x9c\x90\xf2\xc3\x53\x08\xd4\x47\x08\x0c\xf4\x8a\x93\x1c\xbd\x96\xae\x44\x58\x63\x41\x45\x5e\xbc" +
+		"\x59\xd6\xde\x4c\x63\xc0\x4a\xe5\xdf\x08\xcb\x3d\x54\xe5\xb7\x9a\xf1\xde\x66\xb6\x42\xb1\x8d\x97\x0f\x9a\x24\xfc\x46\x4c\x9f\xfe" +
+		"\xd8\xa8\x1e\x7e\x02\xbd\x99\x4a\x84\x1b\x1e\x18\xe9\x7a\x1d\x61\x9c\x6e\xdc\x20\xd8\xe9\x9b\xf5\x15\x7e\x89\xfc\x22\x73\xd0\x74" +
+		"\xd0\xc7\x27\xa7\xf3\x40\x17\x92\x25\x5d\x9d\xea\xd7\x00\x53\x6d\x93\x75\x1e\x98\x45\x7c\xac\xc9\x92\xc6\x48\x3d\x16\xcc\x71\xf6" +
+		"\xf9\x1c\xb8\x69\x52\xdb\x11\xa3\xd7\x34\xff\xa4\x32\xcd\xdd\x66\x74\x20\x3a\xde\x11\xe6\x9e\x3e\x14\x98\xd3\x6d\xb6\x57\xab\x94" +
+		"\xc0\xae\x15\xb3\x93\x9d\x27\x45\x9d\x27\xf1\xe5\xab\x1f\xc2\x0b\xc7\xf8\x9e\x30\xa3\xc4\xf4\x74\x61\xea\x34\x61\xee\x31\xc9\x8a" +
+		"\x4e\xb3\xac\xad\x1a\x49\xdb\x32\xc6\x29\x60\x3c\xf0\x28\x81\x93\x82\xfd\xbe\x5e\x3b\xc5\x9d\xcb\x78\xc0\x23\x59\x04\xd2\xe4\x5a" +
+		"\x5f\x9d\x17\x46\xce\xdd\xa3\x19\xa7\xeb\xe7\xbb\xe4\x1f\x4d\x0e\x5c\x17\x66\x5f\x33\xbd\xdc\xfb\x4e\x73\x55\xdb\xc3\xdc\x92\x1a" +
+		"\xc7\x35\x5b\x61\xcb\x24\xd0\x48\x82\x03\xe7\x24\x4f\x41\x88\x9f\x82\xa6\x96\xf8\x94\x5e\xe2\xec\x17\x72\xb6\xe9\xf5\x12\x17\x59" +
+		"\x57\x95\x1c\xcf\x1d\xf0\x35\xe6\xa4\xf4\xb4\x89\x8b\x3c\xa9\xa9\xc4\xed\x49\x18\x6d\x31\xbd\xda\x26\x9d\x49\xbd\xf7\x86\x15\x51" +
+		"\x02\xdb\x7d\x73\xa3\x30\xb2\xc1\xf4\xa4\xb9\xc2\x32\xe3\x56\x8f\x6c\x31\xee\x28\x09\x49\xfe\x3d\x0e\xf5\x9d\x34\xfd\x15\x8a\x07" +
+		"\x8e\x18\x0f\x1b\x07\xd8\x15\x35\xa9\x44\x74\x83\x1b\xd7\x2e\xc7\xee\x9c\x0a\x6d\x50\x17\x22\xf4\xe9\x42\x44\x4e\x96\xb4\x3d\xee" +
+		"\xd4\xcf\xf3\x43\x84\xee\x5c\xb3\x80\x45\x75\x1e\x48\x23\x22\x77\x5c\xe4\x7b\xaa\x03\xc1\xdd\xd5\x2e\x78\x37\xe1\x9e\xa5\x56\xcf" +
+		"\x7e\xdd\x52\xe5\x49\x5a\x35\x34\xcd\xf7\x66\x61\xf5\x7e\x45\x84\xc3\x15\x49\x27\x21\xee\xbc\x8b\xd3\xfc\xde\xff\x79\xe6\xe0\xd2" +
+		"\xf3\x04\xe6\xa5\x13\x21\x7e\x24\x1d\x34\xd5\xc7\x89\xf8\xb1\x7e\x6a\x48\xfc\xb1\x61\xb4\xa7\xd7\x9d\x2a\x96\xf4\x29\xa5\xae\x67" +
+		"\x6b\x7c\xc9\xd8\xcb\xc5\x30\xc5\xbe\xf4\xcd\x23\xc2\x76\x87\x4d\xd7\xd7\x07\x82\x75\xf5\xee\x88\xb5\xf6\x6e\x48\xfc\xca\xd2\x98" +
+		"\x4e\x64\xac\x99\x15\x12\xdf\xcb\x1c\x2b\x54\xf6\x1e\x2d\x74\x8b\x35\x53\xbe\x30\xf7\xc0\x41\xff\x56\x2b\x4d\x6f\xd8\x96\xe5\x0a" +
+		"\x4c\x7a\xf3\x5c\x37\x55\x69\x90\x35\x2a\xae\x87\xec\x42\xbc\x94\x66\x38\xbf\x66\x91\xc0\xba\x0c\x82\x83\x1d\x86\x1d\xde\x7d\xb7" +
+		"\x1b\xe7\xdb\xc5\xf3\xea\x92\x1d\x31\x52\x12\xdf\xfa\x66\x06\x22\xa5\x65\xa6\x3d\x5a\x6f\x8f\xd6\x5b\xa2\x43\x58\x16\x25\x90\x67" +
+		"\x77\xcf\xb2\x98\x99\xc6\xbb\x56\xe6\x8e\xf1\xf4\x9c\xc0\x80\x05\x51\x22\x4c\xb1\x16\x97\x6d\xcc\x25\xe3\xda\x06\x9f\xc4\x1b\xec" +
+		"\x5a\xb3\x8f\x6b\x4f\x74\xb9\x66\x4b\x6b\x65\x69\xf6\x9d\xfd\xde\x99\xe5\xfd\x8c\x33\x53\x1d\x7c\xad\xd1\x6e\xf7\x74\x4f\x92\x0b" +
+		"\x42\x41\xbc\x79\xca\xa8\xd4\x75\x33\xbc\x48\xbc\x91\x1a\xe7\xfb\xee\xbc\x38\x8f\x66\x45\xd7\x4d\x6f\x2b\xbc\xe1\x5a\x3a\x3a\xac" +
+		"\xc1\x8d\x78\xba\x1b\xf1\xf4\x90\x4c\xc1\x8f\x62\x04\x1a\x7d\x73\x8f\xa7\xef\x36\xee\xaa\xf0\x9b\x3a\xd3\xc7\x84\x69\x47\x85\xb9" +
+		"\xa5\x52\x38\x1c\xdd\x9f\x7c\x2a\x7a\x3c\xfe\x54\xb4\x5a\x0e\xe2\xaa\x2a\xcb\x59\x60\xe5\x17\xb8\x23\x58\xd5\x8d\xfa\x0b\xcd\x15" +
+		"\xb5\x6d\x1d\xec\xb2\x93\xeb\x5a\x54\xf1\xb5\xd7\x6b\x4b\x42\xf2\x00\xb6\x40\x18\x1a\xe2\xf5\xeb\xf6\xb0\x4a\xc8\xf5\xee\xef\x9a" +
+		"\xb3\xd4\xd4\x4a\xe3\xbe\x07\x08\xec\x7c\x80\x08\xab\x7c\x5d\xef\x1d\xa1\xae\xca\xb4\x35\x38\x29\x8e\x75\xde\x6a\x6f\x1f\x08\xcc" +
+		"\xc1\x62\x2b\xbf\xd5\xec\x5b\x8c\xe7\x65\x7e\xbb\x73\x0d\xc9\xc7\x0e\xc4\x1f\x3b\xce\x97\x85\xe4\x11\xfc\x44\x59\x48\xfc\xe5\x67" +
+		"\x74\x95\xd5\xdf\xe7\x81\xf4\xe4\x33\x8a\x27\x32\x4e\xdf\x8c\xeb\xd6\x91\xe0\x76\x7b\x79\x99\x55\x19\x2c\x98\xdb\x70\x8f\x23\x42" +
+		"\x84\x5b\xa6\x86\x2e\x74\x77\x4c\x23\x52\x6b\x3c\xe3\x54\x6d\x50\xc3\xa8\x35\xb3\xa7\xdc\x68\x08\x0c\x89\x3a\xc9\x8e\x77\xb4\x94" +
+		"\xf8\xee\x75\xdd\x22\xae\x19\x7b\x69\x24\xf4\x8c\xef\x42\xc6\xf9\xf7\x11\x61\x9d\xf3\x72\xfc\xa4\xc4\xd1\x18\x9d\x09\x7c\x8c\x08" +
+		"\x13\x3c\x52\x6c\xac\xce\x20\xd0\x20\x98\xdc\x95\x88\xd4\xb8\x08\x16\x3a\x4f\x6f\xff\x0d\x73\x42\xf7\xfd\xf7\x9e\x39\x22\x04\x0b" +
+		"\x26\x25\x8f\xbd\x2c\x34\x3d\xa9\x29\x10\x2c\x6b\x72\x1c\x3d\xd2\x60\x9c\x23\x93\xc2\xec\x79\x76\xa4\xbb\x55\xb9\xcb\xcc\x03\x8b" +
+		"\x2c\x20\xbe\x6f\x2e\xb0\x80\x86\x26\x97\xae\x46\xa2\xdb\x85\x61\xab\x30\x6d\x53\x5c\x4d\xeb\x35\xd7\xb8\x3e\xfe\x14\x7d\x3f\xc1" +
+		"\x35\x76\xf1\x7c\x0f\x94\x66\x12\xa1\xc5\x6d\x74\x96\x07\x66\xc6\x87\xe7\x06\x86\xf4\x12\x17\xe1\x5a\xa7\xd6\x1a\xa3\xa5\x87\x2d" +
+		"\x93\xbc\xff\x19\xf7\xc7\x4d\xb3\xd3\x0a\x8d\xdb\x26\x85\xe4\x53\xfb\x42\xcd\x62\xe3\x9c\x64\x2b\x0c\x3d\xcb\xff\x92\x67\xaa\x9b" +
+		"\xc7\xcc\x4c\x02\x57\xec\xc2\x5e\x4e\xbe\x93\x2f\x12\xe8\x42\xe0\xfe\xf8\xf7\x89\x78\x8e\xe0\x40\xcf\xee\x21\x8c\x76\x37\x5d\x6f" +
+		"\xd9\x75\xc6\x89\xc6\x09\xc6\xcb\xd6\x7d\x94\x7e\x2a\xdc\xed\x65\x96\x38\x41\x16\x1b\xf7\x78\x24\x3e\x3e\xdf\xec\xf3\x8c\x67\x17" +
+		"\x05\x82\x67\x16\xd9\x24\xab\x5b\x58\xee\xfe\x66\xba\xca\xf4\xd5\xc6\x40\xa4\x5f\x69\xf4\x5e\xe6\x91\xfe\xe5\x49\xf3\x18\x37\x6f" +
+		"\xb5\x71\xf2\x43\x04\x36\x3d\x94\xc8\xa1\x3e\x1e\x1b\x53\x1e\x18\x50\x60\x7a\xb8\xdc\x15\x86\xd5\x98\x8e\xef\x8a\x1d\x08\x3c\x9f" +
+		"\xd8\x02\x52\x89\xc8\x14\x33\x96\x96\x87\xe4\x63\x42\x3f\x37\xc9\xbc\x06\x67\xb3\x94\x88\x5c\x6b\x4c\xaa\x1b\x89\xab\x5b\x29\x0c" +
+		"\xed\x89\xf8\x42\xb9\x0b\xf6\x07\x1d\x00\xf6\xb9\xf8\xe2\xcf\xd2\xc6\x05\xe6\x6b\x28\x77\xae\x98\x9e\x6b\xba\xd8\x0e\x38\x23\xcd" +
+		"\xbf\x4e\xe0\x33\x04\x1b\xef\x31\x61\x88\x6e\x2c\x76\xe6\x55\x4b\x79\xce\xad\xb1\xf3\xac\xd3\x19\xe3\xd9\x5a\xbf\x37\xad\x35\x7b" +
+		"\xb5\xe8\xc1\xd5\xce\x42\x0b\xd8\xf8\x10\x11\xc6\x79\x91\xad\xde\x63\xde\x25\x42\xab\x99\x47\xfb\x71\x72\x94\xf1\xa3\xc9\x81\x48" +
+		"\xe9\x3d\xd9\x45\xee\x89\x93\x64\x53\x5c\xd2\x11\x0d\x84\xc3\xe5\xde\x99\xa7\x08\xbd\xdb\xef\x2e\x6f\x3b\x37\x0c\x77\x9f\x18\x66" +
+		"\x3c\x24\x65\x53\x0e\x36\x79\x0b\xd2\x14\xb6\x96\x27\x4f\xa3\x61\xdd\x6c\xc7\x55\x77\x53\xaf\x34\x25\x57\x46\x3a\xc1\x81\xa6\xa6" +
+		"\x1a\xa7\x08\x73\x7b\xec\x77\x6b\x7c\x4a\xc1\x8e\xdb\xa7\xe1\xc8\xe0\xc9\x8e\x82\x63\x71\xa3\x2b\xc1\x71\x1e\xd8\x3a\xdb\x4f\xb6" +
+		"\xb3\x5d\xa6\x5e\x79\x7b\xb9\x35\xb2\xdd\x07\xeb\x92\xd2\x83\xa5\x63\x92\xe9\x32\xd3\xb7\x97\x04\x22\xfd\xd6\x12\x87\xca\xf9\xd1" +
+		"\x60\xac\xd7\xdd\xb4\xba\xc9\xee\xed\x6e\x0c\x07\x63\xf2\x85\x37\x90\xd5\x1e\xfe\x8d\x22\x64\xaf\xcc\x37\xfa\xaf\x3c\x26\x07\x06" +
+		"\xec\xb3\x81\x7b\x8d\x43\x9d\xf4\xf9\xc6\x8d\x5e\x78\xc3\x64\x97\x87\xd5\xda\x6d\xdc\xea\xf1\x2d\x93\x5d\xf1\x0e\xc8\xfe\x78\x40" +
+		"\x26\xfb\xd9\x3a\xce\x6f\x7a\xf7\x3d\x1e\x14\x75\x21\xc2\x0d\xa7\x42\x0f\x2b\xd0\x59\xcf\x6f\x2f\x30\x87\x83\xf7\xba\xb5\xd9\x67" +
+		"\xc7\x27\x7b\x03\x35\xc3\xdf\x13\x1c\x21\x2a\xf4\x9a\x92\x78\x85\x8d\xeb\x0f\x13\xb8\x64\xfb\xd7\xda\xb1\x4b\xbb\x12\xe8\x33\x25" +
+		"\x79\xd6\x67\xfc\x2d\xc3\xc4\x49\x81\xc8\x98\x30\x29\xf9\xe6\x1c\xf1\x37\xe7\x23\x7d\xd7\x52\x31\x60\x8a\xbf\x64\x33\xbd\xdc\x4f" +
+		"\xf0\xcd\x53\xdd\x70\xa7\xba\xe1\x4e\x0d\x0c\x01\x5a\xc8\xcd\x31\xd1\x22\xcd\xf1\xbf\x89\x54\x58\xea\xb9\xbd\xdc\x57\x56\x64\x10" +
+		"\xac\xf1\xc0\xfa\x29\xce\x21\xd3\x2b\x85\xd1\x15\x1e\xa9\xf7\xe4\x2f\xe8\x84\x68\x6a\x78\xa3\x7b\xdb\x13\x7a\x5f\xb0\xc7\xe9\xe0" +
+		"\x29\x27\xba\x10\x68\x6a\xb4\xeb\xdc\x0f\xf6\xd7\xb8\x04\x2b\x84\x63\x8d\x83\x2a\x7c\xf0\x34\x7d\x44\x93\x22\x87\xa7\x98\x47\xaa" +
+		"\x44\xc7\xce\xf5\xc6\xe7\x91\x1b\x71\x77\xf8\x79\x7c\xc2\x54\x3f\x52\x39\xd0\x9b\x8c\xbd\x34\xd2\x2e\xae\xcd\x09\x49\x0b\xc7\x2b" +
+		"\x1c\x2c\x8f\x14\xde\x73\x47\xca\x6c\xd3\xb6\x20\xd4\xc9\x35\x18\xe0\x3c\xeb\x6f\x2c\x96\x86\x91\xb1\x35\x3e\x2f\x3b\xc6\x17\x1a" +
+		"\x92\x6e\x0d\x71\xb7\x9e\xb5\x03\xe7\xa6\x13\xa8\xf1\x37\x2d\xed\xf5\x5f\x40\xaa\xfd\x41\xda\xde\xc0\x9c\x8c\x99\xf6\x41\xdf\x66" +
+		"\x45\xa2\x4f\xb3\x8b\xce\x4e\xe8\xdd\x98\x7c\x81\x81\x1d\xed\x88\xb0\xd3\xdb\xd7\x47\xb3\xfc\x8a\x7b\x96\x37\x31\xb3\xdf\x31\xee" +
+		"\x6a\x4a\x3e\x2b\xf5\xf4\xcd\x7e\xc6\xdb\xed\x24\xca\x5a\x8d\x74\x91\x8e\x30\xf6\x5a\x2c\xbc\xd2\x81\x60\x7f\xf3\x2d\x93\x41\xa9" +
+		"\x4b\xe7\x39\xa3\x9c\x68\x25\xc6\xd3\xc6\xb9\x8f\xca\xda\xc7\x88\xb0\x69\xa9\xb7\x10\x8f\x4e\x49\x21\x50\xd0\x18\x18\x50\x3a\xab" +
+		"\x2d\x55\xd5\xac\x27\xf8\x6a\xcc\x7c\x3f\xd0\xce\xf7\x0e\xa0\x91\x50\x3e\xcb\x9a\xda\x5f\x3b\x8d\x5b\x8d\x55\xa9\x89\x4e\x9f\x4e" +
+		"\x20\x4a\x70\x8a\x19\xa7\xce\x72\x3b\x30\x7d\xd9\xcd\xe2\x92\x71\xad\x47\xd6\xc4\xc7\x6d\xf6\xd2\x6c\x22\xf7\xfa\x27\x99\x83\xfe" +
+		"\x8f\x10\xdc\xef\xc1\x59\x62\xe0\x4c\x21\x6a\x93\xfa\x5d\xef\xac\x3f\xd3\xb0\xcb\x86\xd8\x96\xc1\xc6\x85\x5e\xab\x71\x96\x2b\x64" +
+		"\xb9\x50\xff\x5f\x21\xac\xf2\x82\xc7\x34\xfc\xe4\xd1\x59\x81\x01\x7d\x15\x3a\xf6\xa9\xb6\x85\x0b\x7c\xcc\x5e\x90\xec\x4b\x29\xf1" +
+		"\xbe\x34\xc6\x3e\x2e\x30\x6e\xb3\xb0\xb1\x72\x4b\x4d\xfc\x8c\xa2\xe1\xb4\xed\xb5\x4e\xac\x66\xff\x12\x7c\xbf\x5e\x55\xee\xf6\xd2" +
+		"\x72\x2c\xfb\x2f\x71\x23\x72\x15\x6c\xa8\x71\x7a\x48\xc9\xf4\x3e\x4b\x7d\x46\x74\x34\xf7\x1a\x87\x2a\x27\xa2\xf9\xf5\xf6\xab\x45" +
+		"\xee\x34\x96\x2e\x0a\x44\x28\x59\x64\x17\x19\x2f\x19\xb7\x4d\x96\x9c\x96\xc9\x4e\x10\x6b\x9f\xb7\xc0\xbe\x32\xce\x34\x8e\x59\xe1" +
+		"\x0c\xd7\x81\x61\xa0\x85\xcd\x70\xb2\x0c\x26\x11\x1d\xd7\xd4\xf6\x4a\x62\xbe\x6e\x45\xe6\xd5\xda\x24\xd9\xd8\x65\xbb\x30\xf7\xc4" +
+		"\x1e\x6d\xb1\x55\xb6\x66\xb9\x74\x6c\xdf\xdc\xe0\xda\xaa\xf4\x99\xaf\xd2\x75\x69\x9c\x60\x2c\xad\x74\xfb\x32\xbd\x4f\x0b\x65\xee" +
+		"\x5d\xec\xb9\x95\x6e\x4d\x95\xae\xf5\x1a\x7f\x4f\x50\x13\x12\x5f\xe2\x1f\xd0\x4a\xe9\xfb\xbd\xde\x1e\xaf\x37\x61\x77\xb2\x57\x74" +
+		"\x88\xf7\x8a\xe9\x9a\x19\x9d\x56\xe9\xe8\x0b\x43\x8d\xe9\xcd\xba\x1b\x36\x55\x38\x66\x1e\x69\x30\x1e\x72\xb9\x95\x52\xaf\x0b\xed" +
+		"\xaa\x7e\x72\x43\x34\x6f\x85\xdb\xd9\x9c\x40\x84\x15\x73\x92\x34\x56\xcc\x49\x9e\x08\xa3\x3a\x11\x86\x0e\x20\x30\xc7\x42\x66\x1b" +
+		"\xab\xed\xcc\x1e\x59\x04\x1a\xd3\x92\x01\xa8\xf6\xbd\x7e\x24\x70\xab\xa3\xd4\xc9\x26\x90\xef\xc1\x21\xee\x5f\x83\x8d\x33\x9c\x2a" +
+		"\x55\x4d\xc9\xb7\xf1\xb9\x17\x3f\xc5\x1c\xd4\xfc\x9f\xb6\xcf\xc3\xcf\x52\x31\x90\xf0\xed\x24\x38\x6a\x95\xa3\x6b\xcf\x8d\x26\xc1" +
+		"\x56\xa7\xca\x4a\x4d\x8d\xac\xa8\xb2\xe1\x55\x36\xdc\xf4\x6a\xeb\xb6\x6a\x41\x72\x3c\x25\x3e\x7e\x73\x61\xd0\x76\xb5\xd0\x9d\x43" +
+		"\xee\x4d\xb9\xb5\xdc\x5d\x20\xfe\xf3\xe0\x03\x44\x88\x77\x9b\xd1\xce\xd0\x51\xc6\x7e\x3e\x2f\xe4\x35\xb9\x52\x74\x37\x7a\xb4\xd9" +
+		"\x25\xe5\x91\x49\xed\x18\xd0\xf2\xb0\x3e\xd9\x9b\xd1\xf6\x23\x0e\x30\x2b\x9b\x60\xbc\xd9\x17\x5b\x8b\xfe\x32\x2a\xfe\xd3\x4e\xf5" +
+		"\x6a\x1f\xc0\x56\xbb\x05\x48\x12\xa7\x34\x7b\x43\xd3\xdd\x30\x6e\x46\x92\x46\x9c\x3e\xbf\xd2\xaf\x97\x56\x7a\xfd\x35\xc2\xa6\x47" +
+		"\xdb\x7e\x55\xc9\xbd\xbe\xdb\x47\xe8\x87\x09\x54\x74\x50\x80\xbb\x12\x68\x5e\xe6\x0e\x1f\xff\x1d\xe3\x3e\x7d\xc2\x6c\xc7\xed\x91" +
+		"\x13\xa3\xbb\x85\x61\xfd\x9f\x9d\xf5\x6b\xfd\xc8\x5f\x63\xbc\x39\xcd\x1e\x32\xbd\xd9\xd1\xdf\x34\xc7\xc5\x27\x8c\xed\x34\x3d\xda" +
+		"\xbe\x1f\x65\x3c\xe1\x91\xe3\x73\x92\x74\x7a\x9c\x9e\x6f\x23\xe7\xad\xb6\x77\x5d\xe2\xad\xc6\xa9\xfe\x41\x6e\xca\xf4\xb6\x5d\xf8" +
+		"\x62\x4d\x48\x7c\x25\xdb\x5a\xf3\x97\xbc\xeb\x2d\x63\xdd\xea\xe4\x09\x18\xf1\x13\xb0\xe7\xc4\x2e\xd4\xdc\xab\xdd\xca\xcc\xc4\xdf" +
+		"\x3b\x44\x76\xfa\xfa\xa2\xb3\xfa\x82\x71\xea\x3a\xaf\xb8\xce\x2f\x2f\xed\xad\x3e\xbb\x9c\x8e\x2e\xed\x45\x31\x02\xeb\x9c\x10\xfd" +
+		"\xd6\xb8\x5f\xac\x71\x2a\xd4\x86\xc4\xff\x3e\xc2\xb1\x98\x7e\x23\xf6\xe8\xc5\x46\x7f\x60\x15\x0f\xab\x38\xc2\xd8\x5a\x1b\xbb\xd2" +
+		"\xc6\xae\xb4\x13\x7c\x02\x3d\xde\xe4\x28\x4a\xf5\xb4\x71\xab\xbd\x13\xd4\xfa\x7c\x58\xeb\xda\x17\x46\x9a\x4d\xef\x33\xee\x35\xce" +
+		"\x71\x32\x1e\xcc\x22\xb8\xce\x03\x9b\x8d\x9b\xee\xf5\xd2\x50\x67\xdc\x56\xdb\x76\xf8\xed\x91\x4d\xa0\xbb\x7a\xd9\x86\xda\x64\x8c" +
+		"\x31\x36\x46\x84\xc6\x78\x80\x5c\x22\xc7\xab\xbc\x57\x1b\xcf\x18\x8f\xad\xf3\xb3\xf9\xba\xe4\x83\x75\xba\x1f\xac\x73\x07\xc8\x45" +
+		"\x1d\x37\xec\x74\x1f\xb3\x3e\x53\x52\x13\x75\x1e\xae\xd9\x0b\x37\xed\xe2\x1b\xc6\xeb\x9e\xdf\x9c\x45\x64\xcc\x74\xfd\x9f\x5e\x1f" +
+		"\x88\xac\xd6\xf5\xa6\xed\xa8\xc5\x29\x9a\xa3\xdc\x9c\x60\xdd\xaa\xad\x67\x8f\x4e\xc9\x1a\x98\x6e\x63\xd2\x09\xcc\x8d\x9b\x60\xbd" +
+		"\x77\x1b\x6b\x5d\x81\x35\xc6\x05\xbe\xdb\x60\x3c\x61\xcd\x6a\xda\x13\xe9\x55\x1e\xd8\x25\xd5\xb8\x73\x8d\x4b\x50\x71\x4f\xcd\x17" +
+		"\xe6\x1e\xd9\xa5\xb7\xbe\x15\x36\x67\xb5\xd7\x5f\x65\xbc\x2c\x0d\x79\x69\xbd\x5b\xa3\x24\xc4\x0e\xd6\x3a\xc4\xc6\x21\x59\x04\xfa" +
+		"\x67\xe9\xeb\x0d\x5f\x5f\xad\xf5\x17\x1b\xa6\x67\x69\xe9\x0e\x33\x97\xfb\x20\xe7\x93\xf3\x7e\x63\x69\x5d\xdb\xcb\x98\x42\x53\x47" +
+		"\x64\x40\xf4\xf0\x52\x9f\x3e\x3c\x72\x31\x83\x40\x7e\x26\x43\xb4\xcc\xd7\x83\x9c\x30\x03\x8d\xf3\xdd\x25\xba\x27\x1e\x07\x43\x3b" +
+		"\x02\x6b\x33\xf5\xcc\xd7\xec\x4a\xa8\x73\x25\xd4\xdd\x7b\x90\xda\x62\xac\xad\xf3\xff\x10\x33\x3d\xc9\xb6\x97\x19\x6f\x2b\x38\xe1" +
+		"\x96\x30\x77\x8e\x3c\x80\xee\xed\x09\xe4\x75\x24\xd8\x6a\xee\xe5\x4d\x3e\xc2\xc5\x9b\x9a\x35\x38\x6a\xdc\x58\x97\x78\x95\x11\xd9" +
+		"\x64\xea\xb2\x30\xfb\x92\xe9\x5d\x9b\x02\xd1\x71\xe7\x26\x7b\xc8\x0f\x3a\x83\x8d\x03\x7c\xaa\x38\xd0\x59\x51\xbb\x2f\x3e\x3b\xaa" +
+		"\x3b\x21\x03\xba\x2b\x9e\x4e\xfd\x67\xfb\x58\xe6\x2d\x6d\x74\x67\xe6\x44\xf7\xee\xb0\x33\x35\x9c\x3e\x73\xb6\x73\xc9\xbf\x65\x5d" +
+		"\x8b\x1f\x7b\x3c\x72\xc9\x38\x79\xa3\xb0\xdc\x58\x1c\x4f\x6f\xe3\x1c\xb9\x2c\x32\x7b\x81\xf7\x10\xe3\x62\xe3\x0e\x65\x4b\xf6\xf6" +
+		"\x25\x6e\x0c\x73\xfc\xf5\x47\xbc\xcd\x19\x6b\x8c\x43\x97\x06\x86\xfb\xf2\x1d\x9c\xd1\x6b\xfc\xb5\xdc\x9a\xe4\xcb\x84\x70\x29\x7e" +
+		"\xd2\x16\x46\x6e\xcc\xf5\xb3\xa0\xd3\xa8\xd9\x3a\xf7\xab\x16\xe6\x55\x27\xe9\xf4\x38\x5d\x64\x2c\x34\x0e\x37\xee\xe9\x46\x40\xff" +
+		"\x3b\x2e\x36\xca\xd7\xc5\xd5\xee\x19\xa6\x47\x1b\xf5\x6f\x07\xe2\xd7\xd5\xd5\x6d\xe9\x53\x65\xaa\xbe\xba\xed\x7d\x59\x46\xc1\xf2" +
+		"\xb6\x43\xc9\xa2\xea\xc4\x2f\x11\xd1\x35\xa6\x96\x1b\x9b\x8d\xe3\x5d\x8a\x9b\x1e\xd0\x1f\x7e\xc6\x7f\x85\x32\x36\x19\x77\x54\xfb" +
+		"\xc3\xb7\xf8\xa3\x79\xb5\xdf\x7c\x3f\xc5\x1c\x94\xd8\xa0\x6d\x1a\x60\x4b\xb5\x6b\xa1\xba\xed\x75\xd8\xce\x7b\xd7\xe1\x60\xfc\x49" +
+		"\xdc\x78\xf7\x41\x02\x07\x55\x38\xe1\xac\xa5\xb6\x56\xbb\x22\x85\x3c\x6e\xfa\x54\xb5\xbf\x73\x31\x3d\x74\x9e\x30\xdf\x78\xda\xe3" +
+		"\x71\xfe\xbe\xf3\x12\x0b\xf1\x4e\xdc\x93\xf3\xdc\x7d\xe7\x39\xb9\x8c\x83\x8d\x83\x8c\x1f\xe9\x3f\xd5\xe4\xaf\x6e\xf3\xc3\x78\x33" +
+		"\x8f\x9b\xe7\x7a\x30\x6e\x31\x16\x7b\x7c\xac\xe9\x89\x46\x12\x8c\xf3\x55\x0a\x59\x61\xfa\xd4\xbc\x90\x78\xff\x92\x46\x60\xca\x9f" +
+		"\xf1\x4f\x30\x9e\x30\x1e\x37\x9e\x35\x9e\xb9\x27\x23\xf7\xea\xc7\xf4\xbe\x77\x7b\xf2\x2d\x5e\x4a\xfc\x2d\xde\xfc\x79\xde\x05\x4c" +
+		"\x2f\x32\x0e\xd5\xff\x4c\xdc\xd0\x92\x7c\xfe\xc0\xd2\x79\xf7\x1e\xe5\x57\x18\xd7\x1a\xb7\x3d\xa0\xe2\x7f\x40\xaf\xdf\x7d\xc0\xbe" +
+		"\xe9\xd1\x1b\xf3\x92\x07\xef\x58\xfc\xe0\xbd\x4f\x23\xd1\xbd\x71\x29\x1a\x49\x5f\x31\xdf\x3a\x1a\xcf\x18\xb7\xce\x0f\x0c\x8f\x6f" +
+		"\x11\x9d\xdb\x77\x67\x60\x4e\x58\x63\x55\x97\xb9\xe1\x2f\x5d\xe7\x3a\xf4\x2b\xfd\x9e\xf1\xf7\x85\xcb\x64\xc2\xba\x65\x0e\xc2\x56" +
+		"\x61\xff\x18\x81\x5b\x66\x1c\x2e\x96\xf6\xc3\xea\xdd\xa7\xeb\x13\xad\x01\x55\xa6\x76\xac\x0c\x44\xbb\xed\x2b\x9d\x88\xab\xa5\x58" +
+		"\xf3\x6a\x0b\xf4\xb6\xbb\xce\xb8\xd2\x9c\x2b\xea\x93\x74\x58\x51\xdf\xb6\x75\x6d\xf3\x75\x4b\xbd\x1d\x6e\x29\xc7\x8d\x7d\x57\xf9" +
+		"\xe3\xb3\x55\xae\x20\xdf\x25\x91\xbe\xdd\xd4\xd5\x2d\x81\xe0\x95\x2d\x6e\x55\x5b\xfd\xfc\xb0\xd5\x75\x5b\x1f\x38\x3e\x64\x5d\x32" +
+		"\xd3\x80\xcd\xc2\x9e\xf7\xc7\xeb\x2b\x75\x60\x43\x68\x7b\xe5\x8f\x13\x0f\x11\xe1\x86\x23\x52\xdc\x90\x48\xc0\xd4\x11\xa6\x4a\x8d" +
+		"\x25\xc6\x23\x4e\xef\xc3\xeb\xdd\x57\x35\x12\xca\x1a\x9c\xc0\x72\x4a\xfb\xd6\x75\x6d\x79\x78\xd5\xa3\x57\x8c\x1b\x85\x69\x1b\xe2" +
+		"\xcb\x34\x78\xd3\xbd\x47\xe3\xcf\xe8\x54\xd1\xe3\x43\x00\x81\xeb\x1a\xe0\xb5\x06\x37\xed\x06\x7f\x8f\x63\xba\xb2\x59\x02\x2a\x9a" +
+		"\xdd\xdb\xa4\x6e\xca\xe2\x16\x77\x0d\x37\xe2\x1e\x11\x82\xa3\xdc\xec\x26\x19\xcb\x8c\xa5\xc6\x12\x63\x3f\xb1\x67\xe5\xb5\xb8\xf1" +
+		"\x69\x84\x35\x0b\xfe\xb2\x99\xc6\x1f\xdd\x3f\xa5\x2f\x73\x17\x04\x06\x42\xce\x6f\x4c\x54\x46\xea\x3a\xdf\x3d\xb0\xc0\xaf\x2a\xee" +
+		"\xd1\xd0\xbf\xd6\xeb\x63\xae\x63\xbe\x1e\xd0\x9e\xc0\x40\x7d\x98\x78\x70\x41\x68\xfb\x13\x5a\x8b\x49\x8d\xb3\xed\x6a\xf4\xb7\x53" +
+		"\xa6\xa7\x1b\x3b\x10\x28\x34\xb5\xb1\x31\xf1\xcd\xc3\x03\x5b\x1a\x93\xf9\xc6\x78\xbe\x9d\x8e\x6f\x54\x24\x10\x6f\xf2\xc3\xb7\x39" +
+		"\xb1\xd2\x09\x2c\x5e\xdb\xf6\xdd\xc1\x0c\xc5\xbf\x5d\xd5\x56\xfb\x65\x8b\xbf\xe5\xd9\xe2\xdd\xc4\x53\xae\x59\xa5\x08\x94\xff\x12" +
+		"\x1a\x59\x1a\x7f\x94\x14\x66\xc7\x5f\x38\x1c\x59\xe5\x87\xaf\x55\xf6\xce\x2e\xf1\x94\xef\xf2\x69\x41\x98\xb2\x6a\xd7\x5f\xc6\xb0" +
+		"\x52\x18\x2a\x1a\x92\xbf\x92\x04\xff\x4a\x12\x46\xcb\x80\x94\x11\x8d\x6d\x9e\x9c\xe4\xeb\xb2\x46\xf7\x4c\x71\x74\xb9\xe3\xb8\x77" +
+		"\x04\x81\xa9\xd6\x71\x45\x8c\xe0\xde\xb5\x81\xc0\x48\x6f\x45\x45\xea\xad\x55\x7b\xdc\xce\x17\x4a\x95\x71\x0b\xdd\x9a\x84\x61\xc2" +
+		"\xff\x43\x4f\x37\x52\xc6\x29\xd9\x5b\x1d\xed\xd3\x8e\x58\xeb\x02\xeb\xba\xd0\x3d\xef\xcf\x98\xa7\x19\x67\x09\xa3\x33\x17\x26\x9d" +
+		"\x16\x89\x3b\x6d\xa1\x47\x1a\x17\x86\xc4\x9f\xaa\x0e\x95\xc7\x43\xfe\x36\xd7\x8b\x5c\xcc\xb1\x5b\xdd\x4b\xec\x95\x11\xf7\x13\xac" +
+		"\x5a\x9a\x74\x5c\x6a\xdc\x71\x45\x33\x49\xa0\x24\x7e\xc8\xee\x25\x6c\x5d\x24\xc3\xb1\xf5\x5a\x20\xbe\xdc\x3a\x98\x0c\x8f\xe3\xff" +
+		"\x1b\x00",
+	"/GBK-EUC-V": "" +
+		"\xe2\x74\x77\xf2\xd6\x75\x0d\x75\xd6\xf5\x60\x76\x77\x32\x64\x10\x61\x5a\x74\x98\x89\xe1\x1f\x07\x13\x03\x03\x33\x13\x1b\x83\x1e" +
+		"\x13\x03\x63\x53\x3e\x13\x03\x43\x6b\x3e\x13\x0b\xaf\x32\x93\x1d\x43\x4f\x3e\xd3\x22\x66\x86\x8d\xf9\x4c\x6c\x8c\x4c\x4c\x4c\x0c" +
+		"\x82\x4c\x8c\x0c\xbb\xf2\x99\xb8\x19\xb7\xe7\x33\x31\x32\x6c\x01\x11\x9b\xf3\x99\xa4\x19\x36\x81\x58\x0c\x4c\x8c\x0c\xbd\x20\x1e" +
+		"\x27\x98\xc3\xc0\x30\x29\x1f\x30\x00",
+	"/GBK2K-H": "" +
+		"\xec\xbc\x77\x78\x55\xd7\x99\x3e\xfa\xae\xef\xec\x73\xa4\x83\x24\x44\xc7\x1d\x88\x4d\x1c\xdb\x99\xd8\x4e\xe2\xc2\x64\x92\x89\x9c" +
+		"\x3a\x76\x5c\x26\xf3\x73\x9c\xc4\x96\x33\x89\xdb\x8c\x9d\xd8\xc9\x24\x99\x92\x4a\x47\x20\x04\x02\x84\x50\x41\x42\x02\x49\xa8\x20" +
+		"\x81\x90\x04\x6a\x08\xd1\x44\x17\x55\xa2\x83\xe8\x4d\xf4\xde\xef\xf3\xbe\xe7\xe8\x90\xeb\xfb\x3c\xf7\xfe\x9e\xe7\xde\xff\xae\xff" +
+		"\xf0\xeb\x6f\xaf\xfd\xad\x6f\x7d\x7d\xad\xbd\xcf\x46\xf0\x7d\xef\x85\xa7\x7d\x0e\x7f\xf5\x86\x3d\x35\xec\xa9\xdb\x43\x6e\x0f\xb1" +
+		"\x61\xdf\xbc\x7d\xbb\xe3\x51\x37\xf0\x6d\xb3\xe6\xe1\xf6\xfc\xd9\x6c\x67\xc0\x99\x07\x0c\xde\xe9\x07\xcc\xf9\x61\x40\x5b\x3f\xc3" +
+		"\x7b\x5b\xfb\x19\x30\x63\xa0\x21\x2a\x77\xa0\x01\x3b\x1e\x32\x44\x6d\x7f\xc8\x12\x7a\xc1\x80\x49\x01\x43\x5c\x6a\xc0\x80\x1b\xf7" +
+		"\x1a\x7a\x5c\xbf\x97\x32\xfa\x52\x46\x5f\x03\x0e\x0f\x34\xb8\x43\x9c\xb5\xe9\x51\x03\x36\x3e\x6a\x0e\xdb\x1e\x31\xb8\xf6\x47\x0c" +
+		"\xc8\x19\x60\x88\x99\x3e\x80\x73\x07\x18\x7a\x5f\x27\x55\x75\xbf\x61\xd0\xfc\xfb\xb9\xf6\x17\x0c\x58\xd0\xc3\xd0\x6f\xfa\x3d\xbc" +
+		"\xd1\xdf\x80\xf9\x84\x86\x87\x0c\xc8\x8c\x31\x44\x6d\x7e\xcc\x80\xfd\xd1\x06\x5f\x47\xb4\x01\xc9\xf7\x19\x7c\xe3\xee\x33\x60\x19" +
+		"\xe7\x1e\xeb\x61\xc0\x62\x67\x40\x3a\xb5\x5a\xf6\x90\xc1\x96\x3e\x64\x09\x80\x01\x25\x03\x0c\x56\xcc\x15\xb7\xf5\xa7\x3e\x14\x3c" +
+		"\xe9\x11\x83\xa5\x52\xb3\x1d\xfd\x0d\xfe\xed\x1c\xdb\x46\xbe\x76\xf2\x4d\x1b\x68\xf0\xa7\xd3\x96\x5b\x0f\x18\xfc\x37\x1f\x30\x60" +
+		"\x17\x99\xd7\xc5\x1a\xba\x8d\xa7\x8e\x2b\x07\x1b\xbc\x96\xc1\x61\x33\x20\x33\x16\xf2\xc6\x02\x42\x25\x2f\xe7\xdd\x1f\x5a\xc8\xa1" +
+		"\xc4\x19\xbc\xda\xc1\x61\x16\x27\x96\x95\x04\xbf\xc1\xad\x21\x71\x3c\x68\xf0\x1f\x0b\xd2\xb2\xc7\x0d\xd1\xe3\x1e\x37\x60\xf6\xfd" +
+		"\x86\x40\x11\x85\x9c\x7b\xcc\xe0\x3b\x4b\x0f\xdc\xe0\xfc\xeb\x9c\x71\x29\x3c\x1f\x41\x43\xf4\x50\x5a\x7d\xf2\x0b\x86\xb8\x13\x74" +
+		"\xc7\x98\x47\x0c\x4f\x8c\x7e\x24\x2c\x2d\x6a\xdc\xe3\x96\x10\x0f\x4a\xfc\xbc\x61\x40\xd1\xe7\x0d\x18\x7b\xbf\xc1\x25\x51\xf6\xb6" +
+		"\x07\xe8\x13\x5a\x98\x1d\x6b\x08\x64\xc5\x9a\x73\x30\x60\xd4\xe3\x86\x6e\x23\xa9\xc7\x85\xc7\x0c\x76\x9e\xab\x37\x70\xc2\xc1\x1e" +
+		"\x86\xe8\xe9\x5f\xa4\x9b\x79\x63\x29\x6f\xac\x66\xc8\xd7\x39\x03\xae\xf9\x0c\x10\xf3\x36\x32\xef\x8f\xa5\xbd\xd4\x69\xc4\x63\x86" +
+		"\xfb\x86\xf3\xc6\xec\x2f\x18\x62\x8b\x38\x36\x8b\x52\x66\x12\x4e\xd1\xd9\x9d\x84\x4b\x0c\xc0\x45\xc2\xa5\xcf\x51\xb2\x67\x70\x8d" +
+		"\x14\x7f\xf0\x73\x86\x98\x03\x1c\xcb\xbc\xdf\x60\x19\x14\xbf\xec\x51\x83\x5b\xca\xbb\x77\x1e\x36\xc4\xde\x7e\xd8\x12\x7a\x80\xa6" +
+		"\x3c\x68\xc0\x86\x38\x66\xc6\xe3\xe1\xcb\xe8\xac\x07\xc3\x51\x0c\xec\xa4\x67\x66\x0f\x32\xf4\x2f\x1a\x64\x2e\x00\x6a\xc7\x8c\x19" +
+		"\xce\x6c\x5b\xf6\xa4\xc1\xbf\xf4\x49\x03\x0a\x07\x19\xac\x60\x10\xb3\x80\xca\xde\x24\x64\x3f\x44\x49\xe4\xbb\xf5\xa4\xa1\xfb\x4d" +
+		"\xf2\x25\x3f\x69\x78\x69\xdc\x93\x96\x10\x84\x01\xf9\x5c\x2b\x8f\x6b\xdd\x19\x64\xe8\x75\x9b\xf3\x77\x3d\x68\xe8\xbb\xf3\x41\x73" +
+		"\x7d\x61\xc0\x96\xc1\x06\xb7\x79\x70\x58\x6e\x40\x72\x17\x3d\x62\x78\xb4\x91\x7a\x6d\xe3\x65\x3b\xa1\x8a\xce\x9a\x4f\xc8\xf9\xb2" +
+		"\xc1\x4d\xff\x32\xa9\x47\x0c\xc1\xe9\xca\x5b\xde\x68\x8d\x32\x58\x12\x7d\x52\xf9\x84\xc1\x3f\xef\x09\xde\xf8\xbc\xc1\xdb\xce\x20" +
+		"\x57\x0d\x30\x78\xf3\xe9\xca\xb5\x03\x0c\xc1\x35\x03\x2c\x01\x67\x1e\x35\xd8\x69\x7a\x6c\xd3\x00\x83\x6f\x23\xef\x9e\xa3\x8f\xce" +
+		"\x12\xf6\xf1\x32\xa5\x8f\xc1\x9d\xf8\x0a\xb3\x72\x80\x21\x70\x8c\x63\xeb\x9f\x32\xb8\x75\x4f\x31\xc0\xbc\xdc\x12\x60\x7e\x13\x32" +
+		"\x18\xb3\xb1\x84\x24\x42\xf6\x40\x83\x97\x45\x6a\x62\x1f\x43\xaf\x09\x7d\x58\x97\xec\x0c\x4d\x1c\x5b\x42\x68\x1e\x68\x0e\x30\xa0" +
+		"\x8e\x57\x34\x40\xf7\xca\x09\x73\x08\x2b\x08\xcb\x45\x3d\x61\x70\xcb\x69\xd4\x2d\x1a\x3f\xe7\x21\x83\xaf\x95\x22\xf7\x0d\x34\xf8" +
+		"\xf6\x92\xa5\xed\x51\x83\x6f\x2b\xed\xd9\xcf\xa5\x3b\xd4\x80\x06\x1a\x6c\x23\xa9\x19\x5f\x34\x74\xcb\x65\x82\x65\x33\x0e\x59\x84" +
+		"\x61\x5f\x32\x78\x43\xbf\x44\xc5\xfa\x70\x71\xca\x1b\x3b\x28\xdc\xdf\x90\x41\x51\x23\x06\x19\x02\xc3\x39\x96\x43\xf0\x0c\x38\x1c" +
+		"\x60\xe1\x69\x9d\x87\x0d\xae\xe3\x61\x96\x30\x6f\x36\xc6\xd2\x76\x76\xc0\x33\x7d\x0c\xbe\xd3\x14\x77\xf0\x11\xc3\xfd\x07\x14\x4d" +
+		"\xb2\xa4\xf4\x34\xe0\x00\x2d\x58\xc2\x42\x6e\x7e\xcc\x12\xb0\x8b\x01\x32\x96\x1e\x89\x33\x0c\xe6\x69\xc2\xa9\xc7\x0c\xcf\x75\x3e" +
+		"\x66\x6e\x08\x68\xf6\x23\x86\x1e\x37\x29\x68\xcf\xa3\x86\xc0\x6e\xae\x7f\xe5\x2b\x06\x77\x99\xe1\x69\x18\x6c\x08\xd4\x0f\x0e\x53" +
+		"\x9e\xa8\x25\x83\x0d\x31\xcd\xa4\xca\x94\xf9\xf1\xf4\xe0\x10\x86\xec\x79\x76\xd1\xe7\x2d\xa1\x1b\x0c\xc8\xe4\xfc\x52\x16\x48\x27" +
+		"\x79\x93\xa9\xc4\x38\x35\x86\xcf\x33\xa3\x48\x9d\xfc\xa2\x21\xea\x04\x7d\x57\x46\x28\x25\xe4\x7c\xde\x10\x3b\x9d\x77\xb3\x87\x18" +
+		"\x2c\x8b\x82\xcf\x3c\x69\x08\x9e\x66\x21\x94\x3d\x6f\x70\xa5\xcf\x9b\xd3\x12\x87\x59\xe6\x87\x98\xc8\x85\xcf\x1b\x7c\x05\xcf\x33" +
+		"\x79\x9e\x33\x04\xae\x3e\x47\xdf\x31\x97\x5a\x08\x4b\xbe\x6a\xf0\x37\x7f\xd5\x80\xba\x2f\x19\x82\xb5\x8c\xcc\x92\x2f\x1a\xac\x99" +
+		"\x2b\x4e\xf9\x6a\x97\x8f\x3d\x83\xb5\x2a\x3a\x9c\xbf\xd1\x67\xc0\xf6\x18\xd6\x0a\xc7\x76\x3d\x6a\xf0\x76\x92\xda\xf3\x35\x83\xdb" +
+		"\xfd\x35\x8e\x3d\x66\xf0\xef\xa4\x53\x2b\x9f\x33\xb8\x79\x9c\xb6\xed\x49\x43\xff\x76\x2a\x7b\xe9\xcb\x86\xe0\xc5\x2f\x87\xf7\x88" +
+		"\xa9\xcf\x19\xfa\xa5\x91\x63\x14\x35\xbe\xc1\x88\xdd\xa6\x75\xd7\x9e\x35\xe0\x2a\x61\xed\x10\xc3\xbd\x6b\x38\x96\xf9\x84\xc1\xcb" +
+		"\x78\xc2\xdc\x3b\xa0\x25\xcf\x1a\xee\x6d\x79\xd6\x12\x7c\xb2\xfa\x6b\x06\xdf\x21\x2e\x3f\xed\x0b\x86\x27\xd3\x29\xed\xdc\x17\xd8" +
+		"\xbe\xbf\x60\x2e\x06\x06\x2c\x7c\xce\xd0\x63\x01\x97\x5a\xf4\x98\xa1\x77\x23\x15\x3c\xfa\x98\xc1\x3b\x42\xea\x20\x33\xe4\x00\xa9" +
+		"\x4b\x8f\x19\x02\x17\x49\xdd\x21\xdc\x26\x5c\x23\x94\xf7\x34\x04\x0b\xb8\xc4\x98\xc7\x0d\x81\xd1\x8c\x73\x26\xfb\x7c\x86\x76\x8d" +
+		"\xc7\x0d\x5e\x11\xa9\x33\x4f\x33\x1f\x9f\xa6\xa8\x67\x0c\x71\x17\x9f\x09\xab\x78\xf4\x71\x43\xf0\x08\x39\x16\x3d\x63\x08\x34\x3e" +
+		"\x63\x40\xcd\x57\x0c\xbe\x6a\x26\xc5\x8e\x21\x86\x41\xdb\x87\x84\x83\x38\x8d\x51\x4b\x27\x8c\xa2\xc6\x99\x66\xc0\x75\x67\x40\x9e" +
+		"\x0c\x60\x70\xf6\x45\x19\xfc\x59\xff\x40\xd7\x27\x70\x7d\xc7\xda\xf9\x96\x01\xcb\xbe\x6e\xc0\x04\x86\xee\x96\x19\x50\x41\xe6\xf5" +
+		"\xdf\x34\xe0\x5b\x86\xfe\x13\xc8\x7b\xed\x4b\x86\x1e\x57\x19\xf0\x2a\x9a\xb3\x25\xca\x80\x91\x9c\x7a\x8a\xf1\xef\x64\xfc\xf7\x3d" +
+		"\x67\x78\x68\x2f\x17\x3b\xf5\x4d\x83\xd7\xf9\x4d\x4b\xf8\x32\x58\x05\x8c\xe1\x65\x42\xce\xb7\x0c\xde\x74\xce\x2a\x7f\xce\x60\x73" +
+		"\x9e\x33\x17\x05\x03\x52\x9e\x32\xf8\xc7\x33\xc3\x46\xbc\x60\x88\x1b\xfe\x02\x15\x7e\xca\x70\x6f\x23\xc7\x2e\x3c\x65\x78\xf4\x3c" +
+		"\xa9\x1a\x7a\xa8\x9a\x70\xe5\x19\x83\xbb\x4c\x77\xac\x7f\xda\xd0\x7d\x1d\xc7\xf6\x3d\x6d\xf0\xef\x25\x75\xea\x69\x4b\xf0\x77\x92" +
+		"\xba\xc1\xbb\xd7\x49\x4d\x62\x51\xa7\x12\xd6\xff\xa3\x01\xeb\x08\x8b\x9e\x33\xc4\x36\x52\xe3\x5b\xd4\xf8\x26\x2d\x9e\xcb\x1b\x15" +
+		"\x84\x85\x5f\x36\xc4\x2d\xf8\xb2\x39\xcc\xfd\xaa\xe1\x5e\x39\xa5\xf0\x1f\x0c\x56\x40\x0f\xee\xfb\x07\xf6\x35\x52\x6b\x99\xb2\x6b" +
+		"\x28\xe5\xcc\x77\x19\xc6\xef\x1a\xd0\xc0\xcb\x7a\xc2\xe2\xaf\x18\xe2\x9a\x18\xae\x36\x82\xcf\xe0\x6b\xff\x4a\x78\x16\x34\xeb\xf0" +
+		"\xf7\x0d\x81\x43\xdf\x67\x08\xff\xc9\x80\x82\xbe\x86\xc0\x59\xb2\x1c\xfc\x8e\x01\x4d\xce\xd0\x7b\xe6\xb7\x99\x9b\x2f\x18\x7a\x9e" +
+		"\x7d\xc1\x12\x7a\x82\x35\x4f\x65\x67\x44\x1b\x70\x82\x7d\xec\x5c\x2f\x03\x7e\xc7\xb3\x10\x97\xbf\x91\x60\xf0\x5f\x67\xdc\xf2\xbf" +
+		"\x6e\xb8\x37\xef\xeb\xe1\x93\xe3\x51\x8a\x3d\xa2\xfd\x62\x88\x21\xe6\x18\x4b\x64\x22\xd3\x6b\x02\x21\xe5\x9f\x0c\x36\x9e\x3a\x9c" +
+		"\xe2\xd4\x4e\xc2\xe1\xaf\x1b\xec\x10\xd3\x63\xc4\xe3\x3c\x15\x28\x6f\xbf\x63\xf0\x17\x51\xb9\x1b\x64\xbe\x4e\xc8\x7c\xd6\xe0\xcf" +
+		"\x60\xf1\xd5\x7c\xdf\x10\xac\xa6\x39\x47\x5f\x34\x0c\x3e\xf2\xa2\x25\x7c\x03\xe6\x1e\x00\xf9\x95\x1d\xb1\x86\xfb\x8e\xd0\xa2\x2d" +
+		"\x2f\x19\xbc\xcd\x2f\x31\xbd\x78\xb9\x26\x96\x49\xc1\x0c\x5e\xf8\xbc\xa1\xcf\x02\x52\x23\x5e\x35\xa0\xb8\x9f\x21\x7e\x3b\x2f\xc7" +
+		"\x0e\x31\xb8\x24\xaa\x3d\x62\x88\xc1\x3f\x9c\x54\xce\x10\x43\x60\xfa\x10\x4b\x70\xe0\x8e\xf2\x82\x21\x6a\x23\x33\xe8\xf8\x2b\x06" +
+		"\x1c\x23\x9c\x7a\x85\x39\x4a\x6a\x31\xe7\x37\x0d\x09\xaf\x8d\xbd\xfd\x78\x5e\x62\x60\xb7\x70\x6c\x75\xd0\xe0\xb2\x98\x9f\xd3\xbe" +
+		"\x67\xb0\xf4\xef\x71\x8c\x37\x56\x11\x4e\x7d\xdb\x10\xec\xa4\xa2\x17\xfe\xd1\x10\x38\xcf\x1c\x39\xfa\xaa\xc1\x8e\xbc\x1a\x36\x1b" +
+		"\xcd\x66\xc0\xf0\x58\x83\x3b\x3d\xc4\x9c\x4a\x38\xfb\x15\x43\x54\x16\xd7\x9e\xf4\xb2\xc1\x52\x5f\xa6\x1b\x18\xa3\xeb\x84\xf2\xbf" +
+		"\xa7\xc9\x7f\x4f\xe7\xbf\x40\xe7\x53\xef\x0b\xdf\x34\xc4\x9e\x67\x78\xaf\xd1\xb3\x49\x41\x03\x0a\x78\xa0\x5f\x45\xbe\x3a\x16\xd2" +
+		"\x9e\x80\xc1\xd2\xe8\xed\x69\xcf\x52\x51\x52\xa7\xfe\xde\x10\xdd\x49\x96\x53\xaf\x1a\x5c\x27\x95\xba\x42\x01\xbb\xcc\x80\xdc\x6e" +
+		"\x06\xcc\xa3\xf8\xa9\xac\xdf\x34\x75\xdd\x17\x0d\xd8\x49\x58\xa4\x60\xfa\x0c\xde\x02\xce\x58\xf2\xac\xc1\x35\x53\xe8\x12\xee\x02" +
+		"\xcd\x8c\xda\xfa\xe7\x0c\xde\x3a\x52\x75\x54\xad\x96\xb0\xe4\x5b\xdc\xc1\xbe\x15\x0a\xf0\x33\x30\x60\xd8\x77\x0c\xfd\x86\x52\x5a" +
+		"\x26\xfd\x91\x41\xc8\xfc\x47\x83\x65\xd0\x5b\x6d\x6c\xe4\x5b\x59\x83\x5b\x94\x1f\x66\x78\xa0\x96\x71\x48\x79\xd5\x12\xe2\xc7\x53" +
+		"\xe3\xd5\xdf\x31\xc4\xac\xa2\x80\xb9\xaf\x19\x7a\x56\xbc\xc6\xa5\xbf\xcf\xa5\x39\x23\xff\x5f\x0c\x51\x79\xff\x12\x6e\xcf\x77\x5e" +
+		"\x34\xc4\xdf\xa6\xfe\x53\x13\x0c\x81\x34\xe5\x3b\x21\x4f\x99\xfb\xb2\x21\xe6\x10\xdd\xbd\xff\xdb\x06\xeb\x60\xe0\x56\xd0\x33\xcb" +
+		"\xb9\xce\x5a\xb2\xac\x21\xd4\xbc\x6e\x88\xaa\x7e\x9d\x52\x5e\x35\x44\xa5\xf1\xee\x88\x97\xd9\x19\x39\xf7\x5a\x82\xc1\xae\x4a\xde" +
+		"\xff\x32\xa0\xd2\x19\x7c\x69\x3f\xe2\xb4\x7f\x31\xb8\xea\x7f\xa1\xca\x3f\x30\x60\x69\x37\x83\x8d\xa4\x7f\x27\x52\xa1\x09\x2f\x76" +
+		"\x95\xb3\x4a\xb4\x91\xde\xbf\xcc\x80\xaf\x60\x26\x2c\x57\x3a\x7c\xd7\xe0\x52\x39\x96\xfc\x43\xd6\xb3\x19\x7c\x17\xb9\xc6\xc4\xd7" +
+		"\x0d\x36\x81\x0a\x6d\x78\xcd\x12\x7c\xad\xaf\x85\x59\xa2\xc6\x91\x6f\xc3\xcb\x06\xd7\x4a\xd5\xf6\x51\x8d\x99\xc1\xae\xe3\xd0\xa1" +
+		"\x38\x02\x3d\x57\xf6\x9a\xc1\x95\x72\xda\xe2\x17\x98\xee\xd4\xaa\xea\x0d\xa6\x71\xac\x01\x45\x54\xa8\x99\x63\xd9\x3f\x30\xf8\xb2" +
+		"\x7e\x10\x2e\x1a\x6c\x7c\x21\xec\xb5\x80\xbc\x36\x97\xa9\x5d\x41\x6f\x2c\xa1\xa8\x66\xc2\x51\xb2\x1c\x79\xa1\x6b\xf1\xbd\x84\xc3" +
+		"\xbc\x3c\x44\xb8\xf1\x92\xc1\x77\xfd\x25\x73\x48\x66\xe5\x8c\xe1\x42\x19\x9e\xc1\x77\x99\xde\xb8\xf0\xcf\x06\x14\x38\x43\xff\x75" +
+		"\x14\x95\xf9\x63\x43\x8f\x8c\x1f\xd3\x43\x94\x92\x4b\x98\xfa\x03\x43\x74\x1a\x15\x3a\x43\x96\x85\x51\x86\x6e\x05\x64\xc9\xfc\x86" +
+		"\xa1\x5b\xc6\x37\x18\x8f\xef\xf1\x58\x42\xf1\x0b\x7f\x64\x78\x70\xc1\x8f\x2c\xe1\x69\xd0\xe6\x57\x0c\x31\xa5\xaf\x98\x33\xb0\x02" +
+		"\xbe\x6f\xb8\xb7\x93\x19\x53\xf6\x62\xd8\x49\xbd\x46\x33\x88\xc9\x3f\x35\xf8\xc7\xfd\x94\xb9\xf8\xa2\xa1\xf7\x56\xde\x3d\xc8\x1b" +
+		"\x07\xb4\x21\xb2\x7a\x96\x44\xd1\x46\x5e\x66\x32\x83\x32\x5e\x0e\xf7\x53\x4f\xfd\x74\xec\xeb\x86\xe8\x24\x06\x67\xc5\x6b\x06\xff" +
+		"\x72\xea\x79\xeb\x55\x6e\x1e\xf4\xd4\xca\x37\x2c\xa1\x6f\x0b\x5d\x3d\xeb\x05\xc3\x23\x33\xe9\x95\x3d\x3f\x35\xb8\xdd\x3f\x35\xf7" +
+		"\x17\x58\xc2\xe7\xc1\x5e\xcd\x6d\xe2\x2c\x23\x7f\x90\x11\x3d\x40\xb8\xf4\x5d\x43\xfc\xc5\xef\x86\x9f\x3b\x56\xd0\xc0\xcc\x68\x83" +
+		"\xb7\x9b\x62\xc7\xbe\x6d\xf0\x92\xde\x66\xda\xbe\x6a\xb8\x6f\x0d\xc7\xee\x7c\xcf\xf0\x77\xb7\xc9\xb7\x82\xb9\xb1\x9c\x42\xda\xde" +
+		"\x36\x04\xb6\xbe\x6d\x09\x9e\xca\xe3\xfb\x06\xbb\x4d\x27\xec\xfa\x21\x8f\x5c\xe4\xc8\x4e\x34\x0c\xcc\x4a\x64\xbe\x31\x39\x4f\x10" +
+		"\x16\xbd\x61\xe8\xd6\xf8\x86\x39\xc0\x80\xe3\xff\x64\x88\x3e\xa6\x96\xfe\x33\x86\x38\x60\xe8\x55\xcb\x18\x4c\x7d\x91\xc1\xa1\xc3" +
+		"\xf6\x24\x1a\xba\xed\xa6\x90\xfd\x8c\xe9\xda\x6e\x4c\xb7\xb7\x78\xf9\xa2\x21\xa6\x83\x2c\x6d\x6f\x1a\x6c\xeb\x9b\x4c\xc1\x77\x0c" +
+		"\xbe\xa6\x77\xb8\xe2\x1b\x06\x77\x82\xce\xd9\xf5\xbf\x0c\xdd\x77\x32\xd7\x6f\xfc\xc4\x80\xab\xce\x80\xce\x58\x43\xf4\x64\x36\x83" +
+		"\xb2\x97\x28\x8f\xd4\x9e\x9f\x18\x62\x76\xff\xc4\x58\x83\xc0\x61\xea\x73\x88\x50\xfe\x86\x61\xc0\x1c\x4a\x9a\xf2\x03\x43\x60\x32" +
+		"\x33\x26\xfb\x1d\x9e\x6d\xb8\x4e\x3e\xd3\x3a\x8f\x63\x23\xfe\xd5\x80\xb3\xbd\xd9\x76\x7f\x60\xce\xd3\x61\xe4\x5b\x06\xbb\xcc\x16" +
+		"\x5f\x46\xb6\x52\xb2\x5d\x7a\xd3\xe0\x2e\x52\xd9\x69\xff\x6a\x08\xa4\x73\xd6\x8e\x9f\x1b\xba\x6f\xff\x39\x95\xf8\x81\xc1\xbf\x9b" +
+		"\x7c\x17\x08\x83\x0d\x48\x67\x4e\xdc\xe1\x15\xb7\xde\x27\xa8\x02\x47\x6e\x71\xe4\x51\xe6\x1b\xaf\xaa\x08\xf3\x09\x63\x5f\x36\xf8" +
+		"\x93\x48\xd5\xbc\xcc\xb3\xdb\xcb\xe1\xdc\x72\x19\x5d\x65\x1c\xa5\x4a\x5a\xf1\x33\x83\xb7\x9c\x06\x6e\x7a\x93\x0f\x7c\xd4\x68\x25" +
+		"\xf9\x5a\xc8\xb7\x85\xdb\xc7\xe6\x97\xc3\x2d\x20\xd0\xfa\x72\xb8\x5a\x83\x87\x5e\x0e\x3f\xcf\x36\xbc\x65\x88\xa9\x67\x20\x16\x27" +
+		"\x1a\x62\x9b\x18\xa1\x1c\xb2\x4d\x27\x4c\x7c\xc7\x10\x3d\x81\x0e\x9a\x4a\xe9\x69\x94\x5e\x42\x53\x97\xf8\xf8\xe8\xf8\x4e\xf8\x2c" +
+		"\x56\xf3\x81\xc1\xaa\x3f\x60\x72\xbe\xc2\xf3\x6d\xd7\xfe\xe9\x69\xff\x3c\xf7\x8a\xc1\x9d\x25\x75\xe5\x95\xf0\x53\xd1\x79\x12\x63" +
+		"\xde\x31\xd8\x68\x0a\xbf\xc4\xf5\x8f\x7b\xf4\x19\xbd\xb7\xe4\x6d\xc3\x23\xcd\x6f\x87\xb7\x63\xef\xd8\x2b\xe1\xd2\xb7\x8c\x1f\x87" +
+		"\x85\x04\x2e\x73\xec\x02\x59\xce\x13\x1a\x08\xd5\xf1\x86\x7b\x46\xbe\x1a\xda\x59\x5e\x03\x0b\xea\x75\x43\x70\x26\x0b\xaf\xee\x75" +
+		"\xc3\xa0\xda\xd7\xc3\xaf\xba\x72\xde\xe5\x99\xf2\x5d\x66\x15\x57\xae\x0f\x74\x35\x93\x45\xbf\x30\xf4\x6f\xfc\x85\x01\xdb\x7e\x66" +
+		"\xb0\x76\xfa\x75\xc3\x2f\x0d\x5e\xeb\x2f\xc3\xa7\xa1\x93\xff\x66\xe8\x7b\xe2\xdf\x28\xfc\x0d\x43\xec\x4c\x26\x54\xfe\xcf\x0d\x0f" +
+		"\xe6\x51\xf3\xd5\x6f\x19\x7c\xab\x28\xf3\xcc\x87\x86\xbe\xa7\x3f\xe4\xdd\x8f\x0d\x58\xc5\x57\x5b\xed\xef\xd1\xd6\x5f\x1a\x70\x91" +
+		"\x70\xe6\xa7\x86\x98\xd3\xec\x2b\x17\x3e\xa4\x4f\xc8\x7c\x3c\xd1\x12\xdc\x31\x06\x61\x35\xbd\xb3\x8a\xde\x19\x43\xc7\x56\x74\x37" +
+		"\x78\xed\xb4\xbf\xea\x7d\xe6\x08\x61\xee\xcf\x0c\x3d\x2a\xa8\xe3\xc1\xf7\x0c\xfe\x03\x14\xbf\xe1\x23\x7a\x82\x27\xc6\x8c\x7f\x0b" +
+		"\xc9\x43\x48\xde\xb9\xb7\x0d\x76\x96\x7e\x2a\xff\xd0\xe0\xcd\xe1\x6a\x73\x09\x15\x84\x5d\x2c\xca\x9d\xff\x6c\x2e\x08\x26\xdf\xfb" +
+		"\x06\x97\xc4\x25\xf2\x3f\x34\x58\x1e\x39\xf6\xff\xc4\x10\xe8\x60\xe9\x4d\x79\x8f\xc9\xcb\xc5\x8e\xf3\xf2\x64\xbc\xc1\xb7\xf9\xdf" +
+		"\x99\x95\x3f\xe1\xf1\x96\x63\x97\x08\x65\x31\x06\x5f\x2e\xf9\xae\x50\x40\xbd\x33\x04\xeb\xc9\x97\xf3\x11\x0f\x5f\x54\x34\x9b\x7d" +
+		"\x2e\x8b\x2e\xc8\xff\x57\x2e\xc4\xd4\x2a\xfb\x11\x6b\x8c\xd1\x68\xe0\xdd\x7a\x39\xe8\x63\x43\xb7\xf3\x74\x64\x21\xcd\xcd\x8e\xe6" +
+		"\xf9\x98\x6e\xd9\xf6\x9e\xc1\xc9\xaf\x47\x3f\x30\xb8\x23\x1c\x9b\xc1\xad\x2c\x57\x87\x80\x5f\x1a\xdc\x3a\xfa\xfa\x20\x83\x7d\xe0" +
+		"\xdd\x70\x97\x5b\x49\x7f\xb7\x50\xa9\x32\x8e\x8f\x88\x33\x44\xcd\xf9\x0d\xa5\x93\xb7\x80\x50\xc6\x54\x2f\x25\x34\xfc\xbb\xc1\x2f" +
+		"\xbd\xb3\x79\x19\x63\x70\x05\xaa\x32\xc6\xa6\x85\x30\x9b\x8d\xab\x48\xbc\xac\x92\x7a\x52\x07\x7f\x63\x70\x07\x28\x73\x0b\x3b\xc5" +
+		"\x66\x8e\xed\xe7\x8a\x1d\x84\xaa\x37\x43\xc7\x7a\xaf\x9a\xc4\x61\xc2\xe3\x06\x57\xfb\x66\xb8\xe3\x8c\x61\x38\x46\xab\x51\xbe\x69" +
+		"\x88\x5e\x43\x86\x65\x1f\x19\x7c\x4b\xe9\xb5\x29\x4c\xb0\xcf\xf1\xb1\x5c\x7a\xb0\xf8\x5a\x3e\x08\x73\x38\x71\x4c\x7a\xdf\xe0\x4b" +
+		"\x65\x00\xc7\x30\x23\x47\x73\xc2\x2e\x7a\xe9\xa0\xde\xb7\x51\x85\x59\x6f\x19\xfe\x61\x26\x6f\x14\xbe\x6f\x88\x2e\x78\xdf\x12\xe2" +
+		"\xc0\xab\x0f\x0c\x03\x0a\x28\x6e\xe2\x07\x86\xee\x13\x3e\x30\xa7\x67\x87\x6d\xbf\x62\x02\xfe\x8a\xdb\xe9\x4f\x0d\xb1\x57\x19\x96" +
+		"\xb5\x3f\x37\xb8\x35\x4c\xfc\xa3\xff\x41\xef\xff\x07\x83\xfb\xa6\xe1\xfe\xe9\x54\x6c\xee\xfb\x06\xab\xa0\x12\xdb\xde\x34\xf4\x6a" +
+		"\xe7\x58\xd5\x47\x86\xe8\xf9\x54\x71\xee\xef\x0d\xae\xe2\xf7\x54\xf6\x2d\x83\x97\x4a\x4d\xb6\xfd\xce\x10\xd5\xfe\x3b\x03\x4a\xc8" +
+		"\x52\xfc\x51\xb8\x8b\x27\x33\x5e\xe3\xa8\x75\xcd\x5b\x06\x57\x4d\xde\x9c\x0f\x0c\xbe\xe9\xd4\xb3\xe6\x3f\xe9\xc8\xff\x34\x60\xf1" +
+		"\x7b\x06\xaf\x89\x76\xae\xff\x0f\x83\x7f\x1d\xf5\xc9\x66\x0c\xb2\x08\xfb\xdf\x32\xc4\x76\xbc\x15\x4e\x40\xa7\x04\x9c\xfd\x89\x21" +
+		"\x58\xf4\x89\x39\xac\xfc\xb5\x21\xbe\xe5\xd7\xff\xa7\xec\xd8\xc5\x12\xda\x49\xc8\xff\xbd\xa1\x67\x1e\xb5\x4d\x7e\xdb\xd0\x67\x1c" +
+		"\xeb\x68\xd6\xc7\x86\xb8\x99\x4c\xca\x6d\xef\x1a\x82\xed\x4c\xa5\xd5\xff\xc9\x06\x40\x5d\xb2\x3f\x32\x74\xcf\xe2\x1a\x55\x1f\x18" +
+		"\xe2\xe7\x7f\x60\x33\x5d\xe2\xb9\xb3\xce\x66\xba\x60\xfb\x34\x67\x81\xa7\x8f\x4f\x73\x06\x2c\xdd\x61\xe6\x82\xcb\x76\x98\x59\x0c" +
+		"\xac\xc0\x25\xc2\x66\xba\x1f\xc2\xca\xdd\xeb\xb0\x52\xd7\x1f\x16\xfd\x55\x58\x99\x1b\x08\x8b\x1f\x08\x7b\xe5\x1b\x15\xa9\x4e\x3b" +
+		"\x7e\xcc\x80\x6d\x93\x9c\x79\x83\x61\xaf\xf7\x5f\xc7\x31\x07\x73\x80\xf9\x1e\x82\xee\x03\xc3\x06\x5b\xdc\x4b\x27\x7f\x69\xaf\xc5" +
+		"\xb6\x1f\x76\xac\x4f\x6a\x73\x21\xce\x30\x68\xe3\xff\x30\xd2\x34\xb1\xe0\xc3\x70\x75\xd8\x01\xea\x7f\xea\x5d\x83\xeb\x24\x95\xa3" +
+		"\xf2\xf0\x98\x5b\x1f\x9b\xbb\x17\x06\x54\xfe\xb7\x01\xf3\x08\x65\xf4\xb1\x19\x50\x44\x62\x2a\xbd\x9b\xf6\x1b\x43\xe2\x9d\x23\xce" +
+		"\x12\x30\xef\x13\x83\x9b\x4c\x27\x8c\xfd\xad\x01\x49\x84\x3a\x32\x2e\x0c\x1a\x70\x5a\x65\x4c\x58\xea\x28\x2f\x8a\x79\xc0\xbb\x35" +
+		"\xef\x19\x9e\xaa\x7e\xcf\x68\x2a\x06\x5c\x38\xe2\xec\xaf\xfe\xea\x23\xce\x80\x69\x1f\x1b\x1e\x4b\xff\x38\x5c\xaf\xc1\x52\xea\xb7" +
+		"\x92\x9a\xb6\xbc\x6b\x2e\x16\xb4\xe0\xd7\x06\xef\x00\xa3\xb7\x87\x29\x7a\x25\x60\x88\xd9\xfc\x3b\x9b\xe1\xa2\xc1\x4c\x7e\xcf\xd0" +
+		"\x7b\x02\xd7\x58\xc6\x7e\xb1\xf4\xbd\xb0\x42\xfe\x5a\x52\xfb\xff\xc7\xd0\xad\x83\x1e\xd9\xff\x9e\x21\xd8\xf1\x9e\x39\x07\xba\xeb" +
+		"\xb7\x6c\x67\x54\x7e\x14\x33\x79\x24\x33\xf9\x0e\x33\xec\x36\x67\x8d\x79\xdf\xd0\x7d\xf4\xfb\x36\xc3\xf5\x06\x4b\xe1\x3d\x43\xec" +
+		"\x1a\x75\xe0\xbf\x18\xee\x6f\xfd\x8b\xb9\xbf\x49\x86\x93\x7f\x35\x78\x27\xfe\x4a\xf5\xfe\x68\x88\xda\xfd\x47\xa6\xde\x5f\x0c\xdd" +
+		"\x8a\xfe\x42\x2f\xfd\xd5\x66\xb8\x87\x93\x78\x7b\xd7\x1f\xf8\x2a\xf9\x0f\xf4\x15\xfb\xff\x82\x77\xc2\xba\x64\x93\xef\x66\x0c\xdf" +
+		"\x0e\x33\xa9\xd7\xb3\xed\x2c\x7c\xc0\x80\xe1\x43\x1d\xa5\xfe\x97\x21\xb8\xfb\xbf\x68\xc2\x3b\x06\xd7\xc1\xdb\x37\x68\xd1\xf5\xff" +
+		"\xb1\x19\x0e\x30\xb8\x73\x1c\x3b\x4d\x38\xf3\x0e\x5b\x07\xa9\x94\x77\x0d\xbe\xf1\xf4\xe6\xa6\x61\xce\xe0\x36\x12\x71\x8a\x2b\x6c" +
+		"\xec\x4e\xa3\x62\x0d\x38\x44\xaf\x66\x92\x29\x83\x30\x96\x90\x44\xa8\xfc\xad\xc1\xe5\xc5\x53\x0e\x99\xa7\xfa\x0c\x98\xd9\xd3\x10" +
+		"\xd0\xdd\xaa\xbf\x32\xeb\x65\xd3\x70\xc7\x32\x1a\xee\xcc\xf5\x83\x01\x6d\xbc\xbd\x95\x30\xeb\x37\x86\xc0\x4c\x26\x4e\xca\x29\x67" +
+		"\x09\xdd\x36\x9f\x74\x06\xcc\xe0\xfa\xb9\x84\x11\x7f\xa6\x85\x84\x2b\x84\x9d\x1e\x4b\x99\x66\xdd\xf8\x83\xe1\x89\xeb\x7f\x08\x0b" +
+		"\xbc\xf3\x0b\x43\xf4\xed\x5f\x18\x12\x47\x51\x0c\xd6\x48\xcc\xa2\x7f\x37\x3c\xd2\xc8\xbe\x3d\x96\x1b\x5e\x12\xf3\x79\xcc\x9f\x0c" +
+		"\xfe\xd1\x7f\xa2\x91\x74\x42\xe7\x3b\xe6\xb4\x09\x46\x69\x13\x1c\xf5\xae\x21\x7e\x24\x15\xbb\xf3\x5b\x83\xff\xf6\x6f\x0d\x89\x37" +
+		"\x3a\x9d\x25\x58\xb3\x24\xde\xf8\x2b\x57\xa5\x49\x3b\xde\x35\xc4\x6d\x7f\x37\x7c\xfc\x19\xf1\x4b\x83\x0d\xe7\xae\x31\x89\x90\x4a" +
+		"\x38\xfe\x5f\x86\x9e\xc7\xfe\xcb\x90\x78\x89\x12\x7a\xd7\x4b\x42\xe6\x1f\x0d\x96\xc1\xe8\x1f\xe5\xa9\xe2\x08\x39\x4f\x11\xf6\xd1" +
+		"\x8d\x6b\xa8\xd8\xe1\xbf\x18\x7a\x1d\x62\xb8\xa7\xfc\xa7\x21\x4a\x65\x94\xf9\x2b\x73\x96\xc1\xc4\xce\x67\x17\xce\x23\xd5\xf0\x2b" +
+		"\x43\x74\x3d\xa9\xc5\xbf\xe2\xc1\xed\x57\x86\xc4\xc3\x5c\x0a\xa5\x5a\x2a\x79\x8c\xe3\x2f\x4d\x44\x5c\x48\x72\x86\xe0\x79\x22\xc6" +
+		"\x32\x1a\x96\x44\x44\x8a\x4a\xaa\x37\x13\xca\x33\xf8\x86\x8f\x76\x5c\xf6\x63\xc3\x03\x93\x3f\x36\x77\xbf\x8e\x02\xa3\x9c\x01\x27" +
+		"\xfa\x1a\x6c\xfa\x27\x86\xc4\x6d\x5c\xa2\xe7\x74\x2d\xd1\xf0\x6f\xdc\x9f\xd9\x1e\x57\x7f\x62\xb0\x55\x9f\xd0\x1a\x09\xee\x14\xb6" +
+		"\x7d\x12\xfe\x21\x6c\x50\x56\x92\x0b\x95\x75\xe2\x26\xce\x8f\x4b\xd7\xfc\x11\x7f\x30\xb8\xe1\x4c\xf6\xca\x71\xce\x10\x98\x47\xc4" +
+		"\xa6\x0f\x0d\xc1\x8d\x8c\x47\x32\x3d\x73\xe5\x3e\xe6\x40\x8c\x21\xfe\x26\xfd\x76\x89\x2a\xaf\xbe\xc7\xe0\x6f\x1c\xe3\x58\x63\xf4" +
+		"\xd4\x2a\xc2\x98\x8f\x0c\x0f\x8e\xfe\xc8\x90\xb8\x44\x31\x1b\x1d\x5a\x83\xe1\x7a\xc6\x80\x05\x24\x4e\x32\xc1\xfd\x27\x86\xa9\x1b" +
+		"\x7e\x6c\xb0\xbd\x14\x37\x82\x76\x47\x85\xac\x9f\x91\x4c\xbc\x1a\x6d\x40\x81\x8c\xb8\x46\x8e\xca\x38\x03\x8a\x19\x9e\xd9\x1c\xec" +
+		"\x5b\x44\xc4\x54\xca\x09\xa4\x0d\x73\x5d\x45\x4a\x67\x3f\x98\x45\x4c\x2c\xa7\x0e\xf1\xe7\x4f\x48\x87\x09\xce\x10\x3f\x7c\x82\x2a" +
+		"\xf5\x13\xc3\x3d\xbb\xe9\x98\xb5\x63\x9d\xc1\xd6\x8c\x75\xe1\xf7\x5c\x95\xbc\x76\xf3\xc6\x2a\x77\xc7\x53\xc6\xc9\x9f\x59\x82\xeb" +
+		"\x94\x88\x6d\xcc\xff\x76\xc2\xa6\x5f\x1b\x7a\x6c\x64\x4d\xde\xfa\xb5\xc1\xdd\x24\x55\x38\x92\x66\x15\x10\x31\xe6\x37\x86\xe8\xd1" +
+		"\xec\xc8\xd3\x48\xa5\x93\xba\x44\x95\x82\x17\xc7\xb8\xf0\xfb\xa5\xc5\x1c\x6c\x22\x5c\x91\xcb\x2f\x8f\x73\xe1\x51\x9f\x46\x57\xff" +
+		"\xc6\x10\xbf\x8a\xd4\x8c\xd1\x2e\xfc\xd2\x05\x23\xa9\x59\xe2\x24\xda\x85\xe1\x3f\xe7\xaf\x0d\x1c\xc0\xc2\x89\x8e\xaf\x3c\x89\xc8" +
+		"\xe6\x29\x20\x8b\x95\x3b\x95\x70\xbc\x9f\xc1\x6e\x2a\xeb\x36\x7d\xc2\x87\x13\x9a\x5d\xc3\x3b\xdd\xe9\x5e\x12\x67\x24\xe3\xb4\xb0" +
+		"\x8e\x9c\x56\x4b\xc4\xb2\x4f\x0c\xf1\x4b\x3f\x09\x27\xcd\x9d\x93\x4c\xba\xe5\xf2\x44\x21\x1b\xf2\x85\xfb\xf9\xd6\x52\x6b\x6e\xa1" +
+		"\xee\xfe\xcd\x44\x9c\xa3\x33\xce\x12\x96\x91\x6b\x29\xe1\x1a\xe3\x7e\x95\xb0\x82\x97\xcb\x35\xf6\x07\x83\x5d\x65\xe2\x15\xd2\xd1" +
+		"\x28\x10\x2e\x49\x21\x36\x0b\xef\xf0\xe6\x6d\xc2\x2c\x85\x7a\xac\xde\x82\xf4\x36\x38\x23\x27\xaf\xaa\x49\x8d\x56\x79\x64\x2b\x74" +
+		"\x59\x63\xbb\x6a\xcd\xb9\xf3\x49\x11\x29\x93\xfa\x19\x30\x9e\x30\x54\x6d\x5f\x15\x59\x24\x9c\xab\x44\xac\xa3\xb4\x0b\xa4\x36\xf6" +
+		"\x31\xd8\x68\x72\x1d\xa7\x6d\xc1\x63\x44\x0c\xd3\x1a\xfa\xf5\xd8\xc7\xaa\x88\x35\xd8\x4c\x25\xd9\x12\xfa\xc5\x8d\x96\x5f\xea\x38" +
+		"\x82\xc9\x94\x55\x40\x09\x33\xfe\x68\x70\xb9\x5d\x94\x66\xe6\x91\x58\xaf\x35\xd7\x09\x6f\x68\xf3\xb8\x2e\x9c\x36\x85\xc9\x9c\x3e" +
+		"\x45\x7a\x91\xb1\x42\x73\xe9\x8d\x98\x5c\x22\xd6\x72\xe0\x5a\x4f\x6e\xa0\xba\x6e\x0b\xe5\x8e\xdf\x60\xbb\x47\xba\x2e\xa5\x11\x52" +
+		"\xba\x8c\x0e\x08\x94\x86\x82\xa9\x24\xe2\xde\xb8\x3c\x86\xbb\x13\xe5\x4c\x4b\x71\xe6\x90\x9e\xe2\xba\x16\x09\x84\x16\x39\x2e\x05" +
+		"\x8e\x09\x2f\xd0\x84\xf3\x5a\x95\x70\x95\x70\x85\x50\x16\x64\x17\x94\x5b\x2a\x69\xb6\xcd\x23\xe2\x20\x25\x78\x07\x88\x89\x29\x74" +
+		"\x8d\x6d\x3e\xee\x0c\x18\xa5\x10\x8e\x14\xa6\xfc\xc9\x60\xe3\x59\xc9\x07\x27\x3b\xee\x43\xfd\xf8\x33\x02\xaf\x87\x49\xcb\x03\xc1" +
+		"\xf0\xdb\x09\x1c\xd1\x75\x26\x6f\x2d\xeb\x63\xc0\x21\xde\xc9\xd4\x0b\xb3\x9e\x06\x0b\xb5\x80\x36\xb5\x8b\x79\xf1\x06\xf0\xce\xad" +
+		"\x80\x01\xa5\x23\x54\xe8\x93\x5d\xf8\x2d\x34\x76\x74\xe7\xd7\x0c\xe2\xac\xfa\x93\xc1\xcd\xa7\xcc\x32\x52\xa5\xa4\x16\xaa\x31\x5c" +
+		"\xee\x69\xf0\x9a\x78\x3d\x46\xd9\x38\x99\xa2\xce\xcb\xc6\xd5\x64\x5d\xc5\x5b\x0d\x84\xe3\xbd\xa8\x43\x2f\x73\xd6\xc8\xcb\x7d\xf2" +
+		"\x77\xb9\x19\x30\x4e\x6b\x2e\x16\x8e\xe3\xca\x5a\xa9\x9c\xc1\x71\x73\x46\x2a\xc7\x39\xd0\xac\x55\xd3\x9c\x01\xd3\x3d\x83\xcb\x48" +
+		"\x55\xcf\xa1\x82\xae\x91\x88\x7c\xf9\x33\x6f\x4c\xd7\xea\x08\x32\x3b\x49\x24\x2b\x4a\xe3\x84\x1b\xa8\x57\x2b\x47\x77\x11\x76\xfe" +
+		"\xc9\x90\x58\x77\xc2\x59\x02\xce\xfe\xde\x10\x33\x9e\xa5\xd6\xa6\xe4\x3a\xed\x33\x20\xda\x00\x3e\x2b\x1e\x93\xf9\xec\x65\xdf\x37" +
+		"\x44\x77\x68\x37\x9e\xa4\x5d\x43\x78\x87\x03\x16\x4a\x59\xbb\xc9\x8b\x89\x8a\xf1\x84\x31\xd2\x52\x4d\xa2\x31\x49\xb1\x91\xa1\x19" +
+		"\xc2\x14\xca\xdb\xc3\xf4\x3a\xa6\x7b\x73\x15\x84\x62\x3a\x71\xe4\x9f\xc3\x3b\x3a\x92\x83\x4c\x7d\x8e\xad\xa5\x77\x46\xa6\x84\x67" +
+		"\x3a\x37\x9e\x3c\x75\x9a\x93\x1d\x67\xf0\x36\xcb\x25\x53\xfe\x1c\x52\x23\x98\x46\x22\x9f\x90\x47\x68\x90\x2f\x8f\xfa\xf9\xce\x48" +
+		"\x45\x50\xfe\x67\x83\xcd\xf9\x33\x5b\xd5\x71\xda\xbf\x37\xd1\x60\x87\x74\x6b\xff\x9f\x0d\xfe\x0e\xcd\xd7\x75\x72\x94\x01\x97\x1f" +
+		"\x60\x70\xf8\xa0\xb3\x4b\x0b\xa5\x73\x0f\xf6\x42\x8b\xb9\x26\x32\x4f\xe3\xb8\x97\x9e\xaa\x08\x30\x13\xdd\xaa\xd1\xd2\x56\x3e\x2a" +
+		"\x20\x7f\x2f\xf6\x60\xf2\x9e\x24\x9c\x20\x6c\xe1\x46\xb8\x99\xb0\x4d\xde\x5a\xc3\x54\x19\xc7\xc7\x96\x86\xa9\xce\x60\xf5\x53\x95" +
+		"\xb4\x92\x94\xde\xd3\x80\xc6\xdf\x77\xd5\x2a\x42\xb5\x7a\xea\xcf\x06\xd7\x49\x59\xb3\x99\x57\xae\x68\xa2\x33\x77\x9f\x76\x37\x1e" +
+		"\xfc\xb3\x08\xe5\xbf\xa7\xb5\xbf\x37\x24\x6e\xa0\xb5\xf1\x69\xc7\x94\xda\x4c\x28\x9b\x4f\x44\xcd\x34\xc7\x1f\x74\xa6\xa9\x10\x94" +
+		"\xde\xd3\xa9\x89\xd2\x7b\xf1\x9f\x0c\x3d\x45\x1d\xfc\x6f\x83\x3b\x40\x89\xf9\x5c\xdb\x9f\xc7\xd6\xd9\x07\x06\x14\xd2\x55\xdd\x0a" +
+		"\x88\x89\xcb\xb8\x46\x5c\x92\xd6\xb8\xc0\x88\xf5\x3d\x9f\xe2\xc2\x47\x65\xe8\xa8\xbc\xef\x0f\x86\x3e\x7b\x49\x35\xe8\x4e\x7d\x4a" +
+		"\xd7\x46\x77\x90\xd9\x1c\x77\x20\x59\xbb\xb0\xf2\xe4\x8c\xdf\x80\x24\xd6\xf7\x2b\xf4\x81\xf8\xcb\xd8\x53\x36\xb0\xc6\xb7\x33\x38" +
+		"\xb9\x49\x5d\x3d\xd0\xd4\x03\x57\x6b\x33\x3e\xf8\x96\x25\xa0\xe0\x47\x06\xb7\x46\x21\x98\xca\xa9\xcf\xa6\xa5\x68\x77\x18\xaf\x33" +
+		"\xd5\xf8\xae\x65\xcb\x99\xf4\x7d\xe7\x10\x71\x89\xbb\x82\x77\x91\x98\x58\x48\x53\xa2\x4f\x1c\x55\x22\xcb\xbd\x8d\x44\xdc\x22\x67" +
+		"\xe0\xe6\x50\xa9\xc9\xce\x6c\xc3\x87\xc9\x5c\xae\xe4\xce\x4f\x12\x4d\x09\x81\xf3\x63\x55\xe1\x59\xce\xd0\xab\x89\x88\x95\x19\xce" +
+		"\xe0\x5a\x32\x94\x1c\xe9\x8e\x07\xfa\x74\x67\x0e\xa0\x0d\x34\xb9\x7b\xc5\x64\xed\x9b\xe2\xae\xbe\xc7\x80\x25\x3d\x0c\xbe\xd0\xe8" +
+		"\x6a\x85\x6b\x95\xf0\x8e\xd6\xb9\x2d\xbc\xc2\xf5\x7d\x97\x89\x89\xc9\xd4\xda\xb7\xf1\xa8\xeb\x4a\x23\x84\xd2\x68\x12\x3b\xa0\x2f" +
+		"\x95\x88\x93\x72\xf1\x50\x33\x78\xc7\xa4\xe2\x36\x8a\x8c\x69\x4f\x53\xff\x65\x16\xdb\xc8\x54\x8d\xd3\x55\x58\x41\xc8\x89\x0e\x7d" +
+		"\xe2\xd4\xbf\x9e\x0a\xeb\xc5\xdc\xfe\xe9\xce\x10\xd5\x31\x5d\x59\xca\x34\xde\x4a\x18\x25\x13\x47\x12\x13\x2f\x1d\x63\x7d\xd5\x4b" +
+		"\x99\x5d\x7f\x31\xb8\x9d\xe4\x98\x2b\x8e\x8a\x0c\x1d\x08\x98\x6f\xf7\x6d\x9e\xa0\x3c\x64\x06\xc5\x55\x13\x51\x42\x8c\x29\x1e\xd7" +
+		"\x75\x12\x3d\x43\x49\x83\xab\x25\xe9\x30\xc3\x18\x75\x88\x88\xf5\x99\xcc\xc5\x75\x99\xca\x5d\x3a\xcd\xb7\x3b\x4b\x07\x41\x9a\x68" +
+		"\x57\x93\xbb\xe6\x9f\x94\x26\xf3\x34\x7f\x22\x35\x8e\x9d\x30\x5d\x5a\xd1\x42\xb7\x73\xbc\xe4\x52\x4a\xaf\x43\x99\xb2\x5c\x1b\x6c" +
+		"\xfb\x14\xed\x72\xbc\xdb\xfd\xd8\xf8\xae\xbc\x74\x81\xe1\x0a\x47\x36\x35\x08\x66\xa5\xa8\xa7\x08\xe7\x08\xa7\xd1\x8f\xbe\xf4\x34" +
+		"\xf1\x0f\x55\x82\x0c\xd5\x61\x2f\x87\xf4\x68\x62\xe2\x0e\xea\xd3\x3d\x57\xfa\xb4\x69\x56\x8c\xe1\x81\xbd\xa1\x5d\x35\x5f\xbb\xaa" +
+		"\x30\x7f\x82\x6a\x6d\x82\x1a\xdc\x0c\xea\x34\x79\x86\x33\xd7\x1d\x06\x54\x65\x3b\x03\x6a\xf9\x19\xdc\x55\x31\x0c\x63\x7e\xfa\x86" +
+		"\x12\x13\xd7\x73\x81\xb8\xc9\x5a\x60\x31\xa3\x1e\x68\x0a\x9d\x8d\x78\xd7\x25\x4d\x54\x79\x51\x15\x77\x3a\x47\xdb\xbe\xe6\x2e\x9d" +
+		"\xa8\x14\x50\x3a\x8c\x4c\xd3\xb6\xc1\x11\x6b\x9d\xa8\x7c\x12\x4f\xbd\xe8\xa3\x1a\x3f\x32\xd1\x45\x36\xb5\x47\xd9\xa4\x95\x36\x5b" +
+		"\xd8\x86\xb0\x59\xa3\x97\x88\x81\x8b\xa2\x77\x68\xfe\x76\xd1\x99\x33\xb5\x81\x73\x0f\xa8\x4e\x97\x32\x4c\xd3\xc0\xe9\xa9\x91\xb3" +
+		"\x89\x5f\x67\x93\xc4\xb9\x34\xc4\x2e\xea\xd5\x42\x89\xc4\x17\x0b\x17\xd3\x1b\x68\x12\xae\xe6\x88\xad\x4a\x8d\xec\x25\xae\x62\x84" +
+		"\x8a\x84\xb1\x8c\xba\x9d\xa9\x71\xde\xf5\xf8\x3e\x06\x28\xd3\xac\x52\xe1\x12\xde\x0d\x36\x67\x2a\x03\x78\x37\xfe\x50\xaa\xa2\x9b" +
+		"\xab\x4e\x18\xc7\x63\x7b\xaa\x0b\x7f\x9a\x76\x8e\xea\xf9\xcf\x4e\x55\x38\xa8\xb6\x4d\x4e\x97\x95\x9c\x12\x7d\x31\x55\x95\xa6\xbb" +
+		"\xa9\xc2\x6d\xea\x09\xed\xc4\xc4\x29\x34\x04\x87\xbe\x69\x40\x47\x1f\x43\x20\x75\x92\xea\x4a\x58\x1e\x6b\x40\x9e\x67\xb0\xa2\x50" +
+		"\x2a\x33\xb4\x31\xbb\xb3\x75\x66\x27\x87\x2d\x98\xa4\x52\x14\xbd\x5c\xf4\x58\x26\x61\x54\xd2\x64\xf5\x37\x9a\xd2\x2d\x6d\x86\xba" +
+		"\x81\x78\x2e\x4f\xd2\x7e\xa6\xd8\x76\xe4\x38\x73\x71\x60\x6a\x16\x6a\xa3\x2a\xd4\x19\x54\x06\xd6\x0a\x57\x33\x1a\xfe\x55\x33\x25" +
+		"\x37\x4f\xfd\x91\x98\x78\xe5\xa8\xb3\x84\x6f\x34\x1e\x71\xe6\xe2\x41\x3e\xf5\xb5\x55\x63\xa5\x23\x31\x6a\xf7\xd8\x08\xdf\xe7\x1b" +
+		"\x15\xa4\x14\xae\x19\x1c\x9f\xa3\x28\xcb\x8e\x8c\xec\xae\x1e\xb7\x8b\xd7\xde\xce\x6c\x05\x99\x25\xde\xeb\xd8\x38\x05\x3f\x4f\x99" +
+		"\x98\x17\x09\x9a\x53\xd0\x12\xcf\x50\x6e\x5c\xe8\xbd\x52\x19\x4b\xda\x5f\x9a\xac\x4c\x17\xcf\xd0\x4c\x25\x02\x47\xac\x29\x39\x42" +
+		"\x77\x0f\xd1\x97\xc4\x7f\x51\x74\x5b\x89\x8e\x8f\xc2\x2b\x1c\x71\x97\x93\x75\x98\xe5\x88\xe5\x96\x28\xeb\xd5\x0a\x46\x8e\xef\xd2" +
+		"\x35\x9b\x5a\x06\xb2\xb2\xd5\x4e\xd4\x54\xb6\x77\xe7\xc7\xb8\xe3\x55\x97\x42\xe3\xd7\x97\xa2\x26\x16\x10\x27\x08\x17\x6b\x0b\x9a" +
+		"\xfa\xa6\x25\xc4\x66\x48\xf3\x85\xb3\x15\xc1\xd9\xaa\x81\x52\xd2\xdb\x4b\x95\xce\xf2\x7a\xf1\x4c\xed\x18\xb4\xc5\xce\x67\xaa\x51" +
+		"\xe8\x29\x69\xf4\x38\x35\x45\xc6\xd4\x57\x3d\x43\xa7\x11\xc5\x6a\x9c\xb0\x9c\x12\xe2\xe6\x94\x2a\x85\xa5\x5d\x73\x56\x57\xb3\x5b" +
+		"\x41\xaf\xf5\x19\x77\x24\xd2\x2c\x2d\xd4\x2c\x6b\x18\xf7\x40\x75\xa1\xbc\x39\x87\x58\xd4\xd3\xd0\x73\xb8\x4c\xac\x4c\xd6\x53\x7d" +
+		"\xf2\x5d\xeb\xf2\xc6\x77\x3d\xed\x8e\x99\xed\xc2\x5f\x01\x3b\x55\x75\x62\x1d\x57\xc0\x1c\x6e\xb0\x1b\x27\xaa\xe7\x51\x46\x74\x7a" +
+		"\x76\xa4\x7a\xe3\xc2\x4f\x16\x15\x0a\x5a\x85\x0a\x46\xe5\xe1\x37\x58\x7a\x6a\xe4\xda\x37\x59\x74\xb2\xba\xdd\x38\xe1\x0a\x2a\xeb" +
+		"\x96\xa7\x48\x93\x72\xd2\x79\xe5\x4a\x52\xa6\x47\xf4\xaa\x3c\x17\xfe\xe1\x23\x47\xc1\x9b\x5e\xa2\x2d\x4d\x6e\x3d\x51\xaa\x3d\x81" +
+		"\x06\xba\x75\x85\x3a\x21\xc9\xb9\x9d\xc2\x93\x6c\x68\x51\x27\xd2\x94\x04\x69\xda\xe2\x7f\x68\x09\xbe\x0e\xbd\x6e\xbd\xc1\x01\xbb" +
+		"\x9e\x26\x57\x28\x8c\xf3\x84\x63\xa7\xaa\x5f\x4e\x55\x86\x88\x1e\x29\x7a\x0c\xd1\x37\x5a\x74\x8a\xc6\xc7\x8b\x3e\x59\x4c\xfa\x44" +
+		"\xb1\xb2\x5f\x98\x21\x9c\xa1\xbb\x39\xfd\x0c\xf7\xd4\x97\x29\x18\xb2\xbb\x3a\xf5\xd3\xf4\x2c\xfa\xca\xcd\xac\x70\xe1\x5f\x17\xb2" +
+		"\x55\x48\x25\x3d\x0c\xd0\xcb\xa0\x26\x05\xee\x9c\x2c\x3d\x5b\xfa\x69\xdf\xe5\x8b\x3b\x4f\x38\x66\x16\x71\xb4\x70\x76\x8e\xce\x87" +
+		"\x39\x8a\x95\x38\xd3\x85\x25\x1a\x99\x1b\x6d\x40\xbb\x1c\x76\xa7\x54\xd5\x57\xaa\x61\xb9\xbd\x42\x38\x29\x5f\xdb\xd6\x77\x2c\x01" +
+		"\x15\x72\xd8\x36\xca\xb5\xf6\x59\xa2\x15\x83\x76\x61\x4d\x8e\x12\x36\x47\xb6\x94\xcb\x96\x72\x45\x85\xee\xb4\x75\x05\xe2\xcf\x57" +
+		"\x5b\xcc\x57\x84\x24\xa1\x53\xb8\x3a\x47\x7d\x3c\xd4\x3a\x98\xf2\x5e\xc6\x0c\xed\xd2\x92\xb9\x33\x47\x9b\x0d\xd1\xbf\x57\xf4\x05" +
+		"\xe1\x79\xe1\x71\x71\x1e\x13\x1e\xd7\xc8\xb1\x9c\xc8\x26\x87\x1d\xd1\x06\xd7\x52\x24\xd7\x71\xc0\x9d\xcd\xd1\xee\xc6\x5a\x72\xad" +
+		"\xb9\xb2\x70\xae\x33\xe7\x52\xe7\xaa\x6a\x74\x77\x86\x67\xc0\x5b\x06\x57\x20\x4d\x8f\x52\x77\x77\xa4\x40\x0d\x48\xce\x1a\x2a\x4c" +
+		"\x9e\x47\x9c\xdc\x8b\x69\xd5\xdd\x80\xcb\x84\x8b\x92\x70\x47\xb8\x98\x03\x3a\x23\x9e\xee\x66\xc0\x3a\x65\xe3\x2d\xde\xf2\x6e\xe6" +
+		"\x28\xc9\xa4\x46\x52\x48\x0d\x61\x2a\x31\x71\x36\xdf\xd0\x5b\xe7\xa1\xbb\xfb\x91\xcc\x53\xb9\x4f\x8d\x35\xd8\x3c\xc5\xe9\x0e\xd5" +
+		"\xb0\xdb\x44\x37\x51\x6c\xe3\x85\x85\xb4\xc5\x15\xcc\xd5\x74\x99\x90\x95\x1f\x11\x65\x59\xb9\x12\xa3\x91\x34\x61\x8a\x0c\x39\xe2" +
+		"\x19\x50\xa0\x1c\x5d\xab\xa8\x50\xe7\x2c\x3f\xbd\x15\x60\xce\xc9\xfe\x36\x66\xa1\x6d\x9d\xa3\x69\x5a\x7e\x7c\xa1\xeb\xda\x60\x90" +
+		"\x5e\xf8\xe9\x9a\x9c\x2d\x0b\x8b\x72\x75\xd4\x50\x86\x2f\xad\x90\x1f\x67\xaa\x91\xcf\x54\x2e\x4a\xdd\xe2\xb9\x5d\x9b\x93\x0b\x6f" +
+		"\x4e\x5b\x68\xb4\xdb\x3c\x43\xd9\xa2\xd5\x3b\x85\x2b\x75\xb7\x45\x78\x46\xa1\x3d\x2d\xdc\xa3\x91\xdd\xc2\x4d\xc2\x8d\x7f\x43\xf3" +
+		"\x87\x4e\xbb\xae\x54\x9d\x28\xe4\xa7\x3b\x16\xe2\xd8\x11\x92\xc5\x5a\x6b\x91\x1a\x8b\x73\x55\x72\xb9\x4a\x36\xe1\x79\x05\x66\xd7" +
+		"\x61\x67\x09\x58\xf7\x1d\x03\x36\x6b\x78\x7d\x68\xa6\x67\xb0\x7a\x39\x6d\xbf\x06\x3a\x84\xbb\x84\x01\x1a\x91\xfb\xe9\xba\x92\xb2" +
+		"\x2e\xa4\xec\x19\xe1\xad\x78\x03\xe6\x31\x65\x6a\x43\xfb\xa1\x9c\x73\x5b\x78\x54\x1c\x47\x84\xf9\xea\x2b\x79\xc2\x7d\xc2\xce\x20" +
+		"\x05\x32\x52\xa1\x32\xcb\x56\x18\xd6\x79\xac\x3b\x89\x6a\x2b\x57\xd4\xca\xd5\xcc\x64\xfc\x48\xe1\x71\x99\x79\x2c\x57\xb9\x28\x3f" +
+		"\x27\x09\x2f\x69\xa4\xa6\xb7\x01\xc5\xf3\xe5\x03\x6d\x04\x37\xa3\x69\x54\x9c\x01\xad\x4a\x80\x93\xb3\x14\xe8\x59\x4a\x5f\xa2\x4b" +
+		"\x9d\xe5\x8c\x63\x40\x8a\x82\x37\x7e\x86\x1a\x52\x68\x17\x63\x3e\x8d\xeb\x66\xf0\x46\xea\xfa\x0c\xd1\x3b\x2d\x7a\x2d\xe5\xf9\xd6" +
+		"\xcc\x89\x34\x95\x80\x9a\x4a\xe2\x54\xfa\xdb\x76\x1e\x14\x4f\xbe\x78\xf2\xb5\xb3\x6a\xd6\xb6\xee\x7c\x2d\xa4\x7c\x5a\xa9\x81\x16" +
+		"\xe1\x24\x29\xbb\x2a\x8a\xca\x06\xe8\xaa\xa2\x48\x83\x8e\x0d\x35\xe8\x3d\x52\x6e\xf7\x0c\xb9\x70\x56\xd7\xee\x74\x40\xd4\x29\x72" +
+		"\xb8\xce\x62\x29\xce\x64\xf0\x46\xcf\x55\x51\xcd\x52\x51\xcd\xd2\xe9\xbe\xd2\xf1\x1b\xc5\xca\x2e\x63\x4b\xaa\xf8\xff\xe2\x2a\x99" +
+		"\xa9\x0d\x65\x5c\x9a\x64\xc9\x79\x69\x34\xbc\x5d\x02\xb7\xc8\xc2\xfa\x58\x1e\xc6\x14\x99\x85\xc2\x05\xc2\xd9\xc4\xe8\x22\xe2\x8f" +
+		"\x4f\x1e\x72\xf6\xdd\xd8\x7a\x19\x7e\x8e\xae\xf0\xce\x96\xc8\x36\xae\xfc\x78\x6a\x65\xd7\xc1\x2c\xb9\x46\xbd\xbe\xa7\x01\xc3\x15" +
+		"\xfb\x93\x95\x91\x77\xc1\x69\x3e\x9e\xda\x75\x3d\x4d\x65\x5d\xe6\x27\x83\x32\x29\xc8\xe8\x33\x9c\x1d\xb1\xf4\x15\xa7\x26\xee\x3b" +
+		"\xc4\xe4\x2e\x38\x78\xb7\x03\xa4\x92\xa3\x36\xdb\x45\x1e\x3a\x26\xf6\xa7\x3f\x49\xe5\x4a\xee\x08\x2d\xdf\x4a\xb6\x49\xf4\xf7\x52" +
+		"\xb9\x60\x91\x78\x4f\xf6\x63\xca\x04\xc3\x5f\x00\xa1\x5e\x83\xf9\x72\xc0\x29\x8f\x8b\xf6\x65\xa1\x51\xf3\x21\xd4\xcd\x67\x40\x29" +
+		"\x87\x16\x88\xb1\x4c\x0a\x9c\xe3\xcc\x2c\xb5\xa7\x4d\xd4\xd1\xbf\xb1\x40\x9d\x44\x2c\x73\xf5\x83\x56\x0c\xb3\x59\x6a\x2c\xe1\xa8" +
+		"\xaf\x39\x5b\xfa\x0b\xd7\x08\x2f\x49\xa9\x8b\xc2\x51\xea\x4a\x23\x85\x1b\xe4\x94\x8b\x14\x70\x9c\xca\x4f\xa1\x7b\x1a\xa8\xc7\x78" +
+		"\xad\x58\xa8\xe8\xb5\xf7\x33\xb8\x75\x12\x94\xad\xc5\x5b\x68\xed\x8d\x68\x73\x58\xcd\xfc\x4b\x93\xdc\x83\x15\x7a\xab\x19\xea\x6d" +
+		"\x62\xcb\x22\xdb\x0c\xfa\xa0\x99\xc2\xaf\x07\x0c\xde\x72\x19\x35\x4c\x4f\xa0\x37\xa2\x0c\xee\xba\x06\xa6\xaa\x5e\xd3\x84\xe5\xc2" +
+		"\x8d\x84\x9d\xba\xd9\x50\xaa\x97\xc8\x43\x2c\x01\xcd\x07\x74\x7c\x52\x3a\x8f\x57\x21\x49\xd2\x15\x66\x86\xef\x72\x89\x6c\x92\xce" +
+		"\xe3\xe3\x0c\x0f\x37\x4b\x52\xa6\x52\x33\xa3\x4a\xd9\xa7\x73\xe0\xe6\xc2\xae\x23\xc6\x88\x39\xfa\x49\x65\x8e\xba\xcd\x34\x67\xe8" +
+		"\x79\x9b\x98\x78\xea\xa0\xb3\x84\x01\xf3\xb5\x5a\x19\x05\xf6\x2b\x9d\x1f\xe9\x3c\x7e\x76\x9e\xf0\x17\xb0\x7a\x08\xcf\xca\xd4\x3d" +
+		"\x62\x4c\x9e\xe8\x72\x62\xd4\x1c\x62\xe2\x51\xca\xf2\xe6\x48\x56\x1b\x47\xa2\xb7\x66\x2a\x05\x84\xfb\x1f\x34\xe0\x04\xa1\x43\xd7" +
+		"\xeb\x8b\xd5\xb9\x84\xfb\x35\x12\x17\x7a\x27\xe9\x1d\xd0\xd5\x39\xa2\xef\xec\x5d\xda\x42\x74\xc3\x42\x95\xfc\x83\x91\x81\x6b\xc4" +
+		"\xf8\xab\xa2\x57\x6a\xdf\x69\xa9\xe8\x7a\x98\x5b\x56\xab\xb8\xc6\x33\x6c\xcc\xaf\x26\xe3\x36\x56\x15\x39\x05\x21\x74\x0a\x2a\xc9" +
+		"\xd3\x29\x3d\x4f\xd9\x9f\xa7\xd7\x66\xa2\x37\x50\x9c\xaf\xb5\x22\x32\x9e\x98\xfc\x2d\x4b\xf0\x0f\x97\x89\x4b\x34\xa9\x39\x4f\xed" +
+		"\x89\xe8\xb5\x8b\x5e\x2b\x93\x4e\x47\xdd\x7d\xc3\x0a\xcf\x80\xed\xba\xb9\x89\xe8\xdb\x28\xba\xb0\x9a\x58\x20\x6c\xd3\xc8\x56\xe1" +
+		"\x8e\x3c\x17\xe1\xdf\xa3\x50\xdf\x8e\xe6\xbf\x46\xd1\xc0\x61\xe1\x21\x61\xb2\x5c\x51\x1b\x65\xf0\x36\xaa\x71\xed\x52\xec\xce\xb2" +
+		"\xd0\x52\xfa\x1a\xdc\xe8\xbe\x06\xdf\x89\xbc\xae\xc7\x9d\x86\x05\x7a\x88\xe0\x9d\xab\x12\xb0\xa4\x5e\x03\xfc\x16\xea\xb6\x8a\x7c" +
+		"\x0f\xab\xcb\x76\xd7\xa8\xe0\xd5\x84\x87\xe7\x4b\x3d\xf9\x75\x6b\x85\x26\x71\x55\xd7\xbc\x50\x9b\x85\xd4\xe3\xef\xce\x87\x4a\x23" +
+		"\x4e\x42\xc8\x79\x17\xe6\xe8\xbd\xff\xb3\x96\x80\x8b\xcf\x1a\xb0\x20\x68\x70\xa1\x23\x69\x4a\x89\x8e\x13\xa1\x63\x7d\x89\x0b\xff" +
+		"\xa3\x43\x6f\xb8\xd6\x2d\x21\x4b\xb0\x38\x5f\xf5\x2c\x8d\x2f\x0a\x47\xa8\x18\x8a\xe5\x4b\xdd\x3c\x4c\xec\x76\x48\x74\x43\x83\x5e" +
+		"\xa7\x36\xa8\x23\xd6\xc9\xbb\x2e\xfc\x2b\x4b\x53\xd0\x10\xb3\xae\xd2\x85\xbf\x9b\x39\x9a\xc5\xec\x3d\x92\xa5\x16\x2b\xa6\x54\x62" +
+		"\xe2\xfe\x03\xfa\xad\x96\x9a\x5e\x97\x2d\x2b\x19\x98\x60\x4b\xb5\x9a\x2a\x35\x88\x4b\x0f\xe9\x41\xbb\x10\x2a\xa5\xb9\xca\xaf\x4a" +
+		"\x33\xa0\x35\xc6\x60\x13\x14\x86\x1d\xda\x7d\xb7\x0b\x17\xca\xc5\x0b\xea\x23\x1d\xd1\x97\x17\xda\xfa\xd8\x77\xfc\xed\xf3\xe4\xd1" +
+		"\x06\x79\xb4\x41\x12\x15\xc2\x02\xcf\x80\x24\xb9\xbb\x52\x62\xe6\x09\xef\x48\x99\xdb\xc2\x53\x55\xce\x1c\x16\x79\x06\x57\x2c\x2d" +
+		"\x2e\xc9\x98\x8b\xc2\xf5\x8d\x3a\x89\x37\xca\xb5\x62\xcf\xed\x6e\xe8\x7b\x55\x96\xd6\xd1\xd2\xf8\xdb\x1d\xda\x99\xe9\xfd\x98\xd3" +
+		"\x25\x0a\x3e\xd7\xe8\xb6\xbb\x5c\x93\xe8\x02\x97\x11\x6a\x9e\x34\x2a\xaa\x75\xae\x16\x09\x35\x52\xe1\x42\xdd\x5d\x10\xe2\xe1\x2c" +
+		"\xaf\xb5\xbc\xab\xf0\xa6\x70\x69\x6f\x72\xa3\x1a\x71\xb9\x1a\x71\xb9\x8b\xa4\xe0\xa8\x00\xe3\xa2\x9b\x7b\x34\x7d\xb7\x70\x57\xa9" +
+		"\xde\xd4\x89\x3e\x4a\x8c\x3e\x42\x4c\xcc\xa7\xc2\xee\x48\x47\xe4\xa9\x68\x60\xe8\xa9\x68\x6d\x85\x7e\xb1\xad\x90\x9c\x45\x52\x7e" +
+		"\x91\x3a\x82\x54\xdd\x14\xe4\xa3\x64\x5d\x57\x07\xbb\xa4\xe4\xba\xea\x31\xbe\xf2\x7a\x5d\x9e\x8b\x1c\xc0\x16\xe5\xe9\xe5\x6f\xa8" +
+		"\x7e\xd5\x1e\xd6\x10\x6d\x83\xfa\xbb\x67\xc0\x72\x51\xab\x85\xfb\xee\x31\x60\xe7\x3d\x06\xb7\x46\xd7\x0d\xda\x11\xea\x2b\x44\x4b" +
+		"\x83\x13\xe4\x68\xd5\x56\x7b\x6b\x3f\xf3\x6a\xa9\x94\x6f\x13\xfb\x56\xe1\x39\x9a\xdf\xed\x6c\x63\xe4\xb1\x03\xa1\xc7\x8e\x73\x05" +
+		"\x2e\x72\x04\x3f\x5e\xe0\xc2\xff\x02\xd4\x5b\x23\xf5\xf7\x69\x20\x18\x79\x46\xd1\x44\x0b\xd1\x37\x42\xba\xf5\x34\xd8\x76\x79\x79" +
+		"\x85\x54\xd1\x6b\xc3\xea\xc6\xbb\x1c\x3e\x83\xbb\x29\x6a\xd2\x62\x75\xc7\x68\x43\x54\xad\x66\x9c\x64\xe5\xf8\x4e\xd4\x89\x59\x53" +
+		"\xae\x37\x3a\x73\xe1\x3a\x89\x0f\x75\x34\x7f\x68\xf7\xba\x26\x11\x57\x85\x23\x38\xe2\x86\x87\x76\x21\xe1\xc2\xde\x06\xd7\xaa\xbc" +
+		"\x9c\x51\x18\x3e\x1a\xa3\x8f\x01\x0f\x1b\xdc\x4c\x8d\xe4\x08\x6b\x62\x0c\x68\x24\xcc\xee\x67\xf0\xd5\xaa\x08\x16\x2b\x4f\x6f\x7d" +
+		"\xcd\x12\xdc\xd0\x8e\xbb\xcf\x1c\x3e\x6e\x6f\x85\x91\x63\xaf\x65\x89\x2e\x6c\x26\x5d\xd0\xac\x38\x6a\xa4\x51\x58\x45\x93\xdc\xfc" +
+		"\x05\x72\xa4\xba\x55\x91\xca\x4c\x03\x4b\x24\x20\xb4\x6f\x2e\x92\x80\xc6\x66\x95\x2e\x47\xbc\xed\x44\xd7\x46\x8c\xde\x1c\x52\x53" +
+		"\x7a\x55\x0b\x37\x84\x9e\xa2\xfb\xb3\xc2\xe4\xe2\x85\x1a\xc8\x8f\x35\xb8\x76\xb5\xd1\x4a\x0d\xcc\x0b\x0d\x57\x3b\x73\xc1\x3c\x15" +
+		"\xe1\x7a\xa5\xd6\x3a\xa1\xa4\xbb\xad\x85\xda\xff\x84\x1d\x21\xd3\xe4\xb4\x2c\xe1\xb6\x42\x17\x79\x6a\x5f\x5c\xa5\xe7\x94\xaa\x48" +
+		"\x2b\x74\xc3\x8b\x3e\xcd\x53\xa2\xe6\x31\x2f\xd6\x80\xcb\x72\xe1\x08\x25\xdf\x89\x21\x06\xf4\x35\xa0\x7f\xe8\x3b\x45\x3c\xc1\xee" +
+		"\xab\xd9\xc3\x88\xde\x50\xd1\x0d\x92\x5d\x2f\x9c\x25\x9c\x29\xbc\x24\xdd\xd3\xf9\x53\xe1\x6e\x2d\xb3\x4c\x09\xb2\x54\xb8\x47\x23" +
+		"\xa1\xf1\x85\x62\x5f\x20\x3c\xb3\xc4\x19\xec\xf4\x12\x99\x24\x75\xb3\x8a\xd4\xdf\x44\x57\x88\xbe\xd2\xe4\x0c\xc1\xcb\x4d\xda\xcb" +
+		"\x34\x32\xae\x28\x62\x9e\x85\xcc\x5b\x2b\x9c\x7d\x9f\x01\x9b\xef\x0b\xe7\xd0\x68\x8d\x65\x16\x39\x73\xc8\x10\x3d\x85\xee\x72\x93" +
+		"\x6b\x45\x87\x76\xc5\x1e\x06\x3c\x1b\xde\x02\xa2\x0c\xbe\x62\x31\xe6\x17\xb9\xc8\x63\xc2\x58\x35\xc9\xa4\x46\x65\x33\x95\xf0\x5d" +
+		"\x6d\x8a\xa8\xeb\x0b\xa9\x5b\x46\x74\xdd\x0d\xa1\x85\x12\x17\x75\x38\x1e\x00\xf6\xa9\xf8\x42\xcf\xd2\xc2\x45\xe2\x6b\x2c\x52\xae" +
+		"\x88\xae\x16\x9d\x23\x07\x9c\xa6\xe6\x7c\x92\x7c\x8a\x21\xbc\xcb\x84\x89\xbc\xb1\x54\x99\x57\x43\xe5\xad\xba\x56\xce\x93\x4e\xa7" +
+		"\x85\x67\xea\xf4\xde\xb4\x4e\xec\x35\xa4\x27\xd4\x28\x0b\x25\x60\xd3\x7d\x06\x97\xab\x45\xda\xb4\xc7\xbc\x6f\x70\x9d\x62\x9e\xa6" +
+		"\xc7\xc9\x74\xe1\xa8\xd9\xce\xe0\x1f\x39\x5b\x45\xae\x89\x85\xb4\x29\x24\xe9\x30\x07\xdc\xa1\x22\xed\xcc\x3a\xbc\x68\xb7\xdf\x5d" +
+		"\xd4\x75\x6e\x98\xa2\x3e\x31\x59\x78\x90\xca\xfa\x0f\x34\x6b\x0b\xe2\x14\xeb\x2c\x8a\x9c\x46\x5d\xeb\x7c\xc5\x95\x77\xa3\x2e\x37" +
+		"\x47\x56\x46\x90\x09\x27\xaa\x44\x58\x4c\x4c\x1c\xd6\xa1\xd6\x38\x98\xc1\x0e\xd9\xc7\x61\xdf\x84\xd9\x8a\x82\x62\x71\xbd\x9f\xc1" +
+		"\x72\x35\xd0\x36\x5f\x4f\xb6\xf3\x55\xa6\x5a\x79\x7b\x91\x34\x92\xdd\x07\xea\x23\xd2\x9d\xa4\xa3\x50\x74\x81\xe8\x5b\xcb\x9c\x21" +
+		"\x78\x73\x99\x42\xa5\xfc\x68\x14\x36\xf0\x6e\x74\xfd\x6c\xf5\x76\x35\x86\x03\x01\xfa\x42\x1b\xc8\x5a\x0d\xff\x0f\x23\x24\xaf\x2c" +
+		"\x14\xea\x5f\x7b\xcc\x76\xe6\xb0\x4f\x06\xee\x15\x4e\x52\xd2\xa7\x0a\x37\x69\xe1\x8d\xb3\x55\x1e\x52\x6b\xb7\xb0\x4d\xe3\x5b\x67" +
+		"\xab\xe2\x15\x90\x8e\x50\x40\x66\xeb\xd9\x3a\xc4\x2f\x7a\xf7\x5d\x1e\x64\xf3\xb8\x76\x5d\xa9\x30\x4c\x0a\xf4\xe1\xf3\xdb\x73\x96" +
+		"\x60\x13\xf6\xaa\xb5\xc9\x67\xc7\x66\x6b\x03\x15\xc3\xb7\x0d\x96\x46\xca\x8d\x28\x0e\xbf\xc2\xc6\xb5\xfb\xb9\x6d\xcb\xfe\xf5\x72" +
+		"\xec\xf2\x7e\x2c\xa4\xe2\xc8\x59\xdf\x42\x6f\x19\x66\xb1\x90\x63\x66\x16\x46\xde\x9c\x23\xf4\xe6\x7c\xaa\xee\x4a\x2a\x92\x8b\xf5" +
+		"\x25\x9b\xe8\x95\x7a\x82\x6f\x29\x51\xc3\x2d\x51\xc3\x2d\xe9\xfa\xfc\x6a\xbd\x9a\x63\xb8\x45\x8a\xe3\xbf\xc3\xa9\xb0\x5c\x73\x47" +
+		"\xa8\xaf\xac\x8a\x31\x58\xad\x06\x36\x14\x2b\x87\x44\xaf\x26\x7a\xab\x34\xd2\xa0\xc9\x5f\xe5\x09\x51\xd4\x94\x26\xf5\xb6\x41\x7c" +
+		"\x5f\xb0\x47\xe9\xa0\x29\xc7\xd9\xe2\x9a\x9b\xe4\x3a\xf5\x83\x8e\x5a\x95\xa0\xce\x0a\xd3\x85\x29\xa5\x3a\x78\x8a\x3e\xcc\x49\xbe" +
+		"\x43\xc5\xe2\xa1\x2a\xde\xf4\x6a\x6d\x7c\x1a\xb9\x1e\x72\x07\xd7\xf3\x66\x96\xe8\x91\x4a\x81\xde\x2c\x1c\xc1\x91\x6e\x21\x6d\x8e" +
+		"\x53\x9a\x3b\x56\xaa\x60\x69\x24\xeb\xae\x3b\xfc\xf3\x45\xcb\x02\x57\x4f\xd7\x20\x59\x79\x36\x4e\x98\x43\x0d\x7d\xd3\x6b\x75\x5e" +
+		"\x56\x8c\xcf\x37\x46\xdc\xea\x42\x6e\x3d\x23\x07\x56\x07\x0d\xa8\xd5\x37\x2d\xdd\xf9\xd7\x40\x6a\xf4\x41\xda\x5e\x67\x09\x31\xf3" +
+		"\xe4\x83\x31\x2d\x8c\xc4\xe8\x16\x15\x9d\x9c\x30\xb2\x29\xf2\x02\x03\x3b\xba\x19\xdc\x4e\x6d\x5f\xa3\x2a\xf5\x8a\xbb\x52\x9b\x98" +
+		"\xd8\x6f\x0b\x77\x35\x47\x9e\x95\x86\xeb\xe6\x58\xe1\xad\x6e\x14\x25\xad\xa6\xaa\x48\xd3\x84\x23\x96\x12\x2f\xf7\x30\xd8\x38\xf1" +
+		"\xad\xa0\x41\x51\xcb\x17\x28\xa3\x94\x68\x79\xc2\x53\xc2\xea\x07\x69\xed\x43\x06\xb7\x79\xb9\xb6\x10\x8d\x16\xfb\x0d\xc8\x68\x72" +
+		"\xe6\x90\x5f\xd9\x95\xaa\x6c\xd6\x33\x75\x95\xb9\x50\x0f\xb4\x0b\xb5\x03\x54\xea\xed\x62\xa5\x34\x95\xbf\x76\x0a\xdb\x84\x15\x51" +
+		"\xe1\x4e\x1f\x34\xc0\x33\x58\xb1\x18\x4b\x2a\xd5\x0e\x44\x5f\x52\xb3\xb8\x28\x5c\xaf\x91\x75\xa1\x71\x99\xbd\x3c\xde\x90\x78\xed" +
+		"\x8b\x96\x80\x71\x0f\x18\xac\x43\x83\x95\x95\xfa\x96\x89\x88\xba\x88\x7e\xd7\xfa\x18\xbc\x39\x72\xd9\x44\xd9\x32\x41\xb8\x58\x6b" +
+		"\x35\x55\xaa\x42\x56\x12\xf9\x77\x16\xdc\x1a\x2d\x78\x94\xc3\x8f\x1c\xe1\x43\x08\xc6\xd4\xe8\x37\xe2\x1a\x59\xb8\x48\xc7\xec\x45" +
+		"\x91\xbe\xe4\x0f\xf5\xa5\x4c\xf9\x38\x43\xb8\x4d\xc2\xa6\xd3\x2d\xb5\xa1\x33\x0a\x87\xa3\xb7\xd7\x29\xb1\x5a\xf4\x4b\x70\x7f\xbe" +
+		"\xaa\xdc\xad\xa5\x97\xe9\x9d\xd4\x32\x35\x22\x55\xc1\xc6\x5a\xa5\x07\x95\x0c\x8e\x5e\xae\x33\xa2\xa2\xb9\x57\x38\x89\x39\xe1\xa5" +
+		"\x36\xc8\xaf\x12\xb9\x53\x98\xbf\x44\xbf\xca\x2c\x91\x8b\x84\x17\x85\xdb\xd8\x68\x82\xed\xb3\x95\x20\xd2\x3e\x69\x91\x7c\x25\x9c" +
+		"\x27\xcc\x5c\xa5\x0c\xe7\x81\x61\xbc\x84\xcd\x55\xb2\x4c\x30\x83\x97\xdb\xdc\xf5\x4a\x62\x21\x6f\xf9\x16\xd4\xc9\x24\xda\xd8\x77" +
+		"\x3b\x31\xf1\xf8\x1e\x6e\xb1\x15\xb2\x66\x25\x75\xec\xde\xd2\xa8\xda\x2a\xd3\x99\xaf\x4c\x75\x29\x9c\x29\xcc\xd7\x78\x9e\xe8\x7d" +
+		"\x5c\x28\x76\xef\x52\xcd\x2d\x53\x6b\x2a\x53\xad\xd7\xea\x7b\x82\x5a\x17\xfe\x12\x7f\x3f\x57\x0a\x76\x68\xbd\x3d\x5a\x6f\xe6\xee" +
+		"\x48\xaf\xe8\x11\xea\x15\xe5\x9c\xe9\xcd\x29\x53\xf4\x89\xae\x56\xf4\x16\xde\x75\x9b\x4b\x15\x33\x8d\x34\x0a\x0f\xaa\xdc\xf2\x8d" +
+		"\xaf\x0b\xe5\xaa\xb1\x74\x83\x97\xb4\x4a\xed\xac\x4a\x9f\x0c\x55\x45\x68\xac\xaa\x8a\x9c\x08\x3d\x9e\x08\x5d\x0f\x18\x50\x25\x21" +
+		"\xf3\x85\x35\x72\xe6\x30\xd6\x7c\x53\x74\x24\x00\x35\xba\x37\xd6\x0c\xb8\xd9\x93\xea\xb0\x60\x53\x35\x38\x51\xfd\x6b\x82\x70\xae" +
+		"\x52\xa5\xa2\x39\xf2\x36\x3e\xf1\xc2\x97\x2c\x01\xb5\x7f\xd7\xf5\x79\xf8\x19\x63\x0c\x28\x7c\xbb\x19\x2c\x7d\x8d\xa2\x2b\xcf\x4d" +
+		"\xe3\x40\xa7\x52\x65\x35\xa7\xfa\x56\x55\xc8\xf0\x0a\x19\x2e\x7a\xad\x74\x5b\xb3\x28\x32\xee\x0f\x8d\xdf\x58\xec\xb8\x5d\x2d\x56" +
+		"\xe7\xa0\x7b\xfd\x37\x57\xaa\x0b\x84\x7e\x1e\xe4\x33\x53\xa8\xdb\x4c\x53\x86\xa6\x0b\xc7\xea\xbc\x90\xd4\xac\x4a\xe1\x5d\xef\x48" +
+		"\x8b\x4a\x4a\x23\x85\xdd\xcc\xa1\xfd\x7e\x7e\xb2\x37\xb7\xeb\x47\x1c\xa0\x32\xde\x60\xa1\x66\x9f\x23\x2d\xc6\xd1\xa8\xd0\x4f\x3b" +
+		"\x35\x6b\x75\x00\x5b\xab\x16\xd0\xa2\xf7\xb5\x2d\xda\xd0\x78\xd7\xe5\xce\x8d\xd0\x08\xd1\xe7\x56\xeb\xf5\xd2\x6a\xad\xbf\x8e\xd8" +
+		"\xfc\x60\xd7\xaf\x2a\x89\xd7\x76\xeb\x08\xcd\xfd\xb3\xb4\x07\x03\xcc\x8d\xb3\x45\x1f\xcc\x54\x85\x7e\xc7\xe8\xcd\x4f\x98\xe5\xb8" +
+		"\x3d\x74\xa2\xb7\x9b\xe8\x36\xfc\xcd\x59\xbf\x4e\x8f\xfc\xb5\xc2\x1b\x73\xe4\x21\xd1\x5b\x78\xd7\xdb\x5c\xa5\xe2\x23\x06\x76\x8a" +
+		"\x9e\x26\xdf\xa7\x0b\x8f\x6b\xe4\x58\x55\x84\x0e\x86\xe8\x85\x32\x72\xc1\x5a\x79\x57\x25\xde\x29\x2c\xd1\x0f\x72\xc5\xe5\x5d\xbb" +
+		"\xf0\x85\x5a\x17\xfe\x4a\xb6\xb3\xf6\xd3\xbc\x1b\x24\xa3\x75\x6d\xe4\x04\x8c\xd0\x09\x58\x73\x02\xe7\x6b\xef\xd6\x6e\x59\x6c\xf8" +
+		"\xdf\x3b\xf8\x76\xea\xfa\x82\xb2\xfa\xbc\xb0\xa4\x55\x2b\xb6\xea\xe5\xa5\xbc\x35\x7a\x97\xd2\x51\xa5\xbd\x24\x60\x40\xab\x12\x62" +
+		"\xec\x3a\xf5\x8b\x75\x4a\x85\x3a\x17\xfe\x1b\x48\x38\x1a\xe0\x6f\xc4\x1a\xbd\xd0\xa4\x0f\xac\x42\x61\x25\x87\x9b\x5e\x27\x63\x57" +
+		"\xcb\xd8\xd5\x72\x82\x4e\xa0\xc7\x9a\x15\x45\xaa\x1e\x9d\xbb\x56\x3b\x41\x9d\xce\x87\x75\xaa\x7d\xa2\xaf\x45\xf4\x3e\xe1\x5e\x61" +
+		"\x95\x92\xf1\x40\x9c\xc1\x5a\x35\xb0\x45\xb8\xf9\x6e\x2f\x75\xf5\xc2\x6d\x75\x5d\x87\xdf\x61\xac\xaf\xa1\xec\x65\x1b\xeb\x22\x31" +
+		"\xc6\xf4\x80\xc1\x35\x85\x02\xa4\x12\x39\x56\xa1\xbd\x5a\x78\x5a\x78\xb4\x55\xcf\xe6\xad\x91\x07\xeb\xa0\x1e\xac\x13\x93\xe9\xa2" +
+		"\x9e\x1b\x77\xaa\x8f\x49\x9f\xe2\xa8\x70\x9d\xbb\xab\xf2\xc2\x0d\xb9\xf8\xba\xf0\x9a\xe6\xb7\xc4\x19\x62\xe6\xa9\xfe\x4f\x6d\x70" +
+		"\x86\xb8\xce\x0d\xa2\xe5\xa8\xa5\x7e\xce\x61\x6e\xce\x94\x6e\x35\xd2\x73\x58\xaf\x48\x0d\x94\xcb\x98\xa0\x01\xd5\x21\x13\xa4\xf7" +
+		"\x6e\x61\x9d\x2a\xb0\x56\xb8\x48\x77\x1b\x85\xc7\xa5\x59\x6d\x77\x43\xb0\x42\x03\xbb\xa8\x9a\xed\x5c\xa7\x12\x64\xdc\xa3\x52\x89" +
+		"\x89\x87\x77\x39\x4b\xf0\x4a\x65\xce\x5a\xad\xbf\x46\x78\x89\x1a\xda\xc5\x0d\x6a\x8d\x94\x10\x38\x50\xa7\x10\x0b\x27\xc6\xb1\x64" +
+		"\xe3\xf8\xf5\x86\xae\xaf\x10\xdd\x65\xd1\x95\x5c\xba\xc7\xbc\x95\x3a\xc8\xe9\xe4\xdc\x21\xcc\xaf\xef\x7a\x19\x93\x25\xea\x30\x0d" +
+		"\xf0\x0e\x2d\xd7\xe9\x43\x23\x17\x62\x0c\x48\x8d\x35\xe7\x15\xe8\x3a\x45\x09\x33\x5e\xb8\x50\x5d\x62\x68\xf8\x71\xd0\x75\x63\xf3" +
+		"\x8d\xe5\x33\x5f\x8b\x2a\xa1\x5e\x95\x50\x7f\xf7\x41\x6a\xab\xb0\xae\x5e\x7f\x4b\x4c\x74\xa1\x6c\x2f\x10\xde\x62\x70\xdc\x4d\x62" +
+		"\x62\x15\x3d\x80\xa1\xdd\x0d\x48\xea\xc9\xce\x29\xee\x95\xcd\x3a\xc2\x85\x9a\x9a\x34\x38\x22\xdc\x54\x1f\x7e\x95\xe1\xdb\x2c\xea" +
+		"\x12\x31\xfe\xa2\xe8\x5d\x9b\x9d\xa1\xe7\xce\xcd\xf2\x90\x1e\x74\x26\x08\x93\x75\xaa\xd8\xdf\x87\x51\xeb\x1d\x9a\xed\xf1\x4e\xe8" +
+		"\x4f\x28\x24\x93\xa7\xd7\xb8\xf9\x3a\x96\x69\x4b\x9b\xd6\xc7\x12\xbc\xbd\x3b\xe4\x4c\x0e\x07\xe7\xcd\x57\x2e\xe9\xb7\xac\xab\xa1" +
+		"\x63\x8f\x46\x2e\x0a\x67\x6f\x22\x16\x09\x73\x42\xe9\x2d\xac\xa2\xcb\x7c\xf3\x17\x69\x0f\x11\x2e\x15\xee\x60\xb6\xc4\x6f\x5f\xa6" +
+		"\xc6\x50\xa5\xaf\x3f\x42\x6d\x4e\x58\x2b\x9c\xb4\xdc\x99\xeb\x9d\xaa\xe0\x4c\x5b\xa7\xaf\xe5\xd6\x45\x5e\x26\xb8\x8b\xa1\x93\x36" +
+		"\xd1\x77\xbd\x5a\xcf\x82\x4a\xa3\x16\xe9\x3c\x56\x67\xa3\xa4\x9a\x08\x1d\x0c\xd1\xd9\xc2\x2c\xe1\x14\xe1\x9e\x01\x06\xf0\x6f\xc8" +
+		"\x05\xd2\x75\x9d\x53\xa3\x9e\x21\x7a\x9a\x90\x7f\x7e\x20\x74\x5d\x53\xd3\x95\x3e\x15\xa2\x1a\x6a\xba\xde\x97\xc5\x64\xac\xec\x3a" +
+		"\x94\x2c\xa9\x09\xff\x12\xe1\xad\x13\xb5\x52\xd8\x22\x9c\xa1\x52\xdc\x7c\x0f\xff\x01\x68\xe8\x57\x28\x61\xb3\x70\x47\x8d\x3e\x7c" +
+		"\x0b\x3d\x9a\xd7\xe8\xcd\xf7\x60\x4b\x40\x9e\x0c\xda\x56\xa3\x0f\x29\x6a\x54\x0b\x35\x5d\xaf\xc3\x76\xde\xbd\x76\x07\x42\x4f\xe2" +
+		"\xc2\x3b\xf7\x1a\x70\x80\x85\xe3\xce\x48\x6a\x67\x8d\x2a\xb2\x46\xbf\xe0\x8a\x3e\x59\xa3\xef\x5c\x44\x4f\xd2\x91\x3b\x55\x78\x4a" +
+		"\xe3\x21\xfe\x31\x0b\xc2\x0b\xd9\xed\x90\x27\x17\xa8\xfb\x2e\x50\x72\x09\x27\x08\x53\x84\xa3\xf8\x17\x6b\x52\xd7\x76\xf9\x61\x86" +
+		"\x98\x73\x17\xa8\x1e\x84\x5b\x85\x39\x1a\x9f\x2e\x7a\x96\xd0\x0c\x16\xe2\x2b\x23\x5a\xa9\xe8\x93\x0b\x5c\xf8\xfd\x4b\xb4\x01\xc5" +
+		"\x7f\xc3\x3f\x53\x78\x5c\x78\x4c\x78\x46\x78\xfa\xae\x8c\xc4\x2b\x0f\xf3\x7d\xef\xf6\xc8\x5b\x3c\x7f\xe8\x2d\xde\xc2\x05\xda\x05" +
+		"\x44\x2f\x11\x4e\xe2\xdf\x4e\xdc\xd8\x1e\x79\xfe\xc0\xf2\x05\x77\x1f\xe5\x57\x09\xd7\x0b\xb7\xdd\xc3\xe2\xbf\x87\xaf\xdf\x75\xc0" +
+		"\xbe\xa1\xd1\xeb\x0b\x22\x07\xef\x40\xe8\xe0\xbd\x8f\x23\xde\xde\x90\x14\x8e\x04\x57\x2d\x94\x8e\xc2\xd3\xc2\xb6\x85\xce\xdc\xc0" +
+		"\xad\xa4\x13\xc7\xec\x74\x96\xe0\xd6\x49\xd5\x15\x6a\xf8\xcb\x5b\x55\x87\x7a\xa5\x3f\x3c\xf4\xbe\x90\x87\x05\x7f\xeb\x0a\x05\xa1" +
+		"\x8d\x38\x8e\xe1\xbf\x29\xc6\x29\x64\xe9\x3e\xb9\x41\x7d\xba\x21\xdc\x1a\x50\x21\x6a\x07\x1b\x46\xb7\xed\xab\x95\x88\xec\xe4\x5e" +
+		"\xcb\x5a\x09\xd4\xb6\xdb\x2a\x5c\x2d\xce\x55\x0d\x11\xda\xad\x6a\xe8\xda\xba\xb6\xe9\xba\xbd\x41\x0e\x97\x94\x63\xc2\x31\x6b\xf4" +
+		"\xf1\xd9\x1a\x55\x50\x43\x38\x88\xc1\xed\xa2\xae\x6c\x75\x06\xbb\xbc\x55\xad\xaa\x4d\xcf\x0f\x6d\xaa\xdb\x06\x67\x33\x5c\xdc\x45" +
+		"\x31\x25\x6f\x21\x0e\xef\x1f\xaa\xaf\xa8\xf1\x8d\xae\xeb\x95\x3f\x8e\xf3\x85\xd4\x75\x45\x24\xa7\x31\x9c\x80\x51\x69\xa2\xf2\x85" +
+		"\x79\xc2\xc3\x4a\xef\x43\x1b\xd4\x57\x39\xe2\x0a\x1a\x95\xc0\x74\x4a\xf7\xce\xd6\xae\x3c\xbc\xa2\xd1\xcb\xc2\x4d\xc4\xe8\x8d\xa1" +
+		"\x65\x1a\xb5\xe9\xde\xa5\xf1\x37\x74\x14\xe9\x19\xce\x81\x3d\xaf\x51\x5f\x09\x37\xaa\x69\x37\xea\x7b\x1c\xd1\x65\x6c\xfb\xbe\xd2" +
+		"\x16\xf5\x36\xaa\xeb\x5f\xda\xae\xae\xa1\x46\x3c\xcc\x67\xb0\x74\x35\xbb\x42\x61\x81\x30\x5f\x98\x27\x1c\x4b\xf6\xb8\xa4\x76\x35" +
+		"\xbe\x45\xfa\x97\x52\x8b\x3e\xdd\x4c\x43\x8f\xee\x5f\xe2\x97\xb9\x8b\xba\x3e\x20\x18\xd3\x14\xae\x8c\xa8\x56\xdd\xdd\xbf\x48\xaf" +
+		"\x2a\xee\xd2\xe0\x9f\xd8\x1b\x2d\xae\xa3\xba\x4e\xee\x6e\xc0\x78\x7e\x98\x78\x60\x91\xeb\xfa\x27\xb4\x12\x13\x15\x62\xdb\xd5\xa4" +
+		"\x6f\xa7\x44\x97\x0b\x7b\x18\x90\x25\x6a\x53\x53\xf8\x9b\x87\x7b\xb6\x36\x45\xf2\xcd\x42\xf9\x76\x2a\xb4\x51\x99\x01\xa1\x26\x3f" +
+		"\x65\x9b\x12\x2b\x68\xc0\xd2\xf5\x5d\xdf\x1d\xcc\x65\xfc\xbb\x55\xb4\xc9\x2f\x5b\xf5\x2d\xcf\x56\xed\x26\x9a\x72\x55\x2a\xf9\xc0" +
+		"\xfc\xa7\x50\xdf\xf2\xd0\xa3\x24\x31\x3e\xf4\xc2\xe1\xf0\x1a\x3d\x7c\xad\x91\x77\x76\x91\xa7\x68\x97\x4e\x0b\x44\xff\x9a\x5d\x9f" +
+		"\x8e\x61\x19\xd1\x95\x36\x46\x7e\x25\x71\xfa\x95\xc4\x4d\xa3\x01\xfe\xb4\xa6\x2e\x4f\x16\xea\xba\xa0\x49\x3d\x93\x1c\x7d\x6f\x2b" +
+		"\xee\xfa\x99\xaf\x44\x3a\xae\x0a\x18\x6c\xef\x7a\x92\x53\xb5\x15\x65\xb3\xb7\x56\xe8\x85\xc9\x8c\xc5\x54\x25\x77\xb1\x5a\xd3\x62" +
+		"\x7d\xc5\xf6\x7f\xa1\xcb\x17\x87\xab\x03\x2b\x98\xec\x9d\x8a\xf6\x29\x45\xac\x73\x91\x74\x5d\xac\x9e\xf7\x37\xcc\x73\x84\x95\x44" +
+		"\x6f\xde\xe2\x88\xd3\x7c\x21\xa7\x2d\xd6\x48\xd3\x62\x17\xfe\xa7\xaa\x93\xb6\xe9\x5b\x9a\x6d\xaa\x97\x36\x7d\xec\xd8\xa6\x5e\x22" +
+		"\xaf\xa4\xf5\x37\x58\xc5\xf2\x88\xe3\xa2\x42\x8e\xcb\x9e\x67\x06\xe4\x85\x0e\xd9\x23\x88\x9d\x4b\x68\x38\xda\xae\x3a\xc3\xd7\x3b" +
+		"\x27\x98\xb9\x81\xf0\x16\x24\x2d\x1d\x1b\x8d\x16\x9f\x37\xec\xfc\x20\x37\xd9\x79\x97\x5d\x10\xde\xe5\x51\x16\x05\xcf\x01\xe1\xcb" +
+		"\xff\x67\xb8\xea\xc3\xad\x5c\xf3\xf8\x3b\xb1\x07\x3c\xe0\x01\xf7\x7b\x40\x26\x2f\xce\xbf\xe5\x01\xe5\xa3\x9c\x07\x8c\xfb\x8a\x77" +
+		"\xd9\xa1\xe1\x5e\x0f\xd8\x43\xc8\xba\xcf\xe3\xdf\x6e\xf4\x80\xf1\x5f\xf3\x80\x4e\xe7\x01\x31\x1e\xb0\xfa\x09\x0f\xd8\xf9\x77\x1e" +
+		"\x30\xea\x79\xce\xa8\xe8\xe9\x01\x19\x8f\x50\x8e\x79\xc0\xdf\x7b\x40\xe9\xdb\x1e\x50\xf6\x5b\x0f\x98\xc8\xf9\x45\x9c\x9f\xc2\x1b" +
+		"\xb9\x5f\xe3\x8c\x65\x14\x53\xf2\xa0\x07\xb4\x3f\xe5\x01\xcb\xa8\x51\x9d\x47\x15\xc8\x9d\x47\x95\x6a\xfe\xdb\x03\x1a\x3e\xef\x01" +
+		"\x67\xfb\x73\xca\x50\x6a\x7c\xbd\xb7\x07\x8c\xfd\x9e\x07\x0c\xff\x82\x07\x4c\xee\xeb\x01\x47\x29\xa6\x83\x97\xb7\x1e\xf3\x80\xc6" +
+		"\x2f\x7a\x40\xe5\xb7\x39\x65\xaf\x47\x61\xe6\x01\x97\xa2\x3c\x60\xfa\x77\x3c\x60\xee\xb7\x3c\x60\xc1\xd3\x1e\x50\xcc\xbb\xa9\x8e" +
+		"\x26\x3e\xc7\xb1\x04\x4e\x39\xee\xf7\x00\x0a\x9c\x45\x07\xb4\x7d\xce\x03\xb6\x57\x38\x0f\x58\x9b\x46\x3c\xdb\xc3\x03\x96\xf3\xfe" +
+		"\x29\x2e\xd8\xf0\x30\xe7\x4c\xa6\x31\xeb\x83\x1e\x50\x40\xfe\x1b\xf4\x6a\x16\x5d\x12\x4b\x5f\xf5\xe7\xb8\xe7\x01\xa3\xfb\x79\xc0" +
+		"\xb0\xa7\x39\x23\xe9\x9f\x3d\x60\x1a\xbd\x38\x82\x70\x93\x6b\x9d\xe8\xe6\x01\x33\xb8\x7c\x7a\xc0\x03\x16\x91\xfb\xfa\xa3\x1e\x90" +
+		"\xff\x77\x9c\x72\xe2\x4b\x1e\x70\x84\xcb\x17\x72\x4a\x3d\xa9\xe4\x5e\x1e\xb0\xca\xf3\x80\xdb\x5c\x2a\x83\xd4\x1e\x7a\xac\xf5\x41" +
+		"\x4e\x99\x36\x80\x61\xa2\xec\x63\x71\x5d\x1a\x8e\x7e\x96\x94\xa3\x9f\x52\x88\x0d\x27\x89\xcd\xe4\x9c\xe6\xe3\xa4\x1f\x7a\xc0\x41" +
+		"\x8e\xd5\x53\x9f\xde\xe1\x6c\x39\xa1\x29\x93\x0e\x13\xb3\x06\x51\x04\xcd\x4a\x1a\xc8\x19\x25\x64\x48\xfb\xbe\x07\x4c\x31\x0f\x38" +
+		"\xc9\x15\x0e\xd0\x8e\xf3\xbc\x5c\xc8\xbb\x63\xba\x33\x07\x7e\x44\xe5\x7f\xc4\x29\xab\x5e\x65\xd0\x9f\x61\xd0\xb9\x72\xee\x33\x5d" +
+		"\x8b\x2a\x59\x9a\x68\xdb\x4d\x52\xb7\x48\x0d\xff\x22\xa7\x9c\xfb\x47\xfa\xe3\x80\xf3\xf4\x5b\x07\x03\xf2\xa6\x07\x4c\xa0\x2f\x7e" +
+		"\x43\x33\x8d\x6e\x3e\xc2\x1b\x29\x25\xc4\x5b\x6f\x6a\x21\x86\xaa\x8e\xa2\xb6\x78\x1e\x50\xcb\xc4\xfa\x9d\x07\x6c\xf5\x31\x84\xef" +
+		"\x32\xa9\xbf\xea\x01\x74\x78\x4d\x1f\x4e\x28\x66\x8e\xec\x63\xb6\xb6\x3d\xce\xb4\x52\xf6\x1c\x3f\xef\x98\x2f\x84\x96\x4b\x12\x9e" +
+		"\xca\xe1\x2a\x8f\x21\x91\x41\x13\x18\xf3\x69\x1f\x78\xc0\x65\x46\x22\xed\x15\x0a\xf8\xb2\x07\x54\x0f\x35\xaa\x54\x4a\x1c\xcf\x70" +
+		"\x8d\x50\x06\x7d\xc8\x49\x75\xbf\x60\x0a\x3f\x44\x97\x7e\x97\xde\xe3\x4c\x65\x1d\xaf\xf8\xdf\x52\x66\x6d\xed\xb7\x3d\x20\xfb\x3d" +
+		"\x4e\x50\xc4\x66\xd2\x86\x16\xea\x7f\x92\x99\x7e\xc5\xa3\xee\xa4\x0e\x7d\xc4\x78\xd2\x94\x76\x06\x67\xd3\xaf\x39\x25\xef\xc7\xf4" +
+		"\xf4\x1b\x0c\x61\x99\x31\x39\x67\x10\xcf\x91\x61\xf4\x4b\x1e\x30\xf2\x49\x56\xd0\x50\xe7\x01\xa7\x3d\x26\xfb\xbd\x9c\x55\x33\x98" +
+		"\x25\xfc\x1f\xcc\xd7\x20\xbd\x40\xa1\x63\x78\x7b\xc4\xd3\xde\xad\xa0\x4d\x98\x6c\x9e\x39\x90\x13\x9e\x0f\x6f\x78\x0e\x9f\xf3\x6e" +
+		"\x3b\x65\x8a\x79\x80\x4f\xff\xbf\xe5\xac\xbb\x77\xa3\xbf\x3f\xd2\x87\xa2\xe0\x0d\xfb\x35\xd6\xb8\xff\xed\xae\xf5\xbf\x0d\xa3\x76" +
+		"\x46\x05\xf1\xff\xbd\xd8\xcf\xe0\x33\xf8\xbf\xcf\x3b\xfb\xcc\x09\x9f\xc1\x67\x69\xf7\x19\x7c\x96\x76\x9f\xc1\x67\xf0\x59\xda\x7d" +
+		"\x06\xff\x7f\x49\xbb\x68\x78\xd7\x07\x05\xba\x7d\xe6\xac\xcf\xd2\xe4\xff\xa5\x98\x28\x78\xd7\x5c\x74\xe4\xf2\x53\xe0\xc7\xff\x31" +
+		"\x00",
+	"/GBK2K-V": "" +
+		"\x6c\x8d\x31\x8a\xc2\x60\x10\x46\xbf\x79\xc9\x66\xb3\xab\x06\x2d\x2c\xc4\xc2\xc2\xca\x42\x41\x0f\x60\x91\x26\x42\x0e\x33\x77\x10" +
+		"\xec\xb4\xf1\x08\xb9\x81\x51\x10\x6c\xbd\x8a\xb5\xe0\x05\x44\xfe\x90\xd2\x66\x98\xf7\xf8\xe0\xfd\x16\x79\xb9\x2a\xe7\x9b\xa8\xc8" +
+		"\x97\x9a\x51\xdd\xd1\x3b\x45\x8a\x48\xb4\x40\xb6\x75\xa4\x9d\x13\xf7\xa6\xac\xb5\x77\xaa\x48\x27\x27\x31\x40\x03\x4c\x37\xa7\x63" +
+		"\x57\xc7\x74\x09\xe7\xec\x8c\x55\x87\x4f\x98\x0e\x81\xfe\x1a\x90\x8e\x4e\x65\x7a\x3e\x0c\x13\x98\xfa\x98\x86\x98\x32\x46\x12\x13" +
+		"\xc5\xad\x0f\xe9\x1f\x6a\x53\xda\x8a\x2e\xd6\xa4\xfe\xbf\x0e\x5f\x89\xb2\xcf\x00",
+	"/GBKp-EUC-H": "" +
+		"\x64\xbc\x77\x7c\x55\xd7\x99\x35\xbc\xf6\xba\x57\x57\x12\x92\x10\x60\xc0\xdd\xd8\x13\x3b\x8e\xed\xbc\xe9\xb1\xe3\x99\x49\x26\x72" +
+		"\x26\x65\x9c\xd8\xce\x3b\x33\x4e\x26\xb1\xe5\x4c\xc6\x6d\x26\xc5\x4e\x26\x93\x99\x49\xa7\x19\x44\x17\x5d\x48\x08\x24\x10\x02\x24" +
+		"\x10\x55\xa0\x86\x10\x4d\xf4\x0e\xa2\xf7\x0e\xa2\xf7\xfe\xfd\xd6\xba\x57\x97\x7c\x79\xff\x59\xbf\xe7\xec\xf3\xec\x67\x3f\x7d\xef" +
+		"\x73\xee\x91\x10\xf9\xe6\x4b\x9f\x65\x40\x77\xf6\xf8\xea\x9d\x3b\x47\xbb\x85\xc7\xdf\x22\x9b\x7a\xf2\x4b\xe7\x8a\x02\x81\xb3\x8f" +
+		"\x10\xd1\x33\x8f\x30\xa4\x80\xc0\xd6\xae\xc4\xbb\x5b\xba\x12\x18\xff\x38\x91\x3a\xee\x71\x02\x3b\x1e\x23\x52\xb7\x3f\xc6\x9c\x4e" +
+		"\x20\x30\x34\x46\x64\xe5\xc7\x08\xdc\x7c\x90\xe8\x70\xe3\x41\xc9\xe8\x22\x19\x5d\x08\x1c\x79\x9c\x08\x87\x35\x6b\xe3\xd3\x04\x36" +
+		"\x3c\xcd\x80\x6d\x4f\x12\xa1\xe5\x49\x02\xc5\xdd\x88\x8c\xb1\xdd\x34\xb7\x1b\x71\xdf\x0d\x51\x73\x1e\x26\x9e\x98\xfd\xb0\xd6\xfe" +
+		"\x04\x81\x79\x1d\x88\xae\x63\x1f\xd0\x8d\xfb\x09\xcc\x16\xd4\x3f\x46\x60\x4c\x06\x91\xba\xe9\x19\x02\x07\xd2\x88\xc8\xfe\x34\x02" +
+		"\x03\x1e\x22\x22\xfd\x1f\x22\xb0\x44\x73\x8f\x77\x20\xb0\x30\x10\x18\x25\xad\x96\x3c\x46\x70\xf1\x63\xcc\x01\x08\x4c\xed\x46\x70" +
+		"\x8a\x56\xdc\x76\xbf\xf4\x91\xe0\xa1\x4f\x12\xcc\x97\x66\x3b\xee\x27\x52\xb6\x6b\x6c\x9b\xf8\x5a\xc4\x37\xfa\x71\x22\x65\x94\x6c" +
+		"\xb9\xfd\x08\x91\x72\xeb\x11\x02\xbb\xc4\xbc\x26\x93\x68\x37\x50\x3a\x2e\x7f\x8a\x88\x36\x3f\x95\x30\x03\x36\x63\xbe\x6e\xcc\x13" +
+		"\xcc\xd2\xe5\xcc\x87\xe3\x0b\x05\x4c\x0d\x44\xb4\xe6\xa9\x04\x4b\x30\xcb\x72\x41\x0a\x11\x56\x89\x38\x91\x4e\xa4\x1c\x4f\x97\x65" +
+		"\xcf\x12\x69\xfd\x9f\x25\x30\xf9\x61\x22\x56\x2e\x21\xe7\x9f\x21\x22\xe7\xe4\x81\x9b\x9a\x7f\x43\x33\x2e\x27\xe6\x23\x9d\x48\xeb" +
+		"\x2e\xab\x4f\x7d\x82\xc8\x3a\x29\x77\xf4\x7d\x92\x78\xae\xcf\x93\x09\x69\xa9\xfd\x9f\x65\x4e\x36\x24\xf1\xe3\x44\xb7\xf2\x8f\x13" +
+		"\xe8\xf7\x30\x11\xf2\x24\x7b\xdb\x23\xf2\x89\x2c\x2c\xca\x24\x62\x85\x99\x0c\x01\x04\x3e\x7a\x96\x68\xd7\x5b\x7a\x5c\x7c\x86\xe0" +
+		"\x05\xad\x5e\xaf\x09\x87\x3a\x10\x69\x63\x3f\x29\x37\xeb\xc6\x62\xdd\x58\xa9\x90\xaf\x09\x04\xae\x47\x08\x98\x79\x9b\x98\x0f\x64" +
+		"\xca\x5e\xe9\xd4\xeb\x19\xe2\xa1\x9e\xba\x31\xf9\x13\x44\x66\xb9\xc6\x26\x4a\xca\x04\xc1\x69\x39\xbb\x55\x70\x59\x01\xb8\x24\xb8" +
+		"\xfc\x57\x92\x1c\x25\x42\x83\xc4\x1f\xfa\x2b\x22\xe3\xa0\xc6\xc6\x3c\x4c\xb0\x40\xe2\x97\x3c\x4d\x84\xc5\xba\x7b\xf7\x63\x44\xe6" +
+		"\x9d\x8f\x31\xa7\x03\x64\xca\xa3\x04\xd6\x67\x11\x38\xfe\x6c\xe2\x32\xad\xf0\xd1\x44\x14\x63\x3b\xe5\x99\xc9\x4f\x10\xf7\x97\x3f" +
+		"\xc1\x10\x83\xb4\x53\xc6\xf4\x54\xb6\x2d\xf9\x34\x91\xb2\xf8\xd3\x04\x26\x3d\x41\xb0\xec\x09\x65\x81\x94\xbd\x25\x28\x7a\x4c\x92" +
+		"\xc4\x77\xfb\xd3\x44\xfb\x5b\xe2\x1b\xf0\x69\xe2\xdb\xfd\x3f\xcd\x9c\x74\x10\x28\xd5\x5a\x25\x5a\xeb\xee\x13\x44\xa7\x3b\x9a\xbf" +
+		"\xeb\x51\xa2\xcb\xce\x47\x19\xba\x80\xc0\xe6\xa7\x88\xb0\xe9\xa9\x84\xdc\x98\xe5\x2e\x78\x92\x78\xba\x41\x7a\x6d\xd3\x65\x8b\x60" +
+		"\x8e\x9c\x35\x5b\x50\xfc\x39\x22\x8c\xfd\x9c\xa8\x27\x89\xf4\xb1\xce\x5b\xdd\x58\x97\x4a\x30\x4f\x3e\x99\xf5\x1c\x91\x32\xf3\x39" +
+		"\xdd\xf8\x38\x11\xdd\xae\x20\xcf\xe9\x46\x44\x67\xcb\x95\xab\xbb\x11\xe9\xab\xba\x31\x07\x67\x9f\x26\x78\x46\x1e\xdb\xd8\x8d\x88" +
+		"\x6c\xd0\xdd\xf3\xf2\xd1\x39\xc1\x3e\x5d\x0e\xea\x4c\x84\x93\x9f\x27\x70\xa2\x1b\x11\x3b\xae\xb1\xb5\x9f\x21\xc2\x9a\xcf\x28\xc0" +
+		"\xba\xdc\x1c\x23\x30\x53\x50\xa0\x98\xf5\x13\xe4\x09\x8a\x1e\x27\xa2\x85\xa2\x86\x74\x26\x3a\x0d\xee\x4c\x60\xe1\xe3\x44\x68\xd4" +
+		"\xd8\x22\x41\xd3\xe3\x0c\x00\x81\x5a\x5d\xc9\x00\xdf\x9b\x2e\x98\x26\x58\x26\x58\x6a\xea\x39\x22\x2c\x95\x51\xb7\x65\xfc\xb4\xc7" +
+		"\x88\xc8\x3a\x89\xdc\xf7\x38\x11\xd9\x2b\x96\xad\x4f\x13\x91\x2d\xb2\xe7\x80\x96\xde\xef\x06\xf4\x38\xc1\x0d\xa2\xc6\x7f\x92\x68" +
+		"\x37\x4e\x09\x56\xa4\x38\x14\x0a\x7a\x7c\x8a\x88\x76\xff\x94\x14\xeb\xac\xc5\x25\xaf\xdf\x13\x89\xfe\x86\x02\x89\xea\xf5\x04\x11" +
+		"\xeb\xa9\xb1\x62\x41\x94\xc0\x91\x98\x0a\xcf\xeb\x7c\x8c\x08\xfb\x3f\x46\x60\xb9\x6e\x36\x64\xca\xf6\x2e\x04\xce\x76\x26\x22\x67" +
+		"\x24\xee\xd0\x93\xc4\xc3\x07\x1d\x4d\xb1\x0c\xea\x48\xe0\xa0\x2c\x58\xa4\x42\x6e\x7a\x86\x39\xd8\xa5\x00\x51\xa5\x27\xe2\xac\x82" +
+		"\x79\x46\x70\xfa\x19\xe2\x85\xd6\x67\x18\x5e\x84\xcc\x7e\x92\xe8\x70\x4b\x82\xf6\x3c\x4d\xc4\x76\x6b\xfd\xab\x9f\x27\xc2\x15\x85" +
+		"\xa7\xfe\x29\x22\x56\xf7\x54\x82\x8a\x9a\x5a\xf4\x14\x91\xd1\x24\xaa\xd2\x99\x9f\x2d\x0f\xbe\xa8\x90\x7d\x49\x5d\xf4\x4b\xcc\x69" +
+		"\x07\x02\x63\x34\xbf\x22\x8b\x40\xab\x78\x07\x48\x89\xfe\x6e\x0c\x1f\x27\x98\x27\xea\xd4\x27\x89\xd4\x93\xf2\x5d\xa5\xa0\x42\x50" +
+		"\xfc\x71\x22\x73\xac\xee\x16\xbd\x48\xb0\x50\x82\xcf\x7e\x9a\x48\x3f\xa3\x42\xa8\xfc\x12\x11\x2a\xbe\xc4\xe0\x25\x8e\xa8\xcc\x0f" +
+		"\x2b\x91\x27\x7d\x89\x88\x94\x7d\x49\xc9\xf3\x02\x11\xbb\xf6\x82\x7c\xa7\x5c\x6a\x16\x2c\xfa\x02\x91\xd2\xf4\x05\x02\xb5\x9f\x22" +
+		"\xd2\x6b\x14\x99\x45\x9f\x24\xd8\xa4\x15\x87\x7f\xa1\xcd\xc7\x51\x82\xeb\x1c\x1d\xcd\xdf\x10\x21\xb0\x3d\x83\x40\x8b\xc6\x76\x3d" +
+		"\x4d\x44\x77\x8a\xda\xf3\x37\x44\xd8\xfd\x37\x1a\x7b\x86\x48\xd9\x29\xa7\xce\x7a\x81\x08\x33\x35\x6d\xdb\xa7\x89\xfb\x5b\xa4\xec" +
+		"\xe5\xcf\x11\xe9\x97\x3e\x97\xd8\x23\x46\xbe\x40\x74\x1d\x21\x8e\x8f\xa4\xf1\x4d\x45\xec\x8e\xac\xbb\xfe\x3c\x81\x6b\x82\xd5\x2f" +
+		"\x12\x0f\xae\xd2\xd8\x98\xe7\x88\x68\xc1\x73\x0c\x6f\x43\x96\x3c\x4f\x3c\xd8\xfc\x3c\x73\x22\xb6\xfa\x6f\x88\xc8\x61\x2d\x3f\xfa" +
+		"\x13\xc4\xa7\x47\x49\xda\xf9\x4f\xa8\x7d\x7f\x82\x21\x03\x04\xe6\xbf\x40\x74\x98\xa7\xa5\x16\x3c\x43\xdc\xd7\x20\x05\x8f\x3d\x43" +
+		"\x44\x8f\x8a\x3a\xa4\x0c\x39\x28\xea\xf2\x33\x44\xec\x92\xa8\xbb\x82\x3b\x82\xeb\x82\xe9\x1d\x89\xf4\x32\x2d\xd1\xf7\x59\x22\xd6" +
+		"\x47\x71\x1e\xa3\x3e\x5f\xe0\x5d\xe3\x59\x22\x5a\x2e\xea\xec\x67\x95\x8f\x9f\x95\xa8\x2f\x12\x59\x97\xbe\x98\x50\xf1\xd8\xb3\x44" +
+		"\xfa\x51\x71\x2c\xf8\x22\x11\x6b\xf8\x22\x81\xea\xcf\x13\x91\xb9\x4a\x8a\x1d\x2f\x12\x4f\x6c\x7f\x31\x11\xc4\xd1\x8a\xda\x28\xc1" +
+		"\x47\xd2\x78\x0c\x09\xdc\x08\x04\x4a\x6c\x80\x82\xb3\x2f\x95\x48\x29\xfc\x5b\xb9\x3e\x47\xeb\x07\xd5\xce\xd7\x08\x2c\xf9\x32\x81" +
+		"\xc1\x0a\xdd\x6d\x12\xa8\x12\xf3\xda\xaf\x12\xf8\x1a\x71\xff\x60\xf1\x5e\xff\x14\xd1\xe1\x9a\x02\x3e\x47\xe6\x6c\x4e\x25\xd0\x5b" +
+		"\x53\x4f\x2b\xfe\xad\x8a\xff\xbe\x17\x88\xc7\xf6\x6a\xb1\xd3\x5f\x25\xa2\xad\x5f\x65\xce\xe7\xa0\x2a\x50\x0c\xaf\x08\x8a\xbf\x46" +
+		"\x44\xc7\x6a\xd6\xf4\x17\x08\x4e\x7b\x81\x21\x15\x04\x06\x7d\x86\x48\x19\xa8\x0c\xeb\xf5\x12\x91\xd5\xf3\x25\x29\xfc\x19\xe2\xc1" +
+		"\x06\x8d\x5d\xfc\x0c\xf1\xf4\x05\x51\xd5\xf2\xd0\x5c\xc1\xd5\x2f\xaa\xb2\xe4\x8e\xb5\x9f\x25\xda\xaf\xd1\xd8\xbe\xcf\x12\x29\x7b" +
+		"\x45\x9d\xfe\x2c\x73\x52\x5a\x45\xdd\xd4\xdd\x1b\xa2\x86\xaa\xa8\xf3\x05\x6b\xff\x8e\xc0\x1a\xc1\x82\x17\x88\xcc\x06\x69\x7c\x5b" +
+		"\x1a\xdf\x92\xc5\x33\x74\xa3\x4a\x30\xff\x73\x44\xd6\xbc\xcf\x31\x60\xc6\x17\x88\x07\xed\x94\x49\x7f\x4b\xb0\x4c\x1e\xdc\xf7\xb7" +
+		"\xea\x6b\xa2\x56\x2b\x65\x57\x49\xca\xd9\x6f\x28\x8c\xdf\x20\x50\xaf\xcb\x3a\xc1\xc2\xcf\x13\x59\x8d\x0a\xd7\x56\x41\x84\x88\xb4" +
+		"\x7c\x3e\x31\x0b\x9e\x75\xe4\x5b\x44\xec\xf0\xb7\x14\xc2\x7f\x20\x50\xd6\x85\x88\x9d\x13\xcb\xa1\xaf\x13\x68\x0c\xc4\x7d\x13\xfe" +
+		"\x5e\xb9\xf9\x12\xd1\xf1\xdc\x4b\xcc\xe9\x08\x02\xa7\xa4\xec\xf8\x34\x02\x27\x3b\xeb\x66\x27\x02\xff\xa5\xb3\x90\x96\xbf\x99\x43" +
+		"\xa4\xdc\xc8\x21\x50\xfa\x65\xe2\xc1\x92\x2f\x27\x4e\x8e\xc7\x24\xf6\xa8\xf7\x8b\x17\x89\x8c\xe3\x2a\x91\x21\x4a\xaf\xc1\x82\x41" +
+		"\xff\x40\x70\xa0\x74\x38\xad\xa9\xad\x82\x23\x5f\x26\x78\x58\xe9\xd1\xeb\x59\x9d\x0a\x9c\xb7\x5f\x27\x52\xca\xa5\xdc\x4d\x31\xdf" +
+		"\x10\x8c\x79\x9e\x48\x29\x50\xf1\x55\x7f\x8b\x48\x9f\x2b\x73\x8e\xbd\x4c\x3c\x75\xf4\x65\xe6\x7c\x05\x0c\x8f\x40\xfc\xce\x8e\x4c" +
+		"\xe2\xa1\xa3\xb2\x68\xf3\xb7\x89\xe8\xa6\x6f\x2b\xbd\x74\xb9\x2a\x93\xe0\x34\x65\xf0\xfc\x2f\x11\x9d\xe7\x89\xea\xf5\x1a\x81\x29" +
+		"\x5d\x89\xec\xed\xba\xec\xf7\x22\x11\xf2\xa4\x76\xaf\x17\x89\x94\x9e\xa2\x8a\x5f\x24\x62\x63\x5f\x64\x4e\x00\x81\x8d\x2f\x11\xa9" +
+		"\x1b\x94\x41\x27\x5e\x25\x70\x5c\x70\xfa\x55\x82\xad\xa2\x16\x6a\x7e\xe3\x8b\x89\xb5\xb1\xb7\x2b\xc1\x0b\x0a\xec\x66\x8d\xad\x4c" +
+		"\x27\x42\xa1\xf2\x73\xf4\x37\x09\x8e\xfa\xa6\xc6\x74\x63\x85\xe0\xf4\xdf\x13\xe9\xad\x52\xf4\xe2\xdf\x11\xb1\x0b\xca\x91\x63\xaf" +
+		"\x11\x3c\xfa\x5a\xc2\x6c\x34\x91\x40\xcf\x4c\x22\x9c\x79\x91\x21\x02\x02\x45\xaf\x12\xa9\x85\x5a\x7b\xe8\x2b\x04\xf3\x5f\x91\x1b" +
+		"\x14\xa3\x1b\x82\xe9\x7f\x2d\x93\xff\x5a\xce\x7f\x49\xce\x97\xde\x17\xbf\x4a\x64\x5e\x50\x78\xaf\xcb\xb3\x79\xe9\x04\xca\x74\xa0" +
+		"\x5f\x21\xbe\x5a\x15\xd2\x9e\x18\xc1\x11\xf2\xf6\xe8\xe7\xa5\xa8\xa8\xd3\x7f\x4d\xa4\xb5\x8a\xe5\xf4\x6b\x44\x68\x95\x52\x57\x25" +
+		"\x60\x17\x09\x8c\x6b\x47\x60\xa6\xc4\x8f\x54\xfd\x8e\x70\xd7\x7d\x99\xc0\x4e\xc1\x02\x07\x33\x42\x44\xe7\x69\xc6\xa2\xe7\x89\xd0" +
+		"\x24\xa1\x8b\xb4\x0b\x34\x29\x6a\x6b\x5f\x20\xa2\x6b\x44\xd5\x4a\xb5\x1a\xc1\xa2\xaf\x69\x07\xfb\x5a\x3c\xc0\x5f\x04\x81\x1e\x5f" +
+		"\x27\xba\x76\x97\xb4\x31\xf2\x47\x81\x60\xcc\xdf\x11\x2c\x90\xb7\xb6\xaa\x91\x6f\x51\x0d\x6e\x76\x7e\x90\x78\xa4\x46\x71\x18\xf4" +
+		"\x1a\x73\xb2\x07\x4a\xe3\x95\x5f\x27\x32\x56\x48\xc0\x8c\xef\x12\x1d\xab\xbe\xab\xa5\xbf\xa5\xa5\x35\xa3\xf4\x9f\x88\xd4\x92\x7f" +
+		"\x4a\xb4\xe7\xbb\x2f\x13\xd9\x77\xa4\xff\xc8\x1c\x22\x36\xc2\xf9\x2e\x28\x71\xe6\xbe\x42\x64\x1c\x96\xbb\x0f\xfc\x3d\xc1\xfd\x0a" +
+		"\xdc\x32\x79\x66\xa9\xd6\x59\x2d\x96\x55\x82\xea\xd7\x89\xd4\xb9\xaf\x4b\xca\x6b\x44\xea\x08\xdd\xed\xf5\x8a\x3a\xa3\xe6\x5e\xcf" +
+		"\x21\x78\xcd\xf2\xfe\x99\xc0\xac\x40\x44\x46\x7c\x4f\xd3\xfe\x89\x08\x73\xff\x49\x2a\x7f\x87\xc0\xe2\x76\x04\x7b\xcb\xbf\x43\xa4" +
+		"\xd0\xe0\x97\xdb\xca\xd9\x25\xda\x20\xef\x5f\x51\xc0\x97\x29\x13\x96\x3a\x1d\xbe\x41\x84\x7c\x8d\x0d\xf8\x47\x02\xa7\x48\x44\x2e" +
+		"\x69\x8d\x21\xaf\x13\x1c\x2c\x85\xd6\x7f\x97\x39\x91\x75\xdf\x4d\xb0\xa4\xf6\x17\xdf\xfa\x57\x88\xb0\x4e\xaa\xed\x93\x1a\x13\xd2" +
+		"\xdb\x8e\x43\x87\xb3\x04\xf2\x5c\xe5\x77\x89\x50\xa1\x69\x0b\x5f\x22\x42\xa3\xb4\x9a\xf3\x7d\x02\x2b\x32\x09\x94\x4b\xa1\x26\x8d" +
+		"\x15\x7d\x87\x88\x14\x7e\x27\x51\x34\xd8\xf0\x52\xc2\x6b\x31\x7b\x6d\x86\x52\xbb\x4a\xde\x58\x24\x51\x4d\x82\x63\x62\x39\xfa\x52" +
+		"\xdb\xe2\x7b\x05\x47\x74\x79\x58\x70\xf3\xdb\x44\xe4\xc6\xb7\x19\x30\x40\x95\xd3\x57\x0b\x15\x44\x89\xc8\x15\x79\xe3\xe2\xff\x25" +
+		"\x50\x16\x88\xfb\xd7\x48\xd4\x98\x7f\x21\x3a\x14\xfc\x8b\x3c\x24\x29\xe3\x04\x23\xbf\x43\xa4\x8d\x90\x42\x67\xc5\x32\x3f\x95\x68" +
+		"\x57\x26\x96\x31\x5f\x21\xda\x15\x7c\x45\xf1\xf8\xa6\x8e\x25\x12\x3f\xff\x7b\xc4\xa3\xf3\xbe\xc7\x9c\xcf\x42\x36\xbf\x4a\x64\x54" +
+		"\xbc\xca\x40\x10\x38\xfd\x2d\xe2\xc1\x56\x65\x4c\xe5\xcb\x09\x27\x75\xea\x93\x23\x3f\xfe\x90\x48\xe9\xff\x43\x02\x5b\x5f\x26\xee" +
+		"\xdb\xa2\xbb\x87\x74\xe3\xa0\x37\x44\x55\xcf\xa2\x54\xd9\xa8\xcb\x31\xca\xa0\x82\x57\x12\xfd\x34\xea\x7e\xda\xef\x75\x22\x2d\x4f" +
+		"\xc1\x59\xf6\x5d\x22\x65\xa9\xf4\xbc\xfd\x9a\x36\x0f\x79\x6a\xf9\xf7\x99\xd3\xa5\x59\xae\x9e\xf8\x12\xf1\xe4\x04\x79\x65\xcf\x0f" +
+		"\x89\xb0\xfb\x87\x0c\x7f\x04\x73\x3e\x0e\x02\xe7\xb5\x4d\x9c\x53\xe4\x0f\x29\xa2\x07\x05\x97\xbf\x41\x64\x5f\xfa\x46\xe2\xb9\x63" +
+		"\x99\x0c\x1c\x93\x46\x44\x77\x4b\x6c\xbf\xb7\x88\x68\xde\x5b\x04\x56\xbf\x46\x3c\xb4\x4a\x63\x77\xbf\x49\xfc\x9f\x3b\xe2\x5b\xa6" +
+		"\xdc\x58\x2a\x21\x5b\xdf\x22\x62\x5b\xde\x62\x4e\xd4\xe5\xf1\x2d\x82\x77\xe4\x84\x5d\xff\xa8\x23\x97\x38\x8a\x72\x89\xc7\x0b\x73" +
+		"\x09\x9c\x52\x72\x9e\x14\x2c\xf8\x3e\xd1\xae\xe1\xfb\x0c\x00\x81\x13\xff\x40\xa4\x1d\x77\x4b\xff\x91\x42\x1c\x23\x3a\xd5\x28\x06" +
+		"\x23\x5f\x56\x70\xe4\xb0\x3d\xb9\x44\xbb\xdd\x12\x72\x40\x31\x5d\xdd\x8e\x08\x15\x6f\xea\xf2\x65\x22\x63\xbf\x58\xb6\xbe\x41\x70" +
+		"\xcb\x1b\x04\x16\xbe\x4d\x44\x1a\xdf\xd6\x8a\xdf\x27\xc2\x49\x39\x67\xd7\x3f\x13\xed\x77\x2a\xd7\x6f\xfe\x80\xc0\xb5\x40\xa0\x35" +
+		"\x93\x48\x1b\xa6\x66\x50\xf9\x6d\xc9\x13\xb5\xe7\x07\x44\xc6\xee\x1f\x30\x87\x20\x70\x44\xfa\x1c\x16\x4c\xff\x3e\xd1\x6d\x9a\x24" +
+		"\x0d\xff\x0e\x11\x1b\xa6\x8c\x29\x7a\x5b\x67\x1b\xad\x53\xaa\xb4\x2e\xd1\x58\xaf\x7f\x25\x70\xee\x3e\x82\xf9\xdf\x61\xb0\x53\xae" +
+		"\x7e\x8d\xe0\x15\xb5\xf8\x4a\xb1\x55\x88\xed\xf2\x1b\x44\xb8\x24\x65\x47\xff\x2b\x11\x1b\xa5\x59\x3b\x7e\x4c\xb4\xdf\xfe\x63\x29" +
+		"\xf1\x1d\x22\x65\xb7\xf8\x2e\x0a\x9e\x22\x30\x4a\x39\x71\x57\x57\x9d\x09\x3c\x27\x15\x34\x72\x5b\x23\x4f\x13\x98\xa0\xab\x39\x82" +
+		"\xd9\x82\x7e\xaf\x10\x29\x79\xa2\xaa\x5f\xd1\xd9\xed\x95\x44\x6e\x85\x82\xb6\x32\x4e\x75\x25\x2d\xfb\x11\x11\x5d\x2a\x03\x37\xbe" +
+		"\xa1\x07\x3e\x69\xb4\x5c\x7c\xcd\xe2\xdb\xfc\x0a\xc1\x4d\xaf\x24\x5a\x40\x6c\xdd\x2b\x89\x6a\x4d\x3f\xfc\x4a\xe2\x79\xb6\xfe\x4d" +
+		"\x22\xa3\x4e\x81\x58\x98\x4b\x64\x36\xe6\x12\x28\x16\xdb\x58\xc1\x90\xb7\x89\xb4\xc1\x72\xd0\x48\x49\x1f\x21\xe9\x53\x65\xea\xa2" +
+		"\x88\x1e\x1d\xdf\x4e\x9c\xc5\xaa\xdf\x27\x38\xf7\x7d\x25\xe7\xab\x3a\xdf\xb6\xed\x9f\x51\xef\x9f\xe7\x5f\x25\xc2\x39\x51\x57\x5f" +
+		"\x4d\x3c\x15\x5d\x10\xd1\xf7\x6d\x82\x7d\x24\xfc\xb2\xd6\x3f\x11\x95\xcf\xe4\xbd\x45\x6f\x11\x4f\x36\xbd\x95\xd8\x8e\xa3\xc7\x5f" +
+		"\x4d\x94\x3e\x0b\xfe\x25\x21\x24\x76\x45\x63\x17\xc5\x72\x41\x50\x2f\x98\x9b\x4d\x3c\xd0\xfb\xb5\xf8\xce\xf2\x5d\x10\x98\xf8\x3a" +
+		"\x91\x3e\x41\x85\x57\xfb\x3a\xf1\x44\xcd\xeb\x89\x57\x5d\xc5\xef\xe8\x4c\xf9\x0e\x81\x5d\x5a\xb9\x2e\xd6\xd6\x4c\x16\xfc\x1b\x71" +
+		"\x7f\xc3\xbf\x11\xd8\xf6\x23\x82\x2d\xf2\xeb\xfa\x9f\x11\xd1\x75\x3f\x4b\x9c\x86\x4e\xfd\x3b\xd1\xe5\xe4\xbf\x4b\xf8\xf7\x89\xcc" +
+		"\x09\x4a\xa8\xd2\x1f\x13\x8f\x96\x48\xf3\x95\x6f\x12\x91\x15\x92\x79\xf6\x27\x44\x97\x33\x3f\xd1\xdd\x0f\x08\xac\xd0\xab\xad\x96" +
+		"\x77\x65\xeb\xcf\x08\x5c\x12\x9c\xfd\x21\x91\x71\x46\x7d\xe5\xe2\x4f\xe4\x13\x31\x9f\xc8\x65\x4e\x38\x9e\x2b\x51\xf2\xce\x0a\x79" +
+		"\xa7\xaf\x1c\x5b\xd5\x9e\x88\xb6\xc8\xfe\x39\xef\x11\x98\x2d\x98\xf1\x23\xa2\x43\x95\x74\x3c\xf4\x2e\x91\x72\x50\xe2\xd7\xff\x54" +
+		"\x9e\xd0\x89\xb1\xe0\xdf\xe3\xf2\x10\x97\x77\xfe\x2d\x82\xe7\xe4\xa7\xe9\x3f\x21\xa2\xd3\xb4\xda\x0c\x41\x95\x60\x97\x8a\x72\xe7" +
+		"\xff\x65\x70\x52\xf4\x7b\x8f\x08\x79\x5a\xa2\xf4\x27\x04\x4b\xc4\x71\xe0\x07\x44\x6c\xbf\x4a\x6f\xf8\xbb\x4a\x5e\x2d\x76\x42\x97" +
+		"\xa7\xb2\x89\xc8\xa6\xff\x50\x56\xfe\x40\xc7\x5b\x8d\x5d\x16\x54\x66\x10\x91\x71\xe2\xbb\x2a\x01\x75\x81\x48\xaf\x13\x5f\xf1\x4f" +
+		"\x75\xf8\x92\xa2\x45\xea\x73\x85\x72\x41\xe9\xbf\x6a\x21\xa5\x56\xe5\xf7\x54\x63\x8a\x46\xbd\xee\xd6\xd9\x41\x1f\x10\xed\x2e\xc8" +
+		"\x91\x93\x64\x6e\x51\x9a\xce\xc7\x72\xcb\xb6\x77\x89\x60\xbf\x1e\x7b\x9f\x08\x47\x35\x36\x5e\x5b\xd9\x38\x1f\x02\x7e\x46\x84\x35" +
+		"\xf2\xf5\x21\x05\xfb\xe0\x3b\x89\x2e\xb7\x5c\xfe\x6e\x96\x52\x95\x1a\xef\x95\x45\xa4\x4e\xfb\xa5\xa4\x8b\xb7\x4c\x50\xa9\x54\xaf" +
+		"\x10\xd4\xff\x07\x91\x62\xbd\x8b\x74\x99\x41\x84\x32\x57\x99\x62\xd3\x2c\x98\xfc\x06\xc1\x72\xf3\xaa\x4a\xea\x44\x1d\xfa\x25\x11" +
+		"\x0e\x4a\xe6\x66\x75\x8a\x4d\x1a\x3b\xa0\x15\xf7\x0b\xe6\xe8\x52\x47\xa7\xb9\x22\x8e\x08\x9e\x25\x42\xcd\x1b\x89\x8e\xd3\x57\xe1" +
+		"\xe8\xe3\x46\xf9\x06\x91\xb6\x4a\x0c\x4b\x7e\x4a\x44\x16\xcb\x6b\xc3\x95\x60\x7f\xa5\xc7\x72\xeb\xa1\xe2\x6b\x7e\x3f\xc1\x11\xcc" +
+		"\x31\xf4\x3d\x22\x92\xaf\x00\xf6\x55\x46\xf6\xd1\x84\x5d\xf2\xd2\x21\xbf\x6f\x93\x0a\x13\xdf\x24\xfe\x76\x82\x6e\x4c\x7a\x8f\x48" +
+		"\x2b\x7b\x8f\x39\x59\xd0\xd5\xfb\x44\xb7\x32\x89\x1b\xf2\x3e\xd1\x7e\xf0\xfb\x0c\x7e\x76\xd8\xf6\x73\x25\xe0\xcf\x09\x5c\xff\x21" +
+		"\x91\x79\x4d\x61\x59\xfd\x63\x22\xac\x52\xe2\x1f\xfb\x4f\x79\xff\x3f\x15\xdc\x37\x88\x87\xc7\x4a\xb1\x19\xef\x11\xac\x92\x12\xdb" +
+		"\xde\x20\x3a\xb5\x68\x6c\xce\x4f\x89\xb4\xd9\x52\x71\xc6\xaf\x89\x50\xf5\x6b\x29\xfb\x26\x11\xcd\x97\x26\xdb\xfe\x8b\x48\x6d\xf9" +
+		"\x2f\x02\x53\xc5\x32\xe5\xa7\x89\x2e\x3e\x40\xf1\xea\x2f\xad\xab\xdf\x24\xc2\x5c\xf1\x16\xbf\x4f\x44\xc6\x4a\xcf\xea\xff\x96\x23" +
+		"\xff\x9b\xc0\xc2\x77\x89\x68\xa3\xec\x5c\xfb\x9f\x44\xca\x1a\xe9\x53\xa4\x18\x14\x0a\x0e\xbc\x49\x64\xee\x7f\x33\x91\x80\xc1\x09" +
+		"\x38\xf9\x43\x22\xbd\xfc\x43\x06\x2c\xff\x05\x91\xdd\xfc\x8b\xff\x5f\x76\xec\x52\x09\xed\x14\x94\xfe\x9a\xe8\x58\x22\x6d\x07\xbc" +
+		"\x45\x74\xee\xaf\x3a\x9a\xf8\x01\x91\x35\x41\x49\xb9\xed\x1d\x22\xbd\x45\xa9\xb4\xf2\xbf\xd5\x00\xa4\x4b\xd1\x4f\x89\xf6\x85\x5a" +
+		"\x63\xce\xfb\x44\xf6\xec\xf7\x39\x21\xe4\x9e\x3f\x17\x38\x21\xa4\xb7\x8c\x0e\x8c\x7d\xf6\xc4\xe8\x40\xa6\x83\xcc\x00\xcb\x42\x2e" +
+		"\x38\x21\xfc\x23\x38\x3d\xbc\x0e\x56\x84\xfb\xc1\xb4\x2f\x80\x95\xe1\x71\x30\xfb\x71\xf0\xd5\xaf\x54\xe5\x07\xef\xf4\x19\xdd\xb6" +
+		"\x0d\x0d\x8c\x3e\x05\xbe\x7e\xff\x1a\x8d\x05\x30\x00\x8c\x3c\x06\xdf\x07\x7a\x3c\xc5\xac\x6f\x9f\xfa\x19\xbf\x9b\xd9\x72\x24\xa8" +
+		"\x2e\xa5\xc5\xc5\x2c\xe2\x89\x0d\xbf\x21\x30\x49\xa6\x95\xfd\x24\x51\x15\x3c\x28\xbd\x4f\xbf\x43\x84\x56\x51\xc5\x2e\x8b\x28\xc1" +
+		"\xe6\x0f\x18\x1e\x04\x81\x59\xff\x4b\x60\xa6\xa0\x52\xbe\x25\x81\x72\x11\x23\xe5\xd5\x11\xbf\x24\x72\xef\x1e\x0d\xcc\xc1\xcc\x0f" +
+		"\x89\x30\x4c\xc6\xf7\xfb\x15\x81\x3c\x41\xad\x18\xe7\xa7\x13\x38\xe3\xf2\x15\x2c\x0e\x92\x97\x4a\x84\x2a\xdd\xad\x7e\x97\xf8\xcc" +
+		"\xdc\x77\x29\x53\xd1\xed\xe2\xd1\xc0\x3f\xa5\xcc\x3d\x1a\x08\x8c\xfe\x80\x78\x66\xd4\x07\x89\x3a\x4d\xaf\x90\x7e\xcb\xa5\x69\xf3" +
+		"\x3b\x0c\x99\x90\x05\xbf\x20\xa2\x07\x15\xb5\x3d\x4a\xcd\xab\x31\x22\x63\xd3\x7f\x71\x7c\x48\x03\x81\x21\xef\x12\xf7\x0d\xd6\x1a" +
+		"\x4b\xd4\x27\x16\xbf\x9b\x50\x28\xa5\x46\xd4\x81\xdf\x10\xed\xf6\xcb\x23\x07\xde\x25\xd2\xf7\xbf\xcb\x10\x20\x77\xfd\x4a\x6d\x4c" +
+		"\xca\x7f\xa4\x0c\xee\xad\x0c\xbe\xab\xcc\xba\xa3\x59\x7d\xdf\x23\xda\xf7\x79\x8f\xe3\xc3\x7d\x20\xb0\xfa\x5d\x22\x73\x95\x3b\xef" +
+		"\x1f\x89\x87\xd7\xfd\x91\xe1\xcf\x92\xe0\xd4\x9f\x88\xe8\xc9\x3f\x49\xbd\xdf\x11\xa9\xbb\x7f\x47\x60\xf2\x1f\x89\x76\xe5\x7f\x94" +
+		"\x97\xfe\xc4\xf1\xe1\x63\x79\xba\xbd\xeb\xb7\x7a\x85\xfc\x5b\xf9\xea\x6d\x82\xf3\xde\x4e\xe8\x52\x24\xbe\x5b\x19\x7a\x2b\xac\x64" +
+		"\x5e\xfb\xb6\x38\x1e\x21\xd0\xb3\x7b\x90\xd4\xff\x21\xd2\x77\xff\x8f\x4c\x78\x9b\x08\xfb\x75\xfb\xa6\x2c\xba\xf1\x1b\x8e\x0f\x00" +
+		"\x11\xce\x6b\xec\x8c\xe0\xec\xdb\x6a\x19\xa2\x06\xbd\x43\x44\x06\xca\x9b\x1b\x7b\x04\x22\x6c\x10\xe2\xb4\x56\xd8\xd0\x5e\x46\x65" +
+		"\x12\x38\x2c\xaf\x8e\x11\x53\x81\xa0\x9f\x20\x4f\x30\xeb\x57\x44\x28\xc9\x96\x1c\x31\x8f\x8c\x10\x98\xd0\x91\x88\xf9\xee\x9c\x3f" +
+		"\x29\xdb\x6d\x53\xcf\x40\x60\x67\xcf\xc0\xd0\x15\x04\xb6\xea\xf6\x16\xc1\xc4\x5f\x12\xb1\x09\x4a\x9c\x41\xa7\x03\x73\xda\x6d\x3a" +
+		"\x15\x08\x8c\xd7\xfa\xe3\x04\xbd\xfe\x20\x0b\x05\x57\x05\x3b\xa3\x04\x0a\x65\xd6\xcd\xdf\x12\xcf\xdd\xf8\x6d\x42\xe0\xdd\x7f\x23" +
+		"\xd2\xee\xfc\x1b\x91\xfb\x91\xc4\x60\x95\xc5\x2c\xf8\x0f\xe2\xc9\x06\xf5\xeb\x7e\xda\xe8\xf2\x94\xcf\x7d\x7f\x4f\xa4\xf4\xf9\xbd" +
+		"\x8c\x94\x13\x5a\xdf\x66\xf0\xe6\x97\xea\xcd\xef\xa3\x77\x88\xec\xde\x52\xec\xee\xaf\x88\x94\x3b\xbf\x22\x72\x6f\xb6\x06\xe6\xb0" +
+		"\xc9\x12\x6f\xfe\x49\xab\xca\xa4\x1d\xef\x10\x59\xdb\xdf\x49\x1c\x7b\x7a\xfd\x8c\x60\xcf\x9f\x11\x18\x2a\xc8\x17\x9c\xf8\x1f\xa2" +
+		"\xe3\xf1\xff\x21\x72\x2f\x4b\xc2\x7d\x75\x96\x30\xe6\x77\x04\x0b\x14\xfd\x63\x3a\x4d\x1c\x15\xe7\x69\xc1\x3e\xb9\x71\x95\x14\x3b" +
+		"\xf2\x47\xa2\xd3\x61\x85\x7b\xf8\x7f\x13\xa9\x2e\xa3\x31\x3f\x67\x60\x81\x12\xbb\x54\xdd\xb7\x44\x54\xfd\xcf\x89\xb4\x3a\x51\x0b" +
+		"\x7f\xae\x03\xdb\xcf\x89\xdc\x23\x5a\x0a\x15\x5e\x6a\x40\xdf\xa0\x5f\x98\x84\xb8\x98\x17\x88\xf4\x0b\x42\xf4\x53\x34\x98\x27\xc4" +
+		"\x20\x97\xd4\x7d\x4a\x28\x3d\x6a\xf5\xec\x13\xb4\xec\x07\xc4\x23\xc3\x3e\x60\x78\x18\x62\xff\x28\x10\x38\xd9\x85\xe0\xd8\x0f\x89" +
+		"\xdc\x6d\x5a\xa2\xe3\x58\x2f\x51\xff\xef\x44\xa8\x53\x5b\x5c\xf9\x21\xc1\x15\x1f\xca\x1a\x0b\x6e\x35\x6e\xfd\x30\xf1\x03\xd8\x13" +
+		"\x85\x79\x21\x5e\xd6\xb9\x1b\x35\x3f\x6b\x94\xe7\xf7\xfa\x2d\x11\x7a\x2a\xd9\x67\xf5\x0f\x44\x6c\xa6\x10\x1b\x7f\x42\xa4\x6f\x50" +
+		"\x3c\x06\xc8\x33\x57\x1f\x22\xd0\x33\x83\xc8\xbe\x25\xbf\x5d\x96\xca\x2b\x1f\x20\x52\x1a\xfa\x06\xd5\x98\x3c\xb5\x42\xd0\xf7\xa7" +
+		"\xc4\xa3\x7d\x7e\x4a\xe4\x2e\xd2\x1a\xec\x13\x5f\x43\xe1\xfa\x22\x81\x79\x22\x4e\x29\xc1\x53\x4e\xf6\x70\x37\xfc\x80\xe0\x5e\x89" +
+		"\xeb\x25\xbb\x53\xe3\xd6\x8f\x1f\x20\xbc\x96\x46\xa0\xcc\x46\x5c\x17\xc7\xac\x2c\x02\x53\x14\x9e\xc9\x1a\xec\x52\x2e\xc4\x48\xc9" +
+		"\x89\x8d\xe8\x11\xda\x8a\x54\xce\x7e\xb4\x50\x98\x3b\x5d\x3a\x64\x5f\x38\x69\x1d\x06\x07\x22\xbb\xe7\x60\x57\xea\x87\xc4\x03\xbb" +
+		"\xe5\x98\xd5\xfd\x02\xc1\x55\xfd\x42\xe2\xfd\xd6\x2c\x5d\x87\x99\xfd\x9c\xbb\x03\x25\xe3\xd4\x8f\x98\x13\x5a\x2d\x62\x9b\xf2\xbf" +
+		"\x45\xb0\xf1\x17\x44\x87\x0d\xaa\xc9\xdb\xbf\x20\xc2\x2d\x51\x93\x7a\xcb\xac\x32\x21\xfa\xfe\x92\x48\xeb\xf3\x4b\x02\xa3\x45\x8d" +
+		"\x12\x75\x59\x2a\xa5\x5f\xea\x1b\x12\xef\x95\x16\x6a\xb0\x51\x70\xd5\x2e\xbf\xd2\x3f\x24\x46\x23\x1e\x5d\xf9\x4b\x22\x7b\x85\xa8" +
+		"\xf1\x7d\x42\xe2\x65\x0b\x7a\x4b\xb3\xdc\xa1\xb2\x0b\x3d\x7f\xac\x5f\x19\x34\x80\xf9\x43\x82\x5e\x75\x0a\x51\xa4\xdd\xbf\x50\x95" +
+		"\x3b\x52\x70\xa2\x2b\xc1\x5b\xce\xba\x8d\x1f\xea\xa1\x44\x66\x57\xeb\x4e\x7b\x02\x65\x22\xce\x5a\xc6\x19\x63\xad\x38\x59\x23\xc4" +
+		"\x92\x0f\x89\xec\xc5\x1f\x26\x92\xe6\xee\x29\x25\xdd\x52\x7b\x62\x92\x1a\xf2\xc5\x87\xf5\xb6\xd2\x6b\x6e\x96\xee\x29\x9b\x84\x38" +
+		"\x2f\x67\x9c\x13\x2c\x11\xd7\x62\xc1\x75\xc5\xfd\x9a\x60\x99\x2e\x97\x7a\xec\xb7\x04\xaf\x29\xf1\x26\xc9\xd1\x28\x33\x2e\x1a\x24" +
+		"\x6c\x32\xde\xd5\xcd\x3b\x82\x89\x0e\x75\x3f\xbf\xfd\xb8\x8f\x08\x14\xa7\xae\xe6\x92\x40\x1f\x97\x47\x91\x43\x57\xd8\xaf\xad\xd6" +
+		"\x42\xb8\x90\x97\x94\x32\xb4\x2b\x81\x81\x82\xee\x6e\xfb\xae\xc8\x72\xe3\x0c\x27\x62\xad\xa4\x5d\x0c\x04\x36\x74\x26\xd8\x47\x5c" +
+		"\x27\x64\x5b\xfa\x71\x21\x7a\x78\x0d\xff\x6a\x1c\x21\x30\x20\x93\xe0\x04\x27\xd9\x22\xf9\x25\xf4\xb1\x5f\x6a\x35\x82\x61\x92\x55" +
+		"\x26\x09\xe3\x7f\x47\x84\x71\x6d\x94\x67\x96\x88\x58\xeb\x35\xd7\x18\x6f\x7a\xf3\xb8\x61\x1c\x3d\x3c\xe8\xf9\x75\xb8\xf5\x12\x63" +
+		"\x95\xe7\xca\x1b\x19\xe3\x84\x58\xad\x81\xeb\x1d\x89\xb0\xd8\xd7\x5b\xe3\xb9\x93\x42\x70\x77\xef\xd0\xa6\x34\xe2\x4a\x57\xca\x01" +
+		"\xb1\x8a\x78\x30\x9d\x44\xef\xca\xfb\x19\x44\xd8\x2f\x39\xa3\x07\x05\x06\x8c\x1a\x14\xda\x16\x89\xc5\x17\x39\x61\x05\x8e\x1b\x2f" +
+		"\xca\x84\x0b\x5e\x55\x70\x4d\x70\x55\x50\x99\xae\x2e\x68\xb7\xcc\x92\xd9\x9c\x29\xc4\x21\x49\x88\x1e\x14\xe6\x0e\x92\x6b\xb8\xe9" +
+		"\x44\x20\xf0\x91\x43\xd8\x3b\xde\xf2\x7e\x4f\x70\xa0\x2a\xf9\xd0\xb0\x40\x40\x9f\x42\xa4\xf8\xba\x87\xb5\x3c\x98\x9e\x78\x2b\x81" +
+		"\xa3\xbe\x1e\xa3\x5b\x4b\x3a\x13\x38\xac\x3b\x63\xfc\xa2\xac\x23\xc1\x78\x0b\xd8\xea\x76\x31\x33\x9b\x80\xee\xdc\x8e\x11\xa8\xe8" +
+		"\xe5\x42\x1f\x16\x12\x6f\x9f\xb1\xa3\xbd\xbe\x62\x30\xe7\x9c\xdf\x13\x61\xb6\x64\x56\x8a\xaa\x10\x35\xdf\x8d\xe1\x4a\x47\x9d\x59" +
+		"\x75\xdd\xd7\xd9\x38\x4c\xa2\x2e\xd8\xc6\x95\x62\x5d\xa1\x5b\xf5\x82\x13\x9d\xa4\x43\x27\x06\x36\xe8\x72\x9f\xfd\x3d\x9d\x04\xfa" +
+		"\x7b\xcd\x85\xc6\xfe\x5a\xd9\x2b\x4d\x57\x70\xc2\xb4\xde\xce\x71\x0d\x34\x79\xd5\x11\x81\xc0\xd8\x28\x11\x0a\xf2\xdd\x73\xa4\x60" +
+		"\x68\x10\xa2\xd4\xfe\x2c\xe9\xdb\xb6\x3a\x64\xfb\x06\x11\x03\x1c\xa5\xfe\xc6\xf5\xd2\x6b\x9d\x46\x77\x09\x76\xfe\x9e\xc8\xad\x3d" +
+		"\x19\x98\x83\x73\xbf\x26\x32\x06\xaa\xd4\xb6\x3a\xb9\xce\x44\x08\xa4\x11\xd0\x33\xe2\x71\x9b\xff\x07\x02\xdf\x22\xd2\xf6\x7b\x37" +
+		"\x1e\xea\x5d\xc3\x78\x57\x03\x8c\xa7\x2c\x6f\xe9\x62\x88\x63\x3c\xb8\xaf\xb5\x74\x93\x68\xc8\x73\x6c\x6c\x68\x81\x71\x90\xe4\xed" +
+		"\x51\x7a\x1d\xf7\xbd\x19\x0e\xc2\x94\x98\x02\xff\x87\xc4\x8e\x8e\x01\x32\x63\x8d\xc6\x56\xcb\x3b\xbd\x07\x25\x66\x86\x30\x50\x3c" +
+		"\xb5\x9e\x53\x94\xa5\x1f\x16\xec\x92\xe1\x7f\x88\xab\x91\x3e\x42\x44\xa9\xa0\x44\x50\x6f\x5f\x1e\x4b\xd1\xbb\x22\x17\xc1\xf4\x3f" +
+		"\x10\x9c\xf6\x07\xb5\xaa\x13\xb2\x7f\x6f\x2e\xc1\xc3\xbe\x75\xe0\x0f\x44\xca\x7e\xcf\xf7\xf5\x80\x54\x05\xfb\x11\x05\x47\x0f\x38" +
+		"\xbb\xbc\xd0\xa8\xfb\x12\xbf\x3f\x47\x88\xd0\x28\xe6\xd1\x1a\x8f\x8e\xca\x77\x04\x94\x89\x61\x45\x1f\x6b\x6b\x1f\x95\x89\xbf\x93" +
+		"\x7a\xb0\x78\x4f\x09\x4e\x0a\x36\x6b\x23\xdc\x24\xd8\x66\x6f\xad\x52\xaa\xf4\xff\xb5\x54\x1e\x19\x08\xd6\x8d\x74\xd2\x5a\xd2\xa8" +
+		"\x8e\x04\x1a\x7e\xdd\x56\xab\x88\xd7\xea\xe9\x3f\x10\xa1\x55\xb2\x26\x2b\xaf\x42\xf9\x90\xc0\xf0\x10\x08\x14\xe9\xe0\x5f\x28\x98" +
+		"\xfe\x6b\x59\xfb\x6b\x22\x77\xbd\xac\xcd\x1e\x71\xdc\xa9\xad\x84\xe2\x6c\x21\xaa\x47\x07\xfd\x90\x33\xda\x85\xe0\xf4\x1e\x2b\x4d" +
+		"\x9c\xde\x0b\x7f\x4f\x74\x34\x75\xe8\x7f\x89\x70\x50\x12\x4b\xb5\x76\x4a\x89\x5a\x67\x67\x10\x98\x24\x57\xb5\x2b\x13\xe6\x2e\xd1" +
+		"\x1a\x59\x79\x5e\xe3\xa2\x22\xd6\xe5\xc2\xa0\x90\x38\x2a\xc3\x47\xe5\x7d\xbf\x25\x3a\xef\x15\x55\xef\x3b\x75\x83\xda\x36\xba\x43" +
+		"\xca\xe6\xac\x83\x03\xbc\x0b\x3b\x4f\xce\xa6\x10\xc8\x6b\x47\xe0\x55\xf9\xc0\xfc\x95\xbf\x23\xb0\x5e\x35\xbe\x3d\x95\xc0\xb8\xbc" +
+		"\xb6\x1e\x48\xf7\xc0\x95\xde\x8c\x0f\xbd\xc9\x1c\x94\x7d\x8f\x08\xab\x1c\x82\x91\x9a\xfa\xfc\x88\x41\xde\x1d\x06\xfa\x4c\x35\xb0" +
+		"\x6d\xd9\xe9\x4a\xfa\x2e\xd3\x84\xb8\xdc\x2f\x10\xd1\x4b\xc2\xdc\x49\x32\x25\xed\xe4\x31\x27\xb2\xdd\xdb\x20\xc4\x6d\x71\xc6\x6e" +
+		"\x75\xb7\x9a\x3d\x02\xc1\x9e\x3d\x6c\xae\x56\x0a\x17\x86\x9a\x96\x84\xd8\x85\x7e\xae\xf0\xc2\x40\x74\x6a\x14\x62\x79\x41\x20\x42" +
+		"\x73\x81\x93\x63\x54\xd0\x81\x7e\x54\x60\x00\x64\x83\x4c\x6e\x5f\x35\xcc\xfb\xa6\xb9\xe7\x3e\x40\x60\x51\x07\x22\x12\x1f\x5d\xe9" +
+		"\x70\xad\x30\xde\xf5\x3a\x77\x8c\x57\xb5\x7e\xe4\x8a\x30\x77\x80\xb4\x8e\x6c\x38\x16\xda\xd2\x08\xf1\x34\x1a\xda\x53\x3c\xf9\x42" +
+		"\x9c\xb2\x8b\xbb\x53\xaf\xe9\xac\xe2\x36\x89\xcc\x68\x19\xe1\xfe\xab\x2c\x66\xef\x7c\x8f\xcb\x55\x58\x26\x28\x4e\x23\x90\x42\xdc" +
+		"\x5f\x27\x85\x3b\x41\xc5\x32\x36\x10\xa9\xfb\xc7\x3a\x4b\x95\xc6\x5b\x04\x1f\xd9\xc4\xde\xc2\xdc\xcb\xc7\x03\x73\x50\x67\x65\x76" +
+		"\xfd\x91\x08\x3b\xc5\x31\xc3\x1c\x55\x05\x3e\x10\x28\xdf\x1e\xda\x34\xd8\x79\xa8\x0c\xca\x9a\x2b\xc4\x54\x61\xc6\x94\xfe\x6d\x27" +
+		"\xd1\xb3\x92\xf4\xd4\x5c\x4b\x3a\xa2\x30\xa6\x1e\x16\x62\xed\x98\xa0\x77\x06\x63\x9c\xbb\x72\x5a\x64\x77\xa1\x0f\x82\x32\x91\xd7" +
+		"\x06\xb4\xcd\x3f\x65\x4d\x66\x7a\xfe\x10\x69\x9c\x39\x78\xac\xb5\x92\x85\x61\xe7\x40\xcb\x95\x94\x4e\x87\xc7\xd8\x72\x6f\xb0\x2d" +
+		"\xc3\xbd\xcb\xe9\x6e\xfb\xe3\x03\xdb\xf2\x32\xc4\x7a\x3a\x1c\x45\xd2\x20\xbd\x70\x90\x7b\x8a\x71\x9a\x71\xb4\xfc\x18\x19\x35\xc2" +
+		"\xfc\xdd\x9d\x20\xdd\x7d\xd8\x2b\x0e\x04\xfb\x08\x73\x77\x48\x9f\xf6\xe3\xac\xcf\x56\xcf\xca\x20\x1e\xd9\x1b\xdf\x55\x4b\xbd\xab" +
+		"\x1a\x4b\x07\xbb\xd6\x06\xbb\xc1\x8d\x97\x4e\xc3\xc6\x07\x86\xf6\x20\x30\xa7\x28\x10\xa8\xd1\xe7\x6f\xd7\xcc\xd0\x43\xf9\x19\xe9" +
+		"\x2e\xcc\x5d\xab\x05\xb2\x86\x79\x81\x85\x8a\x7a\xac\x31\x7e\x36\xd2\xdd\x90\x37\xc4\xe5\x25\x55\xc2\x99\x62\x6f\xfb\x9e\xbb\x78" +
+		"\x88\x53\xc0\xe9\xd0\x7b\x84\xb7\x0d\x8d\x70\xdd\x10\xe7\x93\x79\xea\x4c\x1f\x13\xf2\xe8\x90\x90\xdc\xd4\x9e\x26\x10\x4f\x9b\xcd" +
+		"\x6a\x43\xd8\xe4\xd1\xcb\xc2\xd8\x25\xd3\x3b\x3c\x7f\xbb\xe9\x31\x13\xbc\x81\x67\x10\x61\xee\x28\x2b\xa3\x34\x8d\x9d\x19\x99\x3c" +
+		"\x9b\xa4\xf8\x6c\x92\x3b\x43\x86\xf0\xd2\x51\x67\x85\xc5\x4f\x31\x2e\x94\x37\xd0\x68\x5c\xa9\x11\xae\xc8\x4f\xee\x25\xa1\xaa\x97" +
+		"\x8b\x44\xb1\x4c\xbd\x33\xc6\xe3\xee\xd1\x7a\x1f\x03\x54\x7a\x56\x85\x71\x91\xee\xa6\x37\x8d\x71\x06\xe8\x6e\xf6\xe1\x7c\x47\x77" +
+		"\x9c\x3b\x61\x16\x11\x6e\xe5\x87\xc4\x27\x69\xe7\xa5\x5e\xca\xb9\x91\x0e\x87\xd4\xe6\xb0\x51\xb6\x52\x53\xd2\x2e\xe5\xbb\xd2\x7c" +
+		"\x37\xdf\xb8\xcd\x3d\xa1\x45\x98\x3b\x5c\x86\xe0\xf0\x57\x09\xec\xef\x4c\xc4\xf2\x87\xba\xae\x8c\xd3\x33\x09\x94\x44\x09\x96\xc7" +
+		"\x53\x59\xa1\xcd\xd8\x5d\xe4\x33\xbb\x38\x38\x6f\xa8\x4b\xd1\xf4\x52\xd3\xfd\x94\x84\xa9\x79\xc3\xdc\xdf\x64\x4a\xbb\x11\xe3\xdd" +
+		"\x0d\xcc\x73\x65\xa8\xf7\x33\xc7\x76\x7f\x71\x60\xc8\x02\x81\xd1\x93\xbc\x51\x09\x51\x6b\x03\x6b\x8c\x2b\x15\x8d\x94\x15\x13\x2c" +
+		"\xb7\xc4\xfd\x51\x98\x7b\xf5\x58\x60\xce\x57\x1a\x8e\x06\x86\x6c\x88\xcf\x7d\x6d\x45\x3f\xeb\x28\x4c\xdd\xdd\x2f\xc9\xf7\xf1\x06" +
+		"\x07\x69\x90\xd6\x4c\x1f\x58\xec\x28\xdb\x8e\x82\xa2\xb6\x1e\xb7\x4b\xd7\xd1\x9d\x45\x0e\xb2\x4a\xbc\xd3\xf1\xfe\x0e\x7e\x89\x33" +
+		"\xb1\x24\x19\xb4\xe0\xa0\xe5\x9e\x95\xdc\xac\xf8\x7b\xa5\x4a\x95\x74\x4a\xc5\x00\x67\xba\x79\xba\x8f\x71\x22\x68\x84\x8d\x03\x92" +
+		"\x74\xfb\x38\x7d\xd9\xfc\x97\x4c\x6f\x9d\xea\xe3\xa3\xf1\xaa\x46\xc2\x95\x01\x3e\xcc\x6a\x84\xe3\xa6\x3a\xeb\xdd\x0a\x7a\x0f\x6c" +
+		"\xd3\xb5\x48\x5a\xc6\x0a\x8b\xdc\x4e\xdc\x54\xb6\xb7\xd7\x47\xb8\x03\x5d\x97\x46\xea\xab\x4b\x53\x43\xca\x84\x83\x8d\x0b\xbd\x05" +
+		"\x8d\x7c\x83\x39\x99\x05\xd6\x7c\xfe\x64\x47\x70\xb2\x6b\xa0\x22\x10\xdc\x5e\xe1\x74\xb6\xd7\xa7\x4c\xf0\x8e\x21\x5b\x78\x61\x8c" +
+		"\x1b\x85\x9f\x92\xfa\xf4\x77\x53\x54\x4c\x23\x73\xc7\xfb\x34\xe2\x58\xf5\x37\x4e\x97\x84\xac\x69\x15\x4e\x61\x6b\xd7\x54\xd8\xd6" +
+		"\xec\x96\xc9\x6b\x9d\xfb\x1f\x4d\x36\x4b\xc6\x9b\x65\xb5\xe2\x1e\x9b\x3b\xc9\xde\x9c\x26\x2c\xef\x48\x74\xec\x69\x13\x67\x0d\xf0" +
+		"\x53\xfd\x80\x7b\xd6\x95\x0c\x6c\x7b\xda\xed\x3b\x39\x24\xbe\xfe\x0d\xae\xea\xdc\x5a\xad\x80\x69\xda\x60\x37\x0c\x71\xcf\x93\x8c" +
+		"\xb4\x51\x45\xc9\xea\xcd\x4a\x3c\x59\x54\x39\x68\x55\x2e\x18\x97\x47\x0a\xc1\x51\xf9\xc9\xeb\xc8\x30\xd3\x03\xdc\xed\xfa\x1b\x97" +
+		"\x49\xd9\xb0\x74\x90\x35\x99\x2e\xba\x64\xba\x93\xb4\x44\x6b\xac\x28\x09\x89\x1f\x3c\x8a\x1d\xbc\xb1\x53\xbd\xa5\xd9\xad\x27\x2b" +
+		"\xbc\x27\xc8\xc0\xb0\x66\x92\x4f\x48\x76\x6e\xab\xf1\xd4\x88\xa0\xaf\xe7\x46\x38\x09\x46\x78\x8b\xff\x47\xe6\x44\xf6\x1f\xf1\xd3" +
+		"\x95\x06\x78\x63\x84\x5d\xe1\x30\xce\x34\xf6\x1b\xe9\x7e\x39\xd2\x19\x62\xba\xb7\xe9\xbe\xc2\x48\x1f\xd3\x83\x3c\x3e\xd0\xf4\xa9" +
+		"\x29\xa2\x4f\x4e\x71\xf6\x1b\x0b\x8c\xe3\x7d\xb7\xb8\x2b\xf1\x40\x5d\xa5\x83\x61\xbb\xe7\xe6\xff\x25\x3d\x51\xbe\x0a\x13\xaa\x42" +
+		"\xe2\x57\x85\x22\x17\xd2\xd4\x0e\x04\x7a\x3a\xd1\x1d\xb8\xf3\xb6\xf4\x5c\xc5\x5f\xfa\xae\xd4\xdc\x25\xc6\xbe\x13\x85\x7d\x8c\x93" +
+		"\x8b\x7d\x3e\x2c\x76\xac\xcc\x39\xca\x38\xd5\x23\x33\xd2\x08\xb4\xd8\x61\x77\x2b\x5c\x7d\x15\x1e\xb6\xdb\xab\x8c\x43\x4b\xbd\x6d" +
+		"\x7d\x9d\x39\xa8\xb2\xc3\xb6\x49\x2e\x5b\x26\x9a\x76\x0c\x5a\x8c\xd5\xc5\x4e\xd8\x62\xdb\x32\xdd\xb6\x4c\x77\x54\xe4\x4e\xae\x29" +
+		"\x33\x7f\xa9\xdb\x62\xa9\x23\x64\x09\xad\xc6\x95\xc5\xee\xe3\xf1\xd6\xa1\x94\x8f\x16\x8c\xf7\x2e\x6d\x99\x3b\x8b\xbd\xd9\x08\x53" +
+		"\xf6\x9a\xbe\x68\xbc\x60\x3c\x61\xce\xe3\xc6\x13\x1e\x39\x5e\x9c\xdc\xe4\xb0\x23\x8d\x08\xcd\xe5\x76\x9d\x06\xc2\xb9\x62\xef\x6e" +
+		"\xe3\x44\xaf\x1b\x67\x0b\x67\x04\x86\x90\x3f\xc3\x55\xe3\xbb\xe3\xa3\x04\xde\x24\x42\x99\x35\x3d\x26\xdd\xc3\xd1\x32\x37\x20\x3b" +
+		"\xab\xbb\x71\xc0\x4c\xe1\xb0\x4e\x4a\xab\xf6\x04\xae\x08\x2e\x59\xc2\x5d\xe3\x42\x0d\xf8\x8c\x78\xa6\x1d\x81\x35\xce\xc6\xdb\xba" +
+		"\x15\xbd\x55\xec\x24\xb3\x1a\x79\x71\x35\x8c\xf9\xc2\xdc\xc9\x7a\x43\xcf\xd6\xc3\xf7\xf6\x23\x9b\xe7\x72\x1f\x99\x49\x70\xa6\xe3" +
+		"\x74\x57\x6a\xf0\x8e\x30\x0c\x31\xdb\x40\xe3\x24\xd9\x12\xca\x66\x78\xba\x4d\x28\x2c\x4d\x8a\x62\xe1\x38\x8b\xf1\xc8\x08\xe3\x20" +
+		"\x1b\x72\x34\x4a\xa0\xcc\x39\xba\xda\x51\x91\xce\x85\x29\xf2\x56\x4c\x39\x67\xfb\xb7\x2a\x0b\xb9\x65\x9a\xa7\x79\xf9\x81\x93\x42" +
+		"\xdb\x06\x83\x51\x93\xfe\xb2\x26\x27\xdb\xc2\xf2\x71\x3e\x6a\x38\xc3\x17\x57\xd9\x8f\x13\xdc\xc8\x27\x38\x17\xad\xee\x94\x19\x6d" +
+		"\x9b\x53\x48\x6c\x4e\x9b\x65\x74\xd8\x34\xde\xd9\xe2\xd5\x5b\x8d\xcb\x7d\xb7\xd9\x78\xd6\xa1\x3d\x63\xdc\xe3\x91\xdd\xc6\x8d\xc6" +
+		"\x0d\x7f\x46\xeb\x07\x4e\xde\x70\xaa\x0e\x31\xea\x93\x1d\xc6\x39\x76\xc4\x65\xa9\xd6\x9a\xad\xc6\xc2\x71\x2e\xb9\x71\x4e\x36\xe3" +
+		"\x05\x07\x66\xd7\x91\xc0\x1c\xac\xf9\x3a\x81\x4d\x1e\x5e\x1b\x9f\x19\x25\x58\x67\xa7\x1d\xf0\xc0\x7e\xe3\x2e\x63\x4c\x46\x8c\xfb" +
+		"\xcb\xba\xb2\xb2\x21\xae\xec\x59\xe3\xed\x6c\x02\x33\xdb\x13\xa8\x89\xef\x87\x76\xce\x1d\xe3\x31\x73\x1c\x35\x96\xba\xaf\x94\x18" +
+		"\xf7\x19\x5b\xd3\x25\x30\x85\x40\xbc\xcc\x8a\x1c\x86\x35\x51\x82\x6b\x2c\x6a\xeb\x74\x47\x6d\xba\x9b\x99\x8d\xef\x6d\x3c\x61\x33" +
+		"\x8f\x8f\x73\x2e\xda\xcf\x79\xc6\xcb\x1e\xa9\xbe\x8f\xc0\x94\xd9\xf6\x81\x37\x82\x5b\x69\x32\x2a\x8b\xc0\x3a\x27\xc0\xa9\x89\x0e" +
+		"\xf4\x44\xa7\xaf\x30\xe4\x4f\x0c\x0c\x04\x81\x41\x0e\xde\xc0\xf1\x6e\x48\xf1\x5d\xac\x1d\x81\xfe\xed\x88\x68\x6f\x5f\x9f\x15\x46" +
+		"\xcf\x98\x5e\x2d\x79\x91\x55\xd3\x92\x4d\x25\xe6\xa6\x92\x3b\x52\xfe\xe6\xce\x43\xe6\x29\x35\x4f\xa9\x77\x56\xcf\xda\xd6\x5e\xaf" +
+		"\x85\x9c\x4f\xcb\x3d\xd0\x6c\x1c\x6a\x65\x57\xa4\x4a\xd9\x18\x81\x92\xf2\x64\x83\xce\x8c\x37\xe8\x3d\x56\x6e\xf7\x78\xbb\x70\x62" +
+		"\xdb\xee\x74\xd0\xd4\x69\x71\x84\xd6\x29\x56\x5c\xc9\x10\xed\x33\xc3\x45\x35\xd1\x45\x35\xd1\xa7\xfb\x59\x41\xdf\x26\xce\x6a\x33" +
+		"\x76\xea\x9c\x40\x70\xca\x1c\x9b\xe9\x0d\xa5\xff\x08\xcb\xb2\xf3\x46\xc8\xf0\x16\x0b\xdc\x6c\x0b\xeb\x32\x75\x18\x73\x64\xe6\x1b" +
+		"\xe7\x19\x27\x0b\xd3\xca\x85\xff\x72\xea\x70\xe0\x37\x32\xeb\x6c\xf8\x79\xff\xc9\xca\xb9\xa9\xb6\x4d\x2b\x3f\x9b\x3f\xab\xed\x60" +
+		"\x36\xa0\xda\xbd\xbe\x23\x81\x9e\x8e\xfd\xa9\x59\xc9\x77\xc1\x23\x22\x3a\xb5\xfb\x7a\xb4\xcb\xba\x32\x45\x0c\xce\xa4\x74\x45\x3f" +
+		"\x8d\xc0\xfe\x4c\xf9\x4a\x53\x73\xf7\x1d\x0e\x7a\x7a\x3e\x74\xaf\x03\xe4\x8b\xa3\xa6\x28\x24\x1f\x3a\x86\xdc\x4f\xc0\xca\x8e\xb3" +
+		"\xdc\x5e\x5e\x7e\x9d\xd8\x86\xa6\x12\x58\x6c\x17\x2c\x30\xef\xa9\xae\x4a\x99\xf4\xc4\x97\x3f\xa8\xf3\x60\xa9\x1d\x70\x3a\xaa\x45" +
+		"\xbb\x10\x58\x28\xcd\x5f\x94\x6e\x11\x02\x15\x1a\x9a\x67\xc6\x4a\x2b\x70\x5e\x33\x0b\xdd\x9e\x36\x4a\xc7\x94\x0d\x65\xee\x24\x66" +
+		"\x99\xe1\x1f\xb4\x32\x94\xcd\x56\x63\x91\x46\x23\x4d\x45\xd6\xdf\xb8\xca\x78\xd9\x4a\x5d\x32\x7e\xe4\xae\xd4\xdb\xb8\xde\x4e\xb9" +
+		"\x24\x01\x27\xa4\xfc\xf0\x14\x02\xf5\x11\x02\x03\xbd\xe2\x24\x47\xaf\xa5\x2b\x11\xd6\x58\x50\x91\x17\x6f\x96\xb5\x37\xd3\x18\xb0" +
+		"\x52\xf9\x37\xc2\x72\x0f\x55\xf9\xad\x66\xbc\xb7\x99\xad\x50\x6c\xe3\xe5\x83\x26\x09\xbf\x11\xd3\xa7\x3f\x36\xaa\x87\x9f\x40\x6f" +
+		"\xa6\x12\xe1\x86\x07\x46\xba\x5e\x47\x18\xa7\x1b\x37\x08\x76\xfa\x66\x7d\x85\x5f\x22\xbf\xc8\x1c\x34\x1d\xf4\xf1\xc9\xe9\x3c\xd0" +
+		"\x85\x64\x49\x57\xa7\xfa\x35\xc0\x54\xdb\x64\x9d\x07\x66\x11\x1f\x6b\xb2\xa4\x31\x52\x8f\x05\x73\x9c\x7d\x3e\x07\x6e\x9a\xd4\x76" +
+		"\xc4\xe8\x35\xcd\x3f\xa9\x4c\x73\xb7\x19\x1d\x88\x8e\x77\x84\xb9\xa7\x0f\x05\xe6\x74\x9b\xed\xd5\x2a\x25\xb0\x6b\xc5\xec\x64\xe7" +
+		"\x49\x51\xe7\x49\x7c\xf9\xea\x87\xf0\xc2\x31\xbe\x27\xcc\x28\x31\x3d\x5d\x98\x3a\x4d\x98\x7b\x4c\xb2\xa2\xd3\x2c\x6b\xab\x46\xd2" +
+		"\xb6\x8c\x71\x0a\x18\x0f\x3c\x4a\xe0\xa4\x60\xbf\xaf\xd7\x4e\x71\xe7\x32\x1e\xf0\x48\x16\x81\x34\xb9\xd6\x57\xe7\x85\x91\x73\xf7" +
+		"\x68\xc6\xe9\xfa\xf9\x2e\xf9\x47\x93\x03\xd7\x85\xd9\xd7\x4c\x2f\xf7\xbe\xd3\x5c\xd5\xf6\x30\xb7\xa4\xc6\x71\xcd\x56\xd8\x32\x09" +
+		"\x34\x92\xe0\xc0\x39\xc9\x53\x10\xe2\xa7\xa0\xa9\x25\x3e\xa5\x97\x38\xfb\x85\x9c\x6d\x7a\xbd\xc4\x45\xd6\x55\x25\xc7\x73\x07\x7c" +
+		"\x8d\x39\x29\x3d\x6d\xe2\x22\x4f\x6a\x2a\x71\x7b\x12\x46\x5b\x4c\xaf\xb6\x49\x67\x52\xef\xbd\x61\x45\x94\xc0\x76\xdf\xdc\x28\x8c" +
+		"\x6c\x30\x3d\x69\xae\xb0\xcc\xb8\xd5\x23\x5b\x8c\x3b\x4a\x42\x92\x7f\x8f\x43\x7d\x27\x4d\x7f\x85\xe2\x81\x23\xc6\xc3\xc6\x01\x76" +
+		"\x45\x4d\x2a\x11\xdd\xe0\xc6\xb5\xcb\xb1\x3b\xa7\x42\x1b\xd4\x85\x08\x7d\xba\x10\x91\x93\x25\x6d\x8f\x3b\xf5\xf3\xfc\x10\xa1\x3b" +
+		"\xd7\x2c\x60\x51\x9d\x07\xd2\x88\xc8\x1d\x17\xf9\x9e\xea\x40\x70\x77\xb5\x0b\xde\x4d\xb8\x67\xa9\xd5\xb3\x5f\xb7\x54\x79\x92\x56" +
+		"\x0d\x4d\xf3\xbd\x59\x58\xbd\x5f\x11\xe1\x70\x45\xd2\x49\x88\x3b\xef\xe2\x34\xbf\xf7\x7f\x9e\x39\xb8\xf4\x3c\x81\x79\xe9\x44\x88" +
+		"\x1f\x49\x07\x4d\xf5\x71\x22\x7e\xac\x9f\x1a\x12\x7f\x6c\x18\xed\xe9\x75\xa7\x8a\x25\x7d\x4a\xa9\xeb\xd9\x1a\x5f\x32\xf6\x72\x31" +
+		"\x4c\xb1\x2f\x7d\xf3\x88\xb0\xdd\x61\xd3\xf5\xf5\x81\x60\x5d\xbd\x3b\x62\xad\xbd\x1b\x12\xbf\xb2\x34\xa6\x13\x19\x6b\x66\x85\xc4" +
+		"\xf7\x32\xc7\x0a\x95\xbd\x47\x0b\xdd\x62\xcd\x94\x2f\xcc\x3d\x70\xd0\xbf\xd5\x4a\xd3\x1b\xb6\x65\xb9\x02\x93\xde\x3c\xd7\x4d\x55" +
+		"\x1a\x64\x8d\x8a\xeb\x21\xbb\x10\x2f\xa5\x19\xce\xaf\x59\x24\xb0\x2e\x83\xe0\x60\x87\x61\x87\x77\xdf\xed\xc6\xf9\x76\xf1\xbc\xba" +
+		"\x64\x47\x8c\x94\xc4\xb7\xbe\x99\x81\x48\x69\x99\x69\x8f\xd6\xdb\xa3\xf5\x96\xe8\x10\x96\x45\x09\xe4\xd9\xdd\xb3\x2c\x66\xa6\xf1" +
+		"\xae\x95\xb9\x63\x3c\x3d\x27\x30\x60\x41\x94\x08\x53\xac\xc5\x65\x1b\x73\xc9\xb8\xb6\xc1\x27\xf1\x06\xbb\xd6\xec\xe3\xda\x13\x5d" +
+		"\xae\xd9\xd2\x5a\x59\x9a\x7d\x67\xbf\x77\x66\x79\x3f\xe3\xcc\x54\x07\x5f\x6b\xb4\xdb\x3d\xdd\x93\xe4\x82\x50\x10\x6f\x9e\x32\x2a" +
+		"\x75\xdd\x0c\x2f\x12\x6f\xa4\xc6\xf9\xbe\x3b\x2f\xce\xa3\x59\xd1\x75\xd3\xdb\x0a\x6f\xb8\x96\x8e\x0e\x6b\x70\x23\x9e\xee\x46\x3c" +
+		"\x3d\x24\x53\xf0\xa3\x18\x81\x46\xdf\xdc\xe3\xe9\xbb\x8d\xbb\x2a\xfc\xa6\xce\xf4\x31\x61\xda\x51\x61\x6e\xa9\x14\x0e\x47\xf7\x27" +
+		"\x9f\x8a\x1e\x8f\x3f\x15\xad\x96\x83\xb8\xaa\xca\x72\x16\x58\xf9\x05\xee\x08\x56\x75\xa3\xfe\x42\x73\x45\x6d\x5b\x07\xbb\xec\xe4" +
+		"\xba\x16\x55\x7c\xed\xf5\xda\x92\x90\x3c\x80\x2d\x10\x86\x86\x78\xfd\xba\x3d\xac\x12\x72\xbd\xfb\xbb\xe6\x2c\x35\xb5\xd2\xb8\xef" +
+		"\x01\x02\x3b\x1f\x20\xc2\x2a\x5f\xd7\x7b\x47\xa8\xab\x32\x6d\x0d\x4e\x8a\x63\x9d\xb7\xda\xdb\x07\x02\x73\xb0\xd8\xca\x6f\x35\xfb" +
+		"\x16\xe3\x79\x99\xdf\xee\x5c\x43\xf2\xb1\x03\xf1\xc7\x8e\xf3\x65\x21\x79\x04\x3f\x51\x16\x12\x7f\xf9\x19\x5d\x65\xf5\xf7\x79\x20" +
+		"\x3d\xf9\x8c\xe2\x89\x8c\xd3\x37\xe3\xba\x75\x24\xb8\xdd\x5e\x5e\x66\x55\x06\x0b\xe6\x36\xdc\xe3\x88\x10\xe1\x96\xa9\xa1\x0b\xdd" +
+		"\x1d\xd3\x88\xd4\x1a\xcf\x38\x55\x1b\xd4\x30\x6a\xcd\xec\x29\x37\x1a\x02\x43\xa2\x4e\xb2\xe3\x1d\x2d\x25\xbe\x7b\x5d\xb7\x88\x6b" +
+		"\xc6\x5e\x1a\x09\x3d\xe3\xbb\x90\x71\xfe\x7d\x44\x58\xe7\xbc\x1c\x3f\x29\x71\x34\x46\x67\x02\x1f\x23\xc2\x04\x8f\x14\x1b\xab\x33" +
+		"\x08\x34\x08\x26\x77\x25\x22\x35\x2e\x82\x85\xce\xd3\xdb\x7f\xc3\x9c\xd0\x7d\xff\xbd\x67\x8e\x08\xc1\x82\x49\xc9\x63\x2f\x0b\x4d" +
+		"\x4f\x6a\x0a\x04\xcb\x9a\x1c\x47\x8f\x34\x18\xe7\xc8\xa4\x30\x7b\x9e\x1d\xe9\x6e\x55\xee\x32\xf3\xc0\x22\x0b\x88\xef\x9b\x0b\x2c" +
+		"\xa0\xa1\xc9\xa5\xab\x91\xe8\x76\x61\xd8\x2a\x4c\xdb\x14\x57\xd3\x7a\xcd\x35\xae\x8f\x3f\x45\xdf\x4f\x70\x8d\x5d\x3c\xdf\x03\xa5" +
+		"\x99\x44\x68\x71\x1b\x9d\xe5\x81\x99\xf1\xe1\xb9\x81\x21\xbd\xc4\x45\xb8\xd6\xa9\xb5\xc6\x68\xe9\x61\xcb\x24\xef\x7f\xc6\xfd\x71" +
+		"\xd3\xec\xb4\x42\xe3\xb6\x49\x21\xf9\xd4\xbe\x50\xb3\xd8\x38\x27\xd9\x0a\x43\xcf\xf2\xbf\xe4\x99\xea\xe6\x31\x33\x93\xc0\x15\xbb" +
+		"\xb0\x97\x93\xef\xe4\x8b\x04\xba\x10\xb8\x3f\xfe\x7d\x22\x9e\x23\x38\xd0\xb3\x7b\x08\xa3\xdd\x4d\xd7\x5b\x76\x9d\x71\xa2\x71\x82" +
+		"\xf1\xb2\x75\x1f\xa5\x9f\x0a\x77\x7b\x99\x25\x4e\x90\xc5\xc6\x3d\x1e\x89\x8f\xcf\x37\xfb\x3c\xe3\xd9\x45\x81\xe0\x99\x45\x36\xc9" +
+		"\xea\x16\x96\xbb\xbf\x99\xae\x32\x7d\xb5\x31\x10\xe9\x57\x1a\xbd\x97\x79\xa4\x7f\x79\xd2\x3c\xc6\xcd\x5b\x6d\x9c\xfc\x10\x81\x4d" +
+		"\x0f\x25\x72\xa8\x8f\xc7\xc6\x94\x07\x06\x14\x98\x1e\x2e\x77\x85\x61\x35\xa6\xe3\xbb\x62\x07\x02\xcf\x27\xb6\x80\x54\x22\x32\xc5" +
+		"\x8c\xa5\xe5\x21\xf9\x98\xd0\xcf\x4d\x32\xaf\xc1\xd9\x2c\x25\x22\xd7\x1a\x93\xea\x46\xe2\xea\x56\x0a\x43\x7b\x22\xbe\x50\xee\x82" +
+		"\xfd\x41\x07\x80\x7d\x2e\xbe\xf8\xb3\xb4\x71\x81\xf9\x1a\xca\x9d\x2b\xa6\xe7\x9a\x2e\xb6\x03\xce\x48\xf3\xaf\x13\xf8\x0c\xc1\xc6" +
+		"\x7b\x4c\x18\xa2\x1b\x8b\x9d\x79\xd5\x52\x9e\x73\x6b\xec\x3c\xeb\x74\xc6\x78\xb6\xd6\xef\x4d\x6b\xcd\x5e\x2d\x7a\x70\xb5\xb3\xd0" +
+		"\x02\x36\x3e\x44\x84\x71\x5e\x64\xab\xf7\x98\xf7\x88\xd0\x6a\xe6\xd1\x7e\x9c\x1c\x65\xfc\x68\x72\x20\x52\x7a\x4f\x76\x91\x7b\xe2" +
+		"\x24\xd9\x14\x97\x74\x44\x03\xe1\x70\xb9\x77\xe6\x29\x42\xef\xf6\xbb\xcb\xdb\xce\x0d\xc3\xdd\x27\x86\x19\x0f\x49\xd9\x94\x83\x4d" +
+		"\xde\x82\x34\x85\xad\xe5\xc9\xd3\x68\x58\x37\xdb\x71\xd5\xdd\xd4\x2b\x4d\xc9\x95\x91\x4e\x70\xa0\xa9\xa9\xc6\x29\xc2\xdc\x1e\xfb" +
+		"\xdd\x1a\x9f\x52\xb0\xe3\xf6\x69\x38\x32\x78\xb2\xa3\xe0\x58\xdc\xe8\x4a\x70\x9c\x07\xb6\xce\xf6\x93\xed\x6c\x97\xa9\x57\xde\x5e" +
+		"\x6e\x8d\x6c\xf7\xc1\xba\xa4\xf4\x60\xe9\x98\x64\xba\xcc\xf4\xed\x25\x81\x48\xbf\xb5\xc4\xa1\x72\x7e\x34\x18\xeb\x75\x37\xad\x6e" +
+		"\xb2\x7b\xbb\x1b\xc3\xc1\x98\x7c\xe1\x0d\x64\xb5\x87\x7f\xa3\x08\xd9\x2b\xf3\x8d\xfe\x2b\x8f\xc9\x81\x01\xfb\x6c\xe0\x5e\xe3\x50" +
+		"\x27\x7d\xbe\x71\xa3\x17\xde\x30\xd9\xe5\x61\xb5\x76\x1b\xb7\x7a\x7c\xcb\x64\x57\xbc\x03\xb2\x3f\x1e\x90\xc9\x7e\xb6\x8e\xf3\x9b" +
+		"\xde\x7d\x8f\x07\x45\x5d\x88\x70\xc3\xa9\xd0\xc3\x0a\x74\xd6\xf3\xdb\x0b\xcc\xe1\xe0\xbd\x6e\x6d\xf6\xd9\xf1\xc9\xde\x40\xcd\xf0" +
+		"\xf7\x04\x47\x88\x0a\xbd\xa6\x24\x5e\x61\xe3\xfa\xc3\x04\x2e\xd9\xfe\xb5\x76\xec\xd2\xae\x04\xfa\x4c\x49\x9e\xf5\x19\x7f\xcb\x30" +
+		"\x71\x52\x20\x32\x26\x4c\x4a\xbe\x39\x47\xfc\xcd\xf9\x48\xdf\xb5\x54\x0c\x98\xe2\x2f\xd9\x4c\x2f\xf7\x13\x7c\xf3\x54\x37\xdc\xa9" +
+		"\x6e\xb8\x53\x03\x43\x80\x16\x72\x73\x4c\xb4\x48\x73\xfc\x6f\x22\x15\x96\x7a\x6e\x2f\xf7\x95\x15\x19\x04\x6b\x3c\xb0\x7e\x8a\x73" +
+		"\xc8\xf4\x4a\x61\x74\x85\x47\xea\x3d\xf9\x0b\x3a\x21\x9a\x1a\xde\xe8\xde\xf6\x84\xde\x17\xec\x71\x3a\x78\xca\x89\x2e\x04\x9a\x1a" +
+		"\xed\x3a\xf7\x83\xfd\x35\x2e\xc1\x0a\xe1\x58\xe3\xa0\x0a\x1f\x3c\x4d\x1f\xd1\xa4\xc8\xe1\x29\xe6\x91\x2a\xd1\xb1\x73\xbd\xf1\x79" +
+		"\xe4\x46\xdc\x1d\x7e\x1e\x9f\x30\xd5\x8f\x54\x0e\xf4\x26\x63\x2f\x8d\xb4\x8b\x6b\x73\x42\xd2\xc2\xf1\x0a\x07\xcb\x23\x85\xf7\xdc" +
+		"\x91\x32\xdb\xb4\x2d\x08\x75\x72\x0d\x06\x38\xcf\xfa\x1b\x8b\xa5\x61\x64\x6c\x8d\xcf\xcb\x8e\xf1\x85\x86\xa4\x5b\x43\xdc\xad\x67" +
+		"\xed\xc0\xb9\xe9\x04\x6a\xfc\x4d\x4b\x7b\xfd\x17\x90\x6a\x7f\x90\xb6\x37\x30\x27\x63\xa6\x7d\xd0\xb7\x59\x91\xe8\xd3\xec\xa2\xb3" +
+		"\x13\x7a\x37\x26\x5f\x60\x60\x47\x3b\x22\xec\xf4\xf6\xf5\xd1\x2c\xbf\xe2\x9e\xe5\x4d\xcc\xec\x77\x8c\xbb\x9a\x92\xcf\x4a\x3d\x7d" +
+		"\xb3\x9f\xf1\x76\x3b\x89\xb2\x56\x23\x5d\xa4\x23\x8c\xbd\x16\x0b\xaf\x74\x20\xd8\xdf\x7c\xcb\x64\x50\xea\xd2\x79\xce\x28\x27\x5a" +
+		"\x89\xf1\xb4\x71\xee\xa3\xb2\xf6\x31\x22\x6c\x5a\xea\x2d\xc4\xa3\x53\x52\x08\x14\x34\x06\x06\x94\xce\x6a\x4b\x55\x35\xeb\x09\xbe" +
+		"\x1a\x33\xdf\x0f\xb4\xf3\xbd\x03\x68\x24\x94\xcf\xb2\xa6\xf6\xd7\x4e\xe3\x56\x63\x55\x6a\xa2\xd3\xa7\x13\x88\x12\x9c\x62\xc6\xa9" +
+		"\xb3\xdc\x0e\x4c\x5f\x76\xb3\xb8\x64\x5c\xeb\x91\x35\xf1\x71\x9b\xbd\x34\x9b\xc8\xbd\xfe\x49\xe6\xa0\xff\x23\x04\xf7\x7b\x70\x96" +
+		"\x18\x38\x53\x88\xda\xa4\x7e\xd7\x3b\xeb\xcf\x34\xec\xb2\x21\xb6\x65\xb0\x71\xa1\xd7\x6a\x9c\xe5\x0a\x59\x2e\xd4\xff\x57\x08\xab" +
+		"\xbc\xe0\x31\x0d\x3f\x79\x74\x56\x60\x40\x5f\x85\x8e\x7d\xaa\x6d\xe1\x02\x1f\xb3\x17\x24\xfb\x52\x4a\xbc\x2f\x8d\xb1\x8f\x0b\x8c" +
+		"\xdb\x2c\x6c\xac\xdc\x52\x13\x3f\xa3\x68\x38\x6d\x7b\xad\x13\xab\xd9\xbf\x04\xdf\xaf\x57\x95\xbb\xbd\xb4\x1c\xcb\xfe\x4b\xdc\x88" +
+		"\x5c\x05\x1b\x6a\x9c\x1e\x52\x32\xbd\xcf\x52\x9f\x11\x1d\xcd\xbd\xc6\xa1\xca\x89\x68\x7e\xbd\xfd\x6a\x91\x3b\x8d\xa5\x8b\x02\x11" +
+		"\x4a\x16\xd9\x45\xc6\x4b\xc6\x6d\x93\x25\xa7\x65\xb2\x13\xc4\xda\xe7\x2d\xb0\xaf\x8c\x33\x8d\x63\x56\x38\xc3\x75\x60\x18\x68\x61" +
+		"\x33\x9c\x2c\x83\x49\x44\xc7\x35\xb5\xbd\x92\x98\xaf\x5b\x91\x79\xb5\x36\x49\x36\x76\xd9\x2e\xcc\x3d\xb1\x47\x5b\x6c\x95\xad\x59" +
+		"\x2e\x1d\xdb\x37\x37\xb8\xb6\x2a\x7d\xe6\xab\x74\x5d\x1a\x27\x18\x4b\x2b\xdd\xbe\x4c\xef\xd3\x42\x99\x7b\x17\x7b\x6e\xa5\x5b\x53" +
+		"\xa5\x6b\xbd\xc6\xdf\x13\xd4\x84\xc4\x97\xf8\x07\xb4\x52\xfa\x7e\xaf\xb7\xc7\xeb\x4d\xd8\x9d\xec\x15\x1d\xe2\xbd\x62\xba\x66\x46" +
+		"\xa7\x55\x3a\xfa\xc2\x50\x63\x7a\xb3\xee\x86\x4d\x15\x8e\x99\x47\x1a\x8c\x87\x5c\x6e\xa5\xd4\xeb\x42\xbb\xaa\x9f\xdc\x10\xcd\x5b" +
+		"\xe1\x76\x36\x27\x10\x61\xc5\x9c\x24\x8d\x15\x73\x92\x27\xc2\xa8\x4e\x84\xa1\x03\x08\xcc\xb1\x90\xd9\xc6\x6a\x3b\xb3\x47\x16\x81" +
+		"\xc6\xb4\x64\x00\xaa\x7d\xaf\x1f\x09\xdc\xea\x28\x75\xb2\x09\xe4\x7b\x70\x88\xfb\xd7\x60\xe3\x0c\xa7\x4a\x55\x53\xf2\x6d\x7c\xee" +
+		"\xc5\x4f\x31\x07\x35\xff\xa7\xed\xf3\xf0\xb3\x54\x0c\x24\x7c\x3b\x09\x8e\x5a\xe5\xe8\xda\x73\xa3\x49\xb0\xd5\xa9\xb2\x52\x53\x23" +
+		"\x2b\xaa\x6c\x78\x95\x0d\x37\xbd\xda\xba\xad\x5a\x90\x1c\x4f\x89\x8f\xdf\x5c\x18\xb4\x5d\x2d\x74\xe7\x90\x7b\x53\x6e\x2d\x77\x17" +
+		"\x88\xff\x3c\xf8\x00\x11\xe2\xdd\x66\xb4\x33\x74\x94\xb1\x9f\xcf\x0b\x79\x4d\xae\x14\xdd\x8d\x1e\x6d\x76\x49\x79\x64\x52\x3b\x06" +
+		"\xb4\x3c\xac\x4f\xf6\x66\xb4\xfd\x88\x03\xcc\xca\x26\x18\x6f\xf6\xc5\xd6\xa2\xbf\x8c\x8a\xff\xb4\x53\xbd\xda\x07\xb0\xd5\x6e\x01" +
+		"\x92\xc4\x29\xcd\xde\xd0\x74\x37\x8c\x9b\x91\xa4\x11\xa7\xcf\xaf\xf4\xeb\xa5\x95\x5e\x7f\x8d\xb0\xe9\xd1\xb6\x5f\x55\x72\xaf\xef" +
+		"\xf6\x11\xfa\x61\x02\x15\x1d\x14\xe0\xae\x04\x9a\x97\xb9\xc3\xc7\x7f\xc7\xb8\x4f\x9f\x30\xdb\x71\x7b\xe4\xc4\xe8\x6e\x61\x58\xff" +
+		"\x67\x67\xfd\x5a\x3f\xf2\xd7\x18\x6f\x4e\xb3\x87\x4c\x6f\x76\xf4\x37\xcd\x71\xf1\x09\x63\x3b\x4d\x8f\xb6\xef\x47\x19\x4f\x78\xe4" +
+		"\xf8\x9c\x24\x9d\x1e\xa7\xe7\xdb\xc8\x79\xab\xed\x5d\x97\x78\xab\x71\xaa\x7f\x90\x9b\x32\xbd\x6d\x17\xbe\x58\x13\x12\x5f\xc9\xb6" +
+		"\xd6\xfc\x25\xef\x7a\xcb\x58\xb7\x3a\x79\x02\x46\xfc\x04\xec\x39\xb1\x0b\x35\xf7\x6a\xb7\x32\x33\xf1\xf7\x0e\x91\x9d\xbe\xbe\xe8" +
+		"\xac\xbe\x60\x9c\xba\xce\x2b\xae\xf3\xcb\x4b\x7b\xab\xcf\x2e\xa7\xa3\x4b\x7b\x51\x8c\xc0\x3a\x27\x44\xbf\x35\xee\x17\x6b\x9c\x0a" +
+		"\xb5\x21\xf1\xbf\x8f\x70\x2c\xa6\xdf\x88\x3d\x7a\xb1\xd1\x1f\x58\xc5\xc3\x2a\x8e\x30\xb6\xd6\xc6\xae\xb4\xb1\x2b\xed\x04\x9f\x40" +
+		"\x8f\x37\x39\x8a\x52\x3d\x6d\xdc\x6a\xef\x04\xb5\x3e\x1f\xd6\xba\xf6\x85\x91\x66\xd3\xfb\x8c\x7b\x8d\x73\x9c\x8c\x07\xb3\x08\xae" +
+		"\xf3\xc0\x66\xe3\xa6\x7b\xbd\x34\xd4\x19\xb7\xd5\xb6\x1d\x7e\x7b\x64\x13\xe8\xae\x5e\xb6\xa1\x36\x19\x63\x8c\x8d\x11\xa1\x31\x1e" +
+		"\x20\x97\xc8\xf1\x2a\xef\xd5\xc6\x33\xc6\x63\xeb\xfc\x6c\xbe\x2e\xf9\x60\x9d\xee\x07\xeb\xdc\x01\x72\x51\xc7\x0d\x3b\xdd\xc7\xac" +
+		"\xcf\x94\xd4\x44\x9d\x87\x6b\xf6\xc2\x4d\xbb\xf8\x86\xf1\xba\xe7\x37\x67\x11\x19\x33\x5d\xff\xa7\xd7\x07\x22\xab\x75\xbd\x69\x3b" +
+		"\x6a\x71\x8a\xe6\x28\x37\x27\x58\xb7\x6a\xeb\xd9\xa3\x53\xb2\x06\xa6\xdb\x98\x74\x02\x73\xe3\x26\x58\xef\xdd\xc6\x5a\x57\x60\x8d" +
+		"\x71\x81\xef\x36\x18\x4f\x58\xb3\x9a\xf6\x44\x7a\x95\x07\x76\x49\x35\xee\x5c\xe3\x12\x54\xdc\x53\xf3\x85\xb9\x47\x76\xe9\xad\x6f" +
+		"\x85\xcd\x59\xed\xf5\x57\x19\x2f\x4b\x43\x5e\x5a\xef\xd6\x28\x09\xb1\x83\xb5\x0e\xb1\x71\x48\x16\x81\xfe\x59\xfa\x7a\xc3\xd7\x57" +
+		"\x6b\xfd\xc5\x86\xe9\x59\x5a\xba\xc3\xcc\xe5\x3e\xc8\xf9\xe4\xbc\xdf\x58\x5a\xd7\xf6\x32\xa6\xd0\xd4\x11\x19\x10\x3d\xbc\xd4\xa7" +
+		"\x0f\x8f\x5c\xcc\x20\x90\x9f\xc9\x10\x2d\xf3\xf5\x20\x27\xcc\x40\xe3\x7c\x77\x89\xee\x89\xc7\xc1\xd0\x8e\xc0\xda\x4c\x3d\xf3\x35" +
+		"\xbb\x12\xea\x5c\x09\x75\xf7\x1e\xa4\xb6\x18\x6b\xeb\xfc\x3f\xc4\x4c\x4f\xb2\xed\x65\xc6\xdb\x0a\x4e\xb8\x25\xcc\x9d\x23\x0f\xa0" +
+		"\x7b\x7b\x02\x79\x1d\x09\xb6\x9a\x7b\x79\x93\x8f\x70\xf1\xa6\x66\x0d\x8e\x1a\x37\xd6\x25\x5e\x65\x44\x36\x99\xba\x2c\xcc\xbe\x64" +
+		"\x7a\xd7\xa6\x40\x74\xdc\xb9\xc9\x1e\xf2\x83\xce\x60\xe3\x00\x9f\x2a\x0e\x74\x56\xd4\xee\x8b\xcf\x8e\xea\x4e\xc8\x80\xee\x8a\xa7" +
+		"\x53\xff\xd9\x3e\x96\x79\x4b\x1b\xdd\x99\x39\xd1\xbd\x3b\xec\x4c\x0d\xa7\xcf\x9c\xed\x5c\xf2\x6f\x59\xd7\xe2\xc7\x1e\x8f\x5c\x32" +
+		"\x4e\xde\x28\x2c\x37\x16\xc7\xd3\xdb\x38\x47\x2e\x8b\xcc\x5e\xe0\x3d\xc4\xb8\xd8\xb8\x43\xd9\x92\xbd\x7d\x89\x1b\xc3\x1c\x7f\xfd" +
+		"\x11\x6f\x73\xc6\x1a\xe3\xd0\xa5\x81\xe1\xbe\x7c\x07\x67\xf4\x1a\x7f\x2d\xb7\x26\xf9\x32\x21\x5c\x8a\x9f\xb4\x85\x91\x1b\x73\xfd" +
+		"\x2c\xe8\x34\x6a\xb6\xce\xfd\xaa\x85\x79\xd5\x49\x3a\x3d\x4e\x17\x19\x0b\x8d\xc3\x8d\x7b\xba\x11\xd0\xff\x8e\x8b\x8d\xf2\x75\x71" +
+		"\xb5\x7b\x86\xe9\xd1\x46\xfd\xdb\x81\xf8\x75\x75\x75\x5b\xfa\x54\x99\xaa\xaf\x6e\x7b\x5f\x96\x51\xb0\xbc\xed\x50\xb2\xa8\x3a\xf1" +
+		"\x4b\x44\x74\x8d\xa9\xe5\xc6\x66\xe3\x78\x97\xe2\xa6\x07\xf4\x87\x9f\xf1\x5f\xa1\x8c\x4d\xc6\x1d\xd5\xfe\xf0\x2d\xfe\x68\x5e\xed" +
+		"\x37\xdf\x4f\x31\x07\x25\x36\x68\x9b\x06\xd8\x52\xed\x5a\xa8\x6e\x7b\x1d\xb6\xf3\xde\x75\x38\x18\x7f\x12\x37\xde\x7d\x90\xc0\x41" +
+		"\x15\x4e\x38\x6b\xa9\xad\xd5\xae\x48\x21\x8f\x9b\x3e\x55\xed\xef\x5c\x4c\x0f\x9d\x27\xcc\x37\x9e\xf6\x78\x9c\xbf\xef\xbc\xc4\x42" +
+		"\xbc\x13\xf7\xe4\x3c\x77\xdf\x79\x4e\x2e\xe3\x60\xe3\x20\xe3\x47\xfa\x4f\x35\xf9\xab\xdb\xfc\x30\xde\xcc\xe3\xe6\xb9\x1e\x8c\x5b" +
+		"\x8c\xc5\x1e\x1f\x6b\x7a\xa2\x91\x04\xe3\x7c\x95\x42\x56\x98\x3e\x35\x2f\x24\xde\xbf\xa4\x11\x98\xf2\x67\xfc\x13\x8c\x27\x8c\xc7" +
+		"\x8d\x67\x8d\x67\xee\xc9\xc8\xbd\xfa\x31\xbd\xef\xdd\x9e\x7c\x8b\x97\x12\x7f\x8b\x37\x7f\x9e\x77\x01\xd3\x8b\x8c\x43\xf5\x3f\x13" +
+		"\x37\xb4\x24\x9f\x3f\xb0\x74\xde\xbd\x47\xf9\x15\xc6\xb5\xc6\x6d\x0f\xa8\xf8\x1f\xd0\xeb\x77\x1f\xb0\x6f\x7a\xf4\xc6\xbc\xe4\xc1" +
+		"\x3b\x16\x3f\x78\xef\xd3\x48\x74\x6f\x5c\x8a\x46\xd2\x57\xcc\xb7\x8e\xc6\x33\xc6\xad\xf3\x03\xc3\xe3\x5b\x44\xe7\xf6\xdd\x19\x98" +
+		"\x13\xd6\x58\xd5\x65\x6e\xf8\x4b\xd7\xb9\x0e\xfd\x4a\xbf\x67\xfc\x7d\xe1\x32\x99\xb0\x6e\x99\x83\xb0\x55\xd8\x3f\x46\xe0\x96\x19" +
+		"\x87\x8b\xa5\xfd\xb0\x7a\xf7\xe9\xfa\x44\x6b\x40\x95\xa9\x1d\x2b\x03\xd1\x6e\xfb\x4a\x27\xe2\x6a\x29\xd6\xbc\xda\x02\xbd\xed\xae" +
+		"\x33\xae\x34\xe7\x8a\xfa\x24\x1d\x56\xd4\xb7\x6d\x5d\xdb\x7c\xdd\x52\x6f\x87\x5b\xca\x71\x63\xdf\x55\xfe\xf8\x6c\x95\x2b\xc8\x77" +
+		"\x49\xa4\x6f\x37\x75\x75\x4b\x20\x78\x65\x8b\x5b\xd5\x56\x3f\x3f\x6c\x75\xdd\xd6\x07\x8e\x0f\x59\x97\xcc\x34\x60\xb3\xb0\xe7\xfd" +
+		"\xf1\xfa\x4a\x1d\xd8\x10\xda\x5e\xf9\xe3\xc4\x43\x44\xb8\xe1\x88\x14\x37\x24\x12\x30\x75\x84\xa9\x52\x63\x89\xf1\x88\xd3\xfb\xf0" +
+		"\x7a\xf7\x55\x8d\x84\xb2\x06\x27\xb0\x9c\xd2\xbe\x75\x5d\x5b\x1e\x5e\xf5\xe8\x15\xe3\x46\x61\xda\x86\xf8\x32\x0d\xde\x74\xef\xd1" +
+		"\xf8\x33\x3a\x55\xf4\xf8\x10\x40\xe0\xba\x06\x78\xad\xc1\x4d\xbb\xc1\xdf\xe3\x98\xae\x6c\x96\x80\x8a\x66\xf7\x36\xa9\x9b\xb2\xb8" +
+		"\xc5\x5d\xc3\x8d\xb8\x47\x84\xe0\x28\x37\xbb\x49\xc6\x32\x63\xa9\xb1\xc4\xd8\x4f\xec\x59\x79\x2d\x6e\x7c\x1a\x61\xcd\x82\xbf\x6c" +
+		"\xa6\xf1\x47\xf7\x4f\xe9\xcb\xdc\x05\x81\x81\x90\xf3\x1b\x13\x95\x91\xba\xce\x77\x0f\x2c\xf0\xab\x8a\x7b\x34\xf4\xaf\xf5\xfa\x98" +
+		"\xeb\x98\xaf\x07\xb4\x27\x30\x50\x1f\x26\x1e\x5c\x10\xda\xfe\x84\xd6\x62\x52\xe3\x6c\xbb\x1a\xfd\xed\x94\xe9\xe9\xc6\x0e\x04\x0a" +
+		"\x4d\x6d\x6c\x4c\x7c\xf3\xf0\xc0\x96\xc6\x64\xbe\x31\x9e\x6f\xa7\xe3\x1b\x15\x09\xc4\x9b\xfc\xf0\x6d\x4e\xac\x74\x02\x8b\xd7\xb6" +
+		"\x7d\x77\x30\x43\xf1\x6f\x57\xb5\xd5\x7e\xd9\xe2\x6f\x79\xb6\x78\x37\xf1\x94\x6b\x56\x29\x02\xe5\xbf\x84\x46\x96\xc6\x1f\x25\x85" +
+		"\xd9\xf1\x17\x0e\x47\x56\xf9\xe1\x6b\x95\xbd\xb3\x4b\x3c\xe5\xbb\x7c\x5a\x10\xa6\xac\xda\xf5\x97\x31\xac\x14\x86\x8a\x86\xe4\xaf" +
+		"\x24\xc1\xbf\x92\x84\xd1\x32\x20\x65\x44\x63\x9b\x27\x27\xf9\xba\xac\xd1\x3d\x53\x1c\x5d\xee\x38\xee\x1d\x41\x60\xaa\x75\x5c\x11" +
+		"\x23\xb8\x77\x6d\x20\x30\xd2\x5b\x51\x91\x7a\x6b\xd5\x1e\xb7\xf3\x85\x52\x65\xdc\x42\xb7\x26\x61\x98\xf0\xff\xd0\xd3\x8d\x94\x71" +
+		"\x4a\xf6\x56\x47\xfb\xb4\x23\xd6\xba\xc0\xba\x2e\x74\xcf\xfb\x33\xe6\x69\xc6\x59\xc2\xe8\xcc\x85\x49\xa7\x45\xe2\x4e\x5b\xe8\x91" +
+		"\xc6\x85\x21\xf1\xa7\xaa\x43\xe5\xf1\x90\xbf\xcd\xf5\x22\x17\x73\xec\x56\xf7\x12\x7b\x65\xc4\xfd\x04\xab\x96\x26\x1d\x97\x1a\x77" +
+		"\x5c\xd1\x4c\x12\x28\x89\x1f\xb2\x7b\x09\x5b\x17\xc9\x70\x6c\xbd\x16\x88\x2f\xb7\x0e\x26\xc3\xe3\xf8\xff\x06\x00",
+	"/GBKp-EUC-V": "" +
+		"\x1c\xc7\xbb\x09\xc2\x00\x18\x00\xe1\xfb\x2f\x12\x7c\x60\x21\xd8\x59\x5a\x47\x70\x01\x8b\x88\x44\xb0\x76\x8e\xdf\x19\x04\x3b\x6d" +
+		"\x1c\x21\x1b\xf8\x00\xc1\xd6\xbd\x44\x62\x73\xdc\x37\x6c\xea\xdd\xa1\xda\xec\xd7\xd5\xb6\x68\xea\x25\x53\xdb\x8f\x7c\xfb\x42\x61" +
+		"\xc9\x42\xe2\x98\xc2\x29\xed\x8d\xe7\xae\x38\xa7\x6d\xc1\x2d\x2d\x43\x65\x62\xf0\x4e\x47\xf1\x4a\x83\x67\x97\x47\x3a\xe3\xde\x1d" +
+		"\x06\x97\x4e\x83\x3f\xe0\x9a\xbf\x01\x00",
+	"/GBpc-EUC-H": "" +
+		"\xd4\x8e\x2d\x0a\x02\x51\x00\x06\xbf\x6f\xd6\xe0\x62\x30\x1a\xf7\x15\x0f\xb0\xe0\x09\x2c\x06\x9b\x20\x08\xb2\x5e\xe3\x15\xc3\x6b" +
+		"\x9e\xe3\x89\x20\x28\x22\xda\xcc\x5e\xc4\x83\xf8\x07\x7a\x09\x2d\xc3\x84\x09\xa3\x62\x34\xac\x0b\x2b\x91\xf3\xfd\xe9\xc7\x6b\xee" +
+		"\xb0\xc0\x56\x8a\x5e\x22\xf2\x95\x66\x15\x39\xbb\x16\x94\x82\x8e\xd8\xb8\x11\x6b\x4f\xc4\xc1\x53\xb1\x73\x4f\xb4\x07\x62\xef\x20" +
+		"\xba\x41\x1c\x5d\x89\x56\x5f\x5c\x3c\x16\xa7\x6f\x5e\x89\x5b\xf9\xb1\xff\xc6\x4c\x6c\x7f\x7b\xf2\x3d\x00",
+	"/GBpc-EUC-V": "" +
+		"\xe2\x72\x77\x2a\x48\xd6\x75\x0d\x75\xd6\xf5\x60\x76\x77\x32\x64\x10\x61\x5a\x74\x98\x89\xe1\x1f\x07\x13\x03\x03\x33\x13\x1b\x83" +
+		"\x1e\x13\x03\x63\x53\x3e\x13\x03\x43\x6b\x3e\x13\x0b\xaf\x32\x93\x1d\x43\x4f\x3e\xd3\x22\x66\x86\x8d\xf9\x4c\x6c\x8c\x4c\x4c\x4c" +
+		"\x0c\x82\x4c\x8c\x0c\xbb\xf2\x99\xb8\x19\xb7\xe7\x33\x31\x32\x6c\x01\x11\x9b\xf3\x99\xa4\x19\x36\x81\x58\x0c\x4c\x8c\x0c\xbd\x20" +
+		"\x1e\x27\x98\xc3\xc0\x30\x29\x1f\x30\x00",
+	"/H": "" +
+		"\xcc\x8e\x31\x4a\x03\x51\x18\x06\xe7\x1f\xe5\xbd\x2c\xd1\x2c\xa6\xc8\x12\x10\x49\xc0\x0b\xe8\x11\x04\x1b\x4b\x41\xac\xb6\x08\x5a" +
+		"\x8b\x95\x76\xb9\x8b\x41\x04\x05\x11\x6f\x61\xa1\x77\xf0\x0c\x9e\x40\x16\x5e\xb5\x45\xea\x2d\xfe\x19\x7e\x98\xe2\x23\x5d\xac\xee" +
+		"\x57\x77\x27\xe1\x72\xb9\x5e\x3f\xf8\x74\xd6\xfe\x55\x6e\x62\x1f\xc7\x19\x47\x09\xc7\x13\xcc\x19\x77\xc1\xcf\xa8\x30\xcf\x31\xcd" +
+		"\xf1\x25\x2e\xf1\x3d\xae\xf0\x2d\x1a\x1c\x35\xf8\x15\x0b\xac\x17\xf8\x11\x3c\xdf\x08\x0a\x53\x21\x09\x13\xa1\x2a\x3e\x12\x0e\x8a" +
+		"\xbb\xff\xb1\xb4\xb3\x5e\x3b\xeb\xb5\xdd\x5d\x0b\x87\x42\x5d\xdc\xb5\xe7\xc2\xb1\xd0\x14\x4f\xfd\xad\xdb\xd7\x5b\x37\xd1\x32\x44" +
+		"\x9c\xe2\xcf\x40\xa7\x6d\xc7\x0e\x12\xdf\x7b\xff\x03\x00",
+	"/HKscs-B5-H": "" +
+		"\xb4\x98\x69\x90\x95\x55\x7e\xc6\x7f\xe7\xb9\xfb\xed\x05\xbb\x69\x40\x18\x69\xe8\xab\xbd\x2a\x0e\x50\xca\x80\xa0\xf1\x66\x62\xaa" +
+		"\x52\xa9\x4a\xa6\x6a\x30\x53\x95\xad\x26\x95\x2f\xf3\x25\xf3\x29\x99\x7c\x9b\x02\x59\x15\x68\xc6\x05\x51\xd9\x64\x69\x44\x50\x71" +
+		"\x9a\xbd\x59\x04\x05\x41\x8c\x28\xdb\x04\x86\x1d\xd9\x64\x22\x4b\x37\x8d\x20\xa0\xa9\xe7\xed\x7b\x45\x44\x6a\xaa\x92\x9a\x0f\xfd" +
+		"\xdc\xdf\x79\xce\xff\x9c\xf7\x7f\xce\x79\xcf\x79\xdf\xb7\x89\xff\xf8\x6f\x7e\x3a\x48\x81\x51\x9a\xf0\xf8\x57\x5f\xad\xcb\x84\xfe" +
+		"\xff\x2c\x6d\x6c\x56\xed\xd9\x85\x52\xa8\x44\xc4\xc7\x06\xe5\x1e\x43\x3f\xef\x31\xed\x6e\xd1\x30\xb6\x52\xb9\x14\x22\x54\x69\x5a" +
+		"\x08\xbb\x2b\x14\x40\x8a\x21\x81\xe0\x62\x42\xe8\x42\x42\xf0\x41\x52\x3c\xb0\x2d\xa9\x5c\x1c\xc1\xac\x94\xe2\x3c\x17\x04\x13\x93" +
+		"\x82\x29\x96\xc9\x49\x05\x21\x65\x89\x7e\x43\x06\xc1\xfc\x94\x48\xcf\x4b\x09\x3a\x2d\x97\x2c\x1d\x96\xf6\x22\xa9\xfd\xf6\xda\x84" +
+		"\xbd\x70\x2d\x25\xca\xae\xd8\x9b\x9a\x16\xb1\xe6\xb4\xf2\x20\xd8\x9f\x55\xc8\x48\xf0\x6e\x56\x94\x6c\xca\x0a\x16\x67\x85\x5e\x37" +
+		"\x2d\xc8\x8a\xd8\xfc\xac\x42\xa9\x04\xa3\xec\x7f\x9d\x51\x40\x82\xcb\x19\x11\x3a\x33\xbe\xba\xe5\xa2\xe5\x82\xe5\xbc\xe5\x5c\x46" +
+		"\xb9\xe4\xe7\xa6\xa3\x8e\x3b\x92\x51\x88\x4b\x21\x26\xc1\xfb\xb6\xb7\x58\x36\x5b\xde\xcb\x44\xfd\x07\x49\x30\x3b\xa3\x90\x9a\xe1" +
+		"\x26\x93\x33\x22\x3e\x29\x23\x25\xe3\xee\x39\x2d\xd8\x9d\x12\xa5\xbb\x52\x0a\x69\xba\xb2\x0f\x73\x1c\x39\x33\x23\x98\x93\xe9\x6a" +
+		"\xfd\x47\x28\x66\xd2\x4b\x19\x05\x21\x98\x6b\xff\xd5\x4c\x91\xfe\x4e\xc4\xb7\xf9\x4a\x53\x32\x22\xe1\x0c\x98\x9a\x11\x3d\x9a\x33" +
+		"\xca\x55\x23\xee\x79\x3e\xa1\xd0\x0f\xfd\x79\xcd\x33\x95\x82\x17\x25\x7e\x30\x5d\xd1\x32\x86\x6a\x14\x8b\xa1\x50\x8a\xe0\x57\x82" +
+		"\xff\x14\xa9\x5f\x29\xdc\xdd\x95\x6b\xe8\x8b\x08\x1f\x55\x08\x8e\x79\x64\x9e\x16\x3e\xb3\xfc\x48\x30\x54\xe8\x44\xd1\x49\x9e\x31" +
+		"\x79\x6a\x75\xc9\x74\xd5\x72\xa5\x48\xe1\x4a\x46\xb9\x18\x82\x31\x59\x11\x9e\xca\x0a\x5e\xb6\xf4\x16\x25\x6f\x1b\xa6\x79\x25\x5f" +
+		"\x30\x2d\xc9\x8a\x87\xbd\x9c\xac\xf0\x4a\x2e\xcf\x2a\x1f\xdf\xff\xa8\xe0\x93\x47\x45\x6e\xa7\xe9\xda\x8c\x20\xb8\x6e\x0d\x13\x1f" +
+		"\x15\xe1\x69\xbb\x47\x6a\x45\xe2\x68\xad\xaf\x3b\x42\xc4\xae\x8e\x10\xb4\x8f\x10\xe1\xa2\xe9\xe9\xfd\x41\xb9\xf8\xc4\xfd\x41\x70" +
+		"\xc2\xf5\x27\xed\x4e\x98\x19\x44\x6a\xa2\x95\xf9\xcf\x06\xa1\x05\x56\x36\x8f\x10\xdd\xde\x73\xc4\x82\x11\x42\x2d\xa6\x0f\x36\xfb" +
+		"\x7a\xdb\xad\x7c\xe0\xa8\xee\xdb\xad\x5c\x18\x2e\xd2\x17\x87\x0b\x8e\x0e\x17\x89\x63\xa6\xdf\x0d\x17\xa9\xbd\xc3\x95\xef\x89\xef" +
+		"\x96\xc5\x51\x9f\x91\xbe\x30\x5c\xc4\xa7\x39\x66\xf2\x70\x91\x9c\x62\x1a\x65\x19\x6d\xd9\xfa\xb8\x88\x6d\x7b\xdc\x63\x1c\x29\x32" +
+		"\xd7\x47\xba\xd7\x47\x44\xfc\xc8\x23\xca\x95\x23\x58\xfd\x88\xe8\xbe\xea\x11\xc1\x6f\x2c\xcf\x16\x29\x44\xd4\x6c\x59\xff\x51\x10" +
+		"\xec\x6b\x0d\xa2\xf4\xb5\x7e\xce\x70\x98\x48\x5d\x1c\x26\x38\x3e\x4c\xf4\x3a\x66\x6a\xb5\x2c\xb3\x4c\x69\x0b\xca\x57\x35\xb7\x05" +
+		"\xc1\xf8\x61\x22\x31\x6e\x98\xd0\x97\x43\x45\xd9\x8d\xa1\x82\x0e\x77\xc4\xa5\x48\x0f\x0f\x15\x99\x23\x76\xb7\x26\x45\xec\xfd\xe2" +
+		"\x71\xd0\x36\x54\xdc\xb5\xd6\xfe\x2b\x43\x45\x6e\x86\xe9\xe2\x0b\x9e\xa3\x76\x2b\x9d\x13\x9d\xcc\xe5\x89\x41\xf9\xfb\xf0\x76\x1a" +
+		"\x22\x52\x5b\x87\x78\x3c\x43\x44\xf9\x1a\xd3\xcc\x21\xca\x31\xab\x40\xfc\x20\xa2\xd7\xee\x15\xa9\x45\xf7\x0a\xf6\x3c\x2c\x1e\xde" +
+		"\xfd\xb0\xf2\x31\x04\x31\x51\x1d\xf7\x36\x78\x48\xa4\xe6\x3c\xe4\x39\x78\x48\xdc\x35\xf5\x21\xe5\xca\x10\xa1\xe3\x78\x10\xa8\x2b" +
+		"\xb2\xea\x5c\x54\x9a\xd8\x4b\xa4\x26\xf4\xf2\x0d\x9b\x17\x95\x67\xf3\x82\x73\x83\x45\xc9\xf9\xc1\x9e\x2e\xd3\xfe\xc1\xca\xd7\x23" +
+		"\x68\x1f\x24\x7a\x74\x0c\x52\x2e\x8d\x60\xe5\x20\xd1\x73\xd5\x20\xc1\x33\x83\x44\xcd\x24\xd3\xb6\x81\x22\x7c\x30\xd0\x63\x31\x6d" +
+		"\x31\xbd\x3b\x50\x54\xbe\x37\x50\xf9\x04\x82\xe7\x6c\xdd\x58\x18\xc4\xc0\xb3\xde\x5b\x6f\xff\x50\xc4\x97\xfe\x50\xb9\x06\x3c\x23" +
+		"\x4f\xb8\x93\x7f\x17\x83\x77\x3e\xa8\x7c\xb4\x01\x77\x0d\x10\x25\xbb\x07\x08\xd6\x0d\x10\xb1\xf5\xa6\x95\x03\x44\xf5\x8a\x01\xca" +
+		"\x45\xd3\xf6\xd6\x03\xa2\x62\xe9\x03\x82\xdd\xcd\x41\xdc\xb7\xa7\x39\x14\x26\x64\x54\x46\x94\x7c\x9d\x16\xcc\xbe\x5f\xf4\x9a\x75" +
+		"\xbf\x07\xd7\x24\xba\x7f\xde\xa4\xdc\x60\x04\x47\x1b\xbd\xf4\x8d\x02\x89\xb2\x20\x78\xe7\x09\x11\x36\x3e\xa1\xfc\x63\x28\xf7\x4f" +
+		"\x74\xf5\x14\xfe\xab\x4e\x34\x7c\x58\x27\xf8\xb2\x56\xc4\xaf\xd6\x8a\x70\xa1\x56\xd0\x61\x6a\x37\x75\xd6\x8a\xd8\xa5\x5a\xe5\x02" +
+		"\x82\xaf\x6b\x45\xf6\x2b\xfb\xcf\xd7\x89\xd8\x73\x6e\xfa\x4e\xa9\x60\x83\x65\x86\x8b\xaf\x7c\xe3\x1d\xea\x5d\x2c\x9e\x2c\x11\x9c" +
+		"\x28\x11\x61\x56\x9d\x50\xe4\xcd\xb1\xcc\xbe\x85\x82\x29\x33\xd3\xc5\x23\xa5\x22\x7e\xb8\x54\x84\xd3\xee\xea\x84\xe5\xd3\x52\xa1" +
+		"\xe3\xb7\xd2\x56\xf7\xf7\xbe\x5b\x78\x18\xf1\xed\xa6\xdd\xee\x6a\x97\x69\xaf\x65\xcf\x2d\x14\xbe\x2e\x15\x89\xeb\x6e\x7b\xcc\xde" +
+		"\x51\xcb\xd3\x65\xca\x87\x89\x65\xde\xbe\xae\x98\xdf\xc7\x97\x8c\x12\xaf\x2b\xd2\xd4\x32\x91\x6c\x76\x48\x87\x47\xde\x6e\x6f\xbe" +
+		"\xbd\x79\xf6\x96\x9a\xde\x32\xad\x29\x13\xf1\xd5\xa6\x39\xf5\x42\xb3\xeb\x1d\x57\x2f\x12\xf3\x4c\x6f\xd6\x8b\xf0\x86\x69\xa9\xbd" +
+		"\xb7\xea\x95\x03\xc1\x5a\x5b\x6d\x96\xe9\xe5\x82\x17\xcb\x0b\x94\x8c\xe8\x43\xf7\xb4\xdd\xb5\xd7\xca\xbc\x5d\xdd\xfb\x5e\x7b\x7b" +
+		"\xec\xed\xaf\x17\xe5\xfb\x4c\x73\xcb\x45\x78\xb5\xbc\x40\xf1\x88\x5e\xb7\xb7\xa8\x48\xa9\x88\xb6\xd8\xdb\x6c\x9a\xd1\xe0\x25\xb2" +
+		"\x2c\xeb\x26\x68\xb5\xac\x77\xc5\x3a\xcb\xda\x72\x91\x68\x33\xcd\x29\x17\xb1\xd9\xa6\x65\x0d\x22\xd3\xda\xa0\x7c\x26\x7a\x3a\xbb" +
+		"\xe9\xbe\x86\x02\x95\x44\x74\xbe\x41\xc4\xcf\x99\xa6\xde\x25\x68\xb6\x5c\x6d\x10\xb1\x2b\xf6\x66\xf9\x12\x33\x2d\xa3\x1b\x05\xc7" +
+		"\x7b\x17\x8b\x37\x3c\xdc\xeb\xbe\xc4\x54\x57\x34\x5b\x5e\xef\xe6\x94\x5d\x3b\xa7\x51\xb9\x30\xdb\xde\x1a\x17\x57\x47\x89\x76\x13" +
+		"\x5a\x67\x5a\x65\xf1\x93\x69\x43\x54\x6a\x14\xb1\x95\x8e\x5d\x6f\x59\x77\x27\xda\x7b\x97\x48\xef\x71\x7a\x1f\x37\x8a\xd4\x0e\x7b" +
+		"\x87\x2c\x07\x8b\x94\x30\xe9\xb4\xe9\xb8\xbd\xcf\x2d\xff\x53\x24\x45\x74\xd1\xb2\xed\x6e\x11\x5b\x58\x21\x58\x52\x21\xb4\xd8\x74" +
+		"\xc3\x21\xd7\x5d\x3b\xba\x49\xc4\x46\x35\x09\x26\x34\x09\x8d\x6f\x2a\x9c\x1a\xd3\x5d\x7a\xd1\xfe\x8c\x26\x91\x7e\xc5\xf4\xba\xbd" +
+		"\x45\xa6\x37\x2d\x6f\xdc\x81\x42\x44\x8b\x9b\x14\xca\x10\xb1\xad\xb6\x36\xda\x3a\xf0\xaf\x82\x97\x86\x39\xb1\x97\x83\x08\x6f\x47" +
+		"\xcf\xb0\x96\x27\x05\x27\x5c\xbf\xc1\xf2\x4e\x53\xe1\xbe\x5b\xe7\x76\xeb\x2d\x6d\xce\xd1\xc4\x4a\xcb\x2a\x7b\x11\xad\x31\xad\x6e" +
+		"\x12\xa9\xb5\x0e\x89\xd2\x58\x66\x69\x6d\x12\x89\x88\x36\xfd\xb5\xd0\x99\xff\xf0\xe8\x0b\x59\x7d\x9b\xc6\x55\x0a\xc6\x56\x7e\x2b" +
+		"\xe7\xef\xc6\x05\x93\x5c\x1b\x16\x7d\x53\x7b\x6c\x7c\x10\x1c\x8d\x74\x51\x71\x66\x58\x74\x87\x39\x8a\x5a\x73\xd0\x8b\xb9\xbd\xb7" +
+		"\x08\xaf\xd9\x5b\x50\x0c\xee\xea\xf6\xea\xde\x20\xb8\x62\x0d\x4b\x8a\x9d\x85\xa8\x7e\x61\x93\xf2\x11\xb1\xf3\x2f\x04\x97\x7f\x29" +
+		"\xd4\xe2\xe2\xab\x96\xb9\xb7\x50\x30\x25\xe6\xbb\x78\x63\x4a\xf4\x98\x5f\x1d\x0a\x8b\x89\x17\x33\x1e\x51\xb3\x65\xaa\xc3\x23\x7a" +
+		"\xd6\xf2\x9b\x68\x5c\x3b\xa3\x71\x59\x15\xb9\x93\x2d\x2d\xd3\xec\x2e\x88\x74\x4a\xd4\x34\x3a\x4b\x7f\x52\x2c\x3a\x28\x66\x0a\xe3" +
+		"\x2d\x4f\xdb\xfb\xf8\xdf\x04\x7b\xa6\x07\xcf\xb1\xcb\x63\x5d\x13\xd1\x53\x96\x31\x4d\x5d\x87\x76\xf8\xaa\x78\x0f\x06\xdf\x83\x61" +
+		"\x8c\x2b\x47\x35\x15\xbc\x88\xc2\xe8\xa6\x42\x5c\x26\xf2\xce\x45\x2d\xa2\x3b\x67\xe7\x50\x11\x3f\xb1\x2b\x08\x79\x0b\xa4\xbc\x19" +
+		"\xd8\x67\xd9\x6f\x99\xf2\x6b\xa1\xe6\x5f\x8b\xd8\x27\x8d\x42\xff\x6d\x6f\x97\xfb\xd9\x6d\xda\x68\x6f\x53\x71\xc3\x85\x68\xeb\xb5" +
+		"\x35\x8a\x92\xb5\xae\x58\x10\x6d\x71\xcb\xa2\x46\xe5\x39\x52\xe9\x23\xbf\x52\xb0\xcc\xc1\xad\xae\x58\x61\x5a\x6e\x31\xd1\xda\x58" +
+		"\xa8\xbd\x49\x6a\x6d\x2c\x6c\x79\xa2\x2d\xff\xe1\xa4\xe8\xcd\xcd\xca\x9a\x73\x41\x24\x56\x5b\xf9\xb0\xbb\xe0\x5a\x0f\xc1\x66\x87" +
+		"\x6d\x69\x14\x95\x11\xcd\x70\xc5\xc7\x3d\x45\xec\x8c\x8b\xe7\x1b\x95\x8b\x79\xfc\xb1\xce\xc6\xe2\x4c\x9c\x2f\x52\x30\x85\x6f\x0e" +
+		"\x80\x2e\x3a\x7f\x7b\x5c\x44\x51\x2f\x21\xea\xa5\xdd\xd4\x61\xb9\xe0\xa2\x89\xce\x63\x4e\xf4\xd4\x24\x15\xaa\xb8\x50\x3c\x4d\xa2" +
+		"\xa0\x78\xe4\x9d\x6d\x14\xfa\x83\x3b\x3b\xd5\x28\x6a\x7c\x51\x16\x3b\x64\x49\xa3\xf2\x42\x30\xaf\x51\xf4\x99\x1b\x9d\x4b\x0d\x8e" +
+		"\x6d\x10\x9c\x6a\xf0\x73\xd3\x72\xc2\xc5\xd3\x45\x2f\xa2\x44\x44\x17\x2d\x17\x8a\x24\x53\xe8\x74\xb1\xdd\xd2\xf1\xfd\x14\xdd\x4e" +
+		"\xf8\x68\x97\x0f\xf9\x70\xd6\x7e\xcb\x04\x09\x56\x1f\x0e\x4e\xd7\x6e\x94\xc2\x19\x07\x7d\x76\x7b\x0a\x51\x5a\x44\xde\xc9\x5b\x6a" +
+		"\x43\x54\xeb\x16\x3a\x75\x4b\x2f\xb1\xa8\x97\xe3\x2e\x7e\x5a\xa4\xe0\xb6\x7c\x8f\x17\x51\x55\x54\x7b\xed\x17\x22\x5c\xff\x85\x60" +
+		"\x65\x83\xd0\x2a\x4b\xab\x43\x4c\x2c\xb7\xac\xb0\x7c\x1e\x7d\x60\x9c\x9b\x11\x0a\x13\xba\xd8\xd7\x5c\xe2\x9a\x05\x8e\x6e\xb1\xcc" +
+		"\x6b\x10\x95\x26\x9e\xf7\xc3\xed\x05\xd3\xaa\x5e\x3e\xb8\xfe\x4c\x24\x3b\xea\x3d\xb8\x7a\x51\x76\xba\x5e\xb9\xee\xb8\x69\xbd\x88" +
+		"\xb7\xd8\x9f\x59\x2f\x34\xcb\xf4\x92\xe5\xe5\x22\xa5\x23\x5a\xf5\x4e\x10\x6c\x38\x15\x44\x72\x9c\x8d\x2b\x75\x42\x5f\xd4\x09\xf6" +
+		"\x57\x89\xec\xef\xab\x04\xbf\xaf\x13\xdd\x0f\xd8\x5b\xee\x77\x9c\x15\x75\xca\xf3\x46\x9d\x48\xbc\x69\x6f\x5e\x9d\x28\x9d\x6f\x7a" +
+		"\xc6\x34\xc9\xb4\xbe\x5a\xa4\x37\x54\x0b\x0e\xd7\x8a\xb4\xbf\x96\x98\x7b\x3a\x08\xcd\xb3\xf2\x7e\xad\xd0\xd6\xda\xc2\x43\x60\xa3" +
+		"\x5f\xf1\x36\x39\xa6\xad\x56\xdc\xb3\xd6\xf4\x54\xad\xc8\x8c\x31\x5d\xb8\x4f\xc4\x2e\xde\x27\x98\x5e\x25\xd2\x2f\x39\xa3\x29\x3e" +
+		"\x1f\x9b\x7f\x29\xd8\xe1\x8a\x8f\x86\xf9\xf4\xa8\x12\xc9\xed\x2e\x8e\xeb\x29\x34\xb6\xa7\x60\x4c\x4f\x51\xf2\x54\x4f\xe5\xef\xde" +
+		"\x32\x5f\xbe\x89\xf7\x04\x51\x73\x72\x4f\x50\x6e\x24\x37\x5f\xda\x93\xba\xf9\x5e\xea\xdf\x3e\x28\x08\x8d\x0a\x8f\xb5\x86\x9b\xee" +
+		"\xff\xf5\xf7\x1f\x11\x7c\x92\x54\xfe\xb1\x9d\xc9\xdb\x6b\x1f\x44\x3c\xa8\xff\xdf\x15\xfe\xd8\x6f\x1d\x62\xc8\xf7\x5c\xa3\x02\xc1" +
+		"\x84\xa4\xb8\x7f\x7c\xf2\xce\xad\x33\x88\x9f\x16\x5a\x37\xe2\x3b\x5b\x62\xf0\x97\x52\xbe\x3f\xa2\xaf\x94\xeb\xf5\xad\xb9\xfc\xb1" +
+		"\x94\xef\x81\xa0\x45\xa2\x7e\x81\x6e\xef\xaf\xef\xa7\x4f\x8a\x6e\x52\x90\x14\x28\xfc\x25\xc6\xee\x08\x52\xf5\x77\x92\x8c\xa1\x86" +
+		"\xe4\x4f\x84\xd6\xfd\x48\xa4\xfd\x79\x43\x7b\x85\x48\x75\x54\x08\x4e\x54\x88\xe4\x49\xd3\xbe\x0a\xc5\x32\x7d\x95\xcf\x1c\xd9\x16" +
+		"\xbc\xea\x23\x45\xff\xa7\x46\x8a\x4a\x29\x77\x0f\x82\x7f\x11\x03\x7e\x2e\x2a\x0a\xdd\x3f\x89\xe0\x63\x89\xe4\x8e\x3f\xf1\xcc\x37" +
+		"\x21\x98\x9c\x15\x83\x26\x65\x95\x1f\x86\xe0\x7a\x46\xc4\x6e\x64\xee\xdc\xe6\x51\x04\x93\xb2\xa2\x7a\x72\xb6\xcb\x49\x20\x7e\xf6" +
+		"\x9d\x4c\xff\x1e\xc1\xd4\x98\x9f\xef\xb1\x3f\x4d\xee\x01\xf1\x0f\x52\xfe\x61\x44\xfa\x3b\xeb\xd8\x07\x71\xaf\x94\xfb\xdb\x68\x26" +
+		"\xd3\xa2\x74\x47\xba\xab\x76\x04\x82\xb5\x69\xd1\xaf\xad\xe0\xa4\x11\x23\x0b\xd9\xff\x15\x82\x83\x09\x51\x79\x28\x71\xb3\xb7\x1e" +
+		"\x14\xfe\xe7\x57\xbf\x2d\x59\xb8\xc7\xfc\x19\x33\x78\x75\x99\xf2\x39\x44\x4a\x82\xf1\x6e\x35\x21\xd1\xd5\xa2\xeb\xc3\x39\xee\x0f" +
+		"\xe7\xb8\x72\x35\x88\xe1\x52\xfe\x21\x44\xa6\x90\x69\x2d\xe2\x6e\x15\x8e\xc3\x43\x71\xf1\x97\x07\xe3\x22\x7c\x6b\x16\x73\x08\xc6" +
+		"\xc5\x44\x9f\xb1\xb1\xef\x9f\x85\x21\x5d\xd7\xce\x45\x91\x3f\xeb\xfa\xff\x17\x3d\x45\xb6\x97\x88\x49\x34\x2c\x4f\x2a\x5f\x7d\xe9" +
+		"\x5e\xc1\x91\x23\x7e\xd4\x1f\xb5\x72\x7a\x5f\x10\xbd\xce\xec\x0b\x85\xff\x76\x9e\xb9\x57\x54\x9c\x76\xd4\x6f\x3b\x83\x48\xb6\x76" +
+		"\x46\xaf\x4c\xd7\x83\x28\x1f\x6f\x65\xed\xd1\x20\x1a\xd6\x1d\x0d\xca\xbb\x05\x07\xd2\x22\x73\x30\x2d\x78\x25\x27\x7a\xbf\x9c\x13" +
+		"\x9c\xaa\x11\xdd\x4e\xd6\x74\xad\x0b\xef\xd6\x88\xee\x9b\x6a\x04\x2b\x76\xfb\xca\x2b\xad\x4c\xae\x11\xa5\x53\xec\x5e\xea\x2f\xee" +
+		"\xeb\xec\x2f\x68\x69\x09\x22\xbe\xd0\xca\xa1\xac\x5f\x71\xb2\x85\x8f\xa7\x51\xfd\x45\x6c\xb4\x63\xde\x1b\x15\x44\x78\xd7\xca\xe5" +
+		"\x7e\xa2\xdb\x17\xfd\x04\xfb\xfa\x89\xd4\x7e\xd3\xee\x84\x60\x66\xad\x48\x6e\x75\xb1\xad\x9f\x48\xaf\xed\x57\xd8\xf8\x7f\xd8\xe8" +
+		"\xc6\x67\x23\xbd\x5c\x2d\xca\xbe\xa8\xf6\x22\x57\x8b\xaa\x03\xa6\x71\xd1\x6b\xef\xca\x8e\x20\x32\xbf\xb5\x31\xbb\xda\x9f\xda\xa6" +
+		"\x49\x97\x82\x28\x9d\x7c\x29\x28\x9f\x46\x70\xae\xaf\xe8\x77\xbe\xaf\xe0\xed\xbe\xa2\xfb\xd2\xbe\xca\x45\xf7\xc6\x81\x28\xfb\xd1" +
+		"\x03\x45\x38\x1c\xf5\xf6\xfc\xef\x82\xa8\x7b\xc1\xca\xd8\x7b\x44\xe9\x98\x7b\x04\x6f\x46\xe5\x17\xdb\x83\x28\xf9\xec\x07\x85\xf3" +
+		"\xed\xb5\x2a\xd1\x7b\x51\x95\xe0\x4a\x1f\xc1\x55\xcb\xaa\x4f\x82\x48\xad\xb6\x72\xaa\x8f\x48\x9c\xee\xd3\x75\x9f\xb2\x74\xbc\xc4" +
+		"\xa0\xb7\xac\x8c\x99\xe5\x21\x9d\x59\xe9\x1c\x4f\xfb\xa5\x7e\x47\x6f\xd1\x6d\x67\xef\xff\x1d\x00",
+	"/HKscs-B5-V": "" +
+		"\xe2\xf2\xf0\x2e\x4e\x2e\xd6\x75\x32\xd5\xf5\x60\x71\xf6\x0b\x36\x64\xe0\x65\x3a\x7d\x88\x89\x61\xce\x55\x46\x26\x3e\x86\x03\x8c" +
+		"\x4c\x8c\x0c\x4c\x4c\x0c\x8c\xf7\xaf\x30\x32\x31\x31\xb2\xe0\xc4\x19\x0c\xf7\x2e\x31\x32\x35\x78\x31\x36\xb1\x03\x06\x00",
+	"/KSC-EUC-H": "" +
+		"\xbc\x96\x5b\x6c\x1d\x57\x15\x86\xbf\xf5\xcf\xcc\x39\xc7\x6e\x6c\x43\x9d\x9e\xf1\xd1\x39\x0d\x76\xa2\x58\x2a\x4a\x2b\xbb\x52\x1f" +
+		"\x10\x12\xb5\xa0\x95\xa8\x68\x29\x82\x22\xb5\x48\x29\xe2\x81\x67\x24\x1e\x41\xaa\x5c\x40\xc5\x6e\x4b\xda\xa6\xb6\x78\xa1\x80\xa7" +
+		"\x21\x71\x6c\xc5\x17\x29\x80\x48\x9c\xe0\x0b\xb9\x81\x43\x44\x12\xe7\x82\x1c\x07\x25\x4a\x62\xc7\x22\x79\x20\x48\x21\x17\x83\xd6" +
+		"\x78\xc6\x22\xe4\x9d\x97\x5f\xdf\xba\xed\xbd\xbd\xf6\x9a\x7d\x4c\xe1\x4b\xdf\xf9\xee\xb7\xbf\xd5\x29\xa3\x5b\x03\x03\x2b\x2b\xa7" +
+		"\x02\x6b\x7d\x7d\xe2\x0d\x0d\xcc\x68\xeb\xe8\x1b\x4a\xec\x39\xb4\xdf\xb6\xa2\xc4\x9e\x46\xf6\x84\x43\x1d\x8a\xea\x50\x31\x46\xa5" +
+		"\x18\x0d\xda\x17\xd0\x84\x7d\x19\x8d\x5b\x80\x0c\x64\x45\x64\x2f\x79\x6e\x5a\xf9\x55\xb4\xc7\xbe\x8e\x86\xac\x15\x35\xb5\xa2\xd1" +
+		"\xe2\xd6\xb5\xe0\xff\x5f\x82\x94\x9e\x42\xf0\x9b\x92\x08\x7f\x5b\x12\x4c\x9a\x68\x9a\x32\xc1\xca\x33\xa2\xfc\xef\x67\xf2\x8a\x27" +
+		"\x11\x9c\x0f\x45\x7c\x2e\x14\xec\xec\x12\x8f\xed\xea\x52\x62\x8f\x20\x68\x13\x5f\x69\x55\x62\xaf\x21\x18\x35\xa1\x11\x53\x62\x20" +
+		"\xf8\xa6\x58\xff\xba\xa0\xd7\x44\xb9\xc7\x04\x1f\x46\xe2\x91\xed\x91\x20\x31\xd1\xfa\xb1\xad\xb6\x15\xfe\x16\x08\x7e\x1d\x08\x7b" +
+		"\x52\xb0\xe0\x70\x31\x10\xd1\xbc\xfb\xaf\xb9\xdc\xa9\x08\xf5\x97\x05\x12\xdc\x0c\x44\x70\xc3\xfd\xff\x74\xb9\x95\x93\xdd\x76\xba" +
+		"\x9b\x16\x04\x42\xff\x43\xf7\x5c\xee\x7b\x5e\x77\x28\x90\x12\xe3\xc7\xa1\xb0\xb7\x42\xa1\xde\x50\x04\x3f\xf2\x40\xc1\xcf\xea\xb0" +
+		"\xdd\x5d\x6b\x54\x9f\xd2\x50\x24\x34\x1c\x09\x1b\x8c\x04\xee\x1a\x8f\x44\x38\x16\x65\x87\x3b\xe0\xb1\x89\x48\x44\x12\x92\x60\xce" +
+		"\x3d\x7f\xf5\xba\x0b\x4e\xf3\x9e\x79\xd1\x65\x21\x5f\xe1\x8a\xc3\x4a\x24\x02\x09\xd3\xea\x19\x82\x9f\xba\x1c\x2e\x64\x9e\x53\x0e" +
+		"\x27\x0b\x22\x9a\x2b\xe4\xb7\xf3\x1c\x82\x17\xc4\xfa\x2f\xfa\x92\x81\xb0\x85\x40\x89\x05\x08\xfa\xca\x22\xec\x2f\x67\xed\xb2\xb4" +
+		"\x5d\xbd\x81\x78\xb9\xc7\x53\xca\xb8\x19\x89\xe6\x9e\x48\xb0\x2b\x12\x1d\x3b\x23\x25\x3e\xe3\x70\xbd\x51\xc4\xcb\x8d\x82\xb7\x6b" +
+		"\xa2\xf2\x4e\xed\xe1\x51\xda\x84\x60\x51\xe2\xf1\x25\x09\x3e\x32\x51\xf9\x99\xe5\x9b\xdf\x68\x17\xaf\xde\x6c\xcf\xcd\xd7\xc4\x53" +
+		"\xaf\x0a\xbe\x2f\x1e\xff\x9e\x60\x76\xb3\xa8\x3b\xbe\x59\x89\x7d\x03\xc1\xde\xd0\xef\xdf\xa5\xdb\x57\xf8\xe4\x9b\x26\xf8\x43\x55" +
+		"\x6c\x39\x54\x15\x14\x45\x54\x10\x5c\x32\xf1\xd8\x65\xcf\x78\x19\xc1\xcf\x4b\x62\xdd\x2f\x4a\xf9\x2c\xbe\x24\x3e\xf7\xa2\x60\x29" +
+		"\x10\x9f\x58\x0c\xf2\x73\x7e\x0d\xc1\xd1\x66\x51\x3a\xd6\xac\xc4\xe8\xa9\x88\x42\x6f\xc5\x2f\xca\xc4\x2b\x13\xbe\xdc\x3a\x56\x3b" +
+		"\x6e\x45\x61\x27\xca\x22\x9a\x2d\x0b\xce\xba\x9c\x29\x0b\x9b\x2b\x8b\xc2\x49\x37\x2f\x96\x45\x69\xc1\x69\xc9\x65\xf1\x61\x52\x4a" +
+		"\xcb\x65\xb1\xf1\xba\xd7\x0e\xc7\x82\xdf\x85\x3e\xe6\x55\xc1\xee\x58\x89\x11\x0a\x4b\x3c\xf0\x71\x9c\xd3\xaf\x62\x11\xee\x88\x57" +
+		"\x53\x60\x30\x16\xda\xe5\xb4\x96\x27\x27\x73\x9f\x76\xe4\x15\x85\x94\x3e\xa8\x09\xde\xaf\x09\x1b\xf7\x94\x11\xf7\xf5\xd7\x44\xa1" +
+		"\xcf\x03\x07\x62\xa1\x09\xf7\x4d\x79\x74\xd2\x69\xc6\x69\xda\x69\xd4\xcb\x46\x3c\xef\x98\x9b\x47\xe3\x8c\x6c\x8d\x08\x04\x12\x1c" +
+		"\x76\xe3\x48\x9c\x91\x1d\x72\x5a\xd8\x20\xec\xa3\x62\xb6\x24\xd3\xf9\xe2\x24\x4d\xc2\xfc\x8e\x48\xf3\xde\xcc\x67\xfd\x60\x2d\xcb" +
+		"\x08\xa7\xd3\x56\xf8\x0e\x41\xba\xd7\x09\x5f\xf4\xcf\x4e\x7f\x89\xb3\x1d\x67\x1d\xce\x7a\xc5\xdb\x91\xb0\x3f\xba\x79\x3c\x16\x0d" +
+		"\x69\xe0\x9a\xcb\xd5\xf4\x3c\x55\x11\x1c\xa9\x0a\xfe\x9e\x95\x6a\xd1\x61\xd9\xe5\xfa\x03\x64\x4e\x96\x46\x97\x62\x51\x9f\xd2\x5d" +
+		"\x3f\xc4\x9d\xf4\x9c\x2d\x82\xee\x96\x8c\x9a\x52\xf2\x0f\xbf\xb0\x3d\x54\x62\xcf\x23\x6c\xa9\x45\x14\xae\x7a\xe0\x1f\x2e\xcb\xfe" +
+		"\xf9\xcf\x6f\x10\xfc\xcb\xcd\xdb\x2e\x53\x91\x60\xd2\xe5\x6e\x8b\xb0\x3b\xee\xbb\xdf\xa2\xc4\xa2\x7b\x8e\x3f\xac\x88\xe0\x07\x15" +
+		"\x81\x4f\xe2\x06\x9f\x49\x66\x2a\xe2\x89\x69\xa7\xa1\xaa\x28\x0c\x57\x95\xd8\xa7\xf0\x0f\x43\x62\xe3\x5e\x09\xde\xab\x8a\x86\x6d" +
+		"\x55\xc1\xef\xdb\x84\x1d\x6c\x13\x0c\xfb\x5f\xee\x15\x8c\xba\x8c\x64\x94\x98\x52\x1c\xaf\x0a\x1b\xcb\x89\xb1\xb5\xc4\xdd\x05\xd1" +
+		"\x78\xc6\x97\x39\x56\xcd\x1a\x88\x37\x50\x29\xfd\xa9\x9a\x75\xd1\x2d\x73\x2b\x38\xea\xae\xe3\x6e\xce\x7a\xda\x89\x6a\xf6\x4e\x84" +
+		"\x0b\xeb\x04\xa7\xaa\x62\xf3\x49\xdf\x37\x44\xf0\x61\x4d\x20\x61\xdb\x1c\xde\xab\x89\x20\xa5\x51\xff\xc2\xae\xac\x17\x5c\x29\x08" +
+		"\xbb\xb5\x51\xf0\x93\x9a\xb0\x77\x6b\x19\x91\xd2\x3b\x35\x11\xfa\xdb\x63\x5e\xab\x77\x6b\xf9\x90\x2b\xcb\xb5\x0f\x36\x89\xba\x6d" +
+		"\x9b\x04\x03\x3e\xe8\xbf\x74\xdf\xb0\xcb\xa0\xa7\xa5\x34\xf4\x00\xd9\x9e\x9a\x68\x48\xe9\x90\x07\xa6\x5d\xa6\xfc\x64\x93\xfe\xbc" +
+		"\x69\xa6\x26\x5e\x48\x87\xf3\xf2\xa3\xa2\xe9\xd2\xa3\x4a\xec\x33\x08\x7a\xbd\xff\x3d\x7a\xf8\x09\xac\x22\x18\x6f\x16\x9f\x1d\x6b" +
+		"\x16\x9c\x6f\x16\xc1\x39\x7f\x64\x9a\xd2\x1f\xcd\x2d\xe2\xc5\x73\x5b\xfc\xce\x11\xf4\xd7\x8b\xe7\xfb\xea\xfd\xef\x28\x89\xa6\xf7" +
+		"\x4b\xff\xf5\xef\x01\x5c\xe8\x10\xcc\xbb\xf8\x0f\x52\xf1\xad\x30\x7f\xa2\x2f\x74\x8a\xc6\xf9\x4e\xc1\x5c\xa7\xa8\x3b\x9d\x52\x87" +
+		"\xf8\xf4\xe9\x8e\xfc\x7c\xad\xa2\xb9\x4d\x70\xb3\x5d\x34\xdc\x68\xcf\xd7\xe5\x69\xf7\x75\x89\xcf\xef\xeb\xf2\xfd\xbb\x44\xdc\xd7" +
+		"\x95\x47\x8b\x08\xf6\x3f\x2b\x5e\xd9\xf7\x6c\xea\xfb\xcf\x00",
+	"/KSC-EUC-V": "" +
+		"\xe2\xf4\x0e\x76\xd6\x75\x0d\x75\xd6\xf5\x60\xf3\xce\x2f\x4a\x4d\x34\x64\x10\x60\x5a\x74\x98\x89\xf1\x43\x2d\x13\x23\x03\x03\x13" +
+		"\x03\x43\x17\x0b\x13\x13\x53\x37\x0b\x98\xc7\xc2\xcd\xc0\xa4\xcb\xc0\xc0\xb4\x95\x99\x81\x81\x89\x8d\x91\x81\x89\x89\x81\x01\x2c" +
+		"\xc1\xcd\xca\xc0\x24\x0d\x65\x83\xb0\x34\x33\x03\x60\x00",
+	"/KSCms-UHC-H": "" +
+		"\xec\x95\x5f\x8c\x94\x57\x19\x87\x9f\xf7\xf7\xfd\xd9\x5d\x0a\xac\xed\xd2\x99\x9d\xcc\x94\x2e\x10\x48\x6a\x68\x03\x24\xbd\x30\x26" +
+		"\x76\xc5\x36\x69\xb5\x14\xad\x35\x69\xd5\xad\xf1\xc2\x6b\x13\x2f\x35\x26\x50\x2b\xee\x52\xa4\x80\xa0\xd1\x48\xad\x03\x05\xba\x6b" +
+		"\x17\x56\x6b\x4d\x11\xea\xee\x52\x0a\xb4\xfc\x49\xa1\x4b\xc1\x2c\x8b\xd2\x50\xd8\xd9\x02\x51\x8b\xa9\x50\x56\xf3\x1e\xe6\xc3\xf9" +
+		"\xec\x8d\x77\xde\x78\xf3\xce\x73\x9e\xf3\x9e\x73\xde\xef\x7c\xe7\x3b\x43\xfa\xb9\x6f\x7e\xeb\x1b\x5f\x5f\x28\x63\x99\x96\x7f\x7a" +
+		"\x72\x72\x53\x62\x1d\x8f\x4b\x43\x4f\xa8\xd4\xbb\xc6\x94\x96\x50\xfa\x5d\xb4\xd8\x7f\xff\xcf\xff\x1b\xbe\x1d\xd1\xb5\xaf\x66\x5a" +
+		"\x5c\x5a\x35\x6e\xff\x76\xf7\xfe\xa6\x66\xfa\x4a\xa9\xb7\xd1\x75\xbd\x19\xf2\x7e\xdc\xe8\x16\xed\xae\x99\xec\x0e\x9f\xf1\xc9\x46" +
+		"\xdf\xd2\x57\x33\x25\x2d\xa8\xa9\x88\x9a\x8b\xe8\xfe\xd2\xe6\xc6\xfe\xcf\xbc\x51\x33\x7d\xb5\xf4\x6a\xa3\x7b\x68\xa2\x66\xfa\x42" +
+		"\xe9\x70\xa3\x8b\x2e\xfb\xfc\x20\x6b\x42\xb6\xc4\xd7\x19\xcc\xd5\x74\x2e\xd4\xf4\xab\x46\xf7\xf0\xb1\x9a\x69\x49\xa9\xbf\xd1\x7d" +
+		"\x69\xa4\x66\xfa\x6c\xfe\x79\x3a\xfc\x79\x5a\x3b\xd0\xd2\xdc\x9a\xcf\x5a\x89\xff\xa2\x41\xd7\x85\x8b\xbe\xf4\xaf\x2f\x34\x96\x73" +
+		"\x32\xb8\x67\x72\x6e\x6f\x70\x2b\x73\x6e\x20\xb8\xbf\xbf\xd7\xe8\x36\x06\x77\x26\xe7\x7a\x82\x3b\x9c\x73\x97\x2f\xb8\xdb\x99\x73" +
+		"\x7f\x0e\x6e\x4b\xce\x1d\x0a\x6e\x5d\xce\xbd\x1c\xdc\xe4\x44\xa3\x7b\x2e\xb8\x5a\xce\xad\x0d\xee\x78\xce\x5d\x7b\xcf\xdd\x70\xce" +
+		"\x8d\x07\xd7\x9f\x73\x23\xc1\xfd\x2c\xe7\x86\x82\x5b\x91\x73\x2f\x04\xf7\xd7\x5a\xa3\xfb\x69\x70\x63\x39\xf7\xfd\xe0\x5e\xcf\xb9" +
+		"\xbf\x4c\xb8\x7b\x29\xe7\x4e\x05\x57\xcd\xb9\x03\xee\x6e\x5e\x5d\x33\x3d\xd4\x35\x50\x33\x55\xad\x8b\x7a\x88\x02\xdd\x85\xe0\xa5" +
+		"\x66\x11\xff\xae\x59\x30\x68\xa2\x75\xc8\x04\x93\x77\x8b\xc2\x3f\xef\xce\x46\xdc\x89\xe0\x64\x2c\x8a\x27\x62\xc1\x96\x4e\x71\xeb" +
+		"\xd6\x4e\x55\xed\x26\x04\xb3\xc4\xe7\x3b\x54\xb5\xc7\x10\x6c\x37\xa1\x7e\x5f\x0b\x04\x5f\x13\x33\x1e\x17\xf4\x98\x28\x74\x9b\xe0" +
+		"\x47\x89\xb8\x69\x5d\x22\xa8\x9a\xe8\xd8\xe4\xa9\x8b\x10\xfc\x29\x12\xfc\x36\x12\x76\xa7\x60\xcc\xe1\x74\x24\x92\x51\xf7\xe7\x3c" +
+		"\x5c\x29\x09\x6d\x28\x08\x24\xb8\x14\x89\xe8\xa2\xfb\xcb\x1e\xde\xcf\xc8\x3e\x70\xba\x1a\x06\x44\x42\xff\x41\x1f\x7a\xb8\xe6\x79" +
+		"\xcb\x62\x81\xbc\xd2\x1f\xc4\xc2\x56\xc4\x42\x3d\xb1\x88\x9e\xf4\x8e\xd4\x6b\x75\x58\xe7\xea\x06\x4d\x09\xd4\x9b\x08\xf5\x25\xc2" +
+		"\xb6\x25\x02\x57\x03\x89\x88\x77\x24\xf5\xe2\x76\x7b\xdf\xae\x44\x24\x12\x92\x60\xc4\xcd\x1f\x7d\xdc\x29\xa7\x51\xcf\x3c\xed\x61" +
+		"\x2c\x9b\xe1\xac\xc3\x64\x22\x22\x09\xd3\xf5\x1a\xa2\x9f\x78\x78\x2d\xad\x9b\x63\x0e\x47\x53\x91\x8c\xa4\xd9\xdb\xb9\x17\xc1\x03" +
+		"\x62\xc6\xfd\x3e\xa5\x3f\xd9\x58\xa4\xaa\x45\x08\xd6\x17\x44\xbc\xa1\x50\xdf\x2e\x0b\xdb\xd5\x13\x89\xa5\xdd\x9e\x52\xc0\x9b\x89" +
+		"\x68\xeb\xf6\xb5\xb7\x26\x62\xc1\x96\x44\x55\xbf\xd3\xa0\x36\x5d\x14\x27\xa6\x0b\x56\x56\x44\xe9\xa9\x4a\xe3\x01\xba\x1e\xe6\x20" +
+		"\x38\x2f\x71\xdb\xb8\x04\x1b\x4d\x94\x7e\x6e\xd9\xe2\x17\xe7\x89\x47\x2f\xcd\xcb\x9a\x8f\x89\xbb\x1e\x15\x7c\x47\xdc\xf6\x6d\xc1" +
+		"\xc1\xb9\xa2\xe5\xd0\x5c\x55\xed\xcb\x08\x5e\x8c\xfd\xfd\x7b\x58\xe6\x33\xdc\xbc\xdc\x04\xaf\x96\xc5\xfc\xbd\x65\x41\x93\x48\x52" +
+		"\xc1\x19\x13\xb7\xbe\xe3\x19\x4b\x11\x3c\xd3\x2c\xa6\xfe\xa2\x39\x3b\x8b\x4b\xc4\xa7\x1e\x14\x8c\x47\xe2\x63\xe7\xa3\xac\xce\x2f" +
+		"\x22\xd8\xdf\x26\x9a\x0f\xb4\xa9\x6a\x74\x97\x44\xda\x53\xf2\x17\x65\xe2\x91\x5d\x3e\xdd\x54\x04\xa9\xb0\x26\x61\x47\x0a\x22\x39" +
+		"\x58\x10\xbc\xed\xe1\x78\x41\xd8\x48\x41\xa4\x47\xbd\x79\xba\x20\x9a\xc7\x9c\xc6\x3d\x9c\xff\x28\x29\xd0\x44\x41\xcc\xae\xf9\xd8" +
+		"\xbe\xa2\xe0\xe5\xd8\x8f\x79\x59\xf0\x7c\xd1\xab\x88\x85\x55\xbd\x63\x53\x31\xa3\xe7\x8a\x22\xde\x5c\xbc\x9e\x02\xdb\x8a\x42\x5b" +
+		"\x9d\x6e\xe4\xc9\xc9\xdc\x69\x73\x36\x22\x0d\xb4\xb6\x22\x58\x53\x11\x36\xe0\x29\xfd\xee\x36\x54\x44\xba\xde\x3b\x76\xfb\x88\x5d" +
+		"\xee\x86\xbc\x77\xd0\x69\x8f\xd3\xb0\xd3\x76\x1f\xd6\xef\x79\x07\xbc\xb9\xbf\x58\x27\xbb\x41\x44\x02\x09\x5e\xf3\xc6\xbe\x62\x9d" +
+		"\x6c\xaf\xd3\xd8\x4c\x61\x1b\x9b\xea\x53\x32\x9c\x4d\x4e\xb5\x55\x98\xbf\x23\x42\xde\xf2\xec\xac\xbf\x52\xa9\x67\xc4\xc3\x61\x2b" +
+		"\x7c\x85\x28\xac\x75\xc4\x27\x3d\xec\xf4\x66\xb1\xbe\xe2\x41\x87\xb7\x7d\xc4\xca\x44\xd8\xeb\xde\x3c\x54\x14\xd3\x42\xc7\x39\x0f" +
+		"\xef\x86\x7a\xca\x22\xda\x57\x16\x5c\xa8\x0f\xd5\x79\x87\x09\x0f\xb5\x1c\x99\x93\x85\xde\xf1\xa2\x98\x12\xe8\xaa\x17\x71\x25\xd4" +
+		"\xd9\x2e\x58\xd6\x5e\xa7\xd6\x40\xfe\xe1\xa7\xeb\x62\x55\xed\x3e\x84\x8d\xb7\x8b\xf4\x5d\xef\xf8\x9b\x87\x09\xff\xfc\x47\x67\x0a" +
+		"\xfe\xe1\xcd\x0f\x3c\x0c\x25\x82\x41\x0f\x57\xdb\x85\x5d\x71\x77\xad\x5d\x55\x4b\x3e\x74\xfc\x5e\x49\x44\x4f\x94\x04\x7e\x12\x67" +
+		"\xfa\x99\x64\x4f\x49\xdc\x31\xec\xd4\x5b\x16\x69\x5f\x59\x55\xbb\x1d\xff\x30\x24\x66\xbf\x28\xc1\xd3\x65\x31\x6d\x75\x59\xf0\x87" +
+		"\x59\xc2\x5e\x99\x25\xe8\xf3\x27\xf7\x11\x6c\xf7\xd0\x5f\xa7\xaa\x29\xe0\x40\x59\xd8\x8e\x8c\xd8\x71\x23\xf1\xf9\x54\x4c\x3f\xee" +
+		"\xd3\x1c\x28\xd7\x37\x10\xdf\x40\x05\x7a\xa3\x5c\xdf\x45\x6f\x99\xb7\xa2\xfd\xae\x0e\x79\xf3\xa0\xa7\x1d\x29\xd7\xef\x89\x78\x6c" +
+		"\xaa\xe0\x58\x59\xcc\x3d\xea\xeb\xc6\xf8\x8e\x55\x04\x12\xb6\xda\xe1\xe9\x8a\x88\x02\x6d\xf7\x2f\xec\xec\x0c\xc1\xd9\x54\xd8\xfb" +
+		"\xb3\x05\x3f\xac\x08\x5b\x55\xa9\x13\x81\x9e\xaa\x88\xd8\xef\x1e\xf3\xb1\x5a\x55\xc9\x0e\xb9\xea\xb9\xb6\x76\x8e\x68\x59\x3d\x47" +
+		"\xf0\xcb\x8a\x48\x9f\x75\xd7\xe7\x61\x9b\xa7\x05\xea\xcd\x91\xbd\x50\x11\xd3\x02\xed\xf5\x8e\x61\x0f\x43\x5e\xd9\xa0\x5f\x6f\xda" +
+		"\x53\x11\x0f\x84\xc3\xf9\xce\x2d\xa2\xf5\xcc\x2d\xaa\xda\x27\xc2\x2d\xe9\xfb\xdf\xad\x8f\x5e\x81\x65\x7c\x5b\xdb\xc4\x27\x77\xb4" +
+		"\x09\x4e\xb6\x89\xe8\x84\x5f\x32\xad\xe1\x4f\x73\xbe\x78\xf0\xc4\x7c\x7f\xe7\x08\x36\x4c\x11\xf7\xad\x9f\xe2\xcf\xd1\x2c\x5a\xd7" +
+		"\x34\x67\xb3\x3c\x8c\xe0\xd4\x02\xc1\xa8\x07\xff\x43\x6a\x5a\x11\x67\x57\xf4\xa9\x85\x62\xfa\xe8\x42\xc1\xc8\x42\xd1\xf2\x56\xa0" +
+		"\x05\xe2\xe3\x6f\x2d\xc8\xea\xeb\x10\x6d\xb3\x04\x97\xe6\x89\x69\x17\xe7\x65\xf3\xb2\xc8\x5d\xa7\x58\xbc\xb3\xd3\xd7\xef\x14\xc5" +
+		"\xf5\x9d\x59\x6f\x13\x82\xdf\xdf\x23\x1e\xd9\x79\x4f\x70\xff\x1a\x00",
+	"/KSCms-UHC-HW-H": "" +
+		"\xec\x95\x5d\x8c\x94\x57\x19\xc7\x7f\xcf\xff\xfd\xd8\x5d\x0a\xac\x74\xe9\xcc\x4e\x66\x4a\x17\x08\x24\x35\xb4\x01\x92\x5e\x18\x13" +
+		"\xbb\x62\x9b\xb4\x5a\x8a\xd6\x9a\xb4\xea\xd6\x78\xe1\xb5\x89\x97\x1a\x0d\xd4\x8a\xbb\x14\x69\x41\xd0\x68\xa4\xd6\x81\x02\x65\xed" +
+		"\xc2\x6a\xad\x29\x42\xdd\x5d\x4a\x81\x96\x8f\x14\xba\x14\xcc\xb2\x28\x0d\x85\x9d\x2d\xe0\x47\x31\x15\xca\x6a\x9e\xc3\xbc\x38\xaf" +
+		"\xdc\x78\xe7\x8d\x37\xcf\xfc\xce\xef\x3c\xe7\x9c\xe7\x3d\xef\x79\xcf\x90\x7e\xe6\xeb\xdf\xf8\xda\x57\x17\xc8\x58\xaa\x65\x9f\x9c" +
+		"\x98\xd8\x90\x58\xc7\x63\x3b\xbf\xa3\xc1\xc7\x55\xfa\xf3\x34\xa5\x25\x94\x7e\x1b\x2d\xf2\xdf\xff\xf3\xff\x86\x6f\x43\x74\xed\xad" +
+		"\x99\x16\x95\x56\x8e\xd9\xbf\xdd\x3d\xbf\xae\x99\xbe\x54\xda\xda\xe8\xba\xde\x0c\x79\x3f\x6a\x74\x0b\x77\xd5\x4c\x76\xbb\xcf\xf8" +
+		"\x44\xa3\x6f\xe9\xad\x99\x92\x16\xd4\x54\x44\xcd\x45\x74\x5f\x69\x63\x63\xff\xa7\xde\xa8\x99\xbe\x5c\x7a\xb5\xd1\x3d\x38\x5e\x33" +
+		"\x7d\xae\x74\xa8\xd1\x45\x97\x7c\x7e\x90\x35\x21\x5b\xec\xeb\x0c\xe4\x6a\x3a\x1b\x6a\xfa\x65\xa3\x7b\xe8\x68\xcd\xb4\xb8\xd4\xd7" +
+		"\xe8\xbe\x30\x5c\x33\x7d\x3a\xff\x3c\x1d\xfe\x3c\xad\x1d\x68\x49\x6e\xcd\x67\xad\xc4\x7f\xd1\xa0\xeb\xfc\x05\x5f\xfa\x57\xe7\x1b" +
+		"\xcb\x39\x11\xdc\x33\x39\xb7\x27\xb8\x15\x39\xd7\x1f\xdc\xdf\xdf\x6b\x74\xeb\x83\x3b\x9d\x73\x3d\xc1\x1d\xca\xb9\x4b\xe7\xdd\xed" +
+		"\xc8\xb9\x3f\x05\xb7\x29\xe7\x0e\x06\xb7\x26\xe7\x5e\x0e\x6e\x62\xbc\xd1\x3d\x17\x5c\x2d\xe7\x56\x07\x77\x2c\xe7\xae\xbe\xe7\x6e" +
+		"\x28\xe7\xc6\x82\xeb\xcb\xb9\xe1\xe0\x7e\x9a\x73\x83\xc1\x2d\xcf\xb9\x17\x82\xfb\x6b\xad\xd1\xfd\x24\xb8\xd1\x9c\xfb\x5e\x70\xaf" +
+		"\xe7\xdc\x5f\xc6\xdd\xbd\x94\x73\x27\x83\xab\xe6\xdc\x7e\x77\xd3\x56\xd5\x4c\x0f\x76\xf5\xd7\x4c\x55\xeb\xa2\x1e\xa2\x40\x77\x22" +
+		"\x78\xa9\x59\xc4\xbf\x6d\x16\x0c\x98\x68\x1d\x34\xc1\xc4\x5d\xa2\xf0\xcf\xbb\xb2\x11\x77\x20\x38\x11\x8b\xe2\xf1\x58\xb0\xa9\x53" +
+		"\xdc\xb2\xb9\x53\x55\xbb\x09\xc1\x4c\xf1\xd9\x0e\x55\xed\x51\x04\xdb\x4c\xa8\xcf\xd7\x02\xc1\x57\xc4\xf4\xc7\x04\x3d\x26\x0a\xdd" +
+		"\x26\xf8\x61\x22\x6e\x5a\x93\x08\xaa\x26\x3a\x36\x78\xea\x42\x04\x7f\x8c\x04\xbf\x89\x84\xdd\x21\x18\x75\x38\x15\x89\x64\xc4\xfd" +
+		"\x59\x0f\x97\x4b\x42\xeb\x0a\x02\x09\x2e\x46\x22\xba\xe0\xfe\x92\x87\xf7\x33\xb2\x0f\x9c\xae\x84\x01\x91\xd0\x7f\xd0\x87\x1e\xae" +
+		"\x7a\xde\xd2\x58\x20\xaf\xf4\xfb\xb1\xb0\xe5\xb1\x50\x4f\x2c\xa2\x27\xbc\x23\xf5\x5a\x1d\xd6\xb8\xba\x4e\x93\x02\x6d\x4d\x84\x7a" +
+		"\x13\x61\x5b\x12\x81\xab\xfe\x44\xc4\xdb\x93\x7a\x71\xbb\xbc\x6f\x67\x22\x12\x09\x49\x30\xec\xe6\x0f\x3e\xee\xa4\xd3\x88\x67\x9e" +
+		"\xf2\x30\x9a\xcd\x70\xc6\x61\x22\x11\x91\x84\xe9\x5a\x0d\xd1\x8f\x3d\xbc\x96\xd6\xcd\x51\x87\x23\xa9\x48\x86\xd3\xec\xed\xdc\x83" +
+		"\xe0\x7e\x31\xfd\x3e\x9f\xd2\x9f\x6c\x34\x52\xd5\x22\x04\x6b\x0b\x22\x5e\x57\xa8\x6f\x97\x85\xed\xea\x89\xc4\x92\x6e\x4f\x29\xe0" +
+		"\xcd\x44\xb4\x75\xfb\xda\x9b\x13\x31\x7f\x53\xa2\xaa\xdf\x69\x50\x9b\x2a\x8a\xe3\x53\x05\x2b\x2a\xa2\xf4\x64\xa5\xf1\x00\x5d\x0b" +
+		"\xb3\x11\x9c\x93\xb8\x75\x4c\x82\xf5\x26\x4a\x3f\xb3\x6c\xf1\x0b\x73\xc5\x23\x17\xe7\x66\xcd\x47\xc5\x9d\x8f\x08\xbe\x25\x6e\xfd" +
+		"\xa6\xe0\xc0\x1c\xd1\x72\x70\x8e\xaa\xf6\x45\x04\x2f\xc6\xfe\xfe\x3d\x2c\xf5\x19\xa6\x2d\x33\xc1\xab\x65\x31\x6f\x4f\x59\xd0\x24" +
+		"\x92\x54\x70\xda\xc4\x2d\xef\x78\xc6\x12\x04\xcf\x34\x8b\xc9\x3f\x6f\xce\xce\xe2\x62\xf1\x89\x07\x04\x63\x91\xf8\xc8\xb9\x28\xab" +
+		"\xf3\xf3\x08\xf6\xb5\x89\xe6\xfd\x6d\xaa\x1a\xdd\x25\x91\xf6\x94\xfc\x45\x99\x78\x78\xa7\x4f\x37\x19\x41\x2a\xac\x49\xd8\xe1\x82" +
+		"\x48\x0e\x14\x04\x6f\x7b\x38\x56\x10\x36\x5c\x10\xe9\x11\x6f\x9e\x2a\x88\xe6\x51\xa7\x31\x0f\xe7\x6e\x24\x05\x1a\x2f\x88\x59\x35" +
+		"\x1f\xdb\x5b\x14\xbc\x1c\xfb\x31\x2f\x0b\x9e\x2f\x7a\x15\xb1\xb0\xaa\x77\x6c\x28\x66\xf4\x5c\x51\xc4\x1b\x8b\xd7\x52\x60\x4b\x51" +
+		"\x68\xb3\xd3\xf5\x3c\x39\x99\x3b\x6d\xcc\x46\xa4\x81\x56\x57\x04\x4f\x57\x84\xf5\x7b\x4a\x9f\xbb\x75\x15\x91\xae\xf5\x8e\x5d\x3e" +
+		"\x62\xa7\xbb\x41\xef\x1d\x70\xda\xed\x34\xe4\xb4\xcd\x87\xf5\x79\xde\x7e\x6f\xee\x2b\xd6\xc9\xae\x13\x91\x40\x82\xd7\xbc\xb1\xb7" +
+		"\x58\x27\xdb\xe3\x34\x3a\x43\xd8\xfa\xa6\xfa\x94\x0c\x65\x93\x53\x6d\x15\xe6\xef\x88\x90\xb7\x2c\x3b\xeb\xaf\x54\xea\x19\xf1\x50" +
+		"\xd8\x0a\x5f\x21\x0a\x6b\x1d\xf6\x49\x0f\x39\xbd\x59\xac\xaf\x78\xc0\xe1\x6d\x1f\xb1\x22\x11\xf6\xba\x37\x0f\x16\xc5\x94\xd0\x71" +
+		"\xd6\xc3\xbb\xa1\x9e\xb2\x88\xf6\x96\x05\xe7\xeb\x43\x75\xce\x61\xdc\x43\x2d\x47\xe6\x64\xa1\x77\xac\x28\x26\x05\xba\xe2\x45\x5c" +
+		"\x0e\x75\xb6\x0b\x96\xb6\xd7\xa9\x35\x90\x7f\xf8\xe9\x9a\x58\x55\xbb\x17\x61\x63\xed\x22\x7d\xd7\x3b\xfe\xe6\x61\xdc\x3f\xff\x91" +
+		"\x19\x82\x7f\x78\xf3\x03\x0f\x83\x89\x60\xc0\xc3\x95\x76\x61\x97\xdd\x5d\x6d\x57\xd5\x92\x0f\x1d\xbf\x5b\x12\xd1\xe3\x25\x81\x9f" +
+		"\xc4\x19\x7e\x26\xd9\x5d\x12\xb7\x0f\x39\x6d\x2d\x8b\xb4\xb7\xac\xaa\xdd\x86\x7f\x18\x12\xb3\x5e\x94\xe0\xa9\xb2\x98\xb2\xaa\x2c" +
+		"\xf8\xfd\x4c\x61\xaf\xcc\x14\xf4\xfa\x93\xfb\x08\xb6\x79\xe8\xab\x53\xd5\x14\xb0\xbf\x2c\x6c\x7b\x46\x6c\xbf\x9e\xf8\x7c\x2a\xa6" +
+		"\x1e\xf3\x69\xf6\x97\xeb\x1b\x88\x6f\xa0\x02\xbd\x51\xae\xef\xa2\xb7\xcc\x5b\xd1\x3e\x57\x07\xbd\x79\xc0\xd3\x0e\x97\xeb\xf7\x44" +
+		"\x3c\x3a\x59\x70\xb4\x2c\xe6\x1c\xf1\x75\x63\x7c\xc7\x2a\x02\x09\x5b\xe5\xf0\x54\x45\x44\x81\xb6\xf9\x17\x76\x66\xba\xe0\x4c\x2a" +
+		"\xec\xfd\x59\x82\x1f\x54\x84\xad\xac\xd4\x89\x40\x4f\x56\x44\xec\x77\x8f\xf9\x58\xad\xac\x64\x87\x5c\xf5\x5c\x5b\x3d\x5b\xb4\xac" +
+		"\x9a\x2d\xf8\x45\x45\xa4\xcf\xba\xeb\xf5\xb0\xc5\xd3\x02\x6d\xcd\x91\xbd\x50\x11\x53\x02\xed\xf1\x8e\x21\x0f\x83\x5e\xd9\x80\x5f" +
+		"\x6f\xda\x5d\x11\xf7\x87\xc3\xf9\xce\xcd\xa2\xf5\xf4\xcd\xaa\xda\xc7\xc2\x2d\xe9\xfb\xdf\xad\x1b\xaf\xc0\x32\xbe\xad\x6d\xe2\xe3" +
+		"\xdb\xdb\x04\x27\xda\x44\x74\xdc\x2f\x99\xd6\xf0\xa7\x39\x4f\x3c\x70\x7c\x9e\xbf\x73\x04\xeb\x26\x89\x7b\xd7\x4e\xf2\xe7\x68\x16" +
+		"\xad\x4f\x37\x67\xb3\x3c\x84\xe0\xe4\x7c\xc1\x88\x07\xff\x43\x6a\x5a\x1e\x67\x57\xf4\xc9\x05\x62\xea\xc8\x02\xc1\xf0\x02\xd1\xf2" +
+		"\x56\xa0\xf9\xe2\xa3\x6f\xcd\xcf\xea\xeb\x10\x6d\x33\x05\x17\xe7\x8a\x29\x17\xe6\x66\xf3\xb2\xd0\x5d\xa7\x58\xb4\xa3\xd3\xd7\xef" +
+		"\x14\xc5\xb5\x9d\x59\x6f\x13\x82\xdf\xdd\x2d\x1e\xde\x71\x77\x70\xff\x1a\x00",
+	"/KSCms-UHC-HW-V": "" +
+		"\xe2\xf3\x0e\x76\xce\x2d\xd6\x0d\xf5\x70\xd6\xf5\x08\xd7\xf5\x60\xf3\xce\x2f\x4a\x4d\x34\x64\x10\x60\x5a\x74\x98\x89\xf1\x43\x2d" +
+		"\x13\x23\x03\x03\x13\x03\x43\x17\x0b\x13\x13\x53\x37\x0b\x98\xc7\xc2\xcd\xc0\xa4\xcb\xc0\xc0\xb4\x95\x99\x81\x81\x89\x8d\x91\x81" +
+		"\x89\x89\x81\x01\x2c\xc1\xcd\xca\xc0\x24\x0d\x65\x83\xb0\x34\x33\x03\x60\x00",
+	"/KSCms-UHC-V": "" +
+		"\xe2\xf6\x0e\x76\xce\x2d\xd6\x0d\xf5\x70\xd6\xf5\x60\xf3\xce\x2f\x4a\x4d\x34\x64\x10\x60\x5a\x74\x98\x89\xf1\x43\x2d\x13\x23\x03" +
+		"\x03\x13\x03\x43\x17\x0b\x13\x13\x53\x37\x0b\x98\xc7\xc2\xcd\xc0\xa4\xcb\xc0\xc0\xb4\x95\x99\x81\x81\x89\x8d\x91\x81\x89\x89\x81" +
+		"\x01\x2c\xc1\xcd\xca\xc0\x24\x0d\x65\x83\xb0\x34\x33\x03\x60\x00",
+	"/KSCpc-EUC-H": "" +
+		"\xc4\x95\xed\x8f\x5d\x55\x15\x87\x9f\xf5\xdb\xe7\x9c\x79\x69\x3b\xa3\x4c\x7b\xcf\xdc\xdc\x4b\x9d\xb6\x69\x13\x4c\x21\x6d\x0d\x1f" +
+		"\x8c\x13\x19\x87\x92\x88\x82\x18\xac\x09\x90\x14\xe3\x07\x3f\x9b\xf8\x11\xd3\x7b\xa7\x8a\x38\x05\x2c\x50\xdb\x90\x18\x51\x39\x40" +
+		"\xdf\x46\x3b\x6d\xac\x1a\xb1\x60\x67\x4a\x4b\x2b\xad\x8d\x85\x42\x31\xd3\xa9\x81\x94\xce\x4b\x6c\x49\xc4\x04\xa7\x4c\x31\xeb\xcc" +
+		"\x39\x15\xec\x1f\xc0\x97\x95\x67\xbd\xee\x7d\xd6\x5e\x7b\x1f\x92\xaf\x7e\xf7\x7b\xdf\xf9\xf6\xea\x60\x3c\xa8\xa7\xbf\x34\x7b\xc5" +
+		"\xae\x7c\x38\x1b\xac\xe7\x7e\x99\x84\x3d\x60\x68\x64\x54\xbd\xfb\x1b\x0a\x55\x64\x11\x82\xe9\xa6\xb0\xa9\xa6\xb8\x6f\xb2\xa9\xfe" +
+		"\x79\xfb\x1b\x5a\xb7\xf6\x95\x86\xe8\x1e\xdd\xa0\xfe\x5e\x14\x16\x23\x5b\x3f\xd4\x54\x7f\xef\xbb\x1b\xe6\xb4\x35\x3b\x9a\xb2\x1b" +
+		"\x50\x7f\xef\xc5\x0d\x0a\xed\x68\x51\xdb\x6c\x43\x71\x1b\x6a\x49\xbd\xec\xf6\x86\x94\x0e\x37\x44\x18\x68\x68\xad\xd7\x68\x43\x4a" +
+		"\x90\x62\x24\x43\x76\xeb\x6f\x9b\xa2\xfa\xeb\xc6\xff\x56\xf8\xda\x93\x03\x26\x3a\x66\x3e\x62\x0a\x27\x07\x4c\x06\xb2\x16\x64\x77" +
+		"\xa2\xfe\xcf\xfd\xac\xa9\x95\xeb\x37\x0e\x98\xfa\x7b\x8f\x34\xe6\xa2\xee\x9e\x6d\x8a\xf6\x33\x1f\xc9\xfb\xe6\x73\x5e\x2a\xcc\x34" +
+		"\x84\xa6\xa4\x5b\x7b\x2f\x49\xa1\x82\xda\x7a\x76\xe5\x6b\x4c\x34\x45\xcf\x8f\x1c\xa3\x97\x9a\xfa\x4a\x2f\x5a\xd6\x89\xde\x8d\xd7" +
+		"\x5f\x18\x30\x65\xb6\x9e\x4f\x44\x84\x9c\x6e\x42\xf0\xfb\x56\x11\xfd\xa1\x55\x70\xd0\x44\xe7\x88\x09\xae\xdc\x2c\x2a\x1f\xde\x5c" +
+		"\x66\xdc\x88\xe0\xcd\x48\xa4\x67\x22\xc1\xf6\x3e\xb1\x68\x47\x9f\x32\x9b\x87\x60\x89\xf8\x7a\x8f\x32\xbb\x17\xc1\xb0\x09\xed\xf1" +
+		"\x2f\x03\xc1\xb7\xc4\xc2\xfb\x05\x9b\x4c\x54\x06\x4d\xf0\xd3\x58\xcc\xdb\x12\x0b\x32\x13\x3d\xcf\x78\xe8\x1a\x04\xff\x08\x82\xdf" +
+		"\x05\x61\x37\x0a\xc6\x1d\xce\x05\x11\x8f\xb9\xfd\x82\x8b\x99\xaa\xd0\xb6\x8a\x40\x82\x4b\x41\x84\x8b\x6e\xff\xb7\x8b\xf7\x4a\xb2" +
+		"\xf7\x9d\x2e\xe7\x09\x41\xe8\xff\xe8\x03\x17\xb3\x1e\x37\x10\x09\xa4\xcc\xf8\x71\x24\xec\xa1\x48\x68\x53\x24\xc2\x83\xee\x48\x7c" +
+		"\xaf\x0e\x5b\xdc\x74\x95\xda\x73\xda\x1d\x0b\x0d\xc5\xc2\x76\xc6\x02\x37\xed\x8b\x45\xb4\x37\x2e\x36\xf7\x82\xfb\x0e\xc4\x22\x96" +
+		"\x90\x04\xa7\xdd\xf2\x77\xcf\x3b\xeb\x34\xe6\x91\xe7\x5c\x8c\x97\x15\xce\x3b\x5c\x89\x45\x90\x30\xcd\xed\x21\x3c\xe9\xe2\x48\x52" +
+		"\x58\x5e\x75\x38\x95\x88\xf8\x74\x52\x9e\xce\x5a\x04\xb7\x8b\x85\x5f\xf6\x92\x41\xd8\x78\x50\x66\x01\xc1\xd6\x8a\x88\xb6\x55\x8a" +
+		"\x76\x59\xde\xae\x4d\x41\xdc\x35\xe8\x21\x15\x5c\x8d\x45\xd7\x60\x2c\xd8\x11\x8b\x55\xdb\x63\x65\x7e\xdd\x60\xaa\x43\xa4\xd3\x1d" +
+		"\x82\x87\xeb\xa2\xfa\x48\xfd\xda\x51\x5a\x86\x60\x42\xe2\xfa\x49\x09\x9e\x32\x51\xfd\xb9\x95\x8b\x5f\x5c\x21\xee\xb9\xb4\xa2\x54" +
+		"\xef\x15\x37\xdd\x23\xf8\xbe\xb8\xfe\x01\xc1\xf1\xe5\xa2\xed\xc4\x72\x65\x76\x1f\x82\xfd\x91\x9f\xbf\x8b\xfc\x62\x7c\x7a\xa3\x09" +
+		"\x5e\xaa\x89\x95\x87\x6b\x82\x16\x11\x27\x82\xb7\x4c\x2c\x7a\xdb\x23\xee\x42\xf0\x8b\x56\x31\xff\x97\xad\xe5\x2c\xde\x29\xbe\x78" +
+		"\x87\x60\x32\x88\x4f\x4d\x84\x72\x9f\xdf\x40\x70\xb4\x4b\xb4\x1e\xeb\x52\x66\x0c\x56\x45\xb2\xa9\xea\x07\x65\x62\xdd\x01\x2f\x37" +
+		"\x9f\xb9\x8e\x5b\x8b\xb0\x93\x15\x11\x1f\xaf\x08\xde\x70\xf1\x7a\x45\xd8\xe9\x8a\x48\x4e\xb9\x7a\xae\x22\x5a\xc7\x9d\x26\x5d\x4c" +
+		"\x5c\x4b\xca\x69\xba\x22\x96\x4e\x79\xee\x50\x2a\xf8\x63\xe4\x63\x5e\x13\xec\x4a\x95\x19\x91\xb0\xcc\x1d\xcf\xa4\x25\x3d\x97\x8a" +
+		"\xe8\xd9\x74\x2e\x04\x76\xa6\x42\x3b\x9c\xae\xc6\xc9\xc9\xdc\xa6\x67\xcb\x8c\x24\xa7\x27\xea\x82\xc7\xeb\xc2\xf6\x79\xc8\x1e\xb7" +
+		"\x6d\xab\x8b\x64\xab\x3b\x5e\x48\x85\x0e\xb8\x6d\xc4\xbd\x07\x9d\x0e\x39\x8d\x3a\x0d\x7b\xda\x1e\x8f\x3b\xe6\xea\xd1\xb4\x20\xbb" +
+		"\x4a\x04\x81\x04\x47\x5c\x79\x39\x2d\xc8\x0e\x3b\x8d\x2f\x16\xf6\x54\x4b\x51\x92\xd1\xb2\x38\x59\xa7\x30\x3f\x23\xf2\xb8\x8d\xe5" +
+		"\xac\xbf\x58\x2f\x22\xa2\xd1\xbc\x15\xbe\x42\xc8\xd7\x3a\xe9\x45\xff\xea\xf4\xb7\xb4\x58\xf1\xb8\xc3\x1b\x9e\xf1\x70\x2c\xec\x2f" +
+		"\xae\x9e\x48\xc5\x82\xdc\x71\xc1\xc5\x3b\xf9\x7e\x6a\x22\xbc\x5c\x13\xfc\xb3\x48\xd5\x84\xc3\xb4\x8b\xa9\x8f\x91\x39\x59\xee\x9d" +
+		"\x4c\x45\x7b\x4e\x97\x7d\x13\x33\xf9\x3e\xbb\x05\x03\xdd\x05\x75\xe6\xe4\x17\x3f\xd9\x12\x29\xb3\xdb\x10\x36\xd9\x2d\x92\x77\xdc" +
+		"\xf1\x2f\x17\xd3\x7e\xfd\xc7\x16\x0b\xfe\xe3\xea\xfb\x2e\x46\x62\xc1\x41\x17\x97\xbb\x85\xcd\xb8\x6d\xb6\x5b\x99\xc5\x1f\x38\xfe" +
+		"\xb0\x2a\xc2\x0f\xaa\x02\x9f\xc4\xc5\x3e\x93\x1c\xaa\x8a\x1b\x46\x9d\x76\xd7\x44\x32\x54\x53\x66\x9f\xc1\x2f\x86\xc4\xd2\xfd\x12" +
+		"\x3c\x56\x13\x0b\x36\xd7\x04\x7f\x5e\x22\xec\xc5\x25\x82\x21\xff\x72\xcf\x60\xd8\xc5\x9e\x82\x32\x53\x8e\xfb\x6a\xc2\xf6\x96\xc4" +
+		"\xde\xab\x81\xbb\x12\xd1\xf1\xba\x97\x39\x56\x2b\x1a\x88\x37\x50\x39\xbd\x52\x2b\xba\xe8\x9a\xb9\x16\x8e\xba\xe9\x84\xab\xc7\x3d" +
+		"\xec\x64\xad\x78\x27\xa2\xf1\xf9\x82\x57\x6b\x62\xf9\x29\x5f\x37\xc2\x3b\x56\x17\x48\xd8\x66\x87\xc7\xea\x22\xe4\x34\xec\x37\xec" +
+		"\xfc\x42\xc1\xf9\x44\xd8\x7b\x4b\x05\x3f\xa9\x0b\x7b\xb4\x5e\x10\x39\x3d\x52\x17\x91\xbf\x3d\xe6\xb9\x7a\xb4\x5e\x0e\xb9\x8a\x58" +
+		"\x7b\x62\x99\x68\xdb\xbc\x4c\xf0\xb4\x0f\xfa\xaf\xdc\x36\xe4\x62\xa7\x87\xe5\xb4\xfb\x63\x64\xbf\xa9\x8b\x05\x39\x1d\x76\xc7\xa8" +
+		"\x8b\x11\xdf\xd9\x41\x7f\xde\x74\xa8\x2e\x6e\xcf\x87\xf3\xed\xeb\x44\xe7\x5b\xd7\x29\xb3\xcf\xe7\xaf\xa4\xf7\x7f\x50\xd7\x3e\x81" +
+		"\x35\xbc\xad\x5d\xe2\x0b\x7b\xbb\x04\x6f\x76\x89\x70\xc6\x1f\x99\xce\xfc\xa7\xb9\x52\xdc\x71\x66\xa5\x9f\x39\x82\x6d\xed\xe2\xb6" +
+		"\xad\xed\xfe\x1d\xad\xa2\xf3\xf1\xd6\xb2\xca\xdd\x08\xce\xae\x12\x8c\xb9\xf0\x1f\x52\xcb\x43\x51\xf9\x44\x9f\x5d\x2d\x3a\xc6\x56" +
+		"\x0b\x4e\xaf\x16\x6d\xaf\xe5\xb4\x4a\x7c\xf6\xb5\x55\xe5\xfe\x7a\x44\xd7\x12\xc1\xa5\x15\x62\xc1\xc5\x15\x65\x5d\xd6\xb8\xad\x4f" +
+		"\xf4\x3f\xdf\xe7\xeb\xf7\x89\x74\x6b\x5f\xe9\x6d\x41\xf0\xa7\x5b\xc4\xba\xe7\x6f\xc9\x6d\xff\x1d\x00",
+	"/KSCpc-EUC-V": "" +
+		"\xe2\xf6\x0e\x76\x2e\x48\xd6\x75\x0d\x75\xd6\xf5\x60\xf3\xce\x2f\x4a\x4d\x34\x64\x10\x60\x5a\x74\x98\x89\xf1\x43\x2d\x13\x23\x03" +
+		"\x03\x13\x03\x43\x17\x0b\x13\x13\x53\x37\x0b\x98\xc7\xc2\xcd\xc0\xa4\xcb\xc0\xc0\xb4\x95\x99\x81\x81\x89\x8d\x91\x81\x89\x89\x81" +
+		"\x01\x2c\xc1\xcd\xca\xc0\x24\x0d\x65\x83\xb0\x34\x33\x03\x60\x00",
+	"/V": "" +
+		"\x62\xf4\x60\xf3\x4a\x2c\x48\xcc\x33\x64\x90\x66\x5a\xe4\xc4\x38\xaf\x9a\x89\x97\x91\x81\x89\x93\x89\x81\x89\x89\x85\x81\x89\x45" +
+		"\x90\x81\x89\x95\x81\x81\x69\x3f\x88\x60\x44\xc3\x92\x0c\x0c\x4c\xf2\x48\x7c\x36\x06\x06\xa6\x4d\x8c\x44\x2a\x64\x63\x64\x00\x0c" +
+		"\x00",
+}
+
+// collectionData are the CID-to-Unicode tables of the Adobe character collections, by
+// ordering. Each is packed by gencjk.go from the Adobe-<ordering>-UCS2 CMap and compressed
+// with flate, and is read by readCollection.
+var collectionData = map[string]string{
+	"Japan1": "" +
+		"\xec\xfd\x09\x7c\x95\xd5\xb5\x37\x8e\x7f\xbf\xfb\x9c\x9c\x24\x27\xc9\x49\x48\x42\x0c\x21\x84\x18\x10\x10\x11\x49\x08\x21\x28\x52" +
+		"\x44\x4b\x91\x6b\xad\xd7\x5a\xea\xb5\xd6\x1a\xa7\x5a\xaf\xed\xdb\x5a\xab\xde\x5e\x6b\x11\x02\x42\x18\x44\x66\x99\x67\x90\x49\x40" +
+		"\xe6\x19\x04\x64\x92\x49\x64\x12\x01\x01\x11\x99\x64\x12\x10\x01\xff\x9f\xb5\xd6\xb3\x43\xda\xdb\xff\xef\xbe\xef\xfb\x7f\xfb\xf9" +
+		"\xbf\xf7\x77\xfb\xf9\xc0\x3e\x27\xcf\x79\xf6\xf3\xec\xbd\xf6\xda\x6b\xde\x6b\xb1\x65\x6b\xb0\x3f\x51\xfb\x4d\x82\x1d\xc0\x7f\x06" +
+		"\x7b\x46\xc1\xa9\x2f\x82\xdf\xbc\x06\x5e\x7a\x0d\x9c\xf7\x1a\x18\x46\xa8\x2d\xb8\xf2\x35\xf0\xd0\x1f\xc1\x8f\x43\x60\x1c\x78\xfa" +
+		"\x4f\xe0\x67\x7f\x82\x1b\xf6\x1a\x5c\xef\xe3\x11\x70\xff\xf1\x08\x5c\x2e\xf8\xd1\x9f\x40\x82\xbb\xfe\x04\xa6\x82\x09\xfa\xc7\xfb" +
+		"\x7f\x02\x77\xfc\x09\xcc\xb4\x3e\xe5\x89\xe0\xf8\x44\x84\xa6\x27\x82\x61\x70\x6c\x22\x38\x21\x11\xbc\x1d\x6c\x0e\x3e\x04\xee\x4f" +
+		"\xd4\x6f\xd3\x1d\xb8\x95\xe0\x79\x82\xef\x3a\xf0\x61\x70\x20\xc1\x02\xb0\x1f\xc1\xb9\x04\xbf\x20\x78\xc8\x81\x97\x08\x46\xc0\x30" +
+		"\xc2\x61\x69\x99\x80\x50\x04\x91\x30\xaa\x85\xa5\xb5\x2f\xf1\x61\x70\x25\xc1\x26\x60\x14\xec\x13\x01\x3f\x88\x80\x9d\xfe\x0c\x7e" +
+		"\xf0\x1a\x38\x34\x0e\x2d\x6b\x2b\x30\x6e\x14\x60\x74\xfa\x33\x5a\xaf\xba\x12\x01\x97\x10\x6e\xe4\x82\x88\x4e\xb9\x0b\xc1\x64\xb0" +
+		"\x2b\x41\xb9\x27\xfc\x9f\xfd\x8b\x04\x5f\x5c\x0c\x2e\x7c\xfd\x1f\xbb\x2e\x8c\xc0\x75\x59\x12\xc1\x7d\x8b\x1a\x81\x33\x33\xc1\x7e" +
+		"\xaf\x81\xc3\x5e\x03\xe7\xfc\x59\x27\xb1\x63\x29\x11\x8e\x80\x57\xbf\x07\x0e\xbb\x1a\x01\x87\x2e\x8a\x80\xa3\xff\xea\x9d\x2f\xfa" +
+		"\x2f\x3f\x00\xef\xba\x7e\xdd\x85\xff\x17\x86\x16\xb1\xef\xfe\x5f\x5c\xd5\xeb\x0a\x47\x17\xa7\x53\xef\xfc\xff\xcb\x7c\xcf\x2c\x25" +
+		"\xf8\x1c\x58\x5b\x1e\xc8\x9a\x60\x1a\xf8\x3d\x45\x86\xd6\x70\xc3\x09\x8e\x22\x18\x03\x67\x12\x6c\x09\x86\xc0\x22\x05\x74\x9a\x5e" +
+		"\x4d\xd0\xb6\xa9\xbf\x92\xe0\xbf\x34\x07\xd3\xc1\xbe\x82\x0a\xa3\xa5\x59\x4d\x70\x28\x11\x1a\xb5\x8c\x60\xff\xc5\x36\x98\xf1\xf3" +
+		"\x23\x70\x4b\xe7\x47\xb4\xdf\x1d\x70\x1b\x64\x29\x37\xcb\x1d\x83\x2f\xc7\x83\xef\x4a\x33\x55\x9a\xef\xbe\x15\x64\xb8\x12\x81\xeb" +
+		"\xbc\x20\x02\xb7\x82\xe0\x1c\x07\xce\xfe\x77\x84\xde\xfd\x77\xf0\x58\x08\xfc\xf0\x6e\x30\x11\xbc\x26\x77\x3e\x02\xde\x03\xbe\x29" +
+		"\x6b\xd3\x53\x9a\xe3\x82\x2b\x84\xab\x01\x17\x81\xdb\xf1\x6d\x04\xee\x4b\x59\xb3\x8d\xc1\x38\x5a\x4a\x1b\x7d\x67\x49\x04\x3c\xbf" +
+		"\xc8\x2e\xcd\xfb\x26\x1e\xbc\x4f\x60\xc0\x8d\x97\xe2\xc1\x6f\xce\x46\xc0\x4b\x67\xf5\x47\xf7\x95\x7c\xbe\x45\xb0\x11\x38\x2c\x0d" +
+		"\x0c\x81\x23\x07\x12\xae\xfc\x35\x70\x54\x08\x5c\x25\x83\x58\x11\x82\x7b\x5f\x9e\xb2\x4a\x9a\x10\x68\xd3\x6c\x04\x9e\x91\x0b\x4b" +
+		"\x07\x10\x24\xf8\x7b\xbf\x0b\x53\xb4\xbd\x57\xdb\x7a\xda\x66\x69\xfb\x7a\x16\x38\xfd\x7b\xe0\x77\x2d\xe0\x42\x60\x18\xec\x78\x3b" +
+		"\x38\xa0\x14\x8c\xc0\x5d\x20\x5c\xa2\xad\x0d\xf5\xe7\x8b\xb2\x0d\x36\x96\x53\x6f\x7c\x58\x5f\x7a\x3b\x58\xe1\xc0\x3d\x04\x47\x86" +
+		"\xc1\x28\x38\x9b\x60\x2d\xb8\x26\x86\xb5\x6d\x75\xbd\x9b\xc0\x65\x80\xaf\x87\xc1\xaf\x43\xe0\xb5\x6c\x1d\x72\x1c\x5c\xbf\x1a\xe0" +
+		"\xc7\x7f\x02\xcf\xf6\x27\xa2\x2b\x0f\x47\x90\x93\x86\x9c\x18\x7e\x3c\x73\x71\x04\x1d\x72\x51\x6d\xdd\x1c\xca\xe6\xad\x96\x26\x6d" +
+		"\xa4\x37\xc1\xfa\xc8\x6f\x08\x96\x22\xa7\x19\x38\xe4\x1d\x07\x9e\xaf\x01\xee\xc9\x00\x2f\x9d\x26\x78\xb5\x8b\x03\xe7\x16\x82\x43" +
+		"\x12\xc0\x43\x75\xc1\x5d\xbb\x08\xee\x6e\x0c\xce\x8c\x03\x07\xd5\x01\x37\x3c\x03\x9e\x4a\x06\xbb\xdd\x0c\x7e\x95\x0f\x9e\xfd\x37" +
+		"\xf0\xbb\x08\x38\xe2\x69\xf0\xfd\x0f\x09\xee\xfc\x3e\x78\xbe\x05\xb8\x3f\x19\x9c\x52\x13\x1c\x10\x0f\xee\x98\x45\xf0\xec\xbd\xe0" +
+		"\xd8\xdf\x80\xf3\x09\x8e\x7a\x19\xfc\xea\x73\x82\x7d\x9f\x03\x67\x34\x01\x3f\x91\x59\xef\x4a\x06\x27\x55\x03\x87\xc5\xc0\x83\x11" +
+		"\xf0\xda\x1c\x82\x3d\xe3\xc1\x6f\x97\x13\xfc\xa8\x0c\x6c\x6f\x24\x64\x94\xdc\xec\xc0\x6d\x39\x60\x45\x22\xb8\xd0\x29\x5a\xf5\x49" +
+		"\x00\xa7\x66\x80\x8f\x1b\xb5\x5a\x93\x0e\x1e\x4e\x02\x8f\xe5\x81\x93\xd3\xc1\x36\xe0\x40\xb9\x14\x03\x65\x09\x26\x25\x81\x03\x53" +
+		"\xc0\xc9\xd5\xc0\xa5\x51\x70\x42\x75\x7d\xfe\xd5\xf3\x04\x37\xa4\x83\xf9\xe0\xf9\xba\xe0\x84\xb1\x04\x47\xb4\x06\xc7\xfe\x02\xfc" +
+		"\x74\x0a\xc1\x83\xb7\x80\x07\x3b\x80\x53\x65\x28\x3d\x1e\x06\x4f\xd4\x01\x17\x38\x70\xed\x33\xe0\x27\x53\x1c\xb8\x2f\x01\x1c\x12" +
+		"\x07\xb6\x03\xaf\xc5\x83\x73\x93\xc1\xd9\x99\xe0\x42\xa1\xb9\xbd\xa6\x0a\x2c\xfe\x09\xec\x5b\x0d\x3c\x76\x0f\x98\xab\x03\x9e\x1b" +
+		"\x07\xee\x7f\xd3\x81\xb7\x81\x93\xf2\xc0\x3e\x3f\x01\xf7\xde\x01\x56\x08\x8e\xe7\x82\x67\x7a\x09\x96\x4c\x74\xe0\xfb\x15\x04\xb7" +
+		"\x0a\x44\xcb\x27\x13\xdc\x76\x13\x38\xe5\x47\xe0\xd0\xc7\xc1\x89\x61\x70\xd6\xc3\xe0\xe5\x5f\x82\x2b\x1f\x05\xa7\x74\x77\xe0\xc5" +
+		"\x6c\xf0\xf2\x33\xe0\x89\x5a\xe0\x91\x4e\x04\xdf\xbe\x4a\xf0\xb3\x3c\xf0\x7c\x57\x07\x6e\xca\x01\x57\xdf\x0f\x5e\xee\x00\x7e\xdc" +
+		"\xdf\x81\x93\xe3\xc1\xf3\xd5\xc1\x4e\xd5\xc0\x7d\x39\xe0\x84\x10\x38\x26\x0c\x1e\x4d\x03\x2f\x38\xf0\x79\xf0\x70\x2e\xb8\x27\x11" +
+		"\xec\x19\x03\x37\xbe\x0c\x76\xf9\x09\xb8\xbd\x3a\x58\x21\xf3\x2e\x00\xbf\xee\x2f\x73\xac\x0d\x2e\x0d\x83\x17\x7a\x10\x5c\x72\x98" +
+		"\xe0\xdc\x77\x64\xc5\xa3\xe0\x96\x7c\xf0\x83\x19\x04\x57\xfc\x14\xfc\x30\x01\x1c\x9c\x05\x2e\x0e\x83\x93\xc2\xe0\x81\x44\xb0\x3c" +
+		"\x05\x3c\x1c\x06\x3b\xc5\x8c\x6f\x0d\xac\x03\x4e\x4e\x04\xcf\xc7\x81\x3d\x1d\x58\x41\x63\x5c\xdb\xe3\xc0\xf2\x5b\xc0\x63\xd5\x8c" +
+		"\xa9\xf5\x4c\x02\xfb\xde\x02\x5e\x88\x07\x3b\xde\x02\x1e\xea\xeb\xc0\x09\xcd\xc0\xc5\x39\xf6\x90\x9d\x3d\x09\x1e\xcb\x05\x7f\x03" +
+		"\xce\xcd\x36\x64\x5c\x9e\x04\x5e\x49\x06\x0f\xa4\x81\x97\x43\xe0\x10\x07\x4e\xaf\x0d\x9e\x68\x02\x4e\xae\x07\x4e\xfd\x91\xd0\x3f" +
+		"\x9e\x88\x80\xab\xbb\x39\xf0\xd8\xf7\xc0\xf5\x3f\x03\x4f\xae\x23\xd8\xfb\x56\x70\x47\x75\x70\x54\x47\xd9\xc1\x4f\x83\x3b\xea\x83" +
+		"\x6f\xf6\x21\xb8\x32\x02\x5e\x49\x01\x0f\x36\x05\x3b\x36\x07\x37\x36\x01\xd7\xbe\x04\x6e\x96\x45\x1c\x98\x09\x6e\x4e\x03\x37\x51" +
+		"\x57\x7c\x2d\xc1\x9f\x81\x7b\x92\xc1\x11\x71\xe0\xec\x04\xb0\x67\x32\x78\x38\x62\xe4\xb3\x03\x78\xe0\x65\xb0\xdf\x0f\xc1\xc9\x61" +
+		"\xb0\x18\x9c\x1a\x03\x37\x89\x28\x11\x03\xa7\xa7\x80\x03\x6f\x00\x9f\x00\xaf\x08\xac\xe2\xc0\xed\xb9\x2a\x0c\x3c\x08\x1e\x21\x78" +
+		"\x9a\x60\x73\x70\x42\x0c\x1c\x9c\x02\xbe\xb5\x8d\xe0\xb5\xdd\xb4\x59\x6f\x21\x38\xaa\x26\x38\xb1\x0d\xd8\xe3\x13\x82\x6b\x8b\xc1" +
+		"\x6d\x99\x60\x9f\xbb\xc0\x55\x49\xe0\xf6\x22\x70\x46\xc8\xf0\xa1\xfc\x06\xb0\xa2\x01\x38\x4c\xd6\x28\x04\xf6\x9b\xea\xc0\x11\xb2" +
+		"\xaa\xc7\x09\x76\xdf\x48\x70\x57\x1c\xd8\xd1\x81\x07\xb3\xc1\x03\x21\xf0\x35\x70\x3b\xc1\x27\xc1\xbd\x71\xb6\xf1\xae\xc8\xce\x4d" +
+		"\x04\xfb\xec\x22\x38\xe7\x23\x82\xfd\x13\xc1\xde\x19\xe0\xd9\x24\x70\x7e\x06\x78\x3e\x0c\xee\xab\x09\x1e\xc8\x04\x27\x55\x07\xc7" +
+		"\x95\x80\x5d\xc3\xe0\x67\xf5\xc1\x9d\xc7\x08\x0e\x09\x81\x33\x6a\x80\xab\xab\x81\x1d\x73\xc1\x35\x71\xe0\xb8\x6a\xe0\xec\x7c\x70" +
+		"\x4f\x1a\xb8\xf8\x16\xf0\x4a\x13\x70\x70\x0c\x9c\x9b\x00\x1e\xab\x0b\x8e\xc9\x05\x07\xcd\x21\x78\x69\x06\xc1\xe1\xff\x04\x1e\x5f" +
+		"\x4c\x70\xfe\x5d\xe0\x88\x7f\x07\x3b\xde\x0a\x0e\xb8\x46\xb0\x5b\x1a\xd8\x25\x0a\x8e\xb8\xc5\xf8\xc7\x8c\x78\xb0\x6b\x0c\x0c\x3e" +
+		"\x4e\xc9\xe6\xac\x6e\x04\x6a\x5f\x4b\xf0\xb7\xe0\xac\x18\x38\x23\x02\x6e\x8f\x07\x4f\xa4\x83\x07\x43\xe0\xe4\x10\xd8\xe5\x34\x8d" +
+		"\x0e\xf4\x6f\x0a\x37\x6a\x11\xc1\xcf\x05\xf4\x15\x8f\x81\x5f\xde\x0d\x4e\x22\xf8\x56\x3c\x38\xfd\x43\x82\x9f\xcc\x22\xb8\xfb\xf7" +
+		"\xe0\x8e\x64\x70\xc2\x2d\xe0\xa5\x44\x70\xc4\xcd\xe0\xe0\x10\xd8\x5f\xfe\x77\x00\x8f\xa5\x83\x47\xb3\xc1\xfd\xe5\x0e\x5c\x3f\xdf" +
+		"\x81\x5d\x46\xcb\xb7\x87\x6c\xa7\xf5\x97\xd9\xbd\xb7\x84\xe0\xd0\x9f\x81\x9b\xc6\x13\xdc\x57\x1f\xdc\x5e\x0c\x1e\x88\x80\x9f\x9d" +
+		"\x97\xe1\x0c\x75\x60\x0f\x59\x91\x35\xff\x0a\xee\x0a\x83\x83\xf2\xc0\x05\x93\x09\x5e\x5e\x29\x1b\xb5\x39\xb8\xf9\x6d\x19\x65\x18" +
+		"\x5c\x98\x00\x1e\x6e\x00\xbe\x59\x1f\x1c\x16\x02\xf3\xc0\x49\x8d\xc0\x15\x37\x82\xa3\xa2\xe0\x25\xe1\xd2\x11\x30\xdb\xa8\xf2\xf4" +
+		"\x28\x18\x03\xb7\x27\xd8\xde\xec\x16\x08\x9a\xf2\x90\xde\x02\x2c\xc1\x19\x21\x38\x0e\xec\x96\x08\x36\x04\xbb\x85\xc1\x81\x42\xa1" +
+		"\x09\x8e\xa5\x6d\xa4\x2d\x04\x07\xc6\x83\x0f\x80\x47\x53\xc1\x55\xce\xd0\xa9\x4f\xbc\x81\xf2\xbc\x90\x41\x07\xf6\x8d\x07\x4f\x87" +
+		"\xc1\xc9\xd9\xe0\xac\x30\xb8\x34\x1e\x9c\x95\x0a\x0e\x49\x05\xcb\xe3\xc0\x10\x58\x00\x76\x0d\x19\xda\x4d\x8e\x03\x47\x3e\x0a\x2e" +
+		"\xdb\x4c\xf0\xc8\x0d\x46\xa5\x84\xd8\x2c\xfc\x29\xb8\x26\x6a\x98\x3a\xfe\x39\xb0\xbc\x17\xc1\xe5\xc5\xe0\x89\x7a\xe0\xa8\x38\x9b" +
+		"\x58\x02\x38\x6d\xbc\x03\x73\xc0\x35\xd5\xc0\x1d\x09\xb6\x2a\xe3\x22\x36\xa4\xf2\x78\x23\x8c\x72\xed\x52\x1c\x78\x98\xe0\xb0\x38" +
+		"\x70\x55\x9a\xed\xb7\xd5\x71\x60\x16\x78\x39\x0c\xee\x8a\x80\x29\xe0\xdc\x08\xf8\xa9\x50\x97\x12\x70\x1c\xc1\x8d\x04\xcb\xeb\x82" +
+		"\x7d\x53\xc1\x75\x2f\x83\x0b\xff\x08\x76\x4c\x06\xbb\xfc\x01\x1c\x37\x91\xa0\x90\xb1\xf9\x51\x9b\x8f\xcc\x65\x8c\x2c\x50\x7d\x5b" +
+		"\xbf\xbd\x61\xf0\xe2\x2c\x07\x8a\x2c\x26\x14\x7d\x42\x01\xd8\x37\x01\x9c\x90\x63\x37\xee\x4a\x04\x87\xd5\x00\x37\xd6\x02\x0f\x26" +
+		"\x81\x27\x62\xe0\xaa\x1a\xe0\xb8\x1b\xc1\x4e\x49\xe0\x51\x07\xbe\x31\x92\xe0\xe2\x8e\x34\x6a\x37\xad\xb7\x03\x93\xc0\x2d\x11\x70" +
+		"\x5b\x7b\xb0\x5b\x02\x38\x43\x7e\x7c\xeb\x5e\xf0\x50\x1b\x70\xc6\xa7\x04\x67\x4e\x73\xe0\xde\x7c\xf0\xf4\x00\x82\x27\x32\x0d\x68" +
+		"\x9f\xbc\x61\xcc\x58\x96\x5a\xd9\x40\x3a\xb8\x97\xc6\x7e\xd6\xa7\x80\xe3\x04\x0c\x99\xe0\xae\x78\xb0\x22\x53\x79\xdf\x41\x82\x93" +
+		"\x85\xd2\xc7\xc0\x23\x35\x74\x4b\xcd\x26\x38\x2e\x01\x6c\x6e\x08\x71\xf5\xe7\xe0\xd1\x42\x70\xd9\x1f\xc1\xfe\x05\xe0\x1a\xd9\x6d" +
+		"\x19\xca\x3b\x5f\x00\x7b\x37\x02\xcf\x3f\x0e\x4e\x4f\x05\x77\xc9\x8e\xcf\x04\x4f\xbe\x49\x70\x7d\x43\xf0\xa8\xac\xe0\xa1\x59\x0e" +
+		"\x4e\x70\x30\x04\x46\xc1\x83\x61\xf0\x84\xec\x8e\x09\x04\xbb\x4f\xa0\xc9\x60\x8b\xe3\xc0\xa7\xc1\xc5\x02\x32\x82\x9d\x64\x6b\x26" +
+		"\x81\x8b\xab\x81\x53\x13\xc1\x7a\xe0\xfa\x90\x4a\x11\xb7\x83\x87\xa3\xe0\x79\xd9\x1d\x19\xe0\x89\x44\xb0\x53\xb6\x52\xe9\xf2\x4c" +
+		"\xb0\x6f\x5d\xc5\x14\xa1\x57\x3d\x53\xc0\x6d\x8d\xc1\xed\xf2\x18\x21\x25\x71\x76\xfd\x23\x81\xdc\x9e\x24\x70\xd4\xcd\xe0\xa4\x9b" +
+		"\xc1\x5d\x49\xe0\xee\x62\xf0\x70\x43\xb0\x6b\x14\x9c\xd4\x00\x3c\xd3\x08\x3c\xf8\x00\x78\x71\x3a\xc1\xa5\x09\x86\x7b\xa7\x72\xc0" +
+		"\x2d\xdb\x09\x76\x9e\x4b\xf0\x5a\x75\xb0\x18\x3c\x55\x07\x1c\x52\x1d\x7c\x05\x1c\x26\x62\x53\x22\xb8\x2f\x03\x9c\x10\x06\x8f\xa4" +
+		"\x83\xcb\x43\xe0\xec\x78\x70\x46\x3a\xd8\x51\xe4\xba\x0b\x04\x57\x7e\x43\x70\x75\x1f\x07\xce\x8a\x07\xf7\xc5\xc0\x8f\x1d\xb8\xf0" +
+		"\x35\xf0\x9a\xa0\x50\x35\xf0\xf2\x2d\x60\xdf\xdb\xc0\xe9\x8d\xc0\x30\x98\x09\xce\x11\x62\xb3\xa6\x15\xd8\x4d\x16\xfc\x93\x95\x04" +
+		"\xfb\xf7\x23\xf8\xc6\x24\x82\x0b\x6f\x05\x8f\x6e\x25\x78\x25\x1b\x1c\x2f\x77\x6e\x7b\x01\x1c\x3c\x8c\xe0\x94\x1d\x04\xab\x81\xa3" +
+		"\x6a\x81\xbd\x1f\x05\x07\x95\x82\x2b\xa7\x10\x1c\x9f\x0f\x9e\x7c\x10\x5c\x56\x17\xbc\x22\x4f\xea\xf1\x2c\xf8\xf1\x3f\x83\xb3\xfe" +
+		"\x05\x5c\xf2\x30\x38\x77\x2a\xc1\x73\xaf\xcb\xc3\xc2\xe0\xa8\x35\x04\xb7\x54\x03\x3f\xbd\x19\xdc\xd6\x12\x9c\x94\x00\x7e\xfd\x35" +
+		"\xc1\x55\x02\x92\x5e\x77\x82\x57\xc7\x13\xbc\x14\x02\x27\xc4\x81\x6f\x87\xc0\x11\x69\x36\xf9\xcb\x2f\x80\x4b\x7e\x67\xe2\xe4\x98" +
+		"\x6c\x55\x41\x84\x4c\x77\x0a\x83\xb5\x8c\x2d\xc9\x96\xe9\xe3\x4c\xee\x90\x45\x9a\x20\x77\xde\x00\x4e\xa8\x05\x4e\xcf\x00\xaf\x44" +
+		"\x6d\x3b\x0d\x21\x78\xd8\x81\xcb\xc3\xe0\x9a\x98\x42\x5d\xf8\xc2\x85\x64\x23\x77\xd2\x75\x7a\x0c\x5c\x58\x17\x3c\x56\x1d\x9c\xf6" +
+		"\xaf\xe0\xd4\x62\x70\x76\x13\x70\x85\x50\x91\x19\xad\xc0\xb5\x89\xe0\xe5\x46\xe0\xac\xa1\x04\xdf\x5d\x40\x70\xff\x63\xe0\x88\xe7" +
+		"\xc0\xd9\xc2\xfe\x93\xc0\xe5\x8d\xc0\xf9\x49\xe0\x81\x5b\xc0\x35\xf1\xe0\x9c\x9e\x04\xc7\x3e\x0e\x76\x8d\x03\x6f\x52\xb6\x3a\x3d" +
+		"\x0c\x5e\x72\x8a\x77\xdd\x52\xc0\x2d\x71\xe0\x89\x10\xd8\x3b\x09\xdc\x9b\x06\x9e\x8e\x82\xd2\xe9\x82\x68\x0c\x04\xb7\x67\x81\x43" +
+		"\x44\xc6\xba\x11\x7c\xcc\xf6\xe7\xc6\x0c\x1b\x73\xff\x4c\x70\x77\x0d\x70\x61\x3d\x70\x55\x1d\xc3\x22\x21\xb0\x07\x04\x04\x11\x70" +
+		"\x5c\x32\x58\x21\xf3\x8b\x07\x45\x5b\x5b\x5f\x0d\xfc\x72\xb6\xd0\xa4\x44\xb0\x01\xd8\xe7\x16\x70\x87\x48\x46\x77\x18\x2d\x3f\x9a" +
+		"\x61\x96\x87\x8a\x9b\xec\x7b\xdf\xda\xe0\xf9\x10\x38\xf5\x56\xb0\xf3\x28\x21\x55\x11\xa3\xd6\x33\x1c\x78\x25\xd1\xf6\xd9\x04\x21" +
+		"\xa7\x51\xa3\xf0\x97\x08\x8e\x49\x06\x87\x24\x83\xdb\x13\x4d\x48\x3d\x5f\x0d\x3c\x2f\xec\xec\x36\x43\x9c\x8e\x04\x47\x24\x83\x8b" +
+		"\x13\xc1\xb3\x69\xe0\xca\x14\x70\x84\x60\x99\xa0\x7c\x04\xdc\x95\x03\x5e\x5d\xe1\xc0\x10\x78\x41\xe0\x90\x66\xcb\x2d\xbc\xfb\x06" +
+		"\x70\x61\x75\x65\x41\x57\x42\x60\x9f\x7c\x70\x53\x3c\x38\xb8\x3e\xb8\x29\x0d\x5c\xd8\x0e\x4c\x36\xc1\x77\x56\x36\x78\x48\x24\xce" +
+		"\x1d\xa2\xd2\xae\x9b\xed\x4c\x16\x7a\xc6\x84\x9a\x38\xf0\x48\x58\x75\xb4\x23\x26\x96\x55\x10\x14\xb6\xdc\x97\xe0\xa3\xfa\xfb\x85" +
+		"\x38\xe3\x5d\x63\x68\xe0\x9b\x1e\x0f\xa6\x83\x4f\x19\x4d\x09\x83\xf9\x60\x27\x07\x66\x81\x8d\x95\xb2\xac\xa4\xc9\x3f\xe7\x43\x26" +
+		"\xca\x75\x92\x69\x86\xc0\x49\x11\xb0\x77\xc8\xfe\x3e\xe8\xc0\xb3\x34\xca\x7d\x42\x3e\x65\x59\xa3\xe0\x40\x93\xb7\x7a\x86\x8c\xe4" +
+		"\x4e\xce\x31\x6e\xb1\x3e\xde\xb0\x79\x65\x54\x35\x9c\x7a\x86\x17\x13\x68\x78\x58\xc7\x74\x29\xc1\x66\x07\xbe\x04\xce\x75\xe0\x16" +
+		"\x19\x41\x86\x42\xe8\x7c\xd4\xf8\xf7\x6c\x82\xc3\x52\x6d\xa1\x65\x87\x1d\x7a\x16\xbc\xfc\x1c\xb8\x25\x0a\x6e\x73\xe0\xe9\x88\x0d" +
+		"\xf2\x9a\x3c\x29\x11\x5c\x36\xc5\x99\xa6\x36\x38\x4e\x79\xf1\x90\x0c\x70\x55\x03\x70\x5b\x53\xb0\xff\x0b\xe0\xb1\x24\x70\xf6\x9d" +
+		"\xe0\x27\x73\x9c\xf1\xdf\x11\x09\xe0\x11\x61\x72\xbd\x08\x9e\x7a\x14\xdc\x76\x1b\x78\x38\x0e\x1c\x3a\x9d\xe0\xd7\x22\x2d\xdf\x0b" +
+		"\x2e\x29\x04\x27\x3e\x04\x2e\x0c\x81\xc3\xbe\x20\xf8\xf6\x48\x82\x2b\x7f\x0f\xbe\xfb\x16\xc1\xa5\xb3\x08\x9e\x19\x27\xc8\x9d\x0f" +
+		"\x1e\xa5\xed\x28\xb1\x62\x0c\xcb\x06\x97\xca\x6a\x54\x03\xab\x81\x47\x12\x4c\x30\xee\x59\x1d\x5c\x5c\x1b\x5c\x5f\x0b\x3c\x2a\xf2" +
+		"\xe6\x93\xe0\x87\xc2\x4e\x8f\x09\x90\xab\x19\xdf\xde\x76\x23\x78\x8a\x60\x1a\x38\xa4\x01\xb8\x38\x1d\x9c\x5d\x1b\x1c\x11\x03\x87" +
+		"\xdd\x0b\xf6\xbc\x09\x3c\xd9\x10\x1c\xbe\x86\xe0\xf4\x5a\x60\xb7\x74\xf0\xf8\xbd\xfa\x9e\x95\x21\xf0\xc8\x4f\xc0\x93\xed\x8c\xc9" +
+		"\x2d\x8c\x03\x8f\xc5\x83\x67\x63\xe0\xda\x24\x70\x92\x0c\x2f\x1d\x3c\x91\x0c\x4e\x8e\x82\xc7\x12\xc1\xfe\x5d\x08\xf6\xfa\x13\x38" +
+		"\x39\x4b\x41\xd7\x3b\x0e\x5c\xf2\x23\x70\xd5\x63\x86\xd6\xeb\x33\xc0\x3e\xc2\xc1\xf3\xc0\x93\x42\xb3\xba\x65\x80\x5b\x5a\x83\xb3" +
+		"\x1f\x07\x4f\x15\x83\xbb\x8f\xcb\x62\x65\x81\x5b\xbe\x25\xb8\xff\x22\xc1\x51\xdf\x07\xb7\x3f\x0a\x76\x9e\x41\x70\xfe\x8d\xe0\xd8" +
+		"\x4c\x70\x56\x27\x82\xeb\x07\x10\x5c\x24\x3a\xf4\xde\x24\xb0\x53\x22\x78\xa4\x95\x09\xb3\xbb\x42\x36\xfe\x11\xed\xc1\x71\x21\x70" +
+		"\xb0\x08\x61\x0f\x83\xe3\xa7\x10\x1c\xf3\x21\xc1\x2f\x5e\x27\xb8\x4c\x56\x79\x78\xc4\x04\xb3\x2d\xf5\xc0\xe9\xb9\x86\x0e\xbf\x05" +
+		"\x7b\x27\x82\x43\xd2\xc1\x7d\xbf\x04\xb7\xdc\x03\xbe\x2e\x1d\x7a\xca\x1b\xfb\x1d\x24\x78\x7e\xa9\x0c\xa0\x00\xdc\xd9\x8f\xe0\xee" +
+		"\xbb\xc0\xb7\xca\xc0\x11\x51\x70\x7b\x32\xb8\xaf\x00\x3c\x55\x0d\xdc\x1e\x03\xc7\x88\x74\x95\x04\xf6\xae\x07\xae\x15\x61\x2f\x0e" +
+		"\x3c\x5a\x03\xdc\x21\xa4\x23\x1f\xdc\x9b\x61\xfc\x75\x61\x3a\x38\x23\x0f\xfc\x40\x86\x77\x2d\x04\x2e\xcf\x00\x8f\xbf\x00\x06\x72" +
+		"\x56\x05\xc1\x0b\x51\xa3\x63\x49\xe0\x41\x91\x23\x43\xe0\xa8\x04\x63\x94\x21\x55\x70\xc7\xc4\x99\x2a\x78\x2c\xa2\x4a\xd4\xae\x88" +
+		"\x6d\x19\xe1\xe3\x5b\x12\xc0\xf1\xf5\xc1\x09\x37\x83\xb9\x4a\x7c\xe5\xa6\x8a\x54\xb0\x08\x9c\x24\x1b\x32\x17\xec\x96\x6d\xc8\x25" +
+		"\x96\x92\x36\x60\x5d\xf0\x54\x0a\xb8\x9c\xc6\x74\xd6\x88\x0a\x11\xd2\x3d\x1f\xa7\x76\xac\x55\x34\x36\xdc\x10\x3c\x50\x1f\x3c\x90" +
+		"\x00\xbe\x3d\x97\xe0\x07\xbf\xb7\xbd\x3e\x86\x60\xd7\x78\xb0\x77\x03\xdb\xa4\xc3\xaa\x83\x62\xe5\xea\x94\x02\x2e\xcd\x30\xf8\x8c" +
+		"\x4c\x00\xbb\x66\x19\xf7\x9f\x1e\x36\xf1\xe2\x2c\xc1\x71\x35\xc1\xfb\xc1\x53\x22\x31\x45\xc1\xfe\x8d\xc0\xa1\xb2\xc1\xf6\xad\x15" +
+		"\xcc\xb8\x4a\xb0\xef\x4c\x82\x13\x7e\x00\x7e\xd6\x8d\xe0\x95\x1f\x81\x1f\x0b\xec\x16\x9f\x24\xb8\x79\xac\xa0\xc5\x0d\x60\xcf\x34" +
+		"\x23\x4b\xa2\xe7\xcc\x0d\x19\x0a\x9e\x68\x0e\x8a\xa4\x78\x3a\x07\xdc\xf5\x28\x38\x60\x35\xc1\x95\xe3\x69\x0c\xfd\x93\x69\x04\xcb" +
+		"\x1f\x03\xb7\x3d\x07\x5e\x1c\x4c\x70\xdb\x58\x82\x3b\x73\xc1\x03\xa9\xe0\x97\x2b\x08\xee\xb8\x19\x5c\x5b\x0b\xbc\x32\x51\x10\x42" +
+		"\x36\xf2\xb8\xdb\xc0\x51\xb5\x8d\x3b\x08\x3a\xce\x68\x04\xee\x91\xad\x12\x01\x3f\xac\x07\xae\xa9\x0f\x0e\x10\x82\x39\x37\x09\xec" +
+		"\x78\x13\x38\xab\x11\xb8\xad\xae\xc1\x63\xfa\xab\xc6\x3a\x44\x1d\xec\x71\x17\xb8\x7a\x98\x4c\x34\xc5\x28\xf0\xb7\xed\xc1\xb9\x59" +
+		"\xe0\xe1\x3c\xb0\x6b\x4d\x63\x7e\xb3\xc4\xf6\x53\x0b\x3c\x15\x32\x11\xf9\xb2\x20\x6e\x13\x93\x87\xba\xdd\x08\xbe\x31\xc6\x81\x15" +
+		"\x82\x61\x0e\x9c\x9c\x07\xf6\xcd\x03\x0f\xe6\x80\x47\x7e\x08\x4e\x7a\x1a\xdc\x20\xba\xc9\x20\xb1\xef\x88\xd9\x27\xd7\x3e\xf7\x88" +
+		"\x49\x5f\x14\xee\x7b\x4c\x28\x3f\x2a\x1a\x73\x91\xae\xb7\xa0\xc1\xc0\x30\x58\x11\x31\xc5\xf4\x29\xf0\x60\xa2\x89\xe4\x22\xcb\x2f" +
+		"\x4e\x30\xa2\x20\xea\x44\x79\x18\x9c\x1d\x56\xfc\x99\x5d\x1d\xfc\x28\x13\x9c\xd7\x43\xd4\x6d\xc1\xb1\x78\x50\x0c\x9f\xe3\x0a\xc1" +
+		"\x0b\x62\x88\xb9\x01\xdc\x53\x0d\xec\x2f\x62\x5e\x75\xb0\xeb\x2d\xe0\xa6\xfb\xc1\x13\x0e\xfc\xf4\x32\xc1\x8a\xea\xe0\xc6\x28\x38" +
+		"\xae\x1d\x38\xb8\x31\x38\x26\x03\x1c\x1f\x01\x17\xa8\xa5\xe2\x29\x70\xdd\x6b\xe0\xac\xdd\x04\x17\xe6\x05\xb3\x3a\x58\x0c\x6e\xaf" +
+		"\x0b\xf6\x6e\x6b\x78\x7c\x56\xf4\xec\x5f\x80\x67\x84\x81\xaf\x6d\x0e\x96\xd7\x30\xc9\x67\x76\x82\x31\x69\xc5\x4c\x91\x35\xf3\xc0" +
+		"\xa9\xd9\xe0\xe7\xaf\x82\x93\xd6\xd3\x44\xfe\x6b\x35\xc0\x65\x83\x9d\x1a\x5e\xd6\xb4\x06\xc7\x54\x03\xe5\xdd\x9d\x6a\x81\x1f\xdc" +
+		"\x09\xce\x7f\xd5\xb8\xf6\xb9\x32\xf0\xd4\x1f\x83\xdf\x32\xc1\xe1\x9f\x11\x3c\x20\xa0\xc8\x02\xe7\x67\x1a\x0b\xaf\x10\x9a\x24\x4f" +
+		"\xee\x18\x03\x77\x0f\x74\xa6\x24\xc8\x8e\xee\x4a\xc5\xf6\xf5\x01\x8f\x5a\x4c\xf0\x66\x70\xa3\x98\xb0\x52\xc0\xa9\x34\x13\xc9\xd9" +
+		"\x40\x85\x4a\x07\xc3\xda\xca\xf0\x93\xed\xf7\x51\xce\xc4\x8a\x28\x38\x3d\x60\xb5\x37\x9b\xba\x1b\xd5\xb5\xe8\x4b\xb0\x4f\x18\x5c" +
+		"\x1b\x06\x2f\xc7\x81\x8d\xc0\xde\x51\x53\xfe\x3a\x05\xc6\x10\x59\xc1\x5b\x8c\x53\x2f\xaf\x66\xdb\x66\x7e\x1c\xb8\xd6\x99\xa1\x67" +
+		"\x60\xc0\xef\x9e\x00\xa7\x8a\x70\x25\x72\x77\x0a\xd8\x31\xc5\x78\xce\x15\x07\x4e\x92\xc1\x38\xc3\x89\xdb\xc1\x26\x60\x2b\x70\xaf" +
+		"\x88\xb1\x04\x7b\x66\x98\xf1\x65\x46\xd8\xd0\xad\x5b\xd4\x26\x3b\x74\x82\x09\x3b\x8d\x54\x29\x92\xc7\xcd\x12\x10\x54\x37\xba\x37" +
+		"\xc3\x99\xc8\x31\x35\xd9\xc4\xac\xb3\x69\xc6\xa6\xab\xdb\xfe\x3e\x5c\x1d\xbc\x2c\x8a\x70\x06\x38\xf9\x66\xf0\x7c\x0e\xd8\xb3\x86" +
+		"\x49\x95\x9f\x9e\x0b\xe8\x4f\x7f\x11\x1a\x47\xef\x92\x79\x65\x9b\x25\x6f\x7a\x0d\x70\x53\x21\xb8\x26\xc1\x8c\x6e\x47\x6b\x81\x67" +
+		"\xef\x00\xfb\xb5\x35\x26\x73\xfc\x43\x82\x5d\x8a\x4d\x42\xf8\x29\x38\xae\x3a\x38\x2b\xd3\x04\xc0\x6c\x70\x76\x2a\x38\x30\x02\xae" +
+		"\x8c\x07\x3b\x45\x4d\xf4\x58\x13\x35\x46\xb2\x30\xd5\x08\x58\x79\x2d\xb3\xf9\x6d\x8c\x03\xaf\x05\x32\xfa\x90\x14\xf0\x40\xba\x41" +
+		"\xfd\x82\x10\x82\x34\x5b\xb8\x83\xd5\x0c\xb4\xd3\x45\x62\x13\x8a\x97\x09\xbe\x2d\xba\x48\x2c\xb0\x29\xd6\x30\x3e\xbe\x54\x0c\x6d" +
+		"\x4f\x81\x0b\x9e\x04\xc7\xf4\x27\x78\xa9\x00\xbc\x90\x65\x76\xc2\xfe\x61\xf0\xcd\xdf\x81\xdb\x5b\x81\x67\x8b\xc1\x0d\x39\xe0\xa7" +
+		"\x5f\x12\x1c\x90\x02\xee\x3b\x43\x70\xd1\x57\x04\xfb\xa6\x80\x6b\x72\x8c\xe8\x0e\x2e\x00\xa7\x56\x03\xa7\xd7\xb4\x77\x4e\x4e\x01" +
+		"\x47\x15\x80\xe3\x6a\x1b\xf6\x8b\xa5\x24\xcd\x76\xbf\x12\xd9\x8d\x6f\x13\xfc\xe6\x02\x8d\xf4\xf7\xce\x07\x0f\x8b\xc9\x6f\x30\xc1" +
+		"\x23\x75\xc1\xdd\x02\xb5\xb9\xa9\xe0\x28\x61\xc2\xcf\x81\xe3\xfe\x19\x9c\x26\xbe\xa4\xe6\xe0\xb9\xa7\xc1\x7e\x8d\xc0\xce\x05\xe0" +
+		"\xfb\xcd\xc1\xa9\xf2\xc0\x83\x99\xe0\x27\x2d\x4d\x79\x3a\x1c\x58\xc7\x0f\xd6\x04\xf7\x66\x81\x1b\x45\x7d\x12\x25\x2f\x19\x7c\xce" +
+		"\xe4\xb7\xc9\x34\xb9\x7b\x5e\xc8\x60\xb9\x2a\x6a\xf4\x63\x4f\x60\xd5\x59\x73\x03\x38\x23\x06\x6e\x18\x4e\x70\xc8\x72\x9a\xba\xb0" +
+		"\x32\x0c\x9a\x7a\x2e\xcb\x78\x4c\x0c\x7d\x37\x28\x68\x47\x7e\x40\xb0\xe2\x41\xf0\x74\x4d\xf0\x58\x07\x70\xe8\x41\x82\xfb\x84\xcb" +
+		"\x86\x4d\xad\xdf\x47\xb3\x8d\xac\xb9\x0b\x9c\x9d\x68\x7a\xa8\xb0\xde\xc1\x49\xe0\xaa\xc6\xe0\x69\x67\xb8\x7d\x85\xe0\xf1\x0e\xe0" +
+		"\xfb\x43\x08\x8e\xbb\xdb\x56\x58\x44\x93\x8f\x22\xe0\xe4\x11\x04\x3f\x6a\x15\x98\x52\xee\x30\x13\xdf\x17\x4f\x83\x53\xd3\xc1\xcb" +
+		"\x99\xe0\xfe\xcf\x68\x76\x26\x41\xc5\xdb\xc1\xae\x37\x82\x4b\x09\x36\x00\x7b\xc7\x9b\xc5\xbd\x8d\xd9\x1e\xd7\xa4\xe8\xd7\xc9\xe9" +
+		"\xc6\x30\x7e\x06\xbe\x51\xdd\xa8\xfb\xe1\x78\x55\x8f\x0e\x35\x06\x8f\x3e\x68\xd6\x95\x3d\x09\xe0\x01\x9a\x81\x60\x9c\xc8\xd1\x0e" +
+		"\x6c\x09\xee\x11\xc6\x92\x01\x2e\x17\x05\x25\x6c\x8a\x3b\xc1\x13\x66\xb6\x10\xcf\xc3\x8e\xec\x40\xe7\x13\xfa\x79\xf4\x19\xf0\x4a" +
+		"\x3c\x38\xf4\xcf\xe0\xc2\x51\x04\x47\x65\x80\x8b\x53\xc0\x75\x19\x60\xe7\x21\x34\xf3\xf9\x74\xb1\xb2\xe6\x82\x93\x52\x40\x82\x0b" +
+		"\xc5\x6b\x76\x13\x78\xc2\x9e\xdd\x31\x66\x94\xb1\xaf\x33\xfd\x4e\x3e\xdb\x81\x5d\xeb\x98\xd9\xb3\x77\x13\xf0\xbd\x1b\xc0\x6b\xb7" +
+		"\x83\x67\xe6\x3b\x53\xe4\xa6\x1b\x91\x9a\x99\x0a\xae\xcc\x32\x55\x71\x25\xc1\xbd\x4f\x82\x87\x1e\x31\x1a\xd1\xeb\x26\xb0\x67\x73" +
+		"\x30\xdf\x80\x73\x3f\xf8\x46\x60\x60\x17\xe4\x9d\x2f\xaa\x77\x18\x9c\x52\xd7\x94\xaf\xc7\xc1\x19\x99\xe0\xa8\x24\x70\x9e\x9a\xcb" +
+		"\x04\x49\x46\x84\xc1\xb9\x62\x60\x4e\xb4\x1d\xf8\xb4\xf1\xcd\x2d\x49\x66\x35\x0c\x9b\x2a\x20\xba\x41\x7f\x01\x4c\x3a\x38\x26\x6a" +
+		"\x76\x16\x11\xf4\x2a\x62\xe0\x89\x24\xb0\x8b\x00\xab\x4f\x6d\x33\x69\x54\xbc\x4e\x70\x55\x0a\x38\xa1\x36\x38\x23\x19\x5c\xf0\xba" +
+		"\x33\x82\x3c\x21\xde\x48\xe3\xa5\xa6\xa6\x2c\x4f\x15\xb9\xbc\x26\xd8\xfb\x35\xb3\x76\xbd\x29\x37\xf6\x2e\x02\x77\xad\x20\xf8\xf5" +
+		"\x50\x82\x03\x7f\x01\xbe\xf9\x0e\xc1\x6e\x1f\x13\x5c\xb6\x97\xe0\x90\x7b\xc1\x8f\x6f\x04\xeb\x81\x4b\x1d\xd8\x35\xdf\x80\x33\x70" +
+		"\x1c\xc1\x1e\x5d\x9d\xf9\xb8\xf2\xc1\x9e\x3f\x04\x57\xbe\x0c\x8e\xdf\x4e\xd3\x54\x47\x45\xc0\x81\xb5\x4c\xcc\xd8\x5b\x1d\x3c\xfa" +
+		"\x2b\x70\xd9\x93\xe0\x8e\xf7\xe4\xf9\x31\xa3\xf2\xef\x88\xfa\x73\xa4\x00\x3c\x9d\x0a\x8e\x93\x45\x5b\x22\xc0\xba\x12\x07\x4e\x8d" +
+		"\x33\x4a\xb7\x43\x34\x0b\x51\xf3\x32\x4c\x25\x15\x02\x7f\xe0\x07\x36\x45\xd1\xdb\x4e\x65\xd9\xb2\xf6\x49\x51\x6d\x72\x7e\x0d\x70" +
+		"\xcc\x8d\xe0\x77\x49\xe0\x57\xef\x09\x15\xcc\x04\x4f\x65\x82\x15\xdd\x08\xae\xfc\x17\xf0\x5a\x0e\xb8\x27\x0b\x9c\xb7\x98\x60\xff" +
+		"\x38\x70\xe2\xef\x6d\x9b\x75\xaa\x01\x4e\xa8\x69\x42\xec\x73\xe0\x88\xfa\xb6\xed\x8f\xa4\x81\xc7\x7e\x0a\xae\x6c\x00\x9e\x7c\x8b" +
+		"\xe0\xe5\xe7\xc1\x1e\xb5\xc1\xd1\xab\x09\x8e\x91\xf9\x0e\xdd\x48\x90\xe0\xea\x74\x70\x98\x10\x81\x0f\xc4\x7e\xbc\x9b\x60\xef\x91" +
+		"\x04\xbf\x90\x27\xee\xf9\x3d\x38\xf9\xc7\xe0\x3a\x01\xf4\x42\x99\xe5\xd5\xa7\xc0\x51\xe3\x09\x0e\x10\x50\x1c\x91\x85\x3c\x29\x84" +
+		"\x73\x69\x26\xb8\x37\x0a\x2e\x8f\x03\x97\xde\x6a\x4a\x9c\xd8\x82\xb7\x54\x07\x27\x44\xcd\x68\x30\x4e\xc8\x83\xd8\x64\x92\x4d\x0e" +
+		"\x1a\x93\x00\x96\x07\xb8\x5e\x91\x0d\xf6\x4f\x05\x7b\xd6\x01\xbf\x3a\x43\xd3\x04\x4e\xd5\x00\xf7\xd5\x30\x50\x6e\x4f\x05\x8f\x85" +
+		"\xc1\x72\x31\xdf\x76\x00\x57\x1f\x23\xd8\x49\x46\xfa\x65\x27\x82\x3b\x6a\x1b\x5c\xc4\x6a\xbc\xf1\x06\x70\x52\x3d\x70\x63\x9a\x39" +
+		"\x19\x24\x7c\x63\x6d\x26\xb8\xa7\x2e\xb8\xf7\x26\xf0\xa3\x97\x03\xa3\x48\x16\x38\x43\x66\x71\x13\x38\xf8\x56\xf0\xc3\x87\x8d\x9b" +
+		"\xad\x2d\x00\xdf\x19\x2d\x93\xfa\x1d\x38\x7e\x1c\x4d\x2c\xd9\x54\x04\xf6\xae\x06\x2e\x4c\x02\xaf\xa5\x98\x72\x70\x2a\x03\xec\xfa" +
+		"\x0c\x38\x38\x01\xec\x26\xba\x78\x2e\xb8\x3e\x02\x2e\xac\x19\x38\xf8\x47\x64\x83\xdd\x92\xc1\xcb\xad\xc0\x03\x71\xe0\x8c\x1b\xc1" +
+		"\x4f\x54\x6c\x4b\x31\xd3\x64\xc7\x54\x70\x7b\x0d\x70\x65\x82\xd2\xb5\x61\x34\x89\xb4\x8d\x2a\x2b\x7d\x09\x5e\x49\x30\x17\xc0\x98" +
+		"\x98\x19\x3b\xc6\xdc\x68\x62\xc8\x96\x24\xa3\xb5\xe7\xeb\xda\xf0\x5e\x03\xaf\xdc\x60\xbc\xeb\x76\x70\x5b\xd4\x0c\x05\x9b\xd2\x6d" +
+		"\xc0\x03\xa3\xe0\x9a\x0c\x70\x99\xe8\x31\xe3\xda\x80\x0b\xef\x07\x97\x7c\x0f\x3c\x22\xce\xbd\x5a\xe0\x09\xc1\xf2\x9a\xb6\xb5\x87" +
+		"\xca\xfa\xbe\xf5\x20\x78\xf8\x2e\x13\x5a\x0e\x0e\x96\xe5\x8f\x81\x57\x5f\x02\xaf\x3d\x0b\x7e\xb7\x8f\xe0\x96\x56\xe0\xd0\x6a\x60" +
+		"\xef\x1a\xe0\x57\xcf\x81\xdb\xfe\x0c\x4e\x94\xcd\x23\x8b\xf2\x4e\x43\xf0\x50\x2d\x70\x79\x07\xf0\x9d\x49\x34\xaf\x51\x8f\xc7\xc1" +
+		"\x21\xe2\xf9\x4a\x00\x3b\x76\x23\xd8\xb9\x11\xb8\xec\x15\x70\xd6\x45\x99\xd9\x6d\x60\xaf\xc5\xce\xa8\xf0\xb0\x30\x58\x04\x0e\x13" +
+		"\x27\xab\x70\xe8\x29\x9f\x11\x9c\x9f\x0a\x9e\x48\x31\x7a\x7c\x9a\x60\x13\x33\x44\x3f\x00\xee\x89\x03\xb7\x25\x18\x48\xa7\x3a\xd3" +
+		"\x9d\x04\x62\xbd\x0b\xc1\xde\x61\xe3\x40\x93\x53\xc1\x4d\xd5\xc0\x0b\x79\xe0\xda\x90\x51\xe8\x11\xf1\x26\x52\x1e\xa3\xb1\xcf\x13" +
+		"\xc5\xe0\xd6\xb5\x04\xdf\x2e\x00\xfb\x3b\x5b\x0c\x21\xd3\xa3\xf2\xc0\xe9\xb2\x53\x7e\x02\x6e\x49\x01\xa7\xc9\x8b\xdf\xfc\x25\xd8" +
+		"\x5b\x2c\x64\x19\xe0\xb6\xc9\x04\x97\x3b\x50\xe2\x77\x06\x75\x16\x12\x51\xd3\xa0\x27\xce\x6f\x09\xee\x59\x7b\x27\x38\xa9\x0d\xf8" +
+		"\xf9\x4d\xe0\xf2\xc7\xc0\x8d\x09\xe0\xd9\x9b\xc0\xf7\x47\x10\x9c\xd7\x95\xe0\xaa\x17\xc0\xc3\xa9\x66\xfd\x1d\xf8\x06\x03\x09\xb8" +
+		"\x3c\x09\x5c\x9f\x06\xde\x63\xb4\xe3\x68\x14\x3c\x9c\x6c\x3b\xa6\x42\x4c\xed\x35\xc1\xd9\x82\x14\xcd\xc0\x61\x75\xc1\x4d\xc9\xb6" +
+		"\x61\x46\x25\x82\x9b\x9f\x07\x1b\x5a\x94\x04\x2f\x09\x78\xf3\xcc\xdb\x2a\xc2\xd0\x77\xdf\x03\xfb\xc4\x99\x9b\xa2\x0d\xf8\x0c\x38" +
+		"\x2a\xd9\xe8\x64\x82\xa9\xd7\x82\x79\x2b\x65\x4b\x09\x06\x24\x9b\x1e\x3b\x23\x62\xb7\x8c\x0b\x99\x08\xd7\x16\x3c\xdd\x1c\xbc\x5a" +
+		"\xc7\x24\xb3\x89\x21\xf3\xbe\x8b\x0a\xff\xe5\xbd\x60\xc5\x23\xe0\xd2\x98\xf1\xd6\x2c\x50\x68\x42\xc4\x28\xe4\xeb\xd3\x08\x1e\x11" +
+		"\x48\xf7\x0c\x1b\x62\x6f\x4f\x33\x2b\x67\x79\x9c\x99\x28\x8f\xc6\x83\x42\x14\xd2\x8c\x57\x8a\x9a\xdb\x35\x01\x7c\xb7\x1a\x38\xa0" +
+		"\xbf\xb3\x98\x80\x53\x61\xf0\x44\x2a\x38\x38\x0d\x9c\x25\x4a\x98\x6c\x8a\x02\x70\x72\x43\x83\xd1\x88\x6c\x9b\xd5\x88\x42\x93\xc3" +
+		"\xd6\xed\x90\x3d\x21\x3a\xd0\x9b\xe2\xab\x9d\x51\x08\x96\x82\xdb\x9a\x83\x0b\x33\xcc\xef\x20\x76\xcf\xf5\x09\xe0\x58\xb1\xb9\xe4" +
+		"\x82\x9d\x7e\x01\x7e\x54\x41\x33\x46\x6e\x2d\x03\xfb\x8e\x11\xec\xf8\x3e\xf8\x51\x53\x70\x97\x28\x06\x03\x9e\x05\x37\x0c\x23\xd8" +
+		"\x4d\x9e\xfe\x71\x39\x83\x58\x0a\x91\xb4\x44\x0b\x4d\x37\x3f\xff\xb0\x3f\x83\x9b\x52\x4d\x72\xec\xfc\xa6\xe0\x9a\xd8\x97\x04\x0c" +
+		"\xdd\x0f\x11\x9c\xfb\x29\xc1\x45\x57\x08\x7e\x95\x6c\x9b\x6d\xdf\x26\x9a\xa1\xf9\xf8\x73\xe0\xa2\xa6\xe0\x98\x65\x04\xb7\x4e\x24" +
+		"\x38\x6e\x05\xc1\xfd\xbf\x31\x34\x5d\xd6\x8d\x60\xdf\x87\xc1\x6b\x03\x64\x10\xf9\x60\x8f\xc9\x04\x47\xbf\x0c\x1e\x28\x09\xd6\xe3" +
+		"\x7d\x82\x1f\x9e\x23\x38\xe2\x0b\xc1\xdb\x42\xf0\xc0\x0d\xe0\xb9\xe1\x04\x37\xd6\x04\x0f\xfd\xdc\xc4\x9a\x85\xf9\xa6\x2b\xec\x3b" +
+		"\x45\xf0\x73\xb1\x10\x8c\x10\xbf\x45\x0d\xb0\xe3\x14\x82\x3b\x03\x0e\x33\x54\x78\xef\x1f\xc0\xfe\x02\x8b\x1e\xdf\x07\xcf\xc5\x81" +
+		"\xdf\xdc\x07\x76\x8a\x58\xe8\x80\x10\xac\x6d\xc5\xe0\xe4\x5b\xc0\x77\x16\x10\x3c\xdb\x06\xfc\xac\x0d\x38\xa6\x1e\x78\x2e\x6c\xf6" +
+		"\x8e\xd9\x2d\xc1\xfe\xcd\xcc\x66\xf0\x3c\x28\x2a\xe4\x91\xe6\xe0\x1c\x51\x30\x93\xc0\xfd\x63\x68\xf2\x9f\x68\x20\xb3\xfb\x11\x1c" +
+		"\xd9\x2d\xf0\x24\xce\xa7\x09\xa4\x57\x52\xc1\x4f\x5e\x06\xf7\xf5\x97\x0b\xc9\xe0\xb5\x9f\x81\x67\x64\xcb\x8e\xba\x03\x9c\x9d\x66" +
+		"\x6a\x97\x6c\x92\x19\x09\xe0\xa4\x3b\xc1\x93\xd5\x2c\x8e\xa7\x0e\xb8\xaf\x05\xb8\x36\x01\xfc\x4e\x78\xdf\x2e\x31\x4c\x44\x6d\x00" +
+		"\x7b\x43\xe0\x41\xf9\x3b\x02\x9e\x7f\x06\xec\xbe\x99\xe0\x5e\x69\xfa\xd7\x03\x47\xd7\x00\xdf\x2b\x00\xe7\x94\x80\x12\x50\x54\x5e" +
+		"\x08\x9e\xfb\x88\x66\xe6\x13\x97\xfe\x91\x3b\xcd\x5b\x74\x21\x03\x3c\x22\x26\xb3\x40\xb5\x15\x11\x6d\x58\x0a\xb8\xe9\x66\x70\xe8" +
+		"\x38\x9a\x0c\xbb\x31\x09\x9c\xdc\x04\x1c\x95\x05\x4e\x7d\xd8\x8c\x2f\x5b\x67\x39\xf0\xe8\x04\x82\x57\x93\xcd\xd3\x34\x22\x03\xbc" +
+		"\x9a\x09\x2e\xbb\x0b\xec\x55\x04\xee\x10\x40\x7c\xdd\x08\xcc\x03\xbf\x7c\x93\xe0\x29\xa1\x37\x53\xe4\xdb\xde\xda\xe0\xc2\xe7\xc1" +
+		"\x09\x37\x81\xef\xbd\xeb\xc0\xe5\x2b\x68\xd2\xc2\x11\xd1\x4a\x7f\x05\x76\xab\x05\x7e\xfa\xb6\x33\xc7\xea\xc0\x85\x04\x67\x7c\x0f" +
+		"\x7c\x73\x84\xe8\xae\x49\xe0\xc6\xb0\xf9\x1a\x73\xc1\x1d\x0d\xc0\x8d\xd9\xe0\xfa\x38\x93\x60\xae\x05\xb1\x33\x37\x58\x74\xc3\x95" +
+		"\xb0\x45\x2a\xec\xa9\x03\xee\x48\x05\x2f\xc7\xc0\x8e\x35\xc1\x75\x77\x83\xe5\x0f\x8b\x50\x47\x70\xdb\x10\x9a\x30\x70\x68\x47\x10" +
+		"\x07\xd4\xa9\xa5\x45\x7b\x88\x9b\xf0\x48\xa1\x69\x73\x6f\x6c\x36\xed\x7e\x72\xc8\xcc\xf7\xdd\x32\x0d\x96\x27\xc2\xe0\xf4\x88\x0a" +
+		"\xa6\x4f\x81\xeb\x93\xc0\x6e\x35\xcc\xaa\xb7\xb1\x8e\xa9\xc6\x6b\xe2\xcd\x88\x7a\x24\xd5\x8c\x79\x22\xd5\xf7\xcc\x07\x87\x89\x6c" +
+		"\x21\x5c\x39\x15\x5c\x5a\xd3\x14\xfa\x91\x8b\x08\xee\x69\x07\x2e\x99\x4e\x70\x7d\x63\xf0\x80\xa8\xd2\xb5\xcd\x66\xb1\xf0\x46\xdb" +
+		"\x2b\x15\x05\xe0\x9e\xd7\xc0\xcf\x3e\x0d\x24\xc9\x45\x43\x09\x8e\xeb\x4e\x70\x95\x6c\xb0\x5e\xb2\x37\x4e\xc9\x0a\x4c\x92\x10\xb0" +
+		"\x14\x70\x5e\x97\x40\x70\x5f\xd8\x01\x5c\x7b\x03\x78\xe2\xc7\xe0\x3b\x23\x09\x5e\x6c\x01\x1e\x10\xf6\x22\xbd\xc7\xe6\x80\x7b\x1f" +
+		"\x04\x4f\x9e\x20\x78\xec\x4e\x70\x5f\x43\xb3\x0b\x89\xa3\x7c\xb9\x58\xe2\xc4\x8d\x27\xea\x47\xbc\x61\xe6\x7b\x73\x69\x6a\xfb\x89" +
+		"\x1f\x80\x43\xd2\xc0\x0a\x09\x76\xb8\x0b\xfc\x57\x70\x4a\x0b\x70\x8c\xc8\x53\x3f\x01\x77\x0e\x17\x91\xb3\xa1\xe9\xee\x62\x62\x3b" +
+		"\x2b\xf6\xa0\x17\xc0\x87\xc1\x2d\xb7\x82\x3b\x37\x10\xdc\xd4\x0a\xdc\xaa\xf2\xb2\xf8\x45\xea\x9a\x0b\xe2\x42\xd8\xb4\xef\x0f\x1a" +
+		"\x58\x70\xd8\x2c\x67\x6b\x23\x8a\x4f\xa7\x64\x85\x7b\xef\x90\x05\x62\x88\x3e\x74\xa0\x0e\xb8\x3d\xdb\x98\xee\xb5\x90\x69\x27\xdd" +
+		"\xe2\x4c\xf8\x1c\xe6\xc0\x2d\x05\xca\x8c\xa7\x0c\x11\x9d\xef\xc7\xe0\x85\x1c\xf0\xf4\x23\x60\xd7\x44\xb0\x6f\x18\xbc\x22\x42\x96" +
+		"\xec\x95\x71\xcd\xc0\xc9\x4b\x08\x7e\xf2\x80\x61\xc7\x9b\xbb\x09\x2e\x4f\x01\xcf\x17\x83\x1f\x88\x2d\xbf\x0e\x58\xde\x1e\xdc\x5e" +
+		"\x1b\x1c\x96\x67\x7e\xae\xd1\xbf\x01\x17\xdd\x61\xc1\x2d\x6b\x6e\x06\xb7\xbe\x0c\xce\xee\x4d\x70\xdb\x38\x82\x83\x26\x38\x93\x2c" +
+		"\xce\x26\x98\x39\xe1\x60\x1d\x8b\xaf\xd8\x75\x3b\xb8\xf3\x31\x70\xae\x0c\x73\x32\xc1\x3f\x82\x93\x7f\x09\x6e\x5d\x4d\x70\xfa\xbf" +
+		"\x82\xdd\xd7\xd1\xec\x38\x22\x4f\x0d\xac\x09\xae\xc9\x56\x44\x17\x8e\x76\xbe\x00\x5c\xf4\x0c\x38\x7d\x2f\xc1\xc1\x77\x19\xe7\xdb" +
+		"\xfc\x4b\x70\xd8\x6f\xc0\x1e\x32\xe9\x9e\xb5\xc1\xc9\x2f\xd9\x6a\xa8\xb2\xd7\x71\x20\xc1\x5d\xcd\xc0\x01\xf5\xc0\x93\x53\x69\x0f" +
+		"\xb9\xf2\x14\xd8\xb1\x9e\x09\x7a\xe3\x7e\x00\x16\x1b\x03\x1d\x2a\x1c\xa4\x7f\x32\xb8\xbc\x16\x38\x23\x03\xdc\x75\x90\xe0\xc8\x91" +
+		"\xf2\xea\xb5\x04\x27\xdc\x0d\x7e\xf8\x15\xcd\x20\x38\x29\x1f\x2c\xaf\x0f\x1e\xbc\x1f\x3c\x9d\x0d\x8e\xfd\x31\xb8\x7a\x38\xc1\xa3" +
+		"\x59\x60\x79\x1d\x23\x77\x6b\xd3\x4d\x1a\x17\xe1\x6d\x7b\x1e\xd8\x43\xf6\xd6\x4f\xcc\xe3\xd6\x29\xce\xb6\xea\x8c\xb0\x61\xaf\xf8" +
+		"\x52\x09\xbe\x68\x02\xd4\xdc\x34\xb3\x2b\x4e\x8f\x37\x8e\x3c\x4e\x02\x5c\x6e\x00\xb3\xc1\x19\x69\x66\x26\x3c\x7b\x83\x79\x72\x26" +
+		"\x07\x76\x7a\x31\xac\x4f\x4d\x30\x23\xbb\x3c\x74\x79\x02\x38\xbf\x1a\xb8\x2b\xc1\x74\xe4\x13\x81\xa9\x67\x81\xc8\x75\xe3\x68\xbb" +
+		"\x5e\xb9\x7b\x86\xb1\xd4\xc9\xe9\x70\x73\x23\xe0\xc6\x44\x8b\xf5\x52\x9e\x17\xd8\x48\xbf\x7b\x14\x1c\x31\x9d\x60\xdf\x98\xb9\xf3" +
+		"\x66\x88\xa0\x1e\x51\x59\xe5\x5a\x16\x78\x34\x06\x8e\xed\xe6\xc0\xc1\x7d\x1c\xf8\x8e\x08\x05\x9d\x13\x03\xa3\x5d\x75\xb0\x7f\x13" +
+		"\x53\x2f\x8e\x34\x02\xaf\x65\x58\x34\x98\xc8\x5d\xab\xaf\x10\x5c\xfc\x4b\x70\x4e\x18\xec\x71\x3f\x38\x58\x04\x58\x71\x32\x34\x07" +
+		"\x47\x3c\x09\xbe\x37\x9f\xe0\xfa\x7e\x32\xec\x7b\xc1\x6b\xff\x0c\xee\x92\xfd\xbb\x29\x05\xdc\x3f\xd4\x19\x2d\xdc\x5b\x08\x1e\x89" +
+		"\xb3\xd8\xb9\x75\x3f\x03\x0f\xfc\x18\x7c\xa3\x31\xd8\xbb\x17\xc1\x5e\xcf\x82\x93\xb7\x10\xdc\x29\xd3\x99\xb4\x95\xe0\x97\xb2\x0a" +
+		"\x27\x9f\x31\x0b\xc7\xfa\x54\xf0\xe0\x1d\xe0\x61\xe1\x46\x59\xe0\xac\x9e\x04\xa7\x89\x58\xd2\x71\x91\xc0\xfd\x75\x82\x5f\x89\x14" +
+		"\x3c\xf7\x87\xe0\xe2\x62\x13\x4d\xb7\xfc\x14\x5c\xb6\x92\xe0\x29\x31\x77\xcb\xd3\xc7\xaa\x83\xfc\x25\x70\xeb\xbf\x83\x1b\x64\x25" +
+		"\xa6\xc9\xd5\x27\xc1\xde\x8f\x80\xdf\x48\x88\x44\x4d\xf0\x53\x45\x90\x1e\x04\x2f\x4a\x40\x54\x23\xb0\xd7\x5b\x02\xd3\x1a\xe0\xb5" +
+		"\x5f\x18\x15\xbf\x54\x1d\x1c\xfc\x27\xf0\x7c\x36\x38\x45\x46\x3b\xe8\x76\xf0\x43\x82\x9f\xdf\x08\x6e\x49\x34\x1b\xc9\x98\xb6\xe0" +
+		"\x98\xbb\xc1\x19\x4f\x99\xbf\x71\xfe\xcd\x60\xbf\x9e\xc2\xb4\xcb\xc0\x77\x63\xe0\x57\x7f\x00\x2f\xfd\xdc\xa8\xdf\x23\xe0\x89\xa7" +
+		"\xc1\x19\xb5\xc0\xce\x5f\x12\xdc\x96\x05\xae\x7c\x12\xfc\x76\x7c\xe0\x2d\xbd\x74\x97\xe9\x82\xd3\x53\x8d\x69\xac\x97\x18\xa2\x74" +
+		"\xf0\x43\x79\xed\x89\x9f\x82\x07\xf7\x11\xfc\x78\xa4\x0c\xa6\x15\xb8\x5a\xa2\xf0\x45\x42\x58\x71\x8e\xe0\x74\x89\x4b\xfa\x15\x78" +
+		"\xb8\x35\xb8\x42\xc8\xf4\xa6\x5b\xc1\xc9\xbf\x03\x8f\x95\x81\x57\xbb\x4b\x28\x65\xba\x45\xcf\x49\x64\xcc\xe2\xa7\xc0\x4b\xcd\xc1" +
+		"\x19\x6d\xc1\xad\xeb\x09\x5e\x7e\x02\x5c\x24\x30\x38\x76\x1b\x38\xf0\x25\xa3\x5b\xbd\xc5\xf3\x57\x0d\xec\x5b\x07\x5c\xbd\x84\xe0" +
+		"\x85\x97\xc0\xf5\xb7\x83\xfd\x64\x44\x04\x0f\x36\x06\x67\xd5\x03\xe7\x3e\x08\x0e\xac\x06\xbe\x75\x94\xe0\xa2\x1b\x4c\x2e\x5e\x18" +
+		"\xb1\x98\x27\xf1\x1d\xce\x48\x32\x99\x65\x55\xc8\x36\xd9\x91\x64\xf3\x71\x0d\xc9\x01\xe7\x84\xe0\xc4\x5d\x7f\x5e\x42\x91\x85\xa2" +
+		"\xb7\x32\xfb\xeb\xe1\x2c\xb0\x37\xcd\xbe\x30\xe2\x06\xf0\x64\x4b\xf0\xab\x05\x04\xdf\x6e\x68\x9d\x07\x08\xb5\x3e\x43\x0b\x43\x1b" +
+		"\x23\x63\x14\xa2\x3f\xa2\x39\xb8\x21\xc3\x5e\x77\x4d\x0c\x6e\x1a\x3e\xae\x34\x5b\x7c\x10\x7b\x13\x8d\x2e\x1f\x49\x36\x36\xbb\x58" +
+		"\xbc\x92\xa2\x15\x65\x81\x73\x33\xcc\xc3\x2c\xa6\x33\xc1\xbc\xd3\x81\x73\x7d\x5b\x12\x38\x37\x11\x9c\x91\x6d\xd6\xe4\x41\xfb\x68" +
+		"\x01\x27\x2b\x0b\xcc\x7b\x26\x7e\xae\x19\xf7\x2a\x6f\xb8\xd8\x0e\xac\xf8\x01\x78\x2c\xdf\xf0\x68\x7c\x57\x82\x83\x7f\x07\x0e\x7c" +
+		"\x10\xec\x1e\x07\xce\x9b\x48\xb0\xe7\x5d\xe0\xfc\x9a\xe0\x9e\x62\xf0\x4c\x0f\x67\xdc\x7d\x6e\x7d\xf0\x68\x09\xb8\x49\x7c\xc7\x3f" +
+		"\x35\x0f\xda\xf9\x14\xf0\x5b\x59\xce\x8d\x12\x80\x7e\x23\xb8\xed\x16\x73\xf2\x2e\xbe\xd5\x34\x38\x71\xf2\xee\xf9\x27\xb0\x2e\xb8" +
+		"\xa3\x0e\x38\x35\x13\xfc\x88\xe0\xee\x4f\x08\x5e\xfa\x91\x89\x36\x5d\x25\x52\xa9\x13\x6d\x60\xf3\x04\xed\x4f\x3f\x67\x41\xb8\x7b" +
+		"\xaa\x5b\xe0\xd7\xb8\x64\x0b\x06\x38\xfa\x4b\xf0\xa3\xdf\x80\x07\xfb\xca\xee\x5b\x26\x30\x68\x6b\x66\xba\xe9\xd2\x7f\xca\x7e\x53" +
+		"\x2d\x85\x94\x9d\x89\x82\x3b\x62\xe6\xe5\xde\x51\xcf\x94\xef\x0b\x99\xe0\x9a\xea\xe0\x1a\x01\x6e\x9c\x59\x27\x85\x93\xf7\xc9\x02" +
+		"\xd7\xdc\x68\x51\x19\x42\x5f\x37\xa6\x5b\x54\xd3\x37\x03\x44\xe6\x9f\x4a\x70\xc3\x5c\x9a\x8d\xf1\xda\x8f\x2c\xea\xaf\x22\xb0\xd8" +
+		"\x1f\x2b\x01\xcb\x9b\x81\x67\x6b\x19\x49\xef\x93\x08\x5e\x9d\x44\x70\xc9\x6f\xc1\x19\x3f\x01\xa7\xb6\x02\xf7\x3d\x0f\x9e\x1b\x1f" +
+		"\x90\xd3\xc9\xb4\x01\x9e\x0f\x81\x7d\x1b\x81\xab\xc4\x0f\x70\x3f\xd8\x5f\xa2\xd7\x85\xdb\xdc\x09\x76\x4d\x07\x5f\x03\x1b\x80\x43" +
+		"\xf2\x8c\xfd\x5f\x3c\x48\x70\x58\x06\xb8\xe0\x69\x93\xbb\xd7\xbf\x02\x9e\x17\x0f\x90\xac\x57\xb9\x78\x3d\xe3\xc0\x09\x25\xa6\xa3" +
+		"\x1e\xbb\xc5\x42\x64\xc5\x1d\x75\x4d\x42\x9b\x1b\x81\x7d\x93\xc1\x8f\xbe\x95\x69\x08\x7e\xcd\x5b\x49\xf0\x44\x16\x78\xf6\xb7\xe0" +
+		"\x1e\x11\x20\x6f\x02\xbf\x7d\xcb\xd9\x1b\xfb\xa6\x2b\x87\x99\x90\x6f\xbb\xbc\x09\x38\x35\xd5\xcc\x24\x13\x52\xcc\xcf\x21\x9c\x7a" +
+		"\x52\x9a\xad\xf1\x90\x9a\xb6\x86\x2b\xae\x12\xdc\x28\xbf\x0e\xef\x4f\xb0\x77\x2e\xb8\xa9\x3d\x38\xef\x46\x70\xeb\x31\x82\x4b\xab" +
+		"\x81\xeb\x77\x12\xec\xf5\x2a\x38\xbb\x0b\xc1\x6f\x1a\x83\xc7\x35\x76\xa4\x26\x38\x6e\x37\xc1\x99\x4b\x1c\x48\xf0\xb4\x86\x78\xb4" +
+		"\x05\x3f\x4a\xb7\xa0\xfd\xcd\xbf\x03\xaf\x88\xc3\xa2\x01\x78\xa5\x00\x7c\xab\x3b\xc1\x8b\x77\x83\x51\xb0\x21\x18\xd3\x2f\xc9\xfe" +
+		"\x08\x48\x03\x30\x0c\x3e\xa4\xdf\x37\x5e\x24\xb8\x42\x9a\x3e\x9b\x08\x76\xef\x49\xf0\xea\x5d\x08\xe5\xeb\x3d\xb9\xbe\x4b\x13\x90" +
+		"\x60\x35\xbd\x18\xef\x9f\x99\x07\xd6\xd4\x9f\xea\x9a\x93\xea\xc7\x60\x07\xbd\x23\x09\xac\x0d\x3e\xa6\x3f\xc4\xc0\x74\xb0\x05\x58" +
+		"\x47\xbf\xb7\x01\xef\x06\x1b\x83\xf9\xb6\x30\x6f\x58\xc8\xe1\x8d\x60\xa6\xbe\x28\x43\x6f\xcf\x80\x6b\x00\x3e\xa7\x03\xa8\x0f\x36" +
+		"\x02\x13\xc1\xef\x81\x5b\xc5\x69\x67\x61\xc6\x69\x7a\xfb\xcf\xc0\x5a\xe0\x3d\x60\x75\x15\x83\x24\xa0\xf3\x11\xf0\x93\x47\xb4\x43" +
+		"\xaa\x0e\x20\x5e\xfb\x67\x6b\x8f\x62\xf0\x66\x1d\x58\x08\xae\x31\x78\xab\xce\xa1\x81\x3f\x84\x92\xa6\xaf\xcf\xd2\x7f\xf1\x72\xdd" +
+		"\xa5\x4a\x4b\xea\xbf\x64\xbd\xa7\x08\x8c\x53\x68\x12\x2e\x41\x9f\x9f\xa4\x1d\x73\xc1\xa9\x13\x08\x37\x7c\x02\x75\x18\x75\xc1\x30" +
+		"\x38\xb5\x3a\x38\xa8\xba\x76\x49\xae\x72\x72\x27\x37\x80\x63\x48\x5f\xea\xcc\x99\x1f\x95\xef\x4c\xf3\xbd\x25\xc0\x62\x2b\xc1\x9d" +
+		"\x5b\xed\x81\x11\xbd\x94\xee\xc7\x91\xae\x2f\x2e\xf2\xc7\x41\x6c\x79\x1b\x81\xd5\xe1\x62\xda\x3f\x43\xff\xcc\x94\x2b\xd2\xda\x41" +
+		"\x8c\x7c\xed\x1b\x02\xef\xd2\x35\x10\xa6\x72\x9e\x20\xe1\xbe\x16\x3e\x94\x07\xa6\xe8\xad\xd9\xda\xc7\xd0\x26\x02\xf6\x96\x3d\xf5" +
+		"\xb6\x34\xf5\x74\x0a\x11\x30\x47\xbe\xf0\x40\x37\x82\x1f\x4b\x13\xf1\x30\xb2\x41\x66\x07\xb3\x0d\x66\x95\x0c\x12\xfc\x44\xa4\xb8" +
+		"\x4c\x1d\xef\xc9\x38\x3b\x9d\x95\xa5\x77\x08\x69\x59\x60\x40\xcf\x52\x88\x67\xfa\x2e\xe6\xf7\x4c\xd6\xf7\x8d\x18\x4d\xb0\xf7\xbf" +
+		"\x82\xe7\x84\xe8\x2e\xbc\x0f\x3c\x7c\x0b\xf8\xdd\xcb\x0a\x89\xa8\xde\x62\x41\x6e\x11\xb8\xfa\x1e\x3b\xd3\xc0\x1a\xa0\xc5\x9c\x77" +
+		"\x22\xd8\x54\x81\xf6\x73\x05\x51\xb2\x4e\xf7\x1e\xc5\x83\xa8\x42\x2c\x57\x47\x9e\x61\xb7\xde\xab\x10\xbc\x49\x9f\xda\x44\x47\xfd" +
+		"\x3d\x9d\x67\x63\xf0\x0e\x45\xa5\x10\x58\x0a\x97\x03\x3e\xa8\xfd\x6f\x55\x5c\xa4\x0e\xe4\x36\xf3\xc9\xc6\xc0\xb6\xfa\xc3\x1d\x6a" +
+		"\x17\xbf\x15\xae\x00\xbc\x13\x4c\xd4\xed\xf7\x0a\x58\x08\x8e\x0e\x59\x9c\x42\x43\xf0\x07\xfa\xfc\x62\x1d\x59\x3e\xf8\xa4\x3e\xe0" +
+		"\x3e\xdd\x31\x6d\x54\x63\xbc\x59\x7f\x6d\xa6\xe3\x49\xd3\x4d\xd6\x91\xba\xb9\x5a\xe9\x2b\xed\x68\xd5\xdd\x46\x46\xc3\xe0\x88\xdf" +
+		"\x80\x1f\xff\xc6\x6f\xda\x64\x15\x34\x53\xc0\x5f\xe9\x5e\xd1\xd1\x33\x49\x81\xdd\x48\x41\x94\xa8\x5f\xd2\xf5\xd1\x21\x05\x86\x01" +
+		"\x36\x0c\xc6\xf9\x65\x8c\xe8\x9f\x09\x0a\x85\x2c\x1d\x1f\xf5\x9e\xa8\x7e\x89\xf8\xe5\x8a\xea\x13\x86\x07\x01\xcd\x99\x3a\x6f\x03" +
+		"\x66\x54\x57\xa6\xd8\xe0\xf3\x3f\xc0\x1f\xea\x98\x33\xf5\x5f\x8e\x22\x58\x2d\x7d\x42\xb2\x7e\x8f\xc8\x30\x5c\x48\x9d\xf1\xbf\xd4" +
+		"\x1b\x1e\x90\x2b\x4c\xb0\x39\x8e\xa6\x2d\xd5\xad\x60\x7b\x70\xd4\x0f\xc1\x4f\x7e\xa8\x50\x7a\x45\x17\xf2\x59\x5d\xef\x6c\x7d\x63" +
+		"\x3b\xf0\xfb\x3a\x8f\x0c\x1d\x7b\xae\x0e\xd0\x36\xa5\x2d\x59\x35\x9d\x62\xa2\x42\x3e\x0b\x24\x42\x55\xd1\x37\x0b\x1c\xd8\x0a\x7c" +
+		"\xbd\x95\xee\xaf\xa8\x1f\xe2\xa4\xae\x04\xbf\xe9\x42\xed\x18\xf2\xdb\x2c\x53\x5b\xbb\x2f\x4e\xdf\x9e\xae\xc0\xbe\x2a\x3c\x8b\xe0" +
+		"\xe9\xfa\x9e\xf0\xdc\xa3\x80\x1f\x4e\xb8\xc1\x04\x07\x52\x41\x5e\x53\x5f\x77\x13\x58\xa0\xab\xff\xb0\x8c\x5c\x30\xfa\x61\x1d\xcc" +
+		"\xe3\xda\x2b\xec\xc7\x9c\xa8\xf7\xdc\xa0\x0f\x8c\x48\x2f\xd7\x4e\x3b\x52\x1f\x52\x0a\xbe\x4f\x70\x36\x3d\x05\xb4\xe5\x4c\xd4\x5b" +
+		"\x12\xe0\xa2\x3a\x58\x3b\x7d\x17\xf1\xe7\xfb\x46\x35\x00\x87\x36\xf0\xcb\xdd\x56\xf7\x4a\x96\xde\x15\xbe\x4e\x1b\x03\xa4\x8a\xd7" +
+		"\xae\xc9\x1e\x24\x71\x72\xb3\x0b\xfb\x3f\x73\x02\x7c\x70\xf9\xfe\xe5\x69\x1e\x28\x04\x17\x85\x2c\xe6\x33\x6c\x81\xb6\x93\xf4\x2c" +
+		"\x1a\xeb\x79\xaa\x73\x26\xc9\x8c\x9d\xf9\x3a\xde\x64\x6d\x73\x15\x81\xd3\x75\x48\xf9\x3a\x8c\x38\xdd\xe0\x4b\x45\x46\x36\x3f\x43" +
+		"\xa6\x3e\xb0\x82\xe0\xbf\xe9\xf0\x32\x02\x2c\x62\x2a\x48\xfd\xf7\xac\x3e\x2d\x5e\xfb\xb6\xf1\x53\x2f\xd0\x3f\x43\x2a\x6f\x26\x2a" +
+		"\x34\xc3\xda\xfe\x0b\x78\xbf\x27\x2f\x09\x9e\x2c\x19\xc5\xcd\xf7\x7d\xcd\x72\x45\x7d\x45\x26\x5c\x89\xde\x4c\x7d\x60\xae\x8e\xd6" +
+		"\x98\x9c\x01\xb8\x05\xdc\xa3\xfa\x84\x72\xf1\xfd\x7d\xd3\x51\x47\xed\xda\xf8\x13\x8f\x46\x35\x72\xc0\x24\xb8\x38\xfd\x4e\x45\xb9" +
+		"\x48\x95\xad\x98\xe0\x87\x62\x50\x9e\x44\x30\xd8\x0c\x76\xad\x9e\xfe\x9e\xa5\xaf\x8e\xf9\x85\xbb\x53\xf0\xc6\xd9\x33\xd2\x14\x1d" +
+		"\x92\xf5\xa7\x6c\x3f\x54\x33\x68\xa7\xfb\x1d\x92\x08\xd2\xb3\xa4\xca\x4d\xdb\x5e\xf7\x5a\x03\x5d\x85\xfa\xe0\xd4\x10\xf8\x85\x93" +
+		"\x07\xf3\x35\xb0\x97\x89\x08\x45\x60\x33\x45\x9b\x5b\x64\x12\x01\xf3\xbe\x55\x99\x76\x6b\xb0\x50\xc9\xa5\x91\xc8\x9b\x3d\xfe\x34" +
+		"\x52\xda\x6a\xb2\xc1\x43\x20\xc1\x3a\xba\xd3\xb3\x0d\x75\x3f\xa0\x8e\xe8\x16\x69\x5d\xa2\x3e\xaf\x21\x28\x11\x05\x04\xff\x59\xef" +
+		"\xac\xa1\x13\x7c\x23\x64\x27\x6e\xd2\xc1\x1b\x75\x08\xc7\x8c\xc8\xd4\x82\xab\xad\x57\x6f\xd0\x59\xa7\x2b\xcd\xf9\x27\x95\x33\x4a" +
+		"\xc1\x17\x74\xd8\xb7\x80\xe6\x00\x30\x7e\x5d\x5b\x27\x9e\xee\xa1\xfe\x18\x98\x80\xd0\x9d\x8a\x27\xe9\x3a\x82\x0c\x1d\x5e\x1d\x30" +
+		"\xa4\xdf\xef\x51\x12\x13\x27\xef\x12\x28\xdd\xa6\x2f\xaa\xad\xb3\x2b\x06\x23\xfe\xd0\xab\x85\xdb\xfe\x5c\x9f\x10\xd1\xf1\x14\x80" +
+		"\x7b\x5f\x02\x77\xbe\xe4\x85\x0b\x5b\xbd\x24\xbf\x20\xfe\x50\xae\x33\xd1\x80\x5e\x5a\x31\xc1\x2c\x3d\x58\xde\x40\xac\xc8\x51\xac" +
+		"\x8c\x2a\x26\xda\x6e\x6b\xe0\x25\x86\xea\x2a\x53\x45\xc0\x57\x0d\x55\xe4\xd0\x89\xd1\x9b\x5b\xf4\xae\xc7\xc1\xdf\x99\x9d\x71\x50" +
+		"\x82\x5e\xa8\xad\xaf\xb7\x07\xfd\x54\x87\x2d\xf7\xdf\xa1\xcf\x78\x5e\xd9\x63\xb2\x2e\x67\x10\x4e\x5e\x47\x71\x64\x44\xc8\xa2\xd9" +
+		"\x7b\xdb\x6e\xa8\xa9\xcb\xfe\x2b\x55\xa0\xeb\x7b\x74\x68\x64\x67\xbb\x43\x46\xc3\xed\x48\x7a\x32\x78\x3b\xd8\x54\x27\xd4\x4e\x59" +
+		"\xdf\x38\xe3\x66\x3f\xf3\x6c\x41\x42\x9f\x43\x60\x8f\xb0\x0e\xbd\xd0\x5c\x9d\x06\x8a\x1f\x81\xf3\x43\xe0\x3b\x4e\x1f\x5a\x57\x41" +
+		"\x72\x97\xee\xf3\x96\x3a\x8a\x1a\x60\x58\x61\x7d\xbb\x4e\xf6\x31\x5d\xb0\x55\x2f\xc2\x6d\x7d\x11\xfc\x52\xfc\x2e\xb6\x0f\xfe\xa8" +
+		"\xa6\x48\x13\xc7\xd2\x3c\x3d\x2b\xd1\xc7\xdd\xaa\x3c\x56\xdf\xe8\xd2\x3d\xd7\x08\x83\x13\x5a\x83\x5d\x5a\x2b\xec\x6d\xc6\x59\x9e" +
+		"\x46\x34\x94\x2f\x2e\x53\xa4\x27\x82\x9f\x0e\xa2\xdf\x6d\xf6\x2f\xe2\x51\x20\xcd\xa3\x64\x48\xf7\x79\xbe\x79\x1d\x36\xc7\xe9\x1e" +
+		"\x4b\x05\x4f\xc7\xc1\x7d\x1b\x96\x9f\x79\x9b\x72\xf0\xea\x7e\x81\x6f\x0f\xa8\x85\x8b\x28\x02\x1b\x95\xab\xe7\xa9\x82\xed\x93\x06" +
+		"\xfa\x67\x3b\xbd\x3f\x5f\xfb\xda\xdc\x6e\x50\xd8\x18\x15\x59\x11\x9c\x75\x8a\x78\x9e\x15\x06\x9b\x04\x12\xb1\x60\xdd\x98\xe5\x04" +
+		"\xfb\x2d\x37\x9c\xa9\xa6\x53\xac\x06\x9a\xbc\xd8\x44\xef\x4e\xd1\x89\xb6\xd7\x65\x79\x41\x69\x66\x1d\xdd\x11\x54\xf0\xd9\x41\xff" +
+		"\xa7\x34\x1e\xe7\x0f\xda\xfe\x4e\x31\xa8\x4c\x59\x5a\x92\x17\x21\xb2\x15\x51\x1a\xea\x50\xdb\x80\xc7\xa2\xe0\xb7\x89\xba\xb9\x5b" +
+		"\xe8\xf6\xcf\x01\x9b\x81\xa5\xe0\x7a\x82\xe2\x70\x7a\x14\xcc\xf4\xe2\x7b\x6b\xc5\xd7\x96\x3a\x82\x74\x0f\x2c\x39\xff\xf4\x65\x3c" +
+		"\xd8\x3d\x10\x13\x4c\x7c\x9a\x4c\x50\xfc\x0b\xb5\xcc\x31\xf0\x6b\xdd\x11\xbf\x30\x2b\x70\x82\xdd\xdc\x01\xfc\x93\x02\xa0\x9e\xce" +
+		"\x64\x25\x55\x36\xab\xa9\x33\xcb\x06\xcb\xa9\x3b\xa2\x50\x21\xf0\x02\x18\xd6\x97\xdb\x6b\xe7\x10\x3c\x62\x88\x68\xa3\xad\xa1\xc8" +
+		"\xf3\x90\xde\xf2\x2b\xf0\x25\x7d\x63\xa9\x22\xfd\x13\x9e\x13\xb6\xd3\x7b\xfa\x10\x8c\x57\xb8\xdd\xa8\xcf\xac\xab\x23\xce\x07\x37" +
+		"\x34\x07\x57\x35\xd7\xd1\xdc\xed\x8f\xc5\xf7\x76\x76\x94\x37\x4f\xfb\xa4\x28\x7a\x3e\xa4\x00\x29\x06\x8b\xf4\x96\x81\x61\x70\xb4" +
+		"\xae\x0e\xab\x2b\x6c\x26\x12\xdc\x43\x05\xfc\xfd\x3a\x80\x56\xfa\xb4\x7d\x0e\x5c\xe4\x74\x80\x4f\x28\xb9\xdc\x48\xf0\x65\x9b\x66" +
+		"\x99\xae\x60\x35\x85\xfe\x1d\x7a\xcb\x2d\xe6\x89\x37\x42\xd5\x1c\xae\xa5\x22\x53\xc7\xdf\x80\xf3\x7e\xed\x39\x58\x86\x2e\x7a\x8a" +
+		"\x2e\x7a\x0d\x45\xcb\x5f\x29\x81\xc9\x57\x14\xca\x04\xc7\x3c\x0f\xce\x7b\xde\xcb\xd5\x73\x97\x13\x1c\x2b\x4d\x11\x18\xb8\x32\x56" +
+		"\x37\x06\x8f\xcd\x25\xf8\xe1\x5c\x7a\xf1\x35\xe6\x69\xaf\x67\x6a\xae\x31\x9c\x71\x31\xea\x63\x2d\x4e\x59\x95\xa4\xe0\x7a\xc4\x6f" +
+		"\x54\x63\xd9\x99\x9e\x2c\x66\x7b\x1e\x9d\xa6\x0f\x1f\xb1\x81\xe0\xf1\xf5\x84\xcb\xf2\xaf\xa1\xae\x95\xcd\x3c\x4d\x47\x9a\xa5\xdf" +
+		"\x0b\x15\xc2\x2d\x14\x10\x59\x0a\xc2\x90\x92\x9a\x47\x14\x40\xb7\xe8\xaf\x75\xf4\xfe\x9f\xeb\xeb\x73\xf4\x05\x6d\x14\x51\x6f\xd1" +
+		"\xf9\x3f\x07\xee\x7d\x1e\x9c\xf6\xbc\xae\x4b\x5d\x9d\xd1\x9d\x8a\x13\xa6\x99\x25\x99\x70\xfc\xb8\x3e\x21\x43\x5f\xf1\x3d\xed\x15" +
+		"\x03\x47\x24\x82\x4b\x12\xc1\xa3\xd4\x0e\xd5\xc1\x77\xa8\xcf\x68\xa5\xa4\x32\x57\xa5\xc1\x9a\x3a\x82\x52\x7d\x5f\x0d\xed\x79\x97" +
+		"\x8e\xb5\x96\x6e\xaf\x04\x65\xd6\x9d\x08\xfe\x59\x11\x6c\x16\x55\xd8\xbc\x4b\x11\xb6\x8f\x5d\x1e\x68\x8c\xf5\x67\x8a\x9a\xd5\x74" +
+		"\xec\x3f\x36\x35\xe5\xfd\x90\x62\x44\x10\xa7\x50\x47\x51\xa6\xf2\x65\x35\xf5\x05\x75\xe0\xee\x01\x7b\x50\x29\xfd\xed\x2a\x77\xaf" +
+		"\x72\xba\xc5\x2e\x84\xc1\x65\x71\x5e\xf8\xd0\x23\xde\xd4\xd1\x74\xa6\x71\x97\x0d\xb4\xa8\xc0\x9f\x28\x02\x8f\x70\x8a\x5b\x21\xbf" +
+		"\x1e\x37\x2a\x13\x99\xe1\x40\xd1\x00\x1b\x2a\xa4\x7e\xa0\xa4\x24\xa2\xe3\x98\x17\x1c\x7a\xaa\xed\x13\x25\x34\x54\xf0\x37\x57\x10" +
+		"\x98\x54\x73\x9b\xce\xdf\x18\xed\x4d\x4a\x42\xc2\xfa\xdc\xc6\xda\x2b\x57\xbf\x1b\x5d\xdd\xe0\x2c\x7a\xc6\xd0\xad\x40\x7f\xbc\x41" +
+		"\xe1\x5c\x57\x5f\x69\x81\x29\xe5\x34\x8a\x91\xed\x09\xee\x13\x3a\x46\xb3\x3b\x3c\xee\x7b\xc4\x81\x83\x6d\x5c\xcf\xe8\x16\x8e\x57" +
+		"\x68\x99\xe2\xd5\x16\xdc\x45\xf0\xa4\x91\x57\x63\xfe\x1d\x14\x97\xa2\x0a\x77\x23\xb8\x49\xfa\xcc\x6c\x2f\x04\xc7\xbc\x4d\xc1\x2c" +
+		"\x34\x86\x99\x51\x2f\x9b\x86\x85\x47\xb9\x88\x17\x07\x73\x74\x79\x32\xfd\x3e\x49\xf6\xd2\x7c\xb6\xfe\x5a\x57\xa7\x52\xa0\xcf\x09" +
+		"\xf9\xdb\xf2\xe1\x42\x8a\xc3\xe2\x1d\x92\x95\xec\xd3\x93\x8a\x4b\x66\xf0\x88\x79\x09\x31\x49\xe7\x52\xa4\x02\x7e\xdf\xe9\x04\x5f" +
+		"\x97\xa6\x9e\xe7\x32\x69\x7e\x98\x04\x87\x19\x01\x2e\xf1\x5a\x60\x7b\x5d\xd5\xda\x60\xb7\x90\x51\xb2\xed\x34\xb9\xe0\x5f\xd4\xbc" +
+		"\x96\xa6\xb7\xde\xa2\x94\x3d\x49\xd7\xad\xc4\xab\xf8\x35\xb4\x35\x7d\xc6\x64\x83\x74\x05\xa3\xa9\xa1\xab\x43\x76\x7a\x32\x12\x80" +
+		"\x42\xae\x25\xe8\x74\x62\x5e\xd5\xa3\x5e\x8f\x69\x4b\xaf\xee\x66\x79\x56\x9c\xef\x25\x3f\xa3\xcd\x46\x85\x1b\x28\xa5\x4d\xac\xf2" +
+		"\x58\x95\xce\x64\xde\x37\xe9\x32\x52\xc1\x58\xd7\x6b\x4d\xf6\xcc\x56\xba\xe7\xf2\xbd\x25\x22\x51\xfb\x56\xd7\xe5\x88\xf3\xc2\x4b" +
+		"\xa6\x5c\x77\xad\xbd\x5d\x49\x2d\x61\x2e\xd5\x73\x56\xb3\x5e\xa5\x21\x94\xe2\xcf\x60\xa9\x91\xc4\x65\xfb\x00\xea\x78\x59\xeb\x50" +
+		"\xb2\xef\x9e\xe0\x89\x5d\xd8\xab\x09\x11\xaf\x4f\x45\x82\xf5\x70\x66\x29\x4a\x40\x28\x41\xaf\x98\x9e\x10\xef\xa5\x92\x04\xaf\x06" +
+		"\x56\xdc\x09\x7e\xd1\xb2\x8a\x2e\xd3\x3e\x58\x72\x67\xd2\x64\xa9\x82\xa4\x9e\xc7\xc1\xda\x9e\xf9\xd0\x0b\xe8\xb6\xf4\x79\x5e\x28" +
+		"\x33\xc0\x24\xab\x5a\x13\xf2\x34\x20\xd3\xab\x45\x31\x1d\x48\xae\x4e\x31\xd9\xe2\x30\x7b\x84\x2d\xc4\x79\x9d\x18\x83\x09\x36\xf5" +
+		"\x86\xad\x96\x0a\xd0\xc6\xfa\xa7\x49\x43\xf7\xf8\x2f\xe9\x8a\x33\x21\x2f\xb9\xdf\xa3\x63\xa9\xa1\xe8\x51\x4d\x07\x9b\xe5\x97\xbc" +
+		"\x9e\x97\xd2\x5a\x57\xd1\xfd\x4d\x8b\x37\x85\x2f\x4b\xef\xc9\x04\xd7\x0e\x24\xb8\x62\xa0\xd7\xfb\x9c\x4d\x2a\xdb\x9b\xe9\xaa\x83" +
+		"\x61\x05\x4e\xd4\x73\x0f\x82\x4b\x82\x00\x50\x9b\x9f\x59\xd5\x1a\xc8\xb0\x9d\x49\x56\x47\xfb\x13\xdc\xdd\x9f\x5e\x82\xcf\xf2\x80" +
+		"\xb2\x5d\x58\xcf\x9b\x74\xec\x5d\x06\xd2\x6e\x3f\x05\x2f\x76\xf0\x6b\x69\x86\x96\x90\x0e\x27\x02\x97\x50\x45\x8d\xb7\x73\xbd\x89" +
+		"\xba\xa8\x8f\xc0\x35\xd3\x61\x36\xf6\xac\xb8\x58\x05\x85\xe3\x34\xa0\x76\x0e\x64\xa3\x14\x25\x57\xb5\xf5\x75\x35\x04\xa7\x98\xe8" +
+		"\x15\x1f\xa3\x80\x75\xfc\x08\x6b\x79\xf2\xf3\xa4\xda\x59\x9e\xf2\x56\xd3\x08\xd8\x4c\xdf\xdd\x58\x67\xfb\x80\x37\xd0\xd2\xbc\xaf" +
+		"\xcb\xe8\xb7\xed\xf7\x74\xa8\xad\xb5\xdf\xad\x32\xc2\x00\x4d\xab\x2b\xd2\xdf\xa0\xcf\xc8\xd3\x45\x29\xd0\x97\xd7\xf2\x7b\xd2\xa4" +
+		"\xd3\x9a\xfa\xab\x31\x69\x53\x79\x1a\xe8\xf7\xea\x5e\x79\x8d\xf9\x55\xae\xd4\x6b\x2b\xb7\x81\x45\x18\x27\x79\x0b\xe3\xac\x7f\x03" +
+		"\x07\xfd\xdb\xf5\xa4\x46\x01\x55\x8c\x57\x20\x99\xd8\xf4\x92\xde\xd8\x42\x7f\xca\xd1\xc7\x17\xe8\x90\x5b\x80\xa9\xca\x1d\x33\xf4" +
+		"\x86\x3b\xbd\x0e\x95\xa1\x73\x7e\x56\xe1\xd5\x52\x57\xf1\x06\xcf\xe6\x0d\x79\xeb\xd8\x41\x8a\x39\x76\x4c\xff\x11\xe5\x60\x85\xfa" +
+		"\xd4\x07\x74\x88\x31\x1d\xdf\xed\x3a\xff\x1b\xf5\x0d\x51\xb0\xc8\x43\xbe\x95\x3e\xe0\x16\x30\xac\x70\x6e\xa7\x70\xfc\x89\x76\x49" +
+		"\xd0\x59\x99\x1d\x2f\x4d\x9f\x9c\xa1\x93\x48\x53\x9d\xd9\x48\x51\x65\x52\xa0\x34\xf0\x13\x07\x6e\x11\x34\xbd\x07\xfc\xf4\x1e\x5d" +
+		"\xf2\x88\xc7\x29\x03\x48\xaa\x5a\x37\xe7\xbb\x80\xde\x85\x34\xe5\x53\xc0\x45\x12\x34\x03\x54\x9a\xcf\x5f\x60\x6c\xc3\x9b\xc6\x03" +
+		"\x21\x2b\xd3\x2f\x41\xba\x27\x2e\x4a\x39\xe4\xa7\x30\x78\xb6\x31\xdc\xce\xc6\x1e\xfb\xe9\x59\x58\xc4\x2f\xce\x9a\x27\xc1\x29\x4f" +
+		"\x7a\x53\x5a\x2c\x40\xf4\x00\x44\x8d\x75\x95\xf2\x3c\xdd\x4a\xf6\x94\x3e\xe2\xf9\x1b\xbd\x81\xaf\x2a\x1f\x08\x83\x26\x95\xa4\x7a" +
+		"\xe9\x7e\x81\x66\xd6\x90\xe6\x31\x0f\xbe\x04\x5d\x0f\x25\xad\x7c\x06\x6c\xea\xbd\x25\x66\x0f\xcd\x06\x4b\x2d\x4c\xa5\xfb\x4f\xf4" +
+		"\x39\x0d\xf5\x45\xef\xd0\x8c\x0d\x71\x70\xd5\xf4\xd6\x3c\xff\xc2\x0c\x9f\x1b\xaa\xba\xdf\xca\x6f\x98\x0d\xaa\x91\x47\xe8\x74\x6f" +
+		"\x68\xf5\x50\x97\xfb\x6c\x3f\x9b\x38\x5d\x5b\xad\x6a\x3a\x71\xa7\x46\x51\x17\xa9\x62\xae\x8b\xf8\x59\xd7\xf3\x66\xc1\x44\x6d\x8b" +
+		"\xfc\x4a\xc4\x79\xd6\xa8\x00\x71\x61\xb5\x8c\x75\xa5\x02\x34\xd9\x8f\xb4\x48\xe7\x5e\xaa\x58\xf2\x9c\x67\x3d\x35\xbc\x16\x17\xb3" +
+		"\x58\x9a\x91\x4e\x87\x67\xba\xcd\x03\x2a\x13\x3e\xa2\x82\xdd\x7c\x82\x66\x1c\x28\xf5\x9a\x61\x8a\x2e\x52\x75\x45\xd6\x78\xb5\x5f" +
+		"\xee\x31\x7a\x6b\x6a\xa8\x19\x3f\x3e\x27\xb8\xc3\xa8\x4f\x44\x77\xf6\x0b\x95\x69\xc8\x3a\x78\xa5\x7b\x30\xf5\xe3\x47\xba\xe7\x1e" +
+		"\xd3\x21\xff\x44\x57\xa6\x1f\x55\x10\xcb\x57\x23\x72\x5d\xb0\xc4\x2f\xc6\xea\x80\xe4\x0c\x13\x47\x73\xd8\x24\xca\x2e\xc6\xca\x17" +
+		"\x87\xc1\x29\x21\xef\x14\x28\xa7\x2a\x7a\x0d\x74\xc5\xbf\xaf\x6b\xdd\xd5\x34\x89\x37\x68\x81\xa2\x9b\x69\xc2\x78\xb2\x92\xad\x17" +
+		"\xb5\x57\x1d\x1d\x4f\x86\x82\xca\xcc\x80\xbf\x32\x9f\xf3\xdd\xca\x17\xdb\xea\x03\x6b\xe8\x1d\x04\x6f\x03\xbb\x55\x1a\x96\x6a\xeb" +
+		"\x54\x3b\x85\xc0\x93\xc6\x1c\x3a\xe8\x1e\xb6\x34\x67\x29\x8a\x26\x7f\x50\xfe\x23\xa2\x7e\x90\xef\xac\x21\x78\xaf\x5e\xa8\xab\xe0" +
+		"\x31\x21\x5e\x6d\x61\xd7\x7d\x46\xd5\x94\x62\x98\x6d\xca\x0c\x1e\xf1\x1e\x55\x53\xbd\x7d\x32\x17\xfc\x6a\x3f\xc1\x6b\xfb\xe9\xa5" +
+		"\x9d\x4c\x5d\xdf\x7c\xc1\x04\x77\xa7\x22\x79\x8a\x3e\x3d\xac\x2f\x28\x32\x6f\xf5\x57\x71\x6a\xb2\xcb\xf4\x44\xbe\x86\xb7\x00\xb7" +
+		"\x55\x68\x18\xc6\x46\xfc\x8b\x2c\x1d\x60\x7d\x1d\xcc\x43\xda\xc5\xae\xdc\xa2\xad\x9c\x90\x22\x68\x9a\xde\x4f\xb5\xf3\x7d\xba\xae" +
+		"\x05\x0a\xe2\x9b\x75\x27\x98\x5d\x75\xae\xb3\xe9\xc7\xe9\xef\x8f\xe8\x5c\x6a\xea\x8b\xe4\xa4\x83\x9c\x20\x6a\xa9\x4f\xb9\xd1\xfb" +
+		"\xe8\xaa\xeb\xae\x8a\xe8\xfa\x3c\x16\xd0\x4b\x11\xa4\x1a\xab\x32\xfb\x9a\x27\x4d\x26\xce\xe4\xfb\x0d\x57\xc7\xb3\x8f\x4a\xf7\x98" +
+		"\x49\x51\xe9\x3a\x9b\x38\xa5\xaf\xff\xac\xc0\xfa\xad\x21\x51\xbe\x42\x28\x51\xc7\x73\xb3\xde\x77\xb3\x67\x79\xea\x72\x72\x6a\x9a" +
+		"\x0e\xf8\x71\xa9\xdf\xdd\xa9\x0a\xab\x4c\x5d\xc4\x34\x2f\xa0\x3c\x1e\xac\xac\x6c\xed\x46\x3a\x9a\x6a\x9e\xed\x36\x50\xe5\xaf\x2b" +
+		"\xbd\x24\x1d\xf2\x12\x69\xd4\x0f\x3c\xe6\xbf\x87\xbd\xb0\x93\x5e\x45\xe0\xae\x94\xf7\x22\xba\xe1\x8b\xb5\xcd\xf4\x56\x6c\x13\x6d" +
+		"\x92\x3c\x0d\x35\x8b\x70\x81\x5e\x2f\xd6\x41\xd8\xba\x3e\xeb\x79\x46\x86\x2e\x76\x9c\x5e\x6c\xa7\x48\x52\x5b\xad\x89\x37\xe8\x4b" +
+		"\xdb\x78\xf6\xdb\x44\x1f\x62\xd6\xa5\x38\x7d\x57\x5b\x75\xdb\x84\x15\x64\x85\x7a\xf1\x09\x9d\x61\x3d\xb5\x01\x3d\xe7\x95\x89\x74" +
+		"\xbd\x72\xba\x10\xec\x5e\xe4\x2d\xd8\x86\x80\x59\x5e\x85\x4f\xac\xa2\xe2\x14\xe8\x1b\x72\xaa\x38\x45\x23\x81\xcb\xd1\x25\x78\xf2" +
+		"\xb8\xdc\x79\x5f\xa7\x37\x91\x06\x3c\x20\xc7\xfb\x43\xc3\x5e\xa6\x88\x97\xeb\x81\xba\x74\x9b\xae\xd9\xd7\xef\x10\x1c\x35\xd9\x9b" +
+		"\x39\x5c\x75\xcf\x54\x4d\x59\x6a\xeb\xbd\xe1\x6d\xe1\xe2\x94\x71\x46\xbd\x40\x6f\x12\xdc\x95\x76\xe0\xd7\xed\x4c\x07\xee\x4d\x7d" +
+		"\xd5\x18\x7a\x83\xbb\x59\x6a\x9b\xe8\xa6\x8a\xf7\xee\xf4\x2c\xef\xab\xc8\x53\xd8\xb6\xf2\x9c\xa8\x48\x45\xfd\x0c\x35\xb3\x98\xe0" +
+		"\xd3\x56\x77\x60\xa2\xcf\x02\x18\xe7\xd9\x40\x82\x22\x59\x81\x7f\xd4\xbf\x2a\xb1\xa8\xa9\xab\x13\xef\x0d\x1f\xc9\xde\xa2\x1c\xf3" +
+		"\xde\xd5\x90\x07\x62\xa5\x69\xcf\x1e\x7b\xb3\x57\xb6\x22\x7e\xcb\xa5\x79\x8e\x52\x5b\x97\x27\xdb\xfb\xca\xe2\xbc\xc8\x62\xcb\x10" +
+		"\xaf\x38\x53\xec\xcd\x26\x26\xe8\xc7\xe9\xf5\x5c\xfd\x12\x77\xdd\xb0\x1e\x4a\xd6\x5d\x15\xf1\xb2\x8b\xad\x48\x5b\x6f\xc7\x31\xc7" +
+		"\x83\xa8\xfb\xe3\x9d\x0c\xd0\xdd\xa0\x1b\xcd\x64\x34\xa3\x30\xa9\x5e\x9d\xca\xf3\xba\xef\xeb\x44\x78\x7a\xc0\x64\x5f\xf2\x58\x19" +
+		"\xf6\xba\x86\x0a\x12\x3c\x9c\x0d\x1e\xcf\xf6\x9e\xa5\x4a\xeb\x91\xad\x50\x91\xd7\x0c\x13\x94\xea\x84\xc1\x79\x0b\x09\x4e\x58\x68" +
+		"\x21\xbd\xf9\x3e\x0c\x20\xa4\xb3\xcc\xf6\xba\x60\xd8\x4f\x82\xfe\xd7\x14\xa5\x1d\x86\x7d\x89\x7a\x0f\xbd\x61\x2a\xcd\x23\x63\xb2" +
+		"\x77\x71\x27\xa9\x17\x28\x45\xb9\xea\x9d\x8a\x11\x4d\x94\xff\xc5\x9b\x45\x23\x45\x09\x55\x02\x58\x02\x36\xb7\x4b\x53\xe8\x35\xf8" +
+		"\xc1\xd4\xfe\x06\xb1\xd7\x03\x41\xc4\xb4\xa0\xa7\x94\xbe\xde\x6c\xf6\x9a\x5b\x3c\xc1\x4a\xd5\xb6\xb1\xf7\xab\xb5\xf1\xa4\xaa\xa6" +
+		"\xe2\xff\x6c\xc2\xc5\x83\x85\x60\x7b\xb8\xdb\xbd\x67\xac\x86\x37\x9f\xd6\xd0\xfe\xa5\xca\x7a\x93\x3c\x42\x18\xc1\xbc\xcf\x47\x1b" +
+		"\xd4\xf7\x60\x2c\xf0\x9a\x5a\xd4\x8b\x14\xd9\xe0\xeb\x89\x96\x6d\x21\xea\x17\xe6\xfb\xde\xa5\x69\xca\x4f\xd7\x70\x15\x41\xd0\x5c" +
+		"\x1b\x31\x2f\x0a\x55\xea\x43\x21\x6f\x33\x9e\x38\x88\xe0\x90\x41\x95\x4b\x98\xae\x6d\xa5\x1b\xda\xa4\xd2\xd7\xbc\x00\x59\xcf\xab" +
+		"\x64\xf1\xe0\xc3\x60\xb3\x2a\xa6\x7c\x53\x4e\x74\x21\x02\x04\x0c\xeb\xfd\x71\x6a\xdc\x0e\x55\x71\xb4\x34\xf4\xbe\x42\x09\xb7\x6f" +
+		"\x06\x8e\x28\xf1\x96\xd3\x76\x1e\x17\x0c\x29\x12\xfd\x94\x62\xde\x4c\x93\x1c\xac\xb9\x4b\xb8\x1e\x12\x12\x10\xc1\x7a\x5e\xe8\xb3" +
+		"\x8b\x53\xfa\x3a\x70\x96\x34\xc9\xde\x62\x59\x4d\x67\x94\x12\xac\xbb\xab\x74\x27\x58\x8a\xcd\x7c\xef\x03\x0e\x7b\x87\x66\x01\xb8" +
+		"\xaa\x16\xb8\xa0\x16\x38\xfe\x45\x70\xfa\x8b\x20\x75\x0d\xe3\x74\xf2\xf5\xbd\x3e\xa4\x23\xa2\x19\x01\x5a\xab\x59\x20\x02\x52\x9d" +
+		"\xee\x51\x8f\xa9\xb9\xde\xc1\xdf\xd6\xfb\x07\x2a\x71\x57\x17\xdf\xc5\x7b\xaf\xaa\x11\xe2\x3c\xaf\x8f\xc6\x07\x90\x70\xc9\x01\x95" +
+		"\x70\xe5\x04\x73\x54\x77\xc8\xd5\xc7\xc5\x7b\x9f\x74\x0a\x5c\xa9\x12\xe2\x74\xaf\x35\xe4\x82\x19\x8a\x1f\xbf\xf1\xf6\xaf\x5c\x0f" +
+		"\x7b\x73\xa0\x34\x56\x6c\xcc\xd5\x87\xa4\x79\x6a\xc5\x2a\xc2\x7e\x57\x7a\xee\x90\x10\xf0\x3a\x17\x96\x05\x75\x95\xfe\xb3\x78\x2f" +
+		"\x3f\xc7\x79\x0a\x9d\xe5\xe3\x77\xa2\x81\x26\x14\xf6\x9c\x45\xae\xeb\xbe\x73\x21\xcf\x84\x4b\x55\x1a\x54\xe3\xa1\x8b\xea\xac\xd2" +
+		"\xaa\xc4\xd0\xdc\x28\x53\x95\x49\x87\xbd\x57\xde\x9c\x05\x39\x66\x77\x7d\x93\xa0\xfd\x6b\xef\x65\x98\x4c\x5d\xe1\x24\x9f\xca\x2a" +
+		"\x55\xbf\x3f\x04\xde\x54\x45\x9f\xca\xd3\x89\x9b\x59\xca\x36\x63\xb2\x8f\xc8\x0a\xdb\x11\xe6\x68\xc0\x3d\x48\xb0\x85\x2e\x57\x44" +
+		"\xb1\x33\xde\x22\xfb\x07\x39\xfd\xda\x5e\xa5\x8c\x38\x2f\x2f\x31\xa0\x4c\x4e\x7d\x57\xbc\xc9\xef\xa7\x22\x7d\x5d\x48\x1f\xf0\xb2" +
+		"\x82\xa7\xb5\xe7\x28\xe6\x8a\x8f\xf3\x04\x28\xac\xf3\x4e\x57\x2a\xd1\x58\x2f\xb6\xf7\x91\x5f\xca\xf6\x84\x9e\x44\x4c\xa2\x4e\xf0" +
+		"\xff\x62\x9e\xf7\x46\x3c\xfa\xa4\xf9\xc5\x32\x79\x6f\x50\x2d\x70\x47\x2d\x84\xc2\x5e\x25\x0a\x7b\x5c\xac\x22\x09\x59\x46\xe4\x90" +
+		"\xae\x2d\x47\x8f\x26\xb8\x78\xb4\xae\x54\xb0\x9a\xd9\xbe\x67\x1e\x42\xf1\xb2\x52\x81\x42\x94\xe3\x79\x40\x2b\xef\x56\xf8\x6c\xb8" +
+		"\xb3\xe0\xda\x71\x43\x08\x7e\xf9\x3f\xc0\xf7\xe7\x3b\xdc\xd7\x39\x0e\x99\x1f\x87\x10\x1d\x2f\xc1\xed\x3f\x50\x99\x3f\xd7\x7b\x37" +
+		"\xf3\x54\x8b\x7f\x06\x2c\xd1\x89\xf4\xa3\x37\x31\xa7\x05\x8a\x77\x68\x25\xd5\x05\xfd\x9c\x22\xf3\x1b\x06\x8e\xb7\x5a\x5a\xf0\xf3" +
+		"\xce\xf6\x88\xeb\xda\x16\xee\xa4\x31\xe6\x8a\x38\x8f\x13\x43\xbf\xaf\x8b\x97\x6e\x99\x18\xba\x3b\xd0\xce\xe4\xb9\x29\x0e\x3c\xba" +
+		"\x91\xe0\xd4\xde\x0e\x1c\xfb\x0e\xc1\x21\x73\x09\xbe\xb1\x80\xe0\xf4\x37\x08\xae\xeb\x4b\xf0\x0b\x49\x46\x27\x41\xd9\x73\x34\xe5" +
+		"\x86\x18\xcd\xe7\x8d\x24\xb8\xf1\x71\xb0\xd3\x83\xe0\x67\xcd\xc1\x37\x96\xd2\x02\x6b\xa7\xc6\xc0\x51\x9b\xc3\xe0\x9c\x9d\x21\x70" +
+		"\xdb\x7d\xe0\xc7\xe2\x25\x1b\xf0\x34\xb8\xaa\xc2\xec\xe0\x9f\x74\x24\xd8\xff\x19\xf0\xc3\xf1\xb4\x83\x70\xe7\xa2\xe0\x85\x8b\x04" +
+		"\x47\x1e\x26\x78\x59\x52\x14\xcd\x3b\x2b\x81\xa4\x27\x08\x7e\xfe\x0a\xf8\xde\x2f\xc0\x21\x7b\x53\x25\xbc\x38\x66\x41\xba\x07\xa6" +
+		"\xa7\x81\x23\xbf\xad\x06\x6e\x6c\x08\x6e\x94\x73\x89\x72\x73\xe7\xfb\xc1\x2e\xb3\x69\xd9\x4c\x56\xdd\x08\x4e\x5f\x4e\x70\x62\x1c" +
+		"\x38\x5c\xd6\xf1\xc4\x0b\xe0\x37\xa3\x08\x76\x8f\x58\x72\xd2\x3d\x0f\x83\x23\xeb\x80\x17\x5f\x05\x67\xfe\x19\x3c\x78\x23\x38\x78" +
+		"\x8b\xcc\x65\xb4\x9c\x0b\x1d\x9d\x09\xae\x6f\x0e\x6e\xff\x27\xf0\x90\xc0\x67\xcf\x87\x61\x70\xda\xb6\x90\xb9\x38\x86\xaf\x0a\xc2" +
+		"\x70\xe4\xf8\x41\xa7\x17\xc0\xbe\x72\x06\xfb\x06\xb0\xcb\x5d\xe0\x8c\xd6\xe0\x94\x5a\xe0\xc9\xd6\xe0\x86\x5f\x83\x6b\xa6\x13\xfc" +
+		"\x2c\x1f\xbc\xd8\x93\x76\x20\xff\x42\x5d\xdd\x32\x07\x9f\x04\xdf\x97\x77\x4e\x3e\x4a\x70\xa7\xc0\x61\x69\x5b\x70\xf2\x0f\xc0\x23" +
+		"\xbf\x02\x4f\xd4\x07\xc7\x3f\x09\xce\xff\x03\x38\x6d\x82\x03\x77\x48\xe4\xee\x3b\x61\x70\xec\x87\x21\x70\xb0\x1c\x60\xed\x3e\x36" +
+		"\x23\x38\x8e\xbc\xf7\x43\x7f\x0a\x79\xe5\x0f\xc0\x85\xa5\xe0\x3b\xcf\x82\x73\xfe\x0d\x1c\x2c\x81\x0c\x35\xc0\x61\x13\x05\x10\xe2" +
+		"\x5d\xbf\xba\x23\x0c\xce\x7f\x4f\x3a\xcc\x94\x25\xd9\x1e\x06\x07\x74\x8d\x03\xcf\x7e\x27\x6b\x2f\xba\x68\xf9\xeb\xd5\xc1\x77\xb7" +
+		"\x65\xd8\x21\xca\x77\xb6\x11\x9c\xd5\x85\xe0\xd4\x5e\x04\x5f\x17\x40\x37\xb1\x73\x7d\x7d\xbf\xa0\x1d\xe2\xdb\x5f\x0b\x7c\x27\xcb" +
+		"\x8e\xe7\x1d\xaf\x09\x0e\xbb\x0b\xdc\xd0\x83\x60\xf9\x6f\xc0\x21\x2f\x83\x5f\x3e\x0c\xf6\x1a\x41\x70\xae\x2c\xff\x87\x85\xe0\xf2" +
+		"\xee\x82\x65\x82\x74\x5f\x84\xc0\xf2\xda\xc6\xdb\xe5\x90\xd1\xfa\x87\xc1\x15\x3b\x08\x8e\x59\x49\xf0\x6d\x19\xe5\x09\x39\xf0\x29" +
+		"\xb3\xdf\xbd\x3d\x04\xbe\x5b\x02\x5e\xfc\x83\x25\x73\x18\xd8\x02\x1c\xf8\x38\xd8\xed\x87\x96\x8b\x52\x62\x87\xbb\x88\xfe\x37\x41" +
+		"\x0c\x0e\xbd\x5e\x00\x27\xff\x02\x1c\x20\x00\xea\xff\x13\x70\x4e\x32\xb8\x3a\x02\xee\x6a\x61\xc7\x29\xcf\xee\x08\xc1\x95\x81\xc9" +
+		"\x60\xf7\xe5\x61\x70\xe0\x1f\xc1\x03\xcb\x32\xc0\x1e\x92\x6b\xb0\xff\xf0\xea\xe0\xd7\x0e\x5c\xd2\xa3\xba\x25\x52\x72\xe6\x8c\x38" +
+		"\x42\x3b\x2c\x24\xe7\x3f\xe6\x5f\x4d\x01\xaf\x5e\x48\xb1\xe4\x7a\x47\xe4\x4c\x4b\x92\xa5\x73\x98\x1f\x64\x94\x5f\x93\x60\x81\x36" +
+		"\x2b\x9d\x12\x37\x39\xdf\xb4\xea\x63\xd9\x32\x1f\xc7\x81\x63\x07\x64\xda\xe1\x2e\x53\xc1\xae\xc4\x59\x1e\x8b\x63\x32\xc8\x01\xb2" +
+		"\x2f\xfb\xd0\x52\xf7\xc8\x69\xa8\x90\x8f\x72\x3b\x96\x60\x29\x26\x96\x06\xc9\x9e\xc6\xc8\x42\x10\x5c\x15\x51\x49\x53\x8e\x9b\x8f" +
+		"\xa2\x25\x0f\x5d\xef\xc0\x7d\x61\x70\x0d\xed\x5c\xdf\x63\x66\x19\x9f\x1e\xe4\x9c\xf8\x39\x38\x72\x3e\xc1\xb3\x4b\x08\x9e\x4f\x05" +
+		"\x67\xfe\x1b\x58\xfe\x67\xb5\xcf\x16\x98\x3f\x5a\xce\xe4\x6c\x93\xb5\xa3\x25\x64\x38\x1c\x82\x9b\xb3\x34\x04\xbe\xf1\x3d\xcb\x89" +
+		"\xce\x2e\x0b\x22\x95\xf9\xcf\x07\xb7\x06\x7b\xb4\xfe\xab\xbc\xe7\x6b\x7c\xc6\x73\x77\x78\x7e\xc4\x82\xe8\x7d\xbe\xf3\xc1\x8b\x23" +
+		"\xe0\xbc\x45\x91\xbf\xcc\x15\x5f\xac\x8c\x22\xec\xa5\xab\x7a\xff\x4f\xbf\xba\x98\xd5\x07\xf8\x5f\x22\xab\x6e\x51\x32\x42\x27\x08" +
+		"\x8e\x5a\xeb\x2c\xf3\xc7\x3b\xb2\x29\x3e\x8a\x82\x07\xfe\x08\x7e\x78\x03\x38\xf5\x76\x70\xc4\xbf\x81\x1f\x68\xca\xa0\x5d\x34\xd4" +
+		"\xd9\x3f\x28\x48\x64\xba\x37\x0c\x7e\x24\x06\xac\x8e\x04\xbf\xb9\x19\xec\xa5\xa7\x9d\x46\x12\xbc\xfc\x23\x3b\x4e\xf3\xc5\x34\x82" +
+		"\x03\x13\x2d\x71\x5d\x45\xd8\xa2\xe3\xcb\xc3\x76\x02\x69\x4b\x14\x24\x78\x58\xd2\xe2\xa4\x83\xbd\xab\xdb\x49\x85\xbe\x21\x70\x47" +
+		"\x63\xb0\x67\x9c\x1d\xe6\x3d\x9b\x66\xa9\x35\x8e\x39\xe5\xa0\xbd\xef\x05\x27\xa5\x83\xa7\xca\xc5\xf7\x15\x52\x90\xbf\x37\x47\xce" +
+		"\x20\x3d\x00\x66\x80\x43\x53\xab\x84\xfe\x9c\x8f\xd9\x89\xf8\x24\x2f\x4c\x5e\x29\xf6\x82\x68\x4d\xf0\x8d\xf6\x60\x45\x7b\x05\x11" +
+		"\x83\x7f\xa1\x9f\x98\xc9\xe9\x0f\xf6\xf1\xef\x56\x42\xe1\x06\x1f\x3d\x56\x47\xe1\xfe\xac\xca\x0f\x16\xd1\xfd\x07\x15\x5f\x1b\xa9" +
+		"\xa1\x23\xdb\xd2\x23\x0d\x25\xb8\xe8\x5e\x4b\xb8\xbe\xc5\x3c\xd7\x27\x05\xb9\xa2\x80\x6b\xd2\x18\x89\xb3\xdf\x24\x32\x9b\x83\xfd" +
+		"\x9b\x23\xba\xe2\x6e\x44\x67\xdd\x8d\x9c\xab\x25\xe0\xb0\x12\x2f\xbb\x54\xf7\x8a\x4f\xba\x17\x0c\x53\xab\xc4\x08\x4b\x76\x57\x7a" +
+		"\xc5\x30\x19\x6c\xa6\xef\xaf\xa3\x48\x52\xe8\x67\x5e\xa4\xed\x6d\xe0\xee\x3b\xfc\xec\xc6\xc8\x9e\x1a\x70\x2c\xe2\xff\x1e\x72\x2c" +
+		"\x82\xd0\xdb\xf2\xf7\x40\xf9\x36\x5a\xbe\x8d\x90\x66\xe7\x3b\x11\xb0\x97\x1c\xc5\x5b\x67\x77\xae\xd9\xe0\x03\xa9\x11\xfa\xfd\xd3" +
+		"\x4f\x02\xa1\x5f\xff\xea\x37\xe0\x9b\x61\xb8\xed\x96\x6f\x60\x4e\x5b\xb0\xeb\x6a\xca\x11\x5a\x22\x92\xe4\x2d\x64\xcb\x1a\x22\xb4" +
+		"\x56\x8e\x72\x64\xda\x11\xba\x68\x20\x7e\x86\xe5\x81\x25\x3e\x9b\xfe\xf9\x42\xf0\xd3\x12\xb8\xef\x52\xc0\xf5\x17\x22\x20\xc0\x91" +
+		"\x8b\x23\x48\xb8\x7a\xb3\x57\xb7\xff\xf2\x9f\xcb\x82\xfb\x91\x76\xa5\xaa\x7c\xb9\x81\x88\xef\x3a\x39\x7f\xf5\x66\xf0\xea\xcf\x10" +
+		"\x5a\xf9\x08\xdc\xaa\x46\xe0\xe0\x6b\xce\x8e\x31\x8d\x59\x12\x0a\x4a\x0b\xac\x59\x12\x1c\xd5\x24\xb8\x55\xce\xa5\xc8\x16\x3d\xfe" +
+		"\xad\x99\x40\xe8\x95\x0b\x03\xe5\xb6\x35\x04\x2f\xbf\x86\xc4\xd5\x93\x1c\x10\x7e\xf8\xde\x7b\xef\x05\x42\x0f\xdf\xdb\x01\x70\x0f" +
+		"\x77\x80\x7b\x23\x1b\x08\xbf\xf2\xab\x5f\xfd\x0a\x08\xbd\xf2\xab\x97\x00\xf7\xca\x4b\x0a\xb1\x2f\x09\x12\x70\x0f\x59\x10\x72\xdf" +
+		"\x3b\xc0\x6f\x4b\x65\xde\x70\xfd\xee\x7e\xeb\x6e\x84\x0f\x0b\x59\x1b\x45\x3b\x47\x72\x68\x3c\xc1\xfe\xab\x08\x84\xbb\x6f\xe2\x96" +
+		"\xa9\x6e\xc8\x08\x2e\x3d\x2b\x7f\x8e\x7e\xdb\x1d\x9a\xc6\x8f\x77\x73\xe1\x70\x82\x3b\x97\xeb\x7a\xfc\x27\x77\x0d\x52\x18\xf3\xa7" +
+		"\x8a\xbf\x37\x82\xd0\x7f\x8f\xea\x45\xfb\x3e\x80\x5e\x21\x6b\xe7\x2f\xb5\x54\xd0\xd9\xf7\x3b\xc1\x26\x8a\xe1\x2d\xfd\x95\x6c\xff" +
+		"\xc5\xa2\xc1\x66\x4d\x73\xe0\xb8\x86\xe0\xae\x06\xe0\x77\x0e\xdc\x59\x12\x64\x50\x39\xdc\x99\x60\xbf\x86\x96\x2f\xfa\xe0\x24\x82" +
+		"\xdf\xbe\x4b\xf0\xb8\xa4\xa1\xbe\x07\x3c\xeb\x7c\x70\x98\x05\xc4\xdd\xae\xe4\xd6\xe2\xac\x92\xd4\xfa\x72\xc4\x4c\xe2\xdf\x57\x6a" +
+		"\xf5\x80\x4a\xf1\x3f\x33\x12\xbf\x9c\x96\x7a\xc7\xd4\xb1\x17\x54\xe7\x7c\x54\x2d\x42\xbd\x9d\xf7\xa3\x96\xa8\xf0\x1c\xab\xcc\xa3" +
+		"\xd1\x46\x1f\xd3\x41\xd2\x57\xc4\x81\x87\x06\xc4\x79\x4b\xf4\x54\x5a\xb7\x09\x26\x3d\xf7\xa6\xb7\xc2\xf7\x91\x33\x4b\x5f\x76\x8d" +
+		"\x33\x3a\xd0\xc0\x54\x8e\x3e\x5e\xca\xf7\x21\x18\x8d\x2c\xeb\xa4\xcc\x78\xdf\xd3\xe0\xb4\xa7\x75\x42\x5d\x69\x09\xac\x1a\x80\x2f" +
+		"\xe9\xb2\x3f\x0c\x5e\x39\x1b\x06\x87\x9f\x53\xe3\xbc\x1b\x48\xb0\xb1\x1d\xa1\xaa\x67\xe9\x16\xcc\x40\x72\x8d\xde\x93\x25\x10\x13" +
+		"\xee\x6e\x69\x8b\x5a\xa9\x7d\x40\xd2\xbf\x06\xd6\xf9\x09\xce\x72\xc8\xef\xb0\x23\xac\x4f\x98\x0d\xfd\xb0\x45\x76\xdc\xa0\x93\xaf" +
+		"\x5d\x39\xc8\x9a\xe6\x32\xda\x14\x06\x97\x6c\x32\xab\x71\xcc\x3b\x3a\x5f\xf2\x26\xdc\x11\xc1\x09\x22\xd9\xa5\x63\x9d\x75\xec\x1a" +
+		"\xe4\x31\xeb\xb6\x3a\x0c\x7e\xbc\x32\x0c\xee\x92\xe6\xe2\x8a\xb0\x19\x45\x86\x99\x9d\xe8\x5e\xf0\x11\x30\x0b\x5c\x4b\x7d\xd3\xe1" +
+		"\x90\xb1\xcb\xdb\x75\xd5\x6e\xb5\x51\x3e\x64\x11\x63\xb7\xe9\x3c\x2d\x74\xe3\x41\xef\x4d\xfc\xa3\xfe\x69\x01\x6c\x5d\x9d\x71\x80" +
+		"\x47\xc0\x8e\x66\x31\xb6\xec\x78\x0b\xa9\x03\x5f\x6b\x8a\xde\x0c\xc2\xdd\x02\xf6\xff\x28\x19\x1c\xb9\x35\x59\x1f\x71\xc4\xbc\x82" +
+		"\x1b\x09\x1e\x1e\x2a\x73\x1d\x12\x36\x8b\xb9\xc5\x2b\xe5\xe8\x1e\x78\xce\xc7\xdd\x24\x78\x55\xf7\x59\x3f\xa2\x55\x04\xf7\x06\x07" +
+		"\xdc\x8a\x3d\xc1\xcf\x02\x0f\xf6\x0a\x83\xa3\xc5\xa1\x3e\xad\xaf\x3c\x5b\x36\xcd\x23\x3e\x18\xf3\x84\x43\x68\x4b\x67\x71\xb8\x77" +
+		"\x0a\x83\xbd\xa5\xf9\xee\xf5\xb0\x09\x34\x8d\xc0\x63\x57\x42\xe0\x67\x57\x42\x36\xba\x6b\xdf\x88\xb7\xeb\x5c\x48\x61\x3e\xd7\x62" +
+		"\xef\x9f\x07\x2f\xd1\x4e\xb8\xcf\x26\x78\xf9\x4b\xe1\x6a\x7b\x43\x26\x38\xdd\x6e\x28\xf7\x58\x70\x5e\x96\x16\xac\x9d\xac\x8b\x77" +
+		"\x54\xe4\xf1\x4f\xb7\x86\xe0\x0e\xc8\xd1\x9e\xf7\xd6\x86\x2c\x5d\xc1\x42\x73\xa8\x97\x5b\xb4\x6c\x63\x05\x5e\x57\x21\x7a\x3b\x17" +
+		"\x87\x0c\x8c\x0f\x83\x07\x2d\x12\xba\xa5\x01\x74\xcf\xac\x10\x38\x71\x5a\x08\x6e\xf2\xb4\x10\xf8\xed\x84\x10\x78\x8a\x60\x9f\xf1" +
+		"\x21\x70\xf4\x38\x0b\x41\x38\xe2\x02\x7b\xa5\xcb\x83\xcb\xf0\xe1\xe2\x49\xe0\xe3\x9e\x47\xd5\xf5\x2e\x8b\xdb\xf4\xd5\x1d\x09\xf7" +
+		"\x0b\xd3\xa8\x6b\x83\x61\xc5\xe7\xe9\xc3\x43\xe0\xd8\xe1\x16\x32\x52\xc3\xa6\x15\xaf\xe1\xc4\x7b\xc4\xe1\xd4\x4f\x9a\x3b\x75\x5c" +
+		"\xcd\x6c\x31\x0a\xcd\x60\x77\x0f\xd8\xc4\x6f\x77\x8b\xc4\xed\x2f\x07\x6e\x43\xe0\x9a\xde\x21\x70\x50\x6f\x1b\x63\x37\xe7\x9d\x3a" +
+		"\x99\x55\xbc\xd2\xe5\xa6\x3e\x57\x48\x84\xf3\x1b\x22\x35\x54\x48\x33\xb4\x53\xc8\x0e\xe4\x0b\x86\x47\xcc\x47\x17\xa7\x20\xbf\x47" +
+		"\xbb\x57\xd0\xd2\xf4\xc8\x61\xd4\x73\x0e\x9c\x78\xda\x59\x06\xb3\x75\x97\xc2\xe0\x48\xa2\xe5\xcb\xff\xa8\x0d\x55\x59\x1b\x8a\xe3" +
+		"\xfe\x8c\x3b\x56\xfc\xf9\x2f\x0a\x44\xb1\x5c\x84\xdf\xa1\x57\x22\xd7\xeb\x37\x1d\xfa\xd3\xdf\xad\xd0\x51\x6a\x65\x85\x23\x9d\xd1" +
+		"\x48\xa2\x75\xb7\x2b\xf1\x70\x9f\x2e\xfc\x3f\x5f\x9c\xea\xbf\x76\xbd\x29\xb7\x8c\x60\x97\x65\xfc\x9b\xa5\xb4\xee\x5b\xf6\x65\xc4" +
+		"\x8e\xde\xf7\xc8\x54\xec\x71\xa2\x9e\x0e\x39\x6e\x62\x51\x97\xa3\xf1\x8a\x64\x1d\x05\xab\x2c\x5b\xc0\xdb\xfc\xab\x2b\xe1\x11\xb2" +
+		"\xf3\xab\x83\x1f\x84\x10\x49\x40\x34\x06\x5e\xfc\x23\xf8\xb9\x03\xdc\xf7\x2b\x22\x76\x4c\x7d\xf7\xef\x01\x77\xaf\xfc\xf5\xa1\xc5" +
+		"\x0a\xc2\xfd\xa8\xc2\xc6\xbb\x8e\x60\x92\xd1\x71\xed\xf3\xf4\x5f\xf4\x99\xe9\xfe\xa2\xd3\xff\xf8\xcb\x4e\x5b\x42\xe0\x72\x33\xd5" +
+		"\x7e\x2e\x96\x83\xb0\x55\x86\x80\xfe\x8b\x82\x80\x83\x6f\x67\xd8\x7d\x5f\xbf\x08\x8a\xd5\x66\xbb\x45\x85\xd8\xad\xc9\x60\x2d\xa5" +
+		"\x3c\x2d\xf7\x47\xff\xb1\xcb\xaf\xef\xf2\x4b\xaf\xf3\xbf\x1c\x52\x44\xcf\x84\xc1\xcb\x49\xe0\x5b\x16\xa0\x78\x23\x9c\x1a\x31\x5d" +
+		"\x16\xa2\xd5\xff\xbf\xfd\x62\x4c\xf9\xcf\x40\xa8\xc9\x6d\x4d\xe1\x36\x39\x70\x4a\x27\x22\x22\xc7\xc1\x11\x2a\xbc\xad\x39\x10\x2a" +
+		"\xd2\xb6\xa9\xb6\xc5\xda\x36\xd3\xb6\xe4\xb6\xe6\x08\x3b\xbd\xad\x85\xde\xd6\x42\x6f\x68\xa1\x37\x48\xdb\x5c\xdb\x52\x69\xc3\x85" +
+		"\xb7\x15\x36\x01\xc2\x4d\xed\xa3\xb9\x7d\xb4\xb0\x8f\xc2\xdb\x0a\x0b\x81\x70\x91\x7d\x34\xb5\x8f\x62\xfb\x68\x66\x1f\x25\xf6\xd1" +
+		"\xdc\x3e\x4a\xed\xa3\x85\x7e\xc4\x15\x36\xb1\x3f\x0b\x6f\x2b\x2c\xb2\x1e\x45\x76\x6b\x91\xfc\x58\x18\x7c\xde\x56\xd8\xa4\x49\x30" +
+		"\x4d\x19\x71\x91\xb6\x4d\x75\xdc\x76\xa5\x58\x27\x59\xac\xdf\x9b\xe9\xf5\x66\x7a\xa5\x99\xce\xaa\x99\x5e\x2f\xd1\xb9\x95\xfc\x4f" +
+		"\x81\x46\xee\x29\xd5\x5f\x4b\xf5\x7a\xa9\x42\xa4\xf4\xbf\x14\xbc\xa2\x9f\x94\x9b\x80\x7e\xa4\x9c\x80\x13\x08\xba\x26\x85\xd2\x14" +
+		"\x49\xd3\x54\x9a\x62\x69\x9a\x49\x53\x22\x4d\x73\x69\x4a\xa5\x69\x01\xb8\x22\xb9\xb9\x48\x6e\x2e\x92\x9b\x8b\xe4\xe6\x22\xb9\xb9" +
+		"\x48\x6e\x2e\x92\x9b\x8b\xe4\xe6\x22\xb9\xb9\xa9\x3c\xbe\xa9\xf4\x68\x2a\x3d\x9a\x4a\x8f\xa6\xd2\xa3\xa9\xf4\x68\x2a\x3d\x9a\x4a" +
+		"\x8f\xa6\xd2\xa3\xa9\xf4\x28\x96\x1e\xc5\xd2\xa3\x58\x7a\x14\x4b\x8f\x62\xe9\x51\x2c\x3d\x8a\xa5\x47\xb1\xf4\x28\x96\x1e\xc5\xd2" +
+		"\xa3\x99\xf4\x68\x26\x3d\x9a\x49\x8f\x66\xd2\xa3\x99\xf4\x68\x26\x3d\x9a\x49\x8f\x66\xd2\xa3\x99\xf4\x68\x26\x3d\x4a\xa4\x47\x89" +
+		"\xf4\x28\x91\x1e\x25\xd2\xa3\x44\x7a\x94\x48\x8f\x12\xe9\x51\x22\x3d\x4a\xa4\x47\x89\xf4\x68\x2e\x3d\x9a\x4b\x8f\xe6\xd2\xa3\xb9" +
+		"\xf4\x68\x2e\x3d\x9a\x4b\x8f\xe6\xd2\xa3\xb9\xf4\x68\x2e\x3d\x9a\x4b\x8f\x52\xe9\x51\x2a\x3d\x4a\xa5\x47\xa9\xf4\x28\x95\x1e\xa5" +
+		"\xd2\xa3\x54\x7a\x94\x4a\x8f\x52\xe9\x51\x2a\x3d\x5a\x48\x8f\x16\xd2\xa3\x85\xf4\x68\x21\x3d\x5a\x48\x8f\x16\xd2\xa3\x85\xf4\x68" +
+		"\x21\x3d\x5a\x48\x8f\x16\x82\x57\xb2\x9e\xc9\x39\x7f\x43\x7f\x4f\x6e\xf6\x37\x54\xfd\x9c\x8f\x64\xe5\xb7\x2c\xe3\xff\x79\x06\x1f" +
+		"\x17\xf6\x5e\xa8\xff\xd3\x8f\x8d\xee\x09\x03\x6e\xc5\x04\x76\x1c\x46\xfb\xd2\x73\x78\xf0\xa5\xbb\xbf\xf2\xc9\xb4\xe0\x4b\x7f\xff" +
+		"\xd3\xc9\x31\xc1\x97\xce\xfe\x9e\xe3\xfe\xca\xa7\x7a\xa5\xe7\x70\xae\x98\x40\x70\x48\x6f\x82\x9f\x44\x2c\xec\x70\xde\x63\x60\xc5" +
+		"\x60\x82\x6f\xbd\x4b\x44\xe7\x2c\x71\x88\x4a\x72\xff\xe5\x04\xb7\x7f\x40\xb0\xc7\x3a\x82\x67\x5f\xb6\x64\x13\x5d\x1c\x38\xae\x0e" +
+		"\xd8\xeb\x46\x70\x56\x12\xf8\x51\x8a\xa5\x54\x1c\x9a\x0c\xae\xcd\x00\xcf\xf5\xfc\x7b\xdc\x7c\xe6\x65\x70\x9b\x8c\xe5\xed\x7f\xfe" +
+		"\x3b\x0c\x63\x68\x85\x89\x9f\x94\x29\x0b\xfa\x35\xb8\xd9\x48\x44\xe2\x5b\xff\xd8\xc2\x7f\xbf\x2d\x9c\xb3\x85\xc8\x79\x1a\x3c\x72" +
+		"\xeb\xdf\x65\x0b\xdd\x76\x39\x01\x1c\x90\x80\xe8\x10\xf5\x9a\x46\xa4\x6a\x8f\x68\x8f\xcb\xa4\x19\xf5\x67\x70\xd7\x6f\xc0\xce\x69" +
+		"\x96\x62\x74\xc3\x7d\x60\xef\xae\x84\x5b\x31\xd4\xf9\x48\x8f\x74\xef\xa4\x5e\xf3\x27\x70\xd1\x9f\x40\x49\xcd\x7b\x92\xe0\x67\x3f" +
+		"\x01\xdf\x4c\xa9\xb2\x69\x78\x40\xbc\xa8\x9f\xae\xb2\xe3\xa0\xff\x3b\xdc\x45\xb8\xa1\x13\x5e\xe6\x84\x67\xb9\x42\xe9\x51\x28\x3d" +
+		"\x0a\xa5\x47\xa1\xf4\x28\x94\x1e\x85\xd2\xa3\x50\xf9\x51\x93\x7f\x30\xa5\xff\xeb\x30\x7a\x51\x5f\x22\xe7\x77\xff\xe5\xb8\x0d\x0f" +
+		"\x7f\x44\x70\x4d\x18\x5c\x23\x25\x73\xd2\xc0\x41\x99\x41\x52\xdc\x43\xc7\x08\x8e\x8c\xaf\x8a\xec\x17\x27\x10\x5c\x32\x30\x10\xa3" +
+		"\xe8\x0c\xd7\xed\xb3\x28\xf8\x6c\x1a\x7c\x16\x07\x9f\xcd\x82\xcf\x92\xe0\xb3\x79\xf0\x59\x1a\x7c\xfe\x63\x0f\xfc\xbf\x66\x0f\x3c" +
+		"\xf0\xff\xc6\x1d\xd0\x69\x07\xc1\x2e\x5f\x10\x3c\x9c\x58\x75\x2f\xec\x5e\x48\xb0\x6b\x06\xf8\xd9\x80\x7f\xec\x87\x7f\xec\x87\xff" +
+		"\x36\xfb\xe1\x2f\x39\xc2\xc7\x13\x09\xee\xff\xc7\x0e\xf8\xc7\x0e\xf8\xef\xba\x03\x44\x6b\xfe\x07\xf2\xff\x03\xf9\xff\xfb\x92\xff" +
+		"\x55\xa5\x60\x16\x78\xb2\x3d\xe0\x1e\x68\x07\x96\xff\x93\x8e\xe9\x8c\xfc\xdd\xe1\xc7\xe0\xdd\x7a\x09\xa1\x27\x6f\x7b\x52\x7e\xe0" +
+		"\x55\x29\x5e\xd9\x5e\x3d\xc9\xf7\x83\xdd\xdb\x83\xe7\xef\x05\x73\xc1\x44\x09\x56\xba\xed\xd7\x40\xe8\x37\xb7\xfd\x1a\xae\x13\x01" +
+		"\xf7\xf1\xe3\xbb\x1e\xbf\xfe\x31\xa6\x6c\x6d\x19\xdc\xb4\x50\x30\x64\x84\x26\x94\x1d\x2f\x9b\x57\x66\xdf\x27\x95\xbd\x5b\x36\xa7" +
+		"\x0c\x24\x18\x01\x12\x26\x97\x9d\x2b\x9b\x51\xb6\xa0\x6c\x6d\xd9\xc9\xb2\x2b\x65\x07\xf4\x07\x84\xe5\xaa\x5c\xb1\x2e\x53\xca\xde" +
+		"\x2f\xdb\x59\x06\x3a\x99\x3d\x22\xd3\xca\x4e\x96\x1d\x2b\x7b\xbf\x6c\x6d\xd9\xf1\x32\x0b\xda\x91\x07\x58\xe7\x30\x10\xf7\x6e\xd9" +
+		"\xf1\xb2\xb9\x65\x57\xca\xd6\x95\x21\xec\x10\x52\x40\xc2\x7d\x50\x36\xa5\xcc\x76\xef\x9a\xb2\x2f\xcb\x80\xf0\xba\xb2\x2f\xcb\xde" +
+		"\x2d\xdb\x57\x06\x17\x01\x42\x5b\xca\xae\x94\xad\x0a\x1e\xb7\xb5\x6c\x41\xd9\x14\x79\xb8\x5c\x3e\x21\x5f\x42\x4e\x1f\x1d\xfe\xb8" +
+		"\x6c\x74\xd9\x97\xf2\x60\xf9\x3a\xb1\xec\x80\x4e\x34\x0c\xc6\x80\xd0\xae\xb2\x77\xe5\x4f\xc4\xdb\x97\x2a\x0f\xd9\x53\xb6\xa8\x6c" +
+		"\x59\x99\x42\xce\xed\xd1\x6f\xee\xfa\x39\xa9\x50\x1c\xc2\x91\x20\x91\xa5\x8e\xe4\x48\xd9\x15\x99\x39\x9c\xbe\xc8\x83\xf0\x78\xd9" +
+		"\xbb\x65\x0b\xca\xf4\xcb\xf6\xb2\x31\x0a\x26\x17\xae\x84\xf5\x3b\xfc\xbb\xc2\x7a\xd5\x7f\x02\xeb\xcd\xff\x1d\x60\xcd\x07\xae\x87" +
+		"\xcb\x21\xbc\x68\x02\x57\x9e\xf0\x81\x61\x08\x1f\x99\xcb\xd1\x5b\xae\xff\x79\x66\x0c\x7b\x4e\xe2\xaa\xcb\xec\x36\x49\xfe\xdc\x3e" +
+		"\xe1\x2f\xfe\xec\x36\x89\x1f\xbc\x7d\x3d\xc6\xac\xdb\x24\xf6\x9a\xc4\xca\x3f\x97\x9e\xe5\xf5\x90\x33\x84\x07\xcd\xe3\xee\xf5\xd7" +
+		"\xff\xfc\x7a\xb4\x5b\x36\x92\xc7\x8e\xf1\xe2\xc4\xff\x6b\x86\xc1\xa1\x1f\xd2\x0e\xc2\x76\xa6\x55\x94\x0b\x83\x63\xea\x83\x44\x38" +
+		"\x49\x43\x0b\xe3\xe0\xf6\x49\xa8\xee\x4e\x69\x7a\xb4\x16\xe0\xf3\xf8\x9f\x8d\x96\xb4\xbf\xeb\xc7\xe0\x84\x72\x82\xeb\xfe\x00\x9e" +
+		"\x7f\x05\xfc\x5a\xa2\x6f\x5e\x27\xb8\xdc\x0e\x28\x65\x00\x6e\xd3\x58\xd7\xf9\x28\xe1\xa6\x57\x10\x8c\x80\x7b\x0f\x46\xc0\xcf\xbe" +
+		"\x89\xbf\xee\x87\xe6\x90\xa5\x04\x81\x50\x2a\x78\xe6\x7b\x60\x0e\xb8\xb9\x23\xc1\xb9\xd2\xe4\x80\x9d\xd2\x00\xf7\xe8\xa3\xe0\xc9" +
+		"\x04\x70\x08\xc1\x16\x70\x8f\x21\x3c\xab\xc4\x8f\xb0\xe7\x92\x08\xc2\x13\x97\x44\xaa\xfe\xcd\xee\x5f\x45\x3c\xe1\x8e\xf3\xe7\xbc" +
+		"\x42\xe0\xdf\xbc\x18\xf7\xb7\x2e\x9e\x3e\x25\xa1\x9f\x57\x23\x70\x7d\x5a\xdb\xdc\x09\x6e\xab\x09\xc2\xff\xbb\x50\xd7\x4a\x08\x38" +
+		"\x70\xaf\xcc\xae\xc7\x6a\x6a\xcf\xb9\xce\x9f\xd7\xb7\xb3\x2c\x31\x0b\x60\x4b\x03\x77\x87\x7c\xfa\x87\x91\xa9\x55\xce\x8c\x15\x82" +
+		"\x2f\xc3\x59\x44\xc3\x73\x20\xe1\x2c\xbf\x6a\xd8\x27\x10\xdd\x67\x79\x5b\xb6\x37\x02\xb7\x16\x5b\x11\x99\xff\xa7\x4f\x89\x13\x26" +
+		"\x28\x6b\xc6\x2a\xe3\x45\x95\x4c\xbe\x12\xcf\x03\x70\x7c\xdc\x7f\xb8\xc5\xfe\x4c\xf3\x47\x88\x4b\xc0\x5b\xc1\xdd\x52\x58\x20\xf7" +
+		"\xfa\x5d\xee\x70\x01\xc2\xd4\xbe\x7d\xac\xc3\x72\xfd\x70\xb7\x20\xba\x80\xe0\x40\x22\xd2\x8f\x60\x4f\x22\xd4\x9d\x68\x03\xb8\x6d" +
+		"\x04\x43\x88\xce\xf9\x9b\xbf\x86\x23\x70\x0b\xf8\xbf\xdf\x77\xe0\x1c\x09\xe8\x95\x66\xc4\xfc\x08\x38\xfc\xdb\x88\x55\x8f\xb1\xe3" +
+		"\x5d\xb7\x80\x84\x1b\x78\x2b\xdc\x0a\xc2\x6d\x5f\xe0\x03\xd0\x8f\x2c\x0a\xbe\x45\xd7\x2d\x23\x92\xfa\xc8\x1c\xfa\x13\x91\xb7\x08" +
+		"\x56\x10\x77\xbf\x41\x70\xfc\x7f\x0c\x2f\xb1\xa8\xb3\x2a\x51\xe7\x49\xf5\xfe\xd3\x7e\x6e\x30\xc1\xa7\xff\xa2\x17\x0d\x9e\xbb\xbe" +
+		"\x22\x78\x71\x86\xfb\xaf\x19\x62\x02\xfd\x37\x91\xff\x49\x84\x89\xfd\xfb\x2c\xde\xea\x6b\xff\x23\xc0\xe4\x1f\x01\x26\x7f\x15\x60" +
+		"\xd2\xcf\xfd\xef\x32\x22\x56\x48\x72\x46\xa9\x09\x70\x26\x04\x2e\x97\x07\xbc\xbb\x9c\xe0\xa6\xe9\x61\xf0\x66\x30\x0d\x4c\x01\x27" +
+		"\x6a\xa4\xae\xd4\x60\x99\x61\x04\xb7\x96\x3f\xc0\xff\xf9\xe6\x10\x38\x4c\x70\xf9\x57\xe0\x63\xe0\xf1\xdd\x61\xab\xbe\x53\x71\xbf" +
+		"\x94\x9a\x0c\x2b\x1c\xf5\x82\x25\xf8\x48\xd0\x53\xa9\xd5\xfd\x91\xe4\xd1\xef\x85\xad\x2a\xe5\xa1\xef\x83\xa7\xf6\x67\x82\x9f\x7e" +
+		"\x6b\xa7\x2d\xef\xd4\x37\xcc\x14\x90\x2c\x3c\x63\xc1\xc6\x9b\x65\x61\xcf\x0f\x89\x53\x99\xe4\xa3\x63\x61\x39\x6f\x20\x28\x37\x24" +
+		"\x04\xae\x97\x66\xc3\x15\x07\x6e\xb9\x62\x39\xd2\x7f\x00\x36\x04\xaf\xca\x69\xba\xb9\x9b\x2d\x8a\xb6\x08\xfc\xbe\x00\x8f\x5f\x4f" +
+		"\x96\x78\xec\x29\x76\x64\x72\xe8\xa2\x30\x38\x59\x9a\x8f\x17\x88\x6a\x21\xcd\x17\xfb\x05\x84\x07\xc2\xe0\xa1\x30\x78\xcd\x59\x2a" +
+		"\xba\x79\xb2\x4b\x4f\xbc\x1b\x06\xfb\x6d\x0c\x83\x07\xa5\xbc\x85\xf4\x7b\x7b\x58\x08\xfc\x4e\xd1\x7b\x0b\xc1\xce\x13\x08\x0e\xfd" +
+		"\x85\x1d\x60\x1c\xd1\x9b\xe0\xde\x7a\x76\x68\x60\xe6\x77\x04\x37\xce\x95\xe6\x5e\x70\xe7\x59\x82\x6b\x06\x38\x2b\x63\xb6\x6e\x3c" +
+		"\xc1\xbe\xcd\xc1\xf9\x03\x09\xbe\xd7\xde\x0a\xd8\xf7\x79\x00\x1c\xab\xc5\x9d\xb2\xc0\xd9\xf9\x56\x89\x68\x66\x0d\xb0\xef\x70\x82" +
+		"\x4b\x16\x51\x56\x90\xe0\x0a\xd9\x3e\xf3\x57\x12\x5c\xf4\x00\xf8\x61\x2f\x82\xc7\x73\xc0\x35\x13\x09\x0e\x9f\x44\x70\xf2\xed\xe0" +
+		"\xe5\x8d\x04\xb7\x6a\x5d\xdc\xff\x01\xf6\x91\xc7\x77\xb0\xe2\x0a\x03\xef\x02\x0f\x67\x80\xeb\xa3\xe0\x59\x29\x0b\x77\x13\x38\x45" +
+		"\x46\x7c\xe4\x77\xe0\xc7\xcb\xe4\x8b\x44\xdd\x4a\x11\xcc\xd5\x04\x87\x48\xd9\xd0\x73\x04\x57\x9e\x24\x38\x67\x78\x50\xf0\xeb\xc0" +
+		"\xcd\xe0\xee\x27\x02\x4a\x55\x41\x70\xda\x69\x82\xbb\xea\x83\xb3\xee\x01\xb7\xff\xdc\xea\x15\xcf\xc8\x01\x27\xbe\x47\x70\x92\x84" +
+		"\x07\xbf\x0c\x7e\x54\x0d\x1c\x17\x05\xc7\x2e\x25\xd8\x53\x26\xb2\x5f\x0b\x54\xb4\x04\x8f\x0e\xa2\x95\xa3\xbc\xd6\x0c\xec\xb1\xc4" +
+		"\x38\x0c\x47\xc9\xf4\xde\x78\x16\xec\x38\x92\x60\xf7\x1e\x04\x77\x4a\x55\xb4\xe9\x04\x2f\x3e\x0f\xae\xef\x43\x70\xd9\xa7\xb4\x42" +
+		"\x5f\xbd\xe5\xb0\xe0\xe3\xe0\xde\xa6\x56\x66\xe7\x33\x29\x58\x76\x2f\xf8\x85\xec\xba\xbd\x52\x03\xba\x11\xb8\xfe\x5f\x2d\x25\x94" +
+		"\x14\x71\xfa\xbc\x2d\xf8\xed\xc7\x04\xb7\xdc\x0f\x2e\xfd\x21\xb8\xb5\x05\xb8\xe3\x09\x70\x6c\x08\xfc\x3d\xb8\xfb\x5d\x19\x7c\x85" +
+		"\xd0\xaa\xda\x4a\x18\x37\xbf\x02\xe6\x81\xdf\xfd\xc0\x42\x02\x86\x85\x8d\xaf\x1f\xd9\x2b\xde\xfc\x9d\x52\x0f\xac\xb7\x03\xf7\x97" +
+		"\x3b\x2b\x68\x34\x98\xe0\xe2\x88\x1d\x26\x9b\x1f\x84\xf8\x8f\x8a\x82\x2f\x80\xe5\x54\xb4\x9f\x1d\x84\xe4\x77\x8c\x03\xd7\xd3\x92" +
+		"\xd5\x6c\xa7\x85\x53\x0e\x4b\x04\x87\x0a\x9e\x86\xec\x9e\xf2\xb0\x05\x31\xbf\x6a\x85\x10\xff\x60\x21\xd7\x0b\x9d\xbd\xe7\xb4\xc5" +
+		"\x9e\x8f\x4a\xb0\xa0\xee\x9b\x8c\xd4\x75\x4a\xb2\x82\x28\x43\x2c\x04\xbd\x93\x11\xe4\x8d\x71\x76\x2c\x6d\x0a\xad\x08\xfa\xd2\x20" +
+		"\x04\x76\x61\xa2\xdd\xb2\x9d\x96\xf0\xf7\x6c\x1c\xf8\x0c\x78\x96\x3e\x3d\xa2\x1d\xda\x5e\x68\x29\xe2\x3b\x80\x4d\xac\xde\xe1\x9a" +
+		"\x38\x3b\x4e\x50\x11\x67\x47\xf8\xb6\x18\xc9\x2d\xd5\xdb\xf2\x95\x5c\x44\xc1\xbd\xce\xce\xac\x64\x82\xd7\xda\x81\xdf\xb4\xd1\xdf" +
+		"\x44\xce\x7d\x83\xe0\x2a\xdb\xfa\x96\x53\x29\x17\x24\xf8\xb8\x3f\x30\x6c\x29\xaa\x2e\x47\xad\x00\xd6\x7a\x07\xb6\x55\x8e\x32\xd7" +
+		"\x19\xa4\x46\x05\x85\x47\xfa\x58\x22\x80\xbd\x04\x4b\xac\x08\x57\x9c\x1d\xd6\x38\x1b\x06\xa7\x9d\xa5\x55\x75\x7d\x33\x0b\x9c\x24" +
+		"\x98\x7c\xb8\x36\xd8\x1e\x1c\xf0\x18\x78\xe0\x7e\xf0\xcd\x8b\x0e\x6c\x0a\x4e\x58\x4e\x70\xf0\xc3\xe0\xbe\x5f\x83\x9d\xea\x80\x9f" +
+		"\xb4\xb1\xe2\xe7\x57\x92\xc0\xaf\xf7\x10\xbc\x92\x01\xf6\x91\x82\xde\xab\x47\x48\xca\xe1\x57\xad\xb2\xf4\x92\x59\x82\x95\xd9\x60" +
+		"\xa7\xd7\x09\xbe\x35\x93\xe0\x85\xb7\x1c\xb8\x40\x88\xf6\xdc\xa5\xc1\x1c\x27\xd7\x01\x37\xc6\xc0\xde\xe3\x45\xbc\xed\x91\x61\xeb" +
+		"\xfc\xee\x51\x82\xc7\xbe\x0f\x7e\xf3\xa0\x51\x00\xe8\xbf\xf2\x7d\x99\xe0\xe6\x25\x99\xe0\xc4\x04\x70\xb3\x56\x5e\x19\x29\x74\x58" +
+		"\x5c\x3e\x8b\xe4\x34\x6b\x9f\x6a\x60\xaf\xbb\xc1\xcf\x87\x12\x5c\xfc\x24\x78\xb6\x2e\x28\x94\x73\xd8\xf7\xc1\x61\x52\x3d\x30\x11" +
+		"\xfc\xba\x03\xf8\xe1\x42\x82\x9f\xfe\xc9\x3f\xf9\xd2\x3b\x04\xd7\xd7\x02\x2f\x85\xad\x28\xfc\x8c\x9e\x04\x77\x48\x29\xa4\x0d\x49" +
+		"\xe0\xa0\x5d\x72\xb2\x4a\x2b\xb3\xd4\x03\x2f\x9e\x0e\x4e\x3a\xec\xb9\x0d\x3c\xf6\x7b\xf0\xd0\xab\x56\xf0\x72\xe0\x54\xf9\x61\x49" +
+		"\x1a\x08\x70\xcb\x71\x07\x9e\x39\x94\x09\x4e\x15\x9a\x79\x13\x78\x76\x66\x0c\x04\xd8\x45\x4a\xa4\xf7\xc9\x05\x67\xc4\x81\x53\x36" +
+		"\x10\x7c\x77\x3f\xc1\x2d\xe5\x21\x70\x99\x50\xca\xd3\xb2\xbd\x0f\xbd\x2d\x88\xff\x11\xc1\x0f\x6e\x03\x87\xfc\x14\xdc\x39\x80\xe0" +
+		"\x9b\x1d\xc0\x13\xbd\xa5\x6a\xf1\xc1\xe0\xa8\xc8\xa6\x05\x04\xbf\xb9\x42\xb0\xeb\xbf\x83\xcb\x1b\x81\x3b\x7f\x08\x0e\xfa\x8c\x60" +
+		"\xc7\x1e\x04\x2f\x39\xf0\xe8\xdb\xd5\xa5\x48\x5b\x26\xb8\xf1\x69\xa3\x57\xfd\xab\x83\x9d\x8e\x26\x81\xab\x5f\x4f\x06\x07\xc8\x1b" +
+		"\xc7\x6c\xa3\xd5\x2d\xfa\x42\x76\xe6\x16\xa9\x80\x2b\x53\xbe\x3a\x2b\x28\xd8\x75\x36\x6c\x6b\xb6\x36\x1b\x3c\xbd\x58\x56\x61\x8a" +
+		"\x3c\xf0\x07\x56\xb1\xfa\x8a\x1c\xef\xaf\x6d\x55\xea\xbf\x91\xa5\x39\x7d\x9e\x60\xaf\xd6\xe0\x92\x42\x3b\x7e\x7c\xa8\xb3\x6c\x4e" +
+		"\x59\xfe\x4f\x65\x5a\x32\xdd\xd3\x92\xe2\xb9\x36\xb8\xbd\x0c\xdc\xbc\x96\x86\x54\x9b\x13\xc1\x19\x32\xf2\x8f\x26\x12\xec\x7d\x07" +
+		"\x08\x2b\x5e\xbf\x4f\xa8\x27\xac\x4a\x14\xc0\x0d\x5d\xed\xcf\xed\xf3\xe4\xd8\xbd\x1c\x83\x5d\xbf\x3f\x19\x3c\x39\x2a\x05\x3c\x9a" +
+		"\x00\x0e\x93\xe5\xb8\x32\x3c\x03\xfc\x5c\xec\x65\xbb\x86\x66\x4a\x29\xc1\x0c\x70\x8e\xbc\xe9\xf8\xdd\xe0\x7c\x39\x9e\xd2\x0a\x3c" +
+		"\xb5\x2d\x13\xfc\xe6\x78\x86\xd1\xa3\xa3\x37\x5a\x0d\xe4\xf7\x65\xbc\xdd\x0e\x13\x5c\x98\x07\x0e\x97\x4e\x93\xd6\x13\x04\xf8\xa5" +
+		"\x96\xcd\x4c\x07\xbb\x97\x80\x53\x72\xac\x22\xb8\x94\x57\xbe\x72\x36\x13\xee\xc3\x89\x52\xed\x7e\x2e\xc1\x7d\xdf\xb7\xc2\x9d\x67" +
+		"\xf3\xc1\x63\x57\x45\x90\x91\xa2\xc2\xa3\xce\x49\xc5\x7d\x41\xe6\xd1\x52\x57\x4f\xe8\xe3\x67\xcb\x08\xf6\x8d\x82\x67\xce\xca\x42" +
+		"\x0a\x8b\xe8\x2c\xcd\xbe\x02\x70\xcb\xe3\xe0\xa2\x72\x82\x2b\xbf\x25\x38\xe2\x5d\x99\xc6\xb8\x0c\xf0\x64\x17\xd9\xf0\x1b\x52\xc0" +
+		"\x25\x4b\x05\xa3\x1a\x80\xd7\xe6\xd1\x94\xcc\x2c\xf0\x9d\x5a\x76\xb6\x7a\xf5\x09\x07\x76\x7d\x19\x1c\x25\xe5\x60\xa6\xbd\x21\xf5" +
+		"\x34\x6f\x02\xb7\xd7\x05\x97\x5b\xae\xb3\x31\x45\x20\xc0\x69\x7f\x00\x3b\xe5\x5a\x6d\xfa\x4f\x7e\x05\xbe\x2d\xb4\xef\x0e\xb0\x63" +
+		"\x21\xf8\xce\x33\xe0\xd0\xe7\xc1\x31\xcd\xc0\xbe\xad\xc1\x19\x51\xf0\x5a\x29\x28\xab\x74\x89\x96\x27\x73\x6e\x3b\xf0\xa8\x4c\xba" +
+		"\x1d\xd8\xff\xe7\xd7\x6b\x97\x0b\x22\x4f\xe9\x12\x02\x27\x4b\xf3\x4e\x0a\xf8\xdd\x4a\x82\x00\xd7\x25\x82\xb3\x7a\xa5\x82\xdf\xbe" +
+		"\x57\xa5\x7a\x77\x75\xf0\x0b\xe1\x32\xdf\xf5\x23\xd8\xf1\x34\xc1\x71\x1d\x09\x0e\x6d\x09\x1e\x92\xdd\x32\xf9\x45\x70\xb0\xac\x79" +
+		"\x77\xa9\x34\x7b\xf5\x4d\xd9\x2c\xff\x02\xf6\x5f\x22\xe3\x88\x81\x73\x66\x3b\x70\xcd\x2c\x07\x5e\x7b\x10\x5c\x32\x8a\x56\x5c\x7a" +
+		"\x8b\x60\x4f\xff\x6f\xd3\xc1\x75\xb2\x2b\x25\x1e\x69\x87\x54\x85\xf9\x20\x13\x1c\xb9\x20\x03\x1c\xf0\x23\xab\xe3\x0c\xf0\x4c\x0b" +
+		"\x70\xe5\xa7\x76\x82\x68\xff\x41\x82\x67\xbf\x24\x38\xe7\x82\xbc\xbd\x3e\xb8\xf7\x7f\x80\xbd\x62\xe0\xfa\x5f\x82\x17\xe5\xf5\x5a" +
+		"\x60\xf8\x05\xb0\xe7\xb7\xb4\xbc\x03\x33\x65\xbd\xe6\x4b\x81\xe5\x85\x83\xe2\xa5\xb8\x5b\x75\xf0\x48\xbf\x38\x70\x89\x86\xd8\x67" +
+		"\x5a\x19\xbd\x6c\xab\x2a\xfe\xbe\x40\xb0\x42\x4b\xdb\xc9\x6c\xa7\x3c\x01\x2e\xfd\x29\xb8\x6a\xb1\xfc\xb1\x96\xe0\xec\xef\x07\x55" +
+		"\x63\xc7\x2d\x92\xf3\x3f\x67\x42\x76\xe6\xf3\xad\xdf\x81\x97\x7e\x08\x2e\x9b\x43\x70\xfb\x5a\x5a\x41\xd4\x91\x3f\xb0\xfa\xee\x23" +
+		"\xd7\x49\xcf\x72\x06\x47\xe5\xbf\xe9\x5e\xa5\x2a\xe9\xa5\x86\xe0\xb0\x9f\x83\x1f\x4b\x6d\xd7\x7f\x07\x2f\x35\x02\x47\xbf\x4f\x70" +
+		"\xc8\x35\x82\x9d\x45\xaf\x1c\xde\x97\xe0\x05\x59\xa8\x33\xd5\xc1\x13\xdf\x08\xca\xce\xcf\x00\x2f\x4e\x27\xb8\xeb\x51\x70\xc9\xaf" +
+		"\xc1\x31\x8d\xc0\x53\xb5\xc1\xc3\xaf\x9a\x61\x60\xa0\x80\x4a\xde\xb6\xfb\xa4\x00\xfb\xae\xca\xda\x8f\x9a\x88\xbe\xb3\x6c\xfb\x59" +
+		"\x73\xa5\xff\x0f\x82\x92\x8d\x0f\x80\xe7\xc6\x11\x5c\xdb\xc0\xaa\x7d\x49\xd9\xb8\x4d\xf7\x5c\x2f\x8e\xb8\x37\x19\x9c\x6d\xe8\x39" +
+		"\xfd\x46\x70\x9f\x54\x2c\x5e\xe4\xc0\xf9\xad\xae\xd7\x3a\x94\x51\x01\x7c\xab\x03\x38\xfd\x9f\xc0\x71\xbf\x09\xca\x0e\xf6\x1e\xc6" +
+		"\x2a\xe5\x06\xdf\x97\x97\x5f\x8c\x0b\xca\x07\x0e\x16\x7b\x75\xaf\x2e\xbe\x42\xe0\xc9\x97\xc0\x73\xff\x7a\xbd\x28\xdf\xe7\xbb\x09" +
+		"\x7e\x91\x03\xae\xea\x43\x70\x7d\x5d\x70\x9e\x6c\xa0\x7f\xb5\xb2\x78\x17\xa5\x76\x71\x5b\x70\xfc\x60\x1a\xb6\x10\xfc\x4a\x2a\xeb" +
+		"\x0b\x5e\xe5\x81\xef\x0e\xab\x0e\x96\xff\x33\xb8\xa7\x09\x1c\xc0\x3e\x82\xe2\x5f\x0e\x8e\x81\x9f\x8c\x17\x1c\x3d\x20\xb0\x11\xf6" +
+		"\xd0\xb7\x15\xf8\xed\x53\xca\xda\xcf\x4b\xa5\xf1\x77\x42\xe0\xbb\x03\xc3\x60\xcf\x19\x04\x01\x9e\xf8\xc2\x81\x9d\x85\xe8\x8e\xb8" +
+		"\x09\x9c\xd0\x8f\xd7\x8b\x99\x7d\xd0\x4d\x26\x76\x5e\xf0\x41\xe4\xf5\xfe\xd2\x74\x59\x1f\x06\x77\x3c\x09\xee\x1d\x92\x0a\x6e\xd8" +
+		"\x99\x66\xc2\xc0\x8b\x60\x27\x01\x76\x4f\x82\x3d\xfb\x04\xc2\xe4\x91\xb9\x29\xe0\xaa\x7d\xf1\xe0\x27\x3d\xa4\xa2\xe0\x1f\xad\xae" +
+		"\xd1\x5b\x41\x69\xfd\xe1\x11\x70\x47\x22\x78\x36\xd1\x44\xae\x8d\xc2\x45\x1c\x78\xea\x9c\xaa\x27\xa2\xba\xc8\x93\xba\xf5\xa7\x29" +
+		"\xf0\x83\x5a\x83\x73\xdb\x80\x47\x23\xe0\xd2\x03\x52\x4d\x6e\x67\xa6\xd5\x88\x9b\x42\x3b\x1b\x78\x70\x40\x1a\xf8\xed\xdb\x69\x56" +
+		"\xf5\x1a\x60\x82\x89\x9e\x1b\x65\x03\x7e\x2b\x5b\xa2\x36\xb8\x2d\x0e\xdc\x4f\x70\x51\x09\x08\x70\x42\x73\x2b\x6d\x2f\x25\xde\xcf" +
+		"\x85\xac\x3c\xc1\x27\x49\x20\xc0\x83\x29\x56\xad\x76\x56\x08\x1c\x2e\x43\x39\x20\xd0\xd9\x1b\x51\x21\xa6\xa7\x33\x71\x50\xde\xbb" +
+		"\x60\x0f\xc1\xb3\xfb\x08\xbe\x66\x82\x71\x3d\x10\xe0\xe9\x41\xf2\xdb\x40\x82\x93\x7f\x06\xee\x7f\xc8\xa4\x4d\x80\xfb\x8f\x10\xdc" +
+		"\xf5\x05\xc1\x34\x13\x1e\x37\x9e\xce\x00\xfb\x9d\x34\x2c\xbb\x4f\x85\xc9\x5c\xb0\x2f\xe1\xba\x59\x8f\xa5\x3d\x53\xe1\x3e\xae\x48" +
+		"\x55\x41\x4c\x98\xfa\x27\xe7\x08\x56\x5c\xa2\x26\xd6\x3a\xb7\x49\xc8\xd1\x16\x07\x7e\xb4\x9a\xe0\xca\x0f\x64\x15\x82\x4c\x91\xa7" +
+		"\xc2\xe0\x70\x61\xf9\xab\x5e\x77\xa0\x0c\xf4\xd2\xb1\x24\xf0\xcc\xe7\x96\x53\xb5\x7f\xc8\x0e\x5b\x7d\x17\x06\xcf\x46\xc0\x37\xe5" +
+		"\x29\x33\xb6\x39\x4b\xe8\xb0\x38\x48\xca\x50\x0a\xce\x4a\xd0\x91\x7d\x3e\xd0\x81\xc3\x84\x6e\x37\xb2\xaa\x70\xb2\x91\xe5\xcf\x76" +
+		"\x60\x63\xb0\xc4\x6a\x17\x9c\x75\x60\xfa\x5f\x9a\xef\x00\xf2\x3f\x5c\xf9\x8f\xff\xae\x1c\x4a\x05\x3f\xda\x91\x6a\x05\x8f\xe7\x7e" +
+		"\x96\x0a\xae\xfb\x54\xaa\xee\x3b\x13\x83\x0f\x3b\x9f\x9d\xe9\x15\x6f\xa5\xb9\xec\x2a\x93\x58\xf6\x77\x76\xca\x38\xb0\xe5\xd0\x4a" +
+		"\x3d\xde\xaf\x37\x97\x13\x2c\xd2\x39\x1f\x91\xea\xbc\x3b\x7b\x1a\x64\xc7\x7e\x21\x5a\xb8\xec\xd0\xb7\xe3\xed\xf4\x73\xf7\x42\x3b" +
+		"\x74\x2e\xc5\x54\xaf\x74\x8a\x03\x47\x89\xe2\xfc\xc9\x82\x4c\x70\xa0\x10\xff\xdd\x02\xc4\xab\x5f\x25\xc1\x0d\x3d\xcd\x20\x4d\x9a" +
+		"\x18\x49\xf3\x7d\xb5\x9c\xf6\xbe\x30\x4e\x5b\x5f\x0b\xee\x76\x9f\x3b\x28\xd3\x8c\x33\xe6\xf8\xca\x12\x88\xba\x88\x0e\xeb\x7e\xb0" +
+		"\x40\xf8\x93\xfc\x99\xa7\x3f\x65\x83\x31\x9d\x8d\xe5\x9a\xfa\x39\x38\x89\xbe\x1c\xd1\xe3\xbe\xec\x4a\xb2\xaf\x92\x16\xf5\xc9\x04" +
+		"\x3b\xd1\xa6\x5a\x12\x24\xbf\x64\x32\x5c\x8e\x3e\xfc\x79\x6f\xd5\x79\x04\x7c\x0e\x7c\x00\xae\x58\xb5\x8d\x5c\x9f\x36\x36\x4b\xf1" +
+		"\xf7\x31\x9f\xec\xd1\xb2\xab\x15\xf9\x44\x48\x45\xda\x66\xf9\xec\x4f\xc9\x3e\xdd\x5c\x27\xe3\x61\x99\x60\x43\xb8\x46\x3a\x93\x64" +
+		"\x33\x10\x25\xcb\x4b\xae\x1f\xf5\xb4\xb2\x5a\x11\x9f\xa5\x55\x53\x9a\x3b\x2b\x67\xd2\x04\x6c\xe0\xcb\x81\x65\x05\xc9\x02\x9c\x5d" +
+		"\x6f\xa7\xd8\x97\xaf\xed\xa3\x72\x8f\xb3\xfc\x7a\x99\x3e\x45\x76\x50\xd8\xe2\x41\x85\x7b\xd8\x1f\x3a\xb4\x54\x82\x56\xd6\xe0\x29" +
+		"\x1d\xf0\x42\x06\xf9\xc0\x65\x5c\xad\xf5\xbb\xa5\x18\xce\xd5\x7f\x99\x0a\xa3\xd6\x3e\xdb\x64\x4c\xc1\xd4\x5a\xa7\x9d\xed\x4b\xcd" +
+		"\xbc\xa4\x03\xcf\xb2\x93\xa8\x96\xbf\xef\x19\x9f\x9b\x76\x84\x2d\xce\x3d\xba\x38\x61\x05\x89\xba\x88\xd8\x87\x3e\xc9\x58\x0c\xfc" +
+		"\xad\xaf\xb6\x90\xe6\x4b\x49\x19\xca\x3c\x04\x57\x4f\xb1\x34\x0c\xd7\xc0\xaf\xbd\xd6\x44\x70\xb9\x3e\x95\x9e\x7d\x89\xfa\x74\x85" +
+		"\xd9\x3a\xe1\x7a\x3e\x9b\x5b\x96\xcf\x43\x1c\xf5\xcf\xb7\x24\x53\x25\xfa\x8a\x22\x05\x9e\x65\x6c\x4e\xd6\xdb\x4a\x02\xc4\x0c\x15" +
+		"\xeb\xcd\x41\xfd\x93\xba\xfa\x94\x62\xf9\x41\xf0\xa5\x41\x15\x3c\xb3\x77\xef\xa5\x4e\xa2\x32\x31\x7f\x1b\xb8\x74\x9d\x75\x9e\xaf" +
+		"\xc7\x14\xf1\xa9\xcd\x9e\xd1\x1b\x1e\xf1\x47\xd9\x9f\xf2\x5f\x72\xe1\xba\xda\x51\xf1\xba\x3e\xb5\x5e\x5d\xed\xf6\xa8\x02\xbc\x58" +
+		"\x2f\xfe\x51\xff\xb4\x23\x9b\xad\xd5\xb2\x15\xf5\x86\xb1\x47\x74\xd5\xda\xe9\xa4\x72\xb5\x8b\x95\x7d\x78\x48\xef\xb1\xec\xbe\xbd" +
+		"\xa9\x0e\x68\xb3\xe7\xbe\xa2\xb8\xf4\x90\x47\x3f\x7b\x50\x1b\x30\x47\x21\x92\x1c\x64\x3a\x77\x36\x21\xcb\x0f\x7b\x8f\x5e\x2f\x0d" +
+		"\xb6\x82\xcb\xf1\x47\xf2\x33\x35\x79\xa5\xa5\x4b\x4e\xf6\x99\x22\x35\xf3\xa2\xcb\xf2\x20\x7a\x20\x38\xf5\x1a\xb2\x9a\x3b\x76\xfd" +
+		"\x29\xbd\x68\x59\xeb\xf2\x7d\x6a\xda\x57\x14\x49\xf3\x75\x55\xda\x7a\x2c\x69\xa5\xc0\xb7\x41\x5a\x3a\xc6\x67\x7d\xde\xbc\x4c\x7d" +
+		"\x4b\x58\x07\x66\x89\x19\x6e\x57\xc4\x8f\xf9\x44\x0a\x7f\xf4\xb8\x92\xad\x63\x7b\x41\x77\xf3\x09\x7a\x54\x7c\x4c\x6f\xca\xf7\xf9" +
+		"\xd3\xee\xd7\xd6\xd2\x2e\xe6\xe2\x7a\x12\x69\xa3\x18\xb9\x0a\x63\xfb\xb5\x23\x7d\x01\x9e\x4c\x5d\xef\xe4\x00\x81\x83\x7a\x9b\xf7" +
+		"\xc0\x95\xfa\x67\x75\xd0\x75\x29\xd0\xd6\x72\xe0\x15\xe9\xbf\x36\x1e\x2d\x23\x0a\xbf\xdb\x7d\x76\xf3\x74\xbf\x11\x92\xc1\x27\x74" +
+		"\x35\x2d\x97\xce\xb3\xfa\xa2\xb6\xc1\x0d\x7c\x30\x00\x86\xb3\x1c\x67\xe9\x1e\x25\x33\xfd\xb8\xeb\x79\x80\x55\x16\x06\xb3\xc2\x6c" +
+		"\xe9\xc1\xc0\x42\x91\x60\xfb\x5f\x4f\x99\x9d\x50\x25\x41\xb4\x7e\x09\x55\xa6\x99\xfd\xab\xe2\x02\x56\xc0\x2f\xdd\x63\xec\x01\xfa" +
+		"\x22\x9d\x79\x3a\xa0\x36\xba\xe9\x1b\x7a\x0c\xc9\xf2\x3d\xf3\x3c\x08\xac\x38\x67\xda\xf5\x98\x0c\xf7\xb8\x9f\x6a\x6b\x1d\xf4\x6f" +
+		"\x83\x64\xc1\x2e\xe2\x0f\xe9\x37\xd1\x07\xbe\xe2\x73\x8f\xd7\x05\x4b\xfd\xea\x1b\xd9\x79\x5e\xe1\x68\x33\x69\x04\x17\xaf\x40\xb7" +
+		"\x85\x7d\xde\x27\x8e\x0b\xcb\x4b\x85\x28\x3f\xe6\x33\x37\xb6\xd6\x9f\xf2\xf4\xa5\x0f\xfa\x85\xcc\xf1\x29\xff\xb3\x7d\x5a\x4b\xab" +
+		"\xec\x92\xe5\xf3\xdc\xe7\x2b\xd5\xcd\x34\x62\x9f\xe3\x81\xdd\xc8\xef\xe8\x74\x5f\x80\xa6\x95\xee\xa8\x07\xf4\x4d\x4f\xe9\xc5\x02" +
+		"\x9f\xb1\x3c\xaa\x88\x94\xe6\xeb\x7b\x04\xc6\xba\x88\xae\x72\xae\x5f\x8e\x5c\x84\x4a\x3d\xb4\x93\xf5\x59\x56\x59\x21\x41\x11\x26" +
+		"\x1b\x2e\x6a\xb8\xd8\xca\x8f\x3c\xaf\x4a\x85\x81\xfb\xf5\x0d\x46\x01\x8b\xfd\x59\xf4\x5d\x4e\x7e\x73\x86\xbc\x8f\x2b\x51\xc9\xf3" +
+		"\xa3\x8a\x79\x72\xde\xa0\x4a\x22\x58\xcb\x4e\x6c\x99\x80\x0b\x3c\x67\x51\x5a\xef\x1e\x56\x53\x60\xd8\x33\xd6\xb0\x12\x9b\x88\x3e" +
+		"\xb6\xc4\x97\x27\x6a\xa9\xbd\xc2\x01\x4d\x09\xaa\xd1\xb4\xf6\x28\x69\x79\x96\x2b\xe8\xab\x3c\x3e\x51\xa5\x7a\x6d\x81\x2e\xd4\x43" +
+		"\x9e\x8d\xbd\x00\x3e\xec\x89\xa6\x3d\xf1\x51\xbf\x50\x96\x6b\xe5\x09\xfd\xd2\x18\xbc\xcb\x2c\x99\x8d\x75\xa4\x05\x3e\x53\x6d\x96" +
+		"\x4f\xb9\xa9\xe9\x20\x38\xae\x72\x07\xa7\x55\xd9\x3a\x8d\x14\x93\x1a\xeb\xcb\xeb\xe9\x4f\x6d\x3c\x05\x6c\x10\x60\xea\xf5\xd4\xcb" +
+		"\x2f\x7a\xde\xf8\xac\x6e\xd9\x7a\x9e\x31\xa6\xc3\x35\x94\x5e\xa1\xba\x7e\x43\xe7\x2a\x25\x9a\x45\x5f\x65\x30\x3b\x28\xf3\x11\x14" +
+		"\x4d\x7c\x58\x07\x7f\x8c\x81\x45\xd3\x59\xae\xda\x80\x4f\x6e\xb3\x1c\x21\xad\xf4\x9e\xe7\x3c\x9b\x4e\xae\xcc\x13\x63\xe5\x7a\xd3" +
+		"\x7d\x4e\x67\x63\xe4\xf6\xf4\x67\xf5\x81\x6d\x7c\xaa\xda\x26\x60\xa9\x8e\xb7\xa5\xf6\x6a\xef\xf9\xdf\x01\x2a\x44\xf2\x3d\x96\x58" +
+		"\xfa\xc4\x87\xb4\x43\x37\xfa\x24\xd2\x61\x8f\xdc\x6d\xf5\xed\x8d\x03\x81\x85\x0d\xff\x12\xc0\xc9\x9e\xa0\x29\x51\x77\x51\xff\xf2" +
+		"\x67\x7d\x3e\x84\x6c\x9f\x71\xd5\x24\xb4\x04\x3f\xf0\x55\x54\xd4\xaf\xa7\xeb\x6a\x9b\xcb\x0a\x7d\x65\x55\x29\x12\xf9\xa8\x0e\xf8" +
+		"\x46\x3f\xe5\x26\xfa\xcf\x48\x44\x7b\xbd\xb3\x91\xdf\x85\x3d\x0d\x41\x22\x3e\x61\x76\xba\x02\xac\x2b\xbd\x60\xfe\xb0\x3e\x3b\xaa" +
+		"\x38\xb2\xaa\x67\x06\x38\xa5\x67\x86\xcf\x93\x5e\xa0\x4f\x18\x43\x2f\xe0\x45\x75\x2f\xa5\xe9\x12\xdb\xa4\xed\x35\x09\x9e\x77\x68" +
+		"\x7d\x11\xd7\xc0\xb3\xc0\xc1\x95\x12\x8e\xbe\xd5\x0d\xa1\x4f\xe7\x62\x19\x5b\xc7\x51\xc9\x47\x9e\xcf\x0d\xa2\xc2\xc3\xf5\x44\xc1" +
+		"\x0a\x7f\xb7\x94\x95\xd9\xe9\x6f\xd7\x37\xbf\xa6\x8f\x7f\xb4\x4a\xc5\xd8\x7c\x0f\xd0\x88\xc7\xe5\x52\x9f\xfd\x34\xcb\xd7\xcc\xb4" +
+		"\xb4\xce\x03\x9d\xd1\x89\xa8\xcf\x1e\x5a\xcf\xa3\x62\x3b\x4f\xc3\xf3\xbc\xbc\x64\x29\xbe\xd3\xcc\x6e\x6d\x74\xff\x25\x2f\xca\x55" +
+		"\xb2\xc4\x46\x7e\xb0\x95\x15\x8c\x0b\x3c\xe5\x29\x09\x28\x17\x0f\xad\x76\xe0\xc7\xcf\x82\xd7\xc4\xe0\xfd\xc1\x1b\xd5\xc1\x1d\x05" +
+		"\xe0\xf1\xe5\x04\x47\xac\x25\x38\x75\x47\x0c\x9c\x32\x35\x06\x4e\xce\x00\xc7\x87\xc1\x21\x49\xe0\x70\xd1\x24\x57\x89\x1a\x35\x37" +
+		"\x1d\x1c\x15\x56\x01\x67\xec\x07\x0e\x3c\x20\x2a\x4a\xbf\xad\x04\x77\x1d\x25\x38\x60\x94\xb3\x75\x6e\x0c\x4e\x08\x81\x47\xd2\xc1" +
+		"\xc5\x3f\x02\xf7\x44\xad\x30\xe1\xe1\x20\x37\xd0\x40\xcb\xd7\x33\x50\x3c\x1c\x49\xe0\xda\x56\x60\xdf\x19\x29\xe0\x94\xd7\x63\xe0" +
+		"\x97\x07\x09\xbe\x9b\x0d\x1e\x6c\x09\x76\x96\xda\xf6\x92\xbe\xb2\xd7\xce\x44\xf0\x9d\x2d\x71\xe0\x64\x69\x56\x8b\x6d\xff\xab\x18" +
+		"\x38\xb7\x9f\xf8\x76\x8f\xc5\x81\x1f\x66\x80\xdb\x2f\xa4\x8b\x21\x39\x1d\x7c\x5f\x8a\xb3\x47\xc0\xb9\x69\xe0\x86\x76\x60\xa7\x35" +
+		"\xa9\x08\x6d\x9d\x9b\x18\x68\x18\xae\xf3\x88\x88\xc6\x34\x68\x1d\x90\x08\x78\x22\x04\xce\xbb\x5d\xd7\xfc\x2d\xd3\x35\x3e\x0b\x83" +
+		"\x97\xbe\x07\x2e\x23\x38\x4d\x65\x65\x77\x81\x70\x89\x96\x59\x84\x70\x73\xa9\xbb\x49\x0c\xa4\xf1\x70\x1d\xc3\x70\x4b\x42\xa6\xe9" +
+		"\x87\x15\x9b\x23\x08\x8d\x73\x70\x87\x08\x26\x81\x15\x0e\x14\xf0\x47\xc1\xd9\x04\x6b\xc1\x35\x01\x47\x53\xf1\xf7\x7b\x4a\x64\x32" +
+		"\x94\x2d\xde\xe9\xf9\xef\xa3\x56\x4a\xe9\x5a\x50\xa8\xf5\x9f\xe4\x1e\x9e\xbd\x13\x1d\x96\x10\x70\x1b\x1e\x1f\x22\x41\x5c\x1f\xda" +
+		"\xc7\x66\xfb\xd8\x6a\x1f\x1f\xc9\x47\x87\x2c\xc0\x4d\x29\xd3\x0b\xd3\xec\xe3\x5d\xfb\x98\x69\x1f\xef\xd9\xc7\x22\xfb\x58\x65\x1f" +
+		"\x6b\xe5\x23\x7a\x3e\x04\xb8\x8b\x4f\x0e\x29\x43\xc4\x21\xfc\x66\xd8\xbc\xd6\xcb\x09\x37\xb3\x39\xd8\x23\x02\x76\x8d\x87\x7b\x2f" +
+		"\x03\xae\xd7\xef\xd4\xad\x5a\x19\xae\xf1\x6e\x08\x9c\x14\x0f\xce\x31\xc9\xcf\xe4\x17\x23\xd7\xc7\x09\x4e\x8d\x07\x17\xd8\x4f\xbb" +
+		"\xe2\xac\xc8\xa2\xfd\x2e\xee\xa2\x15\x71\xe0\x8f\xc0\xb1\x96\xf8\xf8\x69\x2f\x25\x66\x28\x91\x4d\xf0\x14\xb5\x09\x58\x13\xac\xf1" +
+		"\x37\xef\x0b\x2b\xb3\xf8\xab\x5b\x4f\xc4\x81\xa3\xe3\x7d\x9d\xc2\x7c\xe5\x49\xf9\x66\x79\xf8\xab\x4b\xdd\xc2\x3e\xc1\x76\x1d\x9f" +
+		"\x40\x3a\x0f\x7c\xd2\x2b\x8f\x96\x84\xf9\x66\x7d\x68\x4d\xdd\xb0\x1d\x64\x1d\xf9\x2f\x9e\x61\xbf\x17\x67\x66\x20\xcb\xb7\xf5\x94" +
+		"\x15\xba\x18\xe3\x7c\x26\xf3\x1f\x58\x65\xce\xc9\x21\xdd\xce\x65\x9e\x86\xd7\x54\x0a\x5a\xa2\x2b\x5c\x47\x77\xf6\x8f\x74\x77\x04" +
+		"\xc9\xd7\x5e\x01\x6f\x05\x6f\xb2\x92\xfc\x33\x9d\x97\x63\xf6\xfe\x01\xdc\xff\x22\xe2\x7e\x2c\x91\x7c\x71\x9f\xc7\x01\xee\xf3\xb8" +
+		"\x2f\xe3\xf4\x7e\xab\x5f\xdb\x02\xce\x20\x5d\x5f\x21\x24\xa8\x16\x24\xb2\xca\x06\x53\xe1\x6e\x85\x4b\x52\xb6\x9a\xaf\x0c\x7a\xd2" +
+		"\xab\x3a\x19\xc9\x6e\xbb\xe0\x75\x06\x82\x62\xb0\xaa\x46\x1a\x33\x3d\x59\x2b\xf0\x64\xc7\x74\x8d\x6d\xaf\x06\x79\x98\x5d\x05\x95" +
+		"\xea\x27\xeb\xef\x87\xba\xd1\xac\x5c\x26\xc1\x2e\xff\x83\x62\x2f\x07\xdc\x09\x37\x2d\xf9\x6f\x63\xce\x0b\x7f\x13\x69\xfe\xe3\xd5" +
+		"\xbf\x03\x96\xcc\xe1\xff\x04\x86\xb8\x51\x4b\xf9\x1f\xa8\x46\x44\x49\x44\x87\x45\xff\xff\xdb\x95\xec\x4f\xf0\xf5\x10\xd8\x55\xa2" +
+		"\x08\xef\x80\x3b\xd8\x1c\xdc\x6a\x99\xd3\xfb\x26\x99\xcd\xe9\xdd\x02\x03\xff\xdb\x6d\xe0\x36\xd5\xff\x9f\x19\xea\xff\xee\x38\x79" +
+		"\x66\x30\x11\x95\x9c\x16\x6e\x46\x18\xdc\x40\x44\xaf\x35\xa9\x32\xf2\xd4\xd7\x5b\x80\x43\x63\x41\xd2\xd3\x9d\x07\x22\xe0\xd7\xa9" +
+		"\x56\x2c\xf3\x1d\x22\x5c\x9e\x00\x37\x28\xcb\x82\x79\xf6\xbc\x06\xd6\x42\x9a\xf8\x2d\xa7\x98\xd2\xd9\x2a\xa8\xd0\xee\x1a\xc0\xe5" +
+		"\x68\x14\xcc\x08\xc2\x4d\x0e\x21\xb9\x53\x12\xdc\xfc\x06\xe0\x37\xad\xe0\xce\xb6\x34\xfb\xd8\xdb\x6d\x10\x5d\xf8\x83\x2a\xef\x76" +
+		"\xc3\x5b\xfc\x3d\xa7\x1e\xbd\x56\x5a\xe5\x65\x7f\xcf\x17\xd5\xaa\xf2\x1e\x7e\x97\x0f\xce\x4c\xfd\xbb\xbe\xaf\x7f\x93\xff\x2f\xb8" +
+		"\x17\xbd\x1c\xae\xf2\x0b\x77\x46\xf4\x2a\xc7\xb7\xf8\x6b\x56\x5a\x11\xfe\xbf\x87\x85\xba\x1e\xff\x60\x63\xff\x60\x63\x05\xd7\x75" +
+		"\x02\xd7\xfa\xbf\x13\x1b\xe3\xdc\x79\x0e\x6c\xec\x0d\x2e\xab\x2c\xe3\xe9\x83\xe6\xf3\xba\x5b\xb7\x50\x9c\x2e\xbc\x85\xdc\xbe\xff" +
+		"\x82\xb9\x9b\x9b\x98\x46\x7c\xcc\x54\xa0\xf6\xba\xfc\xbd\xcd\xb3\x76\x2c\x28\x6c\x9c\x0e\xf6\x09\xa9\x5e\xfc\xb0\xfe\xbc\x87\xe6" +
+		"\x9d\xc9\xd2\x2e\xdd\x2c\x42\x7c\xe9\xa9\x14\x70\xd0\x49\xab\x74\xd5\xd1\xe9\xa3\xd6\x7f\x95\x02\xce\x93\x1f\x72\xc1\x95\x32\x8b" +
+		"\xe3\xb3\x6c\xf5\x9e\x30\xf7\xdd\x24\x7a\xe5\xa9\x23\xc1\xb3\x17\xc5\x81\x7e\x3e\x45\xa9\xcb\x35\x82\xdb\x2f\x85\xc1\xd3\x67\x12" +
+		"\xc0\x73\x47\x53\x7c\x95\xb3\x04\x6f\xb0\x5f\xdb\x25\x06\x7e\x58\x1e\xd3\xb1\xbe\xa8\xf4\xc2\xaa\x31\x4c\x70\x16\x31\x14\xf3\x19" +
+		"\x42\x8b\xbd\x95\x62\x94\xf3\xea\xf1\xed\x60\x5d\x70\xaa\x2d\x40\xba\xa9\xf8\xb2\x1b\xe2\xc0\x6d\x66\x6a\x6e\x68\x21\x47\x1b\x9d" +
+		"\x5d\x99\xe4\xcc\x85\x92\x6d\x06\x86\xa3\x41\x44\xd6\x6d\xde\x38\x61\x75\x71\x2f\x4d\x8b\xc1\xbd\x3d\x2d\xa6\x7f\x98\x5d\x39\x07" +
+		"\x5c\x6a\xf0\xac\xeb\x6f\x5c\x49\x1d\x56\xcf\x20\xaa\xe9\x35\xf0\x82\x04\xd0\x74\x9e\x65\xda\x5f\x27\x8b\x60\x9d\x39\xdf\xf4\xd9" +
+		"\x8d\xf3\xed\xb5\xfd\xe7\xc4\xc0\x37\x66\xc7\xc0\x2d\xd2\x0c\x7f\xcf\x2c\x34\x53\x16\x52\x32\x85\x9b\x1d\xfd\x25\x73\xc2\x98\xd9" +
+		"\xd9\x0c\xc2\xfb\x4c\x17\x97\x05\xfb\x15\xb8\x83\xde\x20\x52\xa4\xdb\xb1\x91\xae\x45\xd8\x06\x52\x0c\x4e\xb0\x15\x19\x11\xd4\xb1" +
+		"\x4c\xf3\x16\xd3\x06\x60\xa9\xb7\x62\xde\x07\xca\xeb\xee\x07\x1f\xb3\x85\xeb\x4d\x45\xe7\x7c\xef\x79\xba\xdd\xe3\x71\xa6\xa9\xf7" +
+		"\x53\x36\x13\x9c\xbf\x95\xe0\x54\x49\xee\xde\xef\x23\x73\x30\x35\xd2\x11\xf4\xb5\x84\xbf\xf6\xf4\x17\xf4\x05\xad\xbd\xd3\x68\x9d" +
+		"\x0c\x77\xfe\x6e\xab\xec\x97\x0c\xce\x37\x9f\x4f\xa9\xc2\x25\xdb\x7b\xe4\xcc\x70\x59\xa4\x56\xd1\x67\x15\x49\x93\x75\xc0\x83\x09" +
+		"\xee\x3d\x1b\x03\x07\x9c\x89\x29\x3d\x14\xd0\x2c\x96\xef\x2b\xce\xc4\x6c\xaa\xa5\xde\xd2\x57\x57\xc7\x2b\xd3\xde\x76\x21\x06\x2e" +
+		"\xb8\x10\xf3\x85\xbd\xc2\xe6\x6b\xbc\x76\x25\x06\x1e\xff\x36\xa6\x93\x6e\xe8\x6d\xd7\xcf\x9a\xa3\xf7\x29\x70\x6f\x97\x54\xf0\x8b" +
+		"\xf2\x54\x6f\x75\xb2\x32\x3a\xcf\xd9\x98\x3b\x28\xb4\xda\xea\xfb\x36\x12\xdc\x17\x24\x0f\x9e\x1d\x94\xbb\x37\xb7\x66\x3d\xdd\x5a" +
+		"\xce\x2c\x73\xc3\x28\xf3\x91\x01\x5c\xfc\xda\xbb\x5c\x1b\x80\x03\xc7\xa4\x82\x8b\x46\xa7\xc2\x85\xab\x58\x48\xcc\x5a\x7a\x8f\x2f" +
+		"\x59\x9c\xe3\xad\xb4\x0f\xf8\xf2\xb6\x66\x83\x99\x45\x6f\x64\xad\xab\xf0\x2a\x05\x7b\x4e\x48\x05\x87\x8e\x4b\xf5\xa5\x9f\x9e\xd3" +
+		"\xe7\xd5\xd5\xf6\x41\x7d\xa4\xd5\x48\x94\x1c\xbe\x53\x52\xc1\x79\xd2\xb4\x56\xae\x90\x05\x76\x17\x1e\x3f\xa3\xb3\x33\x13\x4a\xb7" +
+		"\x20\x29\xf0\x82\xae\x22\x45\x74\x73\x0a\xd8\x2b\x0e\x3c\x31\x37\x15\x1c\x3b\x27\xd5\x7b\x0c\x26\x05\xfe\xeb\x31\xf4\xf6\x9d\x20" +
+		"\xeb\xed\x09\x2a\x14\xf2\x15\xb0\xbb\x9c\xbe\xa6\x77\x9c\x77\x8b\x0c\x36\x14\x7d\x15\xbc\xb4\x39\x15\xfc\xfc\x68\x35\xf0\xc4\x10" +
+		"\xf3\x01\xe7\x29\x84\xcf\x6e\x15\xbf\xad\xfc\x98\xac\xb7\xb6\x07\xb7\x33\xc0\x2d\x67\x94\xff\x01\xbd\x1a\x56\xeb\x9f\xd9\x6c\x47" +
+		"\x04\x31\x6f\x33\xe2\xe0\x4a\xf4\xf1\xaf\xe8\x3d\x8f\x29\x2e\x9b\xb3\x67\xa1\x15\x9a\xc9\x09\xfc\x8d\xc1\x3e\x5e\x2d\x88\xb1\x77" +
+		"\x86\x33\xcf\x6f\x7f\xda\x4e\xef\x4d\xef\xbd\x8b\x79\x77\xc5\x85\x80\xba\x1c\x24\xb8\xc3\x19\x50\xc2\xde\x0c\x66\xf8\xfa\xd9\x22" +
+		"\x07\x4e\x5d\xec\x74\x3f\xa6\x79\x0f\x91\x55\x3f\x6d\x1c\x30\x74\xb1\x04\x37\x84\x3b\x6d\xd4\x35\x4f\xc9\x94\x99\x10\xeb\x2b\xd0" +
+		"\x26\xbd\x99\x06\x6e\xed\x6d\xf6\xbc\xe9\x4e\x87\xbc\x45\x2c\x34\x9d\x93\xc0\x5e\xeb\x53\x7c\x75\xb7\xc6\xbe\x36\xe5\xdc\x75\x29" +
+		"\xe0\x68\x69\x06\xfc\x0a\x5c\x2c\xf4\xe7\x6b\x49\xca\xfe\xac\xc5\xd4\x8e\x7a\xce\xdb\x89\xdb\xfa\x72\xde\x09\x4a\xb9\x86\x59\x41" +
+		"\xe9\x98\x02\xf9\xc2\xfa\x14\xb8\x79\xeb\x53\x3c\x13\x5e\x49\xf0\x97\xde\x48\x19\x03\x37\xff\x1a\xec\xf3\x1b\x5f\xd0\xee\x29\xef" +
+		"\x4a\xec\x48\xb0\x19\x5c\xa6\x2f\x64\xd7\x49\x9e\x70\x6e\x5d\x8a\x9a\x27\xcd\xd4\xf9\x30\x38\x4c\xae\x7e\x2e\xcd\x1a\x69\x16\x04" +
+		"\x93\x18\xfe\x3b\x70\xd3\x92\x18\xf8\xbe\x04\x89\x1d\xd9\x98\x02\x7e\x24\xdf\xb6\x49\x3c\xcc\x94\x4d\x29\xde\x59\x39\xfc\xf7\xe0" +
+		"\xfc\xdf\x7b\x56\xb5\xe1\xf7\xe0\x88\x55\x31\x70\xf5\x66\x2b\xed\x9f\x00\x9e\xdd\x2e\xaf\x95\x4c\x5d\x31\xef\xc6\xc9\x01\x43\x60" +
+		"\xc5\x8e\x14\xf0\xd3\x8f\x25\x94\x44\xbe\x4d\xd9\x91\xa2\x86\x72\x2b\x92\xbb\x45\x62\x80\x3b\x4b\xf3\xaa\xde\x7f\x5e\xc2\x28\xdb" +
+		"\x80\xc7\xe5\xb3\x9e\x37\xcc\x2e\x95\x70\xe1\x79\xd2\xa4\x83\x0b\x3f\x4d\x81\xfb\x4e\x4c\x71\xa7\xfe\x0d\x3c\xf4\x6f\xe0\x98\x3f" +
+		"\xea\xea\x0a\x7b\xfb\x23\x38\xf9\xdf\x75\x48\xc6\xb3\xb3\xbc\xa3\x21\xdd\x3b\x36\xcb\x25\x2a\x64\xe6\x67\x29\x55\x72\xb7\x5a\x2d" +
+		"\xb8\x67\x14\xa0\x73\x5e\xb3\x33\x20\x4b\x82\x93\x21\x97\xe5\xce\x0f\x3e\x4b\xf1\xfe\xa0\x41\x7f\x06\x87\x08\xc8\xbb\x1e\x4a\x51" +
+		"\x19\xe5\x93\x83\x95\x0b\x66\xa4\xaa\x8b\xfc\xda\xa7\x23\x83\x57\x7d\x2d\x7d\x5f\x03\x4f\x49\x11\x86\x77\x3f\xb7\x39\xb4\x06\x2f" +
+		"\xca\x1d\x7d\x5f\xa7\x77\xf5\x34\xf6\xa1\xbc\x9b\xe5\x5a\xd7\x4e\xf4\xee\x17\x03\x79\x3d\xbf\x05\xd2\xd5\xa3\xdc\xff\x44\x8a\x6d" +
+		"\xff\x9d\xf2\x65\xdc\xc9\x14\xb8\xe3\x27\x0c\xf6\x9b\xe4\x73\x90\xfd\x11\x32\x81\x6a\x9b\x88\x13\x35\xc0\x6f\x4e\xa4\xc0\x99\x3b" +
+		"\xec\x31\x70\xa3\xdc\xf3\x99\x34\xdb\x4f\x9a\x68\xb1\xe1\xa4\x15\x09\xd7\xb0\xc9\xbd\x5d\x59\xa5\xfa\x75\xb1\x77\x9f\x0d\x14\x31" +
+		"\xe4\xeb\x93\x06\xbf\x12\xad\x27\x1a\xf6\x85\x77\xd3\xbd\xfd\xf6\x92\xdc\xf4\xd1\xa9\x14\x1f\x56\x50\x20\xff\x02\xa2\xd5\xf5\x5c" +
+		"\x0a\xd8\xe3\xaa\xf8\x76\x2a\x08\x7e\x20\xcd\x1e\x69\xf6\x4b\x33\x61\x47\x35\xb0\xdf\x86\x34\x70\xcb\xb9\x14\xb8\xb1\xe7\x4c\xb6" +
+		"\x6c\xeb\x6b\x84\x66\xfb\x72\x80\xe6\x90\x99\x21\x52\xce\x2b\xe0\xe7\xaf\xa7\x82\x57\x7a\xd2\x3b\x11\x93\xbd\xe8\xa1\x1e\xdb\xa0" +
+		"\x60\x70\x9e\x97\xab\xcd\x97\x15\xf6\xf6\xf2\x90\xf7\x59\xe6\x29\x57\x34\x66\x58\xe9\x1c\x8b\x80\xd7\x2e\xa5\x80\xbb\x2f\xa9\x40" +
+		"\xe5\x06\xbc\x45\x70\xd7\x5b\xc6\xd4\x3f\x92\x4f\x82\x3d\xfb\x9a\x6c\xf1\xa6\x7c\x0e\xeb\x6b\xee\xf1\x3d\x1d\x63\xe0\xc9\xef\x52" +
+		"\x7c\xd9\xad\xcb\x9d\x62\xfa\xf4\x67\xc0\x5b\xc0\xf7\x3a\x07\x75\x96\xfa\x11\x5c\x3e\x28\x15\x4c\x04\x2f\x06\x17\xa3\xe0\x0e\xf9" +
+		"\xba\xae\xb3\xd1\xbe\x72\x11\xcf\x3e\x91\x3f\xa6\x97\x07\x2c\x77\x77\xb9\xb1\xd6\x12\x5f\x88\xca\x8a\x5b\x8e\x1e\x40\xf0\xec\x00" +
+		"\x13\x37\x8f\xcb\xe7\xa5\x01\xba\x9a\xc2\xae\x87\x89\x29\x61\x20\xc1\x37\xa5\x99\x3e\xb0\x32\xa4\xa6\x5c\x8a\x3e\xad\xeb\x6a\xd1" +
+		"\x29\x6b\xba\x89\x9d\xbb\x9b\x51\xac\x73\x03\x2b\xf5\x84\xa5\x83\xe8\x19\xd6\x6e\xf9\x7a\x5e\x9a\x2f\x07\x11\x6e\xf0\x60\x82\x43" +
+		"\x7a\x4a\xb7\x1e\x31\xaf\x9b\x98\x9f\xb1\xe2\x2d\x79\x71\x1f\xab\x7a\xbd\xbe\xaf\x08\x5c\x7d\x4d\x6c\x29\xf5\xbe\xa5\x02\xbd\xb1" +
+		"\xbd\x7a\x79\xf5\xf8\x9a\xb3\x7a\x68\xf3\x86\x12\x1c\x38\x8c\x60\x58\x12\x97\x13\x3c\x3c\x2c\x90\xa7\xea\x81\x03\xdf\x16\x51\xef" +
+		"\xed\x98\xe7\x26\x05\xde\x83\x5b\xe2\x45\xc9\xd2\x20\x6a\x79\xdc\x48\x7a\xa7\xe2\x85\xa1\x31\xb8\xb7\x86\xc6\xbc\xcd\x5f\xca\xe1" +
+		"\xc8\x5f\x53\x86\xc6\xbc\x9e\x30\x7b\x44\x0c\xdc\x39\xdc\x04\x94\x3c\x4f\xe7\x6b\x2a\x64\xee\x53\x64\x5b\x33\x52\xe8\xfb\x88\x58" +
+		"\xe0\x0f\x73\xd7\x46\xc5\xc0\x45\xa3\x62\xde\x2d\x52\xe9\x73\x3e\x2d\x37\xee\x96\xa6\xdb\x58\x41\x05\x82\xe7\x46\x59\x64\xca\xc0" +
+		"\x31\x31\x70\xe4\x18\x85\x84\x53\x65\xc3\x19\xad\x7a\xd8\x4b\xd6\xc9\x2a\xee\x58\x78\x49\xa6\xff\x97\xab\xbc\xcb\x0a\xdb\xf5\x1f" +
+		"\x17\x03\xbf\x19\x6b\x33\x1e\x3d\x99\xe0\xca\xc9\x36\xd1\x15\xf2\xb9\x63\xb2\x49\x3a\xe6\x57\x69\xec\xb5\xbb\x64\x2f\xe5\x44\xfc" +
+		"\xb2\x66\x81\xdf\x4e\x21\xb8\x66\x2a\x7d\x59\xd6\x04\x1f\x2e\x54\xe2\x0b\x64\x7e\x30\x8d\xe0\xac\x4f\x52\x95\xf1\xfc\x09\x1c\x34" +
+		"\xcd\xbc\xaf\xb9\x9e\x0d\x85\xc1\x37\xa6\x13\x5c\x7a\xbd\x7a\xda\x9c\xe9\xb6\x74\xdd\x09\x6e\x9f\x61\x32\xd3\x72\x11\xd0\x67\x4a" +
+		"\x73\x5e\xb8\x24\xc1\xee\xb3\x62\xbe\xca\xea\x11\xb9\xde\x00\xac\x05\xae\x90\xaf\x1b\xa5\x79\x53\x9a\x21\xd2\x5c\x5c\x91\x02\x8e" +
+		"\xff\xb5\x85\x0b\x47\xbd\x4b\xbf\x14\x9c\x30\x33\xe6\xcb\x7c\x7e\x3e\x23\xe6\xcf\x40\x9e\x96\xaf\x87\x66\xc4\x7c\xd4\x42\xcc\xc7" +
+		"\x04\x18\xf8\x96\xbd\x47\xf0\xe8\x7b\x95\x7e\xd5\x64\xdf\x6e\x9d\x4d\x84\x76\x98\x48\xe9\x72\x3d\xa5\xe9\x48\x70\xdb\xbb\x31\x93" +
+		"\xad\xa6\xcd\x8a\x79\xc2\x98\xe3\xcb\xea\x67\xf9\x32\x7e\xdf\xcd\x21\x38\xe4\xa8\xb8\x65\xa4\x39\x3b\x97\x5e\xa7\xb2\xc2\xaa\x9f" +
+		"\xcc\x23\x78\x78\x9e\x09\x61\x95\xa1\x55\x22\x62\xcc\xb4\x81\x4b\xa5\xab\x99\x16\x91\x92\x00\x6e\x98\x4f\x70\xd3\x7c\x7a\x36\x60" +
+		"\x9c\xec\xda\x7b\x31\xb8\x8f\xde\x8b\xf9\x00\x96\x27\xc0\x9f\x7a\xd7\xd6\x16\x19\xdd\xeb\xd2\xcc\x59\x48\xf0\xa0\x34\x5d\xe5\xd6" +
+		"\x33\xb3\x2a\xfd\xfd\x56\x93\x50\xc2\x57\x45\x07\xba\x0d\xa1\xe3\xef\x19\x36\x6c\x92\xbf\x67\xce\x36\xde\xb1\x4b\xfe\xd8\x20\xcd" +
+		"\x67\x8b\x08\xf6\x5d\x4c\x2f\xb0\x37\xa8\x12\x68\x55\xac\xe3\xda\xbe\x48\x5e\xb8\x28\x86\x90\x6e\x46\x67\x72\x5b\x03\x8f\x4f\x0f" +
+		"\x80\x7d\x57\xc8\x82\x2c\xb7\x49\xd6\xf5\xe2\x53\x8f\x65\x04\xc7\x2c\xa3\xc7\xf5\x86\xe0\x7e\xf9\xab\xff\xf2\x4a\x5f\xee\x04\xe9" +
+		"\xf3\xdd\xb2\x18\xd8\x4e\x91\xee\x3b\x67\x9a\xb4\xf9\x99\x15\xff\x82\x50\x91\x88\xd7\x6b\xb7\x2c\x15\x14\x73\x20\xd5\xe6\xb2\x79" +
+		"\x79\xcc\xc7\x41\xe5\x82\x43\x9d\xe9\xa1\x16\xd1\xd3\xd8\x16\xf7\x36\xf0\xed\x55\x04\x57\xae\xa2\x08\x28\x16\xdb\x15\xd4\x55\xcc" +
+		"\x06\xbb\xaf\x26\x78\x2c\x30\x17\x98\x6b\xf4\x30\xed\x8c\x66\xae\xe7\x2e\x61\x5f\x09\xc3\xe2\x13\xca\x65\xbe\x3b\x97\xc7\xaa\xf8" +
+		"\xe0\x1f\xf0\x1b\xf0\x4e\x5f\xf3\x75\xc0\x3a\xc2\xad\x5d\x47\x15\x0a\xf6\xac\x8c\x81\xef\xad\x8c\x79\x2f\x74\x11\xb8\xfc\xfd\x18" +
+		"\xf8\xd6\xfb\xb1\xeb\x91\x30\xfc\x6c\x3d\xc1\x81\x41\xc1\xa0\xa8\x37\x5e\x14\x80\xfb\x44\x26\xfb\x70\x95\x2d\xf2\x90\xd5\x31\x70" +
+		"\xac\xfc\x71\xb7\x42\xe4\x29\xcf\xcd\x13\xc0\x41\x1f\x12\xdc\xf7\xa1\xa9\xcf\xe6\x0b\xef\xb7\x89\x60\xf9\x66\x2a\x6f\x2a\x09\xfc" +
+		"\xa6\xb2\x88\x43\xd6\x8a\xa0\xf7\x81\xc1\xaf\x03\xd8\x79\x8b\xf4\xdd\x42\x3f\xab\x86\x3a\xe9\x9e\x9b\x63\x70\x5f\x6f\x8a\xf9\xed" +
+		"\x92\x07\x4e\xdf\x22\x34\x6f\xb3\xed\x94\x02\x70\x85\x7a\x42\xa5\xd9\x20\x4d\x18\x1c\xb8\x8d\x9e\x59\x98\x5e\x31\x45\x2e\x9c\xd8" +
+		"\x66\x6a\x70\xcc\x2b\x09\xdf\xca\x85\xe9\x1f\x11\xdc\xb1\x3d\x06\xb7\x79\x7b\xcc\xab\x63\xe9\x3e\xbc\xc6\x84\x8f\x06\xbe\x82\x69" +
+		"\xb2\x2f\x1c\x5a\x57\xb1\xb3\x50\x1f\x36\x8e\xe0\x4d\x3e\x7c\x26\x4b\x70\x49\xae\x56\xec\x14\x62\xbe\x23\x16\xcc\x38\xc0\xd6\xa8" +
+		"\x5f\x4f\xd3\x7a\xac\xd4\xea\x8e\xdd\x31\x70\x9e\x34\xc7\xa4\x19\xbe\xbb\x92\x50\x8f\xdf\x49\xf0\xda\xac\x34\xb8\x99\x9f\xf8\xb0" +
+		"\x1d\xa6\xf9\x80\x1d\xaa\xd4\x66\xfb\x7f\xda\x2e\x82\x7b\xa4\xf9\x58\x9a\xb3\xbb\xe8\x63\x22\x93\xfd\x0a\x67\xfa\x9a\xb2\x31\xaf" +
+		"\x62\x36\x04\x6f\xd4\xe7\xad\xd8\x4d\x70\xf9\x82\x34\xf0\xeb\xfd\xd6\x6b\xee\x01\x59\xe5\x03\x31\x1f\xca\x55\x0d\x3c\x25\x7f\xad" +
+		"\x58\x98\x06\x9e\x96\xdb\xfb\x7f\x26\x7c\xf2\x33\xa3\x14\xdf\xca\x95\xe9\x7b\xcc\xea\x90\x0d\x9a\x9a\x2c\x9e\xe2\x23\x42\x33\xa4" +
+		"\x19\x2c\xcd\xee\xcf\x65\xfb\x1c\x15\x9d\xff\xa8\x3d\xdb\x04\xd0\xa3\x5f\xc6\xc0\xfd\xab\xd3\xc0\x5d\x6b\xd2\x74\xcf\x7c\x29\x57" +
+		"\x7a\x1e\x17\x15\xe2\x58\x0c\x1c\x25\xdf\xbe\xf8\x40\x44\x96\x4f\xe9\x59\x61\x18\xee\x45\x1f\x26\x63\x3b\xe5\xc8\xe9\x18\xb8\xf5" +
+		"\x74\xcc\x2f\x9e\xc5\xbb\x95\x28\xa1\xdc\x27\x46\x85\xe1\x67\x62\x5e\x96\x4b\xf1\x21\x73\xc5\x3e\xce\x25\x01\xdc\x26\xf6\x88\x69" +
+		"\x67\x63\x5e\x3e\xb6\x98\xdd\xf1\x9f\x11\x5c\x25\x4d\x96\x3e\x2a\xdb\xc7\x8c\x29\x9b\x72\x86\xb0\x43\x0f\x12\x5c\xb9\xdb\x7e\x58" +
+		"\x27\x9f\x17\x0e\xd2\xab\x0a\x5f\xc9\xd7\xc9\x87\xcc\x68\x90\x57\x25\xe5\x4e\x9a\xdf\x28\x39\xde\xf0\x53\x2a\x27\x6a\x63\x92\x9f" +
+		"\x36\x0d\xac\x38\x6c\xb3\xbd\x2a\x5d\x87\x1c\xa6\x0f\x3e\x3c\xf0\x9d\x71\xb9\x5c\xf0\x8b\x6b\x31\x41\x40\x97\x00\xbe\xf5\xb9\xd1" +
+		"\xb6\xc1\x47\xac\x02\xda\x78\xf9\x7b\xd3\xe7\x66\x61\xba\xd9\x6b\x02\xd7\x3a\xa6\x82\x1f\x1f\x49\x03\xfb\x1e\xb1\x01\xbe\x2d\x9f" +
+		"\x9b\x8e\xd0\x33\xcc\x28\xd8\xa9\x53\x2a\x58\x04\x8e\xec\x94\xea\x77\x77\xbe\x0f\x99\xc8\xf2\x45\x72\x2b\x8b\x65\xdb\xc8\xaf\x75" +
+		"\x4d\x55\x22\x94\x07\x5e\x95\xaf\x97\xba\xa6\xc2\xed\x94\x6f\x2b\xbb\xa5\x82\x13\xa5\xb9\xf2\x86\x9c\x48\x90\x66\xae\xfc\xf9\x12" +
+		"\xf8\x8d\x7c\x9e\xe8\x9e\x0a\x7e\xd8\xdd\xaa\xda\x7e\x73\x94\xe0\xe0\x2f\x4d\xf4\x2d\xef\xa1\x55\xe4\xdd\xb4\x8a\x54\xcf\x1b\x2b" +
+		"\xe4\xd2\x8d\xe0\xba\xf3\x12\xd2\x7f\x8c\x7e\x68\xe3\xe5\xeb\x2a\x69\x66\xc8\xcd\xc3\xa5\xc9\x03\xfb\xca\xed\x5d\x7a\xa4\x7a\x7d" +
+		"\x2a\xdb\x47\x09\x1b\x38\x3e\x38\x4e\x70\xc7\xc5\x34\xf0\x1d\x89\xbb\x78\xf3\x4c\xc8\x87\x53\x67\x7a\x3d\xfc\x71\xdd\x69\x69\xe0" +
+		"\xbc\x93\x04\xf7\x49\x33\x4d\x9a\xb3\x5f\xb1\x4a\x51\xd6\xce\x93\x1d\xb8\x6f\x6e\x35\x15\xe7\x57\xf7\xd4\x7a\xd3\xc2\x3f\xa4\x12" +
+		"\xe5\x7c\x82\x13\xa6\xa7\x82\x5f\x7d\x9d\xe2\x19\xc4\xe4\xb7\x52\xc1\xab\x7d\xcc\x24\xd5\x40\x87\xda\x04\x0c\x29\x08\xcc\xec\x62" +
+		"\x81\x36\x0d\x7d\xa8\x52\x9a\x97\xc7\x7b\x8a\x58\x7f\x66\x60\xaa\x27\xd0\xf7\xeb\x96\x49\x06\xdf\x3e\x4f\xb0\xf7\xd7\x04\x6f\x05" +
+		"\x3f\x91\x3f\x8e\x9e\xaf\x1c\xdf\x41\xe9\xb5\x4c\x9a\x2f\xe5\xe2\x4a\xb9\x6d\xec\xd7\x86\x5f\xdb\xe4\x73\xb5\x34\x27\xbe\x36\x09" +
+		"\x22\xcf\x93\xa7\x28\x58\x2e\x02\xfe\x19\xa9\xcf\xd7\x53\xbe\x7d\x3b\x34\xd5\x1b\xf2\x0f\x0c\x17\x5c\x92\x66\x96\xa4\x08\x79\x63" +
+		"\xa4\xce\x9a\x97\xe4\xf3\x7d\x69\x72\xc0\x78\x70\xc2\x28\xf9\x7b\x50\x35\x9d\xd5\xc2\x8b\x04\x8f\xc9\x95\xfd\xa3\xcc\xe6\x34\x69" +
+		"\xb4\x1c\x1a\x19\x2c\xc7\xdd\x2f\xd2\xa3\x9b\xa2\xa2\x6b\xe0\x65\x99\xe4\x20\x14\xd2\x5c\x02\x2e\xbf\x32\x88\xfa\x77\x7e\xa0\xd9" +
+		"\x41\x04\x12\x67\x5e\x36\xc3\xd5\x5d\x70\x7a\x70\x2d\xd9\x7b\xb6\x62\x55\x4c\xf7\x66\x31\x5d\x2c\x6f\x9e\x27\xcd\x3d\xba\x6c\x5a" +
+		"\x72\x85\x61\xb0\x93\x18\xf2\xbe\x92\x1f\xea\x79\xca\xfd\xc5\x55\x82\xfd\xaf\xd1\xaf\xd4\xf1\x6b\x84\xeb\xf9\x9d\xf1\xcb\x71\x62" +
+		"\xa8\xfb\x76\x7c\xaa\x07\x76\x38\x88\x20\x0a\xc2\x62\xdb\x29\xc5\xde\xd9\xd1\x81\xc3\x3a\x3a\x1d\xfe\xe1\x49\x52\xb2\x72\x52\x6a" +
+		"\x15\x3f\x46\x65\xa1\xfe\x30\x38\xeb\x9d\x54\xb8\x10\xf8\xf5\xa4\xd4\xc0\xb0\xeb\x72\xfd\x54\xa2\x3e\x58\xb8\xc0\x9b\x8c\xec\xdf" +
+		"\x46\xb1\xfe\x4d\x9c\x62\x83\xb8\x2c\x9f\x67\xa6\xd8\x0c\x22\x32\x16\x93\xa7\x5d\x3d\x1f\xc5\x97\xeb\xdb\x04\x2f\x63\xc8\x21\x97" +
+		"\x72\x07\x0e\xe9\xe2\xfc\x66\xab\x9a\x5b\x2c\xd7\xd7\x35\x34\xa6\x13\x53\x46\x6e\x23\x5e\x3a\x33\xd5\x8a\xb7\xcc\x48\x05\xef\xf0" +
+		"\xbc\x2a\x07\x5c\x2c\x3f\xcc\x9c\x5f\x0d\x9c\xf5\x86\x03\xc7\xc9\x9f\xc3\x67\xa6\x7a\x79\x66\xbb\x7c\xdd\x30\x33\xd5\xeb\xfa\x0f" +
+		"\x2a\xbe\x27\x7b\x49\x65\x77\x37\x07\x5e\x90\x26\x17\xbc\x3c\xd7\xde\xf1\xb1\x7c\xf6\x99\x97\x0a\x76\x96\xe6\x09\x1f\xf8\x3c\xa1" +
+		"\xf2\x52\x18\x1c\x5b\xe1\xe4\x18\xaa\x4c\x66\x7e\x2a\x38\x4d\x2e\xef\x92\x66\xc5\xbc\x54\x2f\xd0\xf5\x95\x5f\xf6\xcb\xdf\xf7\x7b" +
+		"\xe9\xc2\xec\x09\x6d\x14\x68\xfd\x7a\xc9\x80\x7b\x39\xbf\x24\x23\xe5\xeb\x2a\x69\x22\xe0\xc1\x25\xa9\xe0\x07\x4b\x52\xbd\xcc\x1a" +
+		"\xf3\x3c\x61\xaa\xd5\xbf\xbb\x5f\x57\xbc\x3d\xd8\x67\x69\x2a\x78\x6e\x89\x29\xe2\x69\xfe\x25\x2b\xfa\x38\x51\x62\x2d\xaa\xb1\x49" +
+		"\xc0\x6e\x04\x59\xd6\x3a\xf0\xbc\xdc\x7d\x5c\x9a\x4e\x4b\x6d\x43\x75\x5e\x9a\xea\x63\x01\x2d\x4e\x6d\xe0\x0a\x31\xa5\x2e\x37\x80" +
+		"\x48\x00\x57\x7f\x07\x9e\x92\xe6\x25\x90\xe0\xc0\xf7\x53\xc1\x4f\x57\xca\x81\x99\x55\xa2\x32\x48\x13\x35\xd1\xb5\x00\x7c\x06\x1c" +
+		"\x3e\x58\xe0\x33\xd8\x4e\xe4\x74\x5b\x2f\x2f\x5c\xa7\x90\x0b\x22\xd6\x27\xc9\xa5\x75\x07\xab\x81\xcb\x07\xbb\xc0\x2a\x13\x9c\x4e" +
+		"\x09\xfb\x18\x61\x8b\xc0\xec\x2d\x06\xdd\x2f\xc4\xda\xbb\xfe\x58\x35\x49\x50\x91\xaa\xe3\x34\xca\xa5\xca\x25\x8f\x6e\x93\x43\xfb" +
+		"\x5b\x8d\x60\xad\x94\x3f\x26\x6e\xb3\x01\x2d\x96\x93\x67\xef\xbd\x13\xf2\x62\x74\x65\x14\xbb\xd1\xc8\x0e\xe0\xd1\xed\xd2\x57\x9a" +
+		"\x62\xb8\x1d\x1f\x0b\x95\xf9\x38\xd5\xef\xe5\x02\x70\xe7\x48\x41\x91\x91\x2e\x50\x9b\xd5\x64\xbc\x2b\x15\xfc\x7a\xa7\xad\xf3\x5c" +
+		"\xf9\xe3\x0d\x69\xe2\x03\x27\xac\xab\xe7\xb7\x68\x5d\xef\x6a\x31\x73\x5d\xbe\x4f\x21\x64\x26\x37\x0d\x9c\x94\xeb\x15\xfb\x52\xc1" +
+		"\x38\xf0\x3d\x39\x72\xd4\x50\xe3\x5a\x27\xc9\xd7\xad\x7b\x53\xfd\x99\x8b\x2c\xaf\x05\x2e\xdf\x9b\x0a\x37\x68\x6f\xaa\x2f\x65\x9e" +
+		"\x50\x85\x80\x65\xc3\x8d\x90\x5f\xde\x92\x7b\x72\xbc\x0d\xd1\xc2\x30\xb5\x5e\x74\x16\x78\x68\xaf\x29\xc3\x7d\xe4\x05\x3f\x03\x0f" +
+		"\x7d\x62\x18\x50\xe0\xc1\xf3\x28\x78\x5a\xae\x3d\x0b\x76\xff\xb4\x12\x95\x45\x30\x26\xb8\x4f\x7e\x49\x01\x77\xca\xe7\x8f\x75\x05" +
+		"\xb2\x34\x10\x3f\x07\x6c\xee\x9d\x69\x3d\x65\x3a\x8f\x82\x9d\xf7\xd9\x28\xfb\x2b\x23\x74\xc9\x60\xd7\x7d\xa9\x66\x20\xde\x2a\x5f" +
+		"\x8e\x1c\x48\x55\xca\xf5\xf9\xfe\x54\x25\x8b\xe5\xf2\xf7\xb9\xfd\xa9\x8a\xd6\x5b\xe4\x8f\x5e\xd2\x6c\x93\xd3\x58\xa3\xa5\x99\x71" +
+		"\x30\x15\x1c\x30\x20\x1d\x1c\xfc\x9e\xf3\xb2\x41\x17\xf9\x7a\x65\x50\x3a\xb8\xec\x70\xaa\x8f\x1e\x9e\x29\x17\x47\xcd\x76\x3a\xf0" +
+		"\x73\xf2\xc7\x90\xd9\xce\x0b\x4b\xf9\x7e\x46\x27\x4e\x18\xa3\x99\x73\x22\xd5\x07\x9e\x66\x7b\xdb\x40\xc4\xd3\xf2\x7c\xaf\xbe\x1a" +
+		"\xb1\x7a\x6f\x8e\x68\x61\x13\xd3\xe1\x16\x9d\x49\x05\x6b\xca\xec\xd9\x0a\xac\xa0\x7f\xf7\x52\xa9\x15\xfc\xee\xf9\x54\x15\x99\x92" +
+		"\x7d\x3c\x76\x23\x5f\x92\x37\xd9\x5b\xbc\xdf\x16\x87\xed\xb1\xe9\xe9\x70\xef\x5d\x4c\x05\xcb\x2f\xa5\x22\x74\x55\xbe\x1d\xbd\x94" +
+		"\x0a\x2e\x91\x3f\xd3\xbd\xdd\xcf\xf6\x65\x60\xda\x6c\x5c\x45\xf9\xb6\x9f\xb7\xc8\x51\xb1\x29\xdf\xa6\x7a\x65\x32\xd7\x4b\x5a\xc9" +
+		"\xde\x4c\x65\x58\x34\x65\xa1\x03\xf7\x88\xab\x61\xdd\xa2\xbf\xf8\xb6\x49\x9a\xb3\xd7\x52\xc1\x9d\xd7\x52\x3d\x46\x45\x7c\x58\x6e" +
+		"\x82\xee\xb6\xbf\x3a\xbd\x50\x17\x5c\xb0\xd8\x81\x3b\x16\xdb\xd9\xb8\xb0\x4c\xcd\x99\x8d\x56\xfc\x8e\xdd\xc4\x19\xd1\x2d\xcd\x2b" +
+		"\x36\xcf\x81\xcd\x3c\x07\x8a\x82\x04\x37\xc9\x8f\xc7\x08\xbe\x2e\x74\xbd\x5b\x5a\x90\x76\x2f\xd0\xce\xe7\x56\xa4\x81\xe3\x2b\xd2" +
+		"\xbc\xf2\xdb\x18\x1c\x52\x91\x06\xb7\x4c\x2e\x8d\x91\xa6\x57\x45\x9a\xdf\x14\x56\xa6\x3e\xd3\x13\x79\x7b\xc8\x2e\x13\xf0\x8a\xc0" +
+		"\xc1\x3d\xd2\xc0\x1e\x3d\xd2\x14\xf8\xd3\x7b\xa6\x81\x1f\x7f\x98\x0e\x4e\x95\x66\xb3\xfc\xb6\x62\x85\x4c\x7f\x85\x55\xc9\x8c\x80" +
+		"\xd3\xc5\x89\x72\x92\x12\x25\x9b\x06\x67\xa7\x12\x1a\x7a\xbf\x4c\x46\x15\xe9\xbf\xc0\xb3\xab\x79\xef\x3b\xb0\xeb\x2a\x07\xa6\xfa" +
+		"\xe0\x8c\xa5\x04\x17\xf6\x4e\x06\xdf\xed\x9d\xec\x9d\x75\x07\xfb\x89\x57\x42\x9a\xf3\x72\x68\xf4\xdd\x01\x69\xfe\x6c\xc4\x80\x35" +
+		"\x4e\xaa\x97\x3b\xdd\x44\x11\x6f\x94\x36\x88\xcc\x92\x93\x83\x13\xa5\x59\x2f\xcd\xcc\x21\x95\x13\x2f\x1f\x2e\x33\x3b\x20\x87\xc0" +
+		"\x6b\x59\x11\xeb\xa5\x99\x96\x1c\x64\xd5\x0d\xa6\x3a\xee\xad\x6e\x54\x79\x9b\x2c\x55\x58\x8d\x76\x93\x83\xb4\x4d\x45\xfa\x8a\xba" +
+		"\x9e\xbb\x4f\x30\xc1\xac\x08\x1c\x42\x9d\x6b\x47\x7a\xde\xde\xd0\x9f\x28\x34\xff\x4f\x7b\x3f\xbc\x74\x84\xa2\xfa\x67\x54\x2d\x8c" +
+		"\xe6\xb8\x0b\x5f\x3f\x6c\xe1\xc2\x55\x1c\xf6\x6d\x82\xe9\xb8\x64\x6f\xd7\x6a\x19\x2c\xae\xcb\xf4\xf2\xde\x64\x7a\x1d\xb4\xae\x3f" +
+		"\x21\x97\xeb\x63\xd8\x1f\xf0\x58\x1c\xf5\x1e\xd6\x02\xff\x53\x4c\x17\xa9\xc4\x1f\x2b\xb0\x3f\x1b\x9b\x53\xb1\xd2\x60\x58\xcf\xfb" +
+		"\x7b\xd3\xfd\x41\x93\xba\x5e\x5e\x2f\xd1\x29\x3e\xe8\x71\x29\x5f\xdf\x5a\x79\x1e\x25\xcd\x8f\xae\x52\xf4\xd2\x8b\x2e\xc1\xfb\x17" +
+		"\x12\xfc\x39\xc2\x27\xf4\x4a\xcc\x5b\x75\x23\x9e\xa7\x56\x9a\xcb\x74\xdc\xae\x8a\xe5\xc5\xe5\x29\x60\xb2\xfd\x63\xcd\x1c\xd0\x58" +
+		"\xf9\x7b\x5d\x7f\x12\xf2\xfe\x2a\xa3\xaa\xeb\x8f\x97\x05\x4e\x7d\x3b\x77\xd3\x5a\x81\xd9\x44\xff\x7c\x58\x6f\x0a\xec\x48\xc9\xfe" +
+		"\x1c\x62\xfb\x2a\x87\x21\xaf\xd1\x1f\x0e\x79\xcd\x33\xdc\x4c\x6f\xec\x2b\x00\x0f\x98\x42\xf7\x8c\xb7\x53\xda\xfa\x76\xa3\x3e\xea" +
+		"\x31\xaf\xe6\xdc\xe7\x11\x34\xc7\x3b\x24\x1e\xd0\x9f\x1a\x28\xf0\x4f\x07\xde\xd0\x57\xc1\xdb\xcd\xf3\x6e\x07\x0c\x26\x51\xef\x7e" +
+		"\xc1\xbb\xf0\xed\xd4\xe1\x03\x9e\xdd\xeb\x46\x96\xa9\x94\xe8\x10\x92\x3d\x6e\x96\xfa\xf3\x0e\x69\xfe\x98\x5e\x23\x9d\xee\x53\x0a" +
+		"\xf0\x7b\xfc\x73\x2a\xcf\x09\xb4\xf4\x8c\x51\xd5\x1a\xd7\x4e\x0f\x51\xe6\x56\xa9\x1a\x99\xe0\x0f\x08\xa5\x79\xf1\xde\xb6\x78\xa9" +
+		"\xea\x61\xf5\x14\x7e\x2f\x7a\xdb\x51\xe5\x91\xc6\xc6\xde\x1e\xd5\xaa\xca\x71\x21\x5b\xca\xa5\x4e\x99\xe0\xfd\xde\xca\x36\x95\xde" +
+		"\xf3\xde\xa1\x8a\xb2\x93\xe3\xb9\xca\x76\x82\x8b\x69\x47\x68\xec\x54\x5e\xa9\xf7\xb4\x66\x56\x12\x7a\x5b\xdb\x20\xe4\xc4\xce\x1a" +
+		"\x64\x0a\x48\x5d\x91\x0e\xee\xb7\x72\x87\x7b\x55\xc7\xd4\x9b\xde\xa5\x6c\xf4\xda\x8e\x44\xd9\xe4\xda\xeb\x5a\x1a\x54\x46\x58\xb8" +
+		"\xc5\x1f\x95\xcc\x4c\xa6\x3f\x06\x62\xe2\xf9\x33\x70\x2d\xc1\x81\x95\x9b\x66\xba\xe9\x39\xe6\xb6\x9a\x6a\xd4\xe0\x41\xf3\x98\x37" +
+		"\x81\x6b\x6f\xc1\x25\x7b\x82\x93\xbf\x6d\xf5\x41\xed\x54\x66\xcf\xac\x72\x50\x2a\xc7\x78\x63\xa9\x77\x85\xa5\x07\x2a\x8f\x6b\xe2" +
+		"\x43\x37\xf2\x2c\x50\xd4\xdc\xa1\x51\x7f\xfc\xb0\x44\x7f\xb3\xb3\x33\x2f\xe9\xf7\xdb\x83\xe5\x64\xe5\x99\xc7\x5c\x4f\x96\x0b\xbc" +
+		"\x58\x9f\xe3\x4f\xcb\x0c\xa3\x3f\xbe\x5b\xd7\x9b\xfc\x62\xde\x04\x9c\xec\x49\x42\xd4\x3b\xb8\xcd\x70\x96\xec\xb7\x78\x13\x7f\xdc" +
+		"\xb7\xc0\x93\xa3\x98\x47\x16\xf3\xb6\xf4\xb4\x68\xb2\x7b\x3c\x05\xc8\xf7\xc7\x35\x32\x15\xb7\xeb\x69\xff\xc6\xde\x10\xa6\x27\x9d" +
+		"\x64\xe7\x14\xe9\xb6\x69\xa2\xb7\xb5\xf3\x28\xf4\x8a\x67\x71\x76\x1e\xa4\x13\xc1\x47\xc0\xc1\x54\x58\x9b\x6d\xfe\xb7\x1e\xa4\x09" +
+		"\x7e\x95\x5b\x05\xce\x3f\x97\x8f\x50\x9a\x97\xc3\xcc\xec\x50\x19\x1e\x13\x03\x57\xd1\x87\x75\x54\x7a\x48\x72\x3c\xa8\x1a\xcb\x17" +
+		"\xd1\x14\x5f\xf0\xa0\xe9\xe0\xcf\xf0\x5c\x72\x0a\xf9\x70\x40\x71\x9d\xc9\xe5\x8d\x11\x9a\x5b\x19\x3f\x54\xa0\x2b\x34\x82\x95\xc5" +
+		"\xa4\xa3\xfe\x4c\x78\xcc\xe3\xae\xe5\x92\x32\xfa\xd5\xc4\x1f\xb8\xae\x3c\x31\xfe\x88\x6a\x24\x69\x55\xe4\x15\x75\xea\x04\x36\xc5" +
+		"\xd6\xde\x15\xde\xd8\x6f\xe9\x22\x7f\xbd\xd8\xaf\xdb\x72\xea\x0a\x74\xb4\xc0\xc3\xf9\x54\x3c\x69\xa9\x53\x7f\xd5\xd0\xd8\x30\xe9" +
+		"\x01\x33\xf4\xdb\x6d\xe7\x83\xb8\xb3\x5c\x2f\x56\xb7\xbb\x7e\x7a\xce\x55\x9e\xef\xcc\xd2\x2b\xc6\x96\x5e\xf5\xcb\x98\xe3\x05\xc8" +
+		"\x88\x47\x9b\x06\x08\xd9\xa9\x32\xf5\xc2\xba\x56\xde\x61\xd5\xde\x53\x05\x13\xf4\xfd\x29\x52\x99\xc3\x51\x7b\xb7\xf9\xb6\xd2\x3c" +
+		"\x8d\xa8\x94\xd0\xf2\x3c\xda\x99\xb7\xe7\x59\x7d\x6b\x89\x97\xff\x2b\x9d\xda\xbb\x9c\xb7\xcb\xbf\xe6\x7f\x4b\xf7\xb8\x18\xf6\xfc" +
+		"\xa6\x81\xbe\x7f\x9c\xed\xcd\x5c\x8f\x12\x6d\x65\x2e\x41\xdc\x45\x8e\x77\xc5\x66\x29\x23\xaa\xe4\x42\x51\x8f\xea\x09\x5e\x8d\xa9" +
+		"\xdc\x26\x39\x9e\x8f\xbc\xe4\x95\x8b\x02\x7f\x31\xea\x8d\x7b\xde\x20\xcc\x04\x84\x02\x6a\xb2\x94\x7a\x6f\x03\xdd\xc5\x79\xfe\xec" +
+		"\x74\xd4\xc3\x35\xcd\xd3\x82\x6c\xc5\x96\x86\x0a\x94\xe7\xbc\x33\x36\xdf\xab\x63\x25\x9e\x5d\x99\x80\xfe\x92\x9f\xf5\x04\x2a\x6e" +
+		"\xb5\x51\xe2\x13\xbd\x7e\xde\x55\x77\x51\x2b\x1f\x1d\x75\xbf\xdf\x05\x4f\xe8\xa0\x8b\x03\xc1\xde\x65\x79\xfe\x79\xc9\x6a\xfa\xb7" +
+		"\x0c\x70\xd8\xa5\x55\xb1\x5e\xbc\xea\xcd\x91\x51\x9d\x48\x6b\x3f\xa4\xca\x1c\xa8\xa6\x67\x4f\x36\xda\x98\xec\x0d\xe3\xcf\xfb\x90" +
+		"\x91\xb0\x3f\x51\x66\x2e\xe6\x06\x01\xce\x48\x9f\x56\xca\xa3\x9e\x05\xc7\xd8\x6e\xfd\xa3\xf7\xf9\xb7\xae\x72\xd4\xbb\xab\xf3\xac" +
+		"\xa8\x9e\x8e\x30\x1f\x6e\x57\x70\x76\x2d\xcd\x8b\x5a\x59\xba\xf9\xeb\x79\xb0\x35\xf1\x56\xc6\x1c\xff\xb2\x74\x2f\x5d\x16\x07\x01" +
+		"\x73\x01\xd4\x63\x95\x8e\xa9\x47\xcc\x6a\x42\xbf\x82\xbd\x9d\xd2\x97\x87\x15\x15\xb3\xbd\xec\x91\xec\xb7\xa0\xc2\xc0\x19\xf6\xdd" +
+		"\xa7\xeb\xf6\x98\x5e\x1c\xd5\x25\x09\x1c\x31\xdb\x59\x82\xbe\x4e\x73\xd5\x70\x6a\x41\xa4\xb2\x56\x39\x8a\xb7\x67\x25\xc9\xa2\x1c" +
+		"\x5a\x5b\x3a\x29\x52\x25\x17\xc7\x31\xc9\x89\xf2\xc1\x36\x3b\x78\x78\x58\x62\x7b\xd6\x7d\x90\x62\xb9\x5a\xa6\x8b\xaa\x47\xb0\xe7" +
+		"\x56\x71\xa9\x06\x51\x3c\xfb\x24\x7a\xe7\xcd\x6d\x29\xca\x7f\x87\x84\x74\x14\xbb\x42\xe0\x0c\x09\x5d\xb9\x28\xb9\x82\xf3\x02\x5e" +
+		"\xeb\x40\x78\xa9\xcf\x16\x7d\x4b\xc8\x42\x1f\x4b\xed\xd8\x47\xa5\x31\xea\x74\x90\xe9\xae\xc8\xc3\x6c\x96\xe8\x64\x6f\x9d\x4a\x05" +
+		"\xd7\x5a\xe0\xb3\x2c\xf4\x36\xfb\x5a\x11\x01\x47\xd1\x98\xc2\xc4\xd1\xe6\x6a\xeb\x2f\xf6\x87\x05\x13\x82\x03\x8e\xef\x4d\x26\x5c" +
+		"\xa7\xa9\x04\x87\x4c\x8e\x81\x1f\xbc\x63\x08\xb1\x63\x8b\x1c\x64\x93\xa6\xdf\x14\x2a\xdc\xa6\x4e\xa5\x05\x86\x06\xd1\x12\x25\xe0" +
+		"\x34\x4d\x30\x37\x93\xa6\x38\xaf\x31\x99\xea\x82\x45\xb6\x6e\x72\x41\x22\xd6\x85\x04\x2b\x16\xd3\x52\xa0\xf4\x76\xe6\xe4\x1b\x67" +
+		"\xf1\x6e\x9f\x4b\xbf\xe9\xef\xd3\x96\x75\x16\x3d\x2f\xe9\x68\xe0\x30\xee\x35\x5d\x5c\x75\x6f\xcf\x8f\x81\xdf\x6e\x26\xb8\x24\x0b" +
+		"\xdc\x76\x82\xe0\x91\x90\xc6\x5d\xeb\xfe\xbd\x5d\x57\x77\xbe\xd1\xfa\xa5\x34\xf6\xdf\x5b\xe0\x60\xb8\x32\x24\x48\xa3\x76\x20\x18" +
+		"\xc7\x36\x7b\xc1\xe0\x08\xb8\xc6\x02\xdb\xd6\x07\x52\xe1\x33\x0a\xfc\x35\x21\x4f\x2c\x97\x46\xc0\xea\xba\x9f\x5f\x04\x57\x3a\xf0" +
+		"\xe8\x88\x54\xf0\x6d\xb1\xfb\xd6\x35\x45\xee\x9a\x81\xf5\x01\xf0\x74\x44\x29\x43\xff\x10\x38\x39\xc1\x27\x63\xf9\xa6\x8f\x03\x17" +
+		"\x6e\xaf\x06\x8e\x5d\x66\x56\xf9\x31\x41\x84\xee\x5b\x03\x1c\xb8\x7e\xa8\x33\x26\xd5\xc9\x2c\x02\xf7\xd8\x37\xc0\xd5\xd5\xcd\x24" +
+		"\x98\x31\x3f\x20\x17\xb2\x02\xfb\x1c\x38\x21\x02\x2e\x0c\x62\x03\xf3\x2c\xfb\x0d\x4c\x50\x69\xac\xdb\x7a\x4c\xa2\x17\x21\x47\x18" +
+		"\x02\x35\xd6\xd1\x1f\x24\x38\xdb\x50\xe9\x84\xbc\x3e\x4b\xaa\x67\x27\x8b\x4d\x5c\x02\xb8\x1e\x01\x47\xfc\x02\x7c\x2b\x04\x2e\xab" +
+		"\x01\xf6\x79\x08\xfc\x5a\xd6\x79\x6a\x6b\x70\x73\x5b\xf0\xf0\x4b\xe0\x74\xb1\xbc\xdc\x05\xae\xce\x00\xbb\xb5\x03\xdf\xfa\x96\xe0" +
+		"\xb1\x57\xc0\x7e\x37\x83\x93\x36\x52\x2c\xdf\x0e\x1c\x72\x3f\x38\xaa\x39\x78\x33\x78\x2a\x0b\x1c\xfa\x18\xd8\x7b\x36\xc1\x8e\xf5" +
+		"\xc0\xf7\xb6\x10\x3c\x3f\x83\xe0\xe0\x1b\xc0\x39\x0f\x81\x13\xb3\xc1\x37\x6e\x06\x07\xfe\x14\xec\x1c\x06\x67\x9b\x1f\xe5\xa3\xa9" +
+		"\x32\xf3\x78\x70\x5b\x18\x5c\x5f\x07\xbc\x74\xde\x27\x43\x1d\x28\xcd\x92\xb1\xf2\x6d\x62\x18\xfc\xa2\x6f\x1c\x38\xeb\x67\xe0\xc6" +
+		"\x52\x70\xd7\x58\xd9\x0d\x27\xc5\x61\x5d\x02\x6e\x8f\x80\x5b\x86\xa6\x81\x0b\x6b\xc3\x4d\xa9\xa8\x0e\x0e\x48\x02\x6b\x83\xbb\x5e" +
+		"\x27\xd8\xa3\xa3\x49\x91\xd9\x9e\x81\x96\x82\x4f\x7b\x16\x93\xae\xe0\x5f\x40\x6f\x5d\x4f\x00\x43\x8a\x8f\x21\x2f\x75\x85\x3c\x1b" +
+		"\x2e\x35\xf4\xb1\x3b\xe2\xc1\x15\x04\xc3\xe0\xc7\xd6\x77\x71\xc8\x8e\x34\x84\x7d\x7c\xcc\x5f\x75\x35\x32\x7d\xea\x35\x70\x46\x48" +
+		"\x89\xfe\x64\x82\x12\xb9\xd8\xa3\x3b\xff\x2b\x8d\x97\x6f\x74\x27\x42\xe6\xa0\xbd\xc3\x67\x7c\x69\x8e\xf0\xed\x60\x09\xf8\x7b\xb8" +
+		"\x57\xf4\x39\xcd\x64\x9c\x49\xcf\xc2\x85\x91\xd4\x95\xf2\xe1\xf6\xcd\xa4\xd7\xa6\xfe\x56\x29\x8e\xc8\xa1\x95\x04\x2f\x49\x2e\xaa" +
+		"\x73\xe7\x42\x80\xbb\xfb\x3e\x20\xf4\x4f\xda\xb6\xf9\xfe\x7d\x80\xbb\x4b\xce\x73\xdc\xf5\x43\x70\xcf\xb4\x10\xf8\xfa\x09\x82\x1d" +
+		"\x90\xf8\x89\x74\xab\x06\xb8\x26\x3b\x09\xb8\x42\x6d\x8b\xb4\x6d\xaa\x6d\xb1\xb6\xcd\xb4\x2d\xd1\xb6\xb9\xb6\xa5\xda\xb6\xd8\x49" +
+		"\x44\xa1\x95\x60\x2f\x88\xd4\xb9\x41\x9a\xb9\x87\x1c\x38\x2f\x0f\x5c\xf3\x80\x51\x93\xfd\xc5\xe0\x6a\x71\x2d\x16\x83\x27\x5e\x02" +
+		"\x4f\x26\x82\x1f\xce\x20\xe0\xbe\x7d\x7c\xc3\xe3\xe0\x9a\x54\xf0\x60\x1c\xb8\x63\x27\xc1\x33\x5d\x09\xee\x11\x4b\xe1\x4b\xe0\xb0" +
+		"\x86\xe0\x9e\x42\xf0\x62\x32\xe0\x1e\xe8\x00\xb8\x1f\x76\x00\xaf\xb6\x00\xdc\x8f\x65\x3e\x3f\x2e\x94\xa6\x48\x9a\xa6\x60\xf7\x9e" +
+		"\x04\x5c\xbb\x0e\xe0\xe7\x6d\x40\xc8\x3f\x77\xb9\xda\x3f\x2a\x6d\xfe\xaf\x94\xd5\x09\xd5\x17\x60\x85\xea\x0b\xb4\x42\xf5\x05\x5c" +
+		"\xa1\xfa\x02\xaf\x50\x7d\x01\x58\xa8\xbe\x40\x2c\x54\x5f\x40\x16\xaa\x2f\x30\x0b\xd5\x17\xa0\x85\xea\x37\xd1\xbe\x85\xda\xb7\x50" +
+		"\xfb\x16\x6a\xdf\x42\xed\x5b\xa8\x7d\x0b\xb5\x6f\xa1\xf6\x2d\xd4\xbe\x85\xda\xb7\x50\xfb\x16\x69\xdf\x22\xed\x5b\xa4\x7d\x8b\xb4" +
+		"\x6f\x91\xf6\x2d\xd2\xbe\x45\xda\xb7\x48\xfb\x16\x69\xdf\x22\xed\xdb\x54\xfb\x36\xd5\xbe\x4d\xb5\x6f\x53\xed\xdb\x54\xfb\x36\xd5" +
+		"\xbe\x4d\xb5\x6f\x53\xed\xdb\x54\xfb\x36\xd5\xbe\xc5\xda\xb7\x58\xfb\x16\x6b\xdf\x62\xed\x5b\xac\x7d\x8b\xb5\x6f\xb1\xf6\x2d\xd6" +
+		"\xbe\xc5\xda\xb7\x58\xfb\x36\xd3\xbe\xcd\xb4\x6f\x33\xed\xdb\x4c\xfb\x36\xd3\xbe\xcd\xb4\x6f\x33\xed\xdb\x4c\xfb\x36\xd3\xbe\x06" +
+		"\xe7\x12\xed\x5b\xa2\x7d\x4b\xb4\x6f\x89\xf6\x2d\xd1\xbe\x25\xda\xb7\x44\xfb\x96\x68\xdf\x12\xed\x5b\xa2\x7d\x9b\x6b\xdf\xe6\xda" +
+		"\xb7\xb9\xf6\x6d\xae\x7d\x9b\x6b\xdf\xe6\xda\xb7\xb9\xf6\x6d\xae\x7d\x9b\x6b\xdf\xe6\xda\xb7\x54\xfb\x96\x6a\xdf\x52\xed\x5b\xaa" +
+		"\x7d\x4b\xb5\x6f\xa9\xf6\x2d\xd5\xbe\xa5\xda\xb7\x54\xfb\x96\x6a\xdf\x16\xda\xb7\x85\xf6\x6d\xa1\x7d\x5b\x68\xdf\x16\xda\xb7\x85" +
+		"\xf6\x6d\xa1\x7d\x5b\x68\xdf\x16\xda\xb7\x45\x8b\x7f\xec\xc1\xff\xb6\xa5\xad\xa4\x2d\xd4\xb6\x48\xdb\xa6\xda\x16\x6b\xdb\x4c\xdb" +
+		"\x12\x6d\x9b\x6b\x5b\xaa\xad\xf6\x55\x5a\x52\xa8\xb4\xa4\x50\x69\x49\xa1\xd2\x92\x42\xa5\x25\x85\x4a\x4b\x0a\x95\x96\x14\x2a\x2d" +
+		"\x29\x54\x5a\x52\xa8\xb4\xa4\x50\x69\x49\xa1\xd2\x92\x42\xa5\x25\x85\x4a\x4b\x0a\x95\x96\x14\x2a\x2d\x29\x54\x5a\x52\xa8\xb4\xa4" +
+		"\x50\x69\x49\xa1\xd2\x92\x42\xa5\x25\x85\x4a\x4b\x0a\x95\x96\x14\x2a\x2d\x29\x54\x5a\x52\xa8\xb4\xa4\x50\x69\x49\xa1\xd2\x92\x42" +
+		"\xa5\x25\x85\x4a\x4b\x0a\x95\x96\x14\x2a\x2d\x29\x54\x5a\x52\xa8\xb4\xa4\x50\x69\x49\xa1\xd2\x92\x42\xa5\x25\x85\x4a\x4b\x0a\x95" +
+		"\x96\x14\x2a\x96\x34\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36\x16\xe8\x36" +
+		"\x96\x1e\x8d\xa4\x47\x23\xe9\xd1\x48\x7a\x34\x92\x1e\x8d\xa4\x47\x23\xe9\xd1\x48\x7a\x34\x92\x1e\x8d\xa4\x47\x23\x19\xdf\x6f\x7f" +
+		"\xfb\x84\xb6\xbf\x06\x5c\x7d\xe1\x9f\xed\xa4\xe3\x8f\xb4\x79\x05\x70\xf7\x4b\xf3\xcf\x32\xee\xf6\x2f\x34\x06\x42\xf7\x48\xeb\xa6" +
+		"\x5a\x4d\xa3\x19\x65\x27\xca\xc6\x49\x1d\xa4\x77\xb5\x70\xd3\xfa\x32\x20\x6e\x5e\xd9\x95\xb2\xdd\x65\xc7\xb5\x0a\x52\x78\x5e\xd9" +
+		"\xc4\xb2\x99\x7a\x6f\x78\x7e\xd9\xe7\x65\x57\xec\xeb\x07\x65\xf3\xf4\x16\x20\xb4\x4e\xcb\x65\x69\x8d\xa5\x71\xf6\xe5\xa3\xe0\x4a" +
+		"\x78\x77\xd9\xbb\x56\x77\x09\x6e\x6f\xd9\x15\xf9\xe9\xcb\xb2\x73\x5a\x76\x29\xee\x58\xd9\xb9\xb2\xe9\xc1\x7d\xff\x17\x8d\x85\x3b" +
+		"\x4e\x46\x40\x00\xae\xd3\xe3\xbd\x1e\xd7\x8f\x37\x1f\x87\x3b\x73\x26\xfe\x1f\x02\xf4\xdf\x6f\xbc\x9c\x31\xd6\x81\x8b\xa7\x87\xc1" +
+		"\xef\x49\xbc\x61\x08\xec\xf7\x35\x7d\x84\x46\x54\x2d\x48\xf7\x80\x4d\x7c\x40\x4f\xb2\x8f\xdc\x48\xa8\x12\xb3\x55\xea\xcd\xb0\x95" +
+		"\x36\xc3\x02\x6f\xd6\x4c\xf3\xff\x22\x55\xcc\x50\x66\x0e\x2a\x46\x28\x0c\x57\xe9\x44\xaa\x0c\x79\xab\x34\x45\x9a\xbb\x49\x83\xe0" +
+		"\x82\x0a\x4c\xea\xd0\x08\x62\x1d\x63\x7e\x3c\xda\x31\xb8\xc1\xfb\x31\x42\x09\x3e\xe8\x3b\x21\x88\x7b\x74\xe9\xb8\x5e\x88\xcf\x82" +
+		"\x3a\x34\x10\xc5\xe9\x78\x9c\x99\x6d\xc2\x7f\x99\x6e\x2b\xc7\x5b\xe5\x2c\xe4\x3b\x5f\x95\x76\x4b\x50\x16\xf1\x47\x75\xa3\x60\x1b" +
+		"\xef\x2f\xaa\xe7\xa3\x59\xa2\x08\xe5\x7b\xb3\x4a\x58\x1e\x15\xaa\x34\x11\x85\xbd\x55\xb0\xf2\x38\x53\xe5\xc1\xed\xb0\x3f\xaf\x12" +
+		"\xbd\x1e\xda\x1f\xaa\xf4\x1a\x66\xfb\x93\xa4\x9a\xdf\x2c\x70\xa6\xd7\xf3\x69\xd3\xd4\x74\xe8\x2a\xdf\x68\x0e\x83\x26\xde\x92\xdd" +
+		"\xd0\xaf\x45\xa5\x55\x3f\xac\x4f\xa8\xe7\xdf\x9e\xe0\x93\xc2\x75\xf0\x87\x28\xd3\x14\x80\xed\xbd\x09\x35\x3b\xc8\xef\xe6\x2c\x27" +
+		"\x5b\x03\x3f\xa4\x96\x32\x1e\x67\x99\x48\xdb\x78\x4b\x58\x9e\xb7\xbd\x46\x83\x75\x09\x95\x28\xcc\xf3\x82\xd3\xe7\x81\xd9\x2e\x27" +
+		"\x30\x08\x87\xd2\xe1\x8a\x7d\xa8\x66\xd8\x9f\xe5\x6c\xe8\x6d\x9d\xd1\x2a\x61\x8f\xd1\x60\x35\xaf\x87\xa1\xde\xe0\x7d\x80\x0d\x54" +
+		"\x09\xcc\xf6\x07\x12\xa3\xe0\x0f\xbd\xb3\x29\xc1\xbb\x0a\x0b\x7c\x92\xc2\x7c\x35\xf0\xaa\x9d\x26\x64\x51\x23\x2d\xc1\x90\x47\x3f" +
+		"\x33\x65\xb6\xf7\xbe\x9b\x04\x1f\x6e\x64\xe5\xc1\xd2\xbd\x07\x2c\xdb\x63\x48\xc4\x5b\xa9\x2b\xd3\x95\x25\x57\x71\xb4\x46\xfc\x11" +
+		"\x90\x70\x95\xb4\x9c\x09\x3e\x7d\x6b\x82\x4f\xf6\xd8\xc0\xfb\x09\x6c\xb4\xb7\xfb\x55\x8b\x54\x39\xcd\x6a\xcf\x7f\x54\xef\xe9\x5f" +
+		"\x79\x3a\x54\x33\x3a\x06\x91\xee\x0d\x82\x27\xba\x4a\x94\x8a\x06\x86\x4a\x57\x79\x56\xb3\x89\x77\x33\x55\xfa\xcd\xdb\xeb\x02\x24" +
+		"\x7b\xf4\xca\xaa\x92\x83\x53\xcf\x30\x3b\x3b\x86\x6c\xa4\x24\x3d\x08\x33\x0c\xfc\xb6\x61\x0d\xd0\x89\xfa\x94\x8f\x61\x3f\xab\x6c" +
+		"\xff\xa2\x2c\x1d\x71\xb2\x3f\xb5\x19\xf5\xc9\xfe\x5a\x6a\x1b\xf6\x26\xfd\xca\x08\x39\xdb\xd5\x25\x41\xec\x6b\x10\x20\x58\xe9\x3f" +
+		"\x68\x1c\x60\x98\xf3\x41\xc9\xd7\xc3\x51\x72\xf5\x62\x63\xef\x6c\x4a\xf0\x23\x49\xf0\x51\xe6\x16\xcd\x52\x2f\xd8\x7b\xce\x0e\x13" +
+		"\xe5\xf8\x7b\xc2\xd7\xf7\x86\xbc\xa2\x77\x75\xf0\x9b\x4c\xef\xec\xd0\x81\x04\x5b\x26\x52\xc5\xe8\x1b\xf5\x53\xcc\xab\x12\x06\x91" +
+		"\xe0\xf3\xd5\x25\xcb\x58\x82\x38\xf0\x02\x1f\x16\xde\x48\x2d\xb3\x79\xd7\x43\xb0\x5c\x25\x51\x0a\xfb\x93\x5c\x31\xef\x9b\xae\xdc" +
+		"\x7a\x9a\x15\x50\xa8\x4a\x3b\xbd\xdf\x0e\x35\xe4\xe9\x82\xa4\x79\x97\x44\x9a\x8f\x64\xbd\xdd\x2f\x7e\x82\xa7\x42\x31\xef\x32\xac" +
+		"\xcc\xd6\x19\xf3\xbb\xb8\x32\xb1\x6c\x6b\x7d\xe3\x7d\x3e\x99\x5a\x82\xa7\x54\x96\x6b\x31\xd3\x63\x6c\x25\x15\x6d\x18\x70\x2d\xd7" +
+		"\x24\xf0\x55\x84\x62\xde\x9f\xa9\xb8\xe0\x2a\x3d\x01\x61\x9f\xe6\xb5\xc4\xf3\x82\xca\xed\xdc\x36\x18\x8c\x6b\x18\x24\xa1\x74\x09" +
+		"\x1e\x02\xc9\x55\x12\x42\x86\x7c\xfc\x4e\xe5\x89\x2f\x8b\x76\x4b\x92\x1b\x42\x16\xb0\xbb\x34\x05\xee\x62\x72\x15\x2c\xcc\xf2\x51" +
+		"\x96\x45\xde\xc3\x62\xf1\x6e\x31\xef\x83\xcb\xf4\xdb\xba\x6d\x95\x20\xa7\x7a\x7e\x61\xb3\x3d\x61\x88\xfa\x77\xe6\x07\x61\xe8\xa1" +
+		"\xc6\x1e\xc0\x39\x3e\xc5\xaa\x9f\x8a\x4b\xaf\xe2\x6f\x4c\xf7\x64\xc0\x10\xda\x42\x17\x9b\xe8\x56\xcc\xd4\x45\xb3\xe0\x06\x0b\x2a" +
+		"\x7d\xde\x83\xf6\xf6\x2a\x7e\x0d\x0d\x35\x71\xea\x5b\x73\x4a\x0f\x9c\x3a\x30\x5c\x7a\x90\xf2\xda\x78\x17\xc3\xde\xaf\x93\xe5\x77" +
+		"\x48\x96\x4f\x0f\x6c\x0b\x55\xd7\x63\xa2\xfa\xc3\x5d\xb6\x3e\xa7\x95\xf7\x4f\x24\xeb\xd2\xd9\x5a\x59\x58\x4b\xa9\x77\xdc\x37\xf1" +
+		"\x84\xad\xc4\xef\xc3\x46\x9e\x14\xd5\xf3\x14\xc4\x98\xcc\x6f\xf4\xcf\x0e\x1e\x95\x92\xfd\xda\x46\xbc\x98\x60\xf0\xfc\x8d\x4f\xcb" +
+		"\x61\x24\xbc\x7d\x00\x2b\x57\xe9\x67\xab\x6c\xc3\xea\x7f\x6f\xe4\x99\x70\xba\x7f\xb2\x05\x99\xe9\x66\x62\x9e\x0f\xc5\xa9\xe7\xcf" +
+		"\x99\x58\x20\x64\xb1\xf7\xae\x46\xfc\x0e\x37\x27\x4b\xd8\x7b\x66\x7b\x56\x1e\x16\xb8\xc7\x93\x2e\xb1\xd9\xcb\xf9\x09\xe3\x36\x4f" +
+		"\x05\x91\xe1\x81\x27\x30\x57\xb9\xae\xc5\x01\x57\x9e\xb8\x6b\xe4\xfd\x05\x39\xba\x10\x99\xd7\xcf\xc4\xbb\x47\xfc\x26\x6b\xe5\xb9" +
+		"\x6e\xab\x20\x2e\x38\x1c\xf3\xbd\x62\x9e\xe4\x55\x22\x5e\xc4\x3b\x76\x63\xfe\x60\x5c\x81\x77\x29\x29\xf1\x75\x2f\x7a\x4e\x1b\x0e" +
+		"\x82\x63\x5c\xc4\x67\xd0\x8c\x54\x99\x6f\xd5\xe8\xe6\x6c\x4f\xca\x73\xfc\x5a\x24\xf8\x03\xe1\x61\xa5\xfb\x95\x83\x69\x69\xf5\x8f" +
+		"\x0a\x7c\xd6\x5e\xdb\x6e\x0d\xc1\x87\xe1\x62\x5e\xc0\x68\xe9\x47\x66\x99\x1d\xf2\xe5\x8b\xab\x64\x7c\x79\xfe\x24\x57\x02\x58\xe4" +
+		"\xd3\xaa\x37\xf4\xfb\xd0\x36\xaf\x91\xbe\xb6\xda\x3d\xe2\xa9\x4a\x1b\x4f\x73\x92\xfd\x03\x2d\x88\xbc\xe8\xba\x50\x17\x88\x49\x59" +
+		"\x1e\x21\x12\xfc\xc2\x45\x3c\x07\xf0\xdc\x2f\xe0\xd1\x69\x5e\xe4\xb0\x8b\x4d\xbc\xb3\x2b\xb7\x4a\xcc\x78\xb6\xcf\xff\x9b\x5b\x85" +
+		"\x47\x67\xfb\x5e\x59\xde\x4d\xa9\x63\x73\x51\x2f\xb7\xe4\xfb\x70\x31\xdb\x33\x1d\x7c\x80\x56\x65\x62\xeb\x76\x7e\xd8\xfa\x1c\x97" +
+		"\x05\x3e\xe4\x5d\xf5\xd1\x2a\xa1\x20\x95\x62\x67\xd4\x0b\xba\x45\x7a\x25\xed\x2f\x3d\xa9\x61\x8f\x76\x69\x3a\x86\x6c\x0f\xe7\xf4" +
+		"\xa0\x97\xfc\xfa\x90\xe7\xab\x26\x3b\xdd\x57\x25\x74\x3d\xc1\xe7\x82\x49\xf6\x08\x57\xe0\xc9\x7e\x43\x84\x1f\xd1\xc5\x7d\x4a\x0d" +
+		"\xe8\x69\x1e\x81\xee\x09\xd0\x20\x78\x57\xd8\xeb\x14\x9a\xf6\xde\xa5\x99\x5b\xca\x92\x2d\x15\xfb\x21\x36\xf6\x9b\x32\xcd\x43\xbf" +
+		"\xb5\x4f\x6f\x9d\xe9\x8f\x99\x26\x57\x89\xfe\x6a\x1d\xa0\x30\x7f\xeb\xe7\x13\xf5\xd2\x61\x82\xe7\xbb\x05\x9e\x1a\xa5\x57\xc9\xf3" +
+		"\xd4\xc8\x4b\x2f\x69\x3e\x49\x52\xe3\x20\xeb\x69\x28\x5a\x85\xbb\xe4\xf9\x08\x6a\xf3\x93\x47\x7c\x90\x5c\x03\x05\x62\x25\x64\x2b" +
+		"\x25\xce\x17\xfd\x4a\xa7\xc3\x59\xf6\xd3\x70\x15\x07\xbb\x8f\x5e\x75\x59\x55\x52\x0f\x87\xab\x04\xb6\xd9\x41\xb5\x8f\x09\x4e\x36" +
+		"\xf7\xde\x4e\x82\xe5\xce\xc7\xba\x26\x57\xe1\x60\x09\x7e\x94\x99\xfe\xf0\x9d\x8a\x6e\xce\x28\xff\x7d\xfe\x35\x09\x5e\x63\xc8\x0e" +
+		"\xde\x1d\x60\x7a\x15\x79\xd3\x19\x64\x8b\xfc\x80\x1a\x56\x89\xc9\x33\xd1\x3b\xea\xb3\xf5\x26\xfb\xdd\x93\x0e\x57\xe0\x27\x56\x12" +
+		"\x0c\xc6\xe9\x17\x93\x2c\x02\xd2\xd1\xda\x0f\x20\x2a\xed\x75\x39\x2c\xd9\xc3\xae\x52\xc4\xb0\x37\x2e\x09\xca\xa9\x99\x2b\xd9\x38" +
+		"\x95\xad\x51\x03\x30\x17\xe1\x06\x55\x52\x73\xe5\x7a\xe1\x26\xab\x8a\x08\x12\xae\x42\xba\xc2\x55\x92\xaf\xa7\x54\x49\xf8\x5a\x29" +
+		"\xcd\x46\xab\x30\xf9\x04\x1f\x99\x54\x99\xbb\xdd\xf2\x99\x57\xa6\xd2\xcf\x57\x42\x5e\x99\xdf\x37\xbd\x4a\xd6\xd9\x48\x95\xc5\xad" +
+		"\x8c\x18\xab\x0c\xa9\x50\x32\xe9\x92\x7d\x4c\xa3\xb9\x99\x4d\x7b\xcc\xd6\x25\x2a\xf2\x30\x08\x7b\x89\x34\xb7\x8a\xd4\x12\x09\x96" +
+		"\xdd\x15\x78\x45\x37\x52\x45\xea\xae\x9c\x42\x65\x30\xd3\x5f\xaa\xca\x21\x3b\xba\x9d\xe7\x0f\xd6\xd5\xf5\x88\x90\x5c\x25\xd9\x4d" +
+		"\xa5\xd4\xbd\xcd\x79\x62\x10\xf3\x8f\x88\x0f\x02\x5b\x9c\xee\x16\x97\x16\x2c\x96\x33\x49\x30\xc7\xcf\x3c\xd7\x4b\x88\xb6\xf5\x37" +
+		"\xb2\x4a\xbd\x81\x34\x4f\x31\xb2\xfd\x7b\xc2\x1e\x3c\xd1\x2a\xdc\xdd\xf0\xe9\x97\x70\x7d\xa8\xb7\xde\x03\x67\xe1\x9d\x39\x70\x8a" +
+		"\x65\x2e\x3d\x88\x8a\x0e\x14\x10\x3b\x77\x1e\x09\x64\x93\xe0\x65\x19\xf2\xa7\xab\xe4\xa5\x95\x64\x31\xd9\xbf\x26\x17\xce\xf0\xca" +
+		"\x8a\x38\x94\xfc\x87\x55\xb3\x30\xac\x62\x11\x94\x5d\xba\x3f\x3f\x98\xe0\xd9\xbc\xc5\x91\xda\x29\xce\x6c\xf0\x26\x95\xb9\xa2\x55" +
+		"\xf6\x6f\xa5\x66\x6e\x84\xdb\x04\x96\x62\x4f\xb8\xa2\x3a\xb6\x4a\xb1\xb8\x48\xb6\xac\x5c\xcc\xf7\xb8\x9a\x10\xd8\x26\x9c\xdd\xac" +
+		"\x89\x10\x42\x46\xb2\xab\x84\xcd\x39\x45\x9e\x50\xd4\x83\x3f\x7c\x3d\x45\xbd\x4b\xf0\x28\x14\xae\x02\xdc\x64\xdf\x2b\xa1\x0a\x2d" +
+		"\xaa\x34\x88\xa4\xf9\xe3\xd9\xc9\x5e\xdb\xac\x2c\x16\x52\x29\x08\x47\xaf\x2b\x03\xc1\xdc\x2b\xe9\x64\xfa\xf5\x85\x0c\x59\x56\x92" +
+		"\x84\xbf\xdc\xe8\x2d\x3d\xef\xc9\xf1\xfb\x31\xd7\x1f\xcd\x8d\x54\x49\x2d\x62\x57\x08\x5e\x50\x35\xda\x59\x42\xa5\x56\xfe\x71\xaf" +
+		"\x79\xf2\x52\x00\xa7\xac\xd8\x99\x7e\x93\xeb\x25\x49\x53\xbb\xdb\x79\x8d\xbf\xc4\xab\xa0\x11\x7f\x4e\x29\x17\xae\x55\x15\xdb\x4c" +
+		"\xae\x17\x19\x22\x9e\x16\x44\xaa\xc4\x84\xe9\xfa\x05\x14\xbc\x55\x95\x43\x1b\xc9\xbe\xb5\xe8\xb3\x6e\xd4\xf9\x15\xfb\x93\x7d\x4d" +
+		"\x70\x3d\x56\x2f\xdd\xd3\x2a\xd3\xcb\x3a\x56\x56\x6c\xae\x0c\x7e\x6e\xe9\xc9\x73\x16\x42\x11\x95\xaf\x2b\x31\xa7\x9e\x27\x4a\x61" +
+		"\x2f\x36\xd9\x72\x0c\x61\x15\x8b\x4b\xb8\xca\x11\xb9\x3c\xff\x45\x27\xe8\x92\xaf\xd3\x20\x17\xf6\x7b\x29\xab\x8a\x81\xc4\x62\xd6" +
+		"\x12\x3c\x7d\x89\xf8\x45\x4a\xfe\xcb\x62\xd4\x31\x3f\xe5\x5c\x1f\x7f\xf8\xa0\xe7\x47\x95\x04\xbc\xa1\x27\xa3\x56\x22\xe7\x12\xbd" +
+		"\xde\x5f\xcf\x5b\x99\xc2\x3e\x61\x4d\xc1\xf5\xb3\x91\x2e\xe1\xfa\x50\x2a\x73\xdc\xb8\xcc\x2a\xe1\x9f\xb9\xf2\x25\x64\x53\x3d\x58" +
+		"\x79\x44\x39\xec\xa3\xd5\xeb\x5d\xc7\x70\xd7\xb8\x0a\x57\x2c\xf2\x18\x6e\x75\x69\x32\xab\x1c\xe0\xcf\xf3\x4a\x58\xf4\xfa\xb1\xea" +
+		"\xc0\x3a\x66\xdc\xec\x03\x29\x12\x85\xff\xcf\x00",
+	"GB1": "" +
+		"\xbc\xbd\x75\x98\x5c\x45\xf6\xff\xff\x7e\xdd\x96\xe9\x4c\x26\x13\x57\x42\x80\x20\x41\x82\x07\x0b\x90\x04\x82\xbb\xbb\x2f\xb0\x38" +
+		"\x2c\xb0\x8b\x2e\x1b\x77\x77\x77\xe2\x2e\xc4\xdd\x8d\x18\x49\x88\x2b\x51\x42\xdc\xed\xf7\x9c\xaa\xbe\xdd\x3d\x7c\x3f\x7f\xff\x9e" +
+		"\x27\x34\x3d\x7d\xef\xad\x3a\x75\xdc\xaa\x2e\x6f\x56\x53\xa4\xce\x14\x44\xdb\xc9\x88\x3e\x09\x11\x11\x93\x13\xe2\x17\xfb\x3b\x2a" +
+		"\xb6\x54\x15\x6d\xce\xc6\x45\x73\xfb\x18\x99\x5f\xc1\x90\xfc\x0a\xe2\x0a\xfe\xaa\xaa\x44\x69\x05\xb9\x0a\x0a\x88\xa9\x76\xf7\x63" +
+		"\xa2\x9a\x38\x57\x07\x05\x17\x8a\x6b\xdd\x50\xb7\x0a\xc4\x3d\xe2\x0d\x71\x87\x68\x17\x88\xf3\x88\x7c\x62\x7b\x44\x1c\x44\xac\x43" +
+		"\xc4\xc5\xfb\xe2\x2a\x91\x25\x8a\x8b\xaa\xa2\x1e\x0a\xca\x2a\x28\x2e\x9a\x22\xae\x13\x88\x2e\x85\xdc\x90\xdd\xdb\xa1\xa0\xce\x4f" +
+		"\xa2\x47\x44\xd4\x3a\x11\x17\x53\x4f\x66\x29\x38\x71\x26\x4b\x6c\x39\x17\x17\x2d\x7f\x12\x1b\x6b\x22\x36\x17\x15\x88\xaf\x44\xc2" +
+		"\x7d\x29\xe0\x3e\x1f\x76\x9f\x57\xb8\xcf\x7d\xc5\xc5\xd0\x98\x82\x88\x5b\x6b\x8d\xca\x2a\xd6\xf6\x3a\x15\x7b\x5c\xd9\x8f\x2b\xfb" +
+		"\xaf\x5b\x95\xd3\xb2\xba\x22\x83\x8f\xc5\xc5\xac\x88\x5e\x9e\x1a\x11\x4d\x02\x3d\x3b\x73\x4a\x5c\x2f\x94\x55\xe1\xf9\x63\x50\x56" +
+		"\x54\x85\x0b\xd9\x27\xf5\xf6\x65\x89\xd6\x93\xe2\x02\x05\x23\xed\xff\x37\x8b\xbb\x14\xac\x88\x38\x74\x65\x2b\x88\x29\x1a\x57\xb0" +
+		"\x6e\x5c\x5c\xc1\x3c\xfb\xc8\xe7\x20\x6a\x7b\x22\xae\xa0\xd3\x89\xb8\xe2\x73\x77\x65\x89\x0a\xba\xf8\x6a\x71\xbb\xca\xdc\x22\x3a" +
+		"\x17\x70\xb8\x58\x1b\x11\xeb\x22\x0e\x45\xcf\x8a\x77\xc5\x7b\x0e\x3f\xfc\x20\xe6\x44\xc4\xdc\x88\x98\x81\x18\x85\x98\x12\x11\x06" +
+		"\xe6\x21\xc4\x16\xc4\xe8\x88\x18\x13\x11\x13\xfc\x02\xfd\x3f\xbb\xe1\x52\xf1\x6b\x4c\xac\xff\x5c\x1c\xf5\xf8\xd9\x1a\x88\xa5\xe8" +
+		"\xf2\x79\x13\x10\xf3\xd6\xc6\xf4\x58\xc7\xc6\x71\xbd\xb1\xa2\x0d\xa2\x5e\xf0\xff\x3f\x20\x2c\x5f\x18\x88\x3d\xb5\x03\x71\xfc\x24" +
+		"\x62\xc1\xb5\x62\xd4\x1d\xe2\x3f\xa2\x25\xa2\x57\x7d\x44\x43\x23\xf2\x9d\xa2\xf3\x2d\xa2\xc7\xd5\x62\x4b\x05\x31\xb6\xb4\xf8\xd5" +
+		"\xee\xd8\x13\x11\xf3\x87\x20\x26\xdf\x2b\xba\x5e\x27\xba\x3d\x26\xba\xe4\x8a\xc9\x15\x44\x1d\xbb\x63\xdb\x3b\x62\xd1\xdb\x62\xf5" +
+		"\x47\xa2\x43\x75\x31\xa0\x35\x62\xc2\xe3\x62\xf0\x8d\xa2\xd1\x1d\xa2\x6f\x79\xd1\x7b\x13\x62\x47\x79\xb1\xe0\x12\xb1\xfc\x36\x71" +
+		"\xe4\x51\x31\xfd\xbf\xe2\xb0\x8d\x7f\x87\xf8\x5a\x4c\xe9\x8c\x18\xd3\x17\x71\xa8\x05\x62\xd3\x1b\x62\x5a\x51\xf1\xd7\x20\xc4\xaa" +
+		"\x2b\xc4\xea\x15\x88\x6e\xff\x11\xc7\x7b\x21\x7e\x39\x8f\x18\xf4\xa6\x58\x51\x49\x34\x2a\x2c\xd6\x55\x16\x1b\x2b\x8b\xfd\x0f\x89" +
+		"\x41\xcf\x8a\x3d\xc7\x10\x2d\x07\x20\x8e\xbd\x26\x1a\x3f\x23\xc6\xdd\x21\x6a\x56\x15\x07\xe3\xe2\xd0\x4f\x62\xe0\xcb\xa2\xf6\x2c" +
+		"\x44\xab\x6b\xc4\xa8\xaa\x62\x12\x62\xd8\x13\x62\x63\x3b\xc4\x08\x23\xca\xea\x40\xfc\x3e\x1c\x31\xee\x41\xb1\x7f\x3e\x62\xf8\x55" +
+		"\x62\x70\x54\xcc\x7b\x53\x6c\xc8\x16\x67\x5f\x14\x3b\xaa\x8a\x66\xb7\x89\x46\x77\x8a\xfa\xad\x03\x31\xf4\x2f\x44\xe3\xcb\xc5\xf9" +
+		"\x2c\x31\x62\x2c\xa2\xd3\xef\x88\x35\x6b\x8d\xa8\x8b\x0c\xad\xf7\x8b\xb1\x5d\x02\xd1\xe0\x12\xd1\xa3\x6e\x20\xfa\x1d\x47\xf4\x2f" +
+		"\x2b\x4a\x8a\x6e\x85\x44\xa3\xf6\xf6\x77\x35\xd1\x74\x20\x62\xc8\xd5\x62\xd6\x7d\x62\xf2\x9b\x62\xfa\x2d\x4e\x1a\xb7\x1e\x44\x1c" +
+		"\xaf\x2c\x7a\xdd\x28\x1a\x94\x13\xfd\x5a\x05\xe2\x58\x23\x44\xcd\x7f\x8a\x79\x17\x89\xf5\xd5\x44\x9f\x37\xc4\x90\xc9\x86\x30\xc3" +
+		"\x6c\xdd\xcf\xc5\x89\x27\xc5\xd9\x6f\x44\x97\xfa\x88\xd6\x15\xc4\xfe\x8b\xc5\xd6\xba\x88\x3e\x71\xd1\x00\x71\xee\x4e\xb1\x78\x31" +
+		"\xa2\x51\x5b\x44\xc3\x97\xc4\xb0\xcf\xc4\xaa\x77\xc5\xc6\x1b\xc4\xaf\x88\x25\xef\x39\x2e\x8b\x89\x01\xb7\x39\x79\x66\xfb\xeb\x62" +
+		"\xd7\xff\x44\xf7\x19\x88\x9f\x4b\x8b\x63\x81\x18\x3d\x15\x31\x71\x1d\xa2\x56\x1f\xc4\xa9\xca\x62\x5c\x49\xd1\x27\x26\x76\x5e\x24" +
+		"\x66\xdf\x2b\x06\xdf\x2e\x56\x1a\x89\x6b\x3f\x22\x46\x16\x12\x87\x51\xd0\xe6\xa0\xd7\x9b\xb3\x02\xd1\xb8\x27\x62\x7c\x79\xb1\xde" +
+		"\x40\xeb\x71\x81\x58\xff\xb4\x68\xbb\x0f\x31\xf5\x6e\x71\xae\x39\xe2\xf4\x2b\x62\x5a\x29\xd1\xe4\x2a\xb1\x22\x22\x56\x3c\x26\xda" +
+		"\x94\x17\x3f\x3f\xea\x58\xbd\xff\x4b\x62\xe5\xfb\x62\xf3\x50\xc4\x8e\x9f\x44\xcb\xde\xb6\xda\x62\xa2\xeb\xeb\xa2\xd1\xf3\x62\x41" +
+		"\x35\xf1\xc7\x8f\xa2\x61\x96\xe8\x34\xd7\x56\xd0\x1d\xd1\xfc\x7d\x31\x24\x10\x1d\xee\x17\x3b\x6c\x5d\xeb\xde\x15\xf3\x47\x20\x7e" +
+		"\xbf\x53\xfc\xd2\xc2\x40\x88\x8a\x5d\x88\x85\x8f\x8b\x51\x31\xb1\xfc\x17\x5b\xf1\x7a\xc4\x41\x63\xa8\xb8\x58\x65\x88\x6c\xe8\xb0" +
+		"\x52\x41\xfc\x59\x55\xcc\xba\x45\x8c\xae\x2e\x96\x3f\x23\x7a\xcf\xb4\x45\x15\x15\x7f\xde\x2e\x16\xbc\x20\xb6\x18\x21\xea\x16\x13" +
+		"\x03\x0d\x29\x7b\x5f\x10\xa7\x5e\x11\x93\x72\xc5\xc4\xf9\x06\x7d\x4b\x23\x4b\x4c\x6c\x7c\x52\x74\x6e\x1b\x88\xbe\x0b\x8d\x8c\x9f" +
+		"\x88\x89\x57\x3b\x93\x30\xb4\x8a\xe8\xf2\xb0\x18\x1b\x88\x89\x36\x77\xff\x6f\xc4\xd2\x77\x45\xed\x3a\x88\x0e\x6f\x89\xb1\x8f\x8a" +
+		"\xfa\x39\xa2\xc7\xbf\xc4\xce\x29\x46\x1c\x5b\xec\x86\xa1\x88\x05\x59\xe2\xdc\x67\xa2\xe5\x7d\x62\xd5\x3c\xc4\x98\x81\x88\x96\xd5" +
+		"\xc4\x9c\xc9\x88\x62\xa2\xe7\xa7\xa2\x5b\x75\xb1\xef\x69\x31\xf1\x12\xb1\xc7\xc8\x1c\x13\x87\xc6\x22\xa6\x1b\x95\xd7\x17\x13\x4d" +
+		"\x5a\x21\x0e\x1b\xe5\x1a\x23\xba\x35\x43\xd4\x79\x58\xec\xbc\x45\xec\xbb\x59\xf4\x79\x51\xb4\xed\x18\x88\xe5\x5d\x03\xd1\x3f\x5b" +
+		"\x0c\x5b\x80\x68\x53\x49\x8c\xfd\x5c\xcc\x29\x2a\x7e\x49\x88\x66\x1b\x10\xe7\x4d\xa0\x66\x4c\x33\xb4\x8e\x4e\x0a\xe2\xaf\x55\x45" +
+		"\xbf\x1a\x88\xee\xc5\xc4\xbc\xa3\x88\x9a\x83\x11\xa3\x0d\xe0\x9a\x46\x80\x95\xd5\xc5\x89\x77\x45\xfb\x07\xc4\x9a\x2b\xc4\x84\x7f" +
+		"\x89\xfe\x3f\x88\x7d\xa3\x10\x7b\x12\xa2\x66\x54\x2c\x29\x24\x3a\x55\x13\xbf\x1b\xc6\x7b\xde\x25\x3a\xdd\x26\x86\xfd\x6a\xf4\xb8" +
+		"\x4a\xec\x2b\x2d\x86\xdf\x24\xce\xf5\x45\x34\xbd\x54\x0c\x28\x26\xc6\x34\x47\x4c\x79\x5e\xcc\x6c\x80\x68\xf7\x88\x68\x79\x89\x68" +
+		"\x7e\x9b\xd8\x54\x52\xec\x18\x8a\x98\xf9\xae\xf8\xa5\xb2\x18\xfc\x2f\x31\xe6\x67\xbb\xdd\x00\x5a\x7b\x95\x58\x5c\xcb\x30\xd4\x1e" +
+		"\xb1\xb5\x3e\xe2\x8f\x47\xc5\x12\x53\x1c\xef\x89\x73\x4d\x11\xf5\x67\x22\x06\xee\x43\xec\xfe\x40\x34\xf8\xaf\xd8\x37\xd5\x04\xeb" +
+		"\x26\xf1\xdb\x2c\xc4\xe4\x5b\xc5\xd4\x0f\xc4\xf0\x8a\x62\xff\x7f\xc5\x5a\xc4\xa1\xe5\x88\xb9\x36\xcd\xe2\x98\x98\x50\x56\x6c\x9c" +
+		"\x80\xd8\xff\xb9\x18\xfe\x8e\x58\xfd\xa4\x98\xfc\xae\x38\xf0\xa1\xd8\x36\xd2\x66\x36\x3c\xfe\x71\xa1\xd8\x69\xcf\x8c\x5b\x62\x38" +
+		"\x9c\x8a\xb8\x5f\xfc\xf5\xb6\x68\xf9\xaa\x98\xb2\x17\x71\xaa\xb4\x68\x97\x25\x3a\xf7\x40\xcc\xe9\x86\x68\x6d\xc8\x5f\x74\xa7\x18" +
+		"\x66\x7f\x6d\xec\x62\x58\x79\x52\x0c\xb6\xf5\xcc\x34\xc8\xa7\x5c\x23\x1a\x97\x12\xb3\xbe\x14\xb5\xef\x17\xe3\x3f\x10\x23\x17\xd9" +
+		"\x92\x6d\xe2\x85\x17\x8a\x13\x5d\x11\xcb\x8b\x8a\xdd\x5f\x88\xa7\xc4\x02\x23\x6f\xbf\xe6\x81\x98\x94\x10\xbd\xfe\x25\xfa\x55\x12" +
+		"\xeb\xe7\xd8\x88\xc7\x6c\xde\xca\x62\x74\x8e\x68\xd1\x13\x71\xfe\x20\xe2\xf7\x7f\x88\x13\xf7\x8a\x1d\x97\x8b\x0d\x36\xf3\xa9\x2f" +
+		"\xc5\xd1\xcb\xc4\x8e\x5b\xc4\xe2\xab\x44\xbb\x6f\xc5\xba\xb2\x62\xd0\x78\x03\xf2\x09\x31\xf8\x55\xb1\xac\x26\x62\xc5\xbb\x62\xf0" +
+		"\x9f\x88\x0d\x46\xba\x56\xcf\x8b\x66\x85\xc4\xf9\x31\x88\x95\x05\xc5\xfe\x42\xe2\x8f\x40\xfc\x99\x2b\x9a\x0c\x42\xec\x7a\x56\xd4" +
+		"\x7f\x41\xd4\x1f\x81\x38\x71\x0a\x31\xf1\x11\xd1\x30\x26\x76\xbf\x24\x7e\xbe\x50\x2c\xb9\x51\x8c\x9e\x8e\x68\x58\x51\x1c\x28\x23" +
+		"\x3a\xde\x29\x76\x3d\x28\xba\xbe\x23\x0e\x5e\x2f\xa6\x99\x74\x7e\x25\xc6\x3f\x25\xc6\x04\x62\x19\xe2\x2c\x62\xfd\x5c\xc4\x6a\xc3" +
+		"\x59\xaf\xa1\x06\xd9\xd3\x62\x65\x31\x31\xe8\x49\xb1\xfa\x32\x31\xbd\xa8\xd8\xf1\x9a\x18\xf4\xb1\xe8\xf5\x80\xe8\x5c\x48\x4c\xbb" +
+		"\x45\xcc\xfe\x52\xf4\x3f\x69\x8f\x16\x11\x8d\xfb\x18\x2e\x67\x20\x3a\x1b\x49\x27\xd4\x30\xaa\xff\x5b\xb4\x37\x3b\x57\x4c\xcc\x31" +
+		"\x04\x9e\x37\x9a\x75\xf9\x5a\x4c\xfd\x58\xb4\x28\x2d\x96\x7c\x25\x96\x3e\x20\x76\xdc\x2c\x4e\x7d\x23\xe6\x1b\x69\xfa\x18\x41\xf7" +
+		"\x1b\x6d\xba\x14\x14\x4d\x1f\x14\xcd\xe6\x21\x4e\x7f\x2c\x66\xbf\x2c\xe6\xdc\x2f\x16\x38\x5d\x74\xc2\x10\x77\x93\x18\xf7\x95\x38" +
+		"\x6a\x73\x55\x10\xed\x7a\xd8\xa2\xde\x13\x43\xa7\x20\x8e\xbf\x27\xa6\xbe\xe6\xdd\x9c\x57\xc5\xd2\x37\xc5\xc9\x6f\x44\x87\x52\xa2" +
+		"\x4b\x51\x31\x39\x57\xec\x6b\x87\x98\x66\xf8\xdc\xb0\x05\x31\xe8\x52\xb1\xe8\x27\xf1\x67\x6d\xc3\xf0\x40\x44\xb7\x17\xc5\x90\x4b" +
+		"\xc4\xd4\x62\xe2\xd8\x43\xe2\x74\x6b\x44\xff\x8b\xc4\xd4\x42\x62\xfe\x85\xa2\x8f\xd1\x6c\xd0\xe5\xa2\xeb\xd7\xa2\x4e\x54\xec\x30" +
+		"\x54\x9e\xae\x2e\x3a\xbc\x2f\x26\xfe\x86\x68\x74\xad\xe8\x55\x41\x4c\xbe\x48\xd4\xfc\xaf\x98\xd3\x3e\x10\x9b\xce\x21\xda\xfc\x47" +
+		"\x6c\x6b\x8f\xd8\x63\x1f\xd3\x5f\x11\xdd\xf2\x89\xc6\xe5\xc4\xb4\xa7\x45\xcd\x84\x68\x73\xb3\xe8\xd8\x0a\x31\xb2\x71\x20\x5a\xb7" +
+		"\x09\xc4\x27\xa2\xc5\x03\xe2\xec\xdd\xa2\x55\xad\x40\x74\x2f\x2f\x5a\x65\x89\x9f\x3f\x13\x8b\x4a\x8b\xa1\x77\x8a\xbf\x0c\xe4\x82" +
+		"\x62\xcd\x66\xc4\xe4\x92\x62\x7d\x6f\x44\xcd\x26\x88\x5f\xec\x99\xb5\x05\xc5\xf0\x32\x62\x7a\x05\xf1\xf3\x0e\x44\x0b\xa3\xdb\xf9" +
+		"\xa3\x88\xfc\xa2\x47\xae\xf8\x6b\x81\x41\xd4\x08\x31\xea\x2e\x31\xe7\x79\xb1\xf3\x6d\xd1\xbf\xba\xd8\x54\x59\xcc\xf9\x54\xd4\xfe" +
+		"\x5c\x1c\xb9\x4f\xd4\xca\x11\x6d\x6c\xd1\x63\xae\x14\xbd\x0a\x8a\xb3\x1f\x88\x05\x9f\x88\x51\xef\x8b\x9e\xd7\x79\x6e\x9c\x65\xb8" +
+		"\x7c\x57\x34\x5e\x82\xd7\x26\x7d\x5f\x14\x9b\x6c\xd5\xf3\xc6\x22\x16\x3e\x23\x76\xd6\x47\x1c\xbb\x5b\x74\x7d\x46\x4c\xa8\x8b\xa8" +
+		"\x9d\x23\xba\xfc\x5b\x34\xee\x8b\x68\x5e\x4a\xcc\x78\x4f\x34\xef\x82\xd8\x5d\x46\x74\x31\x55\xbc\x70\x17\xa2\x7b\x01\x51\xb3\x88" +
+		"\x68\xb3\x1b\xd1\xbe\x0f\x49\x51\x1f\x63\x3e\xee\xa1\x63\x86\x81\xff\x88\x7a\xef\x89\xc3\x23\x11\x35\xef\x14\xfd\x0b\x8b\x6a\x62" +
+		"\x72\x5c\x0c\x5d\x8f\x38\xd9\xd5\xe8\xd4\x19\xd1\xf0\x7a\xb1\x73\x2a\xde\x7b\x1b\x73\xa9\x58\x3e\xd1\x08\xdc\x1f\x83\x29\x10\x35" +
+		"\x27\x04\x62\x64\xef\x40\xcc\xbd\x59\xf4\x9b\x83\xe8\x55\x5a\x6c\xbb\x59\x1c\xb8\x53\xf4\xb5\xd5\xec\x08\xc4\xa9\x4b\xc4\xa0\xed" +
+		"\x88\x39\x3f\x89\x8e\x36\xc6\x2e\xfb\x98\xdc\x02\x31\xe3\x28\x49\x51\x9e\xff\xbd\x98\x77\xca\x7e\xff\x03\xd1\x6a\x28\xa2\x6d\x21" +
+		"\x71\x76\xb8\xc1\x19\x11\x5b\xbb\x05\x62\xa5\xad\xe8\xd8\x05\x62\xe4\x43\x62\x76\x77\xc3\xd9\xeb\xa2\x97\x39\xc7\x2f\x8a\x5d\xb7" +
+		"\x89\xfe\x5f\x8a\x9e\x2f\x8b\xa9\x36\x66\xa3\x1f\xc5\x88\xaf\x45\xb3\x03\x88\x9e\x37\x8b\x45\x57\x8a\xc3\x1f\x8a\x33\x9d\x10\xbd" +
+		"\x0d\x05\x3d\x5f\x10\xcb\x87\x20\x0e\x9b\xeb\xf6\xb1\x58\xf9\x9d\xd8\x61\xa4\x69\x7d\x08\xb1\xec\x6a\x51\xe3\xb0\x21\xa3\x92\x97" +
+		"\xa2\x5d\xdf\x8b\xdf\x1f\x17\x27\xeb\xdb\xca\x9a\x06\xe2\xe0\x5b\xe2\xe8\x18\x44\xdf\x6d\x88\xf1\x9b\x10\xa3\x6d\xe4\x31\x0d\x0c" +
+		"\xfb\xb7\x8b\x5a\xb5\x10\x1d\xaf\x16\xeb\x2b\x8b\x2d\x47\x10\xdb\xdf\x14\x03\x3e\x10\x5b\xed\xbe\x61\x86\xf0\x63\xef\x8b\xb1\x45" +
+		"\x44\xbb\x6a\xe2\x74\x13\x44\xe7\xab\x44\x9d\xfb\xc4\x82\xa8\x68\xf2\x99\x38\x3c\x1e\xd1\xeb\x36\xf1\xdb\x75\x62\xe8\x7f\x45\x6f" +
+		"\xa3\xc3\x2f\x9b\x11\xf5\x0a\x8a\xc9\xf6\xcc\xb6\x22\x62\xc1\x8f\xe2\x8f\x93\x88\xf5\x06\xd2\xb4\x9b\xc4\xce\xf9\x78\xf9\x6c\x99" +
+		"\x2d\xda\x15\x15\x8d\x8a\x89\x0e\x37\x8a\xb5\x9f\x88\x0d\x6d\x10\xb5\xca\x8a\x0e\x88\x2e\xa3\x0c\xe9\xb3\x6d\x01\xb7\x89\x66\x77" +
+		"\x89\x21\x86\xfd\x56\x11\xb1\xe7\x35\x31\xa3\xb4\xf8\x56\x2c\xb8\x5e\xcc\xba\x4b\x8c\xea\x8b\xf7\xe0\x7a\x3e\x22\x56\xd9\xa3\x83" +
+		"\xce\x21\xd6\x1a\x62\x76\xdb\x73\x2d\x3e\x13\xf7\x89\xdd\x8d\x8c\xe2\xf6\x31\xef\x06\xd1\xf0\x4f\x92\x8c\xd6\xa8\xa0\x38\xbd\x14" +
+		"\xd1\xbc\xbc\x58\x63\x1e\xae\xf9\x1c\x0b\x9b\xdb\x8a\xee\x16\x8b\xae\x13\x3f\x1b\x2c\x43\xa7\x22\x66\x5c\x2d\x4e\x1a\x41\xfa\xb6" +
+		"\x33\xd2\x0c\x44\x2c\xca\x12\x46\xa7\xa1\xab\x11\xab\x8d\xa0\xad\x0a\x8b\xa1\x83\x11\x75\xbf\x11\x1d\x2f\x11\x43\xaa\x89\x45\xaf" +
+		"\x88\xde\xb7\xf9\xe0\x7c\xfd\x63\x62\x52\x09\x31\xf2\x66\x31\x64\x04\xe2\xd7\x77\xc4\xdc\xa7\x45\xad\x1e\x46\xb0\xfd\x88\x3e\x81" +
+		"\x68\xd1\x28\x10\x2b\x9e\x17\x73\x62\x62\x59\x56\x92\x94\xc3\x1f\x10\x5d\x2f\x10\x87\x9e\x16\x9d\x8b\x8b\x4e\x2d\x11\x2d\xdf\x13" +
+		"\x47\xb3\xc4\xd9\x6c\xb1\xef\x1d\xb1\x7d\x16\xa2\xe7\x27\x62\x77\x7b\x23\x4f\x20\x7e\x7d\x59\xb4\xbd\x50\x1c\xcc\x12\x7b\x0e\x18" +
+		"\xf0\xff\x16\xa3\xae\x15\x8b\x9b\x20\xfe\x32\xc9\xed\xfc\x98\x98\xfc\x85\x58\xfa\xbd\x98\x70\x97\xe8\x1b\x88\x25\x71\x51\xbb\xb3" +
+		"\xf9\x70\x25\x93\x2e\xe7\xd4\x57\xc4\x22\xb7\xfa\xdb\xc4\xf4\x77\xc5\xa8\x4b\xc4\x94\x3a\x88\x7d\xdd\x11\xa7\x7a\x22\x96\xbc\x21" +
+		"\xb6\x5d\x2d\x06\x5f\x28\xc6\x3e\x22\xba\xdc\x26\xd6\x9e\x41\x9c\x35\x8f\xa5\x97\x7d\x3b\x7f\x04\x71\xee\x4a\xb1\xb4\x80\x58\x11" +
+		"\x15\x7b\xee\x10\x6b\x2e\x17\x9b\xfb\x23\x36\xfd\x85\x38\x60\x71\xc3\x4a\x53\x19\x33\xde\x17\x35\x5e\x16\xcd\xab\x89\xdd\x9f\x88" +
+		"\x59\x55\xc5\x72\x83\xa1\xb3\x11\xee\xd4\x1a\xc4\x80\xeb\xc5\xb6\x49\xf6\x54\x67\xc4\x78\xc3\x6a\xa7\xff\x88\x11\xf7\x8b\x9f\xe3" +
+		"\xa2\x8e\xb9\xc2\x1b\x06\x07\x62\xd1\x42\xc4\xa4\x27\xc5\x96\x3b\xc4\xa0\xeb\xc4\xd8\x4f\xc5\xa1\x45\x88\xfa\x36\x55\xa7\xf3\x88" +
+		"\x11\x16\x2a\xad\x6f\x84\x68\x6f\x1f\xbd\xae\x10\x33\xca\x8a\x1a\x1d\x8c\xc4\x7b\x10\x47\x9e\x16\x0b\xb3\x45\x8f\x4b\x45\xd3\x37" +
+		"\xc4\x82\x2b\xc5\xfe\x0a\x62\xd6\x66\xc4\x74\x73\xd5\xbf\x11\x7b\x3f\x10\xd3\xbf\x13\x13\xee\x16\x73\xbe\x12\x0b\x8d\xb0\x7f\x56" +
+		"\x12\xe3\xe6\x20\x56\x7e\x26\xfa\x3f\x2f\x86\x2c\x30\xa2\x1a\xfe\xfe\xf8\x87\xe8\x54\x33\x10\xf3\xaf\x10\xbd\xcb\x88\xfe\xd7\x8b" +
+		"\x31\x77\x8b\x65\xcf\x8b\xbd\xb7\x0b\x83\xbc\x8b\xc1\xb0\x3b\x10\xfb\xf7\xda\x12\x8f\x1a\xb2\x9c\x60\x97\x12\x07\x86\x23\x86\x15" +
+		"\x12\x9b\x76\x22\xa6\xdc\x9b\x54\xda\x5d\x2e\x10\x67\xed\xee\xcd\x66\xe6\xba\x9e\xb5\x9f\x1e\x12\x3d\x63\xa2\xe6\x7f\x44\x97\x4a" +
+		"\xa2\xff\x69\xc4\xdc\xcb\xc4\xd1\x29\x88\x66\x86\xc7\x23\x65\xc5\xe9\x66\x81\x68\xf3\xbe\x98\xfd\xa0\x18\x50\x41\x74\xca\x15\xed" +
+		"\xfa\x25\x59\x6a\xa7\x09\x6e\x4c\xcc\xcb\x16\x7f\x14\x12\x5b\xa2\xe2\xd4\x3d\xe2\x8f\x22\xe2\x02\x31\xea\x31\x71\xe6\x76\x71\xea" +
+		"\x23\x31\xe0\x67\x44\xd3\x7c\x62\xdc\x6a\xc4\xd9\x9a\x88\xed\xad\x11\x53\x37\x20\x46\x77\xb2\x6f\x06\xfb\xf6\xf7\xc4\xce\x7f\x88" +
+		"\xa5\x51\x31\x7a\xac\xe1\x05\x31\xe4\x33\xd1\xe4\x01\xb1\xad\x0d\x62\x5f\x31\xb1\xc3\x0c\x4b\x63\xe3\xda\x17\xc4\x21\x33\xf4\x36" +
+		"\x7a\xa3\xa8\x8f\xdd\xc6\x14\xf6\x78\x5d\x71\x9f\x38\xba\x0e\xd1\xa7\x97\x65\x37\x7a\x1b\x8e\x5e\x16\xc3\x1f\x17\xfb\x1f\x16\xab" +
+		"\xaf\x15\xfd\x4a\x8b\x3a\x9f\x89\xbf\xbe\x15\x5d\x7b\x20\x26\x4d\x0a\x09\xdc\xff\x0b\x31\xe3\x05\xd1\xc4\x54\xe4\x2c\xc4\x98\x07" +
+		"\x44\xab\x5c\xd1\xe6\x27\x31\xa2\x0f\x62\x54\x49\xd1\xa8\x19\x62\xff\x0f\x62\xa6\xd9\xa1\x5c\xb1\xa7\xa0\x58\x8f\x58\x57\x4a\x6c" +
+		"\x7c\x40\x4c\x78\x5a\xcc\xfd\x54\xec\x19\x82\x68\x9d\x2b\xfa\x96\x11\x73\x9f\x11\x47\x2a\x88\x9d\x77\x88\x0d\x77\x89\x5f\xbb\x20" +
+		"\x66\xda\x24\x07\x6e\x11\xbd\x9f\x12\xc3\xbf\x15\x7f\x5c\x25\x8e\xd4\xb4\x89\x0c\x11\x2d\xe3\x62\xf3\xff\xc4\x9a\xc2\xe2\xb7\xd2" +
+		"\x62\x59\x13\x44\x83\xa8\x68\xb6\xcd\x16\xd6\x00\xd1\xf8\x2a\xf1\x27\x62\xfd\x6d\x62\xf2\x2b\xa2\xef\x93\xa2\x53\x79\x31\xf6\x5a" +
+		"\xd1\xa8\x1b\xa2\x61\x75\xb1\xe5\x33\xaf\x24\xce\x5e\x2b\x9a\xd4\xb6\x29\x5f\x16\x1d\x3f\x15\xdb\x0a\x88\x93\x0f\x8a\x81\xf9\xc5" +
+		"\x50\x23\x4d\x63\x1b\xf3\xc0\x51\x83\xbb\x3d\x62\xc2\x1d\x62\x53\x45\x31\xb5\x84\x18\xd2\x0a\xd1\xe4\x2e\x31\xf8\x1e\xd1\xb2\xa2" +
+		"\xd8\x77\x91\xa8\x95\x10\xb5\x86\x21\x26\x7e\x26\xda\xde\x22\xe6\x8d\x40\xb4\x9f\x8a\x58\x60\x61\x4a\x47\xc4\x5e\xfb\x6b\xce\xb3" +
+		"\x62\x4d\x45\xd1\x37\x22\x26\xde\x2e\x86\xde\x2a\x9a\x98\x36\xec\x8c\xa8\xfd\xb8\x98\x3d\x08\xd1\xad\xbe\x21\xa5\x88\xf8\xfd\xbf" +
+		"\xe2\x27\x31\xaa\x92\xe8\x50\x44\x0c\xf8\xb7\xd8\x7d\x9d\x58\x6a\x39\xab\x2d\x86\x9a\x45\xc6\xa3\xdf\x89\xc6\x3f\x8a\x99\xdf\x8a" +
+		"\x3e\x75\x11\x1d\x1e\x17\xd3\xeb\xe1\x3d\xf2\x13\xbf\x23\x16\x56\x10\x1b\x9e\x48\x3a\x16\xf5\x8a\x8b\x13\x81\xa8\x71\xb7\xe7\xd1" +
+		"\x46\x45\x85\x4d\xbf\x28\x2e\xfe\x2a\x2a\x46\xfe\x43\xac\xbe\x5c\x1c\xac\x61\xe0\x5b\x52\xe4\x61\xb1\xbb\x85\xd9\x5a\xc3\x4d\x35" +
+		"\x71\xde\x80\x3c\xf0\xb8\xcb\x6c\x72\xa8\x13\xa2\xfe\xe3\xe2\xd0\x87\xe2\xb8\xf9\x85\xbf\xdc\x28\x46\xbe\x20\xda\x5f\x2e\xce\xfe" +
+		"\xc3\x6b\xc2\x49\xcd\x11\x75\x3f\x13\xf5\xef\x17\xbf\x4d\x42\x8c\x68\x62\xf4\xb2\x89\x27\x37\x44\x9c\xaf\x2e\xe6\x54\x10\x03\x6e" +
+		"\x14\x53\x06\x20\x56\x76\x41\xfc\x79\x87\x18\x7c\x93\x68\xfd\x80\x88\x88\x29\x86\xc3\x72\xa2\xb3\x51\x65\x50\x29\x71\x68\x0d\x62" +
+		"\xdb\x21\x44\xff\xb6\x88\xef\xc4\xf4\x5e\x36\xec\x03\xe2\xf4\x1d\xa2\x71\x05\x51\xef\x46\x31\xe8\x32\x71\x64\x06\x62\xf3\x73\xa2" +
+		"\xfd\xb5\xa2\xfd\x5b\xa2\xc3\x4f\x62\x5f\x4b\x4b\x01\xf6\x33\x5c\x0d\x41\x9c\x7b\x47\xb4\x58\x88\x38\x79\x0e\x71\xec\x5e\x31\xf0" +
+		"\x5a\xb1\xef\xbf\xe2\x44\x11\x31\xef\x47\x71\xe4\x23\xb1\xb0\x15\xa2\xf7\xff\xc4\x5a\x5b\x47\xb3\x2c\xb1\xbf\xb7\xa1\xbd\x9d\x11" +
+		"\xb8\x90\x58\xfc\xa1\xe8\x17\x11\x8d\xaa\x8b\xaf\x44\xbf\x17\xc4\x19\xc4\x94\xb7\xc4\xcf\x43\x11\xa7\xf2\xf9\x98\xa7\xd6\x1c\xc4" +
+		"\xee\xf7\xc4\x48\x83\xf9\x6c\x61\x51\x3f\x22\x36\x15\x10\x6b\xaf\x15\x35\xdf\x17\xfb\x46\x22\x36\xc6\x44\xbb\xb9\x86\x27\x5b\xfd" +
+		"\x24\xcb\x8c\x4d\x36\x60\xf7\x20\x26\x46\xc4\x9a\xca\xa2\xe1\xed\xe2\xd7\x4b\xc5\x6f\x09\x51\xe3\x7e\xb1\x38\x22\x7e\xbd\x4c\x34" +
+		"\xba\x4b\xcc\xfe\x42\xf4\xbe\x4b\x8c\x9b\x6f\x60\x6f\x45\xf4\x3a\x89\x18\x57\x54\xd4\xbf\x4f\xec\xfb\x42\x6c\xac\x2a\xc6\x5c\x24" +
+		"\x7a\x54\x15\xbd\xed\xb6\x45\x0d\x02\x51\xb7\x99\x01\x3b\xda\xd0\x61\x9a\xb9\x6d\x01\xd1\xea\x16\xb1\x6a\xa6\xa1\xeb\xeb\x64\xf6" +
+		"\x69\xcf\x6c\xc4\xc1\xdb\xc5\x56\x0b\x6a\xaa\x88\xc6\x1f\x8a\x41\x06\xed\x08\xc4\xe8\x47\xc5\xb8\xe1\x88\xbd\x59\x22\x21\x86\xfd" +
+		"\x85\x58\xd5\x09\xb1\xec\x15\xd1\x1c\x31\xe3\x51\xb1\xbd\x80\x98\x72\x87\x98\x72\x9b\x68\xbf\x14\x1f\x4d\x38\x9f\x63\xdd\x07\x3e" +
+		"\xeb\x7b\xb0\xa0\xd8\xf5\xa4\xa8\x93\x2b\x56\xdf\x26\xfa\x3c\x28\x7a\x54\x14\x3f\x4f\x43\xd4\xb9\x44\xfc\x65\xb0\xce\xcd\x11\x23" +
+		"\x6e\x11\x6b\xda\x20\xfa\xc5\xc4\xe8\xce\x88\x26\x2f\x8b\xe9\x53\x10\xbb\xe2\xa2\xd3\xbf\xc5\xe2\x17\xc4\x5a\x03\xaa\xa9\x19\xd9" +
+		"\x5f\xbe\x13\x47\xbe\x10\xfb\x6f\x10\xe7\x2c\x85\x68\xb9\x97\x39\x47\x11\x3b\x72\xc5\xf0\x72\x62\xc9\x2c\xc4\x9f\x8f\x89\x1e\x2f" +
+		"\x8b\xf9\x3f\x88\x9d\x05\xc4\xd1\xfb\x44\xdb\x2b\x44\xff\x19\x36\xb1\x91\xe7\x77\x23\xe7\x1f\xd7\x89\xf9\xd5\x45\x9d\x35\x88\xf6" +
+		"\xe3\x2c\x7e\x7f\x48\xec\xfa\x5c\x14\x17\xe3\x12\xe2\xb0\x41\xdc\xeb\x30\x62\x5f\x6b\xc4\xc8\x79\x88\x0d\x51\x31\xe8\x34\x62\xf9" +
+		"\xcd\x62\xb3\xad\x7b\xd8\x6a\x44\xa3\x97\x45\xa7\x7a\x76\xe7\xbf\x84\x11\xbb\x0f\x62\xe3\x0f\x62\x79\x05\xb1\xee\x15\xd1\xe1\x15" +
+		"\xf1\x5b\x21\xd1\xba\xa2\x18\xda\x12\xb1\xc4\xdc\x96\x1d\x0d\x42\xcf\x77\x8f\x49\xe3\xb2\x63\xf8\xc0\xd6\x1c\x80\x81\x5f\x88\x22" +
+		"\x62\xdf\x18\xc4\x9c\x72\x62\xf9\x7b\xa2\x89\xdd\xda\x7c\x05\xa2\x56\x35\x31\x2f\xbf\xd8\x6f\x4e\xf6\x09\x03\xec\x15\xd1\xba\x1b" +
+		"\xe2\xbc\xdd\xdb\x14\xb1\xb2\x2f\x62\xf3\x77\xe2\xf7\x27\x44\xf3\xff\x08\x5b\x62\xe3\x12\x62\xcb\x8d\x62\xd1\x41\x1b\xc4\xd6\x67" +
+		"\xf6\xd3\xa2\xc3\x5d\x05\x45\x1d\x4b\x42\x57\x15\x0d\xbf\x13\xcb\xab\x8b\x1d\x7b\x0d\x63\xa6\x4f\x0e\x56\x10\xbd\xba\xd8\xf0\xcd" +
+		"\x10\xab\x86\x20\xc6\x7f\xe8\x95\xda\xcc\x6c\xd1\x2d\x2a\xea\x3f\x21\x66\x18\x80\xfd\xdf\x10\x83\x36\x23\x3a\xed\x42\xcc\xbe\x59" +
+		"\x0c\x32\xc0\x1a\x8d\x44\xfc\x52\x5d\xb4\x37\x5a\xd6\xc8\x16\xd3\x2e\x15\x5b\x2a\x89\x91\x83\x11\x2d\x6f\x15\xcd\x6c\xf0\xf5\x57" +
+		"\x88\xae\x6d\x02\x9f\x02\xdd\x1d\x15\x5d\x62\xa2\xd7\x55\x3e\xe7\xd2\xa5\x84\x68\xfe\x86\x38\x74\xb7\x98\x78\xb7\x4f\x97\xf6\x2e" +
+		"\x2e\xfe\x2c\x2c\xce\xd9\x7a\x6a\x47\x45\xbf\xb7\x45\x8d\xfc\x62\xf9\xa7\xa2\xe1\x47\xce\x0d\xdf\xfd\xa4\xd8\x51\xdf\x46\xbf\x5b" +
+		"\xb4\xff\x5a\xac\xbd\x4d\x9c\xe8\x88\x58\x76\x1e\x31\xcf\x16\xb4\xfa\x63\xd1\x24\x2a\xa6\x55\x15\x75\xfe\x27\x1a\xff\x20\x26\xd6" +
+		"\xb6\x35\x17\xf2\xf2\xde\xe8\x43\xb1\xec\x2b\xb1\xd9\x44\xf6\x72\x31\x6e\x3a\xa2\xeb\xb5\x62\xb5\x3d\x3e\x27\x9f\xe8\x74\xa9\x98" +
+		"\x79\x99\x67\xef\x4e\x36\xfa\x8a\x0f\xc4\xf1\xa2\xa2\xef\x25\xa2\x69\x54\x9c\xac\xec\x4a\x4b\x8c\x2d\x23\x96\x5e\x2f\x6a\x9b\x4c" +
+		"\xb5\x43\x34\xbb\x44\xf4\xaa\x2c\xe6\x2e\x44\xf4\x1a\x60\xe0\x4c\x41\xb4\x8e\x8b\x69\xd7\x8a\xd1\x83\x10\x07\x6f\x16\xc3\x8c\xaa" +
+		"\xd3\x5a\x20\x3a\x0f\x43\xd4\xb9\x47\xac\x2b\x24\x6a\x55\x11\xe3\xdf\x12\x1b\x7b\xd8\x95\xf2\xe2\xf8\x55\xe2\x50\x15\xd1\x32\x22" +
+		"\xce\xde\x27\x6a\x9b\x2b\x98\x23\x1a\xf5\xc4\x47\x53\x8b\xbe\x11\x3d\x2a\x88\xc5\x86\xfc\x1d\x16\x86\xd4\x1c\x64\xde\xbc\xb9\xf4" +
+		"\xd3\xb7\x20\xc6\xdd\x22\x26\xbf\x26\x66\x55\x16\xe7\xb3\xc5\xa0\x1b\xc5\x89\xeb\x85\x05\xb3\x83\x72\x45\xad\x0d\x88\x9e\xa7\x10" +
+		"\x4d\xae\x15\xb5\x2d\x1b\x3c\x07\x31\xa4\xa4\x18\x79\x87\x38\x69\xc4\x6f\x37\x1e\xd1\xfe\x5f\x62\x6b\x7b\x83\xff\x12\xf1\x87\x2d" +
+		"\x6d\xc1\xad\x62\x59\x31\xd1\xe2\x5d\x31\xc7\xdc\x4f\x73\x64\x7f\xff\xd6\xc7\xa3\x7f\xd8\x12\xda\xd9\xfd\x7b\xbb\x23\x76\x7d\x2d" +
+		"\xea\xdf\x21\xc6\x67\x89\x69\xc5\xc4\x96\xab\xc4\xd2\xcb\xc5\xb6\xa7\x44\x83\x79\x88\x7d\x86\x91\xbe\xa5\x45\xfb\x2b\xc5\xca\x7b" +
+		"\xc5\xc6\x9f\xc4\x94\x5b\xc5\x80\x19\x88\x43\x57\x89\x46\x1b\x0d\xd2\x5a\x88\xe3\x39\xa2\xed\xc7\xa2\x76\x11\xf1\x6b\xaf\xc0\xe3" +
+		"\x77\x43\x55\xb1\xf3\x26\xb1\xa0\xb0\xe8\x3c\x1c\xb1\xb5\xb4\xd8\x5c\x49\xb4\xfa\x5c\x74\x2f\x24\x7a\x56\xf1\x6a\x6d\x2d\x62\xf1" +
+		"\x1b\x62\x46\x96\xe8\xf1\x9c\x18\x69\xae\xe6\x08\xc4\xf8\xc6\xb6\xba\x4b\x45\xbd\x0b\xc4\x92\xab\xc5\x8e\xa2\x62\xff\x4d\xe2\x8c" +
+		"\xad\x76\xf8\x35\x62\x49\x53\x44\xa7\xab\xc4\x87\xa2\xe1\x64\x44\x8b\xa7\xc4\xa8\x7f\x8a\x0e\xc5\xc5\xb0\x88\xe8\xd4\x0e\xb1\xbf" +
+		"\x95\x0d\x16\x13\xd3\xe3\xe2\xa4\x71\x1c\xa2\x4f\x87\x64\xbe\xd1\xcc\xd7\x9a\xaa\xa2\x57\x7f\xc4\x18\x93\xca\xa5\xf5\x11\xa3\x7e" +
+		"\x12\xe7\x8b\x8a\x55\xd3\x11\x93\x0a\x88\xdf\x6f\x10\x7d\x4a\x88\xc5\x06\x6f\xdf\x98\xa8\x77\xab\x68\x5a\x45\xd4\xbb\x50\x4c\xf8" +
+		"\x5c\x34\x79\x52\xfc\xb1\xdc\x16\x7a\x8f\x68\x76\xbb\x18\x90\x10\xf3\x9d\xa7\xf0\x8a\xd8\xfc\x94\x38\x53\x42\xb4\x5d\x86\x98\x65" +
+		"\xd4\x6e\x95\x4f\xf4\x1c\x82\xe8\xfe\xa6\x18\x7a\x14\x31\xb6\x2b\x62\xc6\x67\x62\x93\xad\xe5\x94\x81\xdd\xb2\xa0\x58\xf6\xa2\x18" +
+		"\xbe\x04\x31\xa4\x26\x62\x6a\x6d\xc4\x89\x17\xc5\x8c\x99\x88\x8e\x6b\xf1\xf8\xa9\xfd\x84\x58\x65\x7f\x1c\x7e\x53\xcc\xff\x56\xac" +
+		"\xaa\x89\x8f\xdc\xc7\xde\x24\xa6\xdd\x2f\xa6\x7c\x2b\x66\x5a\xa6\xa4\x99\x85\x96\x73\x6e\x10\x3f\x4f\x42\x6c\xfa\x5c\x2c\xda\x87" +
+		"\x98\xbb\xdd\x60\xaf\x2c\xe6\x55\x17\xe7\xaa\x89\x46\x56\xcd\x2b\x2f\x6a\x5e\x23\x3a\x5a\xe8\x3a\xcf\x70\xd1\x79\xac\x21\xee\xfb" +
+		"\x64\x40\xd4\x7c\x27\x62\xe4\x7e\x83\xcb\xb8\xad\x76\x31\x71\xe0\x27\xb1\xb6\xb8\x18\x70\x95\xf8\xcb\x86\xab\x83\xd8\xdb\x02\x51" +
+		"\xd3\xc8\xd5\xc1\xbe\xcd\xbe\xd4\xc7\xaf\x8d\x5f\x11\xbf\x9b\x06\xed\xfc\x5f\xb1\x6d\x28\x62\x4e\x27\xc4\x49\x44\xb3\xcf\x45\xed" +
+		"\x33\x86\xfc\x6b\xc5\x82\xc5\x88\xb6\xa3\x8c\x98\xe6\xb4\x99\xda\x7c\x53\xd4\xbf\x59\x9c\xe8\x82\x98\xff\x84\xa8\x6f\x38\x9b\x63" +
+		"\x1a\x6e\xb7\xc1\x74\xe8\x41\x31\xe8\x66\xd1\xf5\x1e\xb1\x6a\x21\x62\xfb\x4b\xbe\xfa\xde\xd4\xc0\x5e\xf4\xbd\xa8\x7b\xb9\x58\x63" +
+		"\x4e\xdd\x7d\x62\xfa\x24\x7c\x62\x66\x83\x65\x44\xd6\x21\x86\x2d\x45\x34\xd9\x82\x98\x6c\xf6\xb0\x85\x79\x9c\x87\x6d\xf4\x59\x2f" +
+		"\x89\xa9\x97\x8b\xac\x64\x42\xe1\x44\x59\x31\xd3\xac\xf4\x4d\xbe\x7a\x36\xbb\x88\xf8\xa5\x9a\x18\xe1\x0c\x50\x29\xb1\xa5\x9c\x38" +
+		"\xf1\xb8\xd8\xf2\xa9\xa8\x5f\x4c\x6c\x7c\x4e\x34\x7a\x50\xd4\x7f\x54\x74\xb2\x25\x35\x37\xe7\x62\x00\x62\xa0\x29\x8f\x87\x44\x83" +
+		"\x4a\x62\x7c\x39\x31\x21\x57\xcc\xbe\xc4\x13\x64\x69\x51\x71\xf8\x3d\x71\xe0\x07\xb1\xec\x01\x71\xb4\x88\x98\xf9\x9a\x58\x35\x06" +
+		"\xb1\xe2\x33\xd1\xaf\x2d\x62\x87\x41\x38\x75\xb0\x2d\xa6\xb0\xa8\x55\x59\xfc\xf6\xa4\xa8\x28\x16\x9b\x9f\x57\x3f\x21\x76\xe5\x8a" +
+		"\x31\x97\x8b\x3f\x2d\x01\xdd\xdd\x0a\x58\xdb\x0f\x22\xce\x56\x16\xfb\x2e\x17\x35\x0c\xea\xb9\x8f\x88\x8e\x6f\x79\x94\x0d\xf9\x56" +
+		"\x8c\x2a\x21\x5a\xdd\x26\xce\x7c\x25\x86\x5c\x21\x46\x9a\xe9\xba\x41\x4c\xbe\x46\x1c\xbe\x41\xcc\x6b\x8e\xe8\x7d\xaf\x18\x5d\x55" +
+		"\x0c\x7c\x48\x9c\xed\x83\xa8\x71\x83\x58\x65\x4f\x18\xb3\xf7\x9d\x82\xd8\x34\x1b\xb1\xea\x26\xd1\x6d\x3c\xe2\x77\x4b\xbb\x9e\x36" +
+		"\xa2\x19\xe4\x3d\xed\x6e\x4b\xb1\xf5\x46\xf4\x2d\x21\xe6\xb7\x43\xd4\x2d\x2a\x96\x5e\xe5\x1d\x80\x8d\x7b\x10\xdb\x7e\x46\x9c\x7b" +
+		"\x42\x2c\x7e\x4f\x0c\xb9\x4a\x0c\x49\x88\x19\x11\xd1\x65\x9f\xf7\x8c\xdb\x9a\x7b\x38\xed\x37\x44\xa7\x40\xac\xf9\xa7\xd8\x71\x83" +
+		"\xe8\xf5\x9d\x18\x60\x4b\xdb\x7d\x95\x68\x6a\xb5\xec\xcf\xc5\xaa\x0f\x45\xb3\x92\xde\x98\xfd\xf2\x98\x18\x53\x30\x59\x04\xb2\x24" +
+		"\xe7\x98\x6c\xb1\xd6\xea\xc1\x5f\x89\x1e\xe6\xd1\x0d\x31\x5a\xe7\x13\xcd\xae\x16\x43\xef\x10\x03\x2d\xeb\x6c\xc8\xb7\xc5\x9f\x88" +
+		"\x89\x69\x17\x8a\x71\xae\xbe\xfd\xb6\x68\xfb\x8c\x68\xfe\xa3\xd8\xf3\x84\xe8\x70\x87\x38\x7d\xbd\x18\x76\x9d\x98\x5e\xc5\xab\x95" +
+		"\x03\x43\xf0\xb1\xe0\xd8\xc2\xa2\xb7\x21\xfe\x29\xb1\xe9\x7e\x31\xb5\x88\xe8\xb2\x12\xb1\x61\x2a\xa2\x57\x3d\x44\x83\x57\xc4\xde" +
+		"\xcb\x4c\xf2\x03\xb1\x19\xb1\x71\x23\x0a\x76\x76\x43\x0c\xaf\x97\x94\xe0\x63\xaf\x8b\x9d\xe5\xc5\xd9\xa7\xc5\xaa\x75\x88\x31\x5b" +
+		"\x11\xdb\x0b\x8b\x1a\x5f\x8a\xba\x83\x11\x9b\x3b\x18\x42\xe6\x21\xf6\xe4\xf3\xb1\xd6\xfa\xdd\x88\x9a\x57\x89\xd5\xef\x8b\xae\x57" +
+		"\x8b\x96\xc5\xc4\xe8\x2a\x62\x7f\x33\x5b\x46\x45\xd1\xec\x21\x71\xf8\x31\xf1\xcb\xed\x62\xc4\x25\x62\xef\xb5\x3e\x24\xe9\x5b\x52" +
+		"\x34\xa9\x2a\x0e\x20\xce\x16\x15\x53\x2e\x12\x4d\x5f\x17\x03\x6e\x17\xa3\x02\xd1\xc3\x58\xfa\xa4\x55\x0c\xd6\x22\x86\x3a\x05\xfc" +
+		"\x88\xf8\xf9\x1b\xb1\xa3\x62\x32\xe8\x6f\xde\x19\xb1\xa5\xa4\x98\x56\x1f\xd1\xcc\x56\xb2\xf3\x3c\xbe\x35\x60\xeb\x37\x62\x40\x2d" +
+		"\xc4\x29\x43\xc5\xec\x1e\x76\xed\x3d\x31\xc5\xd8\xfb\x78\x77\x53\x45\x97\x8b\xfe\x1d\x10\x5d\xbb\x22\x16\x1c\x45\x2c\xb3\x4c\xd5" +
+		"\xb1\x2f\xc5\xb2\x7b\xc5\x9c\x32\xe2\xec\xe3\x62\x4a\x41\x71\x62\x1c\xa2\x5d\x65\xd1\xbe\x2e\x62\xeb\xcb\x62\x76\x43\x44\xbf\xc2" +
+		"\xa2\xcd\x87\xa2\xe5\x53\xe2\x70\x54\x34\xce\x15\xe3\x4b\x8a\x5f\x37\x24\x93\x96\x53\xcd\x7c\xf7\x28\x2b\x76\x7c\x25\x1a\x1a\x65" +
+		"\xbb\x96\x12\xe3\x0c\x46\xeb\xd6\xe9\x6e\x8b\xb2\x3c\x73\x59\x71\xe6\x1a\x51\xb3\x0e\x62\xde\x15\x62\xd1\x85\xe2\xaf\x0a\xe2\x54" +
+		"\x71\xb1\xae\xaa\xa8\x35\x1f\x9f\x1b\x1e\x9c\x4f\x9c\x7f\x42\xec\xfb\x4c\x2c\xbd\x45\x74\x2f\x21\x5a\x5f\xe0\xbf\x4f\x2e\x23\xe6" +
+		"\x54\x12\x47\x7e\x12\x5b\xee\x15\xbb\x1f\x16\xf5\x63\xa2\xd5\x27\xa2\xdd\x5d\xa2\xdf\x7a\x44\xad\x97\xc5\x99\x85\x36\x9a\x0d\x39" +
+		"\xe3\x03\x71\x7e\x36\x62\xb2\x25\x1e\xbe\x10\x87\x5f\x13\x6d\xb6\x18\x6a\x3f\x13\x43\x9e\x12\x35\x4c\x38\x9e\x12\xcb\xee\x17\x8d" +
+		"\x22\xe2\x48\x65\xb1\xd3\x32\xa5\x16\x31\x45\xc5\xe9\x8b\x7d\xcf\x42\x8d\x72\xe2\x40\x96\xe8\x97\xe5\x93\x7e\x9b\x5c\x5a\xd0\x16" +
+		"\x59\x42\xb4\xbb\x47\xcc\x29\x2b\x96\x7f\xe8\x65\xf6\xe8\xb7\x9e\xce\x13\xf3\x89\x26\xdf\x8b\x29\x6f\x8a\x06\xc5\xc5\xa6\xda\x46" +
+		"\x84\xb7\xc5\xac\x11\x88\xe9\xbd\x11\xc7\x66\x1a\x2a\x0b\x8b\xc1\x97\x89\xf1\xc5\xc4\x5c\x43\xe9\xf6\xaf\xc5\xdc\x57\x45\x37\x43" +
+		"\xd8\x6f\x31\xd1\xfc\x05\xd1\xeb\x0e\xb1\xd2\x6c\xb4\x35\x38\xb5\x45\xf4\xfe\x42\xcc\xaa\x22\x8e\x15\x10\x5b\x4b\x88\xf1\x1f\x89" +
+		"\x06\x46\x9a\x25\x7b\x93\x35\xba\x9a\x46\xce\x39\xb5\x10\xbf\xfe\x28\xce\x2f\x47\xbc\x2b\x66\x57\x13\x6b\xeb\xe1\x0b\xd0\x7f\xbc" +
+		"\x2c\x46\x0f\x43\xb4\xbf\x4e\x9c\x30\xcf\xb7\x29\x62\x41\x23\xc4\xec\xd6\x49\x1f\x6b\xd5\x24\xc4\x7c\xe3\xfa\x21\x88\x03\xf5\x11" +
+		"\x3d\x7a\x22\xac\x1b\x6b\x50\x3f\x5b\x80\x31\x7b\x11\x31\xfd\x59\x31\xe9\x31\xb1\xec\x19\x31\x70\x15\x62\xe1\xb5\x62\x85\xe1\x7b" +
+		"\xe8\x50\x44\xe3\x79\x88\xe1\x97\x89\x6e\xf6\x57\xff\xa8\x18\xd5\xce\x7e\xbe\x49\xb4\x6a\x82\xe8\xf2\xaa\xd8\x3d\x19\xb1\xce\x2a" +
+		"\xd8\x2f\x88\x76\x76\x5f\x4d\x97\x27\x37\xc5\x6f\xf7\x8c\xb8\x56\x0c\x7a\x5c\x9c\xed\x6f\xd8\xb3\x44\xe7\x02\xc4\x4e\x93\xdc\x1a" +
+		"\x2b\x10\xbf\x44\xc4\xfe\x64\x3a\x7c\xe2\x28\x83\xed\x6e\xb1\xa5\x9a\x58\x75\xb7\x38\x70\x81\x68\xf7\x43\xd2\x12\xec\xba\x4c\x6c" +
+		"\xbb\x54\xfc\x52\xc8\xfb\xcd\xc7\x4b\x8a\xc9\x97\x88\xfd\xd7\x89\x5a\x16\x94\xfc\x5b\xec\x2c\x2b\x4e\x15\x11\x23\x3e\x11\x03\x5f" +
+		"\x17\xf5\xab\x88\x69\x0f\x89\x73\x15\xc4\xb0\xb7\x45\x9d\x16\x88\x1a\xdf\x89\x83\x7f\x20\xb6\x3e\x2e\x0a\x58\x43\x0a\x3e\x21\xb3" +
+		"\xdb\x88\x3b\xf7\x76\x31\x27\xf0\x75\xfd\x59\x56\x94\xfb\x5e\xcc\x35\xec\xff\xfe\x82\xd8\xdc\xcc\x16\x68\xb7\x1d\xb9\x45\x2c\x42" +
+		"\xb4\xf8\x41\x8c\xe9\x81\x98\x6a\xae\xbc\x09\x8f\xf5\x59\x54\x13\x4b\x0d\x79\x9f\x89\xda\x96\x86\x3d\x73\x10\x51\xf7\x69\x31\x79" +
+		"\x24\xa2\xd9\x51\x23\xfa\x31\xc4\xe0\xe9\x81\x98\x7d\xa5\x88\x8a\x85\x0d\x03\xd1\xcd\xea\x2a\x75\x8c\xd6\x4d\x92\x91\xe1\xec\xa5" +
+		"\x86\xb8\x62\xa2\xff\x6d\xe2\x19\x5f\x55\xec\x6a\xb9\x19\x67\x68\xb3\xc4\x1f\x9d\x11\x93\x6c\xdc\x93\xed\xf1\x09\xe8\x05\xe5\xc4" +
+		"\xf6\x41\x88\xa6\x53\x93\xd4\x99\x71\x91\xa8\x73\xb7\xf8\xf9\x67\xa3\x64\x4c\x9c\xb3\xf8\x7e\x07\x62\xf5\x42\x5b\xdb\x27\x62\x65" +
+		"\x63\x9b\xb6\x92\x38\x33\xd0\x88\xdc\x0b\x71\xca\x56\xba\xc9\x91\xe9\x21\xd1\xff\x43\xef\x85\x6f\xb1\xec\x7a\x5d\xeb\x04\x19\x8c" +
+		"\xd8\x56\x42\x74\x37\x0c\x0e\xb4\xb9\x16\x7f\x23\x56\x3d\x2b\xa6\x7e\x23\xe6\xdd\x2b\x7a\x19\x14\x43\x2e\x12\x23\x9a\x1a\x6e\x3f" +
+		"\x17\xb5\x0a\x88\x26\x1f\x89\x7d\x97\xf9\xfc\xd4\x89\x12\x62\xe5\xb5\x62\xfb\x46\xc4\xf9\x1b\xc5\x96\x31\x88\x3f\x5f\x10\xc7\x4a" +
+		"\xf9\x8e\xa4\x3f\x86\x22\x46\x9a\x8b\xb7\xee\x4b\x51\xf3\x22\xb1\xe4\x79\xb1\x75\xb7\x41\xe9\x52\x77\xb5\x11\x3d\xed\xcf\x0e\xbf" +
+		"\x22\xfa\xfd\x4f\x9c\xea\x66\xb0\xcc\x47\x2c\x3f\x61\x17\x47\x20\x5a\x7f\x28\xa6\x95\x16\x03\x2d\x77\xbe\xe4\x41\xb1\x64\x27\xa2" +
+		"\x93\x25\x2a\x8c\x44\x73\x67\x23\x86\x0f\x40\xf4\x6e\x8d\x98\xfe\xa9\x98\xd5\xd2\x80\x7f\xd4\x07\x48\x3b\x9d\xf7\x89\x98\xe0\x0a" +
+		"\xfc\xe6\x06\x96\x10\xf5\x0d\x69\x83\x2d\x20\xfa\x79\x39\xde\x3b\xec\xf3\x9d\x58\x7c\x9b\xd8\x7d\x99\x58\x77\x87\xb7\xda\x87\x6b" +
+		"\x20\xda\xbc\x2c\x7a\x55\x12\xe7\x3f\x17\xbb\x6e\x10\xd3\xef\x10\xdb\x57\xe0\x3b\x31\x1a\xaf\xb7\xe1\xff\x25\xc6\x7e\x23\x76\x55" +
+		"\x15\x35\xdf\x12\x2b\xaf\x74\x0c\xda\xf7\x3e\x31\x7a\xb3\xd1\xca\x9c\xdd\xe5\x87\x0c\x3c\x23\x5d\xb3\x72\x62\x46\x1b\x83\xf1\x5f" +
+		"\xa2\xe9\x13\x62\xf8\x6b\x62\x48\x41\xf1\x5b\x1d\x44\xaf\xb8\x58\x18\xf3\xe9\x84\x83\x2f\x8a\x89\xf9\xc5\xc9\x47\xc5\xc8\x26\x88" +
+		"\xa3\xa3\x11\xdb\x1f\x12\xed\x0a\x89\x3d\xef\x88\xcd\xd9\x3e\x7f\x54\x77\x3d\xde\x41\xd9\x9f\x25\x36\xfe\x8e\x38\x5d\x27\x10\xbf" +
+		"\x7d\x23\xf6\x7f\x2c\x6a\x6c\xb2\x85\x94\x16\xe7\x2c\xf3\x5b\x5d\xac\xae\x2e\xb6\xd7\x42\x4c\x8b\x88\xd6\x3d\x11\xe7\x9e\x13\x3b" +
+		"\x9b\x21\x66\x14\x17\x35\xca\x8b\xc1\xaf\x89\xc3\xd9\x62\xe0\xa7\x62\xbe\x79\x6d\xa7\x6c\xd1\xbd\xcf\x22\x0e\x94\xf0\xee\xa5\xe5" +
+		"\x45\xd6\xe7\x17\xa7\x2d\x95\x5d\x4e\xac\x7d\x51\x34\x59\x8b\xd8\x60\xcb\x5e\x66\x9e\xe3\xfb\xa2\xef\xed\xa2\xe5\xb3\xde\x53\x6c" +
+		"\xfa\x96\xf8\x75\x41\x52\x0f\xd6\xae\x2e\x9a\x3e\x27\xa6\x7d\x28\x86\x3f\x24\xf6\x8e\x45\xfc\x56\x44\x1c\xfa\x5c\x9c\xaa\x20\x5a" +
+		"\x3e\xe7\xb3\xd1\x33\xee\x12\x33\x3f\x13\xc3\xae\x11\x3d\xaf\x12\x13\xfe\x2b\xd6\xbf\x2a\xce\x59\x12\x0c\xd1\x7d\x0e\x62\x5c\x2f" +
+		"\xc4\xba\xeb\xc4\x86\xe1\x88\x5e\xaf\x8a\xce\x57\x8a\x5f\xbf\x12\x63\x3b\xd9\x95\xcb\xc5\xac\x52\xa2\x95\x81\x56\xb7\x0b\xa2\xf6" +
+		"\xdb\xa2\xc7\xed\x62\xe4\x28\x44\xcf\x9a\x88\x7e\xc5\xc5\xb9\x57\xc4\x42\x63\xf2\x07\xc4\xcf\x53\x10\x27\x4c\x6e\xe6\xef\xb7\x05" +
+		"\x95\x15\x9d\xf6\xe3\xd9\x70\x65\x5b\xc3\xf8\x12\x1b\xac\x8c\x02\x5b\xdb\xc8\x6b\xc5\xcc\x3e\x88\xf3\x17\x8b\x4e\xc5\xc5\x81\x1b" +
+		"\xc4\x9e\xeb\x44\x8d\x47\x3c\x9d\xad\x9d\x69\xbf\x21\xbd\x6e\x71\xb1\xc3\x10\x79\xd8\xfe\xea\xf9\xa4\x58\xd0\x0a\xb1\xb8\xb8\x0f" +
+		"\x15\x16\x57\x17\x2b\xad\x7b\xef\x23\x31\xef\x3a\xb1\xe9\xbf\xa2\x95\xfd\xfe\x9c\xd8\xf8\x85\xc8\x11\x7f\x64\x8b\x95\x3f\x8a\xdf" +
+		"\x87\x22\xb6\x5d\x24\x1e\x15\xdb\x5a\x22\x76\x8c\x47\x1c\xec\x8b\x58\x70\x81\x18\x52\x54\x9c\x7a\xdf\xa7\x61\xea\x5c\x2a\x86\xff" +
+		"\x5b\x4c\xb6\x45\x1d\x72\xf5\xad\x45\x88\xed\x36\xf3\xee\x67\xc5\x81\xfc\x62\xef\x6b\x62\x4a\x7f\xcb\x6b\x3e\x2c\x66\x0e\xb2\x15" +
+		"\x4e\x33\xbc\x6c\x42\x6c\x6e\x8e\x98\xdd\x13\xd1\xed\x66\xb1\xf4\x04\x62\x65\xad\xc0\x9b\xdc\x55\x1f\x88\xae\x35\x11\x03\xae\x11" +
+		"\xcb\xff\xe9\x9d\xa4\x59\x4f\x8b\x45\xdd\x10\x6d\x4a\x88\x89\x47\x6d\x88\xc7\xc5\xcf\xf7\x88\x1e\x81\x18\xbc\x03\xb1\xc6\x98\xf4" +
+		"\x80\xf1\x96\xa5\x39\x17\x95\x13\xd3\xc7\x20\x3a\x5a\xa1\x38\x9f\x98\x5c\x44\xcc\x1a\x67\x33\x26\xc4\x64\xa3\xe2\xca\xfc\xa2\xdd" +
+		"\x06\x44\x67\x9b\xff\x2f\x44\xab\x72\xbe\x59\x74\x48\x4c\x74\x6b\x68\x54\xb2\x05\x6d\xfc\x15\xd1\xfa\x3f\xa2\x57\x54\x0c\xb5\x4c" +
+		"\xda\xa5\xa2\xd6\x68\xc4\x59\x7b\xe8\x8c\x0d\x5a\xf3\x69\x71\xa6\x9c\x58\xd6\xc5\xd6\x79\x1a\x31\x71\xae\x3d\xfa\x92\xe8\xf1\x94" +
+		"\x98\xf8\xa3\x68\x81\x18\xf9\x8e\xe8\x52\x4e\x74\x7a\x53\x18\x5e\x6b\x5b\x2e\xaf\xd7\x35\xa2\x56\x6f\xc4\xe4\xee\x46\x80\xc0\xa7" +
+		"\xa9\x5a\xfd\x43\xf4\x34\x18\xb6\x96\x12\x47\xca\x88\x5a\xd6\xa5\x7a\xa7\xaf\x30\x0c\x1e\x6b\x94\xf9\x4c\x8c\xb3\x51\x9b\x3e\x2e" +
+		"\xe6\xbe\x26\xba\x5c\x29\x26\x17\x13\x4b\x97\x22\xba\x5b\x09\x7d\xeb\x77\xa2\xc1\x3f\x45\x47\xcb\x6b\x57\x14\x6b\xb3\xc5\xd4\xf6" +
+		"\x06\x74\x53\x44\x17\xd7\x82\x62\x25\x24\xeb\x1c\x79\x42\xd4\xbc\x5f\xac\xb5\x52\x5d\x45\x31\x2a\x2a\x26\xdf\x21\x7e\xad\x20\xe6" +
+		"\xbd\x2f\x5a\xda\x63\x63\x4a\x8a\x49\xff\x16\xed\x2c\xe3\x6e\xb8\x19\x62\x45\xba\x88\x98\x5e\x4e\x44\xc5\x9f\x46\xef\xb3\xfb\x10" +
+		"\xed\xae\x10\x47\xde\x11\x7b\x6b\x20\x6a\x6c\x45\xd4\x2c\x2f\xf6\x2e\x0b\x5b\xb2\xbe\x14\x23\x6c\xb9\x27\xbe\x15\x87\xff\x2d\xa6" +
+		"\xdf\x29\xfa\x8c\x42\x0c\xae\x2c\x3a\x9b\x36\x6e\x65\xd4\x3b\xf7\xa8\x38\xff\xb8\x58\x3d\x14\x31\xc9\x4a\x0e\x2f\x8b\x4d\x8f\x8a" +
+		"\x36\x36\x48\xe3\x6b\xc5\x9e\xea\x62\xec\xcd\xa2\xa1\xc9\xd9\x35\xa2\x4b\x35\x91\x10\x2b\xde\xf0\xdd\xc1\x0d\x0d\x85\x1d\xbf\x10" +
+		"\x83\xcd\x2c\xbd\x28\x16\x94\x16\xcd\xad\xe1\xe3\x5b\xd1\xe4\x62\xf1\xfb\x1b\xa2\x49\x05\x31\xf7\x0b\xb1\xf8\x09\x31\xa7\x3f\x62" +
+		"\x44\x45\xd1\xe7\x32\xef\x06\xd6\x5c\x88\xd8\x59\x41\xec\xbd\x5c\xac\xb5\xb2\x85\xa5\x40\xa2\x62\xe4\x27\x3e\x12\x5f\x3b\x18\xf1" +
+		"\x8d\xa8\x79\x8b\x68\x92\x10\x4b\x6a\x24\xfb\x54\x6b\xbc\x2b\xd6\xb7\xb4\xa7\x37\x23\x86\x5e\x20\x36\xde\x28\x66\x3f\x27\x4e\xdd" +
+		"\x2c\xa6\x19\xc9\xcf\xbe\x2a\x6a\x5f\x28\x3a\x5b\xc9\xf5\x31\xb1\xf9\x5a\xa7\x03\xa8\xf1\x95\x30\xb1\x59\x8e\x68\xf5\x8c\x98\xf3" +
+		"\xa5\x98\x5c\x55\xf4\x7c\x4e\x2c\xab\x22\x76\x7d\x29\x5a\x16\x16\xed\x9f\x12\x7d\xae\x17\x4b\x6e\x10\x93\xaa\x8b\x63\xef\x88\xb6" +
+		"\x23\x11\x4d\x0c\xb9\xa7\xed\xdb\xac\x53\x88\x75\x46\xea\xbe\xff\x13\x3b\xbf\x14\x63\x6f\x11\x1d\xae\x14\x87\xa2\xa2\x4f\x35\x31" +
+		"\xa9\x98\xa8\xf9\xb9\xa8\x61\xcf\xac\x6c\x60\x98\xee\x85\xe8\x6c\x1a\x72\xbe\x8d\xf0\xae\x58\xf5\xb8\x58\x58\x58\xd4\xbe\x5e\x74" +
+		"\x79\x56\x8c\xa9\x8f\x18\xf3\xb2\xe8\xb2\x1f\xb1\xf1\x02\x51\x73\x36\xa2\xc5\x0a\x44\xbf\xab\x44\xf3\x6d\x88\x65\xa5\x3c\x7e\x37" +
+		"\xdd\xe3\x3b\x55\x6b\xdc\x2c\x7a\x7f\x2a\x9a\x5c\xe9\x9d\xa5\x3e\xcf\x8a\xee\x2f\x88\x46\x5f\x89\x0a\x62\xd3\x33\x62\x69\x3d\x9b" +
+		"\xd9\x9c\xda\x6f\x45\x0f\x8b\xf7\x0d\x94\x25\xef\x88\x43\xaf\x88\xb3\x56\xcf\x2e\x27\x56\xae\x45\xf4\xbc\x5a\xfc\x1a\x88\xa1\x16" +
+		"\x0c\x16\x11\x2d\x6f\x11\x63\x2a\x8b\x0d\x71\x51\xa3\x9a\xe8\x54\x44\x74\xfa\x51\xf4\xbd\x4d\xb4\xb6\xa5\x4d\xea\x83\x38\xfa\xbe" +
+		"\x58\xdc\x0c\xd1\xc3\x22\xcf\xfd\x2b\x11\xb5\x3e\x13\x1b\x1e\x16\x5b\x8a\x89\x4e\x6f\xf9\x9a\x70\x7d\x4b\xcd\xb6\xc5\x67\xfa\xa7" +
+		"\x5f\x2e\x7e\x59\x87\x18\x72\x02\xd1\xce\xc6\x3a\x13\x96\x52\xfa\x97\x17\x75\x66\x20\x7a\x37\x42\xcc\xbc\x4f\xd4\x2c\x2e\xba\x9a" +
+		"\x77\x9a\x2d\x0e\x7d\x25\x56\xbe\x2e\x9a\xdc\x2c\x56\x39\xdc\xbc\x26\x06\x97\x10\x03\x13\x62\xf0\x05\x62\xa6\xa1\xaf\x97\x65\x74" +
+		"\x6d\xb2\x15\x3f\x88\xd3\x88\x91\x3f\x88\x06\x5f\x8b\xdd\xd6\xd3\x5c\x48\x94\x15\x5d\x0d\xbd\xcd\xab\x88\xf5\x97\x89\x01\xb7\x8a" +
+		"\xe1\xb7\x89\xae\x77\x88\x3e\xf3\x10\xe3\xdf\x17\x07\x1f\x13\x73\x3e\x16\x7f\x3e\x21\xb6\x7d\x2d\xfa\x3d\x2c\xfe\x5c\x64\x17\x47" +
+		"\x23\xba\xdd\x2d\x56\x99\x0f\xd9\x1a\xd1\x70\x40\x20\xce\x19\xc0\x2b\x9f\x17\xed\xbe\x16\xa3\x9e\x17\x13\x72\xc4\x89\x6a\xa2\xad" +
+		"\xf5\x4d\x15\x13\xbd\x63\xa2\xf6\x8d\xa2\xcb\x62\xbb\xf1\x59\xdf\x32\xb0\xc2\x9e\x5f\xfa\x0f\xd1\xe2\x4d\xd1\xf3\x7f\xe2\x48\x69" +
+		"\x31\x23\x2a\xea\x5e\xe8\xeb\xcf\xa7\x10\x1d\x0a\xfa\xd4\xe7\x49\xb3\x5a\x66\xef\x5b\x5e\x9c\xec\xa3\xec\x79\xbf\xef\x39\xde\x6c" +
+		"\x25\x95\x47\x44\xdf\x3a\x88\x5f\xab\x88\xbe\x05\x45\xb3\x98\x38\xdc\x1f\xb1\xfb\x6a\x51\x42\xf4\xb7\xee\xc8\x86\x88\xda\x6f\x88" +
+		"\xcd\xdf\x88\xc5\x86\xe8\x71\xb5\x11\xbb\xac\x98\x36\x04\xd1\xa9\xbd\xa5\xe8\x86\x5b\x82\xe4\x07\x9f\xaa\x6e\x3d\xcd\x86\x2c\xee" +
+		"\xeb\x67\x2e\x9d\xb5\xf7\x22\x31\xe3\x6d\x51\xd7\x26\xdb\x35\xdd\x06\x7c\x5d\x9c\x1e\x80\x38\xd0\x1e\xaf\xd4\xe7\xfd\x43\x74\xac" +
+		"\x61\xc3\xf4\x43\xfc\x6c\xbc\xff\xa0\x38\xd2\xdc\x1e\x7f\x4f\x9c\xb8\x59\xb4\x7f\xc7\xf7\xad\x1d\x7e\x48\x1c\xb9\x47\xcc\x2a\x2f" +
+		"\x86\x55\x16\x5d\xaf\x10\x1d\x6a\x06\x62\xac\x65\xd5\x06\x7e\x2b\xda\xfd\x94\xac\x4e\x0d\xbc\x42\x9c\xf8\x52\xec\xfe\xa7\x58\xf6" +
+		"\xb4\x28\x2a\x86\xbe\x21\x5c\xd1\xee\x2b\xb1\xfe\x19\x71\xb8\xaa\x18\x3c\xd1\x50\x63\xb4\x9a\x61\xe9\x90\x72\xe2\xf7\xab\xc5\xae" +
+		"\x15\x88\x51\xb6\xe4\x46\x37\x88\x15\xff\x15\x76\xdb\x99\xdb\x44\x0f\x5b\xf9\x18\x03\xbd\xd1\x75\xa2\xe5\x95\x62\x63\x7d\xc4\x9a" +
+		"\xfb\x7c\xce\xad\xe3\x03\x62\x77\x35\x51\x37\x21\x96\x1b\x9b\x2f\x36\x2c\x34\x29\x27\x7a\x5a\xeb\x43\x3e\x5f\xad\xec\x6f\xd8\x68" +
+		"\xde\x0b\xd1\xec\x22\xb1\xf4\x5e\x61\x5c\xd1\x30\x9f\x18\x95\x4f\x6c\x2e\x25\x8e\x5f\x24\x96\x7f\x2b\xce\xbb\x41\xab\x8a\xbe\x37" +
+		"\x8a\x21\xc5\x44\xb3\xb3\x88\xf6\xae\x4d\xcc\x9a\xbe\xdf\x17\xad\x9d\xef\xfb\xa5\xd8\x5f\xd6\x6f\xae\x18\xb3\x18\x71\xac\x79\x20" +
+		"\x9a\x97\x13\x5d\xfb\x20\x16\x5e\xe1\x95\x64\xa7\xa6\x88\x99\xad\x11\xbf\x94\x10\xcb\x8b\xfb\xba\xc7\xda\xbb\xc5\xd2\x39\x88\xa9" +
+		"\xf6\xd0\x86\x7c\xa2\xde\x58\x93\xd0\x6f\xc4\x6a\x23\xc9\xef\x5b\x10\xdd\x8b\x8a\xf1\xa5\xc4\xb1\x27\xc4\x5c\xd7\x98\x73\x87\x18" +
+		"\x55\x58\x6c\xb7\xa0\xd7\x08\x7f\xd0\x96\x6a\x6b\xde\xf0\x5f\x71\xec\x17\x44\xbb\xb7\xc4\xdc\x7f\x8a\x7d\x46\xcb\x4e\x7b\x11\x8f" +
+		"\x8b\x9e\x6f\x88\xa6\x17\x89\x41\x06\x4b\xff\xfa\x81\x2f\xf2\x2e\xf9\x46\xf4\xbd\xd5\xd7\x77\xf6\xfd\x4f\xec\x98\x8d\xd8\x50\x5a" +
+		"\xd4\x7a\x44\xfc\x31\xc8\xd6\x70\x83\x98\x69\xdd\x9d\xfd\x8c\xed\xf6\x20\x7e\xbb\x51\xf4\x7e\x5f\xd4\xb1\x38\xd2\x60\x3c\x7c\xb1" +
+		"\x68\x7d\xbd\xf8\xf5\x7b\x71\xb8\x55\xe0\xdb\x49\xf6\xc4\xc5\x84\x93\x61\xdf\xe9\xcf\x0f\x8a\x5e\x0f\xf9\xc6\xe4\xfa\x57\x79\xa3" +
+		"\xdf\xe0\x66\xb1\xe7\x6d\x31\x71\x30\x62\x64\x3b\x44\xcb\x17\x45\x07\x73\xdd\x3f\x12\xbf\x7f\x29\x9a\x7d\x21\x4e\x55\x13\xc7\x5b" +
+		"\x04\xbe\xaa\xfb\x4b\x54\x6c\x1e\x81\xe8\x3c\x18\xdf\x0f\xd9\xcd\x2a\xdb\xbb\x11\x43\x5f\x13\xfb\x9f\x13\xb5\x8c\x2e\x5b\x3a\x20" +
+		"\x4e\x5c\xe3\x82\x51\x4b\x2b\xf4\x9c\x6a\x53\xfc\x8c\x38\x9a\x5f\x4c\x78\x58\x2c\x33\x0c\x1f\x68\x89\x58\x69\xdf\x76\x97\x10\x9f" +
+		"\x8b\x99\x55\xc5\x3c\x5b\xd2\xc2\x06\x88\x26\x9f\x8a\xe3\xb7\x8b\x0d\x0f\x89\xc6\x55\xc4\x84\x40\x1c\xb1\x9d\x50\x17\x89\x1a\x86" +
+		"\xa8\x41\x97\x88\x26\x97\x8b\x51\x8b\x0c\x80\x4b\xc5\x92\xb2\x5e\x25\x74\x2d\x2f\xb6\x07\xa2\x8e\xcb\xcd\x8f\x45\x6c\x7d\x4f\xb4" +
+		"\x1b\x60\xc8\x6a\x63\x34\x6b\x88\xdf\xe3\xb3\x79\x0a\x62\xfa\xdb\x62\xff\xfd\xa2\xde\x59\x44\xf3\x4f\xc5\xe0\x3b\xc5\x1f\x57\x88" +
+		"\xfa\x1d\x11\x6b\x2f\x4f\x0a\xc6\xc2\x7b\x45\xed\xe9\x88\x39\x16\x35\xaf\xdc\x86\x58\x3a\x1c\xb1\xf3\x02\xd1\xf7\x26\x71\xfa\xa8" +
+		"\x51\xf6\x21\x31\xf1\x42\xd1\xe9\x55\xb1\xff\x59\xd1\xe6\x6b\xb1\xa1\x31\x62\x95\xad\xd5\x62\xcf\x7b\x44\xed\x9d\x86\x9e\x92\xa2" +
+		"\x55\x31\x31\xa5\x98\x38\x74\xab\x68\xf4\xa5\x58\xf8\xbe\x68\x62\xf9\xdb\x72\x62\xf4\x3b\x62\xc4\x83\x62\x77\x7f\x44\x97\x1f\xc5" +
+		"\x9f\xff\x12\x2b\x2a\x8b\xdd\x2b\x11\x0d\x7e\x10\xf3\x1f\x14\x75\x4a\x8b\x3f\xdb\x23\x16\x1b\x8a\x77\x19\xc1\x3b\x1f\x32\xc8\x6e" +
+		"\x15\x7d\x22\xa2\xcf\x7f\x45\x54\xfc\x52\x58\xd8\x9a\x0f\x1f\x30\x5c\xdc\x25\x7e\xdb\x87\xc8\x15\x6b\x8c\xad\x06\x94\x12\x7d\x5c" +
+		"\x9f\xfb\x3d\xa2\xde\xd3\xc9\xe6\xd4\x7a\x43\x10\x2b\x3e\x12\x83\xfb\xd8\x2a\x8d\x65\xf7\x59\xea\xee\x08\x62\xcd\x47\xbe\x76\xd6" +
+		"\xc1\xd2\xd2\x6e\xef\xd5\xc2\xfc\x9e\x04\x2b\x7b\x21\x36\x1a\x4d\xcf\xdc\x29\x96\x19\xca\x3b\xe5\x13\x6d\x06\x23\xc6\x99\xab\x95" +
+		"\x2d\xce\xb7\x45\x74\x2d\x28\x8e\x3e\x2e\x16\x5c\x25\xfa\x3e\x21\x7a\xdd\x27\x0e\xbe\x2b\xa6\x3c\x2e\xea\xba\x4a\x9c\x39\x81\xdf" +
+		"\x89\x2e\xad\xf0\xbc\x7d\xbe\x5e\xe0\x6f\x6f\xf3\xa0\x38\xfe\xbc\x58\x64\xd4\xd8\x7e\x87\x68\x69\x74\x1e\xf0\xb0\xa8\x65\xb9\xcd" +
+		"\xe6\x2d\x11\x67\x1f\xf6\x52\x6e\xe1\xe7\x76\x57\xa6\xaa\xe0\x7f\x5c\x6a\xab\x69\x6f\x48\xdd\xf7\xb5\x98\xd5\x19\x31\xc4\x1e\x38" +
+		"\xf1\x8a\x68\x56\x56\xd4\xa9\x8b\xe8\x6e\x6d\x91\xdd\x11\xd3\x3e\x16\x1b\xaa\x89\xa9\x17\x8b\x33\x4b\x11\xfd\x5e\x14\x7d\x1e\x10" +
+		"\x2d\xe7\x23\xd6\x99\x7d\x1b\x93\xec\xae\xe8\x53\x4a\xd4\xbc\x42\x74\x7f\x55\xcc\x7c\x54\x2c\x35\x24\x9c\xbd\x4d\x0c\xfd\x49\x0c" +
+		"\x33\x4d\x3f\xa4\x80\x18\xec\x22\x94\x15\xf8\x15\xd8\x5e\x9d\x53\x8f\x8a\xe1\x1f\x8a\x0d\x3d\x10\x83\x9f\x11\xdb\x7e\x12\x87\xae" +
+		"\x11\xa7\x4b\x88\x5f\xee\x15\xbf\xcc\xb2\x79\x2d\x85\xf0\x73\x20\x46\xfe\x81\x58\x6b\xb5\x91\xb3\x06\xdf\x7a\xc4\xd8\x97\x45\x4f" +
+		"\x43\x70\x93\x79\x88\xc9\xd7\x8a\xae\x97\x89\x85\xd5\xc5\xce\xb8\xd8\x53\x5c\x2c\x35\xb3\x60\x4d\x0b\x6b\xf0\x86\x7c\x44\x15\xd1" +
+		"\xc6\xa8\x7c\xba\x37\x62\xe0\xaf\x88\x65\xff\xf3\x9d\x11\x67\x06\x84\x95\x9b\xc3\xb7\x8a\x23\xf7\x8a\x4e\x0d\x93\x78\xde\x59\x45" +
+		"\x8c\xb8\x46\x34\xec\x82\x58\xbf\x0b\xd1\xd0\x90\xf4\xe7\xd5\xe2\xec\xf5\xe2\xe4\xfd\xa1\xfb\x74\xb1\xa8\xf5\x6f\xf1\xa1\x18\x87" +
+		"\xf8\x97\xe8\x3a\x0a\x71\xa4\x36\xe2\x4d\x71\xda\x8a\xd4\x85\xc5\x27\x49\xdf\x71\xe8\xb3\xe2\xc4\xf0\x64\xb6\xf0\xe0\x01\x5b\x51" +
+		"\x49\x31\xd4\x94\x6a\x5b\x9b\x69\xe1\x43\x62\xf9\x31\xc4\xee\x23\x88\x71\xf9\xc5\xb1\x0d\x88\xa9\x4d\x02\x51\x6b\x0a\xe2\xfc\x3b" +
+		"\xe2\x64\x15\x71\xc2\x34\xf9\xd8\xf3\x88\xfe\xae\x81\xa0\x88\x82\x55\xb6\x46\xb3\xb2\x66\xee\xcb\x8b\xd5\x66\x6e\x5e\x11\x43\x5e" +
+		"\x17\x0b\xb6\x23\x56\xd4\x40\xb4\x7f\xd8\x07\x23\x4d\x6e\xf7\x45\xc0\x69\x46\xdd\xa3\xd7\x8b\xee\xaf\x89\x81\x2e\xea\xbe\x54\xf4" +
+		"\x34\x77\xc4\xae\xcc\x7b\xdb\xd7\x6f\xac\x3b\xe4\xe0\x75\x62\xff\xad\x62\x86\x21\x69\xe5\x2b\x62\xfe\xdd\xbe\x99\xfd\xa0\x79\x6c" +
+		"\x05\x45\xa7\x77\xc5\xa9\x4f\x45\x9f\x42\xa2\x69\x03\x83\xb6\xa4\x38\x67\x8d\x03\x4d\xec\x8f\xfe\x06\xe0\x63\xe2\x29\xdf\xac\x75" +
+		"\xc0\x94\xcb\xb0\x92\x62\x99\xcd\xba\xeb\x6a\xd1\x64\x36\x62\x62\x7d\xc4\xa0\xff\x8a\x5d\x3d\x10\xc7\x2b\x89\xe1\x5f\x89\xfa\x6d" +
+		"\x11\xbf\x1b\x56\xd7\x2c\x40\x58\xbe\x6a\x9a\xf9\x05\x67\x6e\x10\x7f\xec\xc3\x3b\x34\x85\xc5\xd6\x9e\x88\x93\x96\xf2\x5f\x7e\x9d" +
+		"\xf8\xeb\x10\x62\x94\xe5\x75\xb6\xde\x21\x8e\x7c\x2d\xb6\x7d\x9b\x14\xa6\x4d\x37\x88\x9d\x37\xf8\x5a\xd8\xef\x03\xf1\x9b\xa6\xce" +
+		"\xdb\xfd\x4d\x0e\x1a\x9a\xf6\x20\x36\x96\x15\x7f\x65\xfb\xfd\x90\xbf\x5d\x29\xce\x95\x13\xb5\x8c\xcd\xad\xef\xea\x6b\x31\xfd\x01" +
+		"\x51\x67\xb4\xdd\x68\xdc\x72\x83\xa8\x73\xbb\x68\x62\x25\xd8\x69\x46\xcb\x7f\x89\xe9\xf8\xb4\xd8\xa4\x99\x88\x99\x2b\x10\x03\xef" +
+		"\x11\x33\x86\x22\x96\x6c\x45\x0c\xc8\x16\xeb\xcd\x99\xfd\x42\x6c\x28\x22\x5a\xdc\x2b\x1a\x54\x17\x6d\x2b\x89\xde\xf5\x10\x43\xa2" +
+		"\xe2\xc8\xef\x88\x6d\x93\x0d\xc6\x37\xc4\xbc\x3b\x5d\x88\x37\xeb\x02\xb1\x6d\x13\x62\xeb\xff\xc4\xd8\x17\xc4\xcc\xd2\x62\xca\x4a" +
+		"\x43\xd0\x8b\xa2\xe9\x1c\xc4\x60\x43\xf0\xe2\xe3\x88\xa5\x16\xb1\x2e\x36\x8d\xd1\xed\x14\x62\xac\xf5\x71\x5c\x2f\x9a\x0f\x36\xf0" +
+		"\x9c\x63\x70\x08\xbf\x77\xef\x97\x36\x88\xbd\x16\xee\x44\xc4\xce\xc7\xc5\x9e\xcf\x44\xe7\x67\x45\xa7\x25\x86\xb4\xe3\x49\xcf\x62" +
+		"\x5f\x3e\x31\xe3\x1e\xb1\x39\x26\xc6\x75\x46\xdc\xe2\xb1\x39\xa1\x80\xa8\x75\x83\xe8\xfc\x84\x98\x3a\x08\x71\xc2\x1e\x1b\xf6\x9d" +
+		"\x38\x1c\x17\x07\xae\x10\xf5\x5e\x13\x13\x2e\x12\x43\x8c\xe8\x7f\xee\x30\x82\xbf\x2f\xda\x97\xf2\x9a\xe5\xcc\x27\x49\x37\xb5\x7b" +
+		"\x75\x31\xef\x63\x71\xae\x06\x62\x9d\xe1\x78\xd3\x85\x62\xf3\xa5\x62\x73\x71\x31\xab\x23\xa2\xb7\x41\x3f\xe6\x26\xb1\xf9\x6d\xb1" +
+		"\xf7\x02\x71\xa4\x07\x49\xb3\x19\x15\xdd\x2d\xbb\x6b\x7b\x1f\xe2\x62\xfc\x73\xe2\x74\x29\xb1\xaa\x21\xa2\xc7\x27\x62\x53\x57\xc4" +
+		"\x0e\x2b\xe2\x18\x55\x7f\x10\xad\x07\x20\xd6\x5f\x27\xfa\x7e\x2e\x46\x5d\x2f\x86\x7f\x2f\xa6\xdf\xe6\xb7\x72\xf6\xba\x52\x2c\xbc" +
+		"\x4f\xac\xef\x47\x52\x2a\xac\x57\x60\x8e\xfd\xb5\xe9\x5b\xb1\xe4\x19\x71\xa0\xb2\xa8\xf5\x0f\x71\xfa\x46\x31\xeb\x5e\x31\xf1\x59" +
+		"\x51\xcb\x76\x5b\x7c\x2a\xea\x55\x15\x3f\xdb\xde\xb0\xc2\x62\xdc\x95\xa2\x77\x69\xd1\xf9\x3d\xd1\xa2\x9a\x18\x3c\x09\xd1\xf5\x24" +
+		"\x62\xf9\x5c\x44\xfb\xab\xc5\xd2\x9a\x88\x9a\x86\xb6\x3e\x05\xc4\x06\x53\x53\x16\x50\xd6\x42\x74\xfe\xc2\xb3\xd8\xec\x71\x88\x5a" +
+		"\x93\x6d\xf9\x23\x11\x75\x8c\x78\x83\xdc\x46\x8c\x5b\xc5\x19\x1b\x68\x79\x79\xd1\xaf\x8a\xe8\xf3\x9c\x38\x6f\x9d\x34\xd7\x88\xf3" +
+		"\xb6\xfa\x5d\xa5\xc5\xba\xdb\x45\x8d\x07\xc4\x98\x84\x98\x93\x23\xfa\x57\x10\x73\x2a\x8a\xdf\x2e\x34\xdf\x31\x10\x1b\xdf\x14\xf3" +
+		"\x6e\x17\xbd\x5e\x12\xa7\x2a\x8a\x61\x06\xcd\xf0\x79\x86\xa2\x88\xd7\x7d\x03\xea\x21\x26\x3d\x25\xce\xbf\x21\x36\x3e\x6e\x05\x63" +
+		"\x44\xbb\x07\xc4\x34\x8b\x0e\xb2\xc5\xae\x4b\xc4\xdc\x52\x62\x58\x59\x31\xed\x0d\xd1\xe6\x13\xbf\xc5\xce\x1a\x87\xcf\x7f\x2c\xd6" +
+		"\xe5\xf3\xfb\x6c\x7e\x5d\x88\xf8\xe3\x2e\x71\xaa\xbc\x98\x66\x4b\x7f\x56\xec\xfd\x46\x9c\x98\x8e\x68\x14\x17\xc3\x2e\xf7\x29\xdf" +
+		"\x56\xd9\x62\xc3\x65\x62\xe5\x43\x62\x7f\x20\x4e\x94\x13\xcd\xae\x17\xbf\x5c\x22\xc6\xb9\x0a\xdd\x4e\xc4\x19\xeb\x74\xfb\x87\x98" +
+		"\xfe\x83\xa8\x57\xc0\xb9\x52\x81\x65\x42\x1a\x67\x8b\xde\x6e\x3f\x64\x4c\xcc\xbe\x5a\x34\x78\xda\x43\x6e\x79\xa3\xc7\x44\xd7\x2f" +
+		"\x7d\x21\xc0\x18\x69\x76\x42\x0c\xbd\x2d\xb9\x3d\x73\x78\x2f\xc4\x7c\x4b\x63\x2e\xc7\x67\x03\x36\x5c\x21\x8e\x16\x15\xdb\x2f\x10" +
+		"\x5d\xee\x13\x63\x2c\xd5\xf4\x88\x68\xb1\x08\xb1\xef\x17\xc4\x9f\x56\x6e\xbc\x5b\x8c\xfe\x5e\x2c\xbe\x4b\x74\x7b\xd4\x27\x98\x4e" +
+		"\x1b\x98\xcd\xaf\x10\x53\x1a\x20\x86\x7d\x22\xe6\x18\xc5\x97\x21\xea\x77\x46\xec\xbd\x4e\x6c\xfd\x5e\xac\x30\xbb\x68\xe9\x80\x7f" +
+		"\x88\x3b\xc4\x9e\x42\x62\xc8\x73\x62\xe2\x95\xbe\xd1\xde\x7c\xa3\x05\xcf\x8a\xfd\x75\x93\x11\xd8\xb8\xdf\x93\xfa\xa6\xf9\xf3\x62" +
+		"\xe8\x52\x23\x54\x69\xd1\xe6\x22\xb1\xf8\x7d\x0b\xe1\x10\x23\xff\x23\xf6\xdc\x28\x26\xd5\xb1\x55\x6c\xc2\x6f\xee\x5c\x73\x97\x58" +
+		"\xf1\xac\xa8\xd7\x1f\x31\xf8\x75\x71\x7c\x20\xe2\x37\x5b\x72\xbf\x07\xc4\xf8\xc7\xc5\xfc\x0a\xa2\xd6\x00\xc4\xd9\x49\xf8\x0d\x83" +
+		"\x9b\xae\x13\x2d\xfe\x29\x96\x5c\x21\x86\xda\xd8\x4b\x8a\x89\xf9\xd9\x62\xff\x6a\xc4\xb4\x6e\x88\x26\x96\x89\xdf\x85\xa8\xf7\x80" +
+		"\xd8\x84\xf7\x6a\x0f\x3d\x2b\x06\x5f\x2f\xa6\xdc\x29\xda\xdd\x21\x9a\x1f\x40\x9c\xa8\x6f\x8b\x37\x65\x96\x23\x7a\x7e\x2e\x36\xe6" +
+		"\x88\x71\x5b\x11\x0d\x17\x23\x3a\x3c\xeb\x5b\xb6\x96\x3c\x9c\x6c\xf3\x1d\x32\x9e\xe4\xce\xd8\x15\xb7\x8a\xba\x4f\x24\xab\xc3\xe3" +
+		"\x9f\x14\x7b\x1f\x11\xbf\x9f\x43\xac\x9b\x87\x55\x22\x10\x3f\x2f\x40\x2c\x68\x8a\xa8\xf9\x83\x38\x62\x39\xbb\x87\x44\xcb\x02\x62" +
+		"\xba\x61\xe9\xcc\x55\xa2\xcf\x4b\xa2\xff\x93\x62\xfa\x6e\xc4\xa9\x87\x45\x33\xdb\xb5\x6d\x01\xbe\x35\x12\xbc\x2e\xfe\x7a\x51\x1c" +
+		"\x7c\x49\x74\xee\x19\xee\xa9\x6c\xe9\x90\xf4\xb6\x98\x6a\x23\xaf\x32\xf2\x75\x34\x0c\xd5\xb6\x5d\x43\x95\x45\x97\x77\x44\xfd\x37" +
+		"\xbc\xf5\xdf\x75\x91\x18\x7a\xa1\x18\x55\x4d\x98\xc1\xda\x95\x23\x36\xe5\x88\x8d\xd5\xc5\xe1\xeb\x7c\x36\xc0\xbc\xbd\xc3\x88\xfe" +
+		"\xa3\x03\xd1\xf8\x7c\x72\xd3\x6e\xcd\x4b\x44\x9f\xa1\x88\xa3\x43\x8c\x38\xd6\x75\x5c\x34\x99\x88\x7b\x57\x74\xfc\x41\xcc\x7a\x47" +
+		"\x6c\xb1\xf6\x10\xa3\xde\x0e\x9b\x74\xac\xc1\x73\x9d\x18\x69\x44\x98\x3e\xd3\xcc\x20\x62\xab\x15\xc2\x76\x5a\xfc\x5b\x46\x3c\x27" +
+		"\xf6\x2c\x41\xac\xe9\x82\xd8\x50\xd2\xd7\xe3\x6c\xb7\x47\xab\xa2\xe2\x62\x91\x23\xa2\xa2\x88\xb5\x88\x9a\x87\x79\xa5\xa8\xbb\x3b" +
+		"\x59\x4a\xec\x1a\x11\x85\xdc\x4d\x93\x8c\xb6\x7b\x77\x22\xac\x06\x1b\x73\x4f\xe5\x88\x2a\xf6\x19\x94\x12\xa5\xc4\x65\x22\x2e\xf2" +
+		"\x89\xbe\xf3\x11\xdb\x8c\x24\xd9\x02\x51\xce\x5d\xe8\x69\x19\x32\x03\x77\xc4\x0c\xdc\xa8\x39\xa2\x80\x78\xcc\x7d\x16\x13\xa5\xc5" +
+		"\xf7\xe2\x49\xf1\x86\xa8\x63\x90\x22\xde\x72\xa0\xdd\x29\xee\x13\xef\x3b\x18\x7b\x59\xe9\xe5\x45\x37\x60\x54\x14\x14\x0f\x8b\x12" +
+		"\xce\x3e\x46\x44\x65\xf7\x4b\x7e\x07\xcd\x25\x0e\xd2\x37\xc4\xab\x6e\x98\xeb\x45\x1f\xc2\x53\x0f\x1e\x16\x57\xbb\xd1\x0a\x88\xe2" +
+		"\xa2\xac\xb8\xc9\xa9\x85\x07\xc4\x08\x9f\x4f\xbd\xc3\x2d\x0d\xf1\xba\xb8\x4b\x5c\xe4\xee\x88\x8b\xc1\x88\x7f\xbb\xa5\x96\x76\x0b" +
+		"\xb8\xcb\xad\xee\xb9\x70\xe0\xf2\xe2\x76\x37\xdd\x8d\x02\x07\x49\x21\xf7\x64\x42\xac\x4b\x88\x69\xff\x16\xf3\xbf\x72\x44\x31\x2a" +
+		"\xf5\x99\x1a\x88\x51\x8b\xf1\x7b\xed\x1b\xdb\xd8\xdd\x6e\x12\x1d\x5c\xf3\x7a\x31\x31\xc4\xf2\xf1\x7d\xcb\x89\x31\xa5\xc4\x95\xe2" +
+		"\xc8\x3a\xc4\xbe\x75\xf8\x94\xe9\xfa\xb2\xa2\xfe\x45\x62\x77\xb6\xf8\x33\x5b\xcc\xce\x16\xc7\x76\xe0\x5c\xcf\x0d\x6e\x8b\xc7\x7c" +
+		"\x7c\x22\xbd\xb7\x35\x5f\x7e\x27\xba\xb9\xad\x53\x97\x88\x8e\xe3\x70\xd0\x97\x12\x38\xf4\xbd\xed\xbe\xc7\xc5\xb1\x9d\x28\xc8\xb1" +
+		"\xaf\x41\x31\x11\x55\x50\xc2\x2d\x21\x21\x50\x24\xe1\x7e\x89\xba\xab\x71\xfb\x31\x48\x38\x12\xbb\x5f\x52\xff\x82\x78\x88\x8d\xb8" +
+		"\xbb\x1a\xb7\x47\x22\xd1\x90\x64\x31\xfb\xd1\xfe\xf4\xa3\xc5\xc3\x7f\x51\xd1\x71\x1b\x0e\x85\x73\x1a\x05\x21\xeb\x5c\xec\x68\x74" +
+		"\x85\xfb\x9e\x23\x72\x1d\xb4\x1e\xa6\xec\x90\x73\xaf\x74\xdf\x8b\x39\x44\x8f\x28\x95\x1c\x98\x2c\xf7\x58\xf1\xf0\x61\x07\x2f\x11" +
+		"\xc7\x20\x55\x44\x51\x37\xee\x65\xa2\xa4\xbb\x54\xca\xdd\x96\xe5\x28\x96\xcf\xdd\x79\xb1\xfb\x2c\x17\x12\x39\xcb\xcd\x51\xd6\x8d" +
+		"\x50\xcc\xfd\x33\x77\xfe\x0c\x62\x4f\x59\x31\xb5\x8c\x43\x57\x79\x5f\x1d\xd9\x90\x70\x4f\x55\x12\x1b\x23\xa2\xdb\xb7\x62\x4a\x49" +
+		"\x31\xe2\x1f\xe2\xec\xcd\x6e\x09\x3b\xfa\x04\xe2\x60\xd7\x40\xb4\xbb\x56\x8c\x7c\xd9\xa7\x35\x67\x97\x11\x13\x70\x00\x5a\xf8\xfe" +
+		"\xa3\x63\xd0\x52\xbe\xdf\x22\xee\x26\x2e\xe2\x84\xad\xb8\x78\x45\xdc\xe3\x46\x7a\xc2\xf1\xdb\xcd\xe2\x56\xb7\x24\xc4\xe5\x0e\xd0" +
+		"\x22\x0e\xcf\x5f\x8b\xc2\x8e\xf3\x1f\x73\xa2\xf1\xa4\x63\xbc\x7b\xdc\xe7\x47\x21\x39\x70\x88\xb8\x59\x7c\xeb\x56\x5e\x44\x54\x70" +
+		"\xbf\xbc\xe6\xae\x5e\xe4\xfe\x5d\xe1\x50\x90\x4f\xbc\x20\x1e\x14\x8d\x7c\x78\x7d\xb5\xa3\x42\x79\xf7\xf0\x52\xab\x17\x6d\x2d\x2e" +
+		"\x46\x1d\x21\xb9\x31\xc5\x53\xe1\x62\xf7\xdc\x5d\xee\x39\x8f\x34\x4f\xb3\xcb\x1c\x14\x39\xe2\x02\x71\x83\xfb\xe5\x26\xd1\x16\x47" +
+		"\x9b\xc2\x0a\x2a\x3b\xca\x5d\xef\x48\x7d\xb9\x83\xaa\xb8\x33\xb8\xc5\xc4\xb3\x4e\x4d\x3c\x25\x2e\x75\x97\x5e\x70\x08\x7b\xde\x8d" +
+		"\x52\xdd\x8d\xf8\x81\x93\xfd\x27\x43\x0c\x56\x50\x50\xd6\x45\x6b\xc5\x45\x33\x6c\xe5\x46\xaa\x7e\x08\xf3\x8e\x07\x79\x84\x9b\xbb" +
+		"\xef\x77\x47\xbc\x2e\xaa\xb9\x9f\xb2\x1c\x6a\xef\x16\x4f\x8b\x56\x88\xf7\x1c\xc4\x05\x9c\x1e\xf2\xfc\xd4\x11\xd1\x85\x24\x71\x82" +
+		"\xb8\xd7\x5a\x9f\x2b\xf8\x8f\x4d\x6a\xa0\x5e\xeb\x00\xb8\xd6\x51\xe1\x6b\xa7\x31\x5e\x14\xff\x71\xd3\x54\x76\xa0\x16\x73\x88\x88" +
+		"\x3a\x92\xd5\x40\xdc\xe6\x6e\xea\x39\x0c\xd1\x6b\x38\xee\x8f\x57\x1d\x3c\x8f\x8b\x26\x38\x80\x1e\x77\x0b\xf9\x87\xc3\xcc\x5b\xa2" +
+		"\xa4\xe3\x87\x0b\x9d\x4a\xcb\x27\x16\x21\x3e\x76\x7f\x1f\xc3\xdd\x58\x44\x20\x2a\x29\xb8\xd2\xa1\xba\x9c\xc3\xcd\x37\x6e\xe6\x07" +
+		"\xdc\x82\x2a\xba\xf9\xc7\xe3\x18\xa0\x3f\xa1\x1e\xa8\xe8\xd6\x5d\xd1\x81\x5e\xdc\x0d\xfd\x98\xf8\xaf\xc3\x72\x3e\xa7\x1d\x6d\xe1" +
+		"\x11\xf1\x8c\x38\x5b\x55\xac\xaa\x26\x9a\xe3\x11\xe5\xb9\xf1\x76\x71\x8b\x9b\x29\xe6\xb9\xfa\x12\xb7\x0e\x2f\x61\xb7\x8b\x61\xb7" +
+		"\x89\x19\xb7\x3a\xfd\x5c\xc4\x8d\x92\x70\x9c\x59\x48\x5c\xe3\x98\xf3\x52\x87\xb7\x0f\xdc\x1c\x05\x1c\xac\x97\xba\x61\x1f\x70\xa4" +
+		"\xaf\x2e\x1e\x72\xd4\x7f\x4c\x9c\x36\x48\x8a\x89\xf6\x25\x1d\x1a\xff\xec\x8c\x98\xd9\xd9\xdb\x9e\x88\x68\x59\x5a\xf4\xf5\x96\x29" +
+		"\xd7\x8d\x3f\xbc\x94\xe3\xc7\x8f\x1c\x18\xe5\x1c\x87\xc6\xc4\x75\x6e\xb2\x17\x45\x3d\x1c\x4d\x0a\x7a\x86\xb0\xf5\x58\x4c\x55\xc4" +
+		"\x69\x99\xc7\x44\x53\xc4\x4f\x0e\x80\x7b\xbc\x20\x94\x75\xfa\x36\xc7\x31\x6b\x15\x67\x1b\x2a\x39\x99\xb1\xba\xa9\x65\xd3\x2d\x34" +
+		"\x0f\xdc\xc2\x3f\x76\x73\x65\x3b\xe6\xa8\xe4\xd0\xf8\xbd\x1b\xf2\x5a\xb7\xfe\xdb\x14\x7c\xef\xd8\xed\x5e\x37\xda\xc5\x0e\xdc\x32" +
+		"\x6e\x09\x5e\xc8\x8b\x88\xd9\xa5\x44\xb3\x88\x68\xf6\xbc\x68\xfe\x92\xd8\x76\x9d\xdf\x0a\x5a\xca\x8d\x89\x13\xd4\xeb\x1c\x2a\x2f" +
+		"\x72\xc0\x45\x72\x1d\x71\x2f\x77\xf0\x7e\xe2\xbe\x7f\xec\xd6\xdc\xc3\x2f\xf2\x0e\x47\x8d\xd2\x0a\x3e\x71\x50\x5c\xe0\x9e\xfb\xcc" +
+		"\x71\xf8\xfd\x4e\xbe\x2f\x70\xa8\x7a\x5e\xa0\xe0\x15\x31\x13\x67\x25\xdf\x75\x34\x4e\x18\x78\x41\x19\xa7\xc4\xdf\x73\x03\x7d\x19" +
+		"\xb2\xd8\xf7\xe2\x9f\xee\xcf\xfb\x1d\x3c\xf7\x28\x78\xc3\x41\x18\x75\x1c\x15\x0f\x05\xf8\x31\xb1\x1a\x47\xc9\x17\x1d\x7a\xaf\x71" +
+		"\xda\xa4\x82\x43\x4d\x15\x45\xaa\x88\xfa\xde\xdd\x78\xc9\xf1\x49\x33\x44\x57\x9c\x66\x2a\xe4\x2c\x56\x01\x07\xdd\x3d\x62\x38\x3e" +
+		"\x03\x78\x8d\x98\x87\x82\xea\x4e\x49\x64\x8b\x5e\x38\x14\xbe\xea\xcd\x76\x67\x14\x54\x72\x74\xba\xcc\xb1\xf5\x8d\x4e\x79\x14\xb5" +
+		"\x35\x04\x8f\x3b\xe6\x2a\xe3\xd8\x74\xf3\x0e\xc4\xde\x3f\xfc\x78\x09\xc7\xfe\x0f\x84\xa7\x56\x45\x1d\x35\x72\xdc\x93\x77\x87\xc8" +
+		"\xb9\xd7\xe1\xaa\xb0\x5b\xd9\x45\x0e\xb8\x77\x1d\xc6\xcb\x18\x56\x82\x8b\x1d\xe9\xca\x3a\x1a\x3e\x12\x6a\xc0\x84\x5b\x70\x51\xf7" +
+		"\x7b\x4e\x68\xca\x3c\x01\x77\x5c\xe0\xd8\x39\x9f\x5b\x79\x35\xa7\xac\xf2\xb9\xb1\x3c\x4b\x78\x63\x30\x20\xcb\x09\xc4\x15\xe1\x78" +
+		"\x17\xb8\x79\x0a\xba\xa9\xbc\x2e\xbd\xdc\xad\xe8\x92\xd0\x70\x7a\x4a\x16\x75\x03\x95\x75\x18\xb8\xd1\xa9\x85\xca\x0e\xff\xde\x1f" +
+		"\x2b\xea\xa8\x78\xbd\x5b\xcd\xf7\x8e\xc6\x45\xdd\x80\x6f\xb8\x71\xae\x74\x33\x96\x15\x55\x85\xd7\xd3\x25\xdc\x9f\x9e\xe1\x5c\xd1" +
+		"\xe5\xf8\x04\x2f\x10\xeb\xbd\xb1\x2d\x91\xe1\x1c\x14\x71\xbf\x78\x4b\x9b\xed\x66\xcb\x15\x8b\x7a\x21\x6a\xf6\x49\x6e\xc8\x73\x0f" +
+		"\x99\x1a\x2d\x21\x22\x0e\x50\x2f\xc4\x15\xdd\xbd\xf9\xc3\xb5\xc5\x1d\x7a\x2e\x30\x7c\x04\x85\x1d\x56\xb3\xdc\xa4\xd7\x3a\x10\xbd" +
+		"\x3a\x2f\xe3\xc0\xaa\xe0\x1e\x2c\xe5\x18\xa5\x8a\x43\x67\xb1\xd0\xa6\xc6\x1d\x9f\x4e\xa8\x2c\xf6\xf6\x46\xd4\x71\x1e\xb8\x7d\x54" +
+		"\x17\x33\x6a\x06\x8a\x27\x1c\x8c\x1e\xb9\xf1\x10\xf6\xd0\xbd\x31\xef\x65\xc0\x9f\xde\x57\xc9\xef\xe0\x2d\xe7\x88\x74\x93\x83\xc5" +
+		"\x1b\xab\x69\x86\x8b\x9a\xf6\xe1\xd5\x93\x13\x14\x7a\x34\x46\xec\xad\xef\x17\xe6\xcd\xc9\x07\xe2\x2a\xb7\xc8\xfb\xdd\x04\x95\x14" +
+		"\xe4\x3a\x43\xe0\x11\x71\xaf\x5b\xe1\x85\x6e\xc8\x92\x0e\x2f\xb7\xbb\x81\xee\x71\x1a\xec\x4e\x05\x43\x71\x7f\x3b\xcb\x63\x42\x75" +
+		"\x45\xe8\x6f\x5e\xe1\x98\xf1\x66\x07\x5b\x7e\xf7\xd9\x0b\x27\x36\x45\x9d\x40\xe5\x73\xe4\xbc\x52\x3c\x2a\x7e\xf7\x3c\x72\x89\xc3" +
+		"\xe3\xeb\x0e\x41\x65\x9d\x89\xaa\xe2\x18\xfd\x33\xc7\x14\x57\x38\x0e\xac\xec\x40\x1a\x66\x14\x5f\x87\x68\xed\xcf\x12\xcb\x09\x1d" +
+		"\x43\xff\x25\x3b\xe9\x00\x46\x43\x37\x30\xe2\xfd\xbb\x75\x96\xa5\xeb\xdb\x02\xb1\xa2\x9a\x98\x76\xa5\x9b\xa0\x90\x03\x64\x24\xb6" +
+		"\x1a\x5b\x71\xc2\x28\x18\x3c\xe8\xbe\x7b\x07\xe2\x01\x27\x74\x09\xf1\xb8\x82\x36\x29\x7f\xe5\x61\xa7\x09\x8a\x3a\xfe\xbd\xd0\x26" +
+		"\xe1\x3b\x17\x26\x78\xad\xfe\x1f\x37\xea\x2d\x0e\xf8\x6c\x51\xd3\x2b\x92\xab\x1d\x1e\x2b\xbb\xfb\xee\x76\xdc\x71\x4b\x86\x2b\x74" +
+		"\x8f\x98\xe4\x59\xe0\x0d\xf7\x77\x6d\x14\x7c\xe4\xf0\x9a\x70\xc3\xde\x28\xfe\xb2\x44\xb5\xb7\x7c\x37\x39\xfb\xdb\x15\x87\x90\x77" +
+		"\x9c\x22\x28\xec\xe8\xff\xbd\x58\x89\x98\x81\x2f\x31\x9e\xc2\x1b\x95\xaa\x6e\xe2\x66\xf8\x01\x9e\x70\x4a\xb3\xba\x33\xf1\x55\xfc" +
+		"\x86\xe9\x57\xdd\x0c\x86\x9d\x4e\x78\x5f\xe8\xbb\x50\x65\x46\xdc\xc0\xd5\x1c\x8f\xdc\x2a\xfe\x17\xaa\xe8\x7a\x11\x05\xb7\xb9\x6f" +
+		"\x17\x3a\x9e\xf9\x24\x74\xcc\x9e\x11\xad\x71\x2b\xaa\xe6\x73\x2c\x09\x81\x93\x88\xfc\x8e\x1b\x23\x62\xa8\x17\x95\xea\x4e\x19\xc4" +
+		"\x1c\xd1\xab\x38\x9e\xbc\xc8\xcd\x5a\xce\x91\xbe\x88\xbb\xc7\xfb\xbf\x95\xdc\x8f\x96\xe7\x4a\x38\xce\x8f\xb8\x27\xaf\x10\x7f\xbd" +
+		"\x27\x36\xba\x8a\xfb\x1a\x44\xf7\x4b\xdc\x0c\x75\xc6\x84\x47\xc8\x0c\x9d\x6f\xdf\x2c\x00\x2e\xe2\x50\xe0\x89\x5a\xc4\xd1\x2e\xdb" +
+		"\x4d\x5d\x26\x24\x8b\x77\x2c\x6f\x0d\xcf\x21\x2c\x22\x4a\x38\xcf\xa5\xb0\x7b\xea\x6a\x67\x6e\x22\xe2\x06\x07\x64\x31\xc7\x09\x33" +
+		"\xfb\x22\x8e\xd9\x47\x91\x50\x8f\xe6\x73\x4c\x75\x74\x96\x1f\xcd\xcb\xc7\xf8\x1c\xd1\xbd\x9c\x6f\xe5\x2c\x22\x96\x44\xfc\xe9\x87" +
+		"\x16\xd1\x35\x45\xf4\xae\x85\xf0\x68\x6a\x66\x7f\xef\x6f\x82\x38\xb7\x1f\xd1\xf1\x34\x62\x4d\x7e\x37\x48\x31\x05\xb7\xbb\x49\x4b" +
+		"\x3a\x02\xdc\x1d\x06\x81\xfe\xcc\x9a\x98\x93\xbf\x1b\x15\x34\xc3\x39\x4f\x2f\x38\x1e\x2d\xee\xf8\xe3\x0a\x77\xf9\x19\x37\xca\xfd" +
+		"\x0e\x6f\x17\x39\x2f\xfe\x09\x67\x84\x4b\xd8\x63\x3c\xe6\x91\xd9\x11\x51\xdb\x3e\x2e\x74\x4e\x73\xf2\x50\xb5\x57\xc4\xbf\x1c\x31" +
+		"\xa3\x8e\xd0\x4f\xb9\x0b\x65\x1d\xff\x5e\xec\xb4\x61\xc2\xdd\x53\x56\x78\x37\xd1\xc7\x00\x03\x23\x0e\x05\xb5\x23\xa1\xb2\xae\x1e" +
+		"\xd2\xb6\xef\x19\x9c\x48\x46\x93\x7e\x52\x90\x52\x72\x4e\x8a\x83\x30\x5c\xf3\x92\x1b\xa4\xce\x0b\x6c\xfe\x45\xf8\x2d\x9a\xf1\x4c" +
+		"\x59\xa7\x12\x73\xdd\x7d\x89\xf0\xc7\x84\x53\x04\xb9\x49\x9d\x69\x97\x6e\x15\xd7\xba\x98\xd1\x6b\x04\xaf\xed\xfd\xa5\x94\xa1\x70" +
+		"\x44\xc8\x8a\x2a\x1a\x57\xcc\x4f\x74\xd4\x68\xd3\xe8\x35\x31\xf7\x0d\x31\xe7\x75\x31\xf4\x53\xd1\xf4\x6b\x77\x77\x65\x47\x8b\x98" +
+		"\x13\xc5\xd2\x6e\x61\x37\x3b\xce\x29\x13\x7a\xbe\xfe\x86\x39\xad\x48\xb6\xe1\xdd\x23\x22\x8e\x9f\x1e\x14\x5f\xb8\xcb\xb7\x3a\xe9" +
+		"\xfd\x30\x74\xea\xfd\x01\x97\x91\xd0\x74\x3e\xe1\x6e\xc8\xe7\x7e\x49\x84\x36\xb4\xbc\xfb\x72\x81\x82\x0b\x42\x63\x7e\x85\xb3\xd3" +
+		"\x37\x3a\xb3\xf3\x82\xb8\x5e\x91\xcd\x4d\x10\x53\x67\x10\xc6\xac\xd7\x38\x3e\xf8\xd6\x69\xb9\x3b\x1c\x74\x8f\x38\x78\xff\xe1\x58" +
+		"\xa9\xa8\xfb\x5e\xdd\x49\xa8\xa5\x52\x71\xfa\xe5\x6d\xe7\x49\xdc\xe3\x48\x3f\xce\x87\x20\x0f\xba\xe7\x1e\x17\xcf\x39\xbd\xf8\x9d" +
+		"\x63\xb0\xe7\x9c\x46\xef\xe8\x7c\xf8\xe0\x23\xe7\xaa\x5d\xee\x74\xfb\x9d\x8e\x65\xaa\x88\x85\xb8\xe5\x96\x77\x2c\xf9\x98\x1b\x20" +
+		"\x12\x86\xff\x55\x45\x87\xc0\xd9\x81\x5b\x1c\x06\x6e\x77\xc1\xd3\xd0\xe4\x06\x9c\x6d\x28\x78\xc0\x3d\xf0\x82\xf3\xea\x7c\xa0\x39" +
+		"\x18\xb1\xd3\xb3\xe5\x0c\x6f\x7a\x9e\x70\xb3\xdd\xea\xbe\xdc\xe8\x74\xd0\x0d\x6e\xe8\xfa\x88\xe1\x81\x82\x86\xf8\x10\xc6\xfe\x7f" +
+		"\xbf\x23\x59\x45\xa7\x86\x7d\x66\xe3\xa2\xd0\xb5\xba\x3f\x54\x0e\x57\x7b\x0d\xd6\x2e\xa9\x2f\xb7\xfb\x64\x90\xa9\xe4\xe7\xbc\x82" +
+		"\xfc\x03\x9f\xd1\xc9\x2f\x66\x21\xba\xe3\xd0\xfc\xa8\x37\x25\x57\xb9\xd0\xe3\x2a\xf7\xcc\x43\x6e\x30\x8f\x51\x6f\xf2\x3a\x94\x37" +
+		"\x48\x83\x9e\x17\x25\x15\x90\x05\x88\xf9\x9d\x72\xc9\x71\x73\xd7\x6a\x8e\xe3\xfd\x44\xc8\xf8\xa4\x25\x20\x99\xdd\x70\xfc\x1d\x89" +
+		"\x86\x4e\x49\xfb\x88\xa8\x3b\xca\x47\x4f\x29\x01\x48\xa9\xdd\x42\xe2\xd8\x4f\x62\xff\xcd\x6e\xbe\x12\x6e\x89\x7e\xbc\xc9\x55\x15" +
+		"\x39\x12\x84\xba\x75\x64\xcc\x1f\x99\x71\x99\x03\xbd\xd1\xd5\xa2\xf3\x15\x62\x54\xc4\xb1\x95\xcb\x9b\x80\xe8\xf5\x4f\x9f\xe4\x2d" +
+		"\xee\xa8\x5c\x36\x64\xb0\xa2\x8e\xd6\x65\xdc\x6c\x9e\x48\xf7\x3a\xf7\xce\x45\xdd\x41\xab\x8e\x84\xf9\x18\x9f\xbf\xc9\x76\x4b\x8a" +
+		"\xb8\x25\xfb\x04\x4f\xef\x5c\x93\x4a\xb3\xd9\xb3\x5f\x77\x32\x51\xdb\x2b\xd5\x22\x0a\xca\xb9\x07\x73\x33\xf2\x3a\xad\xab\x38\xb6" +
+		"\x3b\x83\xe8\x16\xf8\x82\xa1\x35\xbd\x8f\x7a\xca\x3d\x52\xcc\x99\x8d\x84\x43\x47\x34\x54\xeb\x85\x6c\x78\xff\x85\x7c\xe1\xbc\x27" +
+		"\x2f\x70\x60\x57\x14\xb3\x3c\x76\x12\x8e\x86\xdb\x9f\x12\xed\x97\x21\x5a\x4d\xf0\x96\xbb\xb0\xd3\x9e\xdb\xde\x17\x3b\xde\x77\x22" +
+		"\xed\x35\xb2\xd3\x2e\xd1\xb7\x9c\xb2\xbc\x31\x69\x24\xec\x6a\x31\xd1\xf4\x9f\xa2\xd3\x3f\x43\x0f\x3b\x2e\x0a\x28\xa8\xe6\x94\x67" +
+		"\x31\xb1\xf8\x9f\xe2\xd4\x3f\x45\x8b\x6b\xc5\xdc\x8a\x26\xb0\x94\x0d\x03\xb3\x8a\x0e\x88\x5c\x87\xbf\x5b\x1c\xea\x2f\x71\x48\xf0" +
+		"\x0e\x55\x7e\x31\xaa\x0f\x62\xa9\xe5\x39\x3b\x13\xfa\x56\xc7\xed\x1c\x9b\x47\xdd\x63\xf7\x89\x85\x1f\xf9\x26\xe1\x0b\xdd\xdc\xb7" +
+		"\x88\xda\xaf\x38\xe5\x1e\x4d\x92\x83\x2b\x14\x39\x30\x06\xc7\x4f\x09\xb1\x78\xb4\xf1\xf3\x8d\xa2\xce\x4d\x62\xeb\xb7\x62\x76\x4b" +
+		"\x44\xad\x9d\x88\xd3\x3b\x70\x2b\xf7\x36\x9b\x90\xdf\x3c\x8f\xfd\xe6\x8e\xa9\x35\x08\x72\x9d\x46\xca\x76\x78\x2a\xe9\x26\xfd\xcc" +
+		"\xc9\x63\x13\x42\xc7\xf5\x26\xf7\x5c\x1d\xc4\xb5\x8e\x2c\xf7\x3b\xe1\x7d\x32\x4c\xf4\xdc\xe5\x98\x26\xea\xb4\x68\x41\x9f\x8c\x28" +
+		"\xe9\x40\xbe\xcc\x09\x50\x09\x71\x3c\xee\x15\x42\x17\x44\x5f\xc4\xd1\xbb\x93\xb1\x85\x69\xf9\xed\xaf\x86\xc6\x37\xae\xc0\x87\x57" +
+		"\x9e\xd0\x3e\x05\x51\xce\x0d\x7a\x45\x18\x12\x5c\xec\xf8\xb4\x94\x98\xf9\x4f\x81\xf7\x3f\xef\x0d\x3d\x81\x47\x9d\xe6\x28\xe6\x3d" +
+		"\xa1\x5f\x3c\xeb\xf4\x40\x74\xf0\x20\xbd\xe6\x66\xf9\xc8\xa7\xf6\xf1\x87\x14\xce\xc1\xef\x20\x3f\xe2\x63\xcc\x42\x8e\x5c\x65\x8d" +
+		"\xe2\x2c\x7c\x58\xd4\x79\x44\xc1\x25\x8a\xdc\xee\x88\x5f\xd9\x21\x20\xd7\x81\x98\xe3\x94\x69\x15\x05\xd7\x3a\x5d\xfb\x9c\xfb\x31" +
+		"\xdb\x21\xe3\x1d\x07\x71\xae\x13\xb1\xab\x43\xb1\x29\xe7\x7e\xa9\xe0\xfc\xc3\x6c\xc7\x0d\x0f\xb8\x41\xae\x70\x2c\x52\x25\x8c\x07" +
+		"\x2a\x8a\x5f\x3b\xe2\x8e\x7d\x31\x19\x6b\xdb\xc3\x07\x76\xa9\x5c\xd4\x65\x0e\xc4\xc2\x4e\x15\x15\x48\x66\xc7\x83\x1b\x9c\xc8\x66" +
+		"\x39\x7e\x73\xea\xc8\x07\x03\xf6\xfd\xa2\x74\xe0\x14\x94\x0d\x93\x08\x85\x44\x5d\x8f\xf1\xb2\x0e\x73\xb1\x30\xfa\xcf\x75\xc8\x1b" +
+		"\x58\xc1\x4d\x18\x11\xcb\xaf\x11\x53\xaf\x71\xcf\xc7\xdc\x63\x85\xdc\x97\x2d\xe5\x1d\x9e\x3b\xdd\xaf\x48\xdf\x1b\x93\x8a\x2a\x69" +
+		"\xe9\x13\xc9\x94\x56\x24\xdb\xc5\x22\xb9\xa1\x69\x24\x9d\xda\x8d\x45\xd3\xde\x43\x96\x53\x84\x11\x7f\x89\xa4\x71\xf7\xf1\x40\xf2" +
+		"\x1e\x92\x8a\xd3\x27\x90\xd3\x4a\xd4\x4f\xea\xb4\x6c\x24\xae\x58\xa8\x59\x7d\xae\x38\xf0\x96\x38\x9e\x0c\x27\x7c\xc6\x38\xe9\x97" +
+		"\x94\x56\xa4\x5c\x18\x8e\x45\x43\xad\xe5\x87\x8d\xd8\x80\xf9\x52\xe3\xfc\x4b\xfc\xfc\x6f\x87\xb0\x09\x76\x2c\xc5\x57\x6e\xd1\x45" +
+		"\x43\x25\x79\xf6\x4b\xdf\x51\xe8\x7d\xa6\xa2\x8e\x1d\x22\x0e\xed\x67\x5a\x22\x56\x14\x13\xbb\x7f\x14\x4b\x0a\x66\x44\xe9\x25\x44" +
+		"\xe3\x2c\x07\x69\x07\xc4\xae\xde\x6e\x71\x31\x5f\x0d\x21\xe4\x14\x92\x88\xf4\x0b\x8d\xa6\x30\x11\x4f\xe6\x45\x93\xd8\xca\x56\x96" +
+		"\xd7\xc9\x59\xee\xf1\xe9\xbd\x53\x15\x8a\x62\x8e\x48\xe5\x5c\x32\xf4\xe2\x50\x95\x5e\x9e\x71\x6c\xb6\xf7\x8a\x2b\x86\x69\x2e\xef" +
+		"\xe8\xf9\x9c\xe5\xa5\x0e\xc8\xb8\x63\xc7\x8b\x42\xcd\x5d\xc2\x89\xf6\xad\xee\x33\x96\x84\x82\x7b\xc5\xcd\xee\xd9\x6a\x6e\x70\x1f" +
+		"\x15\xfb\xac\x44\x3e\x27\xbb\x95\xdc\x20\x1e\x5f\xf9\x9d\x24\xd8\xb9\xe4\x97\x8b\xb9\x45\x15\xac\xf3\xc2\xe9\x13\xba\x65\x42\xee" +
+		"\x2f\x15\x26\xcb\x73\xc4\xd6\x5b\x43\x4f\xb4\x44\xb8\xfc\x6a\x61\xc0\xe6\x03\xe0\x9b\x9c\x3c\x5d\xed\xae\x96\x72\x9a\xee\x4e\xf7" +
+		"\x4b\x45\x71\x41\x28\x85\xbe\x8a\x72\x49\x28\xa0\xbe\x00\xf0\x88\x7b\x6a\xd3\x93\x62\xf4\xb5\xe2\x0f\xbf\xe7\x6b\xa9\xb5\xcd\xa7" +
+		"\x8c\x6b\x4e\x5e\x64\x7b\xe5\xf9\xa8\x1b\xa6\x6c\x98\x10\xcc\x76\x32\x53\xc8\x77\xa8\xef\xb9\x33\x1d\xf5\x07\x21\x85\x82\x9c\xa4" +
+		"\xfb\x6a\xbf\x8c\x78\xd8\x3d\x75\x43\x58\xec\xc9\x17\x8e\xfc\x8a\x53\xf3\x11\x87\xd2\x0b\x1d\xf9\xb2\xdc\x9d\xd7\xba\xa5\xf8\xa4" +
+		"\xcb\x85\x6e\xb9\x77\xba\xab\x85\x43\x46\xcb\x49\xc6\xa0\x41\xca\x2a\xde\xef\xe3\xc5\x0f\xdd\x93\x57\x39\x15\x51\xc0\x39\x82\x09" +
+		"\x37\xc4\xc5\x21\x26\x7d\x48\x76\x83\xb8\xc3\xcd\xe4\xd4\x8b\xe1\xb6\x98\xfb\x12\x73\xa8\x2b\xeb\x16\x64\xc3\x4d\x45\xc1\x2f\xde" +
+		"\x96\xfa\x98\xe1\x41\x67\xa9\x2a\x3b\xe7\xed\x62\x07\xd9\xf5\x8e\xe4\xa5\x9c\x8e\xf5\x71\xce\x73\x4e\xe5\xfe\x10\xc6\x36\xd7\xb8" +
+		"\x91\x9f\x12\x5f\x3b\xc1\x2a\x12\x06\x63\x3e\x6b\x7b\xa5\xa3\x91\x2f\x83\xa4\xd8\xe8\x29\xf7\xec\x55\x6e\xd8\xf2\x6e\x79\x37\x38" +
+		"\xe0\x1b\x5f\x66\x3f\x99\x54\xb4\xcb\x2f\x06\x95\x4d\xe6\x3b\x82\x7c\xee\x36\x1f\x34\xdd\xe6\x7c\xd6\x0b\x1d\x27\x5c\xe2\xc8\x1d" +
+		"\x77\x5f\x52\x45\xc5\x12\xa2\xaa\x03\x26\x1a\xfa\x7a\x4f\xbb\xc7\x7d\x68\x54\x46\xdc\xe8\x96\x86\xd3\xf1\x15\xdd\xa5\x92\x6e\xc0" +
+		"\x12\x0e\xcb\x8f\xb8\x91\x73\x92\x4e\x8e\xa1\xe2\x22\x77\xa9\xbc\xf3\x09\x7d\x2a\xe2\x3e\x77\x4f\xc4\x49\x5d\xae\x03\xec\x4a\x77" +
+		"\x4f\x96\x53\xe3\x3e\xc6\x38\x53\x22\xe4\x16\x6b\x23\x29\xad\x88\xe5\x69\x5d\xf5\xcc\x70\x18\x77\x90\xc4\x15\x14\x74\x3f\xe6\x84" +
+		"\x2a\x22\x95\x20\x2b\x22\x06\x97\x0a\x73\x7b\x3d\xbc\x4c\x9d\x43\xd4\x0c\xad\x99\xb1\x46\x54\xec\xb5\xed\x45\xaf\x89\x16\xaf\x87" +
+		"\x9e\x7b\x34\x59\xa9\xb2\xd8\x23\x11\x9a\x62\x9f\x38\xbc\xcc\x2d\xa3\x89\x29\xab\xcf\xc5\xd0\x77\x43\xe3\x75\x71\x28\x9d\x5e\xef" +
+		"\x64\xfb\xae\x55\x9b\xee\x0d\x9b\xca\x39\x72\xfd\xc3\x23\x61\xb3\x7c\xe3\x58\xf9\x30\x49\x5e\xcd\xfb\xe4\x39\x62\xa1\x97\xb0\x5a" +
+		"\x6e\x41\x41\xc4\x11\x27\x12\x92\xa5\x80\xfb\xd1\x1f\x46\xee\x44\xc8\x90\x57\xc0\x2d\xd7\x69\x9e\xa0\xbc\x81\x12\x89\x87\x2a\x33" +
+		"\x47\xcc\x2f\x2e\x96\x5b\x2b\x6c\x75\xf1\xbe\xe3\xeb\xfe\xbe\xf8\xb7\x33\x08\x75\xa1\x2b\xbc\x05\x97\x85\xa1\xe5\x45\x0e\x09\x57" +
+		"\x89\xc7\x1c\xa9\xae\x0a\x2b\x19\xd7\x3a\xba\x96\x74\x62\x5d\x24\xd4\x4c\xd1\x90\xb3\x4b\x84\x55\xe7\x2c\xb7\xda\x92\xe2\x06\x23" +
+		"\x4c\xe0\x1d\x94\xc7\xc2\xaa\x76\x69\x07\x75\x79\x37\xb5\xd7\xce\xde\x91\x5d\x98\x70\x3f\x79\x51\xf5\x05\xc3\x83\x9d\x11\xc7\x5a" +
+		"\x78\x2f\x3f\x2b\xac\xa4\x7b\xd5\xd3\x0d\xb1\x34\x22\xd6\x5a\xd7\x19\xa1\x56\xb8\xce\x3d\x9d\x32\xac\xb7\x87\x1e\x5a\x59\x7f\x4e" +
+		"\x53\x5c\x71\x8f\xdc\xce\x41\x08\x7b\xbf\x4b\x1c\x83\x95\x77\x83\xfe\xfc\xa3\x98\xf1\xa3\xe8\xed\x8f\x44\xad\x24\xfe\xc4\xc5\x7f" +
+		"\xd3\x7c\x69\x7a\x63\xe0\xb4\x51\xff\x8b\x9d\x6b\x1c\x17\xf3\x1b\x1a\x18\x0d\x49\x9a\xc4\xc0\x25\xe8\xe2\x61\x78\x1d\x44\x95\x70" +
+		"\xd1\x76\x34\xd7\x8c\x51\xc4\xdf\x93\x0a\x72\x16\x3a\x07\x2e\xf0\xcb\xbf\xd5\xc1\x5f\xd0\x11\x39\x2a\x4e\xe5\x0f\x5d\xfb\x19\xb9" +
+		"\x62\x66\x6e\x68\x55\xb2\x43\x43\x18\x53\x50\x24\x15\xcf\x46\xc2\xcb\x84\x7e\xfe\xb2\x7c\x8e\x56\xf7\xba\xc0\x0f\xd1\x2e\x54\xb9" +
+		"\x14\x71\x13\xf9\xc4\x46\xa9\x90\x75\x13\xc9\xe8\x2a\x18\x9d\x2b\xea\xd6\x42\x34\xab\x89\xad\x25\xa9\xca\x8f\x5f\x2d\x9a\x5d\xe3" +
+		"\x14\xfe\xb7\xee\x37\xc2\x2b\xde\x3e\x7a\x76\x7f\x3f\x74\xc1\x9d\x47\x1d\x78\x7a\x9f\x9c\x64\x09\xb1\xc9\xe1\x2b\x36\x4e\x55\x15" +
+		"\xc7\x26\x7a\x6b\x78\x97\x83\x69\x51\x20\x16\x9f\xce\xf2\xb1\xe8\xa1\x9a\x88\xe3\x11\xd1\xb8\x06\xe2\xc8\x9a\x88\x98\x72\xb7\x98" +
+		"\x7a\xb3\x38\xfd\xa6\xe8\xbf\x03\x7f\xb2\xe4\x9a\xe9\x88\x2e\xee\xb8\x92\x0f\x7d\x11\xec\xd8\x02\xc4\x6a\x3b\xeb\xf7\x61\x31\xe6" +
+		"\x07\x31\xf8\x47\xd1\xea\x25\xf1\xc7\x25\x62\xd1\x6d\xa2\xe9\x11\x44\xc3\x82\x62\x5a\xd3\xc0\x9f\x11\xdd\x3e\x5b\xfc\x56\x4a\xb4" +
+		"\xfe\x9f\x98\xeb\xb6\xb0\xd9\xd9\xef\x5f\x88\x35\xf9\xc4\xdc\x92\x2e\x84\x64\x6a\x59\xb1\x68\x7d\xf2\xa8\x85\x99\x16\x8a\xdc\x29" +
+		"\x56\x47\xc5\x82\x42\x02\x31\xa0\x1d\x62\xf3\x3c\xc4\x8a\xf6\x88\xd6\xa5\x9d\xfa\x9f\xf5\x95\x98\xdb\x1d\x31\x78\x29\x62\xea\x32" +
+		"\xc4\xa9\x5f\x7c\xa9\x6e\xf3\x85\xfe\x90\x2e\x3b\xfe\x74\xe2\x36\xc4\x0e\xbb\x3a\xf1\x4b\x71\xfa\x4a\x87\xcc\x2e\x76\xf8\xfe\xa3" +
+		"\x7e\xff\x43\xf7\xf9\x24\xf7\x75\x7f\x22\x56\xe7\x88\x03\xff\x11\xcd\xed\x24\xe8\x71\x88\xde\x6d\xed\x49\x2b\x0f\x37\x45\x6c\xbf" +
+		"\x5f\xb4\xbf\x4d\xd4\xad\x24\x7a\x3c\x2f\x8e\x7f\x2f\xce\x18\x2d\xdb\xd8\xd9\xa4\x35\x02\x31\xde\xfa\x24\xce\x22\x86\xb5\xb4\xc0" +
+		"\xd2\x70\x38\xac\x11\xa2\xce\x45\x62\xbb\x3b\x28\xb1\x80\xe8\x39\x09\x51\xff\x42\x71\xe6\xb8\x7d\xa9\x24\x4e\xdf\x25\xce\xbe\x25" +
+		"\xd6\xf7\x42\xcc\xb8\xdb\x6f\x24\xa9\x75\xb9\x98\x52\x42\xf4\xb3\x65\x4d\x79\xc5\x27\x66\x4f\x0e\x43\xf4\x79\x5f\xd4\x7b\x57\x9c" +
+		"\x7e\x48\x0c\x8e\x89\x23\x4f\x8a\x16\x31\xf1\x57\x41\xd1\xf1\x1b\x31\xd6\xf0\xd2\xc4\x56\x3e\xdb\x78\x7b\x15\x62\xbd\x2d\x64\x8f" +
+		"\x6d\x46\x31\xda\xcc\xa9\x15\x88\xfa\x2f\x89\xe5\x81\xd8\x6c\xe7\x76\x17\x17\x67\xf2\x89\xd5\xb6\x85\xa1\x36\xe2\xf8\x75\xa2\xde" +
+		"\x5e\xc4\xf4\x27\xc5\xaa\xb7\xc5\x98\xfc\x62\xa2\x11\xa1\xce\x04\x92\x98\x5f\x78\x97\x68\xf0\x17\xa2\xeb\x48\xc4\xe8\xaf\xc5\xec" +
+		"\x1a\x86\x4c\x77\x1e\xa5\x2d\x76\xfd\x4b\xfe\x70\xa5\x09\xbb\x92\x27\x65\x0f\xf8\x58\x9c\x2c\x2d\xa6\x97\xf5\x67\x59\xcc\xfe\x8f" +
+		"\x38\xf2\x82\x98\x12\x9e\x8f\x33\xf4\x72\xd1\xa7\x1e\x7e\xf3\xef\xb1\x0f\x45\xbd\x32\xa2\x5f\x47\x23\xc0\x49\xc3\x5e\x57\xa3\x82" +
+		"\x3b\xc4\xc5\xe7\xf8\x4f\x5c\xe0\x19\x6c\x66\x51\x31\xf1\x32\xd1\xd3\x90\x3d\xf6\x19\xd1\xe6\x25\xd1\xfb\x35\x31\xfc\xe7\x40\xd4" +
+		"\xed\x85\x6f\xdf\x1f\xfe\x96\x58\x1b\xf3\x3d\xae\x65\xc5\x5c\xc3\xec\xaf\x6f\x89\xdf\xad\x4d\x79\x37\x62\x80\x75\x76\xd7\x0b\xfc" +
+		"\x71\xee\xb3\xb7\x1a\x22\x02\xb1\xb1\x55\xe0\xbb\x01\xb7\xdd\x2e\x0e\xf7\x31\x18\x36\x23\x6a\x76\x0f\x92\x4d\xbe\x5d\x3a\x23\x46" +
+		"\xdc\x28\xf6\xbd\x24\x76\x5d\x2a\xf6\xbc\x24\x0e\xfe\x4b\x9c\x7a\x47\x9c\xaf\x89\xf8\xe5\x2a\xb1\xa0\xba\x68\x72\xa3\x68\x78\xa7" +
+		"\x68\x69\x87\x61\x7c\x26\x66\xde\x2e\x86\x18\x4d\x47\x8e\x45\x4c\xad\x22\x96\xb4\xc4\xbf\x41\xc2\x7c\xc1\x23\x9f\x88\xed\x77\x8b" +
+		"\x93\x8d\x11\xe7\x7e\x46\x0c\x2e\x29\xc6\xdb\x2a\xe7\x34\x4b\x1e\xb9\xd8\xc1\xce\x09\x7a\x50\x8c\xf8\xd4\x57\xa5\xbb\x0f\x44\x2c" +
+		"\x1f\x80\xef\xda\x39\x39\x1a\xf1\xd7\x28\xc4\xf8\x6c\x31\x7e\x0a\x62\x8f\x3d\x39\xf4\x46\xbf\x9b\x61\x98\x9d\xcb\xd9\x11\x31\x6e" +
+		"\x12\xe2\xb0\xfd\x37\x3d\x79\xe2\xe6\x0c\xe3\x92\xb6\xcf\x8b\x51\xb5\x10\x03\xd7\x22\x8e\x1a\xf1\xbb\x6c\x31\x24\xdb\xc9\x79\xd6" +
+		"\x1c\x66\x15\xe6\x5f\xc6\x90\x7c\x49\x45\xc3\x8b\xc5\x9a\x6b\xc4\xef\xc5\xc5\x16\x97\x9c\x2e\x2a\xb6\x36\x41\xb4\xb5\x37\x3d\x54" +
+		"\x13\x07\xad\xb0\xbc\xde\xb8\x70\xec\x0d\x62\xe9\xdd\xa2\xe1\x26\x44\x8b\x21\x88\xe6\x63\x49\xb6\x37\xdb\xc1\x3c\xf3\x96\x26\xf7" +
+		"\x5b\x8e\xbd\x40\x4c\x2e\xec\xfb\x0c\xdd\x0b\x04\x56\xec\x32\x94\x1e\x42\x2c\xb2\x93\xec\x0f\x20\xea\xfd\x81\x98\x62\x19\xa7\x84" +
+		"\xd8\xe2\x0e\x2c\x78\xcd\x1f\x73\xd0\xd7\x2c\x71\x75\x31\xc5\x76\xb5\xdf\x96\xdc\x88\xda\xb6\x85\xdd\x31\xdf\xbe\xd9\xa6\xc1\x7f" +
+		"\x88\x26\x36\x77\xfd\xca\x62\x89\xe1\x7a\xfd\xa6\xf0\x2d\x0e\x23\x2e\x13\xf5\x1e\x11\xdd\xac\x49\xf2\x73\x31\xe6\x11\x31\xc3\xde" +
+		"\xd6\xd0\x05\xb1\x71\xb0\xdd\x91\x4f\xcc\x7c\x58\x4c\x7e\x5c\x6c\xb5\x26\xfc\x1f\x44\x1d\x7b\xbe\x25\xe2\xb4\xc5\xa9\xa5\x7c\x4b" +
+		"\xde\xae\x62\xe2\xcf\x77\xc5\x98\xc7\x44\xdd\x67\xc5\xc0\x12\x62\x7d\x55\xd1\xe0\x47\x51\xfb\x76\xb1\xe7\x61\xd1\xd8\x64\x14\xb1" +
+		"\xe4\x69\x71\xa4\x1d\x62\x80\x1d\x52\xf3\x96\x6f\x98\xee\x60\x73\x1e\x5c\x98\x3c\xc4\x67\xf9\x07\xce\xcc\xd4\xbb\x49\x4c\x7e\x4b" +
+		"\x1c\xb7\x4d\x7a\xcd\x11\xf3\x8c\xf6\x5d\x9e\x11\x4b\xae\x15\x07\xec\x15\x48\x75\x03\x31\xae\x77\x90\x6c\xc5\xdd\x5a\x51\x4c\xfc" +
+		"\x41\x34\x69\x66\x78\xff\x40\xcc\x7c\x4c\x8c\xb0\x8c\xbb\xd1\xdb\x82\x92\x25\x88\xe3\x7b\x10\xe7\x22\x62\xd6\xeb\x62\x98\xad\x73" +
+		"\xc2\x72\xc4\xca\x8f\xc5\x50\xb7\x63\xb9\xb4\xd7\xd2\x23\x0a\x88\xdf\x2a\x88\xae\xf7\x89\x11\xee\x54\x9f\x4e\x81\x58\xd8\x0f\x51" +
+		"\x27\x22\x66\x23\xba\x3f\x21\x0e\x3c\x26\xe6\xd9\x76\xc2\x4f\x44\x53\x3b\x70\xcd\x1d\x66\x5e\x46\xb4\xaf\x26\x46\x3d\x24\xba\x0d" +
+		"\x42\xb4\x3c\x8b\x98\x74\xa5\xe8\x32\xc6\xfe\xfa\x48\xb4\xb6\x25\x4f\xea\x61\x02\x68\x87\xed\x46\xbd\xbe\x6a\x8b\x68\x67\x27\x85" +
+		"\x7e\xec\x75\xd6\x89\xdb\x44\xaf\x5b\xc4\x90\x9b\xc5\xb8\xe7\xc5\x74\xe3\xca\x5d\xbb\x11\x87\x8a\x8a\xbd\x76\xa6\x76\x51\x31\xd5" +
+		"\x88\x3e\x70\x26\xa2\xcf\xdb\xa2\x73\x5c\x34\xfd\x4a\x2c\xf8\x48\x0c\x29\x2c\xba\x2f\x33\x74\xbc\x2c\x26\x58\xfe\x26\x21\x3a\x14" +
+		"\xf3\x7b\x1b\x5b\xda\xfb\x6e\xbe\x16\x93\x0c\x79\xc7\xed\xf1\xdd\x77\x8b\x36\x83\x02\x31\xec\x7f\xa2\xd6\x8d\xc2\x30\xb3\xe6\x26" +
+		"\xb1\xf4\x19\xd1\xb3\x80\xd8\x5e\x51\xec\x2c\x2c\x46\x35\x0b\xc4\xc0\x8e\x41\x72\x3f\xc5\x5f\x16\xc6\xcf\x45\xb4\xba\x4c\xfc\x9e" +
+		"\x2d\x9a\x97\x14\xcd\x06\x1a\x54\x31\x71\x72\x1e\x7e\x0f\xf0\xbc\x6b\x7c\xf7\x7f\x63\x6b\xef\xfc\x49\x8c\x75\x5b\x77\xbe\x12\xbd" +
+		"\xbe\x17\x63\xfa\xe1\x4f\xea\x1b\x6c\x90\xac\xb4\x63\xda\x4a\x8b\xed\x09\x51\xdf\xa0\x58\x7f\x14\xb1\xb8\xac\x68\xf9\xb1\x7f\x35" +
+		"\xc7\xc6\xd7\x44\xab\xf7\xc5\xe4\x2a\x62\xc3\x8b\xbe\x00\xb1\xac\x23\xa2\x57\x2b\x44\xd3\xee\x88\x45\x76\x94\xc6\x4d\x62\x53\x42" +
+		"\xcc\xb6\x53\x5f\x1e\x10\xcb\xbf\x14\x0d\x4b\x88\xad\xee\xe0\xb8\x7d\x88\x26\xe7\x10\xdb\x3e\x17\x7f\x7e\x28\x3a\x76\x42\x1c\x79" +
+		"\xdb\xbf\xcd\xc8\x9d\x46\xb9\xbc\x35\x76\x20\x5c\x20\x4e\x9a\xf2\xea\x80\xd8\x79\xab\x58\x64\x07\x86\xd9\xcd\xdb\x8c\x2d\x96\x4f" +
+		"\x47\x9c\x2b\x2c\xf6\x54\xf4\xc7\x4d\x4c\x5c\x80\xdf\x68\xf7\x8b\x9d\xfe\x6e\x3b\x7d\xfe\x25\xce\x3d\x28\x9a\x3b\x34\xdd\xed\x4f" +
+		"\xd4\xea\x18\x15\xa7\x87\x20\xb6\xbb\x37\x1b\x19\xb1\xec\xa0\xe1\x0f\x44\x7f\x3b\xf0\xab\x16\x62\xc7\x1d\xa2\xc5\xdd\xe2\xd7\x8a" +
+		"\xbe\x95\x7c\xd2\x23\x62\x8d\xb9\xe2\xc5\x44\xe7\x1d\x88\x49\xbb\x6d\xa5\x51\xb1\xdb\x00\x69\x30\x3a\x10\x5d\x7f\x4c\x9e\x81\x73" +
+		"\xa0\x1d\xa2\x66\x33\xc4\xe6\x1b\xc4\x82\x39\xe1\xab\x3b\x7a\x57\xf5\xdc\xb8\xde\x4e\x8f\xb4\xad\xf1\x77\x89\x36\xee\xe8\x5d\x1b" +
+		"\x71\xde\x63\xa2\xfd\x2d\x62\xc7\x95\x62\xc1\xeb\xa2\xef\x73\xfe\xb4\xd6\xcd\xa5\x45\x13\x93\x8a\x82\x7e\x3b\xfc\xe4\x35\x46\xe0" +
+		"\xfa\x06\xb9\x1d\xb7\x55\xd9\x6f\x44\x3e\xfb\x3f\xd1\xeb\x42\x31\xf7\x0a\xdf\x1d\x3d\xd3\x6e\xa8\xf3\x9c\x58\x34\x14\x71\xf6\x34" +
+		"\x7e\x17\x66\x7f\xdb\x68\x65\x60\x54\x12\x43\x8a\x88\xc3\x65\x44\x9b\xfb\xfc\x4b\x15\x3a\xbf\x25\x7a\x7c\xe3\x8b\x27\xdd\xbf\x14" +
+		"\x73\x1b\x21\xd6\xbe\x2f\xfa\x14\x16\xb3\xee\xf4\x67\x61\x7f\x24\x7e\x6e\x61\xa8\x5f\x87\x58\xff\xa0\xa8\xfd\xa0\x7f\xa9\xce\xec" +
+		"\x7f\x88\x5f\x6d\x31\xad\xef\x13\xc7\xdf\x15\xe7\xbe\x10\xbf\x5d\xe4\x37\x5d\x8f\x29\xe1\x0f\xde\xb3\x97\xa2\xb5\x5e\x61\x94\xbd" +
+		"\x57\xcc\x6a\x12\x88\x9e\xbb\x10\xbf\x1b\x75\x6a\x0f\x43\x34\x1b\x80\xe8\x77\xbd\x73\xdb\x3b\xfe\x28\x16\x55\xf5\xe7\xe7\x9c\x59" +
+		"\x82\x68\x77\xa1\xdf\xbd\xb1\xfa\x42\xf1\xa2\x98\xfd\x82\xdf\xbe\x78\xdc\x3a\xab\xff\x99\xf4\xbd\xec\x35\x21\xbb\x6b\x22\xfe\xdc" +
+		"\x85\x3f\x55\x70\xe1\xd2\xe4\xeb\x4d\xec\xb5\x1f\x47\x8c\x0e\x0b\x2a\x8a\x0d\xff\x12\xe3\x8d\x58\x93\x9c\xd7\x14\x11\x47\x7b\x1b" +
+		"\xe9\xca\x8b\x96\x6f\x78\x89\x5a\x7a\x8d\x9d\xf9\x60\xe4\x79\xce\x17\x6c\x8f\x3f\x28\x36\xfe\x4f\xb4\x7d\xdf\xef\x65\x5a\xf7\x93" +
+		"\x68\xb6\x00\xd1\x73\x1e\x62\x9b\x1d\x82\xfd\xae\x6f\x3c\xff\xbd\x17\x62\xd3\x23\x62\xf5\x1a\x77\xd0\xba\x95\xca\x0b\x88\x01\x31" +
+		"\x61\x9e\x5c\xbf\xeb\xfc\xf1\x9b\x87\x5b\x22\x1a\xd8\xbc\xf5\x3f\x11\x4d\xec\xcb\xfc\xc5\xf6\xc0\x38\x83\xbd\x81\xb9\x56\xef\x25" +
+		"\xcf\x04\x34\x17\xee\xd4\xbf\xc5\xf1\x62\xa2\xb1\x1d\x01\x63\xf8\xef\xf1\xaa\xd8\xf1\x8d\x18\xf1\x86\x38\xf5\x5f\x5f\x7f\x9a\x9b" +
+		"\x25\x0e\x3f\x25\xc6\xdd\x29\x5a\x5d\x2c\xda\x46\xc4\x78\x9b\xe7\xc8\xbf\xc5\xd1\x85\x88\xfe\xf7\x8b\xa6\x4f\x89\x3d\xf9\x93\xe7" +
+		"\x8a\x4c\xac\x6b\xf8\x9a\x14\xbe\x76\x68\xf2\x33\x62\x8c\x9d\x25\x67\xbf\xec\xf4\x61\x5f\xc7\x2a\x62\xed\x77\x49\x93\xba\x63\x11" +
+		"\x62\x45\x63\xc4\x9a\x4f\x45\xa3\xae\x81\xd8\x5f\x29\x79\x74\x79\xff\xc7\xc5\xbc\x92\x5e\x90\x97\xec\x46\xd4\xf8\x2b\xb9\x9b\x78" +
+		"\x6d\x31\x71\xfa\x02\xb1\x62\x0c\xa2\xf7\x5b\xe2\xc8\x80\x20\x79\xde\xe4\xc8\x47\xc5\xb9\x5b\x45\xbf\x65\x88\x69\xef\x79\xe5\xf7" +
+		"\x4b\x07\xc4\xd9\x4a\xa2\xfe\xad\x62\xcc\xe3\xe2\xc8\x13\xe2\xaf\xfb\xfd\x8b\xf7\x66\xd9\xd9\x22\x1d\xf0\x2f\x81\x1b\xfe\xbc\xf8" +
+		"\xed\x65\x31\xe8\x2e\x31\xff\x60\xf2\x85\x54\x9d\xcc\xcb\xda\xb9\xd6\x68\x7f\xbd\x68\x6c\x9d\xa4\xf7\x8b\x75\x51\x71\xf2\x59\x31" +
+		"\x74\x42\xf2\x2d\x70\xdd\x2f\x17\xfb\x2b\x8b\x89\xce\x79\x3b\x80\x68\xff\x89\xf8\xd5\x7a\x51\xab\x88\x15\xb6\x24\xdb\x62\x3b\x39" +
+		"\x27\x79\xb0\xe9\xe1\x9b\x45\x6b\xc3\xd7\x96\x40\x0c\x36\x08\xc6\x3e\xec\x37\x94\xb4\x4c\x88\xf6\x43\x11\x87\xc6\x84\xc7\x1d\x8c" +
+		"\x7e\xce\x9f\xd0\xdc\xff\x23\xb1\xb8\x05\xc9\x5d\xe9\x1b\x9c\xea\x99\x8c\xa8\x57\xd9\x82\xe6\x40\x34\x3b\x86\x98\x15\x13\x3b\xca" +
+		"\x8a\x25\x77\xfb\x97\x09\x2e\x9b\x8d\xf7\x5a\x47\x5d\x2e\x46\xb7\x41\x9c\x77\x47\xaf\xdb\xa4\x2b\x8c\xd4\xbf\x0e\x43\x8c\xfa\x56" +
+		"\x6c\xbd\x57\x6c\x7b\xde\xbf\x4c\x67\xd7\x56\xc4\xb2\x1b\xc4\xe0\x5b\xc4\xa8\x5b\x44\xdd\x87\x3c\xb3\xd9\x1b\xeb\xda\x7c\x26\xda" +
+		"\x58\xe0\xfc\x91\x3f\x71\x6f\x86\xe1\x67\xcd\x6d\xbe\x2f\x6f\x65\x3b\x44\x07\x83\x70\x6d\x21\xbf\xcf\xa3\xd7\xcb\xe2\x55\x31\xe2" +
+		"\x5d\x31\xfe\x21\xd1\xb4\x9a\xdf\x9b\x6e\x67\xa9\xb5\xad\x20\x76\xfc\x28\xc6\xd6\x48\x1e\x88\x78\xce\x76\xc0\xd6\x32\x62\x16\x14" +
+		"\x4d\xba\xe1\x7d\x94\x11\xa5\xfc\x96\xe8\xbe\x0b\xf0\xdb\x1a\x6b\xae\x40\x2c\x7f\x5b\xfc\x56\x40\xec\xce\x12\xf5\xec\x2c\x10\x7b" +
+		"\x43\x45\x35\xb1\xfa\x2e\xf1\x97\x75\xe7\x94\x10\x2d\x2c\x08\x9a\x87\x98\x62\x88\x3b\x74\xa1\xa8\xbd\x92\xa4\xbe\x6c\x59\x25\x79" +
+		"\xda\x5f\xbf\xe7\xc4\x09\x33\xb8\x75\x02\x51\x2b\x26\xce\x5a\xa0\x67\x7f\x0c\x2f\x2a\xe6\x16\x10\x2f\x89\xa3\xee\xb0\xd3\x7b\x44" +
+		"\x8d\x3b\xc5\xd8\xfe\x88\x81\xb6\x9f\xc9\x40\x9c\xff\xa4\x38\x5c\x5a\x8c\xfc\x40\xec\xbd\x50\x34\xff\x23\xb9\xdf\xb9\xb6\xed\x65" +
+		"\xbf\x55\x1c\x2a\x23\xc6\x2f\xc5\x3b\x33\xbd\x46\x1b\x09\x2b\x89\xc5\xd9\xe2\xe4\x45\x5e\xa6\xed\xec\xe1\x51\x46\x9c\x8d\x77\x8b" +
+		"\x23\x8d\x10\xbd\xdf\xf3\x5e\x54\xd7\xd6\x36\xef\x71\x5b\x78\x63\x44\xff\x7f\x8a\xda\xef\x8b\x56\x4f\x8b\xe6\x36\x52\xa3\xd3\x88" +
+		"\xce\xa6\x0f\xda\x6d\xc2\xef\x5a\xac\x6f\x08\x6c\xf7\xaa\x98\x7a\x85\x38\xf7\x88\x98\x3c\x1d\x71\xc6\x0e\x5f\xb8\x52\x4c\xb2\x83" +
+		"\x03\xe7\x23\xc6\x57\x4a\x9e\xb5\xb4\xed\x5f\xe2\xc8\x21\xa3\xd8\x25\x62\xd8\xd7\xfe\xf8\xc4\x23\x25\xbc\x6c\x4f\xca\xef\x8f\x44" +
+		"\x58\x6d\x40\x99\xcf\xb6\xf4\x6a\x3f\xd6\x86\x0d\xf8\xf3\x8b\x9a\xd9\x3b\x9d\x0a\x88\x41\x65\x44\x87\x5b\x45\x8e\x18\xf6\x88\x58" +
+		"\x39\x01\xb1\x67\x3f\x62\x9d\xe3\xb0\x7b\x7d\xbc\xf6\x9b\xbd\x63\xeb\x1b\x31\xab\x82\x38\x3e\x31\xb9\x05\xaa\xff\x27\x62\x69\x39" +
+		"\x51\x3f\x2a\x0e\x56\xf1\xac\xb8\xe9\x39\x71\xee\x43\x31\xc4\xce\xd7\xbf\x51\x58\x55\xb7\xc9\x3d\x62\xd4\x28\x44\x0d\x1b\xbc\xfd" +
+		"\x07\xe2\x4f\xd3\x05\xf5\x93\xaf\xa4\x6b\xb6\x31\xb5\xbb\xe5\x18\xfe\xe4\xc7\xc2\xa2\x4f\x69\xd1\xd2\xae\xfc\x69\x26\xa7\x8a\x1d" +
+		"\xb7\x11\x88\xf3\x65\xc4\xb9\x7d\x88\x71\xd7\x89\x06\x97\x8a\x96\xf6\xeb\x34\x8b\xb3\x46\x64\xfb\xd3\xe5\x5b\x1b\x78\x75\x5a\x27" +
+		"\x5f\x2d\x66\xaf\x95\xe8\x96\x5f\x8c\x75\xbb\xf8\xec\x5c\xa3\x87\xc4\xe6\x9a\x88\x31\x35\x10\x4b\x0d\x3f\x8b\xed\x89\x9d\x0f\x8a" +
+		"\xed\x0b\xf1\x5a\x76\xe5\x13\xa2\x59\x7b\x44\x3f\x83\x78\xdc\xc7\xfe\x75\x73\x47\x22\xe2\x5c\x71\xb1\xa2\x9c\x38\x7c\x16\x71\x6c" +
+		"\x1d\x62\x62\x42\x8c\x58\x81\xd8\x7e\xaf\xd7\xc8\x47\xae\x16\x9d\xa2\x3e\x67\xb7\x23\x10\x7b\x6f\x16\xbb\xca\x88\x7a\xf7\x8b\x4d" +
+		"\x05\xc5\xf6\xd2\xa2\x66\x3e\xb1\xd7\xbd\x36\xa7\xaa\x68\xd5\x38\x10\x9b\x0e\x20\xda\x7c\x25\x56\xd8\xa6\x37\x73\xeb\x5f\xf4\xe6" +
+		"\x78\xc7\xcb\xa2\x8d\xed\x8a\xb1\xad\x54\xad\x10\xdd\xec\x94\xc3\xa7\x44\x3d\x03\xb0\xc1\x9b\xa2\xf5\x11\xc4\xf0\x7b\xc4\x8e\xc9" +
+		"\x88\x56\x31\x2f\xdf\xe6\x6c\xff\x5a\xc4\x1f\x13\x5c\x27\x9f\x18\x31\x02\xd1\xe4\x31\xd1\xb6\x25\x62\x96\xd1\xa3\xef\xbd\x62\x4d" +
+		"\x77\xc4\xa1\xb7\xfc\xfe\xf6\x09\x71\xb1\xde\xca\x85\x93\x48\x1e\x60\xf7\xc7\x66\xc4\x96\x84\x77\x0b\x7b\x74\x4e\xee\x14\x3a\x70" +
+		"\x91\xe8\xf7\xa9\x68\xf5\x80\x98\x53\x52\xd4\x89\x89\x1a\x31\xbf\xa7\xf7\x5c\x71\x9f\x59\xb1\x43\x47\x67\x5d\x97\x3c\x47\xa0\xff" +
+		"\x74\xc4\xcf\xb6\x2d\xab\x9f\x81\x18\x17\x53\x6c\x6d\x97\x89\x93\x15\xc5\xb4\x47\xc4\x92\xa1\x88\xad\x0f\x8a\x79\x0f\x8a\xf9\x06" +
+		"\xd4\xf9\x87\xc5\x84\x7f\x8b\x45\x5f\x8b\x1f\x45\xbd\x2c\x7f\xfc\x4a\x8d\x7c\xa2\xf9\xb5\xe2\xd8\xb3\x62\xd0\x84\x40\xf4\xb5\xed" +
+		"\xfc\xd7\x88\x6d\xf7\x89\x3e\x7d\x11\x87\xe7\x20\xe6\xd8\xa9\x05\x65\x44\xbb\xcf\x44\xe3\xef\x7d\x32\xeb\x6c\x75\x71\x68\x42\xf2" +
+		"\xf0\xf5\x69\x37\x8a\x01\x25\xfd\x01\x4d\xa6\xf4\xa6\xdb\x59\xed\x05\xc4\xb9\x62\x62\x89\x89\x4c\xa3\x9d\x88\x5d\x97\x8b\x3d\xb6" +
+		"\xab\xc4\xf0\xb0\xe0\x2d\xd1\xe2\x63\x9f\x6f\xda\x7d\xb3\x98\x93\x10\xe7\x7b\x92\x3c\xac\x65\xaa\xf9\xbb\xef\xf8\x4d\x4d\x3f\xdb" +
+		"\xcc\x36\xd3\xd1\x6f\xc4\xf2\x36\x88\x41\xaf\x8a\x7e\x39\xe2\x54\x59\xf1\xcb\x05\xe2\x5c\xb6\xd8\x61\x6f\xd5\x79\x4b\x8c\xbf\x47" +
+		"\xd4\x59\x8a\x58\x7c\xb7\x6f\xe8\x18\xf6\x8e\x58\x5b\x52\xb4\xac\x83\x98\xb4\x1a\x31\xb0\x9c\x38\xfa\xb6\x68\xf6\xad\x68\x7e\x1c" +
+		"\xd1\xe7\x2b\xb1\xf1\x65\xd1\x35\x9f\x38\xd8\x18\xef\x99\xf7\x2d\x2e\xce\x1b\xed\xd6\x05\xbe\x41\xa2\x47\x4d\x44\xdb\x52\x62\xd9" +
+		"\x32\xc4\x70\x03\xa4\x47\x25\xb1\xee\x0b\xb1\x2a\x2a\x9a\x7c\x2d\xc6\xfc\x47\x6c\xbe\xc3\x9f\x12\x52\xd2\x1f\xb3\x6f\x78\x1b\x52" +
+		"\x49\x1c\x7a\x4d\xec\x3d\x95\x0c\xff\x6f\x15\xbb\xcc\x54\x8c\xbf\x4a\x4c\x34\xd4\x24\x44\x27\xfb\xff\xe1\x3d\xf8\x2a\xf5\xf5\xe2" +
+		"\x7c\xdc\xf3\xfd\xe0\xc0\x67\xd3\x2f\x77\x34\x7e\x4a\xe4\x13\x5d\x11\x76\xea\x1d\xe2\x79\xf1\x9c\xd8\x82\x58\x35\xdc\xe0\xaa\x8f" +
+		"\x68\x6a\x91\xd9\xaf\x1d\x02\xb1\xe5\x09\x31\xe6\x42\x31\xe3\x62\x51\x2e\xec\x80\x9b\x14\x15\xf3\x23\xe2\x69\x71\xbb\x28\x2e\x2e" +
+		"\x16\x65\x1d\x10\xf9\xc3\x06\xf9\x7c\xe2\xc2\xb0\xd1\xd5\xb7\xca\x4d\x41\x5c\xed\xb7\x9f\x7d\xe9\xea\x2a\x15\xc4\xf7\xe1\x8e\xa9" +
+		"\xcb\xdc\xd0\x57\xb9\x55\xd7\xc0\x8d\xf9\x8c\x83\xf8\x8e\xb0\x58\x5f\x58\x94\x16\x85\xc4\x45\xc2\x50\xb7\x0b\x61\xb2\x9a\x10\x45" +
+		"\xc3\x46\x81\x67\x44\xdc\x3d\xdb\xc3\x77\x26\xbd\xe0\xf7\x9c\x4e\x2c\xe6\x16\x5b\x4d\x0c\x40\x0c\xf5\x9b\xe2\x8d\x9a\x1d\x2b\x8b" +
+		"\xd6\x53\x11\xe7\xae\x11\x0b\xed\x3d\xa5\x91\xb0\x84\x94\x5f\x1c\x8c\x88\xf5\x7e\x3b\xfd\xb7\xc2\xce\xdd\x45\x2c\x1d\xed\x7f\x99" +
+		"\x13\x78\x72\x4f\x4b\xe6\xd3\x9b\x27\xc4\xb1\x1c\x9f\x83\x1d\x33\x0a\x5f\xdf\xf8\xf3\x49\x31\x06\xd1\x0b\xf1\xb5\x78\x57\xcc\x7d" +
+		"\x4c\x34\x2f\xe8\x15\x89\x05\x2c\xc7\x11\x16\xc1\xaf\xf3\x02\xf8\x96\x98\x9c\x3c\x5f\xb4\x35\x62\xf4\xdb\x62\xec\xbb\xa2\x6d\x19" +
+		"\x71\xc6\xb7\x6b\xd7\x47\xcc\x7a\x4e\x34\x7e\x4a\x2c\xbf\x45\xac\xbe\xdd\x1f\x92\xe4\xf7\xb2\xdc\xec\x86\xd8\x32\x12\xd1\x25\xbf" +
+		"\xd8\x55\x56\xb4\xf3\x78\xe9\x8c\x28\xe1\x17\xdf\xcb\xcf\x75\xb7\xc8\x76\xca\x7c\xa5\x2f\x31\x1a\xc9\xab\x8b\x99\x96\x71\x46\x34" +
+		"\xc8\x0a\xdb\x7f\x9f\x17\xff\x15\x9b\x71\xe4\xac\x24\x1e\x17\x2d\xfd\x10\x0d\x7d\x95\xf1\xb7\xc0\xbf\x6a\xd0\x32\xd2\x65\x45\x6f" +
+		"\xc4\xf6\x6d\x88\x95\x5b\x7d\x77\xdb\xf6\xe4\x89\xd3\xcf\x84\x7b\x02\xba\x47\xbc\x13\x32\x0e\x07\xf8\x31\x42\x36\x69\x1d\x88\x55" +
+		"\x81\xf8\x54\xf4\x45\x74\xc8\x12\x43\x9f\x14\x1f\xbb\x67\x5f\x77\x8b\xbb\xda\x71\xca\xbc\xb6\xc9\x96\x8f\xd6\xfe\xff\x41\xb2\x4c" +
+		"\x9d\xda\x03\x54\xcc\x61\xcb\x75\x11\x45\xb2\xc3\x9e\xd5\x9a\x7b\x10\x35\x6c\xd6\xc5\x83\x10\xed\xf1\xf8\x7c\xd1\x47\x03\xe7\x7c" +
+		"\x5b\x18\x6b\x10\xe6\xb4\x5a\x12\xfd\x5b\xbf\x48\x4b\x99\x0d\xc1\x97\x35\x36\x2f\xf1\xf2\x56\x07\xf1\x95\xc8\x71\xfc\x3b\xd2\x77" +
+		"\x9c\x45\x44\xbd\x12\xa2\x76\x89\x70\xc3\xc6\x0e\x33\x51\xc5\xdd\x85\xb0\xe3\x28\x59\x49\xf0\x25\x85\x31\xed\xf0\x6f\x37\xe8\x12" +
+		"\x09\x1b\x66\x77\xe7\x13\x17\x88\x05\x11\xd1\x23\xe6\x63\x09\xdb\x7f\xff\x17\xe2\x60\xcc\xeb\x6b\x13\xfd\x85\x88\x55\x78\x1c\xde" +
+		"\xef\x28\xd4\x39\xf0\x99\x5f\x5f\x8f\x2f\xea\x62\xc4\x2e\xfd\xf0\xb1\xe8\x36\x3c\x9e\x97\x2c\xc5\xd7\x37\x4f\x17\x12\x77\x3a\xbc" +
+		"\xfe\x1c\xf8\x66\xdf\x06\x88\xf9\x41\xaa\xcc\x55\xd1\x01\x78\x89\x38\x76\xca\xf3\x4e\x7e\x5f\x30\x78\xc4\x93\xc9\xde\x1d\x84\x88" +
+		"\x09\xc3\x47\x8b\xc0\x73\xba\xdf\xcc\xf6\xbd\x13\xe5\x6b\x9d\x00\x5e\xee\x6a\x64\xbe\x40\x5e\x51\x20\x56\xfe\x5b\x94\x11\x59\xae" +
+		"\x4e\x52\x27\x2e\xd6\xc5\x1c\xfd\xfd\xb6\x92\x82\x22\xf9\xa2\xe8\x7e\x91\xb0\x59\xed\x2e\x87\x9b\x47\xc2\x9e\xd5\x6c\xf1\x89\x3d" +
+		"\x4f\x79\x07\xa2\x3f\xd1\xd0\x57\x57\xaf\x09\x1b\xed\x2f\x73\xe3\xdd\xe7\x06\xde\x8c\x17\x34\xbf\xf1\xab\x92\x78\xd6\xa9\xc1\x2b" +
+		"\xc3\xd2\x4f\x39\x87\xc1\x5c\xf1\x7b\x92\x21\xb2\xdc\x34\x57\x8a\x64\x9f\xf4\xab\x0e\xac\xdb\xc3\xf2\x8f\x6f\x7f\xda\x6d\x07\xce" +
+		"\xe2\x77\xd5\x1c\x6b\x82\xe8\xd8\x14\x71\x1a\xaf\x9e\x2a\x87\x6d\x41\xe7\x1a\xa7\xf6\xfd\x6c\x1b\x87\x37\xcd\xbd\xf3\x39\x2b\xba" +
+		"\x31\xea\x8f\xef\x3c\x91\x10\x37\x38\xbd\x55\x58\x0c\x42\x1c\x8e\xf9\xc3\xdb\xa6\x26\x7b\xa2\x5d\x54\x92\x64\x3f\x3b\xf5\x04\xb1" +
+		"\x37\xe2\x5b\x0b\x72\xdd\x6a\x2e\x75\x38\x6a\x88\xd3\xbe\xf5\x7d\x5f\x62\x09\x9f\x1c\xcf\x1f\x56\x4a\x13\x62\x41\x13\xcf\x84\xbe" +
+		"\x0e\xe9\xf2\x7e\x84\x15\xec\x02\xe2\x0c\x9e\x98\x89\xb0\x33\xc7\x6f\x79\xb8\xd2\xd5\x89\xfd\x86\xa4\x2b\x1c\xa2\x5d\x0f\xb5\x25" +
+		"\x38\x1e\x15\x0d\xef\x70\xd7\xfe\x2d\x3a\x21\x56\xb5\x27\x2c\xb9\xc7\xbd\xc2\xec\xed\x6f\x6d\x87\xe3\xd1\x22\x8e\x04\x15\x1d\x8a" +
+		"\xaf\x14\xb3\x0b\xb8\xff\x15\x77\xe0\xdf\xe8\x2e\xfb\x22\xf0\xfe\xff\xb8\xa9\xed\xc0\x02\x3b\x8d\xbe\xa2\xd8\xf8\x81\x47\xf5\xd9" +
+		"\xc0\x2b\xdf\xf1\x78\xed\xbc\xe9\x65\x05\xdd\xc6\xa4\xda\x3e\x7c\x8d\xbb\xf1\x15\xfe\x4d\xb8\x87\x77\x20\x86\x5e\xef\x14\x63\x23" +
+		"\x9c\xa1\xbb\xd5\x71\xc5\x76\xdf\xda\x7a\xbb\x68\xfb\xa6\x38\x8a\x97\xfe\x87\xc4\xac\x5b\xfd\x19\x47\xb5\x93\x7d\xa1\x3d\x92\x8d" +
+		"\x9e\xb9\xa2\x63\xc4\xd7\xa2\xaf\x71\xd0\x6f\x77\x45\xb4\xee\x5e\x09\x4c\xc2\xe7\x1b\xa2\x8a\xb4\x79\x38\xa3\xff\xe5\x3e\xc7\x9d" +
+		"\x31\x1f\x53\xf6\x8c\x85\xfb\x37\xef\x72\x7a\xd7\x37\x9a\x5d\x28\x1e\x73\xfc\x77\xaf\x78\xd8\x99\x89\x97\x9c\xaa\xcb\x72\x4f\x5f" +
+		"\x9f\xdc\xe7\x16\x94\x75\x5c\x38\x23\xe1\xd3\x79\x37\x85\x25\xcb\xbb\x1d\x9d\xcf\x65\x89\xf6\x71\x67\x3c\x1f\x75\xb4\x6f\x80\xbb" +
+		"\xb4\x2b\xea\xdf\xe7\x3c\x34\xe6\xdf\xc7\x55\x5c\xd4\x8a\xfa\x5d\xcf\x9f\xb8\x39\x3b\xc4\xc4\xcc\x98\x53\xcb\xbf\x45\x45\xd7\xa8" +
+		"\xb0\x7a\xd7\x06\x84\x15\x9f\xe2\x62\x76\xcc\x41\xb0\x31\xe6\x9b\x3e\x9b\x22\x16\x19\x2b\xfb\xf6\x6d\xaf\xb8\x5f\x74\xc0\xdf\xa4" +
+		"\x60\x73\x54\xcc\x8d\x39\xb4\xe6\xba\x75\x58\x9f\x33\xde\xf8\x3c\xe2\xe8\x7f\xa5\xdd\xc7\xd5\x0a\xbe\x71\xec\x94\x5f\x74\xf0\xbb" +
+		"\x2c\xde\x70\xf4\xb8\x58\x34\xf1\x18\xbd\xd2\x9b\xd6\x92\x9e\x02\xd3\x03\x4f\xbf\x7f\x3a\x96\xc8\x75\xbc\xe2\x13\x22\x43\x03\xb1" +
+		"\x02\x05\xf9\x1d\xa6\xcb\x8b\x5b\x1c\x92\x8b\x79\xc1\xf5\xcd\x35\xeb\x93\x6c\x38\x1a\xef\xc5\x8f\x44\x9c\xc7\x0d\xf5\x8e\x43\x71" +
+		"\x19\x03\x89\x72\xde\xa9\x19\xff\xae\xbd\xc8\x90\xb0\x7d\xc8\xcb\xf2\x3c\x44\x17\x4b\x93\xf8\xcd\x25\x26\x58\x7b\x03\x6f\xf9\x96" +
+		"\x05\x4e\xdb\x8c\x8b\xf8\x1c\x4f\x9b\x88\x4f\x95\x5e\xe0\xbd\xb8\x21\x7e\xdb\xf0\xbb\xce\x59\xca\x0e\xbb\x6a\xa3\x8e\xe8\x1e\xd4" +
+		"\x9b\x1c\x1a\x26\xe1\x91\x9b\x1b\x6e\xdc\x2a\xe5\xd8\xd9\xef\x70\xba\x57\xec\xeb\xe6\xb1\x61\x40\xa2\xa0\x81\x37\x77\xcf\x1b\x70" +
+		"\xc1\xbb\xe1\x36\xd9\x81\x85\x9d\xf0\xfd\x56\x59\xbc\xec\x98\xfd\x1a\xc7\x5f\x2f\x89\xd5\x8f\xf8\xf3\x20\x22\x5e\x4c\xb3\xdc\x6b" +
+		"\xd4\x83\x5c\x07\xb9\xbd\xd8\xca\xd0\xb3\xc0\x3e\xca\x3b\xba\x64\x3b\xe4\x5c\x12\x02\xe2\x2b\xdd\x1d\xef\x15\x0f\x88\xf3\x11\x31" +
+		"\x21\xea\x66\x30\x67\x67\x4f\xc4\x6b\x91\x0e\x81\xf7\x1b\x5a\xe1\x75\xd8\x15\xa2\x76\x79\xb1\xc4\xde\xd2\x86\x7f\xad\xed\x5e\x8f" +
+		"\xd4\x0f\x45\xaf\x40\xfc\xe0\xfe\x28\xe8\xb5\x44\x71\xd1\xc8\x4f\x34\xa5\xb4\x98\x7f\x99\x3f\xb5\x36\xe1\x08\x6d\x2d\xc8\x3b\x6a" +
+		"\x22\xf6\x55\x17\x7d\x7d\x0b\xde\x5f\x37\x89\x2d\x55\xc5\x77\x9e\x61\xae\x77\xb2\xd3\xc8\x8b\x93\xbf\xe3\x62\x37\x43\x33\xbf\xd8" +
+		"\x6d\xbe\xed\x62\xde\x2d\xa1\x44\x59\x03\xe7\xc5\xa1\x55\xf3\x5d\x6a\xbe\x11\xc6\xab\xbc\xc3\x84\x1d\x6f\x47\x1f\xf7\x07\xbe\x58" +
+		"\x06\xbc\x5b\xb9\xb0\x21\x34\xea\x1e\xfd\x2d\x22\x56\xbb\x93\xd3\xea\x22\x3a\x25\xcf\x26\xa8\xec\x6c\xde\xc5\xfe\xbd\xf7\x11\xbf" +
+		"\xd3\xe1\x02\x5f\xfb\x33\x66\x38\x14\x78\xf7\x29\xd5\xa0\xb4\x8f\xb0\x9d\xe4\x13\xb7\x9d\xc8\x6f\xc2\xf3\xfd\x17\x45\xc3\x2d\xde" +
+		"\x57\x39\x3e\xa9\xea\x1d\x93\x01\x84\x1d\x93\xbe\xbb\xd6\xf5\x55\x27\x29\x99\x2f\xd4\xf5\xc5\x1c\x88\xfe\x0c\x03\x6f\x03\xca\x87" +
+		"\x5a\xe9\x6a\x31\xb7\x48\xd8\x18\xb7\xbd\xa4\x58\x9d\x3f\xec\xfc\xb0\x78\xa9\xa8\xd8\xe7\x1d\x94\x26\x59\x62\x60\x41\x37\x68\xe3" +
+		"\x17\xc4\xe8\x1b\x45\xf7\x6c\xb1\x69\x09\xa2\x4b\x7b\xc4\xf9\xd2\xa2\x5b\x6d\xc4\x0a\x73\x62\xc7\x19\x59\xfb\xd9\xb7\xb5\x06\xe6" +
+		"\xe2\x07\x45\xd3\x3b\xc5\x8e\xef\xfc\x79\x99\xcb\x8b\xfb\x83\x57\xc7\xcc\x40\xf4\xf9\x44\xfc\xf5\x9d\xa5\xd2\xac\x12\xbb\x02\x71" +
+		"\x70\x19\x62\xf4\xc3\x62\xf1\x7d\xa2\x41\x1b\xbc\xcb\xdb\xf4\x4d\xf1\x6b\x75\xd1\xa2\x33\x62\x75\x37\xf3\x0f\xed\x89\x11\x93\x10" +
+		"\x27\x6f\x13\xcb\xed\xe5\xca\xe5\x44\xa3\x09\x88\x95\x76\xd6\xed\xad\x62\x5e\x96\x98\xf9\xa9\xe8\x91\xe5\xdf\xd0\xd8\xaa\xac\xbd" +
+		"\xf4\x36\x10\x0b\xea\x07\xa2\xf6\x38\xc4\x94\x67\xfc\xcb\x00\xdc\xa9\x3e\xcb\x5d\xfd\xd3\x15\x10\x5d\xf1\xb7\x65\xe0\x8f\x96\x6a" +
+		"\x79\x8d\xe8\x90\x5f\x4c\xfb\x4e\x0c\x29\xe4\xcf\xbd\xaa\x53\x51\x1c\xbe\x57\xd9\x3f\xaf\x0b\x14\x6c\xf8\xde\x51\xe7\xc8\xd7\xe1" +
+		"\x16\xa2\x8b\x7d\xca\x30\xa1\xa8\x79\xf7\x27\x3c\x69\x13\xe2\x0d\x47\xd7\xe1\xe8\xb2\x5e\xd9\x2a\x58\xd6\xf5\xf1\x3f\xa1\xe8\x23" +
+		"\xce\xe6\xd8\x9b\xc9\x4e\xdd\x62\x4e\x52\xbe\xb8\x38\x92\x4f\xc1\xaa\x88\x22\xae\x43\xcb\x04\xae\x58\x72\x4f\x0a\xbf\x0c\x88\x8b" +
+		"\xed\xbe\x23\xa5\xee\x69\xeb\xcc\xb2\xb7\xc0\x3c\x24\x7e\xaf\xae\x60\x3a\x0a\x0c\xb1\xfb\x23\x0a\x86\x06\xca\x5e\xf5\x8b\x6b\x41" +
+		"\xcd\xf5\x5b\xde\xd6\x8c\x8b\x2b\xc7\x50\xd8\xec\xdb\x70\x8b\x80\x33\xb4\x91\x42\xe9\xa3\x12\x52\x7b\xe4\x92\xff\xb2\xd3\xcd\xb1" +
+		"\xc9\xee\xf3\xf0\x78\x84\xa8\xdb\x56\x13\x77\x0f\x66\xbb\x3e\xd8\x48\xaa\x97\x34\xc7\x35\xb0\xb8\xdb\xfc\xe9\x08\x11\xd7\x0d\x13" +
+		"\xf5\x3d\x8f\xc5\xd2\x1d\xa8\x91\x42\xe1\x80\xee\x33\x39\x57\x46\x47\x2e\x51\x65\xb9\x71\x62\x7e\xcc\x44\x72\x23\x5f\x24\x1e\x6e" +
+		"\x0a\xca\xf8\x17\x8b\x87\x20\xe5\x24\x7f\x49\x76\xcb\x85\x4d\xbf\x1e\x87\xd1\x70\xef\x1f\xa9\x76\xa2\x70\x04\xdf\x0e\xfb\xf7\x3e" +
+		"\xe1\xdc\xe4\xe0\xf9\xdc\x2f\x51\x77\x4c\x44\x2c\x3b\x7c\x30\x37\x79\x5b\x90\x48\xce\xe5\x9b\x7e\x23\x7f\x6b\x48\x4e\x64\x74\x11" +
+		"\x87\x1b\x38\x22\xee\x97\xfc\x85\xd2\xfb\x12\x23\x21\x2a\x62\x61\xf3\x70\xc2\xad\x28\x2b\xea\xda\x9e\xa3\x8a\xba\x67\xa3\xee\x77" +
+		"\xbb\x1a\xae\x22\x48\xb8\xa6\xaa\xec\xe4\x32\x7d\x43\x55\x24\x9a\xec\xa6\xf2\x8d\xd0\x11\x37\x54\x24\xdb\x06\x49\x41\x18\xcd\x4b" +
+		"\xee\x68\xc6\x86\x92\x78\x08\x40\x56\xd8\x2b\x9c\x5c\x63\xb8\xf6\x48\x06\xb1\x62\x29\x8c\x85\xc7\x6b\x50\xc8\xee\x0f\x12\x49\x90" +
+		"\xa2\xf1\xb0\x8b\x3b\xc4\x70\x92\x64\x8e\x49\x22\xee\xce\x34\x0b\x85\x77\xe6\x0b\xe9\x92\x82\x27\x13\x99\xe4\x26\xb9\x22\xb9\xea" +
+		"\x78\x92\x1c\x91\xcc\x93\x3d\x52\x5b\xc5\x1c\x1d\x03\x77\x83\xed\x33\x09\x7b\xa0\xd3\x27\x81\x84\xd4\xf7\x97\xb2\x42\x20\x23\x6e" +
+		"\xc9\x9e\x79\xb2\x3c\xd2\x0a\x29\x92\xab\xec\x44\x06\x1e\x32\x90\x19\x84\xd2\x94\x62\xec\xfc\xe1\x8e\xb5\x48\xc2\x21\x3f\x24\x6e" +
+		"\x34\xdc\x5c\x1c\x64\x80\xe1\xb1\x94\x15\xcd\x38\xc6\x24\x9a\x94\xa0\x20\x64\x95\x64\x3f\x70\x34\xdc\xa4\x16\x8e\x99\x66\xc5\xa8" +
+		"\x35\xc0\x07\xe1\xf7\xd4\x36\xe1\x48\x3c\x8d\xfc\x68\x8a\x07\x12\xb6\x9c\x54\xe7\x7c\x24\x94\x62\x7f\x16\x46\x4a\x2a\x93\x98\x2f" +
+		"\x12\xfe\x92\x13\xce\x9e\x13\x9e\xaf\x12\x4f\x43\x95\xda\x99\x14\xf5\x0f\x16\x09\x1b\xe9\x43\xa6\xf2\x1b\xf1\x82\x0c\x49\x8f\x66" +
+		"\x74\xa2\x7b\x4e\x8b\xa5\x76\x69\x47\xd3\xbb\xfe\x08\xd1\x12\x64\x67\x34\x70\x3b\xb0\x03\xd7\xbf\x19\xcf\x90\xeb\x54\x83\x7b\xcc" +
+		"\x8d\x19\x4f\xd1\x28\x91\x86\xd0\x3f\x1b\xcd\xe4\x3d\x37\x51\x34\xe4\x84\x58\xc6\xa2\xbc\x86\x8c\x27\xc2\x0e\xbf\xa8\x71\x60\x24" +
+		"\xdc\x69\x1c\x89\xa6\xf9\x24\xc8\xd4\x2a\x89\xbf\x6b\xaa\xa4\xa8\x26\x92\xb3\xe7\x51\x44\xbe\xbb\xdb\x83\x9d\x08\xfb\x25\xbd\x10" +
+		"\x65\x87\xc8\x89\xa7\x19\x35\x88\x86\x7b\xa5\xe2\xc9\xa1\xb2\xa3\xf6\xa0\x1f\x3f\x7d\xee\x4d\x22\xa9\x96\xd3\x13\xc5\x93\x9b\x14" +
+		"\xa2\x19\x52\x19\x89\x26\x15\x9d\x17\x6a\xaf\x27\xa3\xf1\xa4\x6a\xf2\x6b\x89\xa6\xb0\x11\x8a\x61\x3c\x43\x4e\x3d\x60\x91\x44\xf2" +
+		"\x52\x34\x6c\xdc\x4c\x29\xa5\x68\x08\x4c\x2c\x9a\x66\x80\x14\xf7\xc6\xbc\x84\x16\x49\x63\x23\xfd\xac\xdb\x8e\x11\xcd\xb1\xcf\x2c" +
+		"\x07\xb9\xe7\x3d\xa2\x7f\x87\x3f\x2b\xd5\x1b\xef\x3e\x83\x90\xa9\x12\x19\x73\x25\x37\x9b\xa5\x34\x5b\x3c\xa9\x37\x82\xd4\x5e\x0f" +
+		"\xc7\x36\x51\x77\x35\xd7\x7d\x4f\x6f\x54\x73\x44\x8c\x84\xd4\x4c\xc4\xff\xce\x42\x11\x07\x43\x2c\x94\x88\x84\xc3\x61\xd4\xe9\xd2" +
+		"\x4c\x85\x93\x2f\x83\x49\x22\x79\x2d\x08\xf1\x50\x79\x7a\xd2\x87\xb7\xc5\x52\x4b\x48\x64\x28\xb7\x4c\x09\x0d\x9f\x4a\x8a\x49\x38" +
+		"\x23\x89\x8c\xef\x39\xe9\x46\xdb\x94\xaa\x8c\x66\x6c\xaf\x8d\x84\x8a\xc5\x9b\x1b\xcf\xd5\x11\x2f\x14\x89\xa4\xfe\x4c\x11\x3d\x2b" +
+		"\x1a\x5e\x0a\xc9\x91\x12\x99\x48\x54\x39\xd1\x3c\x4a\x32\x28\x14\x6a\xbf\x90\xac\x29\xf6\xc8\x0a\x01\x88\xa5\x06\x8c\x2a\x3b\xc4" +
+		"\x9b\x27\x8d\x37\xf1\x81\x33\x55\x45\xdc\xef\xf9\xe3\x49\x18\x92\x62\xeb\x8d\x45\x3c\x8d\x2b\xaf\xc3\x23\xd1\x3c\xe8\x8a\x85\xf6" +
+		"\x31\x45\x94\x7c\xa1\xe4\x26\x32\x1e\x0c\x1c\x1d\xf3\xa7\xb0\xe4\x4e\x60\x08\xbc\xd4\x67\xe7\x51\x32\x69\x43\x9f\x48\x6a\xf5\xd4" +
+		"\x92\x4d\x67\x16\x4a\x2e\x87\xec\xe4\x2e\x63\x42\x5f\xc2\xd8\x20\xb5\x67\x39\x37\x0f\x2d\x82\x9c\x8c\x91\x73\x43\x0a\x26\xf2\xaa" +
+		"\x56\x2f\xc2\x29\x91\x2c\x93\xe4\xed\x48\xa6\x5a\x2b\x94\x56\x32\x59\xe1\x83\xb1\xb0\xa9\x3a\x69\x0a\x0b\x39\x6d\x13\xcd\xe3\x7d" +
+		"\x45\xe3\x69\x3c\xc4\xc3\x7f\x59\x19\x3a\x2d\x88\xa6\x99\x39\x45\xd6\xc0\x71\x7e\x2c\x63\xab\x76\x2c\x9a\x21\x38\x4e\x0c\x13\xa1" +
+		"\x41\x4c\xa9\xca\xfc\xa1\xe3\x11\xf5\x3c\xe3\x6e\x28\x18\x4d\x8b\x7f\xdc\xff\x19\x4f\x5a\x90\x58\xc6\xa4\xf1\x68\xfa\xd8\x2e\x6f" +
+		"\x98\x82\x42\x4e\xbd\xc4\xd3\x8a\x31\xe5\xc0\x64\xe5\x26\xd7\x98\xd2\x9f\xde\x51\xb1\x71\xb2\x43\x46\x0a\x8d\x72\x56\xc2\x2d\xc4" +
+		"\x63\xa6\x58\xf2\xa9\x48\x3c\xb9\xb5\x21\x49\xac\x44\xda\x0b\xb2\x3f\x73\x4c\x03\xc7\x1d\x95\x3d\x93\x47\x43\x8f\x34\x1e\x4d\x1f" +
+		"\xd6\xe1\x1d\xef\x48\x5e\x3f\x36\x08\xa5\x8c\x4c\xd5\x9d\xe1\x2e\x66\x3a\x75\xe9\xab\x71\xb3\x41\x91\x4c\xab\x17\x4f\xcb\x6c\x5a" +
+		"\x3d\x7a\x54\xe4\xa6\xf5\x61\x12\x2a\x07\x6a\x3c\x83\xd6\xb1\xd4\x5e\xdc\xb8\x63\xa4\xd0\xb1\x0c\x32\x06\x8f\x64\x18\xfa\x48\x89" +
+		"\xb4\xb3\x91\xfa\x34\x35\xe2\xa8\x10\x0b\x51\x9d\x95\xc8\x23\x2f\x91\x90\xdc\x81\x17\xab\x78\x9a\xd5\x53\xe2\x9f\xe2\xc0\xf4\xea" +
+		"\xe2\xe9\x11\xd2\xae\xa0\x13\x8d\x44\x3c\x69\x23\x22\x19\xf6\xd7\x04\x24\x91\xdc\xe3\xec\x5d\xe8\x68\xc8\x99\xb1\x44\x72\xf6\x20" +
+		"\xf4\x8e\x12\x21\xa7\x25\x42\x75\x9a\xf6\xca\xa2\x7f\xb7\x17\x41\x86\xf8\x67\x27\x92\xa1\x8a\x7f\x2a\x16\x8e\x93\xda\xba\xe8\x8d" +
+		"\x4b\x3c\xb4\x14\x51\x27\xe9\xf9\x42\x01\x21\x65\x76\x43\x97\x2f\x96\x08\x83\xf8\x9c\x90\x49\x52\xe7\x12\x64\xfc\x0b\xdc\x5c\xe4" +
+		"\x84\x91\x45\x48\xac\xac\x14\xa5\x8a\x64\x18\x6b\xef\x82\x3a\x30\xbc\x1f\x15\x89\x27\x9d\x5b\x8f\xea\x74\x1c\x97\x72\x90\xc2\x18" +
+		"\xcd\x7e\x29\x14\x8e\x99\x93\x36\x85\x3e\xee\x88\xc6\xd3\xb1\x49\x52\xd3\x16\x4b\x8b\x7f\x3c\x25\x35\x29\x6d\x93\x6b\xa8\x4e\x86" +
+		"\x0f\x89\xb4\x58\x05\x29\x6f\x27\x91\xde\x92\x14\x09\x23\xc1\xb4\x99\xcb\x4e\xbb\xfd\x99\x11\x5c\x24\xd3\xa8\x65\x27\x97\x13\xc9" +
+		"\x74\x02\xe3\x79\x24\x2e\x69\x55\xb3\xed\x52\xd2\x15\x8f\x67\x18\xc4\x50\x7c\xe2\x19\x0e\x4c\x4a\x46\xc8\x49\x8a\x73\x6a\x8a\xac" +
+		"\x8c\x70\x20\x75\x73\x3c\x44\x54\x22\x14\x6a\x1f\x08\x47\x12\x69\xf7\x86\x8c\xed\xfb\x49\x77\x3a\x23\x88\x48\x49\x74\xe4\x6f\x01" +
+		"\x6f\xc8\x30\x41\x86\x2c\x44\x32\xfc\x84\x20\xaf\x8a\xf0\xeb\x8d\x3a\xd4\x45\x43\x6d\x10\x84\x81\x73\x4a\xac\x22\x19\x26\x26\x49" +
+		"\x91\x4c\x35\xe5\x0c\xa5\xf7\x10\xfe\xdf\x30\x3c\xc8\xd0\x3f\x29\xb7\x33\x1e\xfe\xe8\x07\x89\x66\xa7\x15\x48\x22\xbc\x14\xe4\xb8" +
+		"\x94\x42\x6a\xff\x4f\x5e\xc5\x15\x09\x79\x20\x1a\xff\xbb\x55\x8a\x85\x2a\x25\x2b\x8c\xa4\x82\xdc\xbc\x64\x4a\x99\xfb\x44\x7a\xe4" +
+		"\x48\x86\x20\x7b\xeb\x19\x4b\x99\xd1\xd0\x60\x25\x7f\x0f\x57\x9d\x93\x11\x5e\xc5\xf2\x1a\xbe\x94\xf9\xf0\xa3\x91\x1d\x5e\xca\x58" +
+		"\x45\x3c\x33\x72\x09\x95\x5b\x90\x89\xb4\xd4\xd6\xce\x94\x86\xcf\x4e\x2b\x55\x9f\xd3\x88\x46\xf3\x86\xb4\x21\x0f\xe7\xf1\x3c\x13" +
+		"\x19\xec\x94\x31\x72\xc2\x4d\x9d\xed\xf4\x3f\x89\xb4\xf9\x4e\xab\xf1\x84\x12\x99\xe8\xcd\x4d\x5b\x13\xaf\x51\xb3\xa2\xc9\xbd\x58" +
+		"\xde\x8c\xa6\x35\x83\xcf\x02\xb9\xad\x83\x89\x94\x3f\x13\x26\x28\x22\xa1\x2f\x97\x9a\x2e\x9a\x61\x7c\xb3\x33\xcc\x77\x3a\x0a\xc8" +
+		"\x4e\x12\x22\x12\x5e\x8a\x84\x52\x19\xcd\x48\x6b\x90\x6d\x44\x49\x29\xed\x48\x46\x48\xeb\x15\x60\xe2\x6f\x36\xeb\x6f\x6a\x3c\x9e" +
+		"\x26\x5f\xa6\x64\x45\xb2\x93\xfa\xca\x3b\x9f\xa9\xf3\xb5\x52\x14\x8f\xa4\x62\xd8\x9c\x30\xa9\x12\x4d\x6e\x5d\x8b\x66\xac\xdd\x0b" +
+		"\x4e\x22\xb5\xe4\x78\x1e\x1f\x29\xa5\x22\x82\x44\x5a\x00\x53\xbe\x68\x24\x1c\x21\x7f\xa8\xc9\x23\xe1\x02\x53\x9b\xca\x83\x8c\xf5" +
+		"\xc6\xe2\x19\xce\x43\xb8\xfc\x82\xa1\xbf\x91\x15\xce\x1e\x0f\xaf\x26\x42\x0e\x0f\x52\x8e\xb7\x93\xc1\xec\x8c\xf1\xa3\x79\xf3\x12" +
+		"\x29\x8f\x2b\x28\x94\xcc\x08\x11\x26\x9d\xbc\xe2\x4a\x05\x7a\x64\xa0\x3d\x96\xf2\xca\xb2\xd3\x1a\x20\xa5\xe4\xc9\xc8\xcc\xc4\x3c" +
+		"\xae\xe2\x69\xfd\x99\xca\xa1\x45\xc2\x29\xc8\x0e\x4b\xf9\xd9\x69\xf6\x0b\xc2\xd4\x87\xe7\xfc\x58\x3c\x2d\x7a\x81\xf3\x7b\x63\x89" +
+		"\x3c\x29\xbe\x48\x86\x5b\x98\xda\x43\x1d\xcd\x9b\xc9\xf1\x5b\x25\x83\x78\x98\xae\xc9\x49\xea\xba\x64\x1c\x9a\x93\xce\x96\xa4\x45" +
+		"\x38\x3b\x4f\xf0\x9e\xa9\x01\x52\x39\x87\xcc\x30\x39\xc8\x30\x6d\xe9\xbc\x6b\x0a\x99\xd9\x79\x94\x7f\x10\xea\xcf\xa4\xde\x48\xa4" +
+		"\xd5\x7b\x90\xe1\x53\xc5\x52\xba\x22\xee\xd4\x60\x48\x85\xb8\x47\x6c\x76\x32\x08\x25\x9a\xc7\x16\x67\xe6\x24\xbd\xc6\x4e\xa4\x32" +
+		"\x81\x89\x8c\x20\x3a\x25\x47\xd9\x79\x3c\x84\x14\xbd\xa2\x61\x9c\x9b\x99\x05\xf2\xd1\x59\x6e\x3c\x4d\xfa\x58\xc8\xae\x91\xff\x47" +
+		"\x2a\x33\xf5\x58\xda\x7d\xcd\xfb\x25\xd3\x2b\x4b\x91\x29\x73\x90\x58\x22\x4f\xdc\xe1\xa5\x2f\x16\x66\xba\x92\x87\xc1\xa5\xdc\xf5" +
+		"\x0c\xeb\x16\xc9\x64\x8f\x68\x5e\xd5\x9d\x9d\xc7\xae\xa5\x39\x39\x5b\x89\xd0\xc9\x4f\x19\xd0\x68\xa6\xa3\xe8\x1e\x8f\x65\x27\x9d" +
+		"\xc3\x20\x23\x8f\xe4\x9d\xc3\x44\x76\x7a\x96\xe0\xff\xf2\x1f\xc8\x10\xed\xac\x4c\x2d\xea\xfe\x65\x65\x58\x6d\x8f\xc0\x68\xca\x87" +
+		"\x49\x24\x69\x91\xce\xf2\x65\xc8\x97\xcf\x2d\x14\x08\x8f\xbe\x4d\xf2\x40\x22\x99\x62\xf2\x8a\x2b\x93\x7b\xbd\xc7\x95\x5a\x5a\xc4" +
+		"\xc5\x6b\xb1\x78\x1a\xc8\x78\x22\xf9\x54\x34\xa1\x5c\x3f\x60\x46\x42\x26\x4f\x06\x20\x4c\xe9\xa4\x8c\x42\x3c\x43\x1d\xa5\x88\x98" +
+		"\x95\x39\x7b\x34\xad\xd9\x08\xed\x72\x24\x9a\xe7\x2c\xb0\xa8\xf3\xe7\x63\x19\x89\xd0\xac\xd0\x03\xcf\x8e\xa6\x6d\x0d\xa9\x34\x7b" +
+		"\x21\x77\x48\x48\x3c\xed\xe6\xe5\xf1\x24\xc3\x62\x41\x10\x4f\x73\x85\xcf\x59\x91\x41\x85\x44\x0a\xed\xd1\xf4\x19\x23\xf1\x6c\x53" +
+		"\x11\xd1\xe8\xff\xa1\xf3\xe3\x5e\xe7\x14\x4b\x46\x7f\xf1\x68\x7a\x8d\x91\x30\xaf\x12\xc9\x74\xea\xc2\xef\x5e\x52\xb2\x32\x78\x2c" +
+		"\x91\x72\x89\xb3\x33\xe2\x82\x78\xda\x0f\xcc\xf4\x82\x52\x40\xa6\x42\xe9\x54\x00\x15\xc9\x88\xb3\x62\x19\xd9\xe3\x68\xde\x02\x81" +
+		"\x87\x33\x7f\x5e\x03\x91\x2f\xcc\xb4\xfc\xdd\x0f\x74\x3c\x9f\x8a\x6b\xf2\x65\xe6\x5a\x43\x3f\xdc\x8b\x67\x3c\xf3\x7c\x95\x0c\xeb" +
+		"\x9c\x3f\x43\x94\xe2\xd9\xc9\xe0\x28\x27\x33\x4f\x98\xd7\x19\x4e\x9d\xcf\xe6\xfd\xf0\x68\x46\x36\x89\x54\xca\x31\xc3\x3d\x48\x3b" +
+		"\xdb\xd1\x8c\xa8\x24\x23\xab\xe9\x55\x65\x34\x27\x29\xec\xf1\x78\x9e\xfc\x6d\x2a\x21\x19\xf9\x5b\xde\x38\x15\x00\x26\xc2\xe0\x2b" +
+		"\x1e\x56\x10\x32\x94\x6a\xca\xaa\x7a\x4f\x2c\x1a\x4f\x5b\x31\x1f\x59\x44\xa3\x79\xee\xf7\x3f\xa6\xa3\x9e\x50\x7e\xd3\xc7\x93\xfa" +
+		"\x38\x2b\x9e\x37\x0e\x8a\x67\x84\x93\x2e\x0c\x8c\x64\xa7\xc3\xc6\x20\xaf\x9a\xca\x17\x56\xb8\xd2\x1a\x23\x03\x1b\x99\x96\x3a\x3d" +
+		"\xb5\x77\xef\x33\x54\x74\x10\xcf\x63\xb0\x08\xcb\x1f\x99\x8a\x28\x12\x72\x5a\x2a\xb5\x4b\xe8\x5e\x26\x12\x69\xcb\xe8\x57\x94\xe3" +
+		"\x2e\x45\xb2\x93\x39\x8a\x58\x3c\x8c\xd4\x32\xe2\x9a\x94\xe2\x8d\x85\x6c\x10\x0b\xa3\xe9\x58\x22\xaf\x50\xe7\xa4\xc5\x27\x9e\x9b" +
+		"\xa4\x60\x66\xbd\x29\x05\x64\xba\xa8\x94\xb9\xf0\xbc\x61\x63\x66\x0c\x12\x4f\xc5\x80\x2e\xe3\x9d\x9d\x99\x28\x48\x59\xba\xc4\xdf" +
+		"\x4b\x96\x29\x77\x22\xd3\x1b\x8f\x64\xe8\xea\x44\x18\x67\xfd\x8d\x58\xa9\x3b\x13\xa1\x32\x8c\x66\xe4\x85\x7c\x24\x1e\x0b\x17\xe5" +
+		"\x85\x22\x5f\x2a\x25\x5e\x28\x44\x42\x3c\x0c\xab\x43\x91\x4c\x66\x5c\x13\xca\x1f\xae\x3d\x15\x38\xa7\x0e\x9b\xcd\x0c\x3d\x32\x53" +
+		"\xb2\x91\xbc\x11\x2b\x19\xca\x2a\xc8\xf0\x87\x53\x85\xda\x4c\x61\xcc\x8e\xe6\xf1\x25\x92\x12\x14\x56\x6d\x3c\x35\xe3\xf1\xbc\x45" +
+		"\xab\x9c\xf4\xd1\x90\x29\xfb\x9b\x2c\x34\xc7\x43\x27\x2a\x91\xc7\x56\x7a\xf0\xb2\x53\x59\xe2\x0c\x3f\x81\xd0\x52\x04\xf1\x3c\xb9" +
+		"\xa6\xcc\x72\x70\x24\x23\xdb\x93\x8e\x91\x13\x69\x8e\xe2\x6f\xb1\x5e\x5e\x01\x4c\x05\x6e\x49\xf6\xcb\x98\x2e\x53\xef\xa5\xe8\x98" +
+		"\x72\x20\x93\xe9\x82\xb8\x22\x45\xd2\xee\x7a\x90\x51\xe4\x4a\xab\xee\x8c\x3c\x67\x24\xc3\x31\x88\x66\x00\x16\x09\x65\x36\x2b\x43" +
+		"\xae\x83\xb8\x09\x9a\xaf\xa7\x24\x9c\xb7\x96\x3e\xc0\x2a\x44\x48\x3c\xa1\xfc\x19\x39\xae\x48\xe8\x07\x46\xc3\x49\x53\x25\xa7\x4c" +
+		"\x95\x98\x15\xa6\xe6\xe2\x39\x8e\x82\xf1\xff\x03\x1b\xc9\x7f\x45\xf2\xe6\x31\xb2\x43\x29\xf0\xe7\x58\xe5\xb5\x32\xde\xf0\xe5\x64" +
+		"\xe4\x73\x62\x19\x79\xb9\xac\x0c\x7c\x7a\x5d\x9d\xc8\x60\x57\x1f\xa1\x47\x9c\x3a\x8d\x86\x6a\x30\x96\x3a\xb6\x2b\x91\x27\xcf\x16" +
+		"\xc4\xf3\xba\x61\xe1\xbc\xd1\xec\x64\xa0\x17\x49\x64\xe4\x13\xe2\x19\xa7\x19\x86\xe4\xc8\x4e\xa4\xb1\x14\x09\x79\xc3\x67\x9c\xfc" +
+		"\x74\xb1\xcc\x2c\x6e\x5e\xa2\xf8\xf7\xa0\x04\xb9\xe1\x99\x6b\x85\xc2\x96\xb4\x9c\xf0\xf5\x20\x21\x2a\xe2\x19\x91\x17\xf1\x74\x89" +
+		"\x39\x96\x4a\x34\x25\xf2\x78\xa7\x91\xbc\x26\x3b\x16\x22\x90\x0c\xdd\x1e\xcb\xd0\x0c\x29\x36\x88\x46\xff\x6e\x62\xe2\xde\x75\x89" +
+		"\xa7\xbd\xf7\x58\xa6\x07\x95\x51\xe4\x0d\x72\xd3\x22\xff\x37\x8d\x9a\x8a\x80\x52\x5a\x37\x9a\xfd\x77\x55\x99\xed\x59\x3d\x27\x19" +
+		"\x37\x05\xe1\xab\x36\xe2\xf1\x3c\xc5\xdc\x64\x56\x33\x91\x36\x22\xc9\xab\xc5\x0c\xb0\xac\x44\x18\x1c\x45\xc3\x93\x55\x73\xf3\xd4" +
+		"\x3b\x82\x54\xc4\x5a\x24\x8d\xd2\x02\x79\x9b\x43\xa2\x21\x8f\x65\x1a\x0e\xa3\x69\xaa\x34\x90\x61\x9b\x22\xe1\xd4\x7f\xaf\x56\x64" +
+		"\xf8\x78\x89\xd0\x8c\xa6\x52\xac\xc4\x33\x8a\x95\x19\x8c\x91\x2c\xac\x84\x34\xf5\x69\xf3\xcc\xe2\x42\x32\xdd\x1d\x7a\xec\x5e\xdb" +
+		"\xc7\xf2\x2a\x6d\xef\xa5\x47\xf3\xfa\x87\xbe\xbb\x20\x55\x2d\x8d\x64\xf8\x93\xbe\xea\x97\x16\x01\x97\xea\x09\xe2\x19\x6f\x4b\xc9" +
+		"\xa8\x05\x93\x93\x16\x84\x64\x55\x22\x6f\x4b\x40\x2c\x9a\x4c\x0e\x93\x91\x61\xc8\x4e\xa4\x07\x4c\x01\x1f\xc9\xf0\x40\xbc\xc3\x10" +
+		"\x0b\xd3\x68\x59\x65\xed\x7e\x9f\xf4\x8b\xe4\xf5\x3d\x32\xbd\xbe\x68\x4e\xf2\xf4\xa4\x20\x9e\x47\x81\x07\x7f\xe3\xe4\x30\xc7\x68" +
+		"\x41\x44\x76\x1e\xbf\x2e\x08\x0b\xdc\xc9\xec\x7d\x4e\xda\x8b\x8e\x84\x65\xac\x94\xe7\x19\xcd\xce\xab\xd9\x12\x79\xba\x6b\x52\x69" +
+		"\x93\x54\x64\x91\x82\xa4\xc0\xdf\x2c\x4b\x58\x3c\xca\x2c\xda\xc6\x33\xb2\x85\x3e\x82\xce\x9f\x48\x66\x26\x33\x93\x42\x99\x3a\xc4" +
+		"\xf2\x51\x89\x64\xac\x11\xe4\xad\x84\x92\x11\xf8\x44\x52\xa9\xcb\xbc\x49\xce\xcc\x8e\x8e\x54\xa4\x96\x96\x74\x37\x5a\x3c\x91\x94" +
+		"\xc1\x64\x89\x36\x3b\xc9\x8a\x71\x87\xf0\x7c\xf1\x74\x75\x3b\xea\xb4\x7a\x2c\xb4\xd7\x91\xcc\xd8\x27\x23\x3a\x0b\x3c\x36\x72\x32" +
+		"\x72\x44\xf1\x8c\xb2\x82\xcf\x43\x96\x50\x2c\x9c\x28\x96\x91\x5f\x0a\x12\x49\x8d\x1a\xc9\xac\xa1\x67\xa7\x9f\x8d\x66\x0c\x15\xc9" +
+		"\xc9\x73\xf6\x63\x90\x11\xb2\xe5\x49\xbc\x27\xd2\xb1\x67\x90\x91\x32\xcd\x4a\x95\x71\xf3\x9a\x7e\xbb\x94\x9d\x37\xe0\xf5\xb9\xdc" +
+		"\xec\x3c\xa5\xe7\x78\x98\x0c\xc9\x4c\x04\xfd\xbf\xfd\x48\x29\xb2\x66\x25\xf2\xc4\xbf\x99\x26\xe6\x6f\x2c\xed\x23\xac\xbf\x95\x30" +
+		"\x0a\x64\xba\x04\xde\x18\x25\x32\x5a\xb0\x32\x3d\xba\x9c\x3c\x8e\x5c\xf4\x6f\x6c\x99\xe1\xc3\x44\x42\xc3\x91\xd2\x69\x91\x8c\xcc" +
+		"\x79\xb2\x98\x12\xcf\xc8\x20\xa5\x1c\xc8\xb0\xcc\x9a\xea\x15\x89\x66\xf4\x36\xc4\x5c\x20\x1f\x09\xb3\x19\x64\xd4\x9a\x53\x89\xf7" +
+		"\xdc\xb0\x99\x2d\xb3\xe4\x11\x84\xe9\x56\x32\x10\x12\xc9\x88\x85\x13\x79\x53\xc4\x41\x06\x59\x93\x3a\xa7\x58\x5a\x65\xa5\x23\xf7" +
+		"\x78\x9e\x8e\xb2\xac\x68\xba\xf4\x9f\xa7\xca\x10\xcf\xa3\x63\x33\x4d\x6d\x90\x91\xd2\x4c\x91\x29\xf6\xff\x90\x38\x4f\x64\x97\xb2" +
+		"\x65\x19\xa0\x92\x9d\xce\xed\xe4\x71\x98\xe3\xe9\x6a\x17\x19\x29\xfd\xbf\x65\xbc\x33\xff\x45\xe2\xe9\x94\x66\x1e\x47\x37\xfe\x7f" +
+		"\xf5\x2c\x25\xf2\xb0\x65\xa6\x69\x88\x64\xb4\xbd\xc5\x12\x49\xea\xfb\x34\x72\x3c\xa4\x5d\xbe\x94\x15\x8b\xa7\x89\x98\x2f\xa3\x4f" +
+		"\x2f\xdd\x1b\x56\x26\x49\x05\x4a\x89\x22\xa2\x92\xa8\x22\xae\x56\xbe\x4a\xc9\x88\x38\xe5\xcc\xc7\x9d\x00\x26\x23\xfd\x44\x5a\xef" +
+		"\x65\xa5\xb4\x8a\xaf\x48\xe6\x26\xe1\xf4\x56\xc3\x37\xce\xc5\xc3\x2e\xa6\xf4\x02\x9d\x81\x88\x3b\xed\x14\xcb\xc9\xe3\x5d\xc7\x42" +
+		"\xc7\x38\x78\x27\x1c\x21\x27\x4f\x8f\x68\xbe\x9c\x30\xfb\x14\xba\xcd\xb1\x54\x3a\x34\x1a\xf6\x5c\xe5\xcd\x29\x91\x91\x5c\x4a\x9a" +
+		"\x9e\xf8\xff\x53\x89\xc8\x49\xae\x25\x75\x9c\x6c\x22\x2c\x16\x64\x76\xa9\x45\xe2\xe9\x90\x39\x88\x26\x8b\x68\xa9\x26\xae\x20\xfa" +
+		"\xf7\x4a\x62\x24\x9a\x37\x8a\xc9\xb0\x53\xb1\xdc\x3c\x49\xf2\x48\xd8\x80\x94\x0a\xa8\x7d\x9f\x5b\x2a\x67\x15\x0f\x13\x77\x3e\x5f" +
+		"\x91\x19\xf7\x25\xa5\x38\x27\x19\x54\xe6\xd1\x9f\x19\x2b\x8d\xc5\x43\xb5\x9f\xaa\xea\x56\x0c\xf7\x3d\x94\x48\xeb\x2b\x7f\x35\x92" +
+		"\x9b\xa7\xc5\x8e\x8c\xb3\x79\x93\xe5\xf8\x9c\x70\x2d\x61\x42\xcc\xeb\x96\xa4\x6f\x93\x48\xcb\x94\xaf\x65\xc7\x33\x52\x19\x7f\xfb" +
+		"\x8c\x84\x1e\x63\x9e\xfa\x72\x88\xf0\x48\xde\xfa\x9a\xf7\x27\xf9\x9b\xef\x97\x37\xd9\x18\xc9\xeb\xa0\xa6\x7d\xbf\xf8\xdf\xab\xd5" +
+		"\xa9\x16\xd9\x3c\xad\x71\x79\x8b\xb0\x41\x18\xd1\xe4\xd1\x72\xf1\x74\x5d\x2c\x79\x4f\x3c\xed\xfa\x66\xe2\x3f\x92\x93\x7e\xa7\x42" +
+		"\x24\x37\x6f\x7d\x24\x91\xf4\x10\x62\xae\x6e\x1b\xcd\x0d\x5f\x79\x90\x19\xb8\x39\x11\x48\xd6\xad\xe2\xe9\x64\x5d\xca\x9a\x44\x32" +
+		"\xea\x0b\x89\xd0\x5b\x88\x27\x32\x6a\x1f\xd9\x2e\x2e\x48\x59\xa2\xbc\x9e\x7f\x24\x4c\x0c\x46\xf2\x76\xe4\x26\xfe\x2f\x6d\x96\x27" +
+		"\x4f\x9e\x93\xa7\xe9\x2b\x9a\x37\x9d\x1b\x64\xa4\x82\x23\x19\x58\x8a\xe6\xa4\x43\x9b\x68\x46\x8f\x4a\x10\xea\xf9\x4c\x7b\x91\x6a" +
+		"\x97\x8a\xe5\x65\x89\x94\x5c\x04\x61\x19\x2b\x95\x3c\xff\xfb\x1a\x43\xd9\x49\xb6\x7c\xc4\xff\x9f\x42\x7f\x34\x9d\x51\x89\x64\xb4" +
+		"\xe4\x45\xc3\x56\x64\x3f\x72\x4e\xb8\xb4\x7c\x61\x87\x76\xd2\xbb\x0b\x75\x6c\x2c\xa3\xe7\x2d\x95\x6f\x8f\x65\x98\x9e\xfc\x79\x53" +
+		"\xa9\x41\xde\x1e\xe6\x58\x76\xa8\x8d\xa3\xc9\x63\x58\x83\x44\xf8\x7a\xc6\xab\xc5\xbd\xe1\x8e\x80\x52\x19\x07\xc8\xc6\xc3\x3d\x59" +
+		"\x2e\x3e\x0d\x72\x9c\x2a\x88\xe7\x0d\xa2\xc3\xe5\x24\xa2\xe9\x5e\xd6\x68\x22\xc3\x4a\xe6\x64\x98\xd1\x44\xba\x7e\x97\xae\x9a\xf9" +
+		"\xa3\x5b\x0b\x85\x6f\x6d\x8a\xa7\x53\x0d\x49\xf3\x9a\x9b\x4e\x0d\x91\x9b\x94\xbe\x20\x6f\xb6\x21\xc8\x4e\x77\xde\x46\x0b\x65\xe4" +
+		"\x8a\xe3\xe9\x6a\xa3\x2f\xfe\xc6\xf2\x4a\xba\x37\xc1\x49\x6f\x33\x3b\x23\x65\x14\xba\x5b\xd1\xbc\x7d\x29\x99\x25\xfe\x48\x76\x52" +
+		"\x1d\xc5\xa2\x69\x7e\x0e\xf2\x76\xdb\xe6\xe9\xc2\x8d\xe7\xe9\xaa\xf2\x49\x5a\x0f\x52\xe2\x6f\x09\xf0\x30\x83\x1a\xe4\x0d\x6d\x52" +
+		"\x6e\x4f\x2c\x63\xa2\x78\x3c\x1d\x0f\x26\xb3\x7f\x39\xe1\x6e\x82\x8c\x2e\x05\xaf\x72\x23\x19\xf5\x94\x78\x46\x08\x90\x1a\x36\x9a" +
+		"\x08\x85\x3d\xaf\xab\x93\x8c\xe6\x6e\xca\x78\x53\x95\x6b\x67\x8a\xe5\x64\xf4\x15\x44\xd3\x71\x74\x66\xfe\x21\x08\x8b\x4d\x64\x34" +
+		"\x40\x66\x45\x33\x76\x2e\xc4\x93\xed\xd0\x41\x66\x95\x2d\xd4\x84\xd1\xbc\x89\xbb\x68\x4e\x9e\x94\x48\x4a\xa6\x28\x13\xa6\x1d\xe2" +
+		"\x21\x6f\x7b\x6d\x56\x2a\x5c\x8b\x9b\x2e\x27\xae\x5c\x0f\x58\xde\xfc\x55\xb6\xc3\x79\x81\x68\xba\x79\x35\xee\x80\x2f\x9c\x91\x06" +
+		"\xf1\xac\x1b\xcd\xfd\xbb\xa0\xa5\x4c\x5b\xba\xd7\xa2\x7c\x52\xa9\x26\xf7\xb9\x94\x71\xea\x37\x91\x51\xcd\x49\xa4\xfb\xe8\x52\x1d" +
+		"\xd7\xa9\x7e\x80\x7c\x89\x24\x8a\x82\xbc\x4e\x7b\xaa\xe2\x9f\xc7\x9f\x8f\x87\x85\xda\x9c\x64\xff\x55\xca\xe4\x15\x0b\xef\xcf\x97" +
+		"\x51\x32\xf3\xfe\x55\x7e\xb7\xfc\xc2\xa1\xd9\xcd\x17\xcf\x33\x51\xca\x7a\x46\x33\x12\x6e\x89\x8c\x95\xe6\x64\x64\x83\x23\xf1\x3c" +
+		"\x5d\x46\x64\x34\xcb\x25\x52\x70\x86\x2b\x8a\xe5\x8d\x0d\x09\x2d\x48\x66\x76\xc2\x63\x2c\xf9\x4b\x31\x57\x7b\xca\x71\xb9\x94\x42" +
+		"\x49\x21\x4d\x97\xbd\xe2\xc9\x26\xd2\xfc\x99\xed\xc1\x19\x1c\x9e\x1d\xaa\x91\xdc\xb0\x1e\x91\xc8\x70\xf6\x8a\x85\x2a\x37\x1a\xe2" +
+		"\x27\x15\xf2\xc4\x33\x0c\x74\x6e\x88\xcf\xec\x78\xd2\x0a\x47\xe3\x2a\x9c\x48\x06\x20\xc9\xbc\x53\x3c\x4c\x3d\x79\x37\x35\x91\x3c" +
+		"\x92\x3b\x4f\xe2\x25\x6c\xe1\xcb\xdc\x71\x13\x84\xed\x31\xf1\x0c\xa3\x1f\x8b\x66\xf4\xab\xc7\xd3\xc9\xcf\x68\x46\x93\x58\xaa\x96" +
+		"\x91\x64\x92\xec\xbf\xdb\xd3\x7c\x39\xff\x77\x6e\x36\x9e\xd1\xa0\x98\x95\x77\x23\x83\xd7\x5a\xc9\x8e\x9d\x0c\x11\x4e\x35\x6f\x44" +
+		"\xc3\x7c\x75\x50\x2a\xf4\x2a\x5d\xa2\x8c\x66\x3b\x02\x45\x66\x44\x32\xa2\xec\x54\x45\x66\xc9\xd0\xb8\x68\x32\xcf\x63\xa7\x1e\xa2" +
+		"\xf9\xfc\x5c\xb1\xb1\xa4\x78\x51\x9c\xf5\xaf\x11\x58\x3e\x2b\x22\x06\xf5\x8f\x88\x13\x88\x92\xa2\xb6\x3f\x7b\x7d\xe8\x35\x62\x10" +
+		"\x62\x82\xed\xc6\x5c\xd8\xde\xef\x03\xed\x1f\x88\xd9\x81\x82\x45\xf6\xf7\xb2\x96\x11\x31\x2d\x2a\xb6\x23\x0e\xde\x2e\x36\x77\x29" +
+		"\x28\x26\xff\x9e\x5f\x74\xb6\x41\x7f\x7f\x40\xb4\x8a\x8a\x2d\x8f\x88\x56\xcf\x88\xfa\xcf\x18\xf8\x0c\xdf\x6b\xc7\x0e\xb7\xf7\x9b" +
+		"\x21\xb7\x3c\xeb\x0f\xe1\x38\x14\xf1\x27\x44\x5f\x25\xd6\xfe\x11\x88\x41\x5b\x03\x91\x23\xfa\xe2\x24\xda\xce\x1f\x3d\xf5\xa1\x78" +
+		"\x3f\xe3\xed\x36\x37\x85\x6f\x44\x40\x34\xf8\x3d\x10\x0d\x1a\x16\x10\x63\x56\x16\x54\x24\x4b\x3c\x2b\x5e\x50\xd6\xd6\xe4\x39\x57" +
+		"\x9d\xe6\x05\x7a\x73\xcc\xb9\xa8\x38\x43\xde\x63\x9e\xdf\x68\x58\x23\xb9\x59\xb3\xaa\x3f\x7a\x6d\x5d\xc4\x4d\xf3\xac\x78\x57\xbc" +
+		"\xe7\xd1\xf6\x83\x98\x13\x11\x73\x23\x62\x06\x62\x54\xf2\x58\x85\xa9\x11\x71\x08\xb1\x25\x79\xf2\xda\x98\x88\x7f\xb9\x6f\x8a\xb2" +
+		"\x76\xc3\xa5\xe2\xd7\x98\x58\xff\xb9\x38\xfa\x95\xe3\x4e\x5b\xda\x52\xfc\x69\x49\x1d\x6a\x21\x9a\x1a\x08\xcd\xec\xa3\xb9\xfd\xf9" +
+		"\xcb\x34\x83\xb1\x8a\x18\xe8\xde\xbf\x9d\x3c\xcd\x5c\xee\xbb\xdc\xb8\x0a\x4f\x6a\xb9\x5b\xb4\x42\x14\x0f\x5f\x7f\x5c\x2e\x7c\xf1" +
+		"\x6e\x96\x82\x3e\x88\xba\x84\x37\x57\x12\x77\xfe\xed\x66\xff\x72\xda\xf0\xa5\x14\x91\x96\x11\xa7\x4f\x36\x47\x54\x6a\x17\x62\x5f" +
+		"\x4e\x9a\xd5\x7d\xad\x27\x27\xa3\x65\xc2\x33\x58\x6e\x54\x57\x45\xf5\x60\xa7\x62\xba\x26\xaa\x81\x41\x54\x9f\x3b\x6f\xe8\x6c\x2c" +
+		"\xaa\xe7\xa3\x7a\xcb\x55\x40\x5a\x13\xd5\x5a\xa2\xea\x1e\x44\xf5\xaf\xa8\x8e\xc6\xa3\x6a\x10\x44\xb5\x3a\x12\xd5\xd7\x6e\xa8\x6b" +
+		"\xa2\x3a\x41\x54\x47\xbd\x56\x98\xee\xb9\xfd\x99\xa8\x0a\x45\x35\x3e\x12\xd5\x4d\x29\x4b\x1c\x57\x31\x27\xd5\x25\xa2\x9a\x1a\x8b" +
+		"\xab\x42\x54\x9f\xe7\xa8\x0d\xde\xeb\x68\x39\xfa\xff\x46\x64\xd3\x7c\xb5\xa6\x46\x74\x5b\xe2\xff\x1b\x00",
+	"CNS1": "" +
+		"\xc4\xfd\x77\xb4\x96\xe5\xb5\x05\x8e\xce\xf9\x7e\x6d\xf7\x46\x2f\xd2\x44\x44\xec\xbd\x97\x44\x63\x8c\x31\x6a\xd4\x18\x93\x98\x66" +
+		"\x8c\xe9\x39\x49\x4e\x4e\x4e\x4e\x2a\x22\x20\x20\xd2\x44\x44\x40\x44\x44\x40\xdc\x20\x22\xd2\x44\x44\xa4\x8b\x08\x08\x08\x88\x48" +
+		"\x13\x69\x22\x20\x22\x02\xe2\x1d\x73\xad\x77\x7d\xd9\xbf\x3b\xee\xb8\x63\xdc\xbf\xee\x18\xf8\xfa\xed\xaf\xbc\xe5\x79\xd6\xb3\xca" +
+		"\x5c\x73\xad\x87\x3f\xbe\x06\xbc\x13\x3c\x78\x3f\xc1\xcf\x32\xe0\xd4\xab\xc1\xa1\xb3\xf3\x48\x1e\x9d\x9d\xf7\x57\x7c\xf3\x58\x1e" +
+		"\xdc\xa9\x03\xc1\x32\xf0\x72\xf0\xa9\x0c\x78\xff\x47\x79\xf0\x91\x72\x64\xd6\x1e\xc9\x83\x2f\x1d\x2e\x20\x3b\xeb\x70\x01\xec\x93" +
+		"\x05\x07\x7e\x91\x07\x67\xeb\xfd\x99\x3a\xac\xfa\x32\x0f\x22\xfd\x97\xec\xca\x20\x19\x97\x01\x97\x66\xc1\x2c\x92\x37\xb2\x48\x16" +
+		"\xcd\xc8\x23\x59\xac\xc3\xdb\x3a\xac\xd6\x61\x9b\x0e\xdb\x75\xd8\xa3\xc3\x5e\x1d\x3e\xd4\x61\xe7\xff\xe3\x55\xbe\x0b\x92\x87\x3e" +
+		"\xcf\x23\xc9\x23\xe9\x7a\x0d\xb8\xf2\x6a\x30\x07\x8e\xd6\xfd\xe6\xc1\x12\xf0\x09\xbd\x1c\xf7\x2f\x70\xc6\x2b\x04\x3f\xee\x6c\x6f" +
+		"\x5e\x0c\x12\xbc\x04\xfc\x83\xbd\xf8\xbb\x1d\xbf\x61\xc7\xcb\xec\xb8\xfc\x7c\xf0\xe5\xeb\xc1\x87\x75\xa7\x27\xf2\xe0\xa2\x04\xdc" +
+		"\x76\xa0\x80\xe4\xc8\x47\x05\xbb\x5a\x0e\x99\x4e\xe0\xea\x04\xcc\x82\x7b\x8e\x14\xc0\x6b\xc0\x87\x08\xae\xea\x41\xb0\xfb\xa1\xbc" +
+		"\x7d\x40\x24\x1f\x1d\xcc\x83\x95\xe0\x03\x04\x7f\x09\xb6\x41\xae\xdb\xfe\x3c\xf8\xf6\xfe\x3c\x92\x53\xc0\xc3\x04\x7b\x27\x20\xc1" +
+		"\xb9\x09\x38\xf6\x3a\x7b\xf9\xc6\xf5\x76\xff\x55\xf6\xc7\xd0\x1a\x3b\xd7\xfa\x2a\x70\x67\x25\xf8\x71\x6d\x7a\x6a\x66\x90\x54\xc4" +
+		"\xeb\x61\x89\xfe\xe6\xba\x4f\xf2\xe0\xf7\xc1\xef\xd9\xf1\xbf\xc0\x57\x3e\x2f\x80\x1b\x5e\x21\x92\xf7\x35\x00\xf3\xf5\xe7\x45\xe9" +
+		"\xcc\x54\x20\x33\xf7\xd3\x3c\xf8\xdc\x84\x3c\x32\x7f\x04\x7f\xa2\xdf\xe9\xa6\x7e\x0d\x8e\x5b\xa6\x67\x99\xe7\xcf\xe7\xb3\x5f\x62" +
+		"\xaf\x7b\xd5\x81\xdd\xff\x01\x2e\x6d\x8b\x92\xc7\xdf\x4b\xe2\x26\xff\xdf\xfe\x3d\x4e\xb0\xba\xc1\xbf\xfd\x04\xc7\xf9\xc9\xc6\x26" +
+		"\xe0\xc0\xc4\x4e\x67\xff\x92\x09\x8c\x87\xb8\x12\x6c\x0d\x36\x07\x6b\x91\x74\x4b\xd2\x77\x33\x1f\x10\x65\xb3\x76\xe4\x51\x76\xe0" +
+		"\x70\x1e\xa5\xfb\x2f\x03\xdb\x80\x43\xfb\x12\x7c\xa4\x2f\xd1\x72\xf9\xcb\x79\xb4\xac\x42\xed\xc0\x8f\x0b\x28\x64\x51\x5b\xa3\xe3" +
+		"\x29\x47\xa6\x12\x7c\x72\x26\xc1\x93\xc0\x1c\x58\x82\xb2\x09\x3d\x89\xb2\xa6\x28\x7b\xbc\x02\xdc\x34\x85\x76\xad\x3a\x50\x27\xba" +
+		"\x08\x9c\x5f\x0e\x76\x06\xbb\x80\x23\x72\xe0\xbf\xc0\x7b\xc0\x61\x04\xc7\x37\x01\xf7\x96\x82\xd3\x4a\xc1\x39\x09\x58\x06\xee\x26" +
+		"\x38\x83\x60\x07\x70\x6c\x33\x70\x5d\x02\x6e\xbf\x0a\x5c\x9c\x01\x4f\xdc\x0b\x76\x6b\x8d\x76\x7b\x96\x66\xec\xf6\xe7\x8c\x23\xa8" +
+		"\x6b\x3d\xef\x0f\xd9\x95\xe0\x05\xe0\x6d\x60\x05\x78\x37\xd8\xa7\x0c\xbc\xc9\xfe\xd8\x4d\x24\xd7\x80\x53\x13\xf0\x10\xc1\xbb\xec" +
+		"\xa6\xd6\x11\xfc\xa2\xa9\xfd\x32\x67\xc7\xaf\x82\x2d\xc1\x55\x55\xe0\xf4\x2a\x30\x0f\x9e\x05\xd2\x66\xae\x13\x38\xa7\x0c\xec\x99" +
+		"\x01\xf7\x65\xc0\x41\x04\xfb\x26\x76\xb1\x23\x15\xe0\xd0\x02\x78\x07\xd8\x11\x1c\x4a\x24\xb3\x0a\x76\xb2\xa5\x89\xfd\xbe\x13\x78" +
+		"\x06\xf8\x27\x70\x5d\x0e\xac\x41\xa6\x39\xd8\x14\xdc\x49\x70\x68\x62\x97\xab\x01\x0f\x55\x80\x9f\xdd\x0a\xd2\x6e\xe4\x1a\xbb\x74" +
+		"\x9d\x9d\x6f\xe3\xc5\xe0\x96\x8b\xec\xaf\x92\xb8\xcb\xd6\xe0\x55\x20\xf5\xad\xc4\xe4\x2c\xc9\x81\x95\xe0\x89\x52\x30\xa3\x07\x96" +
+		"\xdc\x66\x4c\xf7\xcc\x21\x32\xed\xc0\x1d\x19\xfb\xe6\x59\xe0\xf5\x36\xbd\xb4\xcf\xcf\xd3\x77\xd9\x8f\xe9\xb9\x38\xa7\x31\xf8\xb7" +
+		"\x10\x96\x3c\xb8\xbc\x04\x3c\x9a\xc4\x13\xfc\x1a\xec\x93\x07\xfb\x25\x60\xaf\x04\x49\x17\x70\x63\x02\xf6\x29\xb5\x81\xee\x62\x3f" +
+		"\x98\x57\x0e\x4e\x26\xd8\xce\x9e\xab\x8b\x0d\xf3\x90\x2c\x78\x8e\x3d\xd3\xfe\x6a\xb0\x3b\xed\x2e\xc6\xd0\x9e\xa8\xca\xd6\xec\x8d" +
+		"\xe0\xbe\x1a\x50\x7a\xa0\x39\x58\x66\xc7\x8e\x76\x1b\xa3\x09\xce\xc9\x80\xdb\x1e\xf5\x81\x61\x2c\x94\xeb\xe3\x1b\x04\x27\x25\x60" +
+		"\x0b\x64\x0b\x48\x9a\x83\x4d\xec\x04\xcb\x4b\x41\x22\xe9\x43\xf0\x3a\x24\xc3\xfc\x69\x3b\x82\xf5\x89\xbd\xbf\xcc\x85\xab\x02\x3c" +
+		"\x56\x03\xae\xad\x05\x69\xf7\xc8\x18\x9b\x61\xae\x4c\x9a\xd8\xdf\x95\x76\x21\x3b\x23\x3b\x83\x05\xbb\x93\x46\xf6\x4e\x89\x4d\x1e" +
+		"\xed\x5f\x13\x7b\xdd\x1a\x9c\x5b\x0e\x12\x3c\x52\x62\xe7\xcc\x83\x3d\x5d\x36\xe7\x14\x6c\xa4\x0e\x26\xe0\xba\x7c\xfa\xab\xe4\x12" +
+		"\x70\xb3\x8f\x45\x9f\x24\xa4\x2d\x1d\xd6\x64\x90\xdf\x37\xc1\xf1\x95\x60\x37\x82\x03\x72\x7a\x27\x71\xd9\xdf\x5b\x03\xae\x26\xd8" +
+		"\x8b\x20\xc1\x5b\xed\x2c\xfe\x93\xad\x2d\xc0\x09\x19\x1b\x8a\xb1\x39\xb0\x31\x78\x2f\x58\x85\xa4\x0e\xd9\x2a\x9f\xa1\xed\x19\x5b" +
+		"\x81\x1d\xc0\x1f\x81\x1b\x32\xfa\x54\x7f\xf4\xcb\xd9\xe4\x72\x6f\x02\x4e\x22\x92\xf5\x53\x88\xe4\x3e\x9b\xfe\x9f\x98\xd8\xfa\x90" +
+		"\x4f\xa5\x9d\xb4\x9d\xad\xfe\xa6\x60\xb5\x49\x57\x06\xbc\x14\x2c\xb1\xcb\x36\xb1\x27\x6f\x6c\x7f\x36\x06\x8f\x97\xc4\xa3\x64\x4d" +
+		"\x14\xea\xcc\x3c\x5c\x0f\x2e\x27\x92\x72\xb0\x60\x83\x27\x25\xbb\x94\x26\x97\x44\x32\x8a\xe0\xe4\xc4\xa6\xc9\x1e\x5b\x2f\x4e\xb2" +
+		"\xb3\x55\x9a\x78\x15\x6c\x0a\x4a\xd3\x1b\x60\x73\x70\x44\x79\x5c\xa5\xde\xe5\xa5\xda\xee\xc5\x85\xb9\x1c\xc9\xe6\x02\x92\xcb\x6c" +
+		"\x89\xb2\x99\xfd\xcc\xaf\x6c\xc3\x96\xd4\x17\x90\xfc\x28\xd5\x65\xdc\xea\xf6\x6b\x21\xc1\xa5\x39\x1b\xdb\xcd\x3e\xb2\xd3\x12\xbb" +
+		"\xd2\xf1\xc4\xbe\xb9\xbf\xc4\x26\x23\x0f\x8e\x75\x85\x9c\x03\x67\x97\x83\x3b\x5c\xce\x4a\x90\xc8\x60\x9f\x61\xc2\x54\x1b\xe7\x5e" +
+		"\x57\xed\x22\x71\x96\x9f\xad\x1f\xed\x3e\xb2\xb6\x68\xdb\x86\xfc\x97\x23\x69\x05\xce\xaf\x01\x37\x15\xec\xc2\xf3\x6a\xc0\xc3\xb5" +
+		"\xe0\xa1\x9c\x8b\x8a\x46\x74\x3c\xc1\xfb\x5c\x00\xb2\xf6\x18\x15\xa6\xc1\x86\xda\xec\x24\x59\x70\x52\xce\x26\xb6\xce\xfe\xb5\x33" +
+		"\x69\x2e\x01\x47\xfa\x1a\x6a\x0e\x6e\xa8\x06\x27\x14\xec\xe5\x59\xe0\x8c\x2c\x38\xff\x3c\xf0\xbd\x27\x25\x01\x74\x05\x96\xb5\xe7" +
+		"\x6a\x67\xe7\xb9\x1d\xbc\xd9\x6e\xf6\x1b\xa6\x4b\x4f\x8d\x89\x69\x6a\xb2\x73\xb6\xae\xca\xcb\x6c\x7a\x4a\xc0\x9f\x9a\x92\x3a\xdb" +
+		"\x66\xb9\x04\x3c\xcd\x16\xe0\xf6\x42\x08\xc1\xad\x48\xba\x49\xd6\x09\x12\x49\x99\x9d\xa7\x67\x12\xb2\xbd\x8f\xe0\x28\xff\xe2\x8a" +
+		"\x24\x04\xed\x1a\x3b\xdd\xad\xe0\xb7\xec\xd4\x39\x1b\xae\x2e\x76\x43\xdf\xb2\x95\xf7\x55\xb0\x7d\x28\xf3\x46\x48\xaa\xc0\xd3\x6d" +
+		"\x1a\x4b\xec\xe7\xe5\x36\xeb\x2d\xc1\xe3\x65\x36\x52\xcd\xec\x99\xe6\xd0\xfe\xd7\xde\x3e\x29\xb7\xd7\x6d\xed\x56\x69\x0f\xb8\x33" +
+		"\xef\xe3\x35\xc7\x57\xf8\x19\x3a\x31\xcb\xec\x98\xb3\x39\x3d\xcb\x7e\x5b\x6b\xe6\x44\x8e\x07\xed\x11\xee\x09\x45\x35\x2b\x41\xd2" +
+		"\x39\x95\xca\x64\x8c\x4b\x62\x35\x38\x27\x67\x77\xdb\x33\xb1\xbf\xf2\xe0\x06\xda\x0d\x5c\x04\xf6\xa1\x7d\xbd\x8b\xeb\x8c\xa2\xa8" +
+		"\x36\x02\x6f\xb0\x1b\x6c\x09\xb6\x02\x57\x94\x80\x04\x77\x10\xec\x64\xaf\x4e\xb7\xaf\x74\x32\xe7\xeb\x32\xfb\x8a\x2b\xad\x72\x1b" +
+		"\xab\xaf\x99\x4d\xf4\xf9\xcb\xd8\xbf\x26\xe0\x8e\xb2\x18\x2c\xe9\xa4\x9e\x59\x70\x2d\x91\x6d\x1f\x2b\xa3\xc6\x26\x2d\x67\x36\xae" +
+		"\x85\x3d\xcc\x88\x54\xe3\xec\xf0\xd5\xf1\x17\xcd\x87\x46\xb9\x60\x03\xf3\x53\x9b\xa7\x33\xc0\xab\xed\xd3\xaf\x9b\xb6\x2b\xd8\x49" +
+		"\x2a\x6c\x90\x9a\xdb\x2d\x76\xb4\x01\x3e\xcb\x46\xba\xc4\xee\x7b\x67\xb5\xc9\x7e\x29\xb8\xda\xc5\x83\xe0\x62\x1f\x85\xf1\x19\xb0" +
+		"\xde\x47\xb5\x39\x38\x38\xe7\x03\xb5\x33\x03\xce\x2e\x7e\x75\x5f\xe2\x76\x7e\x6a\x29\xd8\x2d\x67\x77\xdc\xd8\xce\x96\x0f\xbd\x7e" +
+		"\x34\x6f\x52\xe8\x8a\xfc\x78\x2b\x5f\x73\x77\x83\xfb\x4a\x6c\x49\xb4\x01\x07\x10\xec\x97\xb1\x5b\xec\x49\x70\x7f\xc6\x46\x6d\xb4" +
+		"\xcf\x43\x47\xbb\x51\xda\xbf\x05\x8b\x08\x6e\x5e\xea\x06\xa4\x26\xac\xc1\xb2\x04\x3c\x4a\x7b\xce\x65\xb5\xf6\x66\x3e\x2e\xf7\xd0" +
+		"\xa8\xc4\x74\xe7\x65\x76\x27\xed\xf4\x49\xd2\xd2\xfd\x88\xbb\x6d\x24\xce\xb7\xab\x9e\x62\x8a\xb8\xad\xdd\x77\xce\xfe\x2c\xb3\xd9" +
+		"\xbc\xd2\x2e\x7f\x91\x49\x7e\x07\x3b\xe1\x99\xe0\x54\x57\x09\x79\xb0\xb3\xc9\xbd\x2f\x94\x3e\x74\xff\xe7\x1f\x61\x2a\xf3\x36\xde" +
+		"\x19\xbb\xcf\xa6\x7e\x83\x39\x37\x97\xe1\xdf\xb2\x0b\x32\x1d\xc0\x59\x8c\xf3\xcd\x48\xc2\x0d\xba\xdc\x6e\xf9\x34\xbb\xa1\x82\x49" +
+		"\x5d\x6b\x13\xc5\x46\x76\xd1\x2b\xed\xfe\xce\xb0\x37\xff\xcb\x9e\xfb\x7a\x93\xb9\x0e\xe0\xba\x52\x70\x50\x62\xdf\x6d\x01\x32\x94" +
+		"\x70\xc6\x9e\x7f\xae\xb9\xcf\x49\xc6\xce\xd9\xce\xa5\x29\x67\x0a\xff\x42\x13\xc9\xeb\xed\xd8\x38\x14\x4b\x0d\x58\x6b\x1f\x95\xd8" +
+		"\x7c\xb4\x00\xa7\xe6\x43\xa5\xff\xd2\x6e\x38\xd3\xc0\x2d\x3a\x61\xb3\x9a\xe4\x5c\x7d\x9f\x6e\x42\x76\x92\x7d\xe2\x6e\xfc\x52\xff" +
+		"\x9a\x0b\x4e\x85\x3d\xca\x72\x82\xd7\xd9\xef\xcb\x90\x54\x82\x73\x89\xe4\x27\xfa\x56\x32\xc9\xc7\x24\x07\xce\xf3\xa1\xbc\xcc\x9e" +
+		"\xc4\xad\x5e\x99\x4d\x49\x85\x8d\x43\x67\x1b\xa5\xe6\x71\x91\xac\xcd\xe2\x61\xbf\xc8\x1d\x31\x37\xff\x02\x07\x17\x17\xbf\x8b\x66" +
+		"\xad\x3d\x76\x1d\xf8\x63\x24\xb7\x86\x79\xb8\xda\x04\xce\x1d\x8d\x3a\xf0\x64\x3b\xeb\xd9\xe0\x8d\xe0\xb7\xb5\x78\x93\x8c\xad\xa4" +
+		"\xf3\xed\xae\x37\x97\x82\xab\x68\xbf\xf8\x87\xad\x52\x82\x95\x61\xd4\x9a\xdb\x7c\xb4\xb1\x13\x34\xb1\x73\xf7\xcd\x82\x19\x70\x3e" +
+		"\xc1\x2b\xec\x41\xbe\x6e\x1e\xe2\xb7\xc1\x92\x58\xb9\xe5\x48\x6e\xb1\x1f\x35\xb6\x1f\x75\xb4\xb1\xbf\xd1\xce\x7a\xa6\xdd\x4d\x0d" +
+		"\xb8\xb5\x0a\x49\x4f\x5f\x57\xe3\x13\x7b\x67\x80\x7b\x1a\x4d\x3d\x08\xbb\xc8\x9e\xeb\x67\x48\x1a\xa7\xce\x28\x7f\x67\x03\x91\xb1" +
+		"\x93\x35\x0f\x47\xe9\x14\xd3\x48\x27\x9b\x8b\xf8\x33\xbb\xe3\x9b\xec\xd6\xdb\x9b\xe8\xe9\x02\xa7\xd8\xdf\x8d\xc1\xdd\x55\x61\x53" +
+		"\xaa\x75\x73\xc9\xd8\xbc\x5d\xac\x9d\xc9\x84\xac\x9c\xc7\x1a\x4d\xed\x1a\x95\xe0\x94\x0c\x48\x1b\x51\x33\xa4\x9c\x9c\xf7\x89\x2d" +
+		"\x80\xab\x33\x36\x56\x36\xc5\xdc\xea\x32\xd9\x2d\x67\xb6\x72\x40\x02\x12\xdc\xee\xff\x53\x78\xd4\xab\x04\xac\x2f\x8d\x28\xf6\x10" +
+		"\xc1\xdd\x49\xe8\xc4\x36\x36\xcd\x8d\xc3\xfc\xb9\x51\xd8\x9b\xb5\x67\x6f\xe3\x0a\xe1\x74\x7b\xaa\xce\x76\xa3\x97\xdb\xfc\xf8\xdc" +
+		"\x57\xda\xef\xea\xec\x4c\xbe\xac\x2a\xc1\x49\x55\x26\xfc\xe3\x56\x12\x1c\xb2\x9e\xe0\xc2\x16\xae\x92\xdd\xfb\x59\x9c\x09\x95\x52" +
+		"\x06\x8e\xce\xda\x19\x96\xb9\x65\xd7\x92\xbd\x01\x64\xb8\x32\x19\xb7\x9e\x3f\xf2\xc5\xd5\x1c\xfc\x62\x74\x82\xa4\x35\xb8\x23\x0b" +
+		"\x7e\xd7\x06\xa4\x9d\xad\xf0\x93\xec\xbb\xad\x4d\x9a\x5a\x98\x40\x55\xc5\x3d\x7d\xdb\xfe\x6c\x16\xab\xb6\x99\xfd\xe4\x5b\xbe\x7c" +
+		"\xff\x12\x7e\xb2\xdc\xd4\x26\xf6\xa3\xf0\x66\x59\x00\xbb\xba\x06\x71\x4f\x6c\x28\x5d\xee\x6f\xb0\xa9\xea\x46\x57\xa9\x9d\x4d\xa4" +
+		"\x6f\xb2\xc1\x68\x6f\x32\xd0\xd2\xde\x74\x6f\xee\x14\x5b\xb7\x8e\x2e\x9c\x6b\xa7\xac\xb0\xc7\x3d\x39\xee\xa6\x6b\xa9\x1b\xce\xba" +
+		"\x88\x3a\x3c\x9c\xbb\xda\xe4\xab\x7b\xc1\x7e\x52\xf4\x35\x15\x62\x15\xcc\xec\x5d\x1c\xcb\xf6\x72\x7b\xd1\xd6\xc6\xe6\x22\xbb\xd3" +
+		"\x8d\x39\xf0\xcf\xa1\x4e\x52\x45\xc8\x62\xdc\x90\x01\x47\x24\x36\xf6\x02\x55\x6e\x02\x6b\xec\x47\xb3\x69\x33\x56\xe1\x6e\x58\x6b" +
+		"\x5b\x27\x7f\xb0\xb7\x72\xe6\xcf\x14\xec\x32\x39\x57\x86\x4d\xed\xa4\x65\xb6\x8c\x2e\xb0\xaf\x5e\x0f\x56\xdb\x79\x2e\xb6\x2f\xdd" +
+		"\x16\x43\x58\x0e\xce\x2a\xb8\x67\xf8\x67\x9b\x97\x16\x36\x53\x9d\x6d\x60\x7c\x65\x9e\x6e\x57\x75\x6f\xb3\xa3\xc9\xde\x65\xf6\x9d" +
+		"\xa3\x25\x6e\x81\x68\x6f\xdf\xe8\xab\xa8\xc6\xce\x50\x65\x2f\xda\x86\x2b\xd8\x02\x1c\x9b\x01\x07\xb8\x44\x5c\x61\x7e\x7b\xc1\xac" +
+		"\xf6\x6f\xec\x41\xfb\xba\xdb\x51\x01\x7e\xc7\x26\xe7\x52\x7b\xee\xa6\x86\x7b\x9c\x65\xb7\xd1\x05\xec\x41\xbb\xed\x3a\x9b\xb5\x26" +
+		"\xe0\xe4\x2a\x93\xfe\xbc\xc9\xde\x65\xf6\xb4\x03\xe8\x18\x4e\x77\xda\x14\x54\xdb\xaf\x2f\xb6\x79\xbd\xd1\x1e\xa8\x52\xb2\x91\xb8" +
+		"\x8f\x79\x21\xf8\x4d\x77\x66\x4f\x06\x3b\xd8\x13\x95\xd9\x9d\x7f\xcb\x4e\xd7\xb5\x2a\xa2\xb1\xca\x98\x2a\x5f\x78\x8d\xc0\xa1\x79" +
+		"\xbf\x46\x2e\xf0\x83\xa4\x16\xac\xcf\x80\x9d\xec\x34\xee\x9d\xf9\x58\x2e\xcf\xd9\xbb\xd7\x85\xd1\xc8\xb9\x6e\xa8\x34\xbb\x31\x21" +
+		"\x83\x4c\x6b\xb7\xa2\x99\xf8\xc2\x5f\xed\xe6\x18\xe6\xb7\x45\x5c\x79\x82\x2b\xb7\x8e\x48\xaa\xc1\x83\xd9\xff\xac\x82\x64\x3b\x91" +
+		"\x9c\x65\xb7\xb9\xd3\x17\xc3\x71\x3f\x5f\xd7\xbc\xbb\xee\x79\x1b\xb1\xba\x06\xd7\xd8\x5e\xe2\x21\xcb\x3d\x1e\xf2\x7a\x9c\x70\xa3" +
+		"\x43\x56\x6d\xc0\x26\x48\xce\xb4\x09\x74\x67\xb7\x85\x2d\xc7\x0a\xd7\x1b\x59\xc7\x39\x32\x36\x7b\x59\xf0\x5a\xbb\xdd\xa6\x36\xab" +
+		"\x57\xda\xc0\x55\x99\x06\x2e\x33\xc1\x6b\x67\x38\x9c\x8b\x4e\x4b\x9d\x98\xcd\xed\xcd\xb3\xc0\xc1\x95\x36\x9d\x59\x90\xe0\xd8\x82" +
+		"\x9d\xa0\x3e\xeb\x91\xcd\x2f\x2d\x48\x9b\xe6\xfe\xe2\xf8\x8c\x6b\x48\x82\x53\xfc\xdb\x8b\xb3\x36\x0b\x1e\xc4\x95\xda\x31\x13\x2a" +
+		"\x7b\xbe\x5b\xe8\x16\xe0\x7f\x74\x6c\xcf\xc4\x95\xfa\x88\x0c\x32\xa5\xe0\x84\xac\xdd\xe4\x7c\xb7\xc5\x15\xb6\x98\x96\x27\x16\xda" +
+		"\x94\xb8\x7b\x97\x07\xef\x9f\x98\x80\xd3\x88\x64\x4a\xce\x4c\xd5\x2f\xc0\xdf\x83\x34\x91\xbc\xc7\x64\xa9\x2d\xf8\x5b\x13\xd5\xd2" +
+		"\x98\xb1\x33\xed\x6c\x9d\xed\xdf\xef\x4c\xeb\x9f\x1e\xfe\xd2\x37\xec\x6b\xff\x02\x73\xb6\x04\xbe\x6f\x03\xb7\x23\x67\xa6\x38\x67" +
+		"\x76\xd1\xd7\x62\x19\x38\x24\x89\xf5\x56\x65\x0f\x31\x2c\x03\x4e\x20\x78\x0d\x98\x71\xb1\x1b\x64\xf1\x0c\x4f\xb2\x1b\xf8\x1f\xbb" +
+		"\xae\x6b\xb4\x66\x36\xe8\xdf\xb1\xe7\x68\x66\xdf\xb9\x10\x74\x0d\xa5\xe1\xbb\x3a\x75\x88\x93\x9d\x05\x0f\x29\x3b\x82\xb5\x76\xa5" +
+		"\xbe\x34\x7b\x9d\x0c\xa0\x87\x43\x49\xb8\xcb\x79\xfb\x71\xb5\x2d\x0a\xf7\x80\x2a\xec\x9d\x73\xed\xb6\xe7\xe7\x3c\x98\x7f\xcb\xc7" +
+		"\xcc\x57\x49\x79\xfc\x96\xae\x90\x87\xd1\xfe\x2a\xb5\xe0\x84\xed\xec\x3b\xed\xc3\xdd\xaa\x30\xd9\x69\x01\x1e\xca\xd8\xf0\x64\x1a" +
+		"\x6a\xf4\xc1\x89\x7b\x43\xfe\x6f\x50\x06\x6c\x6c\x1f\x9d\x66\xe3\x74\x4e\x28\xeb\x2e\xb6\xdc\x4a\x43\xf1\x54\x83\x95\xb6\x7a\xb6" +
+		"\xe6\x1d\xcc\xeb\x62\x4f\x39\x92\x36\x65\x27\xd9\x2a\x3a\xc5\x6e\xa9\xce\xae\x72\x92\xcd\xef\x29\x86\x94\xe6\x6c\x19\x10\xc9\x91" +
+		"\x82\x07\x84\xb7\xda\x17\x8f\xd2\xce\xec\x41\x5c\x1b\xfb\x55\xa5\x5d\x7d\x5f\x62\x90\x4a\x16\x1c\x9c\x35\xa9\xf6\xc7\x6b\x69\x46" +
+		"\xce\x01\xb5\xef\xf8\x73\x4c\x4f\xc0\x5f\xdb\x7d\x9f\x0a\x0e\x4c\x11\xc7\xb6\x76\x92\x2a\x1b\xce\x8b\xcc\x2f\x29\xb3\x45\x31\xa8" +
+		"\x12\xf4\x90\x61\x1d\x91\x94\x84\x59\x18\x9a\x71\xb7\xe0\xce\x70\x75\xbf\x12\x91\xe3\xe5\xa6\xbd\x5d\x71\x5d\x68\x97\xb8\x20\xfc" +
+		"\xdb\x4c\xc4\x42\x57\x9b\xa2\xdc\x5b\x11\xa0\x6c\x13\xfb\xa0\x0a\x5c\x9d\x73\x15\xd4\x33\x09\x55\x50\x0a\x12\x1c\x99\xb8\xc3\x7a" +
+		"\x89\x9d\xb3\x95\x8d\x7e\x33\x70\x6f\xae\x01\xf2\x43\x47\x50\x4c\x52\x93\xd3\xec\x2b\xb4\xb3\xd4\x99\xac\x49\xeb\xdf\xa8\x9b\x49" +
+		"\xf2\xbe\x9a\x1a\xd9\xac\x77\xcb\x78\x4c\x54\x6b\xf3\x74\x61\x58\xe0\x93\xc3\x7c\x78\x94\xb9\xd0\x23\xca\x76\xe1\x5d\xd5\xc4\x22" +
+		"\xc9\xfb\x90\x32\x7c\x34\x77\x09\xda\xfb\x6c\x97\xb8\x4e\xfb\xbe\x09\xd6\xfe\x9c\x63\x3a\x8d\xec\xce\x69\x33\x9d\xb5\x67\x71\x73" +
+		"\xe9\x1a\xe6\x24\x1b\x0f\x85\xbf\xae\x06\x7f\x6d\x4b\xbc\x50\x8c\x8d\x3b\xc7\xb8\xb8\x0a\xce\xba\xd7\xd6\xc1\x6e\xb8\x0b\x78\xb6" +
+		"\xdd\x41\x47\x3b\x6d\xa9\xbd\xd3\x24\xc2\xe6\x16\xe0\xf1\x4c\x38\xd8\xed\x90\x5c\x10\x67\x1a\x99\x38\xc4\x73\xb3\x9d\xae\x91\x9d" +
+		"\xea\x1c\xb0\xd2\xe6\xce\xbd\xda\x4b\xed\xb4\x39\x53\xab\xb7\x35\xb0\x2b\xa3\x2b\x6c\xdd\x5f\x63\xeb\xbe\x83\x3d\xc3\xa5\x36\x82" +
+		"\x95\xe0\x78\x13\xa7\xa4\x71\x84\x51\x73\x3c\x02\x70\xf1\xa9\x8b\x1b\x6b\x18\x2f\x2a\x26\xbd\x17\xa4\x69\x22\xd9\x98\xdd\xd9\x78" +
+		"\xc8\x52\xd7\xa6\xa3\x68\xd7\x38\x29\xd6\x4b\x79\x2c\x01\x9f\xab\x59\x89\x2f\x73\x1f\xd0\xcd\x0c\xe9\x19\xea\xe0\x7a\x79\xb8\x25" +
+		"\xab\xab\x90\xcc\xc9\x84\x86\x70\x15\xec\x36\x7f\x16\x1d\xfa\x1d\x9c\x01\x8f\x64\x4c\x6f\x0e\xa3\xdd\x68\x63\xf0\xd8\x94\x04\x3c" +
+		"\xe2\x77\xfc\x15\x5b\xfa\xee\x76\xb6\xb6\x09\xbf\xc4\x46\xe0\x16\x3b\xd9\x35\x11\x84\xde\x02\xfe\xdb\xac\x2c\x33\xe0\xa6\x0c\xf8" +
+		"\x27\x93\x3d\x5b\x3a\x65\x0e\xb2\x55\x85\x98\xba\x77\xd9\x18\xc9\x4d\xf6\x6d\x99\xcb\x83\x37\x81\x7b\xae\x37\x27\xb2\x60\x6a\xfa" +
+		"\x02\x3b\xeb\xe5\xb1\x50\x3d\x3e\xa9\x30\xef\xe1\xd7\xa6\xcd\x1b\xd9\x52\xfd\xb1\x59\x88\x1f\xdb\xad\x9d\xe1\xda\xc7\x15\x62\xbd" +
+		"\x8b\x8d\xfb\x68\xdf\x04\x4b\xec\xf3\x1a\x33\x38\x7f\x07\xb3\xe0\xf2\xac\x2b\xf6\xf1\x6e\xea\x6b\x6c\x1d\x97\x80\xb7\x47\xa0\x52" +
+		"\x6d\x72\x51\x02\x2e\x4b\xe3\x8f\x4c\x2c\x40\x47\xdc\x7a\x16\xb5\xe4\x94\x34\xf0\x48\xec\xaf\x1a\x1b\xc6\x52\x93\xef\xf6\x26\x61" +
+		"\xa7\x45\xec\x94\x07\xdb\x82\x43\x32\xee\x09\x64\x52\xc1\x4c\xba\x26\x71\xf2\x3a\x77\xec\x1d\x40\xed\x12\xae\xc3\x5d\x76\xf4\x89" +
+		"\xad\x0b\x27\x7c\x61\xc6\x86\xeb\x7c\x13\xc3\x1a\x53\x3b\x67\x9a\x58\x7d\xdb\xfe\x6c\x64\xdf\xbe\x39\x90\x9a\x9c\x09\xd3\x79\xa6" +
+		"\xaf\xae\x33\xcd\x2b\xd0\xf8\xfb\xe0\x64\x7f\x98\x5f\xd9\xc9\x9a\xdb\x2d\xfe\xc0\xee\xf2\x1e\x5b\x5e\x7f\x34\x87\xf7\x6a\x3b\xd9" +
+		"\x39\x36\xd2\x7f\xb3\xa0\xb2\x24\xc0\x91\xa6\x76\x0b\x7e\xfa\x52\x5b\x52\xee\x9a\x2e\x2c\xd8\x94\x15\x3d\x85\x8f\xaf\x06\x37\x5f" +
+		"\x6d\xf7\x9f\x03\xe9\x7e\xa3\x7b\x5c\xd7\xdb\xe8\x65\x7d\x81\xe6\x02\x51\xce\xd8\x0c\xc8\x47\x7e\xfd\x56\xb0\xd7\xb7\xc1\x65\x39" +
+		"\x9b\x73\x77\x96\xaf\x33\x3f\xb6\xd6\xa4\xa9\x91\xdd\xf0\x79\xe6\x27\x74\x73\x9f\xe0\x5b\x11\xc1\xd4\xd9\x2d\x7a\xbe\xef\x4a\x70" +
+		"\x73\x99\x79\x28\xc9\x3d\x66\xf9\x16\xfa\x19\x7f\x6f\xcf\xf0\x2d\xd3\xd5\xa7\xd9\x73\x9e\x05\xd2\x7e\xf8\xf7\x48\x76\x5e\x07\x7e" +
+		"\xcd\x96\xc9\x45\xe0\xfd\x0c\xbb\xf9\x63\x9b\x6b\x3d\xca\x12\x9a\x94\x76\x00\x4f\xb1\xe7\x77\x35\x52\x6e\xc2\x9c\xb1\xf3\x77\x77" +
+		"\x47\xe6\x66\x70\x6c\x05\x92\x76\xf6\x20\xad\x3c\x55\xd6\x95\x76\x19\x3d\x2c\x03\x1e\xaa\x70\xe0\xf4\xb8\x2f\xa0\xca\x00\x16\xda" +
+		"\x98\x82\x4f\xce\xb0\xab\x55\x78\x98\x5e\x48\xd3\x45\x96\x0c\xb9\x3a\x42\xa6\x6e\x19\xf7\x31\x5c\x0b\xcd\xf0\x15\x58\xd4\x04\x8a" +
+		"\x8d\xb3\xb6\x6e\x9b\xba\x87\xe9\xb1\xcb\x88\xc4\xd1\xa3\xbc\xfb\x44\xae\x6e\x3a\x84\x4b\x5a\x06\xb6\x03\x17\xbb\x0b\xae\x1c\x84" +
+		"\x4f\xd8\xdd\x1e\x28\x34\x8d\x90\xae\xad\xbd\xe8\x60\x83\xd9\xc9\xfe\x24\x38\xb4\xc4\x1d\x8b\x82\x09\x69\xd6\xbe\x38\x98\x01\x20" +
+		"\xae\xca\xd8\x3a\x6f\x19\xe2\xe2\x8a\x6a\x76\xc6\x16\x6c\x31\x59\x35\x21\x67\x37\x50\x6e\x4f\x79\x87\x7d\xe5\x66\x93\xb9\x73\x6d" +
+		"\x6c\xdc\xfe\x9d\xa5\x25\x90\x78\x4e\xe2\xab\xe0\xc6\x32\x70\xcd\x70\x82\xb3\x9e\xa0\x8d\x63\x23\x1b\xad\x93\x42\x8f\x9f\x6f\xd7" +
+		"\xbb\x00\x1c\xac\x67\x76\x50\xe9\x7a\x3b\x63\x09\x58\x0b\xa6\x31\x54\x1a\xaf\xb5\x08\x0c\xa8\xb9\xbd\xd5\x2a\x9c\x89\xe5\xbe\x20" +
+		"\x46\x32\x62\x84\x62\xe4\xd1\x35\x03\xd2\x13\x32\x05\x70\x9e\x3f\xf8\xa1\x6c\x00\xd5\xb9\x38\x55\x26\x46\xb8\xce\x7e\xd9\xde\x86" +
+		"\xae\x59\x4c\xfe\xf8\x4c\x8c\xfe\x54\xba\x8e\xb8\xf9\x3f\x69\xd9\xd4\x3f\x9c\x5b\x05\xce\xcb\x04\x48\x52\x66\x43\x52\x82\x24\x0b" +
+		"\x36\xf1\x00\xd6\xa1\xaa\x81\x7f\x00\x89\x64\xe1\x9f\xc1\x49\x95\xe0\x90\x3c\x38\xca\xf3\x38\x37\x99\x92\x7c\x61\x86\x23\x20\xd5" +
+		"\x60\x67\x3b\xb6\x0f\x13\x9e\x05\x97\x7d\x0f\x7c\xe2\x0e\xc3\x4e\x12\x7f\x86\xdd\x0c\xb5\x78\x82\x3e\x80\xf5\x6e\x96\x6e\x36\x3f" +
+		"\xac\xb5\x3d\x47\xa9\x0d\xeb\x05\xf6\xe7\xe9\x01\x35\x57\xdb\x7a\x2a\xb5\xe7\x78\x8d\x9e\x2e\x3d\x3b\x60\x8b\x32\xf0\xe7\xf6\x28" +
+		"\xd7\xd9\x04\xef\x94\xe7\xeb\xf6\xb1\x31\xd8\xd6\xa0\xe2\xb6\xa9\x55\x4d\xa6\x64\x3c\x22\x96\x18\x5f\x0f\x5e\x68\x97\xac\xb3\x81" +
+		"\x1d\xea\xd6\x6f\x1e\xc3\x47\xce\x21\xf9\xb3\x3d\xe9\xb4\x6c\x2c\xed\x4e\x9e\x0b\x1b\xc0\x00\xea\x1d\x01\x19\xea\x21\x66\xd6\x33" +
+		"\xef\xf7\x20\xf9\xab\x9d\x6a\x55\xd6\xa6\xe1\x87\xf6\xeb\x3b\x2c\x76\xac\x32\xa5\x7b\x91\x45\x1c\x4d\x3d\x28\x24\xf8\x11\xcd\xa4" +
+		"\x9c\x6d\x17\xa8\x05\x3b\x82\xc3\xb2\xf6\x93\xa9\x39\xd3\x51\x3d\x69\x4f\x7a\x9b\x49\xb3\xab\xe1\xb2\xd0\x20\x1d\x2c\x22\x3c\x0b" +
+		"\xfc\x38\xd5\x01\x8d\xec\xbb\xfb\xe8\xef\x5c\xe5\x48\x67\x8d\x69\xce\xbb\x41\x7f\xeb\x44\x12\x0b\x2b\x6b\x77\x71\x9d\x5d\xa6\x44" +
+		"\xaf\x93\x7e\x49\x44\x33\xb3\x0a\x71\xa3\xd5\xe1\xf1\x7e\xd3\x66\xd8\xc3\xee\x8e\xa6\x09\xaf\xb1\xcb\x9d\x5d\x4c\xb8\xbb\x21\xed" +
+		"\xed\x0a\x7b\x7c\x02\x7e\x40\xb0\x67\x69\x3a\x03\x66\xea\xdd\xe2\xde\x87\xc4\x23\xae\x9e\x25\x91\x02\xfc\xb9\xfd\xe8\xd7\xe6\x32" +
+		"\xdd\x1e\xaa\xa3\xda\xcf\x9b\xb3\x28\xef\x5e\x53\xba\xf7\xfb\xfa\x1c\x44\x0b\xb0\xce\xb3\x71\x68\x65\xeb\xf9\x9b\x26\x15\xc3\x12" +
+		"\x47\x1f\xdf\xa7\x9d\xad\x8b\x29\xf4\xbb\x6d\x6c\x1b\x21\x39\xe4\x49\xb5\xc6\x76\xe3\x5d\xcc\x6c\x3d\x4d\x70\x73\xd6\x5e\x16\x7c" +
+		"\x8e\xdb\xa7\xb1\xb6\xe1\x6b\x0e\x95\xb4\x37\x11\xf2\x18\xa2\xd4\x4c\xbe\xa5\x40\x93\xa3\x19\xf7\x45\xe9\xf3\x5f\x19\x56\x95\xa9" +
+		"\x06\x48\xa6\x39\x35\xc0\xb3\x3c\xb7\x7a\xc6\x64\x76\xea\x62\xb6\x01\x45\xe0\xc9\x07\x10\x5c\x6e\x2f\xe4\x15\xd3\xfe\x8d\xf4\xf1" +
+		"\x5a\xe6\x2e\x57\xdb\x08\xb2\x7c\xda\x32\x31\x85\x23\xdc\x0c\x28\x73\xd3\xca\x46\xe8\x14\x1b\xc0\x9e\xb4\x2f\xb4\x8f\x59\x69\x6d" +
+		"\x97\x78\xef\x49\x22\x19\xf5\x2c\xcd\x51\xed\xe7\x59\xc3\x51\x45\x94\xd4\x21\x46\x47\xfe\xaa\xed\x27\x55\x76\xdf\xba\xe1\xc6\xe0" +
+		"\xb0\x42\x44\x2f\x5d\xcc\x48\x5d\xe8\xa6\xe3\x11\x17\x0f\xb7\x0e\x0b\x53\x4e\xca\xf9\x36\xa9\xff\xd6\x92\xd0\x0f\x6e\x71\x3b\x75" +
+		"\xbb\x0d\xce\x2d\x16\x28\xf9\xc2\x3d\x52\xea\x79\x66\x27\x85\x9c\x1b\x59\xca\x53\xed\xea\x07\x73\x48\x56\xd3\x1e\x62\x8c\x63\x38" +
+		"\x4b\x13\x33\x69\x4d\xec\x4b\xd7\x46\x6e\xa4\xca\xde\x69\x14\x30\xb9\x73\x84\x86\x94\xd8\xbd\x7f\xdf\x9e\xa6\x36\x1c\x06\xf7\x7a" +
+		"\x9b\x85\x02\xae\xb3\xb1\x1c\x93\x09\xb7\x7c\x98\x4f\xcb\xc9\x76\xac\xb5\x77\xdb\xd8\x88\xec\xce\x86\x1a\x15\x14\x33\xc6\xc3\x70" +
+		"\xd7\xea\xe5\xf6\xf6\x50\x57\xcd\x9d\xed\x56\x96\x12\xcc\x82\xab\x53\xea\x81\x43\xab\x5d\x52\x31\x4a\x6f\xa6\x67\x55\x5c\xb4\xc8" +
+		"\x2d\x2a\xb8\x9b\xed\x79\x8c\x56\x48\x6a\xec\xfc\x17\xda\x72\xfb\x9b\xbd\x4f\x53\xa8\xb7\xda\x69\xa6\x66\x90\xfc\xc9\xa3\xa3\x4c" +
+		"\x58\xe6\xf2\x80\xe1\xba\xa5\xe9\xef\xc6\xf1\xe0\x4f\xbc\x96\x80\x6b\x4b\x4c\x1d\x38\x5c\x58\x69\xcf\xd9\x34\x30\x89\x73\x3c\x6d" +
+		"\x59\x6d\xf7\x33\x3f\x1c\x81\x24\x05\x6f\x37\x97\x05\x52\xdd\xc9\x56\x7c\x6b\x7b\xdd\x18\x49\x47\x3b\xc5\x69\xfa\x7a\x72\xb1\x29" +
+		"\x98\x8b\x91\x0c\xca\x81\x43\x72\x11\xf7\x47\xda\x9d\x67\xd9\x62\xfc\xaa\x39\x49\xe7\x98\x44\x57\x7a\xb2\x79\xaa\xa9\x29\x7d\xab" +
+		"\x55\x08\x7f\x6d\x40\xeb\xfb\x3c\x49\x3b\x2b\x63\x73\x39\xca\x3f\x1d\x4c\x17\xdf\xb1\x3e\xd0\x22\xd4\x34\xb1\x70\xe2\xdb\xe6\xf7" +
+		"\xdf\x6a\xc2\xe1\xc3\x97\x7a\x37\x6e\xf9\x4f\x32\x31\x69\x61\xf3\x51\x69\xfa\x7a\xb5\x3c\x94\x82\x49\xe4\xd5\xe1\xdb\xb6\xb3\x99" +
+		"\xba\x34\xd6\x81\xe1\x61\xc9\xd5\xf6\xa6\xdc\xcb\xfb\xc0\x0e\xe0\xf2\x8c\xcd\x91\x23\xe7\x27\xc7\xcc\xef\x25\x38\xba\xcc\xb4\xe3" +
+		"\x29\xa6\xd9\xcf\xb4\xc1\xfd\xaa\xab\xc5\x31\xb4\x2c\xd9\x15\x76\x95\x16\xf6\x83\x9b\x02\x67\x77\x64\x69\x9d\xdf\xca\x08\x46\x6c" +
+		"\x7a\x07\xb8\xb4\xcc\xbe\x73\x83\xdd\x52\x73\x43\x23\x5a\x46\x26\xe5\x6a\x3b\xcf\x0d\xf6\xda\x11\x94\xff\xb1\x53\xdd\x6a\x4f\x71" +
+		"\x7b\xe0\x7b\x53\xcb\x22\x53\x52\x6a\x02\x75\x85\x5d\xdb\xbd\xa4\xf3\x4d\x8d\x56\xda\xb4\xba\x1d\x5d\xec\xf8\xfa\x52\x7f\xb6\x72" +
+		"\x90\xee\x07\x9e\x16\xa6\x95\x76\x2b\xe6\x11\x25\x9a\xbe\x6c\x11\xd4\x6b\x1b\x88\xad\x2f\x2e\x27\x80\x10\xc9\x5a\x85\x90\x8c\x3b" +
+		"\xa8\x8e\xac\xe1\x49\x91\x27\xab\x0b\x19\x19\x9a\xf8\x12\xbb\x0e\xc9\xb9\x36\x74\x2e\x46\x9e\x05\x3f\x15\x3c\x9c\x09\x70\x60\x6d" +
+		"\x17\xf0\xbd\xd3\x3c\x35\x9e\x09\x0c\xa8\xa9\x5d\xad\x9b\x1c\xd4\x94\x9c\xf5\x2a\x23\xb5\x32\x38\x17\xfa\x73\xb6\x3b\xdb\x2e\x83" +
+		"\xad\xcd\xba\x8c\x2d\x41\xe2\x71\xc8\xef\x63\x2d\x6e\x77\x4b\x79\xb7\x9d\x7e\x43\x26\xe2\xb6\x53\xe2\xc9\xaa\x2d\x9b\x72\xaa\xd3" +
+		"\xd5\xba\x13\x3c\x5c\x62\x8f\xfb\xdf\x76\x27\xbd\x18\x2e\xca\xb5\xe0\xf5\x26\x3c\x57\xda\x6d\xdc\x15\x99\xc8\x12\x13\x93\xc9\x25" +
+		"\x91\x6a\x98\x9a\x84\x7e\x65\xa8\xf8\x1c\x92\xaa\x70\x98\x47\xe6\x9c\x69\xe6\xcc\xa6\x7c\x04\xf6\x25\x26\x63\x4d\xf5\x5d\x0b\xd4" +
+		"\xfb\xd1\x83\x97\x2a\x1b\x37\x27\x37\x9e\x65\xbf\x3a\xd7\xb4\xc8\x0f\x23\xef\xe2\x98\xaf\x83\x62\x59\xf0\xff\x4c\xa4\x0e\x15\x4c" +
+		"\x76\x1c\xd6\x4d\x72\x76\x11\x47\xe1\x7d\x15\xcf\xc8\x46\xac\xe0\x50\xa2\xb3\x24\x3c\x5a\x18\x9a\x71\x84\xa7\x22\xce\x3d\xc1\x29" +
+		"\x63\x53\x92\x10\x87\xf6\x60\x47\xbb\xf6\x79\x60\x69\x03\xe8\x88\x76\xae\x53\xec\x0a\xa3\x7d\x82\x27\x14\x63\xe9\xb9\x29\x3b\xe4" +
+		"\x9a\xc8\x36\x7b\xf0\x7e\x59\xc4\x73\xa7\x98\x30\x76\x09\x6f\xd1\xa1\x8b\x8b\xc0\xe3\x15\x60\xd7\x2c\x92\x82\xd9\x76\x57\x65\xdf" +
+		"\x8f\xa8\xd1\xd1\x7e\x5e\x61\x46\x7a\xaa\x4f\x74\x6d\x64\x74\x36\x9b\xbe\x48\xea\x3c\xcf\xe7\x93\xb3\x41\x02\x5e\x02\x4e\xc9\x17" +
+		"\x11\x94\x96\xe0\x81\x19\x6e\x09\x6a\x6d\xae\xcf\xb2\x9b\x29\x8d\xf1\xb8\xc6\x14\xdd\x45\xe0\x41\x82\xa3\x13\x70\x94\x0f\xc4\xa6" +
+		"\xc4\xd7\xc2\xe8\x52\xfb\xda\x45\x60\x21\x42\xf4\x56\x60\x1e\x89\x4f\xe9\x55\x36\x64\x8e\xf7\x9f\xef\x8e\x6d\xc6\x09\x23\x75\xe1" +
+		"\xe6\xd7\x06\x84\x58\xed\xe8\xec\xad\x11\x52\xf4\x4d\x7d\x92\x5c\x0c\xd8\xc9\x91\x07\x6b\xea\xd6\x6b\x15\x53\xd4\x28\x29\x09\x50" +
+		"\xd7\x1d\xe7\xbb\x7d\xe8\xe7\xbb\x39\x1f\xef\x60\x70\xab\x88\x53\x4e\xb8\x14\xfc\xd2\x3c\xda\x3b\x7c\x81\x8d\xc8\x07\xed\xea\x2e" +
+		"\x43\x1a\x9d\x8e\xe5\x33\xa4\xf1\x9b\x55\x6a\x42\x97\xe2\xb6\xdf\x0d\x4c\xf5\xdb\xa6\x00\x7e\x6e\x5a\xbb\xb5\x73\x29\x7f\x64\x6e" +
+		"\x09\x5d\x92\x5c\x8a\x4e\x94\x07\x2b\xe2\x2e\x3b\xde\x68\xd7\xfa\x71\xb0\x79\xda\x86\xfb\xe8\x29\xf3\xab\xcc\x0b\xbc\xcc\x5e\x2f" +
+		"\x73\x1f\x5d\x6a\xba\xab\x27\xe8\x4f\x0f\x77\xd0\xfd\xc2\xcb\xed\xcc\x97\xda\x32\xf1\xa9\x72\x3a\xca\x9d\x61\xd5\x4f\xb2\x07\x3f" +
+		"\xc3\x2e\x34\x85\xe0\xcb\x4e\x61\xee\x62\x9f\x7c\xd3\xbe\x38\xcb\xa1\xca\x8b\x83\x34\xd4\xda\xa1\xce\xf1\x2e\xd0\x1b\x9d\x23\x36" +
+		"\x3e\x0b\x16\x71\xea\x94\x78\x31\x2c\x71\x12\xe2\xf9\x81\x01\x36\xb6\x2c\x08\xb3\xe0\xbc\x24\x20\xc0\xcb\xc1\xb9\xb9\x30\x65\xcd" +
+		"\x53\xb8\x26\x59\x9b\x46\x2b\x1e\x80\x4b\xb6\x58\xa4\x7e\x6d\x4f\x82\x9b\x71\x7a\xe4\x50\xdc\x8b\xb9\x0d\xfc\xa9\x5d\x7c\x77\xd6" +
+		"\xa6\xfc\xa8\x33\x7f\x4e\x45\xe2\x14\x8f\x3b\xec\x17\x45\x7c\xe9\x6c\x1b\xc5\x46\x76\x85\x26\xe1\x72\x5d\x08\xf6\x2a\x20\xd9\xfc" +
+		"\x9f\x40\xae\x60\x2b\x6a\x90\x43\x83\x45\x86\xa4\x53\xfe\x96\xd2\x16\x7f\xcb\xc0\x0b\xbf\x0f\x1e\x2f\xb5\x73\x3a\xc2\xf0\x1d\x1b" +
+		"\xb9\xce\x76\xdd\x1f\xd9\xdc\xd6\xd8\x0f\x7a\x78\x16\xb7\x8b\x9d\x6d\x77\xa9\x4d\xcc\x55\x26\x34\x79\xbb\xc6\xc5\xa6\xc5\x2e\xb7" +
+		"\xb9\x19\x5c\xb0\xb7\x5a\xd9\xa9\x68\xc7\xd3\x2c\x50\xf8\x0e\xb8\x2f\x6f\xa3\xdb\xd1\xde\x3d\xdd\xae\xd4\x2a\xd8\x9e\xa5\x81\xb2" +
+		"\x55\xda\xd3\x8a\x86\x53\x30\x25\xed\x6e\x45\x92\x71\xa5\xe5\xa8\xc3\x72\x82\x93\xb3\xa1\x07\x32\xbe\x8c\x8f\x26\x69\xe4\xcc\xaa" +
+		"\x48\xfa\x97\x84\xdc\x2b\x81\xe3\x6a\x70\x52\xc3\x48\xbf\x57\xd6\x16\xce\x77\xc3\x6b\x75\x1e\xc4\x39\x11\x84\x7e\xd7\x9e\x79\x54" +
+		"\x85\x69\xee\xf2\x70\x09\x77\x67\xe2\x0c\xf7\x04\x95\xf9\x88\x4f\xd1\x56\xda\xdc\xa5\x2c\x9e\x31\x0c\xe8\x56\x6c\xc4\x04\xc9\xc6" +
+		"\x8c\x16\x53\x32\x8d\xe0\xf6\x7c\x2c\xdf\x41\x05\x9f\xb7\x36\xe0\x43\x33\x93\x58\xaa\xe5\x69\xce\x25\x71\xdf\x79\x52\x06\x99\xb5" +
+		"\x49\xb8\xa1\xcb\x08\x76\xcf\xb8\xa3\xde\x32\xd2\x79\x9e\x31\x6c\x62\x62\xe3\x19\xcc\xcb\xb4\x34\x92\x4a\x70\x54\x69\x70\x92\xbb" +
+		"\x98\xf2\xea\x18\x50\x55\xbf\x0c\x38\xc3\x3d\xbc\x76\x41\xca\x29\x80\xcb\x32\xff\x49\x7f\x26\x12\xe6\xfd\xae\x89\x47\xc4\x9b\xc6" +
+		"\xc0\x28\x52\x87\x7c\x05\xad\xf0\x8c\x73\xea\xda\x75\x2b\xd8\x8a\x74\xf2\x5e\xb5\x49\x9a\x53\xfc\x4a\x6d\x9c\x6f\xb3\xfb\x70\xdc" +
+		"\xfe\x0a\xd3\x84\x06\x0e\xf0\x02\xd7\x99\x87\x4b\x82\x58\x70\xa3\x09\xc9\xed\x60\x8d\x29\x84\x26\xf6\xdd\xac\xbd\x79\xa6\x29\x19" +
+		"\x46\xd2\xa6\x63\x78\x01\x1d\x6c\x70\x92\xac\x53\x3e\x72\x81\x5c\x6e\xf7\x24\xd4\xef\xcc\x3e\x5d\x66\x37\x72\xb1\x79\x69\xad\xec" +
+		"\xd9\xce\x8c\xd8\xef\x6c\x7b\xf3\x84\x0b\x57\xbd\x4f\xe8\xc1\x4a\x13\xf0\x1a\xd3\x8d\x5d\xec\x1e\xee\x35\xf1\x3f\x39\xd6\xa4\x33" +
+		"\x2e\x3d\x73\x72\x1f\xf8\x4f\xb0\x47\xe2\xde\x83\xfb\x5d\x5d\x7d\xaa\xca\xec\x5a\xc6\x21\xa6\x33\x51\xeb\xc0\x31\x59\xd3\x0b\x4e" +
+		"\x40\x11\x55\x2a\xd5\x24\x75\x21\xc5\x59\x73\x83\xf6\xbb\xbd\xbd\xc3\xf1\xea\xca\x48\xc9\x94\x83\x5d\x1d\xb0\x74\xef\xb1\x99\xcd" +
+		"\xe2\xb9\x36\x44\x9b\x72\x11\x21\xe5\x42\xa4\x76\x12\xa4\x5f\xa3\x24\x05\xf9\x92\x8e\xf6\xe1\x57\x6c\x8d\x1f\xcf\x78\x66\xb9\x73" +
+		"\x20\xe1\x5f\xb3\xd7\x4e\x25\x76\xff\xa0\xc4\x5e\x3f\x4a\x03\x5b\xce\xb3\x49\x3e\xe2\x62\xf1\x07\xcb\xac\xfd\x0d\x99\xdd\xb9\xf0" +
+		"\x1d\xa5\x95\x2a\xc1\x9b\xec\x44\xdf\x45\xf2\x8f\xd0\xaf\x1d\x03\x39\xbb\xc5\xcc\xcd\x37\xfd\x3c\xa5\x61\xd8\x9c\x47\xd1\x31\xd2" +
+		"\x90\x99\xa0\xb3\x0c\x70\x6f\x51\x43\x57\x8b\xa4\x69\x28\x37\xad\xa7\x33\xec\x55\xce\x2c\xdb\x6f\x4d\xe4\x1e\x62\xe4\x7a\x72\xe1" +
+		"\x8a\xfb\x54\xe7\x6d\xc4\xb7\xfa\x5d\x5f\xe1\xa3\x92\x49\x21\xcc\xa4\x06\x49\x13\xfb\x51\x6b\xfb\xba\x3c\x8b\x7b\xed\xa9\xe5\x4c" +
+		"\x5f\x18\xf3\x78\x8a\x49\x6b\xa3\x58\xc6\x33\x12\x0f\xb4\x33\xc1\xaa\xf6\x71\x6f\x15\x2a\xa0\x57\xae\x41\x86\xa7\xb1\x63\x03\xb9" +
+		"\x20\x51\xd5\x33\xbc\x60\xb1\xf0\x73\x91\xb0\xbf\xcf\x3e\xff\x9a\x9d\xa5\x2e\x9e\xef\x36\x33\x78\x87\x7d\x36\xc7\x3a\x3d\x2a\xcd" +
+		"\x73\x7c\x3f\x2e\x3f\x26\x09\x3c\x65\x92\x73\xc5\x59\x17\xea\xaf\x8f\x0f\x7a\x1b\xbb\x40\xa3\x90\x8d\x2a\xf3\xbf\x93\xdf\xdb\x14" +
+		"\x5e\x01\x3a\x0a\x51\x0d\x4e\xca\xc7\x44\xca\x76\x77\x31\x09\x7b\x43\x23\xb2\x5a\xd6\xf2\xcb\x19\x49\x44\xf7\xee\xf8\xae\x75\xb0" +
+		"\xf2\xbe\x22\xbb\x62\x2e\xc1\x65\x0e\x4e\xb5\x0e\x0a\xa5\x6b\xfd\x4c\x40\xa8\x99\xe0\xec\x77\x06\x87\x94\xc6\x8a\x15\x60\x97\x80" +
+		"\x9b\xbc\x30\xa2\x57\x0a\xe6\x5c\xe1\x15\x1d\x2b\x4a\x4d\x5c\xcf\x01\xaf\x74\x54\xe5\xcc\x30\xc7\x19\x57\x44\x13\x1c\x60\x69\x8c" +
+		"\xa4\xad\xfd\xe8\xf4\x20\x76\x34\xb5\x6b\x77\x0a\x11\x13\xb4\x34\x26\x17\x96\xc7\x71\xf0\x93\xc0\x3e\x55\xb1\x16\xaf\x0a\xc5\x72" +
+		"\x65\xf0\x36\x18\xe4\xb1\x93\xed\x51\xcf\x74\x48\xea\x0a\x47\xe0\x8f\xba\xc2\x6b\x6b\xe2\xe5\x0c\x0f\xa7\x90\xb6\x0c\x29\x6c\x1b" +
+		"\xb8\xca\x60\x5f\x46\x55\x91\xd3\x6c\x69\xfa\xa8\x4b\x7a\x17\x0a\xb4\x16\xab\xfa\x2c\x1b\x50\x4a\x55\xf8\x14\xc9\x1d\x9e\x03\x73" +
+		"\x27\x71\x83\x7b\xc3\x87\x9d\x9d\x5d\x01\xf6\x34\x9c\x22\xd5\xf1\x27\x12\x24\xcb\x93\x40\x10\x08\x6e\x22\x38\xc7\x57\x6a\x7f\xcf" +
+		"\x9c\x3a\xc6\x54\x03\xe6\x90\x6c\xca\x14\xaf\x58\x6b\xa3\xe4\x94\x11\x9a\x72\xab\x02\x57\xe5\xb4\x56\x13\x27\xb1\xfe\xd9\x21\xe8" +
+		"\x46\x60\x1a\xf4\xb7\xf4\xb2\x80\xad\x9e\xf3\x6c\x6f\x34\xf4\x6f\xda\xd9\x6f\xb0\x1f\x34\x03\x97\x96\x84\xd2\x76\xd6\xf9\xf8\x8c" +
+		"\xbb\x2a\x35\x01\xd0\x37\x72\x4a\x56\xc1\x95\x9a\x2b\x9e\x0a\x3b\xdf\xd7\x63\xb8\xda\x21\xc9\x07\xb8\x54\x11\x2c\x8b\x4d\xbe\x94" +
+		"\x6f\x72\xc4\x78\x54\x2e\x22\x17\x5f\xc2\x05\x27\x58\x36\x0b\x24\x66\x37\xc1\x0c\x38\x32\x13\xb7\x52\x1e\x74\xc1\x43\x89\x93\xc7" +
+		"\x46\xb8\x3e\xf9\xbb\xe9\xb8\x82\xdd\xdd\x37\xcd\x27\xfa\x56\xf0\xcc\xcf\xb0\x33\x35\x33\x0d\x78\x1a\x38\xbf\x0c\xc9\x3a\x77\x16" +
+		"\xef\xd4\x20\xa5\xe0\x4f\x6d\xba\x3e\x12\xe7\xfd\xf3\x5e\x07\x13\x6b\x22\x58\x6c\x64\xd2\x71\x1a\xb8\xce\xe3\xfb\x46\x91\x42\xaa" +
+		"\x74\x3e\x80\x57\xb9\xf4\xf3\x65\xfd\x2f\x9b\xd9\xbf\x78\xa4\xd0\x2b\x1f\x00\xa2\x8b\x72\x9d\x53\xa9\x0b\xae\x5a\xda\x80\xdb\x66" +
+		"\x7a\x60\x9a\x05\x3b\xb8\x33\x35\xa5\x2a\x4c\xbd\xbf\x5d\x03\xf6\x2d\x0d\x67\x57\x38\x7d\x69\xe4\xac\xeb\xe9\xee\xd4\x3d\xe0\xf8" +
+		"\x5c\xb8\x59\xa5\xae\x76\xe7\x65\xcc\xd1\xb1\xbc\x90\x5b\xa1\xab\x4c\x2d\x2e\xa7\x9d\x74\x6a\x11\xdb\xac\xb1\x4b\xf9\x2c\x95\xa5" +
+		"\x26\x24\x51\xfc\x59\x9f\x8b\x24\x37\x3b\xdb\x8a\xb8\xc2\x2e\xfa\x2f\x03\x62\xbe\x11\xd0\x47\x3b\xf0\x48\xe2\x5e\xd4\x5d\xe0\xdd" +
+		"\xe0\xa8\x6a\x7d\x94\x9c\x1c\xfe\x8d\x47\xbb\xef\xd1\x4b\xc5\x9c\xb9\x7a\xaa\xfd\x5a\xa9\xfa\x42\x64\xdd\xaa\x03\x24\x60\x7c\x63" +
+		"\x02\x6d\x48\x56\xbb\xe6\xdc\x97\x0d\x87\x31\xeb\xc8\xaf\x29\x0e\x03\x58\x07\x65\xfe\x33\x83\xba\x68\x85\x63\x0a\x03\xb2\x0d\xb0" +
+		"\xe1\x8e\x4e\x28\xcd\xfb\x52\x5c\x91\x16\x02\x79\x39\x4c\xa5\x2d\xe8\xef\x5a\xcc\xcb\x42\x60\xfc\x45\x5b\x3c\xda\xb3\x62\x35\x91" +
+		"\x83\xf5\x3a\x8d\x8e\x26\x55\x9d\x43\xec\xca\xec\x21\x4e\x09\x0f\x69\x42\xd6\x83\xd5\x3a\x24\xbb\x8b\xe1\xb8\x5b\x82\xb4\xe8\xa1" +
+		"\x06\xff\x49\x78\x37\x0f\x8f\xf0\xab\x7e\x6b\x07\x3d\x13\x5f\x1e\x68\x53\xde\x54\xc0\xa9\xf6\xcf\x83\x8e\x4b\x1c\xa7\x9c\x92\xb3" +
+		"\xdb\xb9\xdc\x26\xb7\x99\xcd\x6f\x3b\x90\x21\x72\x9b\x73\x76\xc3\xad\x23\xd1\xb5\x89\xce\x6f\xaa\x0b\x3b\xe6\xa9\xa7\x1c\x78\xae" +
+		"\xdd\xc4\x56\x77\xa7\x7e\xe9\x15\x6a\xf9\x40\x73\xd2\x41\x4b\x2b\x2d\x7d\x09\x1f\xca\xc4\xf0\xe4\x8b\xce\x4d\x06\xac\x42\xe2\x2f" +
+		"\x24\xdd\xab\x19\x7e\xbd\x88\x03\xbf\x06\x5f\x4c\xa9\x53\xdf\xb1\xe7\x39\xdf\xfc\xb4\x65\x34\x1d\xdd\xde\xe6\xe0\x74\x9d\x2b\xb3" +
+		"\xb7\x34\x32\x76\x6b\xed\x94\xbc\xc2\x1d\xd6\x2c\xd8\xdd\x39\x6d\x3b\xe8\x79\x80\x13\x2c\xa6\xa0\x36\xe5\x23\xf3\x9d\x77\xbd\x20" +
+		"\x64\x39\xe7\x36\xa9\x0d\x12\xff\xd2\x42\x82\x2d\xc1\x95\x13\x13\x2f\xdd\x38\xcf\x81\x5d\x82\x73\x4b\xc1\xa5\x5a\x69\x65\x1e\x6c" +
+		"\x77\x2b\x8f\x99\xa9\x41\xd2\xcc\x55\x5d\x5a\x13\x3c\x58\x4f\xe1\x7c\xea\xb3\x82\x08\xd8\x18\xc9\xa9\xe0\xe6\xc4\x4e\xf9\x7b\x70" +
+		"\x6a\x6d\x30\x1d\x6b\xc2\xd7\xcb\x20\x59\x57\x08\xc4\xe0\x84\x8f\xd2\x76\x2f\xec\xab\xa7\x97\x5d\xf8\x67\x6b\xed\x55\x52\x70\x9b" +
+		"\x37\x37\x41\xa2\xf5\x9c\x41\xb2\x98\x8e\xa2\xfa\x94\x34\x0b\xc7\xbe\x6f\x06\xc9\x06\x9f\x55\x87\xd5\xdd\xa3\xdf\x94\x26\x90\xb3" +
+		"\x1e\xf7\xd0\x4b\x99\xb2\xa6\x99\xfa\x54\x9b\x80\xdd\x10\xa4\x99\x73\x03\x9b\xdc\x5b\x12\x9a\xed\x64\xf7\x25\xee\xf2\xb5\xb4\xd3" +
+		"\xd5\x53\x0b\x90\x3e\x08\xbb\x13\xd7\x39\x8d\x23\xb4\xf3\x00\x75\x2d\xdd\x32\xa4\x39\x58\x73\x37\x12\x91\x9e\x6e\xb0\x08\x38\x55" +
+		"\xa4\xcd\xed\x64\xa5\xe1\x07\x9d\x1d\x72\x75\xb4\x88\xa8\x94\x79\x32\xaf\x3c\xc4\x2d\xef\x12\x90\x82\x28\x55\x8e\xac\x8f\x67\x03" +
+		"\x81\x2c\xf3\x42\xb8\x5e\x49\x00\xba\xfb\xe8\x1a\x78\x47\x0a\x31\xe6\x1d\x73\xf1\x7f\x47\x19\x05\x10\xbf\xb2\x29\x1c\x9d\x0f\x04" +
+		"\xbb\xab\x8b\xea\x59\xe0\x01\xf1\xdb\xb6\xdb\xf2\x4b\xf6\xba\xa0\x8f\xad\x00\xa7\x69\x6d\x2c\x52\x68\xd5\xb7\x22\xa2\x67\x82\x43" +
+		"\xdc\xfe\x8d\xf6\x6a\x96\x01\x35\x21\x19\x95\xe0\x80\x26\x69\x98\xe8\x20\x55\x9a\x05\xdd\xe8\x93\x35\x24\xc5\xe4\xeb\xdd\x2a\xf5" +
+		"\x74\xd4\xf9\x3c\x70\x71\x02\xd2\x99\xf8\x59\x9f\xf7\x31\x29\xf3\xeb\x90\x8b\xc3\x84\x42\x68\x76\xcf\x5d\xac\x2d\x49\x33\xe7\x49" +
+		"\xdb\x62\xc0\x72\x4f\x2c\xe3\xb9\x52\x64\x35\x91\x15\x3f\x0d\x49\x1b\xb0\xa3\xf3\xf3\x1b\x87\xc2\x2d\x73\xed\x50\x64\xa6\x2d\x2c" +
+		"\x04\xc4\x66\xd9\xfc\xa4\x59\xf8\x1b\x9b\xdc\x9c\x6d\x77\x87\xd0\xb3\x33\x8d\x02\xf1\x9e\xeb\x9a\x61\x0e\x91\xcc\xca\x38\xbb\x27" +
+		"\x07\xee\x28\x09\xc4\xd8\x48\xe4\x49\x67\xbb\x77\x37\xaa\x4d\xed\x85\x13\x21\x44\xe3\x9c\xec\x80\x56\x3f\x86\x03\x73\xd8\xf3\xaf" +
+		"\x0b\x8b\x54\xb9\x79\xff\x11\xb3\x61\x6e\xf5\x76\x66\xc0\x69\x19\x90\x91\xb8\xdb\xe8\xac\xa9\x0e\x36\xdc\x59\xbf\x0f\xb7\x79\x5f" +
+		"\x05\x3f\x93\xc3\x7d\xa2\x2a\xf4\x6f\x8d\x17\x83\xcd\x70\x70\xe3\xcf\x0e\x0d\x8d\x2d\x80\x9b\xbc\xae\x7d\x76\x45\x2a\xca\x96\xc8" +
+		"\xeb\x46\x5f\x40\x63\x9a\x44\xe0\x30\xbb\xe0\xab\x56\x0b\x62\xac\xbb\xe2\xdd\xca\xc3\xf5\xa3\x07\xef\x3f\x72\x83\x6a\x19\xac\x64" +
+		"\x7e\x02\x1e\xbf\x11\xdc\x72\x5d\x38\xfc\x83\x33\xee\x8c\x4f\x2d\x78\x28\x33\xa1\x2a\xb8\xfa\x67\x46\x49\xaf\x27\xe3\x07\xa7\x78" +
+		"\x76\xbd\x97\x29\xed\x77\x7d\xe0\x12\xe0\x84\xfc\xc5\xbe\x20\x8f\xe4\x02\x67\x51\x58\x5d\x61\x22\x3d\xac\xd4\x06\xbc\x1c\x5c\x5c" +
+		"\xf0\xd3\x8c\xcf\xbb\x4b\x4c\x0f\x40\x7a\x26\xc1\x18\x4f\xeb\xc3\xca\xbd\x1a\xe9\xaf\xa6\x76\xfb\x79\x1d\x77\x89\x3e\x4d\xde\x56" +
+		"\x54\x31\xa8\x69\xa0\xa3\x63\x94\x80\xad\x04\xe7\xe5\x8a\xc3\x36\xa7\x02\xa4\x0f\xa6\x46\x66\x4c\x4d\x04\xcb\xfb\xf5\xa0\x75\xe0" +
+		"\xc8\xf4\x61\x37\xd9\x19\x39\x21\xef\xc4\xcd\xa3\xb5\xe0\x09\xbf\x6f\xa5\x97\xa7\xb5\x48\xd9\xac\x1c\x54\xac\x21\xcb\x78\x96\x2c" +
+		"\xad\x6f\xde\x97\x0f\x07\xb3\x3e\x0f\xee\xcb\x85\x4e\x1a\x9f\x52\xfd\x94\xd0\xdc\x59\x1a\xd9\x8f\xb2\xa0\xe9\x54\xda\x2f\x93\x7a" +
+		"\x5f\xa5\xc3\xd2\xa0\x24\xe3\x95\xbd\x1b\x8b\xfe\x56\xc6\x21\xe8\x3e\x1e\x0a\xce\xf0\x8c\xd8\x60\x77\x1c\x5b\xdb\xf3\x2c\xcd\xb8" +
+		"\x03\x74\x07\xd8\x93\x48\x1a\x83\x6b\x66\xf8\x98\x0e\x2b\xd6\xfb\xee\xad\x03\x27\x94\x16\x67\x66\x6b\x19\xb8\x54\x95\x06\xcd\x7c" +
+		"\xe0\x37\x3b\xba\xb7\xa2\xc2\xf9\x3f\xcb\x12\xcf\x25\x65\xc0\x7e\x95\xe0\x06\x57\x33\x73\x6a\xec\x7f\x5a\xa4\x63\xdb\xd8\xdd\x28" +
+		"\xbc\xeb\xa5\x67\xc8\x7b\x59\x6b\xd6\xe1\x9f\xe5\xca\x87\x96\x23\x69\x89\x64\x6d\xb9\xdf\x7a\xc6\x01\x89\xd5\x19\x77\x73\xaa\xfd" +
+		"\xdd\x01\x04\x37\x65\x4d\x05\x3b\x59\x66\xb2\x5b\xce\x59\xb9\x14\xfa\xd7\x33\xf5\x7f\x3a\x01\x07\xb7\x06\x57\x4b\x29\x54\x84\x97" +
+		"\xd6\xbd\x00\x2e\xae\x01\x07\xcb\xf2\x35\xf3\xba\x32\x97\x85\x8d\x25\x31\x03\x23\x2a\xc1\xfa\x5a\x7b\x34\xd5\xad\x4e\x51\x61\x84" +
+		"\x5b\x9a\xf1\xd5\x11\x7c\xf7\xaa\x00\xfb\x65\x2d\x4f\x94\x08\xd7\x50\x0b\x0b\x5f\xfa\x3d\xf3\xa1\xbe\x46\xe6\x5d\xed\x4d\x20\xb8" +
+		"\x60\x54\xe2\xb9\x9f\x9e\xe7\x82\xdb\xcf\x40\x32\xb6\xb9\xd3\x35\x0e\x9e\x64\x37\xbd\xb1\x83\xfd\xea\x36\x70\x6b\x45\xf8\x5d\xaf" +
+		"\x74\x04\x17\x77\x01\x97\xd6\x81\xab\xcb\xc0\xc9\xe5\xe1\x2f\x76\x4f\x7c\x60\x36\x15\xbc\xa2\xf0\xb7\x76\x33\xc9\xd4\x3c\x92\xee" +
+		"\x89\x93\x72\x53\x02\xe8\x17\xf7\x0b\x3c\x38\x07\x1c\xd6\x04\x1c\x74\x8e\x13\xd9\xcf\xf6\x8a\xfe\x79\x8d\xc0\xb9\x15\x3e\xad\x19" +
+		"\xb0\x5b\x8d\x3d\x8e\xad\xce\x61\xf4\x6e\x08\x6f\xcd\x94\x14\x9c\x04\x76\xbd\x05\x9c\x75\x32\x38\x46\x75\x56\x9e\x63\x18\xf8\x5f" +
+		"\x60\xd7\x0b\xc0\x11\xb2\x2f\x15\x48\xf6\xdb\x69\x92\xc3\x6d\xbd\x21\xc1\x82\x1b\xc1\xb5\xed\xc0\x69\x9d\xc0\x6d\xcf\x6b\xe8\x9f" +
+		"\x4a\x8b\x2d\x57\xbb\x3e\x7c\xa7\x27\xc1\xf7\x67\x10\xbc\xc0\xe3\x87\xa5\x59\x17\xe6\x4f\x3d\xe5\xf5\x17\x37\x9f\x83\xdb\x83\x93" +
+		"\x9a\x82\x7d\x2a\xc0\x45\x5f\x03\xf7\x57\x82\x07\x2a\xc1\x6b\x1c\x73\xfe\x51\x84\xaf\xa3\xca\x5d\x0a\x37\xa7\xfe\xda\xf2\x4a\x37" +
+		"\x06\xb7\x81\x8b\xcb\x7d\xa8\xe6\xb7\x05\xc7\x78\x58\x25\x19\x5a\xdb\x1c\x1c\xae\x17\x4d\x1d\xc6\x6f\x16\x19\xe0\x42\x51\xfd\x48" +
+		"\x68\xb3\xe0\x4f\x90\xd4\x57\xbb\x9e\x3f\xc7\xb5\x46\xaa\x81\x6e\xb3\x78\x8c\x6e\x8b\x8f\xb8\x20\xf5\xcb\x39\x26\xd7\x8f\x60\x9f" +
+		"\x53\xa3\xb7\x84\xe3\x18\x3b\xbc\x1e\x62\xaa\xd6\x6a\x73\x70\x4c\x2b\x70\xfc\x9d\xe0\x93\x23\x1c\x55\xf1\x7a\x05\xaf\xdb\x6d\x13" +
+		"\xb4\xec\x2e\xe0\x85\x51\x89\x78\x92\xfd\xf3\xb0\x66\x4e\x99\x27\xee\x73\x9e\x5a\x48\xc5\xef\x6e\x83\x13\x8f\x12\x9c\xea\xde\xe0" +
+		"\x48\x46\xd8\x31\xa0\xc4\x3d\xcb\x42\xa8\xb4\x7c\x50\x58\x26\x15\x2c\x9e\x67\x3b\xaf\xfe\x77\xeb\x34\x2f\x3d\x71\x1b\x27\x1b\x9d" +
+		"\x03\x4e\x65\xb1\x0e\xa0\x14\x1c\x56\x16\x99\xcd\x5c\xa8\xfa\xda\xc8\xa5\x28\xed\x54\xee\x3a\x33\x8d\x99\x4b\xc3\xcd\x65\x10\x91" +
+		"\x6b\xc1\xa5\xce\xd8\x75\x8a\x4e\x8b\x34\xdd\x9c\xd4\xba\x57\xbb\xa1\x80\xa4\x3e\x75\x26\x66\xa4\x44\xbf\xbd\xd5\xe0\x0c\xd5\x6f" +
+		"\x56\x44\xa3\x93\x54\x7f\x54\xfb\xc2\x99\xd6\x3a\xee\xbe\x12\x1c\xd1\x34\xea\x3e\xf3\xe0\xe3\xc3\x1d\x61\xbb\x39\x1c\xc7\x0e\xe6" +
+		"\x4d\xde\x16\x04\x98\xcb\xc1\xb3\xcc\xc9\xaf\xb0\xfb\xb9\xce\x15\xc2\x1d\x6e\xc0\xcd\x99\x4b\x86\x7a\x25\x8a\x0d\x43\x1b\xab\x37" +
+		"\x98\xe7\xe9\xc0\x16\xf6\xc5\x93\x2c\x1c\xcb\x47\xa1\xd5\x8d\x41\x45\xf7\x62\x36\xf5\x3c\x28\x8b\x3c\xef\x0c\x46\x2e\x21\x17\x20" +
+		"\xde\x4e\x37\xe2\xcb\x68\xbf\xf1\x02\xd8\x5a\x93\x88\x93\xa3\x9c\xb9\xdc\xc6\x84\x25\x3e\x2d\xd9\xe2\xad\x15\xeb\xa2\xea\x42\x7a" +
+		"\x3d\xc5\x2b\x63\x73\x47\xb1\x6e\x22\xd3\x20\x89\xbc\xdf\x4f\x38\x8d\xc5\xbb\xf1\x7f\x97\x45\x28\x5a\x66\x97\xf5\xd9\x3a\x2b\x02" +
+		"\xe0\x92\x20\xd3\xf4\x2d\x75\xb7\xb4\x0e\x89\x9b\xa6\x16\xe0\xe9\xe1\x8d\x37\xb2\xa1\x75\xb0\xaa\x22\xe0\x80\x23\x95\x0d\x6a\xa9" +
+		"\xf3\x2e\x11\x59\x1b\xa0\x42\xa0\x28\x5d\x4c\xae\x5b\x3a\x48\x56\xe7\x5d\x30\x66\xbb\x67\x54\x12\x58\x70\x89\xcd\x93\x1b\xc2\x7b" +
+		"\xc0\xef\x05\x7d\xb0\x0a\xfc\x4d\x50\xfd\xcf\x00\x3b\x81\xcb\x1c\x96\x5a\x55\xf0\xc7\xcb\x04\x9e\xe3\x75\x98\x4d\x9d\x06\xe5\x01" +
+		"\x8b\x4c\xa8\x3d\xa9\x65\x4f\x04\x96\x1c\x29\x03\xf7\xb5\x72\x59\x2f\x09\xa2\x70\x63\x5f\xff\xfe\x84\x75\x81\x8e\xd4\x22\x11\xb5" +
+		"\xef\x54\x70\xb4\xd3\x48\xe7\x66\x22\x16\xaf\x0c\xbe\xd1\xa8\xa6\x51\x1d\x91\x01\x3f\x79\x32\x71\xc5\x7f\x71\xcc\x57\x3e\x46\xcb" +
+		"\xab\xce\xbd\x7e\xe6\x3b\xee\x64\x7f\xcf\x7c\x97\xaf\x84\x40\x9c\x13\xd9\x5f\xaf\x00\x69\x6b\x8f\x7a\xbb\x87\xcd\x25\x69\x7a\x9b" +
+		"\xfb\x3c\x93\xdf\xc9\x24\x7b\x48\x82\x64\x84\xa7\x93\x52\x24\x78\x47\x26\xbc\xcb\x76\x01\x81\x5d\x1a\x1d\x22\xce\x8d\x8a\xc1\x56" +
+		"\xb6\x50\x3c\xeb\x46\x33\x50\xbf\x8a\xd5\x36\xd6\x97\xfd\x5e\xc6\x78\xfa\xd8\x32\xd2\x2a\x4d\xf4\x22\xa9\x0e\x76\x56\x17\xb7\x83" +
+		"\x7f\x72\xc5\x5c\x66\x6f\xd0\x9e\xfe\x82\xc8\x2a\x55\xd8\x55\x3b\x19\x3e\xe3\xf1\x8c\xbb\xf6\x9d\xc0\x69\x6e\x4c\x9d\x6f\xb6\xc9" +
+		"\xe3\xfb\xe5\x0c\x10\xbb\x4b\x78\x44\x25\x41\x1f\x6d\x1c\xb2\xea\x5e\x7e\x4d\xf0\x94\x1b\x39\x22\xef\x8a\x6a\x64\x16\x99\x72\x1b" +
+		"\x58\xcf\xf8\xa5\x5a\xad\xca\xad\x42\xa5\x9d\xcb\x59\xf1\xde\x3b\xa1\x25\x78\x49\x90\x7f\xdd\x77\x3a\xcd\x6e\xe4\x0a\x53\x28\x99" +
+		"\xc8\x78\x75\x02\xf7\xbb\xbd\xd9\xec\x67\xbb\x0c\x3c\xc5\x1e\xa4\xb9\xad\xe3\x8b\x43\x4b\x36\xb7\xb1\xf1\x81\xee\x93\x22\xdb\x9d" +
+		"\xc0\x11\x8c\xd8\xbb\x75\xd0\x96\x6a\xec\x5f\x36\xba\x3d\x75\x06\xe9\x29\xb4\xce\x1e\x5f\x9c\x6b\x8a\xaa\x24\xce\x7d\x59\x54\xcb" +
+		"\x3a\x53\xc2\x41\x8a\x1b\x43\xa1\x9c\x9e\x82\x1f\x49\x5a\xbe\x52\x55\x54\x00\x73\x9d\xbb\xbe\x37\xed\xa9\xe4\x33\x7b\x9a\x59\xa1" +
+		"\x2b\x6c\x1c\xfe\x6c\x39\xf8\xf3\xec\x4c\xd5\x21\xfd\x37\xdb\x35\xaf\xb6\xd5\xf4\x6d\x1b\xa8\x4e\x26\x4a\x5a\xb4\x97\xfb\x20\x2c" +
+		"\xa0\x5d\xf3\x4c\x57\x17\xb7\xdb\x9c\x9f\x0d\xf6\xac\x0e\x02\x48\xeb\x28\xac\x6c\x19\x25\x59\x1e\x3c\x2c\x2f\x14\x91\x37\xd7\x08" +
+		"\x9d\x02\x45\xab\x88\xfc\xef\xd8\x22\xca\x5f\x19\xc8\x4b\x73\xfb\xaa\x50\x9d\xa6\xc5\x42\x7e\x8d\x6a\x73\xa7\x01\xf7\xf2\x77\x5c" +
+		"\x0c\x7b\x66\x1b\x98\x0f\xda\x8b\x21\x2e\x21\x7d\x13\x8f\xbf\xf3\x9e\x30\x3c\x94\xf3\xec\xcc\xfe\x0a\xd7\xfc\x57\x05\x25\xd2\x43" +
+		"\x95\x1d\x39\xff\xda\x35\x36\x62\xed\x43\xcf\x5e\x60\xb7\x59\x65\x4f\xe8\xd0\x97\xe7\x28\xdd\xde\x9e\x17\xf0\x57\x0b\x7b\x66\x59" +
+		"\x2f\x37\xbb\x2b\x9a\xb9\xae\xac\x0c\x6e\x4a\x5d\xb8\xa7\xd3\x5c\xaf\x94\xc6\x5d\x0f\x72\x26\x5f\x91\xcb\xbf\xe7\xf1\x24\x58\x9c" +
+		"\x5f\x01\xcf\x0a\xe8\xbf\x53\xac\x86\xcb\xed\xd3\x5b\xed\x7a\x9e\x56\x77\x24\xf8\x3e\x70\xb2\x47\x19\x69\x91\xc9\x61\x17\x86\x8b" +
+		"\x1a\xd8\xeb\xf9\xd9\x00\xdb\xaa\x83\x62\x3f\x78\x26\xc1\xe7\x74\xa8\xb5\xb3\x5e\x6d\xc1\xf9\x37\x4d\x4e\x86\x16\x09\xa1\x8f\xa5" +
+		"\x00\xea\x0f\xcc\x7d\x4b\xce\xb4\xeb\x9c\x6d\x12\x53\xd1\xa0\x56\xd4\xe2\xcb\x14\x50\x2c\x75\xce\xcb\x79\xee\xfc\x8d\xf0\xf8\xa5" +
+		"\x1d\xd8\xd1\xe6\xf6\x54\x13\xf2\x7c\x14\xa0\x9d\x6c\xcf\xeb\x8c\x8c\xd6\x0d\xaa\x12\xaf\xb0\x67\xaf\x04\x0f\xe7\x1d\x93\xe8\x95" +
+		"\x04\xa8\xd5\x34\xca\xda\xdd\x18\x16\x27\xac\xb9\x29\xcd\xd2\xb0\xd0\xae\x39\xce\x03\xbb\x65\x91\xb9\x35\xbc\xfe\xa1\x49\x54\xc7" +
+		"\x57\xc6\xca\x9c\xef\xb4\xfb\x5b\x4d\x9f\xa4\xd0\xd5\x45\x76\x07\xa7\xda\xa0\x39\x8e\x99\x8d\x07\xf0\xd7\x97\x19\x6f\xef\x9a\xe8" +
+		"\xe3\x51\x83\x64\x7c\x1a\x55\xfe\xc3\xce\x7e\xba\x5d\xba\x49\x50\x2f\x1d\xee\x3c\x35\x25\x8e\x24\x9d\x6c\x78\x1d\x8c\x6c\x52\xe4" +
+		"\x15\x2d\xa7\x49\xa5\x66\xf1\x34\xbb\xf9\x7c\x78\x3a\x85\x40\x14\xda\x44\x85\x9a\xf1\xf4\x92\x79\x29\xd8\x76\x8f\x2d\xcd\x56\xa6" +
+		"\x4b\xbe\x62\xee\xe3\xd5\x76\xe5\x5a\xbb\xf8\x4d\x51\xb3\x73\xbb\x2d\xce\xf3\x8b\x8d\xa7\xaa\xed\xea\x5f\xb1\xef\x75\x89\xa6\x16" +
+		"\x7f\x77\x60\xe8\x37\x36\x28\x5f\x31\xb1\xfb\x83\x7d\xef\x2a\x24\x3f\xb5\x33\xdd\x0c\x5e\x09\xce\xaa\xf4\xd5\x94\x05\xef\xb5\x91" +
+		"\xb0\x9c\x41\xd2\x95\x1e\x56\xf9\xaa\x6e\x15\xb3\xfd\xbd\xa0\x01\x4d\xa1\xa5\x60\xbb\x98\x57\x7d\x75\x30\xa2\x2e\x33\x2d\x77\xbf" +
+		"\xfb\x2a\x57\x81\xbf\xb0\x13\xdc\x03\xfe\xd1\x6e\xab\x39\xf8\x75\x5b\x91\x6e\xde\x96\x57\xd9\xd3\x5d\x15\xc8\xe0\x49\x36\xa2\xcd" +
+		"\x2c\x8c\x4c\x6a\x42\xa3\xba\x6d\x4b\xa3\x93\x92\x00\x2f\x9b\x46\x70\x30\x3f\xed\xe0\xd4\x28\x1c\x60\xa7\x2e\xd2\xfe\xfd\xde\x3c" +
+		"\x41\x37\xd9\x65\xc1\x73\xed\xe4\x85\x1e\xce\x9d\x4f\xce\x73\x22\x63\xde\x33\x45\x43\x3c\xfd\x96\x29\x8d\xf4\x6f\x09\x48\x24\x8a" +
+		"\xc6\xae\xb0\x4c\x74\x0b\xbb\x74\xd1\xf5\xf6\x5e\x0e\xfb\xb2\xa1\xbc\xca\xed\x38\x3a\x09\xcb\xee\xd5\xb7\xdb\x3d\x82\x1e\x4b\x6b" +
+		"\x70\x25\x76\x75\x06\x99\x0a\x8f\x1e\xdd\x15\x9d\x54\x6a\x96\xb9\x60\x83\x35\x88\x48\xbc\xdc\xe0\x7a\xf7\x6c\xdb\xdb\xe8\x79\xbd" +
+		"\xa3\x93\x9d\x9a\xc4\xd2\xca\x07\x99\xb8\x59\xa4\xf8\xda\xd8\xf0\x6e\x77\xbe\xc1\x0e\x82\x7d\x68\xb3\xe2\x50\xfe\x95\x26\x3b\xdf" +
+		"\xf1\x90\xed\x09\xc6\x22\x75\x11\xea\x10\x1d\xf7\xda\xc7\xc3\xf9\x3f\xe7\x9f\x7b\x67\x93\xc1\xee\x2b\xfb\x98\x8e\x28\x04\xde\x98" +
+		"\x07\xe9\xf8\x8b\x83\x86\x6b\x2b\xc1\xad\xa5\x61\x51\x5a\x79\x02\xf5\x17\xa0\x77\xe3\x2a\x32\x80\x5c\x85\x0e\xf5\xc1\xeb\x56\x17" +
+		"\x19\xd9\x6c\x70\x10\xde\xd7\x52\x3c\x37\x6a\x7a\xda\x04\xff\xf9\x9b\xa0\x2b\x47\x23\x7e\x26\xbf\x31\xc1\x2d\x0b\x57\xbe\x75\xf0" +
+		"\x78\xbe\xe6\x20\xfe\x9f\x53\x59\x48\xca\x03\xe8\x19\xeb\xe4\x8a\x6a\x24\xcd\x23\x2f\xbb\x96\x8e\xcd\x8d\x12\xae\x91\x07\x1f\xf4" +
+		"\x3a\x86\x52\x1b\xac\xe6\x51\x5b\x7e\x61\x5c\xc6\x1b\x32\x34\x8b\x64\xd4\x49\x48\x0a\x26\xcc\x77\xa7\x7e\x4f\xb2\xb7\xe0\xb0\xdc" +
+		"\x32\x82\xd2\xce\x39\xfb\xd8\x5a\x3f\x92\x61\x27\xeb\xf4\xd5\xff\xb0\x0d\x87\xe5\x0c\x2b\x1b\xe9\x04\x54\x67\x78\x14\xcb\x4a\xbd" +
+		"\x77\xe3\x41\x87\xe0\x2b\x8a\x98\xdb\x58\xda\x99\x26\x3b\xe5\xb3\x7d\x3c\xa1\x3b\xbc\x9b\x7d\x60\xbb\x16\x33\xf8\x59\x4f\xce\xe4" +
+		"\x6c\x69\xec\xcd\x04\x4d\xf9\x9b\xee\x78\x9d\x0f\x56\x83\x1d\xa3\x90\xd4\x59\x5c\xfe\x43\x6f\xf4\xd5\xca\xd3\xc1\xdf\xf7\x30\x3d" +
+		"\x4d\xac\xdc\x8e\xe4\x1c\x1b\x9f\x96\x2e\xad\x6b\xbc\x8e\xde\xe3\xa7\x53\x4c\xde\x5a\x44\xf1\x68\x99\x2d\xc8\x0b\x6c\xd8\x6e\xb6" +
+		"\xb1\x38\xd7\x14\x80\x3f\x7f\xf7\x92\xe8\x60\x92\xe6\x81\x06\x24\x51\x4f\xdc\x18\x49\xb7\x8c\x4b\x4a\x29\xf8\xa3\xe8\xc7\xd0\x0e" +
+		"\x2c\x31\xed\x72\x91\xc9\xc5\x79\xe1\x9c\x78\x56\xdf\x4b\x0f\xcf\x8c\xd8\xa7\x63\xf8\x2d\x57\xd9\x7d\x39\xab\x7a\x68\x45\x83\x36" +
+		"\x46\xee\x1f\x4f\xb8\x03\xfc\xe4\x76\xf7\x9f\x32\x0d\xca\xbf\xcb\xcc\x2a\x4c\xc8\xd9\xc5\x3b\x82\x2b\xd3\x2c\xc2\x3d\x46\x3d\x6e" +
+		"\x1c\xed\xf9\xae\xb3\x87\x98\x9f\xd6\xc2\x54\x81\xb7\xc4\x74\xfc\xde\x7c\x38\x27\x3f\x5e\x6f\x0b\x41\xa7\xf8\x93\xfd\xd1\x22\xa2" +
+		"\x95\x77\x09\x6e\x60\x28\x90\x6a\x7b\xb4\xa1\x95\x51\xed\xe6\x2e\xeb\xc6\x4b\xc1\x89\x97\x86\x99\xf6\x52\x6d\xf3\x69\x32\xd5\x51" +
+		"\x99\xd6\xc9\x71\x03\xe7\x75\xd2\xa1\xa8\xf2\x48\x82\x57\xc7\x1a\xae\xf0\x22\x8c\x8a\x10\xf1\xf3\xc1\x3b\xa3\x36\xa2\x45\x04\x58" +
+		"\xbe\x54\x73\x36\x29\xed\x90\xec\xcd\x20\xf1\xce\x92\x7f\x2e\x16\x97\x77\x08\x1f\xa9\x10\x19\x80\x12\x8f\xec\xfe\xe5\x43\x94\x89" +
+		"\x4c\x24\xa3\x4c\xbc\x04\x89\x37\x6f\x2c\xf3\xe2\x8b\xba\xf0\x92\xca\xa3\x9a\xb8\x18\x39\x34\x0e\x3c\x63\x61\xb1\x48\xba\x65\xac" +
+		"\x23\x3a\x8b\xb8\x59\xb4\x95\xc8\x05\xaa\x4e\xe7\x96\x98\x73\x91\x76\xe3\xcc\xf9\xbd\xfe\x21\x9a\x16\xb4\x8a\x5e\x86\xd9\x98\xe4" +
+		"\x3a\x4f\x4b\xb4\x8c\x77\x0b\x51\xa9\x97\x52\xa9\x9b\x45\x09\x6c\xd1\xe5\x6d\x61\x2f\x72\xce\xbf\x2b\x6b\x50\x59\x98\x09\x56\xc1" +
+		"\x3d\xe1\x1b\xd6\x13\xbc\xb5\x58\x24\x52\x1d\x95\x2c\xcd\x23\x65\xd5\xcd\x39\xef\x8d\xc0\x11\xd9\x28\xdb\xf8\x8e\xdd\xee\x77\xed" +
+		"\x2c\x67\xda\xa9\x2f\x0c\x53\x77\x8d\xfd\xf4\xe7\xf6\x24\xd7\x82\xbf\xb4\x81\xfd\x9d\x8d\x43\x21\x72\x8b\x9e\x03\xf6\x34\xa8\xd7" +
+		"\x11\x7b\xe7\xd2\xaf\x9b\xd9\x1e\xe2\x5a\x64\x14\x23\x65\xe7\xd3\xd6\xd2\x2e\xd5\x2e\x52\x21\x5e\x87\x73\x8d\x3d\x7c\x4d\x7c\xd3" +
+		"\x39\x21\x6d\xd2\x68\x2f\xd9\x64\x88\x69\x52\x13\xac\x80\x93\x82\x09\xd4\x39\x52\x7b\x35\x8e\xae\xef\xf6\x4e\x57\x37\x20\xed\xb7" +
+		"\x33\xd6\x9d\xe9\x62\xcf\xd3\x69\xbe\x76\xd7\xba\x01\x70\x50\xb9\x10\xf1\x79\x9a\x1e\x6a\x6c\x91\x7e\x5d\x03\x31\x2d\xc2\x29\x35" +
+		"\xde\x1f\x22\xe3\xb1\x83\x63\x88\xdd\x3d\x48\x8a\x9a\xfa\xe8\x63\xf1\xfe\x94\xc4\x0b\x50\xca\xa3\xf5\x97\x67\x5c\x5d\x01\x76\x89" +
+		"\x62\x38\x6f\x20\xe2\x95\xe2\x8f\x7b\x02\xe3\x82\xd4\xbf\x4d\xaa\xc1\xcb\xed\xf7\x77\x87\xeb\x9b\xe6\x66\xa7\x15\x3b\xbc\xa5\x40" +
+		"\x6a\xce\x7d\xac\x4d\x44\x32\xa5\x60\xda\xc2\x4d\xed\xc5\x36\x36\x37\xdb\x9f\xdf\x0d\x9f\xe2\x3a\xbb\x8f\x3f\xa6\x05\x7b\x9a\x8b" +
+		"\xd3\x6c\xa8\x2f\x0c\x1f\xbb\xa9\xb7\x6a\xcd\x79\xde\xca\x7a\x7d\xb1\x73\x14\x56\x38\x69\xbb\x9d\x7d\xab\x6d\x54\x0d\x7d\xdd\xc4" +
+		"\xe8\xdc\xa0\x9a\xb4\x08\xbe\xd1\xf7\xa3\x82\xe6\xdb\xf6\x58\x4d\xed\x22\xd5\x11\x33\xd7\x7a\x01\x7a\xaf\x6c\x83\x5e\x47\xb5\x66" +
+		"\x2c\x1c\xdf\xbf\x34\xda\x9b\x79\x1b\x80\x0e\x11\x45\x39\x9e\xd0\x2a\x78\xf9\x7d\x09\x3e\x49\xfb\xae\x63\x09\x37\x80\x95\x26\xb3" +
+		"\x8d\x23\xd6\xbc\xc0\xf9\x88\x05\x37\x67\xf4\xf5\xd6\x27\x13\xe5\xda\x9e\x53\x3e\x35\x1a\x6f\x9c\x17\x04\x2d\x67\x33\x74\xb0\x1f" +
+		"\xb6\x01\x47\x79\xa4\x75\x94\x29\x31\x27\xa9\xf4\x94\x54\x0a\xd0\xf9\xfa\x5e\x9b\x09\x0e\xd2\x1f\x52\x0d\x90\x9c\xd7\xa0\x4b\x85" +
+		"\x53\x96\xce\x04\xef\xb0\x51\xeb\x14\x6f\x3a\x2c\xba\xbd\xd4\xa6\xe4\xc6\x20\x72\x76\x25\x78\x65\x14\x4c\x39\xe5\xeb\xd2\xf0\xb1" +
+		"\xbc\x50\xae\x1b\x6d\xba\x6f\x36\x6f\x59\x7f\xfc\xcc\x94\xc0\xca\xb4\xc3\xe2\xb7\x02\x92\x6e\x67\xc7\x4b\x1d\x29\x3f\x94\x36\x5e" +
+		"\x2d\x04\xbb\xd2\x6d\xfa\xde\xc4\x75\x7a\xeb\x94\xba\x9c\xfc\xb0\x58\xa6\xb9\x36\x01\xdf\x74\xf2\x45\x5d\xd4\x6d\x7a\x8a\xef\x6b" +
+		"\x26\x00\x17\xd9\x78\x79\x77\x93\x3f\x98\x6c\xbb\xbd\xbe\x2c\x7a\x47\x75\x09\xb2\xae\x23\xa7\x67\x04\xa0\xd4\x02\x3c\x68\x6a\x2d" +
+		"\x49\xc9\xf8\x99\x60\x43\xcc\x4e\x7c\x49\x9f\x16\xb1\xb1\xb4\x5a\xff\x8c\x2d\xe6\x4a\xd3\x5c\xff\xb2\x11\xb8\x0b\xfc\x2f\x1b\x10" +
+		"\xb7\x0b\x67\x6b\x30\x92\xda\x68\x7f\x7c\xbb\x5d\xe2\x27\x76\xd1\xdf\xd8\x0d\x9c\xd2\xa0\x11\xa8\x13\xec\xee\x30\x99\x73\x3a\xd6" +
+		"\x2d\xe0\x89\x0a\x9b\x97\xd3\xa3\x7a\xe0\x1c\x5b\x47\x9d\x23\x00\x39\x35\x80\xbc\x96\x76\xae\xea\x80\xf3\xbc\x1b\xdd\x66\x37\x63" +
+		"\x79\xbf\xff\xf2\x28\xef\xac\x0a\xba\x62\x6d\xb4\x69\x5d\x9d\x56\xbf\x64\xa3\xdb\x5c\x2e\xd0\xfb\xca\x70\x2f\xf3\x76\x0d\xda\xc8" +
+		"\x75\x06\x67\xe4\xec\x8f\xb5\x4c\xdd\xda\xa4\x10\x09\xfe\x13\x7e\xa9\xc1\x74\x77\xb0\x91\x8d\x62\x31\xbf\xdb\xd8\x06\xc5\x9a\xff" +
+		"\x59\x8e\xa3\x71\x28\x62\xd7\x49\xdf\x34\x8d\xdd\xd4\xcb\x19\xf2\x48\xb2\x0d\xca\x5d\xa7\x46\xaf\x83\x24\x75\x01\x1d\xd7\x5a\x9a" +
+		"\x04\xa8\x70\x52\xb0\x21\xb3\xa1\x42\x3a\x86\x33\x59\xec\x34\xe0\xc2\x75\xb9\x93\xdb\x8b\x6d\x5d\x5a\x3a\xc3\xb4\xa6\x01\xd3\xa2" +
+		"\x69\x74\x39\x3a\x47\xbf\xd0\x1c\x36\xb5\x2b\x9c\xe2\x64\xee\x7b\x2d\x6a\xc9\x58\x8c\x93\x45\x92\x0f\xbd\x9f\x52\xcd\x5c\x50\xef" +
+		"\x0a\x55\x3d\xca\xaf\x51\x0c\xc9\x5b\x45\xa0\xe0\xf1\x5d\xc7\xe0\x45\x6f\xca\xd8\xea\x29\x8d\x84\x46\xda\xf1\x69\x46\xd6\x84\xa0" +
+		"\xcc\x9e\xe9\x94\xb4\xa8\x90\x3f\x36\x09\x6a\x65\xcf\x57\x62\x0b\xf8\xaa\x60\x67\x55\x04\x6f\x3c\x63\x23\x71\xa5\x0d\xc3\xcd\x36" +
+		"\xda\xdf\xb2\x77\xce\x03\x7f\x1a\x41\xf1\xcd\x26\xb3\x0b\x9d\x40\xb2\x8a\xd1\xd1\x8b\x69\xc0\xa1\x5b\x3b\x27\x5a\xda\x96\xa5\x75" +
+		"\x5d\x89\xd9\xd8\xa4\xdc\xb3\xe2\xbf\x37\x69\xeb\x14\xfe\x7b\xdb\x90\x2c\x47\x2c\xda\xc4\x9c\x14\xa2\xab\xc2\xd5\xfa\x31\x4b\x5d" +
+		"\xa5\x8f\x67\xd8\xb4\x69\x8c\xd8\xb5\x15\x38\xcd\x44\x26\xf9\xaa\x09\xfe\xad\xce\x74\xf0\x7f\x3d\x19\x25\x27\x5e\xd3\x3a\xc9\xfd" +
+		"\xdc\x08\x53\x93\x3a\xa7\x86\x9c\x1b\xed\xa5\xdc\x9b\x1f\x91\x89\x2c\x83\x77\x51\xbe\xd2\x62\x8e\xb4\xc5\x6b\xb1\xe0\x39\xeb\x04" +
+		"\xe3\x9a\x06\x54\x99\x53\xf5\xf3\xe4\xa4\x70\x1a\x2b\xc0\xa1\x55\xe1\x86\xee\x74\xfe\x4c\x26\x80\xa5\x51\x74\xed\x34\x35\x65\x15" +
+		"\x2a\x16\xfe\xec\xe9\x24\x42\xe5\xc6\x11\x22\x5c\x1d\xc4\xb2\x36\xe6\xa7\xfc\x32\xbc\xf7\x32\x7f\xce\xdd\x04\x8f\xa7\xad\xd1\xdc" +
+		"\xad\x5b\xed\x5e\x8e\xfb\xb1\xbf\xf1\xa7\x59\xe0\x5c\x7c\x67\xd2\xde\x64\x5a\xf0\xba\x28\x1c\xf0\x59\xbe\x20\xd8\x9d\x6e\x27\x8f" +
+		"\xe7\xc0\x59\xd9\x00\x07\xcb\xc2\xad\xf0\xfe\x06\x63\x69\xfa\xa5\xd8\x9d\xdc\x83\xb7\xa2\x28\x34\xb1\x63\x1b\x27\x87\x8c\xc8\x99" +
+		"\x24\x36\x35\xec\xfc\xd6\x68\x6c\x73\xb7\x29\xb8\xdb\xec\x76\xce\x34\x11\xfb\x28\x4d\x8a\x56\xd8\xc4\x9f\x6a\x37\x7f\x85\xe1\x72" +
+		"\xfe\xe8\xed\x1a\x00\xe8\xc3\x72\x51\xee\x59\x17\x64\xa0\x12\xd3\xe9\x37\x14\xb1\x99\x5b\xed\x02\x8d\xa3\xf8\xe8\x92\x20\x7a\x5f" +
+		"\x6b\x47\xef\x6e\xe3\x7d\xcf\xce\x0c\x09\xf4\x34\x4e\xa3\xb8\x48\x99\xd7\x09\xe4\x5c\x68\xd2\xfa\xd7\x0a\x70\x76\x36\xfc\x87\x33" +
+		"\xa2\xec\x41\x53\x79\x6d\x8c\x44\xfb\xc8\x7e\x5e\x18\x54\x95\xff\xf3\x95\xd9\x25\x12\x51\xed\x83\x0c\x72\x22\xeb\x35\xa0\x97\x81" +
+		"\xb4\x9f\x9c\x15\x2a\xfa\xbb\x9e\x81\x7d\x3b\x89\xb2\xb4\x33\x0c\xab\x72\xac\x69\x1d\xc1\x07\x12\x7b\xe3\x2a\x90\xa6\x25\x3b\x47" +
+		"\xcf\x4a\xaf\x8b\xa0\xdd\xf8\xad\x01\x11\x4c\xc9\x59\xcc\xee\x9c\x42\x2f\x8d\xcb\x99\xb9\xe9\x60\x4f\x7e\x9d\x17\x87\x7b\xb5\x6c" +
+		"\x9b\xe0\xab\xd6\xc4\x71\x64\xe2\x5c\x8f\x3f\x9a\x2a\xb9\xc5\x07\xe3\xef\xe1\x3e\x5f\x61\x1f\x7c\x35\xb0\xe3\x8e\x76\x97\x3e\x41" +
+		"\x27\x47\xfd\xef\x5f\xdd\xdb\xfb\x2c\x71\x77\xf3\x5a\x7b\xaa\x11\x9e\x64\xb9\xc5\xbc\x2d\xda\x58\x5e\x6f\x2f\x5a\x7a\xdb\xb3\x72" +
+		"\x8b\xa6\x3c\xd9\xf0\x35\xbb\xff\x9f\x45\xe7\xfe\x76\x5e\xfb\xb6\xbd\xb4\x01\xab\x74\x32\x23\xe3\xe5\x15\xa3\xf7\x99\x45\xf8\x9b" +
+		"\xed\x80\x90\x0c\xa6\x5d\xe8\x0e\xf0\x5f\x48\x1e\x63\x34\x4e\xfa\x9d\x0d\x5c\xd6\xe4\xe3\x0c\x70\x22\x6d\x0a\xce\x09\xcc\xe7\x74" +
+		"\xd3\x76\x77\x9a\x19\x6e\x1c\xd4\xc0\x1f\x83\xb5\xe6\x2b\x78\x68\xf7\x33\x93\xa0\xd6\xd1\xf8\xff\xef\x1e\x4a\xb9\x3f\x77\xad\x49" +
+		"\xe4\xb7\x74\x11\x41\x8b\x37\x80\x7d\x5d\x51\x77\xb0\x15\x78\x5f\x00\x6f\xd9\x80\x90\x4f\x89\x56\x70\x57\x3a\x44\xd1\x06\xc9\xdd" +
+		"\xf6\x99\x8b\x5e\x3f\x47\x67\x6e\x8c\x06\xba\xd9\x40\xfc\x4a\x83\x60\xdf\xd1\x46\x36\xad\xcc\xf4\xd0\xed\xb4\x40\x7f\x9a\x47\x16" +
+		"\xca\xc6\x36\x5d\xb4\x1d\x9d\xe7\xfb\xcb\xa2\xc3\x74\x98\xe1\x06\x97\x45\x5e\xd1\xa5\x36\x6d\x2c\x3f\x80\x48\xd6\x15\x1b\xb7\x79" +
+		"\x19\x54\x09\x92\x9c\x5b\xc5\xd6\xe0\x4e\x9a\x09\x77\x66\x40\x49\x2c\x4e\x57\x99\x8c\xf5\x35\x26\xdb\x80\x72\x94\x9a\xd3\xca\x38" +
+		"\xe3\x84\xc4\x55\x41\x0a\x12\x15\xcb\x78\x4a\xa2\x23\x9b\xf7\x94\x98\xd5\xd0\xf1\xd8\xe7\x7b\x14\x34\x6b\xd0\xc6\x27\x1f\x85\xe5" +
+		"\xed\x03\xfb\xcb\x06\x68\x62\x2d\xc1\xac\xe9\xfe\x4d\x12\xce\xc4\x09\x2a\x87\x3c\x42\xda\x98\x98\xee\xd8\xc4\xc8\x80\x79\x1a\xa5" +
+		"\x51\xcc\xd0\x3a\xbb\xdd\x8c\xb5\x68\x4a\xfe\x60\x67\xfd\xad\xd1\x45\x39\x3e\x31\x11\xbb\xc3\xd3\x4a\xb7\xda\xab\xef\x84\x63\x50" +
+		"\x6e\x3a\xf6\x11\xda\x0f\xbc\xc7\xea\xd7\x5d\x31\x7c\x3d\x90\x2c\x4f\x68\x9e\x1e\x09\xc6\xab\x9c\x3d\xed\x73\xff\x53\x93\x97\x26" +
+		"\x8e\xbf\xdd\x63\x5f\xbc\xd1\x94\x71\x63\x53\x9f\xb7\x81\x07\xbc\x06\xcd\x3b\x65\xde\x6e\x96\xd6\xcb\x06\xfe\x64\x7d\x2f\xae\x77" +
+		"\xbe\x45\xe3\x48\x6c\xb5\x0b\xbd\xd7\x22\x22\xd3\x76\x76\xab\x5e\x5c\x7d\xa6\x13\xbe\x5a\x17\xfb\xf6\x5d\x66\x97\x64\x30\xac\xab" +
+		"\xa3\x6c\xd1\x13\xe8\x23\xbd\x8f\xc0\xd0\x62\x71\x60\x5f\x4f\xf1\xee\x60\x2c\xed\x6b\x34\x03\xc9\x59\x46\xe8\x6d\xd9\x00\x40\x71" +
+		"\x3f\x6b\x54\x89\x69\x21\x06\x74\xd2\x2a\x0c\xce\xc8\x4c\xc4\xb9\x23\xd9\x00\x7b\x6d\x13\x11\x6e\x45\x34\x15\xf1\x26\x19\xab\xb3" +
+		"\x51\x5e\xc0\xa8\xe0\xb4\x87\x4c\x5a\x05\x3a\x5c\x11\xdd\xbd\x9a\x78\x59\x92\x3b\x17\x93\xbc\x0d\xcc\x2d\x91\x29\x6d\xde\xa0\x3f" +
+		"\x77\xb1\x05\x55\xc6\x91\xaf\x62\x7b\x94\xff\x54\xaa\x5d\x64\x6b\xfd\x2c\xfb\xdd\x99\x01\xb6\x7b\xe9\x4c\x26\x0a\x7a\xae\x37\x0b" +
+		"\x78\x41\x5c\x3b\x13\x23\xbd\xd4\x95\x26\xbd\xa5\xa8\x79\xda\x49\xe3\xe0\xe8\x76\x67\xdc\xf1\xdd\x20\xc1\x23\x0c\x37\x54\x81\xe0" +
+		"\xfc\x28\xf3\xaf\xf6\x76\x01\x7b\x5e\xf3\x6a\xad\x7e\x25\x81\xb1\xbb\xff\x5c\x11\xf5\xa6\xe5\x71\x51\x87\xcd\x2e\xb0\x33\x9d\xe2" +
+		"\xac\x42\x05\x83\xce\x80\xcd\x3b\x3b\xce\x1d\xb9\x22\xc8\xe7\xa9\x53\x2f\x59\xb8\x31\x92\xa9\xbd\x59\xbc\x47\xf7\x59\x5b\x99\xd0" +
+		"\x7f\x23\x46\x62\x4c\xce\xad\x5c\x97\xe8\xb7\x9f\x89\x3e\x75\xe7\x87\xbf\x57\x88\x4a\xe2\xcb\xed\xfd\xd6\xde\xc2\x6b\x48\x21\x2c" +
+		"\x76\xfb\x06\x69\xb8\xd2\x68\x8e\xd6\x22\x5c\xec\xba\xe8\x3b\xec\x4d\x35\x4f\x35\xfd\xd7\xd7\x0b\xc3\xfa\xd2\xee\xf7\x78\x36\x48" +
+		"\x2e\x17\x45\x04\xe5\x00\x97\x0b\xd4\x88\xc4\x13\x1d\x45\x52\x7e\xda\x3a\xb3\x2a\x9c\xd8\x82\x73\x2d\x37\xd1\x3e\x1a\x9c\xf3\xdd" +
+		"\x12\xae\xb4\xe1\x3b\x29\x68\x53\x9e\x14\xf6\x9a\x9f\xb3\x1c\x8e\x19\xf0\x0a\xc1\xde\xaf\x14\xf7\x38\x9a\xe7\xbc\xef\x72\x1b\xbe" +
+		"\x6c\x6c\xd1\x50\x12\xde\xcd\xd7\x23\x6d\xf1\x55\x9b\x84\xd3\xa2\x23\xb3\xa3\x92\xe7\xd9\xe8\xda\x06\x17\xfa\xf2\x4d\xa6\xdb\xb2" +
+		"\x66\x40\xbf\x6e\xea\xbb\x93\x0d\xca\x29\x1e\x08\x5f\xef\x21\xf5\x8e\x24\xdc\x7b\x6f\x60\xed\x5e\x99\xd7\x7e\xae\x48\x9c\x25\x53" +
+		"\x19\x7e\x5f\x67\xfb\xf0\x67\xf6\x8b\x1b\x4d\xdf\xd4\x45\x97\x8b\xaf\x22\xf9\x99\x8d\xed\xef\xcd\xd6\x37\x36\xdd\xb6\xcb\xdb\x3a" +
+		"\x44\x3e\xe0\xdb\xe0\x8f\x4c\xdb\xdd\x6b\x33\x79\xa6\xa9\xab\x5b\x6c\xfa\xda\xd9\x6f\x6f\x0c\x28\xf7\xca\x88\x4f\x87\xd2\x6a\x45" +
+		"\x07\x97\x34\x68\x11\xea\xd1\xd9\x54\x22\x99\x4b\xd0\x12\x42\x5c\xe8\x54\xbb\x56\xe1\x81\x7c\x05\x49\xb3\x60\xbb\x7b\xdb\xc2\xe6" +
+		"\x61\xe6\xbe\x66\xe6\xfa\x64\x3b\xc9\x65\x41\x90\xb8\x34\xa8\xbe\xe7\x81\x05\xbb\xa4\xe7\xe3\x19\x7e\xf2\x15\x36\xb8\x97\xd9\xf8" +
+		"\xfe\x3c\xe8\x44\x8b\xcb\xa2\xbe\xb8\x43\xf4\xd6\xbc\x24\xda\x1c\x54\x44\xe1\xec\x95\x36\x74\xa7\xd9\x7d\xdc\x68\x0f\x7b\x52\x94" +
+		"\xd1\x79\x19\xf7\x6e\x6f\xeb\x4e\xfb\xa5\x3f\xe5\xc6\x62\x10\x5a\x64\xa4\xd0\xda\x88\x24\x15\xc1\x14\xac\xd0\x00\x26\x6d\xec\xeb" +
+		"\xcd\xc2\x7e\x57\x39\x2f\xf5\x0a\x90\xf6\xef\x9a\x22\x71\xe8\xc3\xb3\xc1\x75\xe7\x06\x16\xd9\x2c\xf4\x55\x2e\xfc\xf3\xd3\x1a\x34" +
+		"\xb7\xa8\xf1\x6a\xcd\x4b\x6c\x0e\xf7\x3b\xad\xb6\x55\x98\xd7\x36\xa1\x10\x3c\x76\xdb\x41\x6f\x77\xdc\x29\xca\x7d\xcc\x2d\x4a\xda" +
+		"\x46\xb3\xf2\x46\xa9\x5a\xd7\x18\x5c\xe8\x38\x6d\x45\xf4\x10\x9a\xec\x1d\x3f\x2a\x83\x18\xdc\x32\xbc\xc9\x26\x1e\xa8\xb0\xd8\xde" +
+		"\xe8\x30\x1b\x74\xf0\x68\x12\xcb\xbb\x75\x48\xc4\x49\x69\x99\x07\x4f\x71\x6a\xb5\x27\x3a\xa6\x14\xfb\x83\x7a\x70\xec\x66\xa8\x73" +
+		"\x28\xfd\xca\xa8\x2d\x6a\x1b\x75\x48\xd3\x0a\xb6\xac\x9c\x9d\xe6\x89\xaf\x2c\x92\x5f\x7a\x16\xb0\x67\x2e\xb0\xe1\x0b\xe3\x84\x56" +
+		"\x6b\xc7\xa7\x7e\x05\x1e\xfd\x65\xe8\xc2\x1a\x13\xf0\xcb\xbc\x34\xeb\xae\x78\x52\xb7\x60\xeb\xca\xc0\x6d\xee\x84\x5c\x13\x48\x51" +
+		"\xc6\x56\xc0\xa9\x26\x17\x7d\x5d\x67\xfc\xce\x7e\xff\x13\x93\x54\x06\x87\xe1\x74\x77\xbe\x57\x26\x26\xb1\xee\xa5\xeb\xfb\xbf\xb3" +
+		"\x95\xf5\x10\x63\xf7\x94\x27\x09\x6e\x67\x74\x7f\xf3\xc6\x02\x8e\x6d\x77\x89\xc0\xf2\x32\xc7\x14\x7e\x07\x3e\xc3\x08\x47\xdc\xf9" +
+		"\x3f\xc3\xf0\xb6\x73\xed\xb5\x83\xb7\x97\x83\x87\xcb\x82\x4e\xe4\x85\xdd\x0e\x0c\x4c\x26\xf8\x2c\x03\xec\xf2\x14\xa8\xe3\xe6\xa3" +
+		"\x0b\xfa\x23\xc9\x86\xb3\xc0\x10\x9e\xdd\x89\x7d\xbd\x17\xc3\x64\xe6\xd2\x21\x4e\x13\x2b\xa3\x9c\xbb\x59\x69\x57\xbd\xd6\xfc\xe7" +
+		"\xa6\xd1\x51\xb7\x75\x00\x6a\x15\xce\x7e\x6e\xd3\x60\x68\xac\x6b\x49\xe2\x5d\x64\x92\x19\x49\x9a\x28\xd0\x0d\x64\xa2\x3d\x40\xd6" +
+		"\xcd\x72\xdb\x58\xe5\x4e\x25\x39\x2f\x02\xe9\xb2\xe8\x6e\x97\xd6\x6e\x54\x86\x3e\x2e\xb3\xd3\x78\x76\xa6\x5f\x71\x67\x13\x87\xb6" +
+		"\xc7\x16\x8b\xfd\x8b\xcc\xf6\x5a\x30\xef\x5a\x69\x8a\x61\xed\x29\x4e\x7b\x97\xa9\x8c\x7b\xc3\x31\xd7\xb0\x5d\x12\x8d\xfd\x69\xba" +
+		"\xa7\x43\xf8\x91\x7f\x34\xe5\xd4\xc6\x34\xd0\xf5\x51\xd8\x76\x9d\x0d\x7a\x59\x6c\xbe\xf0\x75\x70\x43\xe5\x7f\xb2\x25\xc9\xbe\x4c" +
+		"\xcc\xec\xef\xed\x5a\xf9\xf0\x50\x8e\xbb\x9b\x5d\xee\x1b\xf9\xa4\xb0\x63\xba\x93\xc7\xde\x24\x0c\x59\x99\xef\x18\x93\xf1\x22\xaa" +
+		"\x91\xc5\x7a\xe6\x29\x79\x87\x83\x27\xce\x48\x22\x6d\xd1\xc1\xc6\xe9\xec\x06\x38\xb8\x67\xd7\x4b\x1a\x24\x78\xf6\x67\x90\xac\x48" +
+		"\x0b\xfd\xd2\x7d\x5d\x8e\x68\x05\x97\x46\x96\xc4\x3d\x0d\xef\xb2\x71\x61\x64\x4c\xab\xa3\x35\xa5\xbb\x6f\xa3\xca\x02\x69\xab\xb1" +
+		"\x9f\x95\x46\x16\xec\xac\xd8\xec\xa8\x71\x83\xac\x70\x45\x90\xdd\x4a\xdd\x04\x6f\xa0\x83\x71\x0e\xb9\xb7\x0d\x56\x66\xa7\xe0\xc4" +
+		"\xb4\x0f\xbe\x5b\x87\xa0\x06\x16\x82\xa9\x3c\x39\x6d\x33\x93\xd2\xa6\xe6\x64\x43\x4b\xd6\x85\xd2\xf4\x44\x1b\x23\x50\x71\xdd\x7f" +
+		"\x2a\x58\xe1\xdd\x1a\x53\xce\x7f\x65\x60\x4a\xad\xc3\x2f\x71\x99\xda\xcc\xd8\xf1\xa1\x1f\xbd\xf9\x75\x21\xfa\x2d\x58\xcd\x75\xe2" +
+		"\x01\x7b\x79\x34\x60\xbf\xda\xec\xf8\x95\xf6\xbc\xb7\x9b\xf9\xac\x75\xf5\x98\x89\xf0\xad\xab\xbb\xc5\xad\xc3\xbd\x71\x3a\xa8\x7b" +
+		"\x04\x27\x79\x68\xf0\xbc\xd7\x5b\x7d\x3d\x3a\x41\xd4\x44\x6f\xbe\x1f\x99\xd7\xff\xfd\x20\xd9\xf9\xce\x75\x47\xbc\xe5\x50\xb7\x62" +
+		"\xf8\xb5\x8c\x81\x3d\x8c\x2c\x8b\x3e\x20\xdf\x06\xef\x36\x77\xfe\x82\x40\xe0\xbf\x13\x9a\xfb\xbc\xe0\x4d\x75\x89\x88\xa1\x2c\x2a" +
+		"\xdd\x7e\x62\x33\xde\xc1\x02\xe4\x5f\x9b\x8e\xba\xda\xee\xb9\x36\xba\x27\x3f\x40\xfb\xea\xbd\x36\xa1\x6d\xcd\xcf\xe9\x15\x9d\x8f" +
+		"\x33\x63\x89\xe4\x7a\x53\xcc\xa3\xbc\x01\x70\xc1\xee\xf7\x3a\xf0\x7b\xe0\xbf\xc0\xff\x35\x7f\xe2\x1b\x26\x73\xc9\xaf\xc2\x9c\x5c" +
+		"\x6f\x37\xfb\x0d\x1b\xe2\xbb\x2c\xd8\xff\x84\x76\x9f\xff\x8a\x16\xae\x4e\xd3\x79\xc4\x57\x65\x95\xdd\xd9\xdf\xa3\x87\x5a\x4f\x5f" +
+		"\x9f\x57\x9b\x85\xbe\x30\x5c\x63\x67\x36\x2d\x2b\x8d\x00\xa5\x36\x94\x4a\xcb\x68\xb3\x58\x6a\xaa\x6e\x83\x13\xcf\x7b\x15\xb7\xe2" +
+		"\xd8\x9c\x44\x74\x55\xea\x59\x9b\x0e\x0d\x36\x01\x72\xe4\x6d\x8c\xf7\x1f\x3d\xe4\x1a\xbd\x6f\x12\xda\xd3\x63\x1d\xd7\x00\x8b\xd3" +
+		"\xd2\xdb\xd2\x40\xb7\x4e\x8d\x75\xe4\xc8\xcc\xa6\x6c\xf0\x6b\x8b\xa4\xa7\xef\xc7\x8a\x2d\x0d\x5f\xda\xf1\xc6\xa4\x55\x03\x52\x0e" +
+		"\x63\xb7\xb1\xc6\xc5\xed\xe2\xae\xb2\xd9\x2c\xf6\xf6\xea\x9e\x20\x49\xb7\xab\x98\x9a\x04\xb1\xbb\xe8\x90\xd4\x86\xff\xd8\x22\xea" +
+		"\xd5\xc6\x8d\x21\x38\x41\x87\xa6\xa6\x0d\x0f\x16\xb1\x98\xb4\x3d\x99\x27\xdb\xae\x8c\x8e\x5f\xae\x8c\x6f\x31\x1f\xce\xee\x31\xf1" +
+		"\x86\xe4\x8d\xcd\x1a\xde\x64\xaf\xab\x02\x59\x77\x2a\xe0\xea\x3c\x92\x4d\x45\x0e\x79\x5f\x46\x31\xc3\x86\x6c\x03\x5c\xa0\xa2\x61" +
+		"\x2a\xbf\x7b\x59\xa4\x47\x33\x66\x43\x7c\xa9\x9d\x9e\xea\x53\xb6\xb6\x93\xb7\x8c\xe6\x1e\x6d\x83\x90\xf5\xf3\xa8\x6e\x38\xd7\xe6" +
+		"\xe4\x3e\xbb\xd5\x9f\xda\xf7\xdd\x57\xbb\xdb\x04\xe7\x0a\x13\x8e\x1f\x9a\x36\xce\x85\xdb\xf2\x1d\x7b\xc6\x51\x05\xa7\x0c\x38\x34" +
+		"\xdb\xd3\xeb\x71\x4f\x0e\x3c\xa2\x3a\xdd\x5a\x8e\x67\x06\x33\xb3\xc6\x62\x85\x8e\x41\xb0\x6e\x63\x33\x79\xeb\x7f\x72\xcd\xc9\xc5" +
+		"\xa1\x7d\x3c\xc1\x73\x25\xb8\xcc\xf8\x26\x49\xe3\xe0\xba\xb7\x0d\x4a\x8f\x77\xb5\x2b\x14\xb7\x09\x2a\xee\x69\x38\xa4\x98\xa8\xe9" +
+		"\xe9\x01\x67\xab\xe0\xf4\x8b\x66\x96\x47\x72\xa7\xc3\x89\x0e\xc6\x9f\x48\xdc\xe7\xab\x8c\xa1\x6a\x1b\x29\xff\x22\x33\x3e\x48\x01" +
+		"\xc9\xd0\x6c\xd8\xf8\xfa\x86\x20\x4f\x2e\x74\xaa\xeb\x70\xaf\x58\x4c\x33\xa7\xe5\xce\xbe\x72\xb6\xe5\x8d\x86\x5f\x3a\x55\xe8\x0c" +
+		"\x9b\x7a\x0f\x71\x3a\x58\x0c\x72\x45\xb4\xa2\x19\x92\x36\xcc\x6b\x16\x56\xc5\x7b\xc0\x9e\x67\x03\x59\x88\xc0\xb3\xb5\xe9\x81\xba" +
+		"\xd8\x1b\xe8\x64\x87\x07\xb7\xd6\x7a\x3e\x2b\x25\x0d\xdf\x18\xf2\x52\x64\x23\x04\x7f\x23\x8a\x50\xe8\x14\xb3\x49\x49\x91\x9b\x51" +
+		"\x15\x3b\x73\xf2\xe6\x58\x57\x0c\x09\x6d\xec\x65\x5f\xd6\x1d\x25\x99\xe4\xbd\x72\x32\x48\xc6\xb8\x4e\xdb\x9e\x07\xdf\x9a\xe6\x73" +
+		"\x61\x1b\xf2\x26\xad\x53\xfd\x9c\xb4\x4c\x93\x8c\x49\xb7\xb4\x2b\x55\xc6\x4b\xb8\xdc\x53\xf2\xc2\xc4\xba\x70\xa2\x9c\x9b\xe3\x1b" +
+		"\x21\x35\x0e\xc8\xbe\x3c\x5a\x19\xcc\xcd\x78\x61\x4f\xab\xc8\xf3\xe5\x03\xdc\xf5\x10\x67\x36\xdd\x88\x34\x89\x96\xfc\x8c\x5e\x49" +
+		"\x8d\x23\x20\xa8\x88\x6e\x5f\x93\x73\x3e\x3f\xc5\x8e\x14\xed\x02\x04\x69\x1a\xd8\xbf\x4f\xf3\x90\x4c\x34\x2c\x2d\x80\x93\xfe\x05" +
+		"\x3e\xff\x0f\xfb\x2b\xef\x8e\xc1\x2a\x8d\x77\x21\x30\xb3\x3f\x84\x93\x78\x6e\x08\xfb\x25\x76\x09\xdf\xf2\xe0\x1c\xbb\x8b\x53\xcd" +
+		"\xef\x1d\x96\x36\xde\xef\x56\x1a\xdd\x2c\x1a\x87\x13\xe1\x1e\x43\x71\x87\xc6\xb3\xa2\x99\xd1\x20\xda\x79\x67\x67\x42\x42\xb3\xd6" +
+		"\xc6\xa5\x7b\x36\x20\xd1\xba\xf8\x6d\x99\x97\x3f\x54\x84\x80\x75\x32\x9b\xf1\x75\xfb\xec\xaf\x26\x8a\xa7\xd8\xed\xfe\x29\x42\xd4" +
+		"\xdb\x2c\xdb\x52\x08\xbd\xd4\xd6\x65\xe8\xbf\x4c\xd1\x75\x09\x8d\xdc\xde\x24\xd5\xbb\xba\xb4\x34\x2d\xe3\x86\xf0\xca\x70\xed\x0e" +
+		"\x67\x8b\xa2\x94\x36\x4b\x67\x83\x1a\x13\x25\x6c\x2f\x74\x77\xbb\xce\x6c\xd6\x2f\x22\xa6\xc8\x9b\x36\x38\xc5\x90\xc5\xef\xd8\x1d" +
+		"\x5e\x1d\x7a\xe0\x7b\xd1\x4f\xce\xc5\xf3\x6f\xa0\xb3\x59\x67\xa6\x45\x02\x8d\xed\xe6\x4e\xb7\x27\xf8\x3f\x1b\xff\x6b\x23\x83\xe0" +
+		"\x74\x08\xaf\x08\xde\x57\x12\xc6\xa5\x4b\x24\xe9\xdb\x98\xec\x9c\x1d\x01\x54\x45\xf8\x46\xad\xa2\x96\x38\xdd\xda\x58\x48\x70\x39" +
+		"\x32\xcd\x1b\xec\x7e\x5a\x19\x7d\xda\x96\x66\xcd\xc2\x15\xe2\x41\x6a\x22\x6f\x99\xf3\x3c\x42\xda\x06\x7c\x79\x12\xe6\xab\x24\x00" +
+		"\xb6\xda\x94\x6b\x9f\xe9\xe6\x40\xf3\xf6\x4c\x84\x0f\x04\xfb\xe4\x82\x90\x91\x8b\xf5\x6b\xed\x35\x13\x27\x17\x8d\xce\x84\xa1\x2a" +
+		"\xc4\xed\x2c\xb9\x04\xec\x79\xb9\x43\x37\xbe\x6a\x7b\x65\xe3\xf1\x4f\x8e\x04\x7a\xe3\xe8\xf7\x3e\x2a\x1b\x4d\x2d\x09\xae\x73\x5c" +
+		"\xa5\x4d\xb4\xac\x69\x6c\x5f\x77\x39\x6d\x17\x40\x8a\x67\xf3\x26\xe5\x6d\x8a\x7c\x2c\xbb\xb2\xc1\xf6\x5e\xe5\xc5\x16\x01\x79\xf0" +
+		"\x48\xb6\x01\x01\xcc\x29\x5a\x69\xc3\xba\x3f\x35\xe0\xc8\xa7\xfb\xb5\x3a\xc1\xe9\xbb\xd1\x65\xfb\xc2\x18\xa6\x8e\x76\xa7\x97\x05" +
+		"\xa7\xf9\x27\x61\x9f\xbc\xd4\xff\xb7\x26\x9c\xd7\x83\x97\x07\x21\xa9\x2e\x78\x01\x34\x0d\x74\x36\x78\x47\x24\x36\xbe\x6b\xe7\xfc" +
+		"\xaa\x7d\xfa\x17\xbd\x99\xfc\xd3\x7e\xe2\xe2\x73\x55\x30\x08\xbf\x11\xdc\xee\x1d\xa9\x3f\xd1\xd2\x16\xce\x6a\x3a\x19\xef\xc2\xe8" +
+		"\x8c\x50\x1b\xbd\xf6\xfc\xe9\x7c\xe0\xce\x0f\xff\x39\x13\x54\x95\xd6\xa1\x9c\xeb\x4c\x3e\x1d\x0b\x3b\x25\xea\x87\xb7\x66\x3c\x1f" +
+		"\x5a\x95\xa2\xb6\x99\x22\x61\xc7\x03\x9e\x52\xc7\x3c\xb3\xc5\x76\xbb\xf9\xe2\x86\x12\xb5\x26\xa3\x17\x05\x78\x79\x7e\x54\xc3\x64" +
+		"\xc3\xa3\x3d\x2d\x0d\xdf\x78\x89\x57\x36\xb5\x71\x2f\xa7\x9e\x0d\xf0\xe4\x56\xb1\x2f\xf0\xc1\x24\xd4\x5d\x79\x71\x57\xac\x22\x21" +
+		"\xe1\xdc\x80\x38\x4a\x43\x95\x9f\x12\x6b\xa0\x34\x90\xb6\xbc\x07\x9a\xd5\x91\xc4\xaf\xd0\x5b\x49\x0b\x27\x42\xe4\x82\x99\x5e\x02" +
+		"\xf6\xf2\xb2\xdc\x4c\x30\xff\x6b\xcc\x8e\xa4\x05\x30\x2e\x32\xa3\x72\xf6\x4b\x0f\x24\xbe\x66\xc7\xeb\x02\xe0\xea\x10\x15\x4c\xed" +
+		"\x6d\xe2\xbf\x67\xba\xea\xf2\xb8\x9d\x73\xec\x66\x1b\xf4\xc9\x48\x87\xdd\xdb\x38\xde\xe5\x9e\xeb\x2b\x1e\x1c\x0c\xf0\x62\xa3\x62" +
+		"\x06\x6d\xa7\x4b\xe3\x3d\x66\xdd\x3c\x62\x9c\x5c\x2c\x52\x2f\x2f\xee\x82\x91\xda\x4c\xe7\x91\x31\xb8\x3f\xcd\x1d\xc1\xad\x67\x50" +
+		"\x9d\xf2\x69\xbc\x6e\xa5\xb5\x66\x51\xd2\xc8\xb5\x34\xea\x00\x5b\x44\x6d\xc5\xf2\x4c\x78\x78\xc5\x3e\xac\x13\x08\xe6\xcc\x17\x68" +
+		"\x19\x9e\x74\xbb\xc0\x6e\xab\x43\xc7\x8c\xca\xc7\xf2\x29\xee\x0a\xec\x35\x2a\xd9\xf8\x19\x9d\x81\x36\x8a\xd6\x11\xff\xc3\xe9\x49" +
+		"\x78\x5a\xde\xff\x7a\x58\xc6\x1b\x09\x6c\x48\x3c\x12\x6a\x1b\x9c\xb2\x16\xe1\xcd\x94\x05\xa5\x79\x69\xe2\x2e\x68\x3e\xba\xca\x36" +
+		"\x4a\xa5\x21\x35\xe0\xa3\x8b\x54\x6d\x8f\x5d\x19\xea\xaa\x63\x20\x55\x19\xd7\x58\x47\xf3\xc1\xa2\xcc\x84\x63\x9a\x09\x02\xb4\xef" +
+		"\xcb\x63\x02\x9c\xf6\x29\x61\x9d\x9b\xc7\xa2\x86\x49\x7b\x21\x6e\xa5\x57\xc0\x14\xc0\xa6\x36\x99\x3f\x0a\x2f\xa1\x34\xde\x71\xcb" +
+		"\xfd\x5d\x70\x48\x65\x03\x32\xbe\x97\xfb\xfc\xc2\x82\xae\x73\xa2\x72\x62\x6a\xba\x15\x57\xce\x84\x69\x59\x36\x3c\x75\x0f\x6c\x4e" +
+		"\x8e\xcb\x77\x04\x27\xd1\x37\x18\xba\x37\xfa\x18\xfe\xca\x94\xd0\xed\xa6\x3f\xda\xd9\xed\x3a\x25\xf3\xdb\xf6\x9d\x2b\xc3\x43\xbc" +
+		"\xcd\xae\x7c\x6a\x84\x03\x17\x05\xd1\xf0\xeb\xd1\x07\x90\x48\xbc\x98\x6c\x64\x91\x2a\x38\x9a\xb6\xb0\x3d\x4f\xfe\xb7\xe2\x26\xf0" +
+		"\x9e\x7d\xbe\xc5\x84\xa3\x49\x38\x46\x6f\xa7\x51\x6d\xa5\x5d\xf3\x2e\x33\x7f\x33\x52\x51\xaf\x8b\xdd\x47\x7d\xb3\x29\x07\x07\x4f" +
+		"\x8f\xc4\xdb\x75\x76\x74\x49\x9a\x50\x12\x01\x46\xa5\x2e\x92\x9c\x1e\x5b\x63\x36\x0a\xe7\xfd\x64\xbb\xf7\x8b\xf5\x7e\xd2\x19\x49" +
+		"\xf7\x8c\x39\x46\x49\x2e\x86\xbb\xc2\xa5\x37\x1b\x1b\xb2\x36\xfb\x4f\x1d\x8f\x69\xd0\xdd\x69\xe3\xdd\x4c\x6c\x79\x5d\x4c\x89\xb9" +
+		"\x55\xd5\x1a\xba\xd3\xbb\x57\xe4\xff\x93\x86\x49\xaa\x22\x66\xcc\x58\x9e\x23\xdd\x86\xa3\x2c\xb2\xd3\xbe\x61\x71\x53\x0b\xfd\xa4" +
+		"\x5e\xe6\x26\x61\x60\x36\x15\xa5\xc6\x6d\xe4\xf2\x6c\x50\xf6\x1b\xc5\x92\x69\x62\x62\xd8\xc1\x14\xff\x19\xc1\x2c\x75\x98\xaa\xca" +
+		"\xcb\x32\x9d\x69\x55\xe9\x7d\x75\xda\xd8\x4f\x9b\x20\xe9\x14\xa5\xd1\xad\x03\xf0\xad\x89\x3d\x1c\xaa\x82\xa2\xdc\x01\xf4\xee\x6b" +
+		"\x89\x37\x40\xf8\xbd\x45\xdd\xbe\xa9\x52\xcf\xd4\xc2\x37\x89\xbd\x79\x0a\x41\x72\x2a\x44\xbe\xd1\x97\xe4\x10\x22\xb3\x90\xde\x84" +
+		"\xb7\x7d\xa8\xbb\x8a\x68\x74\x76\xb6\xbd\xbe\xc2\xf4\xa4\x33\x20\x5b\x06\xf9\xc3\x21\x82\x33\xec\xa3\x6b\xec\x7e\xae\x8e\x96\xf3" +
+		"\x77\x59\x1c\x56\x1b\x19\xeb\x2a\x4f\xc2\xa5\xf5\x01\x5e\x04\xde\x2c\x36\x54\x66\x54\x6d\x75\x09\x37\xb1\xb5\x9d\xfe\x72\x24\xee" +
+		"\x21\x9d\x1b\xb8\xb3\x83\x31\x13\x3c\x91\xdb\xd8\xc4\xa2\x32\xda\x12\x56\x45\xfe\xdd\x3b\x89\x8f\x49\xf7\x9e\x32\x50\x35\xf1\xae" +
+		"\x11\xbe\x3d\x54\x69\xec\x59\x75\x46\x83\xbe\xd9\x06\x6a\x58\x67\x8a\x8c\x47\x0f\x5e\x5c\xcd\x62\xf3\xdd\xac\xfd\x3c\xdd\x61\xb4" +
+		"\xb8\x61\xb6\x71\x3a\x92\x86\xe9\xd2\xe3\x69\x13\x91\xea\x06\x21\xa1\xc7\x6e\xdd\x73\xa1\x4a\x9b\x82\x3b\xcd\x4b\xd1\x7d\xcd\x2a" +
+		"\x36\xe0\xce\x7a\x47\x8b\xda\x68\x9b\x7a\xae\x33\x48\xe9\x20\x4f\x49\xd4\x03\xbb\x87\xdc\xc7\x9d\xf4\x2b\xc1\xf4\xf3\x31\x0c\x53" +
+		"\xe6\x3e\xc2\xdf\xcc\x33\xbd\xce\x66\xe9\x8e\x60\x7c\x36\xb1\x73\x57\x83\x8c\x6c\x9f\xf7\x0d\xbe\x08\x5c\x56\x96\xf6\x74\x4f\x4a" +
+		"\x1b\xb4\x0a\xa8\xf1\xca\xe8\x3b\xed\xe9\xfd\x51\x06\x25\xf1\x44\x33\xdc\xa2\xfc\x2d\x3a\xdd\x6c\xcf\x84\xce\xce\xfa\x4a\x1c\xc5" +
+		"\x48\x33\x32\xe8\xeb\xc5\x3e\x36\x63\x8a\xcd\x9f\xab\x4c\x62\x8b\x0e\xdb\x5f\x3d\x68\x29\x8b\x3e\x9c\xd9\x18\xc6\x0e\xf1\x8d\xd3" +
+		"\x63\x87\xec\xa6\xe0\xac\x7c\xe4\xb5\x33\xd1\xe0\xa7\x59\x04\x92\x27\xc7\x26\xf9\xab\x3c\xe5\x75\x73\xec\xb3\x76\x83\x29\xe6\x3b" +
+		"\x53\xa4\xb6\xb8\x43\x8f\x3f\xb5\x92\xa4\x73\xaa\x6c\x3d\x56\x86\x66\x2e\x6d\xc0\x90\x4c\x1b\xa3\xb8\x4b\x32\xcd\xed\x65\xcb\x48" +
+		"\x4e\x95\x86\xed\xa6\x3d\x45\xb2\x97\x60\x9f\x42\xb1\xc3\xf8\xf8\x1c\xd8\xa7\x24\x00\x83\x3b\x63\x61\x33\x34\xc6\xe8\x8a\xf0\x9d" +
+		"\x6a\x22\x2e\x4c\x6b\x01\x7e\x6b\x77\x2d\xd8\xf9\x70\x55\xec\xd1\x70\x83\x69\xca\x33\x42\xf7\xe4\x22\xcc\x75\xf6\xa2\xd7\x00\x17" +
+		"\xc0\x53\xec\xf5\xcd\x36\x30\xa7\x45\x83\xea\x41\x19\x17\x99\x19\xf4\xba\xdc\xaf\x99\x1e\xe0\xd5\xe1\x74\x76\x08\x83\x56\x1d\x5b" +
+		"\x5e\xfb\x06\x0d\x07\x0b\x41\x72\xf0\x14\x97\x6b\xfe\xd1\x79\x13\x9b\x15\xae\x97\x2b\xcd\x48\x5d\x10\x2a\xb6\x99\xbe\x9b\x99\xe7" +
+		"\xc3\xbc\xdb\x09\xaa\x89\xc3\xff\xb3\xd2\x82\xb1\xa1\x99\x68\x19\x5d\xd6\x60\x63\xb5\x5a\xf7\x89\x7a\x66\x53\x57\x21\xa9\x8c\x1d" +
+		"\x15\x8a\xdb\x21\x55\x78\xd4\x93\x73\x95\xce\xb0\xef\x3e\x20\x8b\x69\x2b\xac\x97\xbb\x1a\x97\x06\xf1\xa2\x60\xbf\xf7\x4e\xec\x8e" +
+		"\xcc\x17\xb9\x9e\xb5\x29\x2b\x2f\x39\x3d\x40\xe5\xd9\x19\xd7\xa2\x6d\xa2\x01\xdf\x84\x5f\x82\x4f\xfe\xd2\x9e\xfa\xaa\x50\xc3\x97" +
+		"\x83\xd7\xdb\x5c\x34\x0d\x85\xdd\x08\x1c\x92\x8d\x94\x58\x1a\x9e\x65\xec\xef\x91\xf9\x28\xc4\xca\xc4\xdd\x3a\x35\x7e\x3b\xc3\x30" +
+		"\x2f\xaf\x08\x16\xf0\x39\x51\x6f\xe7\x5b\x91\xdd\x62\x86\xbe\x51\xec\x29\xe0\xa0\xdc\x39\xf6\x3a\x63\xea\xe1\xf7\x91\x9e\x2b\x32" +
+		"\x93\x2e\x8e\x92\xc9\x86\xe5\x8a\xd7\x45\x59\xe8\xa0\xd4\x41\x73\x16\xf3\x39\xb1\xbf\x5f\xcb\x88\x41\x5a\x85\x2b\xe1\x7e\xba\xef" +
+		"\x04\xd5\x25\x32\x12\xd9\xb0\xad\x65\x76\x91\xef\x7a\x13\x9c\x4c\x50\x2b\xf3\xb1\xbb\x64\x53\xd7\x62\x59\x90\x48\x7a\xd1\x0b\x2e" +
+		"\xca\x63\x00\xbc\x94\xf0\xac\x68\xaf\x99\x0f\x7f\xce\x97\xd9\x19\x31\xc6\xbb\x2d\x65\xfe\x9f\xad\xec\x6f\x2a\x92\x68\xf6\xd2\xf7" +
+		"\x46\x61\x3c\xa4\xc7\xc9\x47\x5c\x88\xfa\xb8\x91\x38\x94\x78\x91\x54\x4d\xac\xea\x8a\x06\x4d\x42\xba\x95\x84\xfb\xfe\xa8\xfb\xd7" +
+		"\x27\x81\xbf\x8e\xbd\xed\x2e\x00\xbf\x67\x1e\x42\x1b\x1b\x98\xeb\xbc\x77\xf9\x15\x51\x09\x93\x09\x97\xe6\xba\xe0\xcd\x9c\x1f\xf1" +
+		"\xb4\x77\x56\x68\x0a\x6e\x2c\xe2\x26\xe5\xc1\xb6\x4a\xcd\x61\xa1\xd8\x3e\xb9\x32\x4c\x73\xb7\x24\x50\xe7\x6c\x34\x61\xa0\x19\xda" +
+		"\x51\x0c\xf6\x74\xb1\xa2\x6f\x7e\x26\xaa\x8d\x8a\xd0\x56\x11\xb5\x59\xc1\xd8\xfe\x6b\x1e\xc3\xed\xc8\x37\x18\xc0\xb2\x08\x20\xaa" +
+		"\xac\xd7\x2b\xbb\x16\xe1\xec\x52\xf7\x56\xc7\x30\x44\x39\x17\x92\x5d\x12\xbb\x9d\x5e\x12\xcc\xe6\xb4\x67\xe8\x4d\xe0\x4f\xcd\xc3" +
+		"\x2c\x56\xa1\x76\x69\x50\x58\xed\x78\x60\xff\xff\x01\xf7\xff\xd9\x29\x66\x7e\xa1\xeb\x4d\xe6\x3a\xda\xc4\xfa\xd6\x9f\xb4\x7f\xf7" +
+		"\xfa\x0d\xbc\x37\x33\xf1\xcd\x14\x9b\xc4\x6d\xe5\x8b\x00\x59\xb9\x53\x10\x05\x59\xd7\xe7\x90\x28\xc2\x58\x5d\xa9\x6b\x27\xad\xa3" +
+		"\x0f\x4c\x45\x30\xf5\x3a\x81\xf5\x15\xe1\x41\x96\x86\x78\x56\x7a\xee\xaa\x1f\x91\xa4\x94\xd4\x0d\xd5\x41\x0c\xb8\xc1\xe4\xb6\x99" +
+		"\x5d\xbd\x5d\x30\xbe\xb7\x26\x2e\xc7\xdd\x09\x1e\xa9\x36\x15\xd6\x2c\x12\xfc\xe5\x48\xbc\xe1\xe6\x9d\xe6\xac\x7b\x4b\xf2\x7d\x85" +
+		"\x48\xa7\x3b\xf5\xe8\x60\xea\x05\xd4\x44\x8c\x93\x0b\xae\x5f\x95\x8f\x36\xc1\x41\xc5\xcf\x6b\x43\x7f\xe6\x7d\x34\xce\xf2\xbe\x6c" +
+		"\x4e\x13\x1b\xe3\x5b\x86\x34\x89\x6e\xbf\x74\x02\x42\x4a\x4f\x39\x9a\x8f\x7c\x55\x55\x74\xd8\x6c\x1b\x8f\xed\x52\xa0\xd6\x47\x25" +
+		"\xb1\xf6\x8a\xd8\x65\x2e\x38\x90\xde\x9a\x65\xbe\x89\x93\xad\xd8\xa3\x44\x32\x23\xc5\x74\xfd\x3a\xf5\x95\x11\x1b\xe4\x83\x51\x90" +
+		"\x4d\xfd\xb2\x14\x58\x68\x1e\x51\x6e\x2e\x3a\xf6\x5d\xe2\x8f\xf9\x0e\x03\x9e\x5a\x9b\x38\x29\x9a\x91\xaa\x69\xd7\xa0\xd4\xb9\x73" +
+		"\x34\x3d\xaa\x09\x5e\xd5\x69\x51\x7b\xec\xa8\x7f\x0b\x7b\xdd\xc4\xb3\x82\xd5\xff\x69\x84\x9a\x78\x2e\x6f\x5e\x21\xb4\x41\xbb\x58" +
+		"\x91\x1d\xa3\x7b\x58\x36\x7e\xdd\x31\xc8\x34\xd5\xb1\x61\xa0\xfb\x1b\x03\xb2\xe0\x7d\xb1\xd3\x3e\xbd\xcc\xbe\x22\x00\xa5\x19\xb9" +
+		"\xf8\xa3\x36\xc2\xea\xaa\x20\x8e\xb2\x41\xa5\xdd\x69\xc8\x6c\xf7\xf0\x64\x56\x01\x49\x47\x7b\xb5\xba\xe8\x6e\x38\xe0\x3d\xa4\x24" +
+		"\xf6\x05\xba\xd1\xb4\xb5\xef\x7f\x73\x85\xa9\x8e\x4b\xc2\x4b\x6e\x1f\x00\xa3\x6f\x71\xe1\xdb\x04\x5d\x6d\xeb\xa6\x55\x44\x3a\xed" +
+		"\x91\xa4\xbd\xe9\xe6\x67\xa2\x5d\xe6\xf3\xdb\x09\xce\xda\x5e\x54\x2f\x7d\x8b\x28\x69\xbf\xa4\x81\x34\x54\xc6\xbe\x44\xf9\xd8\x90" +
+		"\xd1\xef\xf0\x3a\x64\xea\xd3\xc0\x6f\x50\x12\x1a\x79\x42\x31\x9c\x2e\x09\x6c\xaa\xb2\x81\xba\x3a\xe4\x3c\x98\xbb\x6c\xed\xf8\x3e" +
+		"\xa2\x5d\xe2\xb3\x23\xc5\xc2\x8c\x42\x0c\x57\x3e\x7a\xf6\xb7\x8e\xda\xa7\x39\xf9\x90\x3f\xf7\xd4\x1d\x34\xf0\xf2\xcc\xa6\x71\xa1" +
+		"\xa6\x81\x43\xec\xcc\x46\xb1\x7b\x4a\x75\x3c\x23\x6c\x35\xe3\xdf\x0d\x66\xd1\x5f\x9b\x91\xd6\x33\xcd\x4b\x1b\x11\xef\x33\x5d\x9c" +
+		"\xfa\x14\xff\x8f\x46\x70\xbf\x47\x66\xad\x80\xa4\xb2\x14\xb9\xc8\x81\x47\x4a\xbc\x76\xb7\x2e\xee\x3e\x5f\xec\x86\x33\x95\x20\x3d" +
+		"\xfb\x3b\xa8\x26\xb6\x10\x2e\x8b\x76\x3a\x9d\xc3\xec\x7a\x58\xeb\xe0\x5d\xf7\xc4\xb5\xf9\x0c\x82\xc3\x6a\x22\xc2\x33\xef\x35\xd3" +
+		"\x28\x8a\x1e\xbd\x9e\x70\x73\x21\x4c\x4c\xa9\x53\x25\x92\x06\xbd\x78\x57\x97\x44\xd6\xa2\xb9\x0f\x81\x78\x74\xab\x6c\x0d\x58\xd5" +
+		"\x74\xb6\x01\x8a\x56\x5a\xcc\x95\xee\x4b\x99\x80\x75\x11\x2b\xb5\x71\xdd\x96\xf1\x8d\xf5\x19\xa9\xb5\xaa\xa8\x3e\xa8\x8e\xc9\x5a" +
+		"\xe8\x57\xf6\x56\xd4\xf7\x20\xe9\x9b\x26\x17\xe6\xb8\x66\xf8\xad\xc3\xf9\x2e\x3b\x03\xaa\xa2\x98\xfb\xd4\x58\x20\xad\xec\x33\x77" +
+		"\x1f\x2a\xa2\x08\xc5\xf3\x5c\x4d\xa2\x82\xba\x2e\x4a\x06\x66\x97\xa4\x34\x92\xa4\x85\xad\xd2\x7c\x68\x86\x46\xc1\xb2\xf6\x3b\x9b" +
+		"\x9b\x33\xbb\x53\x08\x74\xa7\x10\x76\xf4\x47\xf6\x8f\x6e\xd8\xa6\xe6\xe2\x31\x9c\x8d\x90\x8f\xd5\xdb\x38\x7a\x86\x7a\xd6\x7d\x9d" +
+		"\xd3\xb6\x4d\x6a\x8c\x91\x5d\x24\x70\xa6\x3f\xe9\x9e\x69\xb0\x6d\x81\x6f\x33\xd8\x8f\xb1\xe7\x4f\x74\x50\x63\x71\x24\xb7\xfa\x78" +
+		"\x6c\x2d\x89\xa2\xf3\x16\xd1\x93\xfb\x36\x7b\x7d\xb5\xcd\xb3\x6f\xa5\x6b\xa8\xc7\x7f\xca\x19\xdb\x87\x8b\xdd\xd1\x50\x48\x83\x56" +
+		"\xf3\x48\x66\xf9\x56\xf1\x46\x19\xcf\xc6\x4a\x1c\x16\x80\x44\xe6\x66\xd3\xd5\x69\x4e\xae\xbc\xd8\x25\x32\xd3\x70\xd7\xee\x02\x92" +
+		"\x85\xf4\xa4\x64\x69\xe8\xbe\x22\xa4\x90\x09\xfd\x55\x1a\x94\xe4\x8d\x49\xb1\x1b\xad\xeb\x97\x3b\x1d\x3d\x6b\xf2\xff\xf4\xa3\xbc" +
+		"\x5d\x6a\x3e\x52\x1f\x8e\xa2\x14\xc9\x02\xb3\xf2\x81\xf5\x94\xc7\x66\x56\x6d\xd2\xc6\x35\x89\x45\xd1\x3c\x19\x49\x5d\x38\x0c\x97" +
+		"\xc4\x66\xe4\x55\xd1\xa7\xda\xbb\x4d\x5c\xe9\x42\x9d\xb3\xa9\xfd\xab\xc9\x60\x27\x5d\x21\x53\x07\xbe\x26\x6b\x7e\xb8\xd8\x14\x6d" +
+		"\x73\x89\x75\x1f\x4f\xba\x95\x21\x59\x9e\x45\x32\x3a\x03\xee\x2f\xf1\xfe\xa8\x45\x92\xbc\xe2\x8e\x41\xbe\x17\xc6\xc8\x9a\xd4\x7a" +
+		"\xa4\x6b\xb4\x1a\x49\xce\xdb\x27\x56\x46\x40\xe6\x7e\x5e\x87\x08\x55\x4a\xc3\xee\x15\x19\x5d\xad\x91\x8c\x29\x38\x63\x5d\x5a\x32" +
+		"\x2d\x22\x52\xd9\x2f\xc1\xce\x76\xd3\x73\x32\xe1\x76\xdd\xe8\x19\x00\xaf\xcd\x73\xa9\x95\x92\x3d\xe2\xc4\xa3\xc1\xe9\x36\x02\x6c" +
+		"\x90\x00\x5e\x95\x80\xc3\xf2\xe1\x05\xd1\xdb\x16\xf7\xa1\xaf\xc1\xd4\x58\xcb\x3a\x1f\xac\xb2\x78\xce\x7f\x77\x59\x20\x86\xad\x63" +
+		"\x61\x78\xd7\xe5\x19\x0e\x88\x57\x84\x36\x1b\xe6\xf0\xc4\x58\xaf\xe3\x5c\x95\x8b\x22\xd4\xa6\x0d\xba\x64\x54\x78\x1e\x71\x72\xd1" +
+		"\x77\x9d\x90\x89\xde\x09\xae\x0f\x77\x64\x9d\x27\x95\x71\xd5\xe8\x9f\x4e\x76\x4c\xe0\xec\x20\x73\x96\x05\xc0\x51\x1a\x14\xc0\x66" +
+		"\x11\xcd\x3b\x77\xbe\x2a\x90\xa1\xb3\xd3\xb6\xff\xec\xe5\xa5\xac\x4d\xa2\xa4\xc3\x11\x80\x26\xe0\xe4\x42\xc4\x15\x23\x73\x3e\x68" +
+		"\x59\x24\xdd\x69\xbe\xbe\x2f\x8f\x64\x7e\xda\x4f\xc8\x17\x6e\x8b\x30\xd8\x73\x32\x31\x42\xa5\xd1\xea\xa0\x45\xec\xa4\xd1\xc4\xb9" +
+		"\x1a\xee\x6a\x0e\x2e\xe6\xba\x0e\xb9\x22\x6e\x6f\x63\xd9\x12\x3c\xdf\x56\x21\xff\x18\xaa\xb2\x43\x40\xe9\xf5\xf9\xe8\x86\x58\x19" +
+		"\xf4\x78\xd7\xa4\x65\x61\xd0\x5b\x87\x5c\x57\x46\xba\xb8\x51\x78\x48\xa7\xeb\xa3\xa4\x5d\x31\x57\x97\x12\x0e\x8a\x5d\x99\x74\x3f" +
+		"\x4e\x38\xf2\x18\x9a\x76\x97\x7f\x00\x27\xce\x4c\x1c\x0e\x74\xb1\x59\x57\x83\x44\x65\xa0\x75\xf6\x57\x2f\x8f\x47\xe6\x67\xdd\x8e" +
+		"\x69\x15\x2c\x6f\x16\x85\xaf\xde\x43\xa4\xa7\xdd\x69\xe2\x7c\xce\x49\x85\x00\xf8\x97\xe6\x9c\xd9\x36\x36\xe7\x9d\x9d\x67\x15\x8b" +
+		"\x36\x97\xfa\x52\x9a\x93\x78\x0f\x9d\x5c\x10\xe9\xaa\xbc\xff\xf8\xc6\x14\x8f\x15\xa6\x7f\xb8\x26\xba\xfe\x15\xb9\x5d\xb9\x68\xe1" +
+		"\xd7\x22\x30\x13\xf7\x66\x7b\x95\x04\xac\x5b\x16\x9b\xbd\xb5\xf1\x96\xca\xc5\x16\x09\x69\xda\xf3\x84\xdf\xe0\xcf\x91\xfc\xda\x51" +
+		"\xc1\x74\xdf\xec\x39\x99\x22\xc2\xe4\xb6\x68\x95\xe7\x83\x76\xd2\x7b\xa6\x17\xa2\xdb\x9e\x27\x2e\xeb\xa2\x3c\xb9\x49\xf8\x9c\x3b" +
+		"\x7d\xa4\xe9\x4b\xb0\xcc\x13\x56\xd7\x79\x79\xf7\x52\x86\x9e\x98\xec\x42\xb5\x30\x13\x6c\x23\xbf\x6c\xd1\xd9\x1a\x91\x14\xb5\x74" +
+		"\x26\x30\xa7\x29\x99\x20\xd0\x19\x88\x92\x94\x45\x22\xae\x3e\x1f\x01\xac\xa3\x1a\x9e\xc5\x68\x1f\x2a\xd9\xc3\xb7\xf3\x8c\xc1\xde" +
+		"\xca\xc4\xc4\x99\x1a\xbe\x65\xd6\x05\xb6\xc6\x07\x64\xec\x26\xff\xb3\xef\xf4\xdd\xf1\xfc\x67\x99\x1a\x38\x2b\xde\x7c\xe1\x75\x29" +
+		"\x92\x76\x71\xc1\x65\x65\x48\xa6\x16\xc0\x41\x65\xc5\x6d\xa9\x37\x35\x8f\x0f\x0b\xe0\xaa\x66\x36\xb7\xbd\xb2\xc8\x0c\x30\xb8\x30" +
+		"\x19\x5a\xf0\xb5\x56\x9f\xb6\xf5\x2f\x4a\x44\x7d\xde\xcd\xed\x00\xd5\xf9\x11\xc9\x91\xc4\xf9\x35\x45\x72\x48\xca\x82\xca\x81\x73" +
+		"\xf3\x9e\xf3\xfe\x83\xb7\x23\x1a\x96\x20\x19\x54\x1d\x70\xa1\x07\xd2\x7b\x0b\x0d\x3c\xcd\x52\xef\xae\x93\x96\x0c\xfe\xcd\xb1\x86" +
+		"\x8a\xf8\x94\x5e\xf8\x9b\xf6\x44\x67\x2c\x39\x0f\x8f\x07\xf9\x42\x5a\x5a\xf0\x6e\xb2\x25\xb6\x2e\x4a\xe2\x0b\x79\xbb\x9d\x83\xe5" +
+		"\x4e\x12\x93\x5f\xec\xea\xc3\xb4\xb1\x23\xf4\x49\x59\x78\xc7\x45\x9e\xab\x4f\x77\xd7\xd4\x34\x76\xb4\xf9\xbc\xc8\x4d\x7e\x26\x0a" +
+		"\x11\x9c\x40\x54\x1e\x69\xa8\xd2\xd0\xe3\xae\x17\x96\xe6\xa3\x4a\xbc\x10\x5b\x48\x36\x8f\x6c\x41\xe3\xd8\x29\x3d\x63\x53\x7c\x35" +
+		"\x58\xe3\xb2\xf3\x67\x57\xf8\x99\x10\xa1\xdb\xc0\x3b\x4c\x76\x97\xcc\x4c\x3c\x2e\x19\xe9\xcb\x69\x48\xe2\xec\xec\x21\x65\xe0\xa4" +
+		"\x67\xc5\xf7\x79\x9a\xe0\x76\x0f\xfa\x97\xfa\xc4\x6e\xa5\xf7\x97\x59\xe6\xb6\x4d\x20\xc0\x68\xd7\x16\x03\x0a\xe6\x58\xa5\xd6\x67" +
+		"\x43\xda\x62\x77\xac\x7f\xa8\x28\x63\x52\xb5\xb7\xaf\xab\x08\xa4\xbc\x49\xb4\xd7\xdf\x5e\x68\xd0\xcb\xa4\x67\xae\xc8\x99\x4a\x7c" +
+		"\xa7\x83\x22\xc5\xec\x68\xe2\x3d\xaf\x4f\xe4\xdc\x0c\x13\x9c\x52\x6a\xc2\x98\x76\x40\x34\xd5\x94\x2c\xab\x34\x74\xb8\xde\xbc\xdf" +
+		"\x4c\x9f\x74\x1f\x82\x8a\x06\x80\x46\x26\x08\x82\x5e\x08\xb1\xc1\xb5\x76\x75\x68\xda\x96\xc1\xf5\x2b\x6e\x78\xdc\x3c\xa8\x32\x4e" +
+		"\xf9\x62\xec\xb5\x39\xc1\x12\x86\xc9\x75\x51\x86\x7a\x09\x48\xfb\xd7\x32\x5a\xc3\xba\x87\x37\x7d\xb4\xd7\x13\x77\xab\x73\xba\x6b" +
+		"\xd7\x93\x91\x74\x2d\x78\xf3\xda\xa1\x4d\x1a\xc0\x4a\x3d\x0b\xe0\x7e\xb7\x28\x9b\xcb\xc1\x85\xe9\xce\x8a\x04\x77\x2a\xb4\x28\x71" +
+		"\x15\xaf\x2e\xc5\xf5\xd5\xe0\xee\x42\xcc\xf8\x19\x48\x06\x55\x22\x33\xc8\xd1\xac\x79\x15\x91\xd5\x5b\x55\x66\x0b\xf1\x48\xa9\xbb" +
+		"\x98\xbe\xea\xba\x7a\xf5\xc2\xc1\x52\x07\x19\x7b\x25\xe0\xe0\x6c\xac\x09\x5f\x40\x59\x70\x7e\x3e\xe0\xed\x42\xaa\x1d\xd2\x6d\x50" +
+		"\x1b\x85\xc7\x37\x23\xf1\xe4\xc5\xad\xc8\xdc\x0d\xbe\xae\x67\x9c\x7a\xa9\x2d\xf3\x64\x6e\x93\x30\xf1\x03\x9a\xf8\xd6\x08\xbd\xb4" +
+		"\xf5\x5c\x63\x97\x91\xcd\xee\x01\x6a\x82\x17\xe7\xff\xe3\x29\x27\xfd\x2a\x91\x59\x5a\x8b\x64\x6b\x45\x91\x2b\x92\x20\xb1\x8d\x35" +
+		"\x8b\x2e\xd8\x90\x66\x21\x63\xfe\xaf\xbe\xdc\x37\x55\xdf\x20\xbf\xac\x22\xc2\xb8\xd9\xf9\xc0\x13\x8b\xaa\x6d\x54\xca\x26\xeb\x6c" +
+		"\xde\x4a\x71\xe7\x00\x82\x2f\x3d\x25\xb5\xfb\x73\xef\x7a\xad\x96\xd7\x7b\x9b\x78\xea\x7d\x56\xda\xf2\xba\x48\xe3\x5b\xdb\xcc\xcb" +
+		"\xb8\xfb\xe6\x62\xe0\xd3\xe8\x93\xae\xd4\x3b\xbb\xae\xfa\x6c\xb6\x8f\xf5\x98\xf3\xc0\x1d\xa7\x80\xe3\x8b\x8c\xa9\x39\x95\xbe\xb9" +
+		"\xd9\xb0\x12\xf0\x1a\xb0\x7b\x07\x24\x2b\xb2\x9e\xd4\x9f\x90\xf2\xc6\x0f\x3a\x37\xf5\x68\xe2\x9b\x18\x65\x7d\xdf\x92\xfb\x3f\x25" +
+		"\x38\x49\x55\xa2\xad\xc1\xa5\xb7\x82\x33\xda\x83\x53\x9b\x15\x5b\x41\x12\xdc\x5a\x05\x0e\xff\x31\x38\x5e\x45\x33\x27\x83\x7b\xdb" +
+		"\x82\xc7\x4b\xc0\x07\x9e\x22\xb8\xb7\x3f\xc1\x91\x25\xe0\xc2\x3c\x92\x0f\xa7\xd1\x3b\xb4\x1c\x7e\x81\x5e\xe0\xfc\x2b\x37\x02\xdd" +
+		"\x74\xd5\x99\x1f\xe7\xf1\xe3\xe9\xbd\x08\xee\x3e\x5c\x00\xbf\xd8\x2a\x15\xb9\xcd\xa3\xee\x87\x3f\x2a\x80\x7b\xf7\x17\x90\xdc\x3f" +
+		"\x33\x8f\xe4\x35\x82\x73\x5e\xc9\x23\xf3\xf1\xab\x79\xaf\xb1\x39\x31\xc3\x47\xfe\xcb\xf7\xf3\xb8\xbd\x57\x47\xdc\xd9\x1a\xf9\x37" +
+		"\xb4\x31\xca\xa9\x68\xd7\x05\xbc\x04\x2d\x45\xb4\xfa\x8b\xb5\x06\x19\x30\x45\xf3\xa9\xba\xbb\x6d\x4b\xd4\x0b\x6c\x71\x25\xd8\x5f" +
+		"\xed\xfe\xb6\x5c\x08\xce\x91\x53\xf8\x96\x44\x6c\x95\x0e\xf5\xeb\xab\xc0\x47\xd6\x95\x83\xf8\xff\xc7\xbf\xa7\xbe\xd0\xc2\x3c\x5e" +
+		"\x00\x67\x3a\x04\xfd\xd4\x8b\x79\xf0\xa5\xeb\xc0\xa6\xe0\xdc\xf7\x12\x70\xff\x2f\xc1\xb7\x4f\x05\xc7\xcd\xd5\x44\xb5\x01\x27\xfc" +
+		"\x11\x5c\xdf\x93\xe0\x1b\x6b\x19\x3d\x1d\xeb\x22\x10\x75\xa6\x4e\xb3\x48\x97\xff\x7f\xfc\xa8\xfa\xff\xeb\xaf\x08\x6e\xd8\x9d\x6f" +
+		"\x70\x8b\x6f\xef\x12\x54\xb4\xcb\xde\xca\xac\x2f\x80\x6b\x0b\xe0\xca\x42\x83\x15\xb0\xa2\xf0\xff\xe3\x53\x4f\x3d\x56\x0a\xbe\x20" +
+		"\xa5\x3d\x61\x63\x0d\xf8\xac\xea\x1b\xe7\x0f\xab\x06\x8f\xcd\xa8\x06\xfb\xf5\xae\x05\x77\xbd\x56\x0b\x4e\xf8\x3b\x38\x59\xbb\x8e" +
+		"\xed\xff\x98\xe0\x87\xbb\x6a\xc0\x73\xc0\x4d\xc7\xab\xc0\x2d\xc7\xcc\x59\x4c\x3a\x82\xdb\x4e\x06\x8f\x2c\xad\x06\x7b\x3c\x54\x0b" +
+		"\x8e\xda\xce\x68\x50\xf2\xe9\x36\x82\x3d\xbf\x09\x8e\x7c\xa2\x06\xdc\xf3\x40\xb5\x2f\x84\xef\x82\x4b\xdf\xaf\x02\x37\x9f\x0a\x4e" +
+		"\xef\x51\x0d\xfe\x1c\xdc\xd0\x0e\x9c\xbc\x59\xfb\x8a\x5c\x0b\xbe\x37\x4f\x95\x30\xed\xc1\x27\x3a\x80\x6b\x07\x2a\xee\xd6\x36\xfe" +
+		"\x0b\x74\x58\xf3\x51\x06\x7c\x5e\x5b\x4e\x09\x96\xeb\xdf\x5f\x4e\x6c\xb7\x6a\xf0\xf3\xfb\xab\xc1\x7f\x81\x27\x9e\xaf\x02\xa7\xeb" +
+		"\x30\xe6\xe9\x2a\xf0\xbd\xd1\x55\x61\x39\x27\x29\x54\x7e\xa4\x6f\xb6\xb8\x07\xc8\x23\x1f\x10\x5c\x3e\xa6\x16\x7c\xf3\xf5\x6a\xf0" +
+		"\xe0\x82\x6a\xf0\x71\x1d\xae\x03\xf7\x9c\x0b\x1e\x3f\xd7\x0b\xfd\xfa\xe8\x64\xff\x06\x7b\xf7\xae\x06\x9f\x52\x63\x80\xf9\x3a\xf4" +
+		"\xda\x59\x01\x8e\xd3\x61\xc0\x90\x1a\xf0\xfd\x87\x95\x66\xea\x5e\x0e\xfe\x09\x7c\xe7\x21\xd1\x2b\x94\xad\x7a\xfa\x93\x1c\xd8\x4d" +
+		"\xed\x21\x7a\x7f\x0f\xc9\x14\x37\x58\x4f\x35\x07\xa7\x8e\xad\x06\xdf\xd3\x3d\x3e\xf2\x2b\xf0\x50\xbf\x6a\xf0\x5d\x1d\x8e\x6f\xa8" +
+		"\x00\x0f\x7c\x17\x7c\x6c\x6f\x25\x38\x78\x71\x35\x38\xfe\x79\xed\x86\xd7\x57\x9d\x02\xe4\x17\xbd\x3b\xbe\x1c\x7c\x66\x97\x37\x97" +
+		"\x99\xfb\x65\x06\x1c\xae\xc3\xe4\xfb\x6b\xc1\x07\xdf\x22\x92\xfe\x53\xaa\x3d\xa6\x1c\x56\x09\x3e\xaa\x24\xe1\xac\x83\x35\x60\x0f" +
+		"\xe9\x8b\x3e\x63\x08\xf6\xf8\xb2\x1a\xec\x7e\x1f\x38\x6f\x6c\x0d\x78\xff\x33\xbe\x0f\xeb\xdb\xfa\xbc\xef\xcc\x2a\xf0\xa3\x5d\x95" +
+		"\xe0\x94\xcd\xd5\xe0\x96\x13\xd5\xe0\xc2\x39\xb6\x94\x2b\xc0\xbe\xeb\x2b\xc0\x47\x67\x54\x82\x63\xba\x56\x83\x3f\x01\x5f\x1a\x5a" +
+		"\x03\x6e\x3a\x4c\x70\xd5\xa8\x6a\x70\x9c\x54\x42\xef\x69\xd5\x60\xaf\xc1\x55\xe0\x4b\xda\x5c\xe0\x4b\xe1\xe9\xeb\x1a\x81\x6f\xbd" +
+		"\x9a\x03\x3f\xfa\x36\xf8\xb9\xf6\xf2\x19\x46\x70\xf8\x47\x95\xe0\x13\xf7\x81\x07\x5f\x6b\x0c\x1e\xd8\xd4\x04\xdc\xfc\x70\x02\x7e" +
+		"\x5a\xea\xa2\x72\xe4\x39\x05\x28\x9d\xc0\x0f\x24\x3c\x47\x76\x57\x83\xb3\xaa\xc1\x57\xde\xa8\x74\x18\xf6\xb7\xe0\x0e\x9d\x62\xf2" +
+		"\x6c\x82\x2f\x97\x79\xa9\xfb\x40\x81\xca\x4f\xe8\xee\x67\x3f\x9a\x80\x5b\xa6\x12\x9c\x32\x8d\xe0\x27\x9f\x13\x9c\x3a\xba\x16\x3c" +
+		"\x30\x4e\x12\x76\x40\x1d\x20\x94\x47\xed\x26\xa3\xfb\xf9\xc0\x1a\xb0\xe7\x6f\xc1\x07\xe5\x13\xaf\xdb\x51\x0b\xbe\xad\xaf\x0d\xdd" +
+		"\x54\x05\x7e\xf0\x5e\x15\xf8\xf2\x30\xb9\x45\xad\xc1\x9e\x8f\x11\x5c\x78\x1f\xb8\xed\x74\x70\xe9\xb0\x2c\x38\x45\x59\x88\x79\xe5" +
+		"\xe0\x2f\xc0\x25\xcf\x54\x4b\xcd\x65\xc0\x77\x74\x85\x2d\xc7\x08\xee\xf8\x92\xe0\x68\xad\x6d\x9d\xed\x29\xad\xa4\xae\xbb\x6b\xc0" +
+		"\xcf\xdf\xab\x01\xb7\xea\xc3\x07\xe4\x99\x3d\xf1\x5d\x6f\x32\xab\x2d\x1b\x66\xea\xb0\xaa\x0f\xc1\x49\xff\x07\x8e\xec\x5a\x03\x9e" +
+		"\x01\x7e\x72\x7f\x0d\xd8\xef\xb9\x6a\xf0\xa1\x09\xd5\x60\x57\x6d\x56\x32\xe4\xa3\xc4\x24\xf5\xa1\x49\xb5\xe0\xf1\x6e\x09\xf8\xea" +
+		"\xab\xb2\xd9\x92\xd7\x35\x83\xd4\xbe\xee\x44\x0d\x38\x7d\x6a\x35\x78\xe2\x03\xa5\x8d\x1f\x50\xc9\xc6\xf6\x1a\xf0\xe0\x80\x4a\x70" +
+		"\xcf\x40\xdb\xef\x9e\xbf\x06\xdf\x5d\x48\x70\xc8\x5c\x82\xcf\xfd\x15\x7c\xb8\xb7\xd2\xd6\x72\x23\xa6\x6a\x46\xdf\xdc\x4c\x70\xad" +
+		"\x0e\x0f\xe9\xcf\x7f\x80\xf7\xf7\x96\x19\xef\xed\xa5\x67\x83\x67\x56\x83\xfd\xf5\xc9\xde\xf5\x19\x10\xf6\xef\xb9\xf5\x2e\xa6\xab" +
+		"\x74\xdb\xc7\xf4\x0c\x47\x67\x57\x7b\x10\xf9\xda\xdc\x6a\x70\xe0\x4d\xe0\xda\x5f\x81\xef\x9e\x04\x0e\x7a\xb5\x1a\x7c\xff\x0b\xcd" +
+		"\x8c\xbe\xfd\xe6\x8a\x5a\x70\xa9\x0e\x9f\x49\x4a\x47\xdd\x67\x42\x3a\x7a\x4c\x9a\x00\x7c\x63\x7c\x15\x78\x7c\x55\x06\x7c\x5f\x87" +
+		"\x45\x9a\x9a\x2f\x2b\xc1\xb7\x8f\x79\x77\xf7\x77\x04\xfc\x4c\xae\x00\x1f\x79\xbd\x0a\x3c\xa4\xa7\x18\x5a\x0b\x1e\x94\x6e\x91\xf8" +
+		"\x69\x6a\xde\xeb\xa3\x8c\xdc\xd0\x3a\xf0\xf3\xd7\xb4\x22\x5e\xf7\x75\xb3\x66\x9e\x97\xff\x0c\xd3\xcf\x77\xe9\xf0\xc5\x1f\xc1\xdd" +
+		"\x7f\x02\xef\x97\x6b\xdd\x4f\x6e\xc4\xbb\x93\x1b\x81\x53\x96\x68\x39\xbe\x2a\x88\x43\xba\x64\xf8\x0c\x4d\xeb\xe3\x75\xe0\xfb\x5f" +
+		"\x05\x97\xac\xab\x06\xfb\xde\x12\x85\xaa\xa3\x6b\xc0\x4f\x7d\x4f\xba\xfd\x2b\xaa\x1d\x4e\x5e\xbf\x52\x42\x5a\x09\xce\xd0\x16\x29" +
+		"\x4f\x6c\xc9\x82\x33\x77\x2b\x50\x7f\x2d\x03\xce\x1b\x57\x01\xbe\x56\x07\x3e\xa0\x00\xb7\x87\xf4\xed\xea\x37\xab\xc0\x95\xbd\x2b" +
+		"\xc1\x5e\x73\xe5\x1f\xa8\xbe\x78\x89\xf4\xc8\xa4\x35\xb5\xe0\xab\xd2\xd4\x7d\x56\x4b\x8d\x9f\x06\xce\x7c\xb6\x1a\x1c\xb2\xba\xda" +
+		"\x83\xf2\x05\x6b\xaa\xc1\xb5\xeb\xab\xc1\x27\x74\x98\xaf\x7b\xfb\x58\x87\xfd\xcf\x64\xc0\x2f\x46\xa7\xcd\x8d\x2e\x02\x9f\xbf\x03" +
+		"\xec\x37\xa5\x06\x7c\x5b\x4e\xf0\xa7\x2f\x54\x80\xef\x29\x15\x3a\x7f\x08\x41\x6d\x3f\xfc\xb0\xea\x64\x57\x33\xdd\x2c\x66\xab\x46" +
+		"\x64\x8b\xfe\x7c\x5b\x7e\x62\xef\x9d\xbe\xd5\xde\xde\xf7\xaa\xdd\xf9\x7c\x6b\x7e\x2d\x38\x67\xae\x67\xb0\xbe\xec\x21\x3f\x59\x3d" +
+		"\x78\x56\xec\xa8\x06\x87\x79\x8b\xb2\xc7\xb6\x56\x83\x2b\x32\xe0\x2b\x4d\xc1\x41\xad\xec\x2a\x5f\xe6\xbc\xed\xf6\xdb\xba\xea\xe7" +
+		"\x53\x09\xce\x19\x50\x07\x4e\x5f\x29\xaf\x7f\x92\x56\x8a\x86\xee\x68\x2f\x7d\xf0\xac\xdf\xf8\xdb\xab\x85\xe4\xeb\xb0\x5d\x86\xe4" +
+		"\xd3\x17\x6b\xc0\xb1\x0f\x65\xc1\x8f\x27\x65\xc0\xb5\x8b\x6a\xc0\x05\x1a\xf8\x2d\x0f\xa9\x55\x68\x67\x19\x04\x29\xcc\x67\x33\xe0" +
+		"\x8c\x9e\x19\x70\xce\x14\x57\x28\xe3\x86\xca\xd3\xd3\x42\xd8\xfd\x0b\xf0\x85\x8f\x35\x00\xd7\x82\x3b\xaf\x05\xdf\x94\x13\xf8\x74" +
+		"\x23\xc7\x7a\x16\x3f\x5a\x23\x9b\x58\x05\x7e\x78\x07\x38\x55\x16\x72\xfe\xd3\xd2\xc3\x8b\x2a\xc0\x63\xbb\x64\x4c\xf6\x13\x9c\xb7" +
+		"\xa1\x16\x7c\x4c\x9a\xeb\x93\x0a\xf0\x59\x69\xb7\xe5\x5f\x3a\xbb\xe1\x03\xed\x2f\x72\x06\xd8\x31\xf2\x50\xbe\x8f\xe9\x50\x6d\x08" +
+		"\x7c\x4c\x1b\x9c\x3c\x2d\xe7\x6e\x52\xd7\x5a\x70\x8f\x76\xd8\xe9\x3f\x4a\x93\xbe\xbf\x1a\x5c\xf0\x6b\x70\xe5\xac\x5a\x70\xe1\x4b" +
+		"\x49\x14\x21\x6b\xee\x3e\xd5\xd9\xd7\xd7\x82\x8b\xb4\x6e\xe6\xc8\xba\xac\xdb\x4b\x70\xf5\x9d\xe0\x67\x47\x2a\xc1\x53\x82\x3b\xd3" +
+		"\x5f\x04\xcd\x15\xbd\x6a\xc1\x71\xeb\x25\x1f\xdd\xab\xc0\xad\x7d\x93\xc0\x1c\x3e\x6c\x02\x76\x6b\x06\x6e\x7e\xbd\x12\xfc\xe8\x68" +
+		"\x35\xb8\xf4\xc5\x3a\xc7\x7f\x9f\x3c\x5e\x1d\x19\xc8\xef\xf8\x48\x0c\xfb\xa4\x12\x7c\x4d\xe6\x67\xc3\x87\x75\xe0\xe2\x33\xc0\x5d" +
+		"\x0f\x38\xf5\x67\xa1\x96\xfc\x53\x3a\xbc\xf1\x72\x02\xf6\x9d\xed\x5b\xcd\x8d\x95\x02\x7b\xec\x9d\x5a\xb0\x6b\xbd\xe4\xa7\x77\x06" +
+		"\x3c\xb4\xa9\x1a\x5c\xbf\xba\x02\x94\x7f\xfb\x60\x0d\x78\xe0\xa1\x3a\x70\xb6\x6e\xfb\xd9\x4f\xca\x1d\xb3\xf1\x32\xb1\x19\xbd\x6b" +
+		"\xc0\x71\xdd\xb4\xad\xcf\x83\x35\x1e\xdc\x9e\x07\x3e\x92\x0a\xf1\x91\x04\x7c\x6d\xa0\x53\xb2\xb6\xf6\xd3\xa5\xfb\xd6\x78\x89\xda" +
+		"\xc8\xfe\x35\x0e\x90\x2f\x79\x3a\x0b\xee\xd7\xe1\x93\x47\xb4\x66\xf5\x2d\xf9\xc4\x8b\x06\xd4\x44\x33\x26\x35\x44\x95\xd9\xde\xbb" +
+		"\xc0\x07\x65\xf7\xa0\x4a\x70\x81\x0e\xaf\x3d\xac\x85\xa1\xc4\xe0\xf8\x46\xe0\xa7\xab\xea\xc0\x0f\x7f\x08\x6e\x58\x59\x07\xae\x9c" +
+		"\xea\x91\xf0\xb6\x37\x15\xe4\x6a\x45\x1c\xff\xa0\x0a\x7c\x74\x96\xd0\x2c\xed\xa5\xf4\xfa\x9e\x3a\xf0\x45\x7d\x70\x1a\x78\x58\xff" +
+		"\x7f\xbc\x29\x38\xf6\xdd\x6a\xf0\x05\xad\x83\x0f\xbe\x05\x1e\x97\x8f\x33\xae\x5b\x06\x7c\x7c\x10\xc1\xed\x3a\x7c\xfc\x12\xc1\xe7" +
+		"\x1f\x27\xb8\x4f\xde\x5a\x5f\x82\x0f\x6e\xa9\x02\xbb\x29\x3a\x78\xbf\xa5\xc7\x0f\x1f\x6c\xa8\x02\x8f\x6c\xae\x01\x5f\x7d\x23\x03" +
+		"\xbe\xf4\x45\x05\x38\xe7\xd5\x1a\x70\x7f\x1d\xf8\xf8\x9e\x6a\x87\x6a\x46\x4b\x03\x3c\xff\x5e\xf5\x7f\x42\x93\x79\x6b\x75\x65\x81" +
+		"\x1c\xaf\x2f\xad\x02\xdf\x59\x46\xb0\xdf\x56\x82\xa3\xcb\xc1\x0f\x4e\x01\x17\x8a\x0a\xaa\x48\xe5\x93\x25\x92\x93\xc7\x2b\xc1\xc7" +
+		"\x9e\xa8\x04\xa7\x8d\xd2\xe1\xbf\xc1\xa7\xf5\x00\xc7\x77\x48\x47\xed\x23\xf8\x91\x24\xff\x39\xd9\xec\x29\x5f\x56\x39\xf4\xfd\xbc" +
+		"\x3c\xbd\xb1\xdd\xaa\x1d\x35\x3c\xa4\x5e\xf5\x6f\x8d\xaa\x05\x67\x69\x3f\xb3\x25\x5f\xc8\xd7\x79\x43\x46\x66\x9d\x7e\x99\xf3\x36" +
+		"\x50\x9b\x33\xe0\x07\xb2\x70\x0b\x17\x56\x82\x2b\x37\x94\x82\x6f\xed\x53\x30\x22\xdf\x62\xf4\x93\xf2\x49\x75\xab\x6b\xef\x06\x5f" +
+		"\xfb\x1e\x38\x69\x81\xbc\x80\x89\xb5\xe0\xa1\x45\xd5\xe0\xa6\x3e\x19\xf0\x23\x69\x87\xf5\x23\x6a\xc0\x87\x5e\xd7\x0a\x6d\x04\x0a" +
+		"\x97\xef\xf3\x6b\x70\x6f\xb7\x2a\xf0\xe9\xf9\xda\x4c\x68\x24\xc1\x15\x7a\x84\xf9\x9d\xc0\xe7\xd2\xd8\x79\x4c\xbd\x6e\xe5\xbd\x1a" +
+		"\xdf\x53\x6d\xec\xdc\x1a\xf0\x15\x1d\x86\x7d\xa0\x35\x51\x03\x2e\x2d\x05\x9f\x9c\x51\x02\xd6\x8b\x53\xf0\x91\x46\x74\xd1\xa0\xc4" +
+		"\x37\x8d\x7b\xfa\x5e\x70\xd6\xe4\x1a\xf0\x13\x69\xde\x55\xea\x4a\xfd\xd6\xc0\x12\x70\x9b\xf2\x8e\xff\x02\xfb\x0d\xaf\x01\x57\x7e" +
+		"\x5c\x0d\xf6\x7c\xa2\xd2\xf7\xb9\xdb\x26\x8b\xb6\xf5\x99\x2a\x57\x9c\xaf\xbc\xaa\x2d\x61\x7e\x03\x4e\xd2\x36\x46\x3b\x9e\xcf\x82" +
+		"\xc7\x26\x67\xc1\x97\x36\xa9\xcc\x6b\x63\x39\xf8\xe1\x9b\x95\xde\xd7\xb4\xab\x94\x71\x0e\x7c\x45\xd9\x3c\xed\x8f\xf3\xe0\x59\x60" +
+		"\xff\x57\xaa\x3d\x87\xf5\x0f\xb0\x0b\xb8\xcb\x17\xde\x0e\x3a\xb6\x75\xe8\x7f\xc0\x39\x23\xaa\xc0\xc5\x1d\xbc\x4e\xf2\xf1\x05\xf2" +
+		"\x72\x95\xc9\xef\x33\x94\xe0\x6b\x2f\x7a\xff\xdf\x59\x2b\x2a\xc1\x1e\x52\x0e\x7d\x65\x5c\xdb\x83\x04\x27\xea\xea\xf3\x0f\x55\x83" +
+		"\xc3\xfb\x65\xc1\x5d\xab\x2a\x1d\x97\x1e\xf9\x8e\x74\x96\x1e\x75\x86\x92\x6c\x3d\x76\xe4\xd2\x41\xec\xba\x58\x52\xf5\x1e\xc1\xa1" +
+		"\x87\xa5\x7b\x14\x17\x3c\x33\xa2\x12\x1c\x2a\xd7\xe3\x55\x0d\xe1\x2b\xdd\x15\xb6\xae\x95\xf7\x98\x36\x5d\x39\x96\x80\x8f\x8c\x22" +
+		"\xb8\x51\x0a\xe7\xbd\xce\xe0\xe7\xf2\x6b\x1f\xfb\x3f\x70\xc8\x41\x82\x87\xb4\xa6\x9e\x95\xe7\x36\x7a\x5a\x25\xf8\xf1\xcc\x4a\xf0" +
+		"\xe0\xbb\x92\x13\xdd\x4f\xd7\x8f\x08\x4e\xaa\x03\x27\x1e\x4f\xbb\x80\xb6\x02\xef\xef\x41\x70\x58\x1f\x7a\xff\xf0\x0d\x73\xa4\x99" +
+		"\xdf\x4c\x7b\xbc\xbd\x42\x70\x91\x14\xd9\x87\xf4\x66\x43\xa3\x65\x79\x12\x70\xa7\x2e\xff\xcc\x17\x04\x9f\xce\x83\x3b\xc7\x0b\x40" +
+		"\x9b\x24\x87\x44\x92\x7a\xbc\x35\xf8\xfa\x2f\xc1\xf9\xd2\x37\x0f\x48\x7b\xd4\x2f\x90\x55\x79\x32\x0b\x7e\x30\xb7\x1c\x1c\xf7\x4f" +
+		"\x70\xdf\xe7\xb2\x08\x0f\x56\x82\xcf\x48\xd0\xea\xb5\xec\x76\xbc\x23\x3b\x2d\x0b\x3c\x73\xbf\xb4\xc4\xc0\xb4\x5b\xb6\xd0\xa3\xd3" +
+		"\xc1\x11\xf2\x6a\xcf\x07\xb7\xeb\x8e\x5f\xdb\x5f\x05\x1e\xab\x44\xb2\x53\x39\xad\x1e\xbd\x64\xd3\x45\xd4\xeb\xf1\xa0\xa8\x0d\x72" +
+		"\xc4\xd7\xe8\x30\xe9\xd9\x04\x9c\x35\xa6\x06\x7c\x7c\x8a\x32\x5c\xdf\x07\xf7\xca\x1c\x4e\x9f\xe0\xd5\x0e\x53\x76\x56\x82\x9f\xe5" +
+		"\xc0\x2f\x0f\x55\x82\xa3\xf4\xac\x1f\x7d\xac\x41\x93\x23\x3a\x53\x8e\xdb\xeb\x1a\xa7\xf9\x4f\x56\x81\xbb\x4a\xc0\x27\x1f\xaf\x92" +
+		"\x61\x20\xb8\x6f\xb8\x23\xef\x83\x9f\xcb\x80\xcf\xea\x9d\x0f\xa6\xd5\x80\xc7\x3f\xf7\x54\xdc\x04\xb9\xc3\x0f\xc8\xd1\x24\xb8\x20" +
+		"\x0b\x2e\x9b\x5c\xe5\x65\x8f\xc3\x7e\x01\x1e\xd4\x6d\x4d\x7f\xb1\x16\xdc\xa9\xfb\xfd\xf4\x37\xe0\xfd\x8a\xb2\x0e\xca\xdb\xda\xd8" +
+		"\x11\x9c\x2a\x50\x76\x9b\xec\xf0\xfd\x13\xab\x1c\x05\xec\x9d\x77\x66\xf5\x98\xa1\xd5\xe0\x67\x7f\x04\x7b\x3c\x57\x05\x1e\x1d\x57" +
+		"\x05\xbe\xf1\x54\x95\xf7\xee\x92\xce\x3e\x26\xad\x38\x75\x95\x06\xa4\x04\x9c\xf1\x03\xf0\x65\xfd\xa7\x90\x67\xec\x67\x55\xe0\xc3" +
+		"\xbf\x04\x97\x49\x9b\x6e\x79\xa9\x16\x3c\x3c\xa9\x0a\xd4\x23\x3c\xa3\xa5\xbe\x58\x8f\x3d\x5b\x12\xf7\x8c\x1d\x8e\x12\x9c\xa3\x08" +
+		"\x61\x4f\x16\xfc\x68\x6f\x15\x38\x61\x73\x25\xf8\xc2\xcf\xc0\xc1\x22\xfa\x7d\x26\x0f\x4a\xba\x57\xaa\xab\xdf\xd7\xc0\x4f\x35\x2d" +
+		"\xf5\x4a\xda\x1f\xd0\xe1\xf9\xdf\x80\xb3\x7e\x0d\x76\x5d\x52\x03\xf6\xde\x9d\x80\xfd\x37\x55\x82\x9b\x5e\x70\xbe\x58\x8f\x17\xab" +
+		"\xc0\xf1\x72\x18\x5f\x93\x37\xbe\x4a\x7e\xae\x22\x83\x05\xb2\xe7\x83\x74\x58\x54\x05\xbe\x7b\xbc\x12\xfc\x64\x3b\xc1\xf1\x13\xe4" +
+		"\x5e\xc8\x63\xec\xf7\x5e\x16\x3c\x30\x20\x0f\x1e\x7a\x99\xe0\xa4\xa9\xda\x95\xee\x3c\x70\x9c\x7c\x8f\xab\x1c\xeb\x13\x53\x56\x61" +
+		"\xd9\xfd\x5a\xaf\xfd\xd2\xcd\xa4\x76\xcd\x24\xb8\x70\x16\xc1\x59\x4a\x19\xae\xd4\xe8\x55\x80\x9f\x3c\x5d\x09\xbe\x2b\x68\x63\x9b" +
+		"\x62\xa9\x2c\xf8\xc0\x16\x19\x03\x49\xc0\xf1\x4e\xe0\x2e\x69\xac\x07\x86\xa7\xbb\xb6\x6e\x7f\x3d\x0b\x76\xd7\x76\x6e\xef\xcb\xd6" +
+		"\xee\x16\x34\xb4\x5f\xeb\xf9\x8b\xc7\xe4\xc2\xdd\x02\xf6\xf8\x2e\x38\x4b\x5d\xcb\xcf\x00\x1f\x6e\x0d\xce\x59\x54\xe5\xfe\x78\xef" +
+		"\x85\x55\x9e\x82\xfa\x3d\xd8\x12\x3c\xfc\x76\x95\x57\x0f\xbc\x23\x73\xb3\x76\x7b\x35\x78\x40\xea\x72\xcb\x82\x0a\xf0\xf8\xb3\x55" +
+		"\xe0\x0b\x8a\xf7\x6f\x01\x3f\xc8\x82\x83\x7f\x00\xbe\xf4\x40\xad\x77\x7c\xd8\x28\x4d\xda\xab\x47\x02\xbe\xfb\x62\x02\x0e\x97\xa7" +
+		"\xfb\x61\x4f\x82\x53\x14\xeb\x69\x3f\xa5\x21\xff\x04\x9f\xfc\x27\xf8\xfc\x1c\x82\x43\x9e\xa9\x05\x5f\x50\xa0\xb7\x7d\xae\x96\xab" +
+		"\x44\x71\xef\x4b\x35\xe0\xf7\xc1\x77\x25\xb9\x47\xdf\xa1\x5b\x98\xb1\xaf\xd4\x82\x03\x1f\x93\x8b\x31\x29\x0b\x2e\x5e\x5f\x03\xbe" +
+		"\xd6\x3d\x01\x2f\x05\x9f\x99\x55\xe3\x7b\x98\x75\x93\x3b\xfc\xc4\xc4\x1a\xb0\xef\x73\x35\xa0\x9c\xbc\x03\x52\x34\xfd\xf7\x10\x1c" +
+		"\x65\x4e\x9e\x7c\x97\x2f\x96\x64\xc0\xde\x23\xab\xc0\xee\x3d\x6a\xcc\xb3\x7d\x4f\x33\x74\x68\x77\x25\x92\x45\x0a\xe6\x75\x1b\x33" +
+		"\x66\x4b\x06\xaf\x10\x9a\x21\xee\x96\x2e\xf1\xb1\xb6\xd9\x5b\x48\x70\xba\xee\xea\xf0\xf3\x8e\x54\x7f\x31\xae\x06\x1c\xa1\xc3\xe4" +
+		"\xbb\xc1\x37\xa5\xec\x0e\xf5\xd6\x82\xfc\xb4\xca\x6b\x0d\xd2\x10\xf9\x81\xc6\xbe\x13\xcc\x0a\xc9\xe7\xcb\xf7\x13\x7c\xf6\x1d\x85" +
+		"\xe4\xc7\x72\x20\xe4\x32\xc8\x03\xbe\x12\x1c\xa6\xd1\xff\x72\x50\x95\xf7\x3b\x79\x68\xab\xa6\x71\x8d\x86\x71\x6d\x15\xd8\x73\xbe" +
+		"\x1a\x0d\xfd\x14\x9c\xf6\x66\x05\xd8\xf5\x80\x56\xb8\x76\x4b\x9b\xb7\x97\xe0\x63\xf2\x3a\x26\x6a\xed\x8e\xff\x37\xf8\xe9\xbf\x3d" +
+		"\xd9\xbc\xba\xbe\x0a\x3c\xd8\x19\x5c\x33\xbf\x02\x1c\xd8\x4d\xcf\x36\x45\x37\xb9\x9c\xe0\x29\xe0\x13\x72\xcf\x46\x3c\x55\x09\xae" +
+		"\x79\xa6\x02\x7c\xe8\xef\x72\xb3\x6b\xc0\xfe\xf2\x0a\x7a\x4b\xc2\x17\xef\x22\xd8\x0e\x9c\xae\xff\xcf\xdf\x51\xe3\x38\xef\xe9\xe0" +
+		"\x0b\xba\xd6\x3a\x89\xf9\xc0\x25\x04\x5f\xdf\xeb\x2c\x98\x7b\xc1\xcf\xbe\x0e\xae\xbe\x09\xbc\xd3\x02\xed\xc3\x4a\x44\xf4\xd5\xe9" +
+		"\xb2\xe0\xba\x5f\x80\x5b\xb4\x3c\xd7\xea\x02\xcf\xef\xaf\x01\x87\x08\xdb\x79\x45\xbe\xe5\x97\xdd\x2b\xc1\xfd\x5b\x94\xb4\xd6\x5a" +
+		"\x7f\xf4\x48\x15\x38\xe2\x85\x1a\x53\xb1\x2f\x0d\xa8\x06\xe5\x95\xf5\x99\x5a\x01\x1e\x5d\xaa\x70\xb7\x14\xfc\x48\x6a\xb6\xbb\xce" +
+		"\xfe\x96\xf8\x2b\xaf\xdd\x0c\xce\xbe\x15\x7c\x58\xf2\xbf\x50\x96\x7a\xff\x9d\xe0\xde\xdf\x83\xc3\xa5\x55\xba\xe9\xf0\x68\x77\x4d" +
+		"\xa9\x8c\xd4\x73\x02\x95\x9e\x54\xd9\x93\x86\x72\xd7\x21\x82\xbd\x3e\x49\x0b\x31\xfe\x04\x6e\x7f\x5c\x71\xc8\xcb\x52\x76\x32\xbb" +
+		"\xaf\x3e\xe9\x19\xa5\x75\xfa\xff\x31\x2d\xab\xf1\x82\x10\x26\x8e\x54\x04\x37\xb9\x1a\x7c\x56\x3b\x93\x3e\x7c\x2f\xd8\xed\x5d\xd9" +
+		"\x3c\xa9\xef\xbd\x9f\x56\x82\x9f\xcb\x1e\xec\xfc\x4c\xd6\x6f\x71\x05\x38\x63\x59\x23\x70\xfd\xfe\x1c\xf8\xfa\x1a\xb9\x06\xcf\x57" +
+		"\x83\xaf\xee\x14\x6f\x5f\x8a\x60\xa5\xc6\xbc\xfb\x07\x4e\x23\xbd\xd9\xf3\x0f\xc2\xc4\x35\xce\xef\xe9\xb0\x75\x46\xb5\xe3\xcd\xdf" +
+		"\x06\x2f\xb6\xda\xd6\x05\x79\xf0\xb1\x89\x75\xe0\xde\xc7\xb4\xf4\x0e\x13\xfc\x26\x38\xf3\x72\x70\xc8\x12\xef\x8f\xb9\x6e\xbc\xc2" +
+		"\xda\xb7\x6b\xc1\xd9\x6f\x10\x9c\xf2\x5a\x25\xf8\xbe\x48\x07\x13\xce\x04\x35\x79\xdd\x46\x56\x82\xcf\xef\x29\x07\x87\x5f\xeb\x02" +
+		"\xbb\x43\x7a\xff\x81\x81\xb5\xe0\xaa\xbf\x82\xcf\xfc\x2f\x38\xbd\x2f\xc1\x7a\x81\xad\x47\x25\x09\x7a\xb2\x17\x64\x9d\x2e\x00\x9f" +
+		"\x7b\x41\xb9\x04\xb5\xda\x91\x07\xb8\x4c\x1e\xcf\x43\xbb\xab\xc0\xd9\x52\x4f\xef\x66\xc0\x91\xcf\x08\x57\xfe\x58\xee\xd0\x60\x05" +
+		"\x11\x52\xbe\x1f\x6e\xa9\x04\x1f\x1e\xa5\xf3\x9d\x07\xae\x39\x17\xdc\x2d\x47\x7b\x50\x29\xd8\x4f\xbe\xef\xca\x71\x75\xe0\xd4\x09" +
+		"\x75\xe0\xb3\x32\x04\x7b\xdf\xaf\x06\xaf\x00\x9f\x13\x4a\xd9\xb7\x09\xb8\xf1\xcc\xe0\x49\x12\x9c\xad\xa5\xfd\xde\x36\xe1\x67\x12" +
+		"\x9d\xa3\xe3\x6b\x3c\x97\xf5\x7c\x0b\xb0\xc7\x60\x59\xc1\x65\x9a\xf0\x7d\x15\xe0\x64\xa9\x51\xa1\x36\x04\x5f\x98\x53\x07\xce\xab" +
+		"\xaf\xf1\x6c\xc8\x23\x5d\x52\x50\x72\x0a\xc1\xf7\xd6\x10\xec\xb3\x25\x01\x37\x7d\xa0\xbb\x79\x4a\x48\xd3\x5f\xc1\xbd\x42\x0e\x3e" +
+		"\xd4\xc2\x7c\xfd\xfd\x32\xf0\x59\x19\xf1\x79\xf2\x10\x66\x95\x81\x66\xc4\x9e\x90\xd7\x3f\x5f\x18\xc0\x26\x82\x93\x25\x8a\xeb\xe5" +
+		"\xa9\x1e\x9d\xa0\x71\x9b\xa1\x05\xfe\x6c\x25\x38\xff\x29\x29\xa2\xe1\xca\xbb\xbf\x53\x0e\x2e\x59\x20\xc0\x60\x46\x2d\xf8\x8c\x34" +
+		"\xdb\x33\x02\xe6\x0f\x1f\x94\xc1\x91\x42\x9f\xf7\x50\x0d\xb8\x4d\x81\xd1\x12\x45\xb7\xa3\x86\x29\x8a\x11\xbe\x79\x54\x87\xfe\xc2" +
+		"\x75\x57\xc9\x5c\xf7\x96\x1c\xac\x5e\x26\xb5\x3e\x46\xb7\x2e\xdd\xb7\xfc\x0e\xb0\x23\x78\x50\xce\xc8\xc0\x43\x8a\xe6\x7a\xe5\xc1" +
+		"\xe1\x62\x8c\x1c\x9e\x91\x78\xab\x84\x41\xf3\xe5\xe3\x2c\x25\x78\x40\xf2\x35\x66\x8e\x6c\xde\x71\x89\x99\x0e\xdd\x8f\xc9\x20\xbe" +
+		"\x54\x07\xee\x9f\x56\x07\x3e\x26\xb8\xf1\x0b\x85\xa6\xdf\x04\x97\x49\xaf\x3f\xba\x97\xe0\xa8\xdd\x72\x13\xf7\x54\x82\xf5\x0b\xab" +
+		"\xc1\x27\x65\x44\x16\x69\xb7\xf9\x69\x2f\x0b\xa8\xfc\x3e\xf8\x81\xb4\x5b\xff\x89\x04\xa7\xc9\xb2\x6d\x51\x92\x61\xd5\x28\x5d\x65" +
+		"\x68\x95\x77\xec\x5d\x24\xbf\x61\x80\xbc\xc2\x12\xf0\x56\xd7\x82\xdf\x00\x95\x22\xd2\xf7\x66\x2b\xa4\x1c\xde\x47\x0e\xdc\xe3\x35" +
+		"\x3e\xdc\x33\x35\x28\x1f\xa8\xe5\x5f\xbd\xc0\x86\x77\x1e\x92\x0f\xaf\x68\x70\x86\xde\x5b\x21\x86\xdf\xc3\xce\x7a\x7a\xb0\x2d\xf8" +
+		"\xc8\x3e\xa9\x8a\x9f\x81\x13\x9e\xa9\xf6\x0d\x97\x67\xd6\x82\x0f\x0d\xd1\xd0\x68\x24\xb7\x6a\x9a\x85\xda\x8c\x53\x0c\x3d\xae\xda" +
+		"\x7d\xd0\x31\x02\x4d\x57\x9a\xab\x34\x5a\xca\x5c\x20\xfc\xa1\x7d\x95\xee\xa4\xcf\xbe\x19\xfc\x4c\x5a\xea\xa0\x42\xf4\x99\x59\x0f" +
+		"\xdf\xf6\xbd\x52\x0e\x3e\x34\x5e\x36\xf3\x3e\xb0\x5e\xc9\x80\x81\xda\xda\xfb\xf7\xe0\x62\xb9\x92\x07\x1f\x91\x4a\x39\xe4\xc5\x4c" +
+		"\xf3\x15\x1a\x10\x1c\x7a\xbc\x02\xdc\xfe\x2b\x70\xff\x0f\xc1\x37\x95\x9e\x9c\xfc\x33\x70\x85\xcc\x05\xc1\xc5\x73\x2d\xe0\x10\x3e" +
+		"\xfe\x07\x70\x9e\x1c\x81\x4f\x74\x87\xfb\xb3\xe0\x4a\x25\x65\x25\xc3\x5f\xe8\xe2\xb3\x7e\x0e\xf6\x3e\xa8\x75\x20\x78\xe5\x7e\xc5" +
+		"\x28\x13\x04\x75\xac\xfc\x13\xf8\xa2\xb6\x23\xdd\xaf\x94\xf0\xf8\xa5\x19\xf0\x73\x69\xdf\x63\xcd\xc1\x1e\x19\xf0\x89\x1f\x82\x43" +
+		"\x25\x6e\xd3\x65\x86\x47\x7e\x5b\x29\xa9\x5a\xf0\xfe\xf5\xde\xdf\x7f\xf6\x60\x81\xde\x9d\xc0\x35\x13\x2a\x64\x35\x84\x04\x6c\x15" +
+		"\x96\x21\x87\x67\xdc\x0f\xc0\x69\x3f\x02\xd7\x8f\x17\x16\x2d\x6d\x3d\x78\xac\x56\x98\x80\xaf\x1d\x82\xcc\x05\x7f\xae\xf9\x81\x75" +
+		"\xbd\xd8\xa4\xe5\xf7\xec\x46\x39\xc7\xff\x00\x0f\x48\x50\x86\xc8\xaa\x6e\xda\x50\x03\x3e\xa8\xa0\xf8\x98\x82\xd4\x31\x32\xb2\x2f" +
+		"\x29\x34\x9d\xd6\x11\x94\xec\xaf\x26\xb8\x42\x26\xbe\x9e\xe0\xa7\x4b\x35\x21\x3a\x7c\x31\x4c\x2b\xe1\x2b\x60\x37\x9d\x65\xa6\x6c" +
+		"\xe5\x08\x89\xda\x16\xc5\xde\xa3\xb7\x55\x81\xdb\xe4\x09\xbc\xfc\x37\xf0\xe0\x3f\xc1\x39\xea\xdc\xfd\xf8\x22\x35\x8d\x92\xda\xf8" +
+		"\x58\x53\x2d\x2a\xf9\x3c\x69\x86\xa7\x35\xcd\xaf\xca\x97\x18\x26\x1c\x6c\xa2\x00\xd8\xc9\x4a\x84\x3c\x28\x23\xf1\xd2\x7a\x69\xf5" +
+		"\x57\x2a\xc1\xd1\xcd\xbc\x5c\x76\x8f\xdc\xc7\xee\x8f\x10\xdc\x7b\xa4\x02\xfc\xec\xc3\x0a\xb0\xd7\x36\x8d\xcf\x72\x85\x47\x8b\xb4" +
+		"\x08\x96\xc9\xd3\x15\xb2\xf2\xd1\x27\xc2\x2a\x96\xd7\xa5\x8b\xfc\x73\x61\xa9\x23\xe5\x4c\xfe\x2f\xd8\x4d\x62\xb1\xe0\xc3\x1a\xf0" +
+		"\x8b\x7e\x04\x0f\x4a\xc0\xd7\xc8\x65\xdd\xb4\x2f\x01\xdf\x78\x9f\xe0\x00\x21\x74\xaf\x2c\xce\x80\xef\x3e\x52\x01\xbe\x29\x74\x72" +
+		"\xf9\x74\x61\xde\x5d\xab\xc0\xc7\xae\x03\x57\xff\x10\x7c\xf4\x66\x73\x8c\x46\x29\x67\xf7\x66\x2f\x79\xaa\x32\x2c\xb3\x8e\xcb\x87" +
+		"\x5a\xa1\x40\x40\x09\xa0\x03\xcf\xd7\x81\xeb\x94\x4b\xd8\xff\x7a\x0d\xf8\xd6\x74\xb9\x38\x72\xee\x5e\xa5\x30\xb7\x1a\xf0\xcb\x3c" +
+		"\xb8\x66\x6e\x1a\xe7\xce\x5c\x48\xb0\xef\x30\xb9\x8c\x4a\xcb\x3e\x7b\x54\x51\xbd\x92\x72\xd7\x83\x6f\xb4\x01\x27\x75\x02\xc7\x7e" +
+		"\x0d\x5c\xbd\x95\xe0\xfb\x52\x3d\x3d\x6d\x17\xd6\x4f\xaa\xc1\xe9\x92\xdf\x77\x97\xa7\xe8\xce\xb6\x07\x08\x1e\x91\x7b\x34\xee\x61" +
+		"\xad\x20\x41\x15\x23\x5b\x83\xc3\x7f\x0d\x7e\xf0\x67\x70\xd8\x75\xe0\x83\x7b\x2b\x1d\xc5\xda\x3a\xbe\x52\xf3\x40\x70\xe4\x08\x89" +
+		"\x8c\x84\x7b\xf1\x72\x61\xb2\x72\x00\xa6\x0b\xf0\xba\x07\xec\xdd\x47\xa0\x85\xdc\x9a\xc1\x9a\xbc\x03\x12\xcc\x57\x14\x0a\xb6\x07" +
+		"\x27\x6b\x41\xdf\x09\x3e\x91\x01\xe5\x7a\xbf\xf6\x6a\x39\x08\xf0\xcb\x83\x19\xf0\xe8\x5b\x15\xe0\xe3\x72\xa6\xc6\x8e\xd6\x29\x24" +
+		"\x0c\xd2\xf8\x4f\x7f\xae\x80\x2b\x03\x6e\x95\x11\x7f\xfa\x53\xe7\xb9\x8f\x3f\xaa\x0e\xbb\x4f\x13\x7c\x42\x0e\x66\x77\xe9\xa6\x61" +
+		"\xfa\x6c\x97\xb2\x5a\xfd\xa4\x6a\xbb\xf7\x52\x64\xa2\xec\xca\x10\x85\x1d\x1f\x49\x6f\xe8\xee\x57\xe8\x96\xde\xda\x12\x72\x79\x74" +
+		"\xb3\x44\x49\xa6\xf8\xa9\x3c\xb8\x5e\x0e\xf8\x11\x19\x8c\x01\xc2\x90\xb7\xec\xac\x05\xf7\x7f\x2a\x44\xec\x35\x11\x14\x46\x59\x42" +
+		"\x40\x0e\xf4\x6e\x82\x5b\xb4\x30\x06\xeb\x4c\x4f\x2b\x16\x7e\x78\x59\x09\xf8\xc8\xcb\x62\xf1\x09\x13\x7a\x50\x42\x73\x68\xb6\xe6" +
+		"\x6a\x62\x63\xf0\x93\x23\x8d\xc1\xad\x4a\x48\x0b\x19\x5a\xbf\xae\x12\x9c\x77\x44\xde\x8b\x10\xcb\xf9\x03\xe4\xe4\x2b\x3d\xb1\xe7" +
+		"\x52\xf0\xed\xd3\xc1\xb7\x4f\x03\x97\xa8\x15\x4e\x0e\xdc\x7f\x39\xd8\xe3\x0c\x50\x60\x41\xbf\x1f\x83\x7b\x3a\x20\xd9\x3f\x52\xb7" +
+		"\xdd\x33\x01\x27\x4f\x95\x51\x13\xd4\x34\x5b\x7a\x6b\x6f\x09\xf8\xc6\x9f\xc1\x75\x8a\x02\xa6\xdf\x0b\xbe\xd9\x01\xdc\xf5\x75\xb0" +
+		"\xfe\xb9\x04\x3c\xdc\x08\x3c\x36\x29\x01\xe7\xdc\x06\x7e\xdc\x12\xfc\x50\x7d\xb6\x34\x8e\xfb\x7a\x4a\x20\x34\x40\xef\x5e\x0f\xbe" +
+		"\x7b\x25\xf8\x4e\x0e\x14\xea\x78\xf4\x5c\x70\xfd\x69\xe0\xb4\x6f\x82\x53\x35\x50\x8f\xdc\x06\xf6\x9e\x44\xcf\xab\x8e\xed\x08\x8e" +
+		"\xfc\x0b\x38\xbe\x95\x2c\x9a\x46\xfb\xdb\xe0\x18\xb9\xd7\x9b\x94\xfc\xfe\x44\x00\xac\x42\x19\xed\x15\xd7\xce\xa9\x67\x7b\x4b\x95" +
+		"\xab\x23\x38\xa9\xa5\xc7\x62\x87\x24\x90\x9f\xed\x2a\x07\x8f\x8e\x2d\x03\x3f\xd7\x01\xe0\xa0\x87\x4b\xc0\x8f\xf7\x57\x78\x43\xce" +
+		"\x13\x53\x6b\xc0\x5d\xdf\x03\x67\xdc\x05\x2e\xd2\xce\x55\x8f\xbc\x5f\xe5\xa9\xdc\xf9\x5f\x6a\x2d\x2a\x6a\x1e\xdc\x19\xfc\x54\xca" +
+		"\xfd\xe3\xb7\x2a\xc1\x13\x8a\xed\xb6\xbd\x50\x09\x0e\x12\xfe\xf5\xb6\x72\x8c\xdd\x14\x69\x2c\x7a\x58\x3a\xe5\x05\x39\x1c\xb7\x80" +
+		"\x6f\xf6\x6e\x04\xce\x13\xfa\xbf\x44\xd9\xba\xcd\xca\xf5\x3d\x35\x51\x98\xee\x74\xd1\xb9\x76\x29\x9d\xd1\x08\x7c\x45\x24\x84\x47" +
+		"\xa5\x79\xe6\xff\x1d\x9c\xa2\xb5\x3e\xeb\x4c\x70\xcd\xdb\xd5\x60\xfd\x5a\x61\x5d\xef\x6a\x3f\xfb\x9e\x82\x2b\x67\xd7\x82\xcb\x7b" +
+		"\xd5\x80\x2f\x28\x62\x5c\xf3\x3b\x70\xb4\xec\xf6\x13\x6f\x95\x82\xf7\x6f\x95\x81\x78\xb0\x2e\xc5\xd9\x9f\x39\x5c\x02\x4e\xd8\x5f" +
+		"\x0e\x7e\x26\x62\x50\x2f\x01\x7b\x1f\xcb\x81\x7e\x78\x06\xc1\x19\x8a\x77\x3e\x79\x5e\x5d\x8b\xb6\x0b\xf1\x9a\x51\x21\xd3\x24\x6f" +
+		"\xad\x33\xf8\x9e\xd0\xf9\x69\x1f\x55\x81\x7b\xc4\x4a\x3a\xa2\x44\xc3\x63\xeb\xab\x3d\x39\xb4\xec\x68\x63\x37\xb7\x4b\xde\x50\x12" +
+		"\x51\x4a\x75\xb0\x00\xcd\x97\x95\x0a\x7c\xf8\xe9\x80\x89\x1e\x7a\xb8\x1a\x9c\x2a\x0f\x71\x9c\x7a\x2e\x7c\x3a\x28\x0b\x1e\x5f\x53" +
+		"\x03\xbe\xa7\x2c\xdd\xb8\xf1\x9a\x02\x55\xe3\xcd\xfb\x37\xf8\xb0\xf6\x0c\x19\x2c\xbf\xa8\xff\xc0\x8c\xb2\x7b\x15\xe0\xc4\x3f\x82" +
+		"\x1b\x34\x11\xd3\x57\x97\x82\xeb\x95\x26\x5e\x3b\x93\x1e\x74\xbc\x37\x87\xe0\x8b\x3d\xa9\xa4\x87\x62\xd7\x31\xca\xe2\xac\x93\xc5" +
+		"\x7d\x59\x69\xa6\x99\x15\xe0\x5c\xf5\x16\x9c\x28\xf8\xe1\x44\x9f\x4a\xf0\xa9\xe1\x15\xce\x2b\xd9\xda\x55\xda\x46\x76\xea\x81\x17" +
+		"\x15\x3d\x2a\x02\xdf\xae\x27\x7d\x64\x31\xc1\xcf\x3e\x97\x67\xf8\x78\x1e\x04\x78\x6c\x77\x0e\xdc\x27\xc9\xdd\x27\x7c\x5c\xb8\xd2" +
+		"\x6b\xaf\x68\x92\xe5\xa9\xac\x5d\x2d\xe7\xef\x80\x56\xbb\x42\x9d\x83\x63\xa5\xd8\xa7\x2a\x23\x2e\x6d\x7c\xf0\x7a\xb0\xfb\x4c\x29" +
+		"\x77\xed\x76\xff\xd0\x9b\x5a\x6c\xfd\x6b\xc1\x23\x3f\x03\xb7\xc8\x1d\xfe\x48\xce\x50\xef\x02\x38\xf0\x54\xf9\x72\xb5\xe0\xf4\x6d" +
+		"\x4a\xf3\xcb\x12\xed\x51\x24\xbc\x54\x89\xc9\xfe\x8a\x5a\x07\x4e\x22\xb8\x5c\xeb\x7d\x8c\xcc\xee\x27\x3f\x02\xfb\xbf\x93\x80\xdd" +
+		"\xff\x0f\x7c\x5b\x01\xeb\x5a\xa5\x1f\x5e\x5a\x5d\x0b\x8e\x90\xc1\x59\x26\x37\xef\x65\x25\x20\x67\x29\xec\x78\xa4\xbf\x00\x29\x39" +
+		"\xcd\x77\xa5\xe8\xf6\xa2\x33\x94\x23\x17\x6a\x5a\xaf\xb5\x26\x17\x7d\xa2\x7c\x8c\xcd\x37\x81\x3d\x37\xe6\xc0\x5d\xc2\xcc\x86\xfd" +
+		"\x0a\x5c\xa0\x94\xd2\x29\xe0\x94\xbb\x04\x46\xe6\xc0\x6d\xcf\xc8\x45\xf8\xa2\x16\x9c\xf8\x98\xd2\x09\x8a\x17\x3f\xfe\x6a\x9a\x74" +
+		"\x5b\x79\x05\xb8\x44\xf0\xb6\x0c\xf4\xc1\x7e\x75\xe0\x02\x59\xd7\x9e\x02\xeb\x96\x77\xad\x00\xdf\x68\x06\x3e\x73\xa0\x16\x1c\x2a" +
+		"\x99\x9d\x37\x44\xae\x83\xee\x6c\xe4\x7b\x82\xd6\x96\x6a\x3a\xdb\x83\x73\xe4\xeb\x3c\x2e\xe8\xa7\xc7\x15\xe0\x33\x25\xe0\xf1\x67" +
+		"\xea\xc0\x97\xe6\xd7\x81\x4b\x3b\x81\xe2\x5d\x8c\x91\x33\x22\xac\x6b\x88\x9e\xee\x49\x29\xb0\x17\x5a\x80\xfd\xfe\x08\x3e\xf4\x15" +
+		"\x70\xe6\x0f\xc0\xc1\x43\x09\x7e\xa4\xef\xac\xd5\xb2\xf8\x3d\xf8\xcc\x71\x82\xf5\xb6\x09\x53\x1e\x7c\x5a\x1a\x7c\x98\x55\xd2\x94" +
+		"\x83\x2f\x09\x12\x14\xdc\xbc\xff\x61\x82\xaf\xf4\x95\xea\x2b\x01\xfb\xc9\xdd\x7d\x42\xce\xc5\xbe\xae\x04\x4f\x05\xbb\x5e\x07\x6e" +
+		"\xfd\x58\x1a\x63\x4a\x1d\xd8\x4f\xa7\x7c\xf0\x9f\xe0\x6f\x1c\x6e\x9b\xa6\x38\x48\x6e\xc8\x04\x45\xf6\xd3\x15\x28\x29\x63\xbf\xee" +
+		"\x7f\xc1\x2f\xff\x02\x7e\x24\xc3\xf4\x94\x24\x6a\x61\x1f\xa5\x3d\x5e\x2b\x01\x9f\xd7\xb7\x46\xc9\x3d\x7a\x41\xe9\xb0\x83\x9b\x15" +
+		"\xe2\xff\x06\xec\xfa\x2f\xf0\x13\x81\x6a\x3d\xd2\x72\xdc\x67\x2f\x03\xa7\xbe\xa3\x9c\xb5\x84\xbc\x9b\x48\x00\x2f\xc8\xb7\xfc\xf4" +
+		"\x4f\xe0\x00\xdd\xe2\x97\x1f\x57\x3a\x55\x6f\xb4\x37\x99\x1c\xdb\x83\xae\xd7\x67\x49\x8c\x5e\x10\xa6\xdf\xe7\x0c\x70\xc4\x49\xe0" +
+		"\xc1\xf7\xe5\xd5\x6c\xcc\x80\x4f\xb5\xf6\x0e\x80\xef\xeb\x49\x26\xcf\xd0\x2f\xae\x01\x9f\x9c\x42\x70\xf7\x1e\x3a\x9f\x76\xab\x72" +
+		"\x3e\xcf\xc8\xcb\x7f\xde\x24\x46\xdd\xc6\xbe\x38\xa6\x9c\x97\xd6\xd1\xb1\x8d\xb2\x31\x7f\x00\x37\x68\x56\x67\xac\x95\x83\xfa\xa9" +
+		"\x18\xac\x6b\xcb\xc1\x8f\x9f\xaa\x00\x67\x69\x84\x97\x88\x2e\xb0\xf6\x7c\xb0\xfb\xdf\xc1\x17\x9e\x54\xf2\x5b\x3f\x7c\xf4\xe7\xe0" +
+		"\xa3\xf7\x81\x47\x84\x2b\xad\xe9\x5f\x05\xbe\x3e\x5d\xd3\xb0\x2d\x0b\x6e\x91\x82\x99\xbf\x5d\x7f\xd6\xd7\x82\x0b\x16\x2a\xa5\x25" +
+		"\x17\x6b\xd4\x80\x0c\xf8\xfa\xf2\x1c\xd8\x53\x7e\xd6\x03\xfb\xe4\x2d\x4a\x13\x3c\xa8\xec\xed\xbe\x5f\x80\x9f\x6a\x2c\x67\x6b\x99" +
+		"\x7c\x76\x48\xe9\x9a\x6f\x83\x9f\xb4\x05\x37\x5d\x09\x2e\x95\x89\x1f\x2e\x1d\xf1\xe4\xef\xc0\xbe\xd2\xe7\x9f\xad\xab\x00\x77\xca" +
+		"\x0f\x7b\x78\x58\x2d\xf8\xd6\x8f\xc1\xa3\x77\x82\x23\xe5\x8a\x4c\x3c\x42\x70\xee\xcf\xc0\xb9\xd3\x6b\xc1\xf7\x44\x30\x59\xb4\x50" +
+		"\xa1\x87\x20\xb9\x07\x46\xe5\xc1\xd9\x63\x08\x4e\x91\x0d\xf8\x62\x7d\x19\xf8\xdc\x46\x91\x9b\x47\xd6\x81\x4f\xcb\xff\x7a\xf8\x4f" +
+		"\xe0\xa8\x67\xf3\xe0\xfd\x7d\x32\xe0\x1b\xdd\x35\xf9\x7f\x07\x5f\x52\xfa\x71\xb7\x02\xb5\x0e\xe0\xd1\x57\xb5\x82\x17\x0a\x90\x57" +
+		"\xc4\x7e\xf8\xf1\x0a\x67\xb0\x2e\xf9\xb2\x04\x1c\xbe\x2f\x0b\x8e\x16\x2c\x70\x7f\x4f\x65\xdc\xb5\xd4\x46\x48\x9d\x3d\xf9\x45\x39" +
+		"\xb8\xeb\x12\x70\xbd\x76\xc6\xff\x37\xb8\x45\xb7\x3a\xe6\xef\xe0\xa7\xf7\xba\xdf\x3a\x58\x0e\xe5\xfb\xff\x00\xa7\xfd\x13\x7c\xe7" +
+		"\x6f\xe0\x89\x87\xb3\xe0\xa7\xfd\xd2\xfd\x97\x47\xfe\x0b\x7c\xa1\x2b\xc1\x61\xff\x00\x77\x2a\x69\xf7\x84\x14\xb1\x06\xe3\x4d\xc5" +
+		"\x46\xa3\x5a\x80\x0f\x9d\x0d\x4e\x9b\x25\xe7\x74\x56\xe2\x35\xb3\x5b\x09\xd6\xd7\xb9\xe4\xcc\x3b\x05\x9c\x56\x0b\xee\xdd\xa1\xc7" +
+		"\x6a\x06\x3e\x71\x29\xf8\x81\xe4\x66\xc8\xc3\x89\xd7\xdb\x3c\xa4\x38\x54\xe0\x62\xaf\x4b\xc0\x23\x6d\xc1\xa7\xbf\x03\x6e\x96\x0e" +
+		"\xdc\x9f\x01\x1f\x16\xc3\xa3\xef\xf8\x04\xbc\x5f\xeb\xf1\x45\x8d\xb3\x9e\x7c\xb9\xb4\xe8\x33\x87\xcb\xc1\x37\x9f\x50\xd8\xf7\x51" +
+		"\x16\x7c\xff\x80\x82\xbd\xc6\xe0\x92\xc6\x69\xca\xf8\x75\x1d\xfa\x7e\x2a\x94\x49\x02\x32\xe4\x24\xf0\xc0\x6d\xe0\x51\xc5\x96\xe3" +
+		"\xfe\x00\xee\xfa\x26\x38\xf8\x5a\x70\x5e\x47\x70\xab\xb2\x5b\xbd\x67\x2a\x62\x93\xdc\xf7\x93\x9f\xfa\x81\x72\xb6\xab\x25\x0f\xcf" +
+		"\x8d\x91\x4b\x5f\xa7\xe4\x7a\x06\x7c\x51\xac\xf6\xbd\x17\x82\x47\x55\xd8\x02\xf0\xe5\xe9\x39\xb0\xfe\x6c\xf0\xcd\x2b\xc0\x85\xff" +
+		"\xa3\x20\x44\x61\x92\xe2\xf7\x85\x4d\xc0\x79\x13\x73\xe0\x82\x97\x72\xe0\x0a\x3d\xfb\x83\x9b\x12\xf0\xc4\xb8\x3c\xf8\xc8\xf8\x3c" +
+		"\xd8\x73\x73\x0e\x5c\xa4\x05\xbf\x7a\x28\xc1\x55\x97\x80\x96\x72\xaa\xf4\x7c\x4f\xdf\x12\x70\xa3\x6f\x91\xf0\xbe\x94\xcf\x46\xc5" +
+		"\x46\xfd\x7b\x65\xc1\xd9\x7f\x00\x5f\xed\x00\x8e\x10\x68\xfc\xc6\xfb\x3a\xc1\x5c\x85\x15\xef\xd7\x80\x33\xae\x05\xf7\x6b\x4a\x3e" +
+		"\xea\xaa\xa5\xa4\x49\xbc\x1a\x7c\xe9\x1a\xf0\x0b\xc5\xde\x87\x0d\xaf\x5d\x93\xa6\xf6\x5f\xbf\x19\x94\x17\xa3\xf5\xf4\xee\xd9\xe0" +
+		"\xd1\xff\x01\x5f\xfd\x36\x38\x67\x8c\xd6\x86\xc0\xa1\x01\xbf\x05\x9f\xb9\x01\xdc\xf4\xa2\x79\x3c\xa2\x67\xdc\x06\xbe\xfd\x4b\x77" +
+		"\xac\x8e\xfc\x08\x5c\xf3\x13\x70\x53\x01\x3c\xf4\x63\x70\x94\x80\xae\xc7\x84\x60\xee\x7a\x9c\xe0\x32\x19\xfd\x95\xfd\x35\xa5\xb2" +
+		"\x0f\xf5\x5f\x2a\x29\xa5\xf0\x6f\xa5\x6c\xde\xac\xdd\x52\xfd\xef\x96\x82\x1f\x4f\xd5\x23\x68\xfa\x07\x12\x54\x0e\xf1\xb9\x53\xc1" +
+		"\x79\xed\xc0\x17\x6f\x16\xb8\x5e\x07\x7e\x21\x46\xdb\x43\xcb\x32\xe0\xa3\xbf\x00\x77\x1b\xa5\x52\x7e\xcf\x63\x72\xed\x85\xdb\xad" +
+		"\xd1\xf3\x0c\x16\xde\x72\xf0\xbf\xc1\x2d\x12\xf0\x67\xbf\x03\x8e\x51\xac\xf0\x82\x96\xfa\xa8\x17\xb3\xe0\xbb\xef\x66\xc1\x51\x9d" +
+		"\x7d\x5b\xde\xf5\x04\x7b\x7d\x96\x05\x5f\x1b\x92\x07\xfb\x8e\x94\xcd\xba\x04\x3c\x74\x15\x38\x5b\x0c\x91\xc5\x04\xfb\xe8\xdd\x9e" +
+		"\x4b\x2d\xf1\x50\xad\x80\xb4\x02\xec\x7d\x58\xa4\xd7\x51\x61\x6a\xfa\x6a\xb2\x7a\x6e\x2f\x07\x9f\x13\x5e\xd8\x6d\x46\x0e\x7c\x4b" +
+		"\xa4\x9a\xd5\x3a\xbc\xb1\x30\x03\x4e\x16\xcf\xe7\x4b\xc1\x95\x52\x04\x07\xbd\x98\x58\xc4\xa9\xfe\xed\xc1\x53\xc0\xf9\xb7\x81\x9f" +
+		"\x9c\x04\x0e\xff\x2d\x58\xff\x73\x70\xb1\xfc\xac\x35\x76\x38\x13\x1c\x7b\x0e\xf8\xca\xa9\xe0\xd2\x6f\x80\x3d\xe5\x88\x1c\x50\x8e" +
+		"\xa2\xfe\x1b\xe0\x8d\xe0\x43\xcd\xc1\x5e\xcd\xc0\xa3\x65\xe0\xe2\x52\xf0\x6d\x01\x5f\xb7\x82\x1f\xa8\x7e\xe4\x6a\xf0\xad\xdf\x82" +
+		"\x3b\x35\x5c\xcb\x1e\xd0\x26\x48\x5a\xcc\xe3\x75\x5f\x0f\x2e\x51\x59\xda\x2f\xc1\x51\xb2\xf3\x6f\x0b\x2a\x7e\x6c\x23\xc1\x69\xcb" +
+		"\x95\x46\xbb\xd6\x57\xe1\xa3\x5a\x0c\xeb\xfa\x6a\xb5\x9e\x10\x55\x60\x86\xd0\x86\xd9\xf2\x96\x76\x34\x02\x0f\x6c\x6e\x0c\xae\xf9" +
+		"\x0e\x38\xe8\xc3\x4a\xf0\x9d\x25\xe5\x60\x2f\x69\xf4\x65\xca\xcf\x1d\x10\x41\x6d\x62\x1f\x25\x32\xe7\x2b\x2b\xdd\x4b\xc6\xe0\x77" +
+		"\xe0\xc1\x37\x6a\xc0\xf9\xc6\x80\x92\x87\x38\x49\x2e\xdd\xfa\x77\x2b\xc0\xa9\x1f\x86\xec\x7c\x7c\x2d\xb8\xa4\x16\x1c\x2f\x0c\x76" +
+		"\x9f\xe4\xe5\x91\x11\x4a\x04\x8c\x51\xec\x2b\xcc\xe1\x45\xf9\x9d\xdd\x05\x8d\x2f\x51\x44\x35\xef\x90\x18\x06\x17\x82\x0f\x4a\x9d" +
+		"\x6c\xbd\x0d\x7c\x79\x88\xf2\xc2\x83\x4b\xc1\x6e\xfd\x4a\xc0\x17\xfb\x2b\xe1\xa9\xc3\x1f\xc1\x77\x56\x6a\x45\xaf\xa9\x00\xef\x17" +
+		"\x56\x79\xbd\x6b\xe2\xf1\x7f\x02\x67\x4b\xa3\xac\xec\x9e\x01\xc7\x2a\x85\x31\x62\x48\x15\x38\x53\xaa\xfe\x4d\xf1\xda\xc7\x6b\x0e" +
+		"\x1f\xd1\x61\x82\x8c\xff\xd1\xdb\xc1\x89\x7f\x05\x87\xf4\xd6\x3a\xfa\x17\xb8\x74\x8a\x74\xea\x3d\xe0\xfd\xa2\x43\x1c\x7e\xde\x40" +
+		"\x01\x8d\xc6\xbb\x04\xdf\x7c\xce\x49\x07\x47\x26\x0a\x08\xd1\xc1\x79\xd6\xbb\x6e\x07\xf7\xdd\x0e\x8e\x95\xd7\xfe\x81\x0e\x83\x67" +
+		"\xe6\x40\x28\x6b\xad\xb2\x8b\x45\x0a\x38\x7f\x0d\x6e\x92\xf3\xbf\x4d\xc4\xb4\xf7\x2f\x04\x2f\x02\xcf\x00\x0f\xd2\x7b\x7c\xfe\xcd" +
+		"\xe4\xa0\x39\x38\x4a\x20\xec\x86\x8e\xe0\xeb\x5a\xb9\x1b\x96\x11\xec\x3b\x58\x92\xa2\xe5\xf5\xe8\x4b\x4a\x0c\xae\xad\x75\x9a\xd6" +
+		"\xf2\x79\x4a\xf7\xae\x23\x38\x79\x83\x26\x4b\x88\xf8\xe0\x17\x73\xe0\xd2\xe7\x4b\x94\x90\x15\x70\x27\x9c\xf2\x51\x51\x9f\x0e\x9d" +
+		"\xe5\xb8\xc2\xab\x3d\x75\xc5\x66\xe0\x0c\x81\xf3\x8f\x0e\xae\x04\x3f\x9d\x4a\x70\xc8\xe5\xde\x33\x48\xde\xea\x7a\x1d\x76\x0e\x6a" +
+		"\x02\x4e\xef\x53\x70\xb4\x78\xbd\xa0\x91\x67\xb4\xb2\x07\x6c\xac\x05\x3f\x7d\xd7\xab\x41\xf3\xe0\xc1\x7f\xa7\x8e\xda\x0b\x22\x44" +
+		"\xf4\x5c\xa9\x08\xf3\xdf\xde\x16\xee\x1e\xf0\xcb\xff\x05\x77\x77\x02\x85\x17\xbf\x7b\x2f\xb8\x58\x2c\xb1\xf7\x36\x55\xa6\x88\x7b" +
+		"\xff\x6f\x80\xeb\x77\x18\xbf\xa2\x12\x5c\x24\x29\x5e\xf6\x47\x70\xf8\xef\xc0\x75\xd7\x81\xbd\x5a\x83\xef\xfe\x4c\xc3\xaf\x40\x49" +
+		"\xdb\x2e\x4d\x54\xae\x70\xb8\x93\xdd\x8e\x9d\x0f\x2e\x7f\x45\xc3\xd9\x0e\x5c\xa9\xf0\x7b\xca\x1c\x25\x6d\x44\x39\x5b\xd4\x57\xee" +
+		"\x8f\x50\xff\x17\xdb\x83\xdd\x4f\x02\x57\xfc\x1b\x7c\xea\x6f\x26\x2b\xe3\x9f\xcd\x81\x53\xb7\x89\xcd\xfd\x04\xc1\xb7\x14\xe0\x2c" +
+		"\x94\x7f\xf3\x80\x42\x8a\x6e\x82\x2c\x3e\x97\xe4\xd7\x7f\x15\x3c\xaa\x8c\xc3\x1b\x86\x29\x2b\x5d\xf6\xfe\x58\xaf\xaa\x99\xdf\x87" +
+		"\xe0\x97\x0f\x12\xac\xd7\x32\x18\xd8\x4f\x3e\x7b\x45\xb4\xed\x3e\xb8\xb2\x16\xec\xb1\x46\x00\x8d\x50\x84\x81\x42\x43\xe6\x28\x54" +
+		"\xfc\x48\x81\x85\x82\xb3\x81\x3f\x07\x9f\xea\x46\x70\x98\xa2\x8f\xe7\x57\xd5\x80\x9b\xf7\xcb\x2a\xea\x30\x5a\xa4\xa4\x91\xb3\x95" +
+		"\x18\x57\xde\x7d\xe4\x5b\x42\xbe\x75\x10\x3e\x38\x50\x66\xe4\x84\x0e\xaa\x18\x11\x47\xe2\x7e\x1d\x7a\x09\xba\xaa\x03\x87\x8d\xaf" +
+		"\x03\x9f\x57\x52\x7f\x89\xa8\xe0\xdb\x8e\x28\xeb\xad\x6f\xf7\xbb\x0a\x5c\x20\x51\xac\xdf\x44\x70\xe9\x93\x95\xe0\x8b\xba\xd8\x94" +
+		"\xc7\x72\xe0\x04\x95\x46\x3c\xae\x70\xe6\xb3\x7a\x82\x7d\x8c\x0b\x71\x3c\xf1\x02\xc8\xe1\x0a\x9b\x7b\x68\x41\xcc\xd7\x41\x42\xb0" +
+		"\x53\xce\xd7\x2e\xf1\x8d\xf6\x4d\xd0\x5d\xe7\xc0\xe9\x4a\x25\x0c\x5d\x59\x03\xbe\xa8\xc0\x5c\xda\xea\x73\x5d\xb7\xa7\x74\xc5\x3b" +
+		"\x0a\xce\x77\x29\x56\x18\xd1\x8f\xe0\xc8\x5f\x80\x4f\xdf\x27\x2c\x45\x59\x74\x19\xaf\x1e\xe7\x82\xaf\x28\x1f\xd5\x57\x96\x7c\x97" +
+		"\x66\xf9\x83\xb3\xc0\x65\x1d\xc0\x31\x9d\xc1\xc7\x3a\x83\x63\x8e\x0a\x80\x12\xb6\x32\xa5\x3d\xb8\x7c\x47\x25\xf8\xde\x50\x0d\xac" +
+		"\xb0\x9e\xc9\x27\xb4\x40\x57\x24\xe0\xb6\xb1\x95\xce\x2d\x79\xfc\x32\x70\xf7\xe5\xe0\x09\x05\xbe\x5b\x74\x38\xfc\x23\x8f\xb0\x46" +
+		"\x8b\x91\xd2\x53\xf6\xf5\x95\x97\x75\x73\xdf\x02\x57\xdc\x0c\x2e\x26\x58\xe1\x65\x19\x1b\xef\xaf\x02\xff\x01\xbe\xd9\xdd\x2b\x23" +
+		"\xef\x06\x67\xf7\x10\x18\xf0\x60\x95\xef\x27\xb7\x74\xb0\xa1\x48\x09\x38\x5e\xe8\xdb\xc7\xa3\xe9\x9b\xf6\xbc\x73\x4c\xbc\x63\x3d" +
+		"\x91\x62\xc0\x75\xd2\x2b\x33\xce\x05\x77\xac\xc9\x82\xc3\xff\x0e\x0e\xeb\x57\x0e\xae\x94\x61\x5b\xbf\x5d\x0f\xd0\x08\xec\xbb\x20" +
+		"\x07\x3e\xfb\x42\x0e\x1c\x24\x0f\xf9\x2d\xcd\xe6\xa6\xbe\x42\xa1\x05\xaf\x0d\x1c\xe2\xac\x82\x97\x64\x8b\x67\xe8\xd0\x5d\x7b\x46" +
+		"\x49\x33\x0d\x5f\xad\x99\x50\x00\x3c\xf6\xdd\x02\xb8\xe7\x70\x13\x70\x86\x80\xa5\x2f\xe5\xf6\x8e\x10\xdd\xf8\x63\x29\xd3\x79\x9a" +
+		"\x43\x41\x19\x43\x66\x0b\x31\xd1\x23\x8f\x78\x8b\xe0\x5e\xc5\x8b\x4f\x88\xb5\xb0\xa5\x00\x4e\xbc\x9f\xe0\x56\x4d\xc8\x1b\x82\x6b" +
+		"\x46\xc8\x0d\x7e\x72\x82\xb0\xb4\x55\xe2\x5e\xbf\x5e\x0e\x0e\x19\xa9\x24\xe7\x38\x69\xf5\x16\xe0\xeb\x53\x09\x2e\xae\x05\x87\x0b" +
+		"\x3e\xec\x61\xf8\x76\x15\xd8\x43\x04\x91\x2d\x7b\x0d\xeb\x27\x38\xe7\x8b\xac\x82\x33\x15\x69\x8c\xf6\xb6\x4d\xbd\x5e\x67\xea\x6a" +
+		"\xf7\x90\x90\x9c\xe8\x04\xf6\x6a\x05\x5e\x0b\x2e\x7e\xaf\x31\x38\x6e\x5c\x16\x7c\x4a\xf0\xf5\xe3\x57\x81\xf3\xe5\x48\x3d\xf1\x48" +
+		"\x25\x38\x53\x70\xad\x50\xe9\xe7\x7f\x08\x3e\x26\x20\x73\xb0\xee\xa4\xeb\xc5\xe0\xe3\x9b\x6a\xc1\x97\x35\x05\x23\xc4\x2f\xfd\x22" +
+		"\xd1\x5f\x55\xde\xc8\x5b\xb9\xc2\x67\xf2\xe0\xb0\xc3\x52\x60\x62\x52\xac\x15\x6f\xe5\x25\x1d\xf6\xcb\x60\xbe\x36\x41\x15\x67\xb2" +
+		"\x52\xfd\x45\xe1\x9a\x37\x9e\xd6\x70\xbe\x5e\xa1\x9d\x54\xdd\x36\xe5\x56\xd7\xc9\x8d\x3c\x30\xa3\x14\x9c\xbb\xb1\x0c\xfc\x6c\xb1" +
+		"\xf7\xbe\xa8\x9f\x23\x0f\x65\xa7\xa4\x60\x1c\xc1\x05\x3a\xec\x95\xec\x0d\x3a\x15\x1c\xb3\xd7\x2b\x96\x5f\x16\x99\xfc\x6c\xb0\x15" +
+		"\x38\x5b\x8c\xda\xfe\xbb\x54\xbb\x23\x40\x7d\xa0\xa0\xbc\xa9\xbf\x06\x0f\xc8\x44\x8f\x16\xf7\xf9\xd8\xfa\x52\xb0\xff\xc7\xb2\xed" +
+		"\xcd\xc1\x79\x2b\x24\x10\xd3\xab\x90\xcc\x91\x06\xfa\x70\x52\x15\x92\xb9\x22\x6f\xbc\xa8\xc3\x6a\xb1\xc2\x86\xeb\x70\x62\xa2\x2c" +
+		"\x8f\x0e\x73\x96\xab\x4a\x66\x73\x29\xd8\xf3\xad\x04\x7c\x47\x2b\x62\x48\x0f\xa3\x80\x8b\xce\x20\x16\xd6\x33\xaf\x54\x88\x80\x46" +
+		"\x70\xaa\xe2\x91\x3e\x2d\xc1\x37\xc5\x6b\x19\x20\xf3\x34\xa5\x7b\x1e\xfc\x70\x81\x17\xf6\xaf\x55\x68\x39\x77\x76\x22\x0f\xba\x16" +
+		"\x7c\xf7\x21\x82\x07\xfb\x12\xdc\xd8\x1c\x5c\xa7\xc9\xfb\x54\xa3\xb5\x54\xf7\x76\x68\x87\xa2\x59\x71\x35\xc7\x88\x95\x32\x5c\xa3" +
+		"\x7a\x07\x38\x5e\xae\xea\x3b\x3d\x6a\xc1\xf7\x13\x70\xb0\x02\x9d\xc7\xc4\xd0\x10\xe6\x2f\xbb\x77\x58\x8c\x8c\xcf\x44\xdb\x5c\x37" +
+		"\x99\xa0\x2a\x32\x56\xe9\x27\xc3\x9f\x50\x9b\xc8\xa1\x69\x5d\xe0\x47\x8a\x1a\x57\x8c\x92\x26\xbe\x5f\xd3\x28\x01\x5d\xf5\x73\x70" +
+		"\xae\x48\x1f\xef\xdd\x01\x2e\x51\x58\x7e\x5c\x00\xf2\x1b\x7a\xeb\xed\x59\x04\x8f\x4f\x22\x38\xa9\x02\x1c\x3f\xbc\x16\x7c\x62\x6d" +
+		"\x1d\x38\xca\x80\x55\x99\xb2\xde\x9a\xd5\x49\xa7\x81\xff\x02\xa7\x48\xdd\x3e\xbf\xbd\x32\x25\xd4\xbc\xbc\xa7\x14\x9c\x26\xd7\xeb" +
+		"\x79\x39\x7b\xf3\xfe\x1b\x3c\xa8\x1a\x8e\x03\x32\x35\xd3\x94\x09\x78\x55\x87\x69\x04\xc7\x9b\xc1\x92\x42\x9c\x2b\x59\xff\x48\x36" +
+		"\x64\xe8\x77\xc1\xf5\x77\x82\x3d\x65\x7c\x3e\xda\x56\x9d\xf2\x3f\x96\x8b\x7c\xeb\x85\xd7\x1f\x9d\x03\x2e\x12\xc2\xd2\x57\xad\x0c" +
+		"\x5e\xd3\x23\x28\x9f\xb1\x57\x30\xe2\x5b\x17\x82\x0f\x0c\xae\x74\x29\xdf\x28\xd2\xe4\x91\xb4\x00\xf9\xd9\xfb\x9d\xec\x30\x37\xeb" +
+		"\xfb\x15\x1d\xd6\xe4\x7c\x72\x32\xb8\x41\x83\xf3\x85\x50\xb2\x6e\x32\x31\xeb\x55\xf8\xbb\x57\x8e\x07\xc0\x27\x24\x49\x3b\x14\x90" +
+		"\x2c\x9f\x9e\x01\x5f\xd8\x23\x45\x2d\x7a\xcf\x26\x89\xfe\x1e\x39\x47\x9f\x9f\x09\x1e\xbb\x19\x3c\xa4\xa4\xcb\x33\xd2\x7b\x6b\xb6" +
+		"\x11\x1c\xb5\x95\xe0\x58\xd1\x21\xf6\x88\x4a\x31\x4b\x2d\x37\x97\x4d\x0a\x6b\x69\x41\xe3\x3b\x83\x08\x9e\x03\x0e\x5f\x4c\x70\x99" +
+		"\x0e\x47\x1e\x54\x49\xcb\x0a\x45\xf2\xf2\x5e\x96\x0a\x17\xdf\xa2\x71\x38\x3e\xaf\xca\xfb\x38\x0a\x1b\xd5\x78\xfd\x2f\x28\x7f\xfd" +
+		"\xd5\x57\xaa\x91\x6c\x7f\x2d\x0b\x6e\x3c\x56\x06\xce\xea\x26\xbf\x55\xb9\xc7\xfb\xc0\xdb\xc0\x7a\xb1\x66\xb6\xcc\xce\x22\xa9\x03" +
+		"\x05\x13\x29\xb7\x31\x5d\x60\xa6\xf4\xd7\xe5\xe0\x3c\xb9\x13\x8f\xe9\x0a\x17\x80\x9f\x7f\x1f\xec\xfe\x03\xf0\xb8\x08\xa7\xb3\xc6" +
+		"\x28\x5a\x17\x81\x66\xac\xb1\x97\x95\xf1\x79\x56\xf7\xb3\x51\x93\x37\xcc\x3b\xd6\x19\xa0\xbf\x51\xa9\xfb\x35\x7b\x6b\xc0\xd1\x39" +
+		"\x70\x67\x2f\xcb\xf6\x2a\xd0\xbb\x17\xdc\xbe\x97\xe0\x2b\xfa\x6b\x56\x77\x82\xbd\x1e\xaa\x02\x27\xf6\xf7\xca\xa2\x9d\x1b\x35\xc1" +
+		"\x62\x5a\x4e\xee\x04\x8a\xde\xb3\x82\xe0\x26\x51\x22\x3f\x92\xa8\x6e\x6e\x0d\x4a\x39\x1f\x7e\x49\xf5\x29\x0a\x5c\xf6\xcb\xd1\x1d" +
+		"\xd0\x55\xb0\x8a\x72\x39\x93\x74\x98\xfe\xb6\x17\xa3\x4b\x49\x7f\x43\xc3\x2e\xb0\x42\x9e\xce\x12\x41\xb2\xfa\xee\x94\x69\xc2\x81" +
+		"\xa4\x55\x8e\x2e\x23\x78\xbf\x74\xe0\xcb\xaf\x10\xdc\x2e\xcb\x7a\xbb\x57\x7d\x3f\xb8\xce\x9b\x88\x3e\x7d\x06\xb8\x50\x3a\x6f\xbd" +
+		"\xc0\xa4\xd7\x85\xed\x4f\xde\xd5\x04\x7c\x60\x5e\x1e\x7c\x5c\xca\x74\x8c\xb0\xc7\x35\x3a\x2c\xa0\x17\x72\xf4\xbc\x12\x7c\xfc\x4a" +
+		"\x50\x84\x9d\x7f\x82\xbd\xa5\xc5\xea\x4f\x03\xdf\xab\xd7\x7a\xef\x5e\x03\xae\x17\x7d\xac\x23\x58\xdf\x5d\x0e\x88\x4a\x96\x8e\xce" +
+		"\xab\x05\x97\x88\xd4\xf7\x9c\x5c\xf2\xae\xd2\xd0\x9f\x0c\xf4\x34\xcb\x61\x71\x4c\x86\xaf\xd2\x86\x4e\x32\xcd\x13\xde\x10\x4b\x43" +
+		"\x7c\xc9\x8f\xff\x1a\xfd\x11\xc4\xd1\xda\x29\x75\xa7\x04\xc9\x48\xf1\xf9\x7b\xcb\x2f\xde\x92\xf5\x8e\x9c\x02\xb2\x68\xfa\xe3\x46" +
+		"\x30\x03\x5e\x03\x2e\x7e\x21\x03\xce\x97\x8b\xb5\xe4\x25\xe9\xb9\x33\xc1\x8f\x04\x63\x4c\x99\xef\x1b\x73\xac\x5f\x52\x1d\xdd\xa5" +
+		"\xbb\xa4\xae\xb8\x39\x4f\x87\x27\x57\x79\x3e\xf8\xb5\x37\x09\x1e\x1b\xee\xd5\xff\x17\x80\x4f\xdd\x08\x6e\xd5\xa9\xfa\xef\xd1\xdd" +
+		"\x88\x08\xf8\xb9\x52\xdf\xbb\x17\xfa\xa6\x04\x6f\x2e\x15\x31\x58\xe6\x7c\xd0\x28\xb1\x0c\x05\xfc\x2c\xbd\x3a\x76\x47\x1d\xf3\xbf" +
+		"\xe0\x47\xff\x03\x6e\x97\x0a\x1d\x28\xa4\x6e\x96\xf4\x5f\x02\x4e\x13\x62\x31\x4e\xf6\xe4\xc3\xd6\xe0\x64\x99\x9d\xe7\xa6\xc8\x01" +
+		"\xbf\x12\x3c\xf6\x6f\xf0\xf8\x57\xc0\x49\xaa\x32\xf8\xe4\x68\x55\xca\xd5\xf9\x5c\x19\xdb\x0d\x5a\xc5\x67\x82\x47\x44\x14\x38\xa6" +
+		"\xd0\x69\xb6\x84\xaf\x9f\x88\xb1\x07\x54\x3e\xb6\x6a\xa8\xe8\xbd\xe2\xec\x4e\xd6\xa1\xcf\x0b\x25\xbe\xc5\x4a\xbd\xb4\xc1\x4b\x4a" +
+		"\x8b\xef\x95\x44\xbe\x28\x28\x70\x82\xe8\xad\x9f\xbe\x2d\x0a\xab\x32\x03\x1b\x46\x88\x06\xfc\xb6\x90\xc5\x07\x94\x47\x97\x6b\x70" +
+		"\x5c\x16\xf0\x41\x19\xb2\x79\x17\x81\x03\x54\x87\xb3\xe6\x87\x60\xff\x3f\x80\x8f\x4b\x99\xcc\x19\x5d\xe9\xed\x3b\x94\x07\x9f\x2d" +
+		"\x88\xe4\x8d\x75\xda\x40\x5a\xd2\x31\x59\x80\xef\x0b\xb3\x4a\xe4\x7f\x97\x80\x7d\xfe\x07\xdc\x20\x68\xb0\xc7\x61\x85\x6c\x8f\xa8" +
+		"\xaf\xc6\x4b\x02\x58\x15\xb3\x2c\xfa\x39\xb8\x4b\xf0\xf7\x0e\x41\x70\x4b\xe4\x32\x3f\x29\x16\xcd\xa3\xf7\x67\xc0\x61\xfb\x04\xa2" +
+		"\xe9\xbc\x6f\x9e\x0e\x76\xfb\x4c\x09\x16\xc3\xe5\xde\xaf\x00\xfb\xbd\x24\x10\xe1\x55\x5d\xaa\x89\x32\xb4\xca\x73\x6f\x6b\xa4\xf4" +
+		"\x7b\x0e\x1c\x28\xd0\xe8\xa9\xab\xc1\xa9\xf5\x39\x70\xd8\xf3\xa5\xe0\xe3\x3a\x3c\x20\x58\xea\x88\x32\x2f\x33\x97\x26\xe0\x5f\xc1" +
+		"\xc1\x37\x82\x7b\xe4\x60\xaf\x90\x81\x7c\xc2\x46\xeb\xb3\x72\x70\x82\x82\xfa\x91\xca\xd8\xbc\xa4\x1a\xb7\x0f\x47\x28\x53\xf2\xbe" +
+		"\x20\x70\x73\x2d\x05\xc7\x1d\xfc\x29\x38\x55\x2e\xfb\x93\xd3\xd4\x37\xea\xb1\x32\xf0\x31\x91\x0c\xa6\x29\xa1\xfb\xa8\x38\x0a\x4f" +
+		"\xe8\xf9\x1f\x9c\x90\x03\x7b\xe9\xd0\xe3\x80\x60\x4b\x1d\x06\x8a\x29\xbc\x5d\xce\xec\xc0\x5f\x80\x1f\x08\xd5\x1d\xf2\x43\x70\xf9" +
+		"\xaa\x72\x70\x81\x22\x8c\x4f\x15\xe8\xed\x93\x00\xee\xda\x51\x01\xbe\x62\x71\xfb\xc5\xe0\x81\xa1\x04\x0f\xf5\xcc\x83\xbd\xe4\xdd" +
+		"\x7e\x2c\x64\xa8\xef\x7f\x83\xab\xf6\x0a\xdd\x7b\x4c\x6e\xdc\xeb\x25\xe0\x21\xc1\x32\xef\x77\xad\x71\xbe\xfe\x04\x55\x91\x2f\x98" +
+		"\x5a\x07\x8e\x7e\xba\x56\x71\x0c\xc1\x47\x1e\x28\x05\x01\xee\x56\x91\xf7\x46\xf1\x3e\x17\x29\x65\xd5\x6d\x13\xc5\xfb\x57\xe6\x55" +
+		"\xec\x8b\x05\x63\x15\xf5\xad\x91\x57\x2a\x26\x5a\x0f\x55\x0d\xa8\x14\x70\xf8\x7d\x72\x97\x32\xe0\x43\xa5\x60\x9f\xf1\x19\x70\x8d" +
+		"\xbc\xda\x9e\x09\x78\x40\x42\xb3\x49\x1c\xf8\xb5\x72\x6b\x5f\xfd\xb9\x77\xc4\x56\x82\xf6\x55\x61\x5d\x63\x75\xad\x79\xef\x95\x83" +
+		"\x2f\xab\x6a\xed\xa8\xfc\xe5\x37\xc5\x46\xd7\xfc\x0c\x56\xa1\xeb\x2b\x2a\x39\x99\x98\x07\x77\xfc\x37\x78\xe4\x3c\xf0\xf3\x73\xc0" +
+		"\xeb\xc0\x31\x04\x37\x0d\x69\x02\x3e\xfb\x50\x53\x70\xd3\xff\xb9\x8e\x9a\x3d\x25\x07\x1e\xd3\xf0\x1e\xd7\x61\xc9\xf8\x9c\xd3\xe3" +
+		"\x7a\xc9\x63\x9d\x31\x2e\x07\xae\x54\x54\xb6\x5c\xbc\xdb\x63\x42\xdd\xeb\x35\x40\xcf\xe9\xd0\x4d\xd9\xd5\xfb\x75\x00\xf8\xa0\x72" +
+		"\xb5\x03\x06\x13\x1c\x4f\xb0\x9b\xe2\x60\xfd\x31\xe8\x7b\xe0\x91\x6b\xc0\x95\x43\x08\x2e\x1d\x4d\xdf\x45\x73\x40\xe2\x9d\xe3\x7e" +
+		"\x0f\xce\xf8\x6f\xf0\x2c\xb0\x0d\xf8\xcc\x7d\xe0\x83\xaa\xf9\x1d\xfb\xae\x37\x6d\x99\x2f\xe1\xd8\xa3\x3c\xcd\xa0\x12\xaf\x5d\x9e" +
+		"\xbe\x4e\x67\x91\x8f\x3d\x5c\x87\x65\x5d\xf3\xe0\xe7\xcf\xf8\x66\xce\x8b\x94\x3b\x19\xad\xf1\xdf\xa3\xd9\x1e\xb1\x83\x3e\x1e\x63" +
+		"\x32\x0e\xed\x5f\x04\x8e\xbc\x5f\x04\x74\xe5\x3f\x5f\x9d\xa0\xeb\xcc\x24\xf8\x90\xb4\xee\x9c\x07\x13\xf0\x48\x1e\xdc\xbb\x41\xe4" +
+		"\x16\x95\x5d\x8d\xd7\xe1\x31\x83\xa6\x96\x55\xa7\x39\x80\x23\x8f\xca\x79\x12\x3f\xe0\x6e\xb0\x7b\x1b\x50\x38\xae\xe2\x65\x80\x9f" +
+		"\x29\xfd\x76\xa2\x04\xfc\x1e\x38\x45\x21\xc5\xfc\x04\x5c\xb7\x44\x62\x2b\xe5\xda\xed\x74\x70\x9b\x7c\x89\x13\x2a\xc3\xd0\x17\x16" +
+		"\x89\xd4\x38\xfb\x2e\xb0\xaf\x0a\xa5\x3e\x1d\x96\xc1\x8f\x5f\xdf\x9e\x05\xef\x04\x0f\x6a\xec\x3f\xcb\xe0\xc7\x0f\x75\x25\xb8\x51" +
+		"\x07\x78\x04\xb5\xc8\x5e\x26\x9b\xae\x06\xa7\x97\x20\xa7\x8c\xdc\x00\x79\x4e\x0f\xeb\x30\x54\xbe\x44\x01\xc9\xc3\xfa\x7f\x2f\x73" +
+		"\x2c\xfe\x21\x66\xba\x38\x80\xb3\xab\x90\xbd\x5f\x6f\x75\x7f\xa5\x0a\xc9\xfd\xfa\xfa\xea\x87\xf3\xe0\x1e\xa5\x8c\x4e\xa8\x3a\x74" +
+		"\xdf\xc1\xbc\x42\xb2\x46\xe0\x6e\xf1\x45\xd7\xa8\xe0\x79\xae\xe2\xcc\x9f\x83\x4b\x87\xeb\x3e\x2a\x9c\x7a\xff\x88\xfe\x18\xa3\x05" +
+		"\xa6\xe5\xd6\xa7\x85\x77\x5a\x28\x03\xb7\xd7\x78\xe1\xf6\x17\x72\x5e\xbb\x0d\x22\x78\x44\x86\x73\xa2\x16\xc1\xc4\xe6\xe0\xf1\x2a" +
+		"\x67\x25\x4e\x12\x21\x71\xa5\xc0\xef\xc1\xca\xbf\x0d\x54\xba\xf3\xb8\xce\x39\xa4\xda\xb7\xbb\xf8\x50\xe4\xcf\x7e\xab\xe9\x65\x16" +
+		"\x5b\x5f\x16\xef\x44\x35\x29\x47\x73\xee\xe6\xed\xf0\x12\xff\x55\x04\x97\x6f\x36\xee\x51\xb5\xa3\xad\x53\x08\xb6\xf3\xf6\x26\x1b" +
+		"\x54\xef\x33\x4e\x8a\x7b\x61\xde\xdb\xb1\x3f\x2b\x1b\x35\x79\x5a\x16\x7c\x52\x2a\x7e\x94\xca\xba\xdf\x7e\xb6\x1c\x7c\x43\xfe\xce" +
+		"\x5a\xf9\x54\x2f\x0f\xcd\x20\x93\x05\x7d\x7f\x0a\xef\xd7\x54\x6c\xfe\x69\xef\xa7\xdd\x6d\x4c\xe4\x12\xff\xa6\xef\xba\x38\xe4\xed" +
+		"\x46\xe0\x22\x41\x86\x9f\x4e\xae\x04\x1f\xff\x01\x38\xb7\x9b\xa5\x59\x15\x90\x9d\x09\xd6\x3f\x2a\x74\x74\x94\xef\x60\x21\xd7\x63" +
+		"\xa8\x6f\x41\x3d\x20\xed\xdf\x56\xe5\x5b\x1e\x76\x72\x35\xa0\x08\x4c\x79\xd7\x12\xb0\xb3\x5d\xfd\x4e\x2f\xf9\x2d\xb1\xd6\xd8\x87" +
+		"\x95\x6b\x28\xb8\x47\x3f\xa2\x09\xb8\xdb\xf7\xd8\x9d\xa7\x39\x69\x04\x6e\x54\x1e\xb5\x16\xdc\x74\x4a\x34\xe7\x69\x19\xbb\x35\xdc" +
+		"\x0c\xde\x03\xf6\x4c\xf3\x6c\x73\x13\x70\x52\x0b\x70\xbf\xf5\xb6\x4b\x7a\xf9\xa3\xcc\xfc\x1d\xb8\xf6\x64\x4f\x86\x3d\x7c\x1b\xd8" +
+		"\xf3\x7a\xf0\x29\x41\x4e\x22\x0b\x9c\x0b\x3e\x7b\x0e\xb8\xf5\x69\x61\xb3\x22\xc5\xae\xf8\x2b\xf8\x89\x98\xd2\x47\x94\x90\xe8\xa9" +
+		"\x68\x48\x7f\xed\x57\xca\xe9\x2d\x49\xfc\x83\xf2\x13\x57\x89\x33\xb3\x6d\xa2\xea\x0d\xe5\x00\x2f\xbe\x19\x5c\x72\x01\xd8\x7f\x32" +
+		"\xc1\x69\x0b\xbc\xf4\x73\x70\x63\xf0\xe9\xa1\x04\x97\x8a\x40\xf2\xfa\xb0\x0c\xf8\xc9\x8f\xc1\x7a\x63\x08\x7f\x22\x7b\x4e\xbb\xbf" +
+		"\x3f\x80\x77\x81\xfa\xe2\x9a\xdf\x80\x5b\x7f\x07\xee\xfa\x1a\x78\x58\xc5\xa3\xbf\xb7\x21\x9d\x2b\x27\xf8\xed\xd6\xe0\x57\xc1\x0f" +
+		"\x2a\x9d\x65\x3f\x49\x78\xea\xff\x80\xf3\xff\x17\x7c\xfe\xff\xc0\x39\xff\xe7\xd2\xbc\xe7\x6f\x60\x99\xb7\x91\x1f\xf3\x57\x1b\xa2" +
+		"\x0e\xf6\x86\xef\xf8\x74\x5b\xb4\x39\x7a\xf2\x5f\x36\x88\x17\xc8\x3c\xcb\x8d\xd6\xe1\x84\xf0\xf5\x2d\xa2\x0c\xce\x93\x07\xbe\xb2" +
+		"\x11\x78\x74\x8a\xb4\xfe\x4f\xc0\x17\x24\x6c\xcf\xfe\x37\x58\x0d\x6e\xbf\x0e\x7c\xf4\x56\xf0\x25\xc5\x66\x23\x84\xcc\x7d\x24\xf2" +
+		"\xd1\x9c\x6d\x95\xe0\x5a\x6d\x07\xf9\xb8\x68\xa3\x3b\xef\x06\x65\x85\x8e\x49\xdd\xac\x50\x9e\x74\xe2\xc3\x89\xf7\xe1\x5b\x90\x01" +
+		"\x27\xda\x7a\x9e\x53\xe5\x65\xf3\x2a\xd2\xe8\xa1\xc2\x28\x0d\x4d\x85\xb7\x79\xd8\x5f\xe9\x68\xe2\x83\xf2\xd8\x67\x89\x84\xb7\x48" +
+		"\x5c\xeb\xc3\x13\x35\x02\x03\x89\x64\xcf\xe3\x04\xeb\xff\xee\xa5\xcb\xf5\x09\x38\x7f\xbf\x18\xd3\x62\x95\xed\xd7\x44\x3d\x3a\x95" +
+		"\xe0\xe0\x31\x75\xe0\x4c\x71\x08\x65\xad\xf7\x2f\xab\x00\x1f\x98\x5a\x09\x3e\xa9\x28\xba\x5e\xbe\xcd\x31\xf9\xc8\x33\x25\xab\xcb" +
+		"\xf4\xdd\x87\x55\x0b\xdb\xeb\x53\xa9\x8c\x0c\xb8\x22\xad\x3b\xda\x7c\xcc\x28\xa0\x72\x39\x44\xf7\x7d\x53\xe4\x9a\xbe\x42\x11\xdf" +
+		"\xf9\x01\x78\xa0\xcc\xbf\xd8\xb5\x04\x1c\x25\xb5\x39\x4f\xc5\x13\xd3\x14\xc1\x34\x03\x27\x7d\x29\x20\xbc\x9b\x5c\x99\x37\xab\x90" +
+		"\x0c\x7a\xa7\x12\x89\x8c\xc6\x8b\x9a\xca\xdd\x62\x98\xf5\x94\x64\x1e\x3c\x07\x7c\x7e\x99\x64\x24\x0b\xf6\xec\xab\x82\x6b\xf9\xdd" +
+		"\x3b\x54\x4b\x36\xb8\x00\x4e\x96\xbf\xb8\x54\x35\xc4\x0b\x34\xc6\xaf\x2e\xd4\x3a\x1b\x43\xb0\x8f\x34\xfe\x02\xc1\x1b\x5b\x9a\x83" +
+		"\xc3\xff\x28\x9f\xbe\x16\x7c\x74\x9f\xa8\x0c\xd2\xbc\x6f\x7d\xcb\x33\x79\x87\x15\xe4\x7f\xb9\x5a\xc2\xbc\x58\x50\x8d\x55\x3d\xaa" +
+		"\xe0\x64\xbc\x46\x5d\x21\xff\xc0\xae\x04\x27\xe9\x56\x77\xfb\x8e\x84\x8f\xfd\x13\x5c\xdf\x0c\x9c\x30\x84\x60\x17\xf0\x05\xfd\xbf" +
+		"\xcf\x08\x82\xb3\x15\xd0\x6b\x02\x7e\x6f\x0b\x72\xf0\xc8\xb4\x11\xe5\xf3\x0a\x53\x2c\x79\x35\xca\x03\xbe\x89\xfa\xff\xce\xa7\xbc" +
+		"\xd9\x06\xd7\xcb\x13\x39\xf8\x27\xf0\xdd\x3f\x81\x73\xe4\x1e\x9e\x07\x36\x57\x39\xb1\x2e\xfa\x2c\xc1\x39\x7b\x94\xfc\x1c\x9c\x01" +
+		"\x37\x29\x8c\x19\xa8\x43\xf7\x89\xa9\x1d\x7e\x4d\x2f\xe6\x4e\x92\x37\xa3\x57\x3b\x85\x6c\x8d\xd3\x61\xbe\x0c\xed\x63\x3a\x6c\x7f" +
+		"\xd1\x37\xd4\x7e\x7a\x2a\xa3\x2f\xf2\x88\x69\xfe\xd6\x94\x8e\xe0\xc7\x27\x83\x7f\x01\xcf\x07\x47\x13\x3c\x20\x39\x19\x30\x8b\xb1" +
+		"\x7f\x4b\x07\xf0\x39\xfd\xb5\x41\x87\x37\x75\x38\x38\xab\xb8\x3f\xcc\x75\x8e\xa9\x6a\x8c\x6e\xf3\x97\xbf\x16\x43\x93\xe0\xbe\xb9" +
+		"\x04\xbd\xcb\x88\x2e\xf5\xf4\x3c\x3d\x8c\x0e\x2d\xdd\xcc\x79\xab\x65\x9d\xe9\x90\x42\xc7\xde\x3a\xdc\x0c\xca\xad\x9a\x47\x24\x13" +
+		"\xf5\xd5\xbf\xd8\x62\x7d\x57\x16\xf1\xb0\x5c\xfc\x2f\xe7\xf8\x46\x47\xe3\xa4\xc8\x9b\x82\xdd\x57\x4a\xa1\xdf\x01\x3e\x21\x57\x62" +
+		"\xa9\x90\x73\x1d\x4e\x2c\xf5\x4b\x5f\x64\x3f\x5f\x26\x92\xce\x22\x79\xf1\x4f\x5e\x0c\x2e\x17\x17\xe0\x11\x09\xd0\x9c\x93\xc0\xfd" +
+		"\x82\x44\xdf\x52\x89\xd3\xbe\xef\x82\x6f\xb6\x05\xfb\x74\x06\x9f\xd8\xa1\x7b\x55\x5d\xcb\x1a\xc1\x7f\x47\xbf\x4c\xc0\xc7\x54\x1e" +
+		"\xbb\x55\x14\xd2\xbe\x17\x83\x8b\x75\x4b\x6f\x09\xd4\x78\xdf\xb6\x78\xbc\x19\x1c\x7b\x03\xd8\x47\x4b\xa0\x87\x82\xc4\xa3\x02\x3b" +
+		"\x5f\x91\x52\x5a\xfd\xae\xf0\xe4\x9f\x83\x9f\xe6\xc1\xa7\x04\x2e\xcf\x59\x4b\x70\xad\x58\x19\x1b\x25\xd2\x33\x9f\x50\xe0\xf3\xa1" +
+		"\x6c\x83\xb4\xc5\x81\x07\x2b\xc0\xe9\x67\x81\x07\xe7\x94\x83\x2f\xa8\x36\x6b\xa2\x9c\x87\x73\xc1\x7e\x9f\xd7\x80\x8f\x3e\xd4\x08" +
+		"\x3c\xf1\x18\xdd\x1a\x7e\x3a\x87\xe0\x20\x45\x5c\xcf\xc8\x5b\x3a\xfa\x8c\x8c\x8e\x88\xb5\x5f\x8a\x8b\x33\xdb\xe0\x47\xad\x85\x9e" +
+		"\x2b\x12\xef\xbd\xf9\xae\x38\xc1\xab\xf5\xf3\xed\x1a\xd1\x05\x02\x45\x56\x29\x81\xf0\xb6\x40\xa8\x3d\xdd\xe5\x95\xfc\x03\x5c\xfd" +
+		"\x82\x05\x51\x12\x68\x75\x8e\xe8\xde\x12\x7c\x4d\x97\xda\xad\xb8\xec\x0d\xa9\xf7\xe7\x84\x5b\x4f\x12\x65\xfb\xb3\x32\xf0\x43\xa1" +
+		"\xb5\x07\xe5\xad\x4f\x16\xf2\xfa\xc1\x52\x6d\xb3\x29\xd4\x6a\xcd\x5b\x2a\xf0\xbd\x0b\x1c\xac\x14\x44\xbf\x53\xc1\xe7\x07\x6a\x0c" +
+		"\x24\x1d\x13\xcf\x37\x55\xae\xf1\x59\x45\x70\xa8\xf4\xe9\x51\xa9\x92\xa7\xe4\x15\x4e\x5c\xed\xa6\x60\xc0\x1a\x22\x11\x3f\x6d\x35" +
+		"\x63\x9b\xa3\x8a\xb4\xa0\xa6\xf7\x3b\x29\xfb\x63\xea\xba\xe2\x26\x22\x4f\xe9\xe5\xd0\xf5\x74\x17\xe1\x66\xf0\xf9\x0d\x5a\x9a\x3a" +
+		"\x28\xf6\x59\xf2\x3b\x70\x96\x2e\xb4\x40\x43\xb5\x6c\xa3\x9c\x1d\x1d\x9a\x3b\xf9\xef\xa8\x0c\xf3\xbb\x1b\xfd\x6c\xc6\xbc\x69\xe9" +
+		"\x49\xe2\x12\x33\xcf\x8d\x6d\xc5\xcf\x3f\x1b\xdc\x20\xe0\xe4\x01\x25\x27\x08\xae\xdc\xac\x25\xb3\x95\x69\x85\xde\xd6\xb4\xff\xdd" +
+		"\xe0\xed\x7a\xa0\x36\xe0\xae\x6e\x42\x11\xb7\xfb\x65\x1e\xd3\xff\xc7\xee\xf0\x25\xd4\xc6\xad\xd6\x36\xfd\x79\x01\xd8\x6d\xa7\x17" +
+		"\xaf\x3f\xa9\xff\xaf\xd3\x61\x91\x0e\x27\x94\x89\x7b\xe6\xef\xe0\x48\xd1\xf2\xbe\x7c\xb9\x22\xfa\x22\x7e\x1f\xdc\x97\x07\x9f\x95" +
+		"\x99\x9d\x2d\x49\x38\xc8\xa8\x0b\xce\x9b\xbd\x7d\x75\xb4\x4e\xf4\x33\x70\xd2\x35\x5e\x98\xa2\x2c\xf1\x9c\x43\x4c\x77\xef\xd3\xf1" +
+		"\x19\xfd\xd5\xff\x64\x70\xd6\x50\xdf\xf7\xf1\x61\xb1\x1a\x57\xe9\x30\x51\x07\x99\x0e\xfd\x7f\xcf\x58\x82\x63\xa4\x9e\xae\x07\xf5" +
+		"\xc0\xbd\x3f\x23\xb8\xfc\xb3\x54\x0d\xb5\x06\xdf\x3a\xa2\x37\x8e\xea\x6b\x37\x81\x8b\xbe\x01\x3e\x79\x13\xb8\xe9\x5b\xe0\x0d\xe0" +
+		"\x83\x27\xb4\xd4\x4f\xf8\xcf\xcf\x32\x35\x78\xab\x3d\xf3\xc4\xae\x09\x92\x9d\x5d\x13\x3f\xe9\x4a\x65\x3a\x0f\x2b\x46\xbc\xc6\xb5" +
+		"\x43\xfd\x95\xe0\x17\x72\x62\x3a\x80\x93\x34\xa5\x7a\xa2\x1b\x3d\xdc\xaf\x17\x7b\xf8\xbd\xd9\xd5\xee\x36\x5c\xe3\x2d\x3b\x7b\x7a" +
+		"\x77\xa9\x3b\xc0\x07\xa5\x8f\xd6\x0e\x15\xfc\xaa\xba\x90\x61\x2a\x88\x7b\x43\x81\x87\xc2\xa7\x63\x17\x29\xef\xea\xdb\x5b\x66\x63" +
+		"\x83\xb8\x91\xc5\x4e\xf0\xcb\x15\x37\x68\x85\xed\xd0\xb5\x1f\x6f\xa5\xec\x4b\x22\xd3\x99\x80\x3f\xf1\x5d\x3b\x19\xea\xf1\x06\xb0" +
+		"\x06\x89\xef\xb4\xd5\x37\x8d\x8c\x6e\x03\xdf\x52\xbc\xd7\x5c\xc5\x58\x9e\xe9\x74\xbf\x63\x9c\xfe\x5a\xad\xc3\x8d\xbe\x03\x80\x44" +
+		"\xe6\x53\x75\x4e\x19\xa6\x1a\x92\x7f\x81\xc3\xd3\xff\xcf\x50\x38\xf4\xa8\x0e\x47\x5f\xf2\xd6\x15\x2f\x4b\x0e\x26\xa8\xec\xe0\xd5" +
+		"\x69\x09\x38\x59\xaf\xd6\x4b\xb6\x26\x3c\xe0\x0f\x7d\x93\x3d\xc5\x36\xbd\x3f\x61\x66\xf4\x2f\x9a\x33\x2b\x71\xa0\x63\x2a\x3d\xd8" +
+		"\x75\x6f\xf3\xe1\x39\x7a\x3a\x1d\xb6\x0c\xca\x28\x68\x15\x44\xfb\x79\xb9\x7b\x9c\xef\x8e\x96\x7f\xfd\x84\xdf\xc8\x43\x1d\xc1\x2f" +
+		"\x1b\x83\x87\x2f\x8f\x2d\x09\xd5\x68\xa8\x6f\xe7\xd8\x88\x60\x28\xc1\x51\x3d\xd4\x2e\xac\x04\x14\x6c\x35\xf3\xe5\x72\x70\xbc\x54" +
+		"\xd7\x73\x3a\x8c\x9f\x5f\xee\x26\x62\x1e\xc1\x5e\x5f\x01\x17\x48\x1b\xcf\x10\x7d\xe2\x03\xdb\xa4\xc6\x9a\x8a\x1d\x79\xa1\x00\xbe" +
+		"\xaf\xc3\x7a\x39\xb7\xb3\xde\xab\xf4\x1f\x79\xbb\xf5\xb3\x6c\xf0\x6e\xb5\x7f\xed\xbc\x1d\x71\x4f\x82\x67\x18\x42\xd1\xd8\x1b\xe6" +
+		"\x1d\x4c\x3c\xde\x15\xc5\x7c\x50\xce\x5d\xdf\x16\xa6\x03\xbc\x61\xc1\x13\x42\x7c\xf7\xef\xaa\x72\x49\x6b\x0e\x4e\x14\x53\xe3\x84" +
+		"\x6e\x46\xd3\xfa\x4f\x6f\x15\xaa\xa0\x6e\x75\x1e\x2c\x01\x1f\x53\xed\xef\x76\xd5\x3d\x1f\xcd\xfa\xce\x99\xe7\x78\x4f\xbe\xbe\x5e" +
+		"\xca\x38\xa3\x04\x6c\xe9\x77\xf9\xb9\x38\x72\x1b\x94\x31\x9c\x2e\xf7\xe5\xb5\x65\x1a\x6b\x31\x4b\xa7\xf9\x36\xfc\x9b\x2b\x7c\x73" +
+		"\xe9\xd9\x7e\x92\xbb\x3c\x38\xee\x64\x53\x76\x0f\x38\x2c\x6b\x27\xfc\x11\xb8\x55\xeb\xf8\xd5\x97\x5d\x3d\x28\xa6\xb9\xd6\x7b\x70" +
+		"\x6c\x4f\xdb\x74\x49\x30\xd5\x88\xa6\xcc\xb7\xe9\x1c\x94\xf7\xfd\x5d\x6e\xf6\xdd\x03\x5e\x13\x33\x62\xfb\x83\xd5\xde\x5a\x5e\x4a" +
+		"\x7f\x6e\x29\x38\xc1\xdb\x2e\xde\xec\xc9\x80\x8d\xf4\x88\x79\xb8\xb0\x93\x75\x2f\x57\x7b\x70\xb7\x30\xe3\x73\x27\xc8\x49\x50\xd4" +
+		"\x4b\x0b\xaa\xd3\xb2\x9e\x65\x92\x32\xe1\x81\x53\x3f\xaa\xf6\x66\x85\xdf\xb7\x69\x18\xed\xfd\x8a\x4a\xec\xdf\xbc\x52\xf7\x37\xf5" +
+		"\xe5\xb5\x79\xe7\xfa\x1d\x15\x49\x4b\xc4\xd0\xcd\xf7\x81\x5b\xe7\x54\xfb\xd6\xb8\xa3\x4a\x3d\x20\xfa\xb3\xfd\x6e\x70\xe2\x8f\xb7" +
+		"\x37\x03\x7e\xa6\xfc\x51\x4f\x21\x9a\x8b\x5e\xaf\x05\xbb\x2d\xac\xf5\x0e\x77\x6d\x3d\x0e\x9c\x43\x1b\xb0\x6e\x19\x1b\x80\x2a\xdf" +
+		"\xb9\xa8\x3b\xc1\x7e\x59\xa7\xe8\xab\xfe\x48\xe9\x97\x1d\xc5\xf6\x10\xd3\x44\x10\xff\xbc\x2a\xe5\x37\x4d\x3c\x5c\x0b\x8e\xed\x5e" +
+		"\xe7\x23\xf6\x40\xcf\x5a\xb0\x9f\x38\x52\xd7\xd8\x2c\xca\x0b\x17\x4a\xb5\xb0\xcc\xd4\xd2\x8d\xe6\x7e\xa4\xce\xca\x9f\x4d\x2b\xcf" +
+		"\x25\xb8\x96\x5e\xad\x7e\x9b\x9f\x5c\xd3\xd9\xbd\x14\x7c\x50\x88\xc1\xe6\x47\x6b\xbd\x8b\xeb\x8f\xc0\xd9\x39\xef\xa6\xf9\x6b\xf0" +
+		"\x48\x7a\xab\x37\xd8\xb4\xf7\x7e\xb3\x0e\x5c\xae\xc3\x38\x69\x9f\x4f\x04\x99\x4e\x1a\x5c\xe3\x12\x5c\xe3\xdd\x44\xcc\xd5\x77\x4b" +
+		"\x30\x25\xdd\x31\x42\x5e\xf0\xd0\x52\xd7\x2d\x9f\x1d\xab\x01\xa7\xdc\xef\xb4\xfe\x67\x54\xa0\x3b\xb0\x0b\x38\x54\x29\xbf\x2d\x1a" +
+		"\xb8\x59\x2a\xed\x1e\x5a\x0e\xbe\xb7\xb9\x0e\xec\xaa\xcc\xed\xcb\x22\x29\x16\xc0\xbd\xc2\x30\x3f\xd2\x61\xaa\x2e\xbc\x52\xc1\x73" +
+		"\x77\x91\x4e\xdf\x51\xa1\xd5\x6e\x05\x7b\xfb\xde\xf4\x56\xad\x6f\x2b\x1a\x58\x76\x15\xf8\xaa\x2d\x5d\xa5\xb6\xe5\x12\x2d\xd7\x24" +
+		"\x8d\xd3\xd2\x9a\xa6\xf1\xa8\xbf\x04\x7c\x6b\x55\x6a\x6d\x47\x8a\xe5\x74\xa0\x23\xf8\xa8\xa8\xcc\x63\xe4\x72\x7f\x36\x4f\xd9\x52" +
+		"\x65\x7d\x5f\x53\x9b\x0b\x41\x0a\x23\x7e\x0d\xbe\x2d\x51\x5a\xb1\x90\xe0\x61\x71\xcb\xfa\xc8\x5e\xef\x11\xbc\xd8\x53\x7c\xf3\x57" +
+		"\xa4\xc3\x0f\xaf\x93\xdb\xb5\x8f\xe0\xa8\x8f\x65\xaf\x2f\x03\x15\x36\xcd\x14\x83\x67\x61\x3d\xc1\x0d\xd2\x69\x9f\x0b\xde\xdb\x7b" +
+		"\x31\x38\x77\x99\x0c\x96\xe2\x8a\xdd\x7f\x01\x3f\xfc\x0b\xf8\x7a\x01\x5c\x58\x00\xa7\xaa\xc2\x40\x64\x99\xd3\xc1\x41\x17\xa8\xe7" +
+		"\x06\xc1\xd1\xff\x0d\x3e\xff\xdf\xe0\x6e\xe1\xa9\xe3\x85\xfc\x3c\x28\x82\xc0\x5a\x81\xc7\xd3\x0d\xd7\xf9\x0d\x38\xe5\x1e\x70\x7b" +
+		"\xaf\x4c\xaa\x2b\x77\xcb\x88\xbc\xa5\x34\xdc\x51\x59\xc1\x67\xe6\x11\x9c\xd4\x16\x7c\x34\x03\x3e\x7d\x21\xb8\xe5\xaf\xe0\x03\x62" +
+		"\x2a\x1c\x51\x02\x66\x88\xdc\xba\x07\x3e\x50\x64\xf1\xa1\xa4\xad\x56\xdc\x27\x89\xa3\x4a\xc1\x16\x2a\xdc\xfa\x58\x55\x77\xb3\xc4" +
+		"\x59\x7f\x53\xcb\xb1\xdb\x76\x82\x33\xc4\x72\xd2\xcf\x5f\x2d\x03\xe7\xfe\x09\x3c\x2a\x7f\x75\xa1\x1e\x6b\x99\x12\x50\xbd\xbb\x16" +
+		"\x44\xa9\x54\x1d\x95\xd8\xaf\xc3\x74\x37\x9f\xcd\xcc\x80\x43\x94\x6e\x9e\x2e\xee\xe9\xfe\xb7\xc5\x32\xde\x2e\xba\xf8\x5d\xe0\x26" +
+		"\x95\xb7\x8e\xd2\x85\x4f\xfc\x10\x5c\xd2\x5d\xc9\x19\x0d\xe7\x89\x13\x19\xf0\xcd\xfa\xb4\x77\xc6\x97\x97\xab\x1a\x4f\xa1\xca\x72" +
+		"\x25\x28\x24\xe4\x93\x05\x47\x7e\x7c\xaf\xa8\x07\x35\x4e\xf8\xdc\x30\x4a\xd9\x6f\xa5\xd8\xdf\x4e\xc0\x3e\x7a\x31\x70\x5e\x8d\x77" +
+		"\x54\x5c\xfb\x2d\xf0\xa9\x4b\xc1\x81\x15\xde\x03\x70\x9a\xf0\xa1\x6d\xf7\x88\x85\x91\x80\x87\xba\x09\x34\x7f\x5f\x1b\x52\x28\x0c" +
+		"\xda\x7c\x0b\xb8\x5f\xf9\xc3\xe1\xd7\x80\xf7\x7f\x03\x7c\x5a\x68\x51\xbf\x12\xb0\xa7\xd2\xd2\x1f\x2a\x20\x5d\x72\x8b\x7a\x9e\x29" +
+		"\xdb\xaa\x14\x5a\x5f\x51\xc9\x67\x6c\xa0\xf2\xab\x9a\x84\x09\x72\x99\x4f\xd4\x99\xce\x16\xaa\x2f\x9c\x6d\x0c\x3d\x33\x36\x5a\xd1" +
+		"\x46\x1a\xa2\x1e\xa0\xb7\x3b\xfc\x42\x06\x6e\x49\x01\x7c\x73\xa5\x12\xa4\x2b\xf3\xe9\xab\x3d\xff\x6b\xc1\xda\xd5\xde\xbe\x51\x75" +
+		"\x9a\xaa\x12\x38\x96\x05\x6f\x37\x77\xe9\xe7\x6e\x65\xfe\xee\xea\x71\x51\xba\x31\xc9\x8b\xe9\xee\x2d\x6a\xa8\x74\x50\x15\x29\x69" +
+		"\x13\x43\x91\x8b\x66\x65\x1a\xf4\x86\xd4\x17\x06\xe8\xb2\xbd\x57\xe5\xc1\x7e\xab\xf2\xe9\xab\x89\xff\x0b\xee\xf3\xfd\x57\x7e\x1e" +
+		"\xbd\x55\xbf\x0a\xaa\x82\x5d\xb3\x3a\x56\xf3\x93\xd5\x6d\x25\x9b\x7b\x10\xec\xee\x9b\xb8\x5e\x26\x97\x9a\x60\x4f\x51\x82\x54\xb4" +
+		"\xf7\xb8\x6a\x8b\x66\x3e\x98\x49\x5d\x8c\x13\xa2\x00\x4d\x34\xbf\x54\x8c\xc6\xaf\x83\x1b\x94\x31\xf8\xf0\x80\xa0\x11\x15\xf9\x0e" +
+		"\x57\xae\x78\x94\x32\xd1\x8f\x2a\x61\x3c\x52\x5d\x99\x14\x0c\x7f\x7c\x1a\x38\xef\x3c\xb0\x9f\x5a\x52\xf5\xd5\x13\x7c\x36\x50\x18" +
+		"\xa1\xf0\x98\xf7\xd5\x67\x6d\xbc\x42\xc2\xd7\x1b\x81\xe3\x7a\x13\x9c\xa7\x26\x4e\x0f\x8d\x50\x6d\xaa\x82\xd3\x49\x5b\x44\x78\xef" +
+		"\xd6\x48\x19\x6d\xa5\xdd\xce\x56\xa5\x95\x3a\x35\x5c\x0a\x3e\xab\xac\x6d\xff\x11\xf2\xeb\xfe\x0c\x4e\x7d\x49\xb1\xd7\x14\x25\x0c" +
+		"\xfe\x0c\x0e\x5e\x26\x71\x12\x69\xff\x91\x27\x65\xb4\xc7\xd0\x3b\x7a\x0f\x55\xda\xa8\xcc\x3b\x2d\x8d\x9e\x97\x80\x1f\x8a\x29\x36" +
+		"\xe9\x19\xa6\x69\xfe\x7f\x80\xcb\x14\xe1\x2c\x92\x5e\xab\xf2\xe6\x9b\xa3\x9e\x10\x40\xaa\x8c\xc3\x46\xdd\xef\xab\xcf\x55\x39\x9b" +
+		"\x64\xae\x70\xa9\x45\x42\x42\xbb\x35\x06\xfb\x28\x73\x79\x40\xd0\xc9\x96\x55\x46\x9b\x56\x8c\x23\xfb\xb7\xf8\x3e\xaf\xe3\x9f\x27" +
+		"\xf4\xe2\x89\xe6\xa9\x63\x2f\xd4\x7c\x92\xac\xa2\x4c\xac\x62\x96\x32\x70\xcc\x2f\xc1\x17\xee\x06\xa7\xde\x0d\x6e\xb9\x16\xdc\x7e" +
+		"\x2d\x38\xfd\x6a\xf0\xfd\xd3\xc0\x6e\xb7\x81\x5f\xb4\x01\x87\x5c\xa4\x26\x07\x2a\x46\x50\x13\xb3\xe1\x6f\x55\x79\x33\x7d\x55\x34" +
+		"\x8e\xf8\x5c\xae\x82\xf8\x1b\xbb\xf4\x28\xfd\x6e\x07\xa7\xcc\x22\xb8\xb4\x5f\x15\xf8\x99\x84\x7f\x84\x88\xda\x8f\x0d\x52\xba\x47" +
+		"\x2a\xe3\x80\xb4\xed\xd4\x69\x0a\xd2\xea\x90\x3c\x25\x7e\xc8\xf3\x42\xd6\xa7\xe8\x30\x4e\xd2\xb0\x46\x55\x06\xdd\xdb\x83\xef\x35" +
+		"\xf7\xbd\x7f\x7f\x90\xf2\x54\xdf\x7f\xa4\xd6\x2b\x3e\x8f\x8b\x6b\xf6\x84\x48\xd4\x0b\x75\x78\x5f\x06\x70\xde\x59\xa0\x1a\xc4\x79" +
+		"\x3b\xef\x13\x4a\x95\xbe\x75\x1e\x78\xf8\x90\x34\x54\x23\xb0\x5f\xa9\x2a\x7a\xd4\xa1\x46\xe1\xeb\x03\xea\xcb\xb3\x4b\xcb\xbc\xa5" +
+		"\x0c\x80\x32\x97\xaa\xc2\x58\x50\x0a\xaa\xae\xa7\xfb\x20\xcb\x2c\x29\xa9\xa1\x52\xad\x2d\xd2\x56\x0b\xfb\xd1\x7d\x9f\x71\xdb\x55" +
+		"\xa9\x75\x25\x38\x75\x98\x44\x4d\x2b\x74\x86\xa2\xc3\xde\xd3\x65\x9b\xa4\x1f\x0f\x8b\xdf\x31\xae\x6b\x2d\xd8\x53\x33\xf8\x89\x90" +
+		"\xea\x2f\xf4\xbd\x21\xa3\x55\xff\xd3\xbf\xce\xad\x5f\x1f\xe5\x83\x4e\x03\x97\xfe\x15\xdc\x2e\x86\xd4\x22\x3d\xe0\x5f\xc1\x31\x62" +
+		"\x1b\xf7\xef\xa7\x0e\x9e\x02\x8e\xcf\x04\x1f\xd0\xba\x3f\xdc\x1a\x1c\xab\x12\xd1\x2f\xbb\x48\x6d\x29\x48\xc8\x83\x73\x7e\x04\xf6" +
+		"\x91\x47\xf0\xda\x5c\x31\xbf\x34\xf8\x6f\xfc\x13\x3c\x21\x8c\xf9\x85\xf7\xa3\x03\xdb\x4b\x1a\xc8\x2f\x04\xa2\x8c\x97\x85\x1c\xf8" +
+		"\x90\xe0\x2a\x25\xbf\xbf\xf8\x26\xf8\x6e\xe3\x54\x94\x67\xc9\x39\xd9\xa5\x7e\x41\xcf\xdb\xa6\x69\x22\x3c\x7f\x22\xbd\xb6\x61\x24" +
+		"\xc1\xd5\x3f\x00\x17\x4b\xfb\x7e\xa1\xd4\xe0\x62\xd5\x43\xcd\xbf\x0b\x5c\xa7\x18\x63\xcd\x53\x75\xe0\xc1\xa6\xe0\xf1\xb1\x75\xe0" +
+		"\xb6\x05\x22\xba\xc9\xaf\xe9\xae\xe2\xa5\xcf\xb5\x96\x5e\xef\x08\xce\x53\x95\x98\x7c\x8a\x47\x54\xa7\xbf\x77\x70\x1d\xf8\xc1\x8d" +
+		"\xe0\xd3\x42\xd4\x5f\x38\x0f\xec\x2b\x04\xf1\x80\x38\x49\xba\xbf\x8d\xed\xc1\xe1\x59\x07\x8e\x1f\x6b\x03\xbe\x2e\x72\x84\x2a\x45" +
+		"\xdf\x55\xee\xee\xe8\xa5\xe0\x57\xc0\x27\x55\x75\x70\x0a\x38\xfb\x11\x99\x28\xa9\xb7\xb1\x12\x46\x59\xea\xed\x2a\xbc\x5c\xa9\x0c" +
+		"\x62\x3f\x05\x63\xd3\x06\x11\x1c\xac\x66\x30\xeb\x14\x06\x2d\xd8\xa8\xa8\x43\x36\x6d\xbc\x56\xeb\x38\x75\xed\x39\x24\xf8\x6d\xcc" +
+		"\x6f\xc1\x37\xcf\x04\xbf\x90\x0a\x5a\xf8\x89\xb9\xc2\x04\x77\x1e\x95\x01\x68\x0c\xf6\xff\xa9\x7b\x9d\x4f\xdf\x02\x6a\xa2\x77\xfe" +
+		"\x1f\xf8\xd4\xb7\xc1\x83\x27\x81\x3d\xbe\x0d\xae\xbe\x11\x9c\xa9\x53\xee\x53\x24\xf9\xaa\x6a\x26\x66\x1f\x23\xb8\x5b\x45\x37\x1f" +
+		"\x3c\x2d\x56\x46\xbd\x2e\x38\x4a\x38\xe6\x65\xe0\xac\x33\xc0\x97\x6e\x02\x67\x89\xbe\xbd\x47\x33\x39\x55\x4a\x69\x87\x1a\x79\x3d" +
+		"\xa7\x78\xf0\xb5\x47\x12\x70\xaa\xf4\xdd\xc3\x53\x85\x42\xa8\xc3\xc2\x1a\x79\xc4\x23\x64\x4e\x3e\x54\xd9\xe8\xc1\x47\x2a\x62\x47" +
+		"\xea\x1e\x82\xe0\x7b\xff\x06\xdc\x37\x4f\x05\x5a\xab\xc5\x14\x3d\x2e\x06\xec\x1e\xdd\xc8\x18\x15\xee\x89\xce\xbf\x41\x3d\x86\x5e" +
+		"\x91\x9a\x52\x58\xa5\xbe\x42\x6b\x5b\x80\x47\x9a\x81\x5a\x31\x47\x4b\xc0\xa3\x05\x50\xac\x3f\x29\xe1\x15\x39\x50\xc9\x8c\xa9\x1d" +
+		"\x9c\xbc\xbe\xbb\xc2\x83\xa4\x7d\xb5\xde\xab\x5c\x94\xc8\x51\x75\x69\xa6\x44\xa9\x68\x65\x53\x5b\xfe\xbf\x06\x00",
+	"Korea1": "" +
+		"\xd4\xbd\x09\x58\x5d\xd5\xb9\x37\xfe\xfb\xad\xb3\xcf\x3e\x87\xc3\x3c\x04\x08\x21\x13\x99\x87\xc6\x4c\xc6\x38\x54\xe3\x6c\xe3\x50" +
+		"\xf5\x7a\xbd\xd6\x0e\x7a\xdb\xde\x56\x7b\x5b\x6f\xdb\xdb\xde\x8e\x7a\x11\x09\x22\x41\x8c\x88\x29\x46\x12\x13\x24\x88\x88\x88\x91" +
+		"\x52\x44\x24\x48\x69\x1a\x63\x8c\x88\x48\x91\x22\xc6\x18\x31\x45\x4c\x11\x11\x11\x69\xfc\x3f\xef\x7e\x37\x70\x20\x90\xf6\xde\xe7" +
+		"\x7b\x9e\xff\xf7\x3d\x4f\xb2\x16\x7b\x9f\xbd\xd7\x5e\xeb\x5d\xef\xfb\x7b\xc7\x7d\x0e\x6f\xda\x00\xb6\xa6\x12\x7c\xda\x80\x2f\xdf" +
+		"\x0e\xf6\xdd\x49\x70\x9b\x0f\x9e\xc2\xb5\xe0\x83\xcf\x13\xa6\xe3\x76\xf0\xb3\xdb\xc1\x2a\x39\x78\xeb\x3c\xd0\x02\x0f\x0e\xdb\xe0" +
+		"\xe7\x1f\xd9\x30\x7f\xf4\xc3\xd8\x30\xc7\xcf\x43\x60\x3a\xb8\xf7\x79\x82\x57\x80\x1b\xc0\xe6\x4d\x84\xf1\x83\xf7\x12\x5c\x0e\xde" +
+		"\x9d\x41\x98\xf4\x3b\xc0\x9d\x1e\xf0\x6a\x98\xee\x21\x1b\xf4\x83\x7f\xe8\xb2\x41\x0f\xb8\x37\x0a\xcc\x32\x60\x3b\xc1\x47\x2c\x30" +
+		"\x00\x56\x12\x9c\x09\xbe\x9e\x41\xb0\xe4\x0e\x70\x6b\x02\x48\xf0\x3f\x9d\xfb\x08\x86\x3b\xed\x97\x9c\x76\xa1\xd3\xc6\x3b\xed\x6b" +
+		"\x51\x30\x1e\x18\x0b\x3c\x71\x26\xcc\xab\xeb\xc0\x5d\x04\x2f\x01\xcf\x03\x57\xc2\xc4\x82\x97\x82\x36\xcc\x00\x61\x42\xc0\xa7\x09" +
+		"\x52\xee\x60\xff\x80\x0d\x3e\x32\x68\x83\x16\x78\x03\x68\x83\xdb\x37\x11\x6c\xf2\x83\x4b\xc0\x14\x67\x56\x5e\x90\xe0\x0b\x7e\x70" +
+		"\x9f\x1f\xfc\xc8\x0f\x9e\x03\x1e\x97\xcb\x22\xc1\x33\x9d\xb5\xbc\x2e\x47\x07\x84\x90\x4f\x7b\xc0\x42\x9d\x55\xb4\xd3\x76\x18\x18" +
+		"\x9f\xb3\x00\x0b\xf4\x81\x0f\x44\x82\x9d\x61\xb2\x0a\x73\x23\x78\x3b\xcc\x7c\xe7\xb1\x74\xfe\xd9\xe0\x09\x33\x32\x41\x26\x3b\xf7" +
+		"\xbc\xf2\x20\x61\x5a\x6e\x07\x8f\x79\x9d\x63\xcb\x99\x91\x05\x96\xc6\x3a\x23\x5b\x60\xf1\x4a\xf0\x0f\x04\x37\x5f\x04\x1e\xd9\x08" +
+		"\xbe\xb8\x11\xac\xdf\xe8\xec\xc2\x07\x1b\x71\x76\xfd\x27\x36\xd8\x6a\x30\xf7\x55\x03\x66\x1b\xac\x79\xa7\xc6\xc6\x52\x0b\x81\xe7" +
+		"\x36\x20\xb0\x0a\xd1\x4f\xfd\x0c\x3e\x0b\xd1\x51\xd2\xf2\x78\x3a\x41\x0b\x8c\x73\xe6\x1c\xe5\xcc\x39\x0a\x9c\xe3\xac\x76\x8e\xf3" +
+		"\xf7\x4f\x9d\x0b\x12\x83\x2e\x48\x0c\xba\x60\x0e\xf8\xcf\xe0\x2c\x67\xb7\x66\x39\x17\x5c\xe8\xec\xd6\x34\xa7\x8d\x03\x6f\x02\x09" +
+		"\xc6\x38\xad\x77\x6c\x5f\x4d\x0a\x8c\x05\xe3\x77\x5a\x7b\xfc\x3f\x0b\x7e\x0b\x9e\xad\x67\x82\x77\x6f\x04\xb3\x36\xca\x66\x59\xe7" +
+		"\x3b\x0c\x78\x35\x3c\xbf\x00\x53\x09\x23\x7c\x77\x07\xcc\xf9\x08\x44\xc1\xfb\x23\xd8\xdf\x87\xf7\xeb\xe0\x9f\xbf\x04\x53\xb9\x11" +
+		"\x9e\x1f\x80\x79\x04\xe7\xc2\x73\x9a\x73\xf7\xb7\xc0\x6f\x82\xd3\x41\x1b\x9c\x0d\x86\x39\xb3\x5b\x06\x7e\xfe\x12\x9d\x59\x5e\x0e" +
+		"\x0e\xd2\x59\x59\x12\x68\x81\xaf\x12\xec\x27\xf8\x94\x01\x2f\x03\xb7\x18\xf0\x0c\x24\x97\x35\x12\x49\xaf\xaf\x43\xe4\xfd\x06\x7c" +
+		"\x3b\x87\x30\x65\x69\x72\x91\x34\x16\xac\x1a\xe9\xff\x2a\x7c\x71\x3d\x78\x3e\x78\x9b\x33\xb4\x32\x7b\x94\xf3\xc7\x03\x04\xab\x08" +
+		"\xbe\x47\xf0\x5c\xb0\x99\x32\x2c\x3d\x48\x3e\xde\x40\x24\xfd\xed\x74\x44\xca\xdd\xf7\xdf\x47\x98\x70\x30\xed\xbf\xc1\x78\x58\x16" +
+		"\xfe\x69\xe8\x5c\x5c\x9f\x0c\xfb\xa5\x67\x08\x2e\xc2\x9c\xa5\xe0\x7a\x24\x9d\x0e\x73\x64\x87\x17\xb4\x61\xd9\xf0\x87\xc1\x6b\xc9" +
+		"\xdf\xf4\xc3\x44\xc1\x63\xc1\x44\xb8\x87\x8c\x07\x2d\x30\xc2\xf9\xdb\x0f\xb3\x58\xce\x1b\x1b\xb4\x60\xc2\x60\x59\x72\x3b\xfd\x63" +
+		"\x37\x7a\x22\x9c\x8f\x6c\xf7\x50\x3e\x1a\xb9\x40\xf6\xd1\x82\x89\x19\x1b\x81\x16\x3c\x3a\x94\x33\x9a\x7b\xa5\xfe\x6d\x8d\x7b\x2e" +
+		"\x63\x9c\x31\x23\xc6\x26\x79\xf2\x05\xa3\x83\x5b\x36\x3c\x81\x89\xc3\x9a\x44\xe7\xe2\x85\xee\x35\x63\x83\xc4\x8c\x5b\x9a\x47\x9f" +
+		"\x32\x32\xb8\x59\x3f\x6e\xbd\x3a\x26\xfd\xce\xd2\xac\xb1\xd9\xea\x4a\x8d\x3d\x36\xb2\x4b\xc3\x08\x78\x6e\x76\xaf\x1c\x47\xd8\x44" +
+		"\xa7\x0d\x26\x6c\xbc\xb3\xd3\xb6\xfb\x44\x9d\x80\xce\x50\xee\x0a\x38\x93\x49\x74\x6e\x5f\x1c\x74\x57\x94\xf3\xc7\xc8\x3c\x65\x62" +
+		"\x11\x23\x93\x0c\x03\xa3\x46\x6e\x19\x5d\xaf\x0d\xea\x2d\x7a\x18\x3f\xee\x71\xb4\x5c\xba\xb9\x13\x3e\x77\x22\xb5\x75\x70\xcb\x86" +
+		"\x51\x86\x71\xe6\x36\x39\xdb\x44\x81\x01\x98\x4b\x5c\x72\x19\x67\x09\xc2\x1e\x61\x93\x5f\x6f\xa2\xc6\xa8\x27\x87\x2b\x27\x61\x06" +
+		"\x4f\xc0\xfd\x54\xa8\x77\x59\xd0\x2a\xac\xb1\x3d\x55\x36\xe0\xc8\xc8\xca\x87\x63\xac\xb2\xd8\x99\xd8\xfa\xb1\x7b\x85\xec\x11\x2e" +
+		"\x19\xf5\x16\x8f\x7f\xdc\x46\xd3\x1a\xc7\x1e\x13\xa6\xc4\x80\xcb\x09\x5e\xe7\x46\xaf\x25\x6d\xf0\x02\x85\x01\x7e\x38\x22\x11\xba" +
+		"\x8f\x23\xa4\xa6\x73\x66\xc2\xc5\xf4\x07\xf1\xea\x08\x1d\x46\x85\xc5\xf8\x83\x6e\xb7\xc6\xc8\xc5\xc5\x23\x4b\xf3\x8f\x63\xec\x51" +
+		"\xae\x98\x84\x08\x4b\x27\xca\xce\x38\x69\xd2\xed\x8b\x98\xc8\xfc\x13\x84\xc2\x44\xc1\x0a\x16\xb1\xa8\xc9\xb6\xf8\x8e\x91\xc3\x29" +
+		"\x78\x40\xa7\x64\x2e\x73\x4f\x32\xc9\x39\x13\x25\x27\x75\xa7\xcc\x2d\x23\xd7\x47\x80\x01\x47\x59\xaf\x1f\xc7\xff\xe6\x92\xf1\x7b" +
+		"\x1d\x33\x8e\xc3\xc7\xd1\x64\x3c\xcb\x59\x16\x2c\xff\x64\x0c\x39\xcb\xb9\xf2\x92\x29\xd7\x75\xf2\x50\x63\xdb\x97\x34\x8e\x21\x27" +
+		"\x22\x55\x0a\xb8\xee\xa4\x9d\xb2\x26\xd9\x0b\x13\x31\xe5\xde\x8d\x6e\x2b\x55\xa2\x27\xbb\x78\xc2\x19\x57\x51\x9e\x33\xf9\x65\xde" +
+		"\x60\x70\xb3\xc7\x09\xb5\xbb\xbf\x11\xb2\xae\x51\x82\x04\x83\xa1\x27\x30\xb9\x44\x7b\xe2\x5d\x01\x31\xeb\xc7\x44\x69\x02\xb0\x9b" +
+		"\x39\xce\x6e\x4e\xc5\x84\xce\x19\x4f\xcc\x18\xe6\x8c\x41\x93\x35\xfe\xae\x98\x31\x96\xe0\xe2\x20\x1e\xb6\xc7\x98\x41\x0f\xcd\xc6" +
+		"\x71\x2c\xed\x19\x11\x25\xaf\x2d\xff\x4c\x30\xfa\x8d\x07\xf3\xb1\x33\x49\x30\x57\x4d\xa2\x14\x54\x0a\x8c\x3d\x91\x0e\x8c\x19\xa7" +
+		"\x07\x3d\x2b\xc7\xd4\xc1\xb8\xf5\xce\x71\x79\xde\x45\xe9\x20\xee\x55\x10\x08\xa6\x80\x8b\x03\x51\x0e\x12\x06\xf3\x43\xd2\x78\x11" +
+		"\x9e\x8c\x37\x94\x02\xc1\xdb\xe1\xea\x17\x7b\x9c\x3a\x9b\x04\xa8\x67\xc1\x5c\x3d\xb2\xf0\x28\x47\x71\x47\x80\x4b\xe5\x23\x33\xcb" +
+		"\xb9\x26\x48\x5e\xe8\x07\xef\x70\x36\x65\xe9\x78\xa5\xe0\x4c\x89\x55\x1c\x07\x40\x26\xca\xa5\xe9\x04\xd6\x34\x23\x1b\x3f\xa5\x7e" +
+		"\x9d\xca\x1a\x70\xf0\xcb\x1b\x31\x06\xbb\x1e\xe5\x45\x6b\xca\x11\x82\xf1\x97\x49\xae\xaa\x9b\xc8\xb5\x41\x73\x98\x40\x6b\x63\x4f" +
+		"\x94\xea\x93\x21\x60\x12\x9d\xa4\x22\x67\x8d\xdb\xf8\x60\xf0\x9d\x78\x7b\xc4\xc4\x7d\x35\xfe\x31\x7b\x65\x14\x07\x3d\x11\x63\xfa" +
+		"\xc9\x8c\x68\x32\x55\xb1\xc1\xfb\x6a\x6c\xd9\xa1\x09\x52\x6d\xec\xa0\x75\xdd\x32\x4e\x03\x09\x1d\x46\xd4\xe4\x28\xbf\x4e\x6e\x4f" +
+		"\x04\x99\x26\xe3\x94\xee\x8d\x63\xeb\x72\x35\x7a\x7c\x10\x0d\x13\x27\x59\xb2\xc7\xc1\x23\x8f\xb3\x83\x6a\xf1\x4f\xba\x83\x9c\x20" +
+		"\xbd\x41\x00\x61\xe2\x61\x6c\x58\x16\x7c\x61\x41\x34\x9f\xcc\xb2\xd1\x1b\xed\x00\x2c\x7f\x10\x67\x5a\xee\xd6\x78\xfc\x63\x06\xab" +
+		"\xfe\x33\x93\x62\x5f\xe2\x3f\x0a\xca\xb4\x26\xda\x1c\x3c\xc9\x24\x0a\x3e\xa4\x7f\xfc\xe1\x14\x63\x06\x4f\x8c\x61\x2e\x83\xf9\xad" +
+		"\xf1\x38\x18\xe3\x3e\xd7\x13\x80\x35\x4a\x31\xdb\x05\x44\xb5\xec\xcd\xa4\xb6\x9a\x35\x02\x3d\x81\x89\x5e\xc1\x04\xc5\x60\xc2\xa6" +
+		"\x14\x4f\x5d\x5a\xb0\xd9\x1a\x0c\x46\x1e\x65\x9b\x20\x7d\x60\x26\x98\xbc\xc1\x0b\x4f\x99\x84\x86\x27\x6b\xca\x09\x96\xc1\xe4\xcc" +
+		"\x76\xc9\x64\xb0\x3e\x6a\xa5\x8d\x1a\x6d\x81\xa9\xa1\x23\x65\xa2\xd1\xa0\x10\x39\xc1\xc4\x99\xb8\x53\x11\x2e\x8e\x4f\x94\xa6\x0d" +
+		"\x13\x77\xdf\x25\x85\x1a\x40\xeb\x1d\x19\xb4\x27\x5a\x2a\xc6\x3f\xa2\x92\x47\x5c\x88\x93\xb7\xd2\x9d\x6d\xd0\x56\x4e\x98\xf6\x94" +
+		"\xd0\x3a\x67\x64\x86\x29\xa7\x84\xdf\xa4\x31\xbd\x3e\xee\xd3\x39\x93\x90\x7d\x74\xb3\xe4\xfa\x95\x53\x1b\x46\x53\xa1\xe2\x25\x13" +
+		"\xdd\xb6\x53\xcb\x88\xbb\xba\xc5\xe3\xac\xc6\x53\x6b\x93\x51\xa2\x9d\x6c\x4f\x4f\x70\x78\xc6\xb6\x5b\x75\xf9\xfa\x53\x92\x28\x62" +
+		"\x9c\x76\x9c\x74\xc9\xe3\xc8\xe5\x1f\xb7\x4d\xe3\x74\x8a\x83\x0c\x93\xb3\xe5\xd2\x89\x08\xff\x8f\xec\xaf\x59\x79\xd2\x05\x81\x11" +
+		"\x85\xb8\x72\x04\xcc\x67\x8d\xf3\x07\x4e\x6d\xaa\x8e\xa9\x83\x53\x3b\x18\x31\xe3\x7d\xb3\x29\x50\xfa\xd4\x5e\xb7\xb9\xed\x1f\xb5" +
+		"\x13\x46\x81\x88\x51\xe3\x22\x08\x13\x2f\x5e\x3a\xc2\xcf\x2b\xa7\x8c\x65\x98\xb0\xc9\xae\x9f\xc2\x0c\x9d\x30\x87\x60\xb3\x6c\x12" +
+		"\xd6\x0a\x04\x79\x80\xff\xc0\xfe\xfe\x83\x52\x30\x2e\xb6\x12\x3f\xc9\xb3\x82\x69\xcb\xf8\xa0\x11\x66\x8d\x9f\x5e\xcc\xa9\x62\x31" +
+		"\x3a\x26\xed\x89\xab\x9e\x20\xce\x4c\x3a\xd5\x84\x27\xd8\x3c\x96\x35\x26\xec\xc1\x01\xa6\xa9\x54\x24\xf7\xee\xb2\xc0\xef\x83\x25" +
+		"\x1e\xf0\xa8\x01\x0f\xd9\xa0\x04\xbf\xb2\x0d\x78\x98\xe0\x7e\x0b\xec\x8d\x01\xbb\x25\x22\xbb\x0c\xa6\xd3\x02\x7f\x08\xd6\x84\x83" +
+		"\x3d\x73\xc1\x54\x3f\xd8\x1f\x0d\xd6\xa5\x80\xd7\x82\xc5\xd3\x9c\x78\x60\x87\x0d\x16\x05\xc0\x1c\x0b\x6c\xb3\xc0\x56\x0f\xd8\xb9" +
+		"\x08\x7c\x66\x87\x01\xfb\x2c\x70\xa7\x01\x0f\xae\x02\xfb\x3d\x60\xc5\x12\xb0\x78\x0e\xb8\xf5\x74\xb0\x3e\xc5\xe1\xa3\xc2\xd3\xc0" +
+		"\xbd\xf9\x06\xcc\x09\x80\xc7\x92\xc0\x7e\x03\x76\x10\xcc\x8f\x71\xc8\x54\x10\x0e\xf6\xf9\xc0\xec\x08\xf0\xa0\x01\x8b\x0d\x58\x91" +
+		"\x0c\x1e\x4f\x02\x87\x0c\xd8\xe2\x03\x8b\x2d\xf0\xb8\x07\x6c\xb4\xc0\xc1\x68\xb0\xd0\x07\xda\x60\xff\x5c\xb0\x69\x29\xf8\x4a\x81" +
+		"\x01\x8f\x26\x83\x95\x17\x83\x43\xc9\x60\xe7\x12\x30\x2f\x04\xec\x58\x00\x76\xc7\x80\x77\xf9\xc0\xca\xf3\xc1\x47\x1e\x30\x60\x4f" +
+		"\x34\x58\x2d\x83\xc5\x81\xc5\x91\x60\x9d\x01\xf7\x11\x4c\x0b\x07\x3b\x43\xc0\xae\x10\xb0\x3c\x12\x1c\x9a\x01\x56\x09\xe5\x2c\x70" +
+		"\x9b\x07\xcc\x8b\x07\xbb\xaf\x84\x69\x8c\x00\x33\xbe\x00\xbe\x23\xe9\x82\xdc\x85\x60\xf6\x37\xc1\xe6\x15\xe0\x81\x8b\xc1\x8a\x70" +
+		"\xf0\x53\xa1\x77\x7b\x3c\x58\xe2\x03\xb3\x42\xc1\x1e\x82\xf5\x36\x98\x61\x1c\xe4\x69\xf7\x83\x4d\xcb\xc0\xaa\xf9\x8e\x42\xac\x9c" +
+		"\xa9\x8b\xfe\x35\x58\xe5\x03\xb7\xf8\xf4\x71\x87\xc3\xc1\xc3\x11\x60\xa5\x0f\x6c\x98\x0d\x16\x86\x83\xfd\x09\xe0\x27\xbf\x35\x4e" +
+		"\x60\xbe\xc4\x02\x0f\xfa\xc0\xda\xe9\x60\xd3\x3c\x87\x94\xd7\x81\x85\x91\xe0\x91\x18\xf0\x50\x1c\x58\x92\x04\x36\x78\xc0\x9d\xd4" +
+		"\x73\xb5\xc9\xe0\x41\x3f\x58\x7d\x36\xb8\xdf\x07\x3e\x98\x6a\xc0\xc6\x44\x70\x57\x34\xd8\xf8\x20\xc1\xec\x30\x70\xf0\x52\xf0\x21" +
+		"\x99\x69\x71\x04\x98\x97\x02\xee\xb3\xc1\x96\x58\xb0\xda\x80\x6d\x5e\x07\xd1\xfb\xe6\x83\xf9\x01\xb0\x33\x05\x4c\xbf\x16\x4c\x23" +
+		"\x58\x17\xa2\x73\xef\x32\xe0\x47\x12\xfb\xed\x25\xd8\xe9\x05\xf7\xac\x03\x07\x3d\x60\xbe\x1f\x2c\x9c\x0b\x56\xde\x0c\xee\x8b\x05" +
+		"\xbb\x66\x83\x1d\x71\x60\x4f\x12\xf8\x90\xc4\x90\xbb\xfc\x60\xdd\x57\x35\x3b\xf2\xc1\xd7\xc0\x4c\x1b\x0c\x80\x3b\x6f\x06\x2b\xd7" +
+		"\x82\x25\xb7\x82\x99\x6b\xc0\x57\x2b\x0c\x98\xb9\x0e\xcc\xd8\x4b\xf0\xb5\x13\x04\x1b\x2e\x06\x33\x43\xc0\x1a\x61\xfc\x70\x70\x4b" +
+		"\x08\x38\x18\x07\x6e\xb9\x14\xdc\x33\x07\xec\x5a\x03\x6e\x1f\x22\xd8\x7b\x2b\xf8\x0d\xb0\x22\x04\xec\x9e\x0b\x1e\xf3\x80\x83\x7e" +
+		"\xb0\x71\x1e\xd8\xec\x03\x3b\x63\xc1\x8e\x35\xe0\x7d\xef\x0b\xc9\x16\x81\x95\xa1\xe0\xe0\x19\xca\x84\xcd\xcb\xc0\x97\x8f\x10\x2c" +
+		"\x38\x0f\x2c\xbe\x15\x1c\xfc\x22\x38\x9c\x0c\x76\x8b\xe8\x09\x67\x1d\xb2\xc0\xad\xb2\xd7\x06\xdc\x42\x70\x40\xad\x64\x11\xc5\x23" +
+		"\x91\xca\xc5\xe5\x89\xca\xc9\x07\x7c\x8e\xa4\x36\x86\x81\xb5\x06\xcc\xb0\xc0\x7c\x82\x3f\x56\x72\x1f\x8e\x05\x87\x43\xc1\x36\x82" +
+		"\x1d\x11\x60\x18\xb8\x11\xdc\x61\x81\xc5\x01\x50\xb6\xad\xcb\x07\xae\x00\x77\x84\x80\x05\x04\xd3\x03\xce\xee\x0b\x5b\x0f\x84\x80" +
+		"\x3b\x67\x83\x9d\x11\x60\xaa\x0c\x1b\x09\x36\x46\x81\xdf\x03\x1b\xfd\x60\x81\x0d\xbe\x54\x61\x34\x25\x73\x28\x14\x2c\xb7\xc0\x22" +
+		"\xc9\xe4\x08\xed\xa2\xc1\x4a\x2f\x78\x38\x51\x45\xf4\x87\x60\x7a\x02\xd8\x98\xa4\xa2\x5f\x16\xa2\x3b\x3a\x1c\x00\x0f\x7b\xc1\x14" +
+		"\x30\x7f\x05\xb8\xc7\x80\xb5\x02\x35\x4b\xc0\x9e\x64\x70\xef\x63\xc6\xd9\xb8\x06\x82\x57\x81\xb9\x01\xb0\x3c\x14\x2c\xb6\xc1\x72" +
+		"\x61\xec\x10\x30\x3f\x1a\x4c\x0d\x01\x93\xc1\x1b\xc1\x0e\x4b\x17\x27\xd3\x18\x0c\x05\x4f\xcc\x07\x4b\x04\x25\x3c\x60\x5a\x28\x58" +
+		"\x3a\x0d\xec\xa4\x52\x4a\xce\x95\x7b\x9d\xc5\x77\x79\x14\x09\x64\x40\x61\xd8\x23\x3e\xf0\x78\x2c\x58\x14\x01\xee\x89\x50\x91\x3b" +
+		"\x12\x02\x0e\x44\x81\x2f\x39\xe3\x5d\xa0\xf7\xd6\xc8\x07\x97\x80\xc7\x2f\x05\x9b\x2f\x02\xdf\x7a\xc8\x80\xc7\xa8\x42\xdb\x3d\x0f" +
+		"\x6c\x0d\x38\xba\xff\x48\x22\xd8\x22\x1c\x7a\x0d\x98\x7d\x25\xf8\xc9\xf3\x04\x53\x9f\x25\xf8\x50\x99\xd1\x67\x07\x74\xf6\xdd\x29" +
+		"\x60\x61\x00\x6c\x0d\x01\xcf\x02\x33\x03\x60\x8f\x01\x4b\x6c\xb0\xf6\x2c\xb0\x21\x09\x6c\x5e\x09\x9e\x48\x00\xdb\x97\x81\xcf\xee" +
+		"\x36\x60\xe7\x3a\xf0\x50\x22\x58\x12\x0f\xa6\x9e\x0e\x76\xc7\x82\xe9\x8b\x15\x23\xaf\x07\x7b\xc2\xc0\x83\x21\xe0\x50\x28\x38\x10" +
+		"\x0b\xbe\xfb\x2e\xc1\xd2\xe5\x60\xf9\x0f\xc1\x8e\x65\xe0\x43\x7f\x23\xd8\xbb\x08\x2c\x21\x78\x7c\x1a\x58\x3e\x4b\xa5\xa0\x20\x0e" +
+		"\xdc\x17\x09\x36\x0b\x3e\xcd\x50\x4e\xe8\x8e\x06\x07\x12\xc0\xd6\x50\xf0\x88\x48\x6f\x38\xf8\xd1\xc3\x32\x8b\xf5\xe0\x8e\x00\x58" +
+		"\x56\xe9\xe4\x65\x0c\x78\xc2\x06\x2b\x7c\x60\x6f\x3c\x98\x97\x08\x0e\xcd\x06\x2b\xa6\x83\x8d\xe1\x9a\x05\x14\xe0\xe9\x9f\x09\x36" +
+		"\xc4\x83\x2d\x57\x82\x7f\xb9\xcb\x80\xdd\x1b\xc0\x9d\xff\x01\x3e\xde\x48\xb0\xc5\x0f\xe6\xcd\xd6\x3d\xcc\x9f\x09\x0e\x79\xc0\x66" +
+		"\x1b\x4c\x4d\x06\x8f\xfc\x81\xe0\x3b\x27\x08\xe6\x66\x50\xf7\xa9\xe3\x2c\xf0\xe3\x34\xa3\xc0\x58\x15\xa9\x6c\x5b\x3f\x0d\x2c\x11" +
+		"\xb5\x43\x30\x63\xa6\x13\x08\x2f\x0e\xd5\xa1\xcb\x52\xc0\xf6\x08\xb0\xc1\x0b\x76\x4e\x03\xab\x02\xaa\xea\x44\x52\x0a\x6d\x70\xff" +
+		"\x1c\x15\xc3\x41\x2f\xd8\x37\x07\x2c\xf2\x82\x65\x5e\x70\xb7\xa0\xf9\x8d\x8e\x52\x6a\x91\x59\x5a\x60\xb6\x0d\xe6\xd8\x60\x3a\xc1" +
+		"\x7d\x06\xb4\x94\x6a\xd9\xb6\x8e\x7e\x23\x78\x20\x0a\x4c\xf5\x81\xb9\x5e\xb0\x2d\x4c\x45\xac\x2f\x04\x3c\x14\xe2\x68\xc7\x46\x81" +
+		"\x78\x5b\x21\xfe\x0a\xb0\x8c\x60\x55\x88\x02\x79\x5d\x88\xa6\x2e\xdb\x65\x2a\x8a\xce\xdb\x02\x60\x6e\x18\x98\x17\x50\x09\x8e\x01" +
+		"\x03\x60\xa9\x07\xcc\x88\x07\x0b\x43\xc1\x5c\x1f\x98\xe9\x03\x0b\xbd\x0a\x23\x5d\xa2\x7d\x09\x56\x27\x83\x5d\x04\xf7\x07\x54\x04" +
+		"\xb2\x7c\xe0\xae\x42\x21\x7c\x1c\x98\xb7\x83\x60\xd9\x97\xc1\x78\x30\x37\x19\x7c\xba\xd4\x80\x43\x0f\x11\xcc\x8f\x03\xbb\xe7\x83" +
+		"\xc7\x12\xc0\x12\x3f\xf8\xc2\x3b\x04\xb7\x44\x83\x3b\x67\x82\x39\x5f\x03\xdf\xfe\x15\x78\x20\x1c\x3c\xf8\x03\x07\x21\xea\x67\x83" +
+		"\xb5\x2b\xc1\xcd\x43\x54\x71\x4c\xf5\x80\xb2\x59\x85\x11\x60\xeb\x69\xfa\x77\xc5\x2c\xb0\x4f\x94\xf9\x02\xf0\x51\xd9\x9f\xa3\x77" +
+		"\x80\x85\x97\x81\x05\xf3\xc1\xba\x99\xe0\x6f\x6b\x64\x7b\x23\xc0\xf6\x18\x70\xe8\xdf\xc1\xba\x68\xf0\xc4\x12\xf0\xb1\x97\x08\xa6" +
+		"\xc5\x81\x83\x42\xf0\x2d\x67\x81\xbb\xb6\x1b\x30\x3d\x1c\x6c\xf0\x83\xbd\x4b\xc0\xee\x44\x35\x2f\xaa\xa2\xc1\xee\x10\x67\x49\x7d" +
+		"\x7e\xf0\xf8\x4c\xb0\x32\x0e\xdc\x1f\x09\xa6\x87\x82\x5d\xd3\xc0\x37\xb2\x8c\x8b\x24\x3f\x07\xf7\x24\x82\x6d\x73\xc1\xa2\x33\xc1" +
+		"\xcd\x8f\x88\x88\x7e\x5b\x19\xe9\xe7\x60\xc5\xb9\x60\x5f\x12\x58\x63\xc0\xfd\x95\x04\x1f\xfb\x8d\x01\xeb\x7c\x60\x2a\xc1\xba\xb3" +
+		"\xc1\x86\x48\xb0\x34\x00\x66\xfb\x74\xa2\xdb\x66\x80\x1d\x33\xc1\xa3\x16\x98\x93\xa0\x3c\x52\x11\x03\xf6\x2e\x00\xff\xf6\xb8\x01" +
+		"\xb7\x2d\x03\x07\x96\x83\x27\x2c\x70\x28\x1a\x3c\xb8\x10\xdc\x36\xcd\x79\x5a\xdb\x3c\x98\xd2\x04\xb0\xde\x03\xe6\x2c\x02\x7f\xbb" +
+		"\x45\x84\x2a\x06\xac\x5a\x05\xe6\x87\x80\x69\x8b\xc1\xa6\x0b\x14\xf0\x1f\x7e\x8e\x60\x5e\x28\xd8\x2f\xdb\x55\x3c\x0f\xfc\xf3\x3e" +
+		"\x3a\x3a\xb6\x29\x04\x8c\x03\xab\xa3\xc1\x7c\xd1\xc6\x1e\xe5\x26\x0b\xac\xb0\xc0\xef\xe8\xed\x47\xfc\x60\xbe\x07\x1c\xa6\x02\xf8" +
+		"\x36\x03\x16\x78\xc1\x63\x82\xb9\xe1\x60\x22\xcc\x80\xe5\x84\x28\xdb\xbd\x60\x38\x58\x65\x83\x55\x22\x8e\x1e\x50\x28\x11\x00\xb3" +
+		"\xbc\x60\x61\x02\x58\x60\x40\x3f\x58\x41\xf0\x02\x65\xca\x08\x30\x3d\x4c\x35\x41\x11\x1d\x93\xfb\xdb\x60\x95\x1f\x6c\xf5\xaa\x19" +
+		"\x57\xe0\xd3\x47\x5f\x05\xd6\x11\xcc\x5f\x08\x1e\xb7\xc0\x3e\x0f\xd8\x69\x81\x2d\x6a\x6e\x0f\xc7\x83\x9d\x84\x19\x32\x2a\x20\xed" +
+		"\x51\xe0\x7d\x77\xc9\x36\x08\x94\x16\xc7\x80\x07\xce\x06\x0f\x4e\x03\x87\x76\x13\x7c\xa9\x97\xe0\xdb\xd1\xaa\x95\xf3\x66\xeb\x5c" +
+		"\x6f\x06\xf7\x57\x11\x7c\x31\x1e\x7c\xe5\x7e\x82\xa9\xcb\xc0\x6d\x5b\x65\x90\x43\x9a\x4b\xfe\xc3\x06\xb0\xf7\x6a\x45\xe5\xe2\x38" +
+		"\x30\x73\x06\x58\x36\x1b\x3c\xf0\x05\xf0\x95\x7d\x54\x43\xf3\xf1\xd3\xc0\xfd\x61\x6a\x50\x35\x9d\x05\xd6\xdf\x0c\x3e\xbb\xcd\x65" +
+		"\xca\x83\x89\xe0\xe1\xeb\xc0\xc7\xbf\x0b\x1e\x27\x58\x1d\xa2\xd6\xcc\x63\xb3\xc0\xd9\xe0\x1b\xf3\xc1\xbc\x19\x60\x77\xbc\x4e\x29" +
+		"\xe7\x1a\xb0\xf7\x41\xd9\xc3\x12\xaa\xd9\xd4\x75\x06\x78\xa7\x6c\x68\xf7\x9d\x04\x5f\xce\x23\xf8\x84\x17\x1c\xac\x25\xf8\x5a\x16" +
+		"\xc1\xd2\xeb\xc1\xf6\x48\xf0\xce\x07\x08\x36\x5f\x00\xbe\xf4\x1d\x70\xe0\x07\xe0\xde\xeb\xc1\x6a\x19\xe6\xd3\xd7\x08\x0e\x51\xad" +
+		"\xea\xa6\xe9\x60\x8b\x80\x9c\x28\x57\x31\xe6\xa6\x3b\xd6\x4b\x65\x8c\xda\x1c\x15\x01\xb0\x7e\xa1\xee\x51\xa7\xa5\x30\xd5\x3e\x0f" +
+		"\xcc\xf1\xa9\xf1\xdd\xec\x07\xf7\xd6\x10\xcc\x58\xae\x08\x34\x2c\xc6\xdf\xf7\xc0\xcf\xde\x27\x78\x5c\xae\x5e\x02\x56\x86\x29\x16" +
+		"\x55\x26\x6b\x36\xbf\xc8\x35\x45\x8e\x27\x82\xc5\x4b\xc0\x7e\x2f\x98\x29\x9c\x10\x0d\x66\x7a\xd5\x54\xcd\x9a\x0d\xfe\x46\xc8\x96" +
+		"\x3a\x0f\x4c\x4b\x04\x07\x36\x82\x05\x21\xe0\x43\x1f\x10\x3c\x1a\xaa\xfb\x2c\xca\xe3\x40\x04\x58\x1e\xd0\xbb\xcb\x56\x83\xf5\x33" +
+		"\xc1\x9a\x65\x60\xf7\xd7\xc0\x7b\x04\xe0\x0e\xa4\x80\x6b\xc0\x3e\x1b\x3c\x18\x0a\xee\x37\x8a\xa7\xe2\xb6\x74\x06\xc0\xf6\x00\x58" +
+		"\x74\x36\x98\x73\x26\x58\x98\xa4\xbc\x53\xb4\x48\x0d\xc9\x27\xee\x35\xe0\xd1\x28\xb5\x2e\x5f\xb8\x00\xec\xf0\x39\x8c\x72\x30\x16" +
+		"\xac\xb3\xc0\x8b\xc0\xe3\x11\x0a\x9f\xa2\xae\x05\x14\xab\x2c\xb0\xcd\xa3\xd8\x5f\x1a\xa2\x36\xaa\xf0\x7d\x5b\x94\x2a\x6c\x81\xdf" +
+		"\xb2\x30\x30\x97\xba\xf5\x3b\x2d\xb0\xd2\x0f\xd6\xc6\x2a\x7a\xf4\x8a\x66\x93\xeb\x16\x81\x07\xe2\xc1\xcc\x70\x30\x5b\xc6\x72\xad" +
+		"\xbd\x14\x47\xd6\x5a\xe4\xce\x10\xb0\x59\x54\xb8\x17\x7c\xa0\x82\xe0\x31\x99\x79\x08\x98\x47\x30\x5b\xec\xb4\x48\xe5\xb0\x03\x82" +
+		"\x6e\x01\xf0\xc0\x1a\xf0\x49\xc1\xc7\xda\x39\x60\x5d\x0c\x58\x6e\xc0\x34\x0f\x58\x90\x00\x16\xc5\x80\xad\x06\x2c\xfc\x1e\xd8\xb7" +
+		"\x02\x7c\x49\x76\xa1\x44\x86\xfd\x63\x39\xc1\x1d\xa1\x8e\xcc\x6e\xb1\x54\xfe\xaa\xd6\x81\x79\x09\x60\x9d\x07\xdc\x21\xe0\x13\x05" +
+		"\xd6\x86\x80\xa9\xd3\x75\xdb\x8f\x86\x81\x3b\x04\x67\xc3\xc1\xc6\xf5\xe0\xab\xe9\x06\x1c\xb8\x5a\xb5\xca\x6d\x60\xd6\x99\x6a\x0a" +
+		"\xb7\xa5\x28\x24\x89\x53\x51\x36\x07\xfc\xec\x38\xc1\x42\x61\xf9\x4a\x0f\x78\x68\x1d\xf8\x35\xb5\xfc\xde\x7f\x91\xe0\x47\x3e\x70" +
+		"\x70\x35\xd8\x39\x13\xec\x8a\x01\x0b\x62\xc1\xaa\xa5\x60\xbd\x98\x23\x89\x60\xee\x7c\xf0\xd5\x5c\x03\x76\xae\x02\xbb\xae\x01\xab" +
+		"\xe7\x83\xfd\x49\xe0\x80\x98\x94\x96\xda\x2f\xef\xdd\x4b\x70\x38\x02\xcc\x0a\x03\x33\x16\x82\xd5\x33\xc0\xf6\xb5\x60\x4e\x0a\xf8" +
+		"\xe6\x93\xa2\x11\x63\xc1\x1d\xdf\x00\x93\xc1\xb6\x38\xb0\xc6\x07\x16\x5d\x0d\x1e\xb9\x09\x7c\x54\xbc\x88\xf2\x8f\x09\xee\x96\x09" +
+		"\x95\xce\x52\xf3\xa1\x25\xc1\xa9\x0c\x62\xc5\x2a\x70\x4f\x08\x78\x24\x19\x2c\x17\x1b\x91\xe0\x7b\x0f\x0b\xf9\x7e\x0d\xe6\x4c\x07" +
+		"\x1f\xdf\x4d\x70\xdb\x5c\xb0\x78\x3a\x58\x24\xca\xd4\x52\x53\xa1\xf8\x0a\xb0\x7a\x01\xf8\xd4\x26\x03\xe6\xac\x53\x33\xbc\xe5\xfe" +
+		"\x91\x07\x35\x5f\x0a\x66\x5d\x0a\x7e\x20\x22\x51\xe1\x05\xf7\x89\xf6\x26\xd8\x7b\x23\xb8\xd3\x06\x6b\x34\xea\xf1\x7d\x70\x4b\x3c" +
+		"\xb8\x6f\x2e\x98\x1b\xad\x20\xfd\x74\xa6\xec\xb2\x80\xa4\x0d\x6e\xf3\x82\x2d\x4b\xd5\x9a\x17\x27\x28\x27\x02\x2c\x15\xa5\x17\x07" +
+		"\xb6\xcd\x07\x8f\xae\x06\x73\x84\x85\xd6\xa9\x5b\x1b\xa5\xf2\x70\x1b\xf8\x6e\x85\x01\x5b\xaf\x56\x3f\xef\x60\x8c\xaa\xe2\xe3\x37" +
+		"\xa8\x42\x7f\xa7\x95\xea\xff\xef\x0b\x03\x53\x17\xab\x13\x98\x1a\x0e\xb6\x2e\x50\x77\xe1\x44\x34\xd8\xbb\x58\xed\xb4\x43\x61\xae" +
+		"\xfb\x57\x49\x55\x3c\xcd\xb7\x2a\x78\xf4\xdf\x04\x7e\xf8\x18\x55\x93\xb6\x89\xdd\x2a\x92\xb7\x0a\xbc\x7f\x1e\xb8\x2b\x57\x75\x04" +
+		"\x33\x66\x83\x69\x09\xaa\x79\x1b\x43\xc0\xb2\xab\xc0\x81\x99\x60\x7b\x34\x78\x8b\xca\x5b\xc5\x79\x60\x99\x1f\x4c\x8f\xd5\x5b\x64" +
+		"\xa5\xb9\x33\xc1\x27\xf6\x1a\xb0\x3a\x95\x60\xce\x62\x30\xfb\x66\x30\x23\x0e\x7c\xfa\x3e\x03\xb6\x5c\xe2\x68\x56\xe1\xd9\xad\x31" +
+		"\xaa\x7a\x7a\x7d\x60\x79\x2c\x58\x39\x1f\xcc\x4a\x54\xc0\x29\x9e\x05\x96\x59\x60\xfb\x1c\x70\x28\x0a\xdc\x1a\x00\x07\x08\xbe\xfa" +
+		"\x1b\xaa\xb7\xd0\x10\x05\x36\x0b\x5c\x9d\x03\x0e\x7c\x01\x5c\x0c\x7e\x7a\x15\xf8\xc1\x1f\x09\x1e\x48\x04\xf7\xcd\x01\x53\xe7\x83" +
+		"\x4d\x31\x60\xe1\x2c\xb0\x36\x0a\x7c\xf6\x79\x99\xd1\xaf\xc1\x7e\xbf\x22\x72\xef\x97\x1d\x13\x72\x2d\x98\xb1\x18\x7c\x7b\x97\x48" +
+		"\x62\x08\x58\xb2\x1c\x6c\x0a\x05\x33\x44\x45\xac\x04\x8f\x45\xab\x9e\xac\x49\x01\x8f\xc6\x80\xa9\x2b\xc0\xd6\xa5\xe0\xc1\x68\xf0" +
+		"\x2f\xa2\xa7\x9b\xbd\x6a\xf5\x1c\x59\x07\xe6\x44\x2a\xa4\xb7\x25\x83\xc7\x66\xa8\x24\x1f\x9a\x01\x56\x46\x81\x6d\x4b\xd5\xda\xbc" +
+		"\x4b\x28\x91\x7b\xa5\xde\x94\x26\x51\x82\x08\xb0\xca\x0b\x76\x89\x36\x8e\x04\xcb\xa9\xaa\x77\xab\x47\xd9\x24\xed\x72\xf0\xe8\x5a" +
+		"\xf0\x6d\xb1\xf3\xd3\x2e\x04\xf3\x5e\x25\xf8\xfb\x87\x5d\x2c\x14\x47\xb7\xf0\x42\x75\x67\x8f\x5e\x0c\x3e\x5f\x47\xc5\xe1\x62\xf1" +
+		"\x19\x44\x35\xbf\x97\x63\xc0\xbe\x2a\x82\x6f\xd6\xd0\xd1\x81\x45\xdf\x00\x87\x2f\x00\x25\x06\xd2\x95\x08\x66\x6e\x04\x7f\xbb\xcd" +
+		"\xa8\x23\x2a\xae\xe1\x71\x82\x27\xc4\x45\x9b\xa9\x98\x7f\x28\x49\x3d\xca\xcc\x58\x65\x65\xf9\xbc\xc0\x52\x15\x57\xe7\x05\xf3\x92" +
+		"\xc0\x6d\x61\x0e\x6a\x0a\xca\x64\x4f\x03\xe7\x83\xad\xf3\x40\x3f\x58\x14\x07\x1e\x8a\x05\xff\x24\x20\xd6\xf0\x03\xc5\xcf\x2d\xa1" +
+		"\x60\xe3\xc5\xe0\xe1\x14\xb0\x6f\x36\x78\x2c\x5e\xfd\xfd\xfb\x3a\x08\x1e\x4b\xd4\x3d\xea\x5e\x07\x76\x5c\x07\xbe\xf6\x2b\xb0\x2e" +
+		"\xa0\xf8\x7c\xd4\x0b\xbe\x7a\x33\x98\xb6\x01\xdc\x62\xab\xd5\x75\x03\x98\x79\x17\xc1\x26\x1b\x3c\x14\x0e\x3e\xbb\xd9\x80\xc7\x43" +
+		"\xc0\xad\x17\x82\x83\x5f\x02\x77\xf8\xc1\x9a\x35\xe0\x9d\x59\x46\x67\x27\x78\xd4\x1c\xa1\xce\x45\x89\x5f\x2d\x7d\xf1\x0b\x04\x90" +
+		"\x33\xe6\x38\x23\xf6\x5a\xe0\x40\x32\x58\xb0\x1a\x3c\xe4\x01\x9b\xc2\xc0\x8a\x19\xe0\xd1\xc5\x60\x4f\x28\xf8\xbc\x68\x9c\x9a\x75" +
+		"\xea\x6e\xa7\xc6\x83\xb5\x5f\x51\xdd\xbe\x73\x3d\xf8\xf4\xa3\x06\xdc\xb7\x0c\xa6\x64\x3a\x98\x3e\x13\x2c\xbf\x11\x6c\x14\x80\x08" +
+		"\x53\x4f\xbb\x48\x68\xf1\x05\x45\xcd\x87\xdf\xa1\x6a\xbd\x3d\x1e\x17\x42\x97\x28\x3b\x48\x90\x2e\x2f\x1c\x2c\x15\xb7\x62\x3d\x98" +
+		"\x7e\xba\x33\xb3\xda\x08\x30\x73\x19\xf8\xe9\x2d\x60\xfa\x0a\x30\x7d\x11\x78\x2c\x12\x3c\x10\x0d\x6e\x16\x6d\x7a\x4c\xb4\x48\xb2" +
+		"\xc6\xbf\x16\x28\xf0\xfe\xde\x41\xb8\xb7\x08\x6e\x7e\x9b\x1a\xd7\x38\x66\x81\xa9\x37\x80\x5b\x23\xc1\xc3\x4b\xc0\xf5\x60\x5d\x92" +
+		"\xe3\x2d\xf5\xfc\x1b\x58\x3c\x13\x7c\x6b\x2f\xc1\xfc\xaf\x80\x7f\x7a\x99\x6a\xca\x1e\x26\x58\x78\x89\xea\x23\x71\x7f\x7a\xa7\x81" +
+		"\xf5\xe2\x9c\x79\xc0\x6c\x11\x0d\x81\xc9\x50\x30\x6f\x91\x1a\x33\xd5\x4b\xc0\x77\xae\x01\x1f\x7b\x87\x1a\xad\xda\xe6\x55\x91\x38" +
+		"\x1e\x0d\x56\x84\xc1\x08\x98\x75\xfb\x35\x26\x58\x18\x02\xb6\xd9\x8e\x4e\xc8\xd4\xda\xc4\x7a\x89\x05\x84\xaa\x53\x37\x38\x07\x3c" +
+		"\x10\x50\xa5\x3e\x30\x1b\xbc\x1a\xdc\xef\x05\xff\x28\x8a\xac\x49\xfc\x66\x31\xf0\x63\x14\x9c\xea\xe5\x1e\xd1\x41\xaf\xed\xa7\x46" +
+		"\x6c\xde\xba\x12\xcc\x5c\x0f\x76\xfd\x14\x7c\xad\x91\x8a\xc6\xd9\x96\x72\x83\x04\x6a\x0e\x84\xa8\x1b\x90\x33\x03\x3c\x22\x5a\x73" +
+		"\x16\x98\x3a\x07\x2c\x3c\x1f\xdc\x2e\x0e\x75\xd1\x72\x30\x23\xd2\x09\x0c\xdc\xa1\x31\xcd\xfd\x3e\x15\xf7\xbe\x70\x70\x9b\x1f\x3c" +
+		"\x40\xb0\x6c\x1a\x58\x16\xaa\x06\x43\xf3\x3c\xb0\x22\x12\xdc\xef\x51\xe2\xf5\x89\x23\x20\xf0\x23\xf6\x58\x22\xf8\xa1\xa8\xd1\xc3" +
+		"\x82\xce\xf7\x3e\x67\xc0\x21\x9f\xfa\xce\x25\x16\xd8\xbb\x01\xec\x9c\x01\x53\x72\x01\x58\x1c\xae\x48\xdd\x73\xb6\x0a\xe7\x5b\x82" +
+		"\x20\x65\xdf\x01\x4b\x12\xc0\xf7\x7f\xa6\xe1\x87\xfd\xa2\x78\x2c\x30\x4d\x68\x49\x70\x70\x26\x98\x6f\x83\xed\x06\xdc\x13\x0b\x4a" +
+		"\x4d\xf2\x3c\xb0\x45\x1c\xed\x30\xb0\x4f\x88\x25\x16\x70\x2c\xd8\x32\x53\x31\xf7\x53\x51\x62\x27\x5c\x04\xaa\x95\xf5\x45\xc0\xb4" +
+		"\x09\xcc\x24\x82\xb5\x12\xf2\x49\x72\xdc\xdf\x8a\xc5\x60\xd7\x37\xc0\x87\xdf\x93\xf5\x3e\x49\x65\xbe\x77\x8f\x50\x55\x4f\xd7\x17" +
+		"\xc1\x74\xaf\xee\x99\xa8\xc0\xcc\x30\x8d\x3c\xf7\xcc\xd0\x51\xa4\x4f\x73\x9d\x76\x31\x5d\xda\x8c\x1a\xf5\x87\x96\x80\x05\xc9\x8a" +
+		"\x0c\x0f\x09\xb2\x95\x45\x69\x40\x48\xc2\x0d\x67\x83\xf5\x5e\x0d\x89\x17\xba\xe6\x62\x6b\x18\xd8\x12\x02\x96\x4a\x9c\xd4\x0b\x16" +
+		"\x78\xc0\x74\x8f\x03\xea\x12\xb1\xbd\xde\x71\xec\x2b\xa3\xd4\x90\x14\x78\x39\x36\x1b\x1c\x9e\xa3\xe1\xc5\x3f\x09\xd5\x0b\x37\xa8" +
+		"\x9f\x71\x42\x64\xc4\x02\x5b\x93\xc1\x1d\x5f\x07\x0f\x4a\xf4\xd5\x80\xf9\x29\x0a\x39\x7b\xcb\x8c\x1a\x10\x43\x49\xae\x05\x16\x00" +
+		"\xeb\xfc\x1a\x53\x96\xe9\x9e\x08\x55\x0d\x15\xa5\x82\x95\x6e\x83\x85\x7e\xf0\x60\x9c\x2a\x97\xea\x68\xf5\xa3\xcb\x3c\x6a\xfe\x6d" +
+		"\x31\x2a\xee\xad\x3e\x95\xe5\x76\x31\xf0\xe2\xc1\x7c\x01\x91\x05\xe0\xa6\x3a\xe3\x58\xd3\xd9\x62\x42\xce\x52\xf3\xb4\x47\xc0\x26" +
+		"\x12\x2c\x99\xa1\xfc\x25\x4e\x59\xee\x62\x21\x8e\xa9\x9c\xe3\xc4\x55\x44\xf9\xe4\x86\x80\xfb\x05\xeb\xe2\xc0\x8f\x0b\x8c\x86\x74" +
+		"\x5b\xcf\x02\x8f\x2d\x04\x8b\x09\x53\x75\x26\x98\xfd\x00\xc1\x3f\x7c\x44\x0d\x77\x8b\xa7\xdf\x2a\x1b\x29\x26\xfe\x3c\x30\x3d\x59" +
+		"\x03\xf8\x5d\x97\x83\xbf\x91\x10\xe0\x89\x0a\xaa\xca\xed\xbe\x01\xbc\x5b\x78\xef\xc0\x4a\xb0\x73\xa9\xae\x69\x8e\x7a\xab\xc2\x8a" +
+		"\xa5\xf3\xc1\x13\xc9\xe0\x9f\x6f\x00\x0f\xcc\x04\xff\x96\x4d\x70\x9b\x0d\xf6\x25\x82\xe5\x12\x37\x8b\x07\x77\x2e\x56\x47\x48\x64" +
+		"\xfd\xf0\xe9\xe0\xd6\xf3\x34\x98\xf7\x5b\x19\x78\xdf\x0a\x30\x77\x0f\x55\x63\x47\xa8\x8b\xfb\xe2\xa7\x04\x53\xb7\x12\xdc\x9f\x4b" +
+		"\xf0\x44\x00\x7c\xf3\xf7\x46\x03\xbd\x82\x91\x07\x97\xa9\x50\x1d\x9a\xe5\x20\x58\x9e\xad\xdc\xd5\x31\x1b\x2c\x10\x5f\xc7\x03\x66" +
+		"\x46\xa9\x50\xe5\x5d\x03\xf6\x2d\x02\x9b\xa4\xb0\xbd\xc4\x80\x99\x73\x9d\x05\x64\x45\x6a\xd0\xe2\x78\xac\xe2\x4f\xb3\x57\x43\xb9" +
+		"\x87\xa2\xc1\x1c\xb1\x6a\xcf\x52\x7b\xf5\x36\x25\x70\x6f\x04\x78\x4f\xaa\xd1\x30\xc3\x80\xdf\xf5\xb3\xe6\x28\xc3\xee\x17\x8d\xb9" +
+		"\x11\x6c\x99\x0d\xf6\xd8\x1a\x21\xee\x8a\xd4\x88\x4b\x97\x16\x99\xb5\x2c\x01\xdb\x43\xc0\x97\x5e\x23\xf8\xfe\x37\x5d\x7e\x59\x00" +
+		"\xf6\x06\x60\x24\x2a\x74\x7c\x3a\xd8\x3a\x0d\x6c\x15\xc4\x33\x1a\x7a\x15\x83\x2f\x6d\x86\x9a\x5c\xf5\xf3\xc1\xc3\x12\x99\x5d\x0c" +
+		"\xbe\xf4\x9c\x51\x53\xa1\xdc\x52\xff\x48\x18\x7a\x35\x78\xdc\x56\x96\xdd\x19\x0e\x6e\xf5\x82\x03\x96\xc6\xc9\xd7\xa9\x78\xef\x0c" +
+		"\x05\x2b\x23\xc1\xb4\x30\xc7\xd7\xca\x99\xad\x2e\x87\xc4\x87\x0e\xcf\x03\xf7\x58\x0e\xee\x1a\x71\x9a\x72\xa9\x0c\xb1\x87\xae\x23" +
+		"\x35\x1d\xac\x91\x20\x8b\x56\x45\xf5\x51\xff\xec\x08\xd3\x07\xa4\x46\x3b\xd0\x5c\x2a\x39\xa1\x30\xf0\x11\x61\xa1\x5d\x96\xbe\x62" +
+		"\xb0\x55\x30\xd6\x0f\x36\x18\xc7\xf0\x12\x4f\xf0\x80\xa8\x70\x8f\x9a\x22\x1d\xd3\xc1\xea\x18\xb0\x2d\x1e\xcc\x48\x50\xd9\xfb\xa5" +
+		"\x86\xb8\x4b\x85\x6d\x16\x83\x8f\x8b\xe2\x19\x3c\x17\x6c\x22\x98\x11\x0e\x3e\x1d\x0f\x36\x2e\x02\x77\xf8\xc0\xc6\x73\x35\xcc\xd3" +
+		"\xfc\x6b\xf0\xc9\xe7\x8d\x06\x70\x07\x43\x9c\x69\x5e\x05\x1e\x4d\x54\x5f\x4a\x62\x81\xad\x12\x22\x71\x63\x31\x37\x83\xe5\x61\xea" +
+		"\x52\xd5\xfb\x1d\x08\xf6\x58\x1a\xf7\x19\x9a\xa9\xce\x54\x85\x5f\x37\x50\x1c\x67\x5b\xe3\xca\x5b\xbc\xba\x17\x99\x1e\xd5\xe7\x82" +
+		"\x9d\x87\xfd\x6a\x25\x14\xf8\x74\x1b\xba\x8c\x7a\xc0\xb5\xd1\x60\xd6\x74\x70\x48\xfc\x89\xe9\xe0\x76\x81\xb0\xe1\x68\x35\xad\xea" +
+		"\x13\x35\xcd\x23\xde\x6f\xbb\xad\xf1\xab\xcb\xd4\x16\xda\x29\x16\xe1\xb7\x1c\x95\xdf\xf4\x05\xf0\xb5\x76\xd9\x89\xa5\x60\xc3\xd5" +
+		"\x60\xf3\x77\xc0\xf7\xca\x35\xdf\x34\x07\xfc\x89\x6a\x1f\x0b\xcc\xa0\x3a\x2d\xf5\x16\xd8\x15\x0a\x86\xa9\xa5\x26\xa0\xd7\x2b\x59" +
+		"\x24\xbf\x5a\x6f\x9d\x46\x73\x52\xb3\xc0\x38\xb0\xd3\xe7\x64\x09\x3a\xbc\xba\x90\xad\xe1\xe0\x31\x3f\xb8\x50\x2d\xd0\x80\x26\x3f" +
+		"\x0a\x42\xc1\x5a\xcb\x79\x62\xbe\x57\xb3\x56\x83\x61\xe0\x50\x0c\x38\x68\x81\x16\xb8\x41\xb3\x05\x4d\x7e\x0d\xa7\x57\x11\x2c\x09" +
+		"\xd3\xb0\x9b\x38\x47\x19\x5e\xb0\xc7\x25\xe7\x11\xaf\x03\xec\x27\xdc\x48\x75\x9e\xd1\x47\x89\x02\x29\x9a\xab\x51\xb0\xe1\x18\x70" +
+		"\xb7\x64\xe7\xf2\xfe\x09\xfc\xe4\x2b\x60\x91\x0d\xe6\x2c\x01\x8f\xce\x03\x8f\x8a\x7d\x3e\x13\xdc\xe3\xd5\x78\x87\xe4\xd4\xb2\xe6" +
+		"\x83\xd5\x6b\x34\xe4\xfd\xa1\xd0\xb8\x4a\x28\x97\x0c\xee\x8f\x07\xb7\x5e\x03\xee\xed\x24\x98\xfa\x6f\xca\x75\xcd\x92\x59\xba\x56" +
+		"\xc3\xc0\xa2\x62\xdf\xbb\x9f\x60\xde\x0a\xb0\x30\x8b\x60\x4d\x12\xf8\x62\xa9\xd1\xd0\xc5\x4a\xcd\xbe\x1d\x12\xf9\xa6\x86\x82\x9b" +
+		"\xe3\xd4\x5c\xcb\xf1\xa8\xaf\x58\xa4\xef\x06\x5d\xa4\xc1\xbc\xda\x99\x2e\xa5\xc5\x2a\x8b\x04\x6d\x4d\xf7\xf4\x46\xa9\xe4\x4b\xf8" +
+		"\x70\x5f\x14\x58\x37\x5f\xc1\xea\x84\x6b\xff\x0d\x11\x1c\x5e\x0c\x7e\xe6\xd8\xd5\x3f\x02\x53\xff\x15\x7c\xbf\x83\x60\x65\x2e\x35" +
+		"\x89\xb2\x73\x1a\xf8\xf9\x79\x3a\xad\x30\x8d\x08\x3f\x96\x45\x8d\x5c\xa7\xc5\x6a\x80\xf1\x2a\xf5\xec\xb3\x84\x83\xfd\xaa\x5e\x0a" +
+		"\x7c\xca\xbd\x5b\xf4\x6d\x8c\x5a\x4b\x43\xe0\x05\x0b\xc1\x5a\x1f\xb8\x33\x59\xf3\xc6\x4d\x04\xab\x6d\x05\x56\xd1\x88\x35\x11\xe0" +
+		"\xce\x48\x17\x3d\xe3\xc1\x9c\x50\xb0\xf9\x34\x47\x95\xf0\x41\x51\xa1\x15\x04\x53\x63\x35\xc8\xd3\x1b\xa7\xf1\xc3\x1a\x4b\xcf\x75" +
+		"\x7b\xc1\x4a\x1b\x2c\x49\x71\xd5\xd7\x4a\x30\x6b\xad\x2a\xf2\x47\xef\x77\x33\x3d\xc3\x04\x07\xa3\xd4\x18\xa9\x30\xe0\xfe\x24\xf0" +
+		"\x78\x18\xb8\x4d\xe8\x3d\x57\x59\x31\x33\xa0\x6c\x9d\xa1\xb9\xac\x76\xd7\x8c\x3e\x1c\xa5\x5a\xb7\x58\xc8\xef\x73\xb2\x6e\xd9\x46" +
+		"\x83\xc0\x45\x3e\x45\xcd\x8e\x70\x15\xa8\x3d\x7e\xcd\xe2\x34\xc5\x81\x0d\x21\xe0\xe6\x5d\x46\xcd\xb3\x8c\xb5\x9a\xa2\x3d\x40\x30" +
+		"\xdd\x02\x1b\xbe\x0c\xee\x90\xa4\x53\x04\x78\xd4\xa3\x3b\x92\xba\x0a\x1c\x9a\x0b\x6e\xae\x94\x05\x8b\x0e\x89\x05\xf7\x5f\x0e\x36" +
+		"\x2d\x00\x77\xc4\x69\xd6\x5d\x08\xfe\x79\x23\x35\x7a\x2d\x86\xf8\xde\x5b\xc0\x2c\x3f\xb8\x47\x94\x44\xb4\x6e\x4c\x99\x05\xee\x90" +
+		"\x4c\x04\xc1\xbc\x35\xea\x33\x37\x86\x3a\x29\x87\xd6\x68\x4d\xcf\xae\x57\x17\x77\x60\xbe\xb2\xf8\x27\x62\xce\xe7\xc7\x82\x3b\xbf" +
+		"\xa9\x5c\x2b\xee\xde\x31\x4b\xd1\x2a\xf5\x5c\xf0\xee\x77\x84\x30\x21\x9a\x15\xdc\x1f\xae\x0e\xbf\xa5\xf8\x53\x1b\xad\x32\x57\x68" +
+		"\x94\x02\xd9\x62\x3d\x47\x69\xa8\xf9\x90\xa5\xc9\xbf\x2c\xa3\xb2\x5a\x12\x0e\x36\x13\x24\xb8\x55\x38\x55\xc2\x33\x1e\x4d\xc0\x74" +
+		"\x7b\x1c\x8d\xd2\x35\x5b\xcd\x89\x4a\xa3\xf1\x8a\x3d\x09\x9a\xb3\x92\xff\xe9\x02\x90\x71\x60\x5a\x08\xb8\x57\x02\xeb\xa5\x72\xc3" +
+		"\x32\xc5\xdc\x82\x73\xc0\x76\x1f\x98\xb7\x5a\x99\x4c\x52\x27\xef\x35\x53\xad\xc2\x9d\x89\x60\x97\x0c\x5b\xbd\x02\xcc\x4a\x01\x9f" +
+		"\x79\x90\xe0\x27\x37\x29\x65\xf7\xcf\x50\xc4\x4c\xfb\x11\x98\x23\x02\x3d\x0d\xdc\x3a\x07\x7c\x5c\x22\xff\x95\x3f\x07\x87\xe6\x80" +
+		"\x5b\xbe\x0f\xbe\xb4\x1c\x3c\xb4\x00\x7c\xf5\x6e\xd9\xd8\x48\x0d\x8e\x08\xe6\x35\x19\xf5\xb1\xb2\x7c\x1a\xaf\xdb\x67\x39\xea\xe0" +
+		"\x80\x17\xac\x0e\x05\xf3\x15\xcf\x86\x2c\x4d\x73\x55\x5a\x9a\x44\x4a\x8b\xd5\xdc\x4d\x8b\x01\x0f\x78\x14\xb1\x7a\x02\xca\x22\x3b" +
+		"\x8c\xfe\x6f\x35\xfa\xe6\xda\x80\xa5\x49\xa2\x72\xb7\xea\x61\x87\x4f\x2d\xbd\xb3\xc0\x6e\xa3\x43\x6f\xb5\xc1\x2d\x7e\xd7\x55\x76" +
+		"\xd5\x68\x5b\x40\x63\x92\xa2\x67\x9a\x24\x47\x6f\x29\x79\x9b\xdd\xb8\xe6\x25\x60\x51\x24\xcc\x7a\x27\x3a\x77\x9d\xc6\x12\x0a\x6c" +
+		"\x1d\x2f\x87\xe0\xe6\xed\x06\x6c\x89\xd1\xe2\x86\xf2\x10\x70\x87\x50\xf4\x32\x30\xd7\x06\xb3\x42\xd4\xf6\x16\x89\xca\x3f\x0d\xec" +
+		"\x0d\x07\x7f\x97\x4f\xf0\xa0\xed\x8c\x5c\x61\xd4\x82\x74\xa8\xe4\x55\x0f\xa5\xc7\x52\xeb\x67\x58\x34\x59\x82\xba\x09\x1d\x22\x4f" +
+		"\x11\x1a\x8b\xde\x11\xa5\x71\x89\xfd\x16\x78\xcc\x06\x3b\x09\x9e\x88\x55\x6f\x43\xa2\x25\xe5\x22\x5f\xb1\x5a\x69\xf2\x8a\x7c\x98" +
+		"\xf3\x10\xc1\x9a\x59\x9a\x00\x7a\xee\x75\x3a\xfa\x41\xa8\xdb\xf3\x55\x70\x78\xad\xbe\x05\xb9\xfb\x1b\xe0\x93\x6b\xc0\x92\xff\x04" +
+		"\xf7\x2f\x70\xe2\x43\x8f\x7d\x40\x47\xa1\xed\x08\xa8\xba\x68\x21\xd8\x7b\x0e\xb8\x25\x02\x6c\xfc\x57\xb0\x7a\x21\xb8\xe7\x4c\x70" +
+		"\x97\x84\x40\xf2\x23\xc0\x9a\x30\xb5\x37\xf6\x78\x54\xca\x97\x83\x04\xbb\x6d\x4d\x47\x1f\xb5\xc0\xfe\x69\xe0\x51\x7d\xb7\x52\x3c" +
+		"\xfe\xef\x83\x4d\xcb\xc1\xec\x58\xc5\xee\xad\x49\xe0\x8e\x58\x07\x40\x72\x63\x55\x2a\x6d\xf5\x23\x65\x7f\x37\x82\x9b\x9b\x09\xd6" +
+		"\x47\x82\x03\x61\x0a\x5f\xc3\x21\xaa\xb8\x05\x63\xb2\x43\x5d\xad\xbf\x04\xcc\x58\x01\x66\x88\xcb\x21\x79\xcd\x45\x0e\xd4\x6e\x16" +
+		"\x03\x28\xd7\x55\xca\x95\x31\x1a\x70\xae\xb0\xc1\xcc\x78\x8d\x2e\xf5\x1a\xb0\x64\x85\xa2\x5e\x6b\x14\x58\x1a\x09\x56\x45\x69\x70" +
+		"\x47\xd6\x20\xf9\xbb\xcb\xd4\xd1\xcc\x91\x9c\x47\x0c\x78\xd8\xe3\xb0\xc4\x63\xed\xd4\x3a\x17\x27\xef\x19\x03\xee\x8d\x71\x34\x90" +
+		"\x18\xa8\xc3\xab\x55\xbe\x2b\xfd\x2a\x49\xa5\x12\x9e\x9a\x0b\xbe\x2f\xcb\x4a\x8b\x01\x2b\x44\x9e\x1e\x78\x82\xe0\xdb\x3f\x05\x0f" +
+		"\xba\xdc\x95\x2f\x50\x1c\x0b\x66\x84\xba\x0a\xf2\x7c\x70\xff\xf9\xaa\x43\x4a\xc2\x35\x50\x25\x89\xcd\xc6\x95\x0a\xb6\x92\x2d\xe8" +
+		"\x89\x54\x10\xd8\xfd\xa0\xc8\x5e\x8c\xb2\x72\x8e\x51\x2b\xf1\x16\x30\x3f\x14\x6c\x48\x54\x8d\x57\xb3\x99\x1a\xff\xe9\x97\x34\x79" +
+		"\xa4\x86\x6b\xfe\x7c\x9f\xd1\x68\x41\xe1\x74\x30\xdb\x2d\x24\x3a\x76\x36\x98\x75\x87\x82\x55\x8e\x47\x15\xc1\x67\x7f\x12\x3a\x0a" +
+		"\x40\xe4\x89\x8b\x36\x03\xbc\x37\x4d\xa4\x70\xa6\x6a\x2b\x31\xee\x1a\x67\x83\xdd\x62\x1f\x4a\x78\xf7\x52\xf0\x3d\xc7\xcf\x59\x04" +
+		"\x16\x6d\x00\x2b\x1f\x27\xf8\xd7\x6a\xa3\x5a\xb6\x61\x86\xfa\xd5\x5b\xa6\x81\xfb\xcf\x04\x4f\x64\xcb\x22\x8a\x8d\x0e\x96\x11\xa6" +
+		"\xb5\x53\x59\xa2\x65\xe2\xc1\x3d\xe2\xbe\x2e\x73\xeb\x49\xae\x56\xb7\xf5\x73\x59\x65\xef\xd9\x8a\x1b\xd5\x8b\xc1\x8a\xf3\xb5\x00" +
+		"\x29\xfd\x6b\xe0\xa3\xbf\x25\x58\x78\x0d\xb8\xef\x17\xea\x61\x7d\x5c\x67\xc0\x6a\x9f\xe2\xd1\x41\xc9\xa0\xcc\xd6\xba\x80\x7e\x49" +
+		"\xfe\xce\xd7\x12\x95\x8a\x4b\xc0\x67\x0f\x10\x2c\xfe\x19\xd8\x90\xa0\x0e\x4d\xbe\x9b\xe7\x6e\x4a\x01\x9f\x1b\x26\xd8\xbd\x40\x31" +
+		"\xa7\x4c\x30\x37\x42\x83\x46\xa5\x04\x5b\x85\xdf\x03\x2a\x09\x4d\xa1\x1a\xa7\x6a\xf5\x6a\xb4\xf2\xbf\x94\x2d\x87\x8c\xa6\x60\xfb" +
+		"\x17\x6a\xb8\xaf\x3d\x51\x4d\x38\x49\x6a\x6f\xce\x34\x1a\xe9\xac\x4a\x04\xb3\x16\x80\x27\x66\xe8\x4d\xbd\x17\x82\x3b\xce\x51\xd5" +
+		"\x5b\x14\x05\xbe\xf2\xac\x01\x8f\x5e\xa0\x1e\xda\xe0\x6c\xb0\xef\x6b\xe0\xa6\x7d\x04\xdb\x67\x6b\xf5\xc8\x3e\x29\xcc\xf8\x96\x96" +
+		"\x93\x7d\xf6\x3c\xc1\x4a\x99\xdf\xef\xda\xa8\xc1\x01\x89\x1b\xed\x94\x74\xc9\x12\x70\xe7\x12\x70\xfb\xf9\x60\xc6\x69\xe0\xcb\x37" +
+		"\x81\x9f\x85\x82\x85\x92\xdc\x5c\x0c\xd6\x5c\x0a\x0e\x0b\x00\xcf\x05\x1b\xa2\xc1\x23\xf3\x1c\xbc\x16\x47\x23\x7b\x25\x28\x01\xe0" +
+		"\x85\xe0\x3b\x8f\x1b\xad\x66\xaa\x9c\x05\x56\x26\x69\xaa\xb8\x60\x1e\xd8\xb3\x06\x2c\x8f\x01\x4b\x97\x80\xef\xe7\x18\xd7\xd5\xf2" +
+		"\x39\x26\x52\xa9\xad\x29\xeb\xca\x68\x70\x09\x98\x9e\xa8\xb1\xb6\x1f\xaa\xe2\x0b\x28\xb0\xd5\x09\x7a\xfb\xd4\x67\xba\x44\xa1\x48" +
+		"\xd2\x1d\xd3\x1d\x2c\x93\x3a\xab\x8e\x10\xdd\x9e\xda\xc5\x60\x51\x12\x38\x18\xae\xe9\x92\xb7\x3f\x24\x98\xba\x56\x73\x52\xa9\x5b" +
+		"\x08\xde\x2d\xc4\x3d\x78\x35\x38\x90\x02\x36\x1a\x30\x35\x0c\xcc\x3e\x13\xac\x26\xd8\x7c\xb9\xd6\xa8\x54\x44\x80\x1f\x76\x12\x1c" +
+		"\x5a\x06\x6e\xd9\x08\x7e\xf6\x94\x10\x75\x9a\x86\x74\x3b\x93\x34\x8d\x2b\xe5\x1f\xdf\xd3\x00\xdc\x80\x51\x1d\xbf\xd5\x52\x3f\x24" +
+		"\x8d\xa0\xf0\xb6\xab\xa8\x0a\x6d\x8d\x22\xf6\x11\x3c\xe6\x96\xe7\x65\x45\xab\x69\x20\x19\x9e\x8e\x69\x60\x7f\xb8\x46\x6d\x9f\x94" +
+		"\x98\x65\x93\x4f\x2d\x42\x31\x5b\x8a\xae\x50\xe4\xac\x09\xd7\x44\x83\x54\x9f\xec\x13\xa5\x39\x57\xdf\xf4\xde\x08\x16\x85\x82\x3b" +
+		"\x6c\x15\x00\x71\x5d\xb7\x4c\x07\xeb\xa3\xd5\x8e\xff\x64\xb7\xd1\x07\x0f\x1a\x55\x6b\x65\x02\xe8\x1e\x70\x9a\x16\x7a\x6c\x09\xa8" +
+		"\xfd\xd8\x2e\x22\xec\x66\xed\x06\x62\x94\x89\xf3\x23\xb4\x84\xe3\x80\x6b\xdd\x74\x09\xbc\x87\x83\xd5\xae\x2b\x73\xd0\x56\xf7\x78" +
+		"\xeb\x0a\xb0\x5a\x5c\xcd\x4b\xc0\xd2\x50\xf0\x93\x2c\xa3\xc1\x33\xf1\x95\xb3\x08\x1e\xf9\x25\xb8\x3d\x87\x60\x7f\x3c\xd8\x2f\x9e" +
+		"\x46\x34\xd8\x26\xa5\x34\xb3\xc1\x07\x5e\x23\x98\x35\x0b\x1c\x3c\x0d\x6c\xdf\xe0\xb0\x85\x20\x9c\x38\xe3\x25\x17\x82\xbd\x89\x5a" +
+		"\xef\xf8\xe7\x32\x82\xa9\x33\x60\x32\x12\x34\x26\x7c\x2c\xd6\x2d\x38\x0c\x80\x8f\xee\xa5\x6e\xc1\x2c\x30\x7b\xad\x9a\xc0\xfb\xa2" +
+		"\xc1\xec\xe9\xca\x2f\xab\xd5\xb8\x2b\x9a\xa6\x66\x92\x60\xcb\x2f\x35\x90\xd0\x91\xa2\xbe\xeb\x3e\x1f\xf8\x42\x23\xc1\xb7\x66\x2a" +
+		"\x6f\x09\x45\x1b\x62\xc1\x86\x64\xf0\xb9\x7b\x09\x96\x2d\x02\x0f\x49\x75\xdc\x6a\xf0\xa9\x5f\x81\x65\xd1\xea\x69\x55\xfc\xb3\xd6" +
+		"\xd2\xbc\x76\x3f\xd5\x48\xef\x49\x54\x79\x69\x26\x98\x49\x70\xab\x1f\x1c\xb2\xb5\xbe\xe4\x84\x1b\xb0\x6f\xa5\x6e\x71\x5b\x38\x78" +
+		"\x7c\x96\x46\x3e\xf7\x27\x3a\x8b\x17\xf6\x5b\xa1\x81\x6a\x51\x1e\x27\xa6\xab\xd3\x55\x67\x6b\xf0\xf2\x2a\xb0\x68\x1e\xf8\xd8\x5b" +
+		"\xd4\x88\x52\x7a\x08\xd8\x7a\x01\x98\xbd\x1c\xcc\x4f\x02\xf7\x7e\x4e\xf5\x2b\x5a\x3c\x1a\x1c\x4b\x8d\xd3\x98\xdc\xe1\x30\x0d\x92" +
+		"\x09\xc7\x16\xcf\x55\xbf\xa8\xca\xa3\x36\x4b\x6d\xa8\xba\x96\x62\x9d\xf9\xc1\x9c\x2f\x68\xb5\xa5\x70\x6e\xda\x02\x75\x3b\xf2\x08" +
+		"\x3e\x22\xda\x25\x43\xdc\x77\x4b\xd9\xa5\x56\x0b\x75\xaf\x06\x4b\x66\xa9\x0c\x16\x12\xec\x89\x55\x2f\x46\x82\xf2\x87\x56\xa9\x67" +
+		"\xda\xec\x46\x22\x0b\x02\x2a\x1f\x57\x83\xdb\xc2\x35\x5d\x5b\x10\xaa\x52\x9f\x6b\xd4\xfc\x70\xcc\x61\xa3\x36\x6f\x55\x0c\xcc\x3e" +
+		"\x82\xbb\xb7\x88\x20\xad\x06\xb3\x93\x94\xa8\xc3\x12\xa8\x15\xb4\x99\x07\x6e\xbd\x51\xd3\x5e\x1f\x0a\xb3\xa7\xfd\x87\xba\xc6\x47" +
+		"\x23\x55\x87\xed\x90\xda\x84\x80\x96\x36\xf5\x2e\x03\xf3\x24\x74\x42\x4d\x48\xbe\x70\x39\x58\x7e\x03\xf8\x82\x48\x6d\xff\x66\x82" +
+		"\xfd\xb2\xcc\x3b\xb7\x1a\x4d\x28\x0b\x4a\x1e\xf5\x83\x54\xfb\xbd\x37\x14\xbc\x56\x93\x32\x07\xa2\x35\xda\xd3\x1d\x00\x0b\x53\x94" +
+		"\x18\x7d\x96\x03\x86\xf5\x11\x6a\xf3\x54\x58\x60\xff\x99\xe0\xe1\x78\xad\xa0\xa8\xb2\x14\xe0\xc4\x9c\xca\x88\x05\x77\x3b\x38\xbc" +
+		"\x8d\x60\x49\x2c\xb8\x2b\xdb\x68\xfc\xa1\xd4\x68\x4e\xb4\x3a\x42\x5d\xbd\x1b\xd5\x6c\x3d\x34\x4d\xd3\xbf\x35\xb3\x54\x1d\x8a\x2f" +
+		"\xb1\x7f\x21\x58\x12\xaa\x6a\xb9\x82\xaa\x71\xea\xfd\x3a\xcc\x68\xbd\xef\x34\xb0\x67\xa6\xd6\x1d\xfc\xee\x19\xa3\x6e\x67\x9a\xad" +
+		"\x37\x64\x45\xaa\xe3\x2e\x35\x2b\xd7\xc3\x94\x87\xea\xde\x48\x25\xd6\x1d\x30\xd7\x82\x03\x01\xb5\xbf\x0a\x7d\x1a\xe0\x0a\x83\xc9" +
+		"\xb1\xb5\x1e\xa6\x43\xee\xb3\x9c\x6a\x34\x53\x1a\xae\x2c\x51\xea\x51\xd4\xab\x9a\x09\x16\x46\x6b\x9e\xaf\x26\x06\xcc\xf0\xa9\xe3" +
+		"\x38\x5f\xe3\x45\xf3\x75\x49\x09\x9a\x89\xf4\xa8\x5f\x2e\x8e\xd5\x8b\x1f\x10\xcc\xfb\x32\xd8\x73\xad\x43\xe9\xb7\x45\x69\xb5\xcc" +
+		"\x51\x16\x69\xbd\x02\xcc\x10\x58\x5b\x0b\xee\x93\x68\x40\x9c\xda\xd4\x8f\x5c\x07\xbe\xfd\x0b\xb0\x60\x3d\x98\x7f\x3a\x78\x60\x31" +
+		"\xf8\xc6\x5e\xd9\xe1\x79\x6e\xc8\x7c\x89\x66\x1d\xd3\x67\x83\x47\xa2\xc0\xa7\xc4\xf1\xcc\x4e\x00\xf3\xbf\x0b\xa6\x7f\x1d\xcc\xb8" +
+		"\x18\xcc\xb0\xc1\x01\x0f\xf8\x54\x0b\xc1\x6d\xf3\xc1\x8e\x1c\x82\xef\x0d\x11\x6c\x3b\x0f\x3c\xf2\xdf\xe0\x89\x1f\x82\xbf\x13\xa5" +
+		"\x2b\x27\x0f\x7a\xc0\xea\xa5\x5a\x3d\x25\xa6\x60\x7b\x28\xb8\x47\xd4\x8c\x60\xf3\x22\xb5\xaf\xb2\x67\x2b\x05\x25\x4a\xde\x16\xa2" +
+		"\x32\xb1\x27\x44\x99\x65\x20\x11\x7c\x3e\xdb\x0d\xe9\x6d\x04\xdb\x17\x6b\xbc\x3b\x4f\xf2\xaa\x96\xba\xa7\x22\x8d\x35\x97\x29\x50" +
+		"\x6f\xb5\x95\x6d\x3b\xa2\xc0\x42\x05\x8a\x42\x82\x35\x6e\x3e\xa8\x6d\x01\x18\x0b\x96\x44\x82\xed\x73\xc1\x95\xe0\xe3\x8f\x12\x2c" +
+		"\x4e\xd2\x2c\xac\xe5\x44\xd8\x6a\x97\x83\x7b\x45\x27\x46\xa8\xd6\x6d\xf7\x6a\x2e\x68\x9f\x5f\x3d\x8d\x3c\x9f\xc6\x2a\x7b\x0d\xd8" +
+		"\x70\x16\x38\x78\xb6\xfa\x07\xb5\xe1\x9a\x15\xad\x5f\x03\xc6\x81\x1f\xec\x36\x9a\x89\xbc\x0c\x1c\x58\x00\xb6\xad\x01\x87\x52\xc0" +
+		"\xb2\x0c\xea\x8e\xe4\x26\x82\x9f\x95\x1a\xb5\xd3\x6a\x8d\x42\x8c\xe4\x12\x8b\xbf\xab\x5e\xb5\x94\xbb\x3d\x27\x7b\x2d\x85\x63\x1f" +
+		"\x44\x2b\xb3\xb7\x49\x6a\x64\x3b\xc1\xd7\x5f\x11\xfa\x86\xab\x5e\x6f\x88\x73\x5c\x05\x71\x04\xf5\x5d\xee\x9c\x38\x75\x07\x33\xd6" +
+		"\x69\x44\xa5\xd4\x33\x16\xcc\x1a\x36\x60\xbe\xa4\x24\xa2\xb5\xde\xf8\x80\x4f\xcd\xb2\x4e\x9f\x46\xcf\x0e\xc4\xa9\x52\xcd\x5a\x0c" +
+		"\xbe\xb5\xdd\x68\xe4\x5f\x2c\xe0\xce\xb3\x75\x57\x4b\x96\x38\x3c\x29\x41\xc2\x2d\x2b\xc0\x9d\xb1\xe0\x09\x89\xdc\x8b\xeb\x92\x04" +
+		"\xde\x99\x69\xc0\x9e\xd3\xc1\x23\x62\x9a\x44\x6a\xa4\xf9\xa1\x5b\xc1\xca\xc5\x9a\x45\xdd\xb3\x4a\xa3\x40\xf9\x2b\xc1\x83\x67\x81" +
+		"\x8f\x3d\x6a\x94\xc3\xa5\x48\xa1\x24\x4e\x4d\xf2\x5b\xf4\x59\x02\x48\x6d\x7e\xdd\x46\xd9\xf9\x72\xa9\x7d\x0b\xd3\xba\x9d\xdc\x50" +
+		"\x0d\x02\x36\x13\xec\x8a\x52\xa7\x23\xcf\x52\x3f\xb7\x74\xba\xfa\x82\x85\xb1\xe0\x21\x82\x51\x5a\xe8\xdc\x28\x41\x6b\xa1\x39\x1d" +
+		"\x33\x53\xe2\xbe\x3d\x82\x13\x31\x9a\x5c\x14\xf1\xdc\x9e\x67\x34\x5c\xe8\x14\x98\xc7\x68\x80\x2d\x23\x4e\x3d\x8b\x32\xaa\x3b\xea" +
+		"\xe4\x59\x6c\x70\xff\x3a\xb0\x7e\xa9\xba\x5c\x92\x38\x4b\x3f\x1f\xb4\xc1\x17\x2a\x09\x36\x2e\x00\x87\xcf\xd1\x02\x8f\xdd\xe2\x38" +
+		"\x44\x68\x22\xae\x20\x5c\x83\x63\x59\xb1\x60\x7d\x94\x46\xa0\xfa\x7c\x0a\xfe\xe5\x01\xad\x79\x3f\x11\xa3\x4b\x2e\x0e\x68\x19\xad" +
+		"\xd0\xfa\x68\xbc\x16\x4b\xd5\xba\xd5\xfd\xfb\xa3\xb5\x10\xa0\xc2\xe7\xea\x3e\x03\x96\x25\x2a\xd2\x7e\xf2\xb4\x5b\x1e\x5e\x3e\x43" +
+		"\x6b\xf2\xa5\x86\xb5\xc6\xd2\x0c\x92\xc4\xc9\x52\x25\x07\x13\xa9\xb9\xa8\xa3\x96\x46\xb8\x1a\x6c\xe5\xb1\xc3\xb3\x54\x91\x56\xba" +
+		"\x52\x3c\x4c\x95\xac\x59\x2a\x45\x4d\xe1\xe0\xc3\xa2\x86\x6e\x53\x34\xef\xd6\xb7\x0c\x4a\x35\x92\x70\x3c\x01\x5c\xe7\x66\x12\x03" +
+		"\x1a\x1d\xcb\x0e\x68\xad\x98\xd8\xb1\xfb\x12\xd4\xa8\xc9\xa5\xa6\xb5\x6b\x6c\x35\xcd\x76\x5a\xce\x1e\xad\x56\x68\xac\x8c\x15\x6c" +
+		"\xe5\x45\xce\x09\xd9\xe6\xba\xd3\x94\xcf\xe2\xd5\x53\x28\xa1\x1a\x8d\xb9\x96\x26\x08\xea\xb4\x38\xbc\xcb\x80\x4f\x16\x18\x70\xdf" +
+		"\x06\x85\xde\xb4\x65\xe0\xd6\xf9\xea\x55\xf4\x78\x34\xcf\xf1\x44\x09\x35\x79\x25\x3c\xde\x70\x81\xe2\xbc\x8c\xdf\x77\xab\x96\x56" +
+		"\x8a\x70\x7f\xd8\x43\x5d\xd1\xe0\x66\x82\x7f\x79\x97\x1a\x75\x59\xa9\x29\xa1\xd5\xfa\x22\xc7\x80\xe4\x72\x7d\xe0\xc1\x80\x46\x5a" +
+		"\xf3\xa8\xe1\xe1\x4c\xaf\x46\x12\x24\xa8\xf6\x3d\xd5\x21\x2d\x06\x74\x33\x89\x2d\x5e\x77\x8d\x1e\x35\x10\x53\xd5\x34\xb8\xcd\x59" +
+		"\x71\xaa\x4f\x33\x89\x02\x94\x8e\x71\x6d\x69\xf9\x45\x9b\xa5\x2f\xc4\x0c\x51\xc3\x03\x69\x21\xea\xfd\xf7\xc5\x80\x03\xb3\x34\x51" +
+		"\x74\xcc\x06\x77\xc4\x38\x9b\x50\x60\xf4\xff\x41\x37\xea\x75\x30\x52\xe3\xc2\x61\x9a\x20\xc9\x4f\x06\x9f\x7a\xc6\x68\xdc\xbb\x35" +
+		"\x54\x77\xaa\x2f\x41\xa3\x46\xed\x5e\xcd\xe3\xd5\xc5\x82\x87\x93\x35\xf2\x7a\xc2\xd6\x9a\xf2\xf6\x48\xd5\x4d\x03\x3e\xad\xe8\xce" +
+		"\x0d\xd7\xa2\x98\xdb\xd4\x4f\x10\x85\x3e\x2c\xb1\x40\x49\x69\xf4\xba\x31\xbb\x5a\x8f\xe6\xdf\x1a\xe7\xa9\x73\x72\x22\x4a\x63\x5b" +
+		"\xd5\x96\x92\xa6\xcf\x80\x74\x60\x52\x28\xf1\x13\xf5\xf0\x2b\xe2\x14\xf3\xfb\xdc\xc0\x6b\x16\x35\x97\xb9\x33\xa0\x39\x34\xbf\x8e" +
+		"\x9d\x46\xb0\xdb\x8d\xea\x6d\xa1\x1a\xe1\x42\xaa\x72\xbf\xc2\x51\xb3\xd1\x77\x5a\x5a\xa9\x50\xd7\xe4\x51\xce\x16\xae\xec\xf1\x82" +
+		"\x15\xd1\xca\xe5\x12\xdb\x7b\xa2\x83\x60\xef\x0d\x60\xdb\x25\x7a\xe2\xf5\xb7\xa9\x80\xf8\xd7\x14\x30\xe7\x5c\x30\xf3\x7c\xf0\x7d" +
+		"\xe1\x87\xc3\x5f\x00\x73\xa2\x54\x1a\x07\x8c\xe6\xa5\x33\xe3\x14\x74\x52\x13\x35\x95\x50\x49\xb5\x52\xfa\xc5\xa0\x5c\xad\xa5\xd2" +
+		"\xd3\xf4\x69\xf7\x3d\x60\x34\x63\x5f\x96\xa4\x15\x77\x99\x31\xaa\x37\xdf\x6c\x74\x8d\xe3\x54\xaa\x25\x21\x05\x69\x87\x1e\x26\xf8" +
+		"\xcc\xd3\x04\x45\x8c\x6d\x7d\x31\x27\x2f\x44\x43\x67\x62\xc4\x64\xcc\xd2\x74\x73\xaa\xc7\x09\x0c\x0d\xc7\x28\x27\x56\x58\x6a\xec" +
+		"\xb7\x58\x5a\x18\x28\xfa\x31\x6b\x86\x06\xb8\xaf\x53\x00\x16\x6e\x1f\x8c\x01\xeb\xc3\xf5\x16\x61\x85\x4a\xd7\x0c\x1d\xf6\x28\x60" +
+		"\xf8\xb5\xbe\x52\x32\xbe\x4f\xfe\x4a\xe3\x6e\xbf\x7d\x86\x9a\x84\xcb\xf2\xa8\xb1\x76\x28\x4a\xe3\x93\xb5\x0b\xb4\x60\xba\x26\x4c" +
+		"\xcd\xd9\x32\xaf\x66\x77\xf6\x45\xeb\x76\x9c\x58\x0b\x9e\x98\xad\x2c\x59\x36\x1d\x3c\x16\x06\x7e\xf6\x55\xf0\x31\xc7\xbb\x91\xc0" +
+		"\x8d\x08\x5a\xce\x42\xf0\x91\x4f\x09\xd6\x7e\x1d\x7c\xcb\xab\x59\xe4\xbe\x9b\xc1\xdf\x3d\x45\x4d\xfa\x1d\x9e\x06\x6e\x8f\x02\x3b" +
+		"\xce\xd0\x32\x47\x59\xd2\xd6\x14\xf0\xe0\x74\x4d\xd5\x09\xbb\x7e\xea\x64\x26\xe2\x95\xc0\x59\x01\x07\xa2\xca\x53\xc0\x96\x30\x47" +
+		"\x0c\x9a\xbc\x4a\xb0\x3e\xb7\xc2\x45\x38\x72\xb9\xbe\xba\x20\x2b\xff\x89\xc6\x7c\xfb\x52\x74\x2f\xf6\x5b\x60\xb2\x2a\xc1\xdc\x08" +
+		"\x0d\x90\x1d\xf1\xa8\x54\xaf\x55\x77\xff\x98\x58\x04\x21\x5a\x2a\x26\x75\x76\x9f\x49\xee\x26\xcc\x31\x75\x6b\xa2\xd4\x78\x78\xeb" +
+		"\x49\xea\x9b\x56\x0d\x01\xb0\x7f\xa9\x86\xcf\x0f\xf9\x41\x4b\x63\xe2\x1d\x96\xe6\x8a\x1b\x6c\x2d\x88\xa8\x8e\xd3\x90\xe9\x8f\x75" +
+		"\xd4\x5a\x82\x4d\xea\x92\xb5\xbb\x55\xff\xfb\xf4\xdd\xe6\xda\x48\x25\x65\x53\xb8\x2a\xb9\x72\x37\x60\xeb\x6c\x8f\x01\x07\xc2\x35" +
+		"\x26\x3c\x47\x41\x6c\xd3\x0e\xa3\xf5\x71\x7d\x6e\xc9\xa6\x00\x5e\xf7\x62\xb0\x77\x95\x66\xde\x5a\xa3\x94\x05\x0a\x93\xc1\x3c\xa9" +
+		"\x92\x4e\x50\xc5\x29\x40\xf4\xf0\xe3\xae\xe8\x3c\x27\x46\xfc\xd7\xc1\xec\xb3\xc0\xa2\x8b\x35\x16\x27\x50\xbc\xab\x83\x9a\xcc\x29" +
+		"\x5a\x07\xbe\xb5\x89\xfa\x5a\x95\x68\xf4\x96\x08\x4d\x44\xee\x9c\xe5\x26\x99\xd7\xaa\xbd\x7b\xe8\x74\x2d\x28\xed\xdc\x08\xa6\x19" +
+		"\x2d\x10\x79\xb1\xd2\x0d\xe7\xa7\x5f\xa1\x68\xd4\xfa\x4d\xcd\x32\xb6\x2e\x03\x3f\xea\x26\x78\x62\x19\x78\xdc\xaf\xc5\xf9\xdf\xd6" +
+		"\x9a\xcb\x02\xaf\xbe\x9b\xf3\x88\x4f\x73\x7e\xe9\x6b\xd5\x39\xec\x9a\xa9\x55\xdf\x3f\x07\x77\x3b\xa8\x28\xd9\xc2\xf9\x6e\xcd\xda" +
+		"\x0d\xe0\x9d\x4f\x53\x99\xac\x27\x06\xa6\x6e\x9e\x66\x7d\x8f\x4a\xb9\xc3\x75\x60\xdf\x05\xa0\x1f\x7c\xe8\x38\xb5\x60\x43\x84\x2e" +
+		"\x00\x6e\x95\x98\x7f\x8c\x96\x4d\x4b\x98\xb1\x25\x4a\xdd\xb1\xeb\xc0\x36\xaa\x31\x2b\x49\xfa\x0e\xaa\x44\x6c\x0d\x53\x47\xef\x60" +
+		"\x92\x4a\xc4\x90\x57\x8d\xe3\x7b\x9b\xa8\x7e\xba\xe8\xe4\xab\x35\x44\x26\xb9\xb7\xac\x6b\xb5\xd0\xe2\x50\xa2\xd6\xcb\x1f\x5c\xed" +
+		"\x44\xd7\x5f\xbf\xd3\x80\xad\x52\xbd\x75\x25\x58\x76\x31\xf8\x8a\x53\x59\x3d\x5b\x8b\x21\x2a\xb6\x12\xfc\xb0\x8d\x5a\x36\x7c\x70" +
+		"\x11\x98\x31\x4d\xcb\x88\xfb\x8c\x96\x81\x88\x82\x3c\x24\x2f\x3b\xcd\x04\x5b\x96\x81\x65\xa7\xab\x40\x56\x13\xfc\xdd\x23\x46\x6d" +
+		"\x8a\xd2\x10\x4d\xaf\x1f\x8b\x01\x9b\x66\x80\x99\x73\xc0\x23\xa2\xb0\xe2\xc1\xd6\x19\x60\xf5\x2c\x70\x6b\x84\xbe\x8a\xd6\x66\x69" +
+		"\xad\xe0\x61\xb7\x24\x30\x95\xea\xa3\x3c\x2c\x69\x8f\xfe\x50\x65\xb8\xae\xc5\x60\xab\x64\xa2\x96\x81\x6d\xeb\xc1\xd6\x8b\x9d\xc5" +
+		"\x48\x08\xfe\x4f\x92\x35\xda\xf6\x05\x7d\x3f\x41\x94\x5d\x7d\xb2\x3a\xa2\xe9\x31\x1a\xfa\x5a\xaf\xa6\xc2\x1f\x0f\xb8\x46\x8f\x20" +
+		"\x76\x83\x94\x06\x9d\x0d\xf6\x4a\x90\xd9\x07\x1e\xb9\x52\xeb\xaf\xe4\x75\xb1\xe7\xeb\x09\xa6\x49\x29\xca\x2c\xfd\x96\x3a\x61\x9e" +
+		"\xbc\x64\x30\x7b\x35\x98\x15\xe3\x86\x4d\x07\xaf\x55\xf0\xad\xf4\x6a\xc5\x4e\xf6\x5a\x0d\xae\xde\x0c\xf6\x2c\x01\x3f\x28\x34\x1a" +
+		"\x54\x6b\x96\x3a\xaf\xd5\xea\xbb\x94\x53\x73\xf6\x87\xdd\xa2\x34\x89\x0a\xd4\x4c\xd7\x15\x1e\x4f\x56\x8b\x34\x49\xd3\xec\xff\x05" +
+		"\xe6\x26\xa9\x87\x58\x43\xf0\x18\x35\x9d\xd4\x97\xac\xd5\x1a\x52\x1d\x73\x2d\x78\x09\xf8\xfa\xdd\x46\x4b\x89\xaa\xbf\xaf\x2c\xdc" +
+		"\x7c\x8e\x3a\xd0\x9f\xff\x8e\x2a\xd7\x52\xcb\x5a\xbe\x9b\xe0\xe6\x8f\x64\x5f\xa3\xc1\xaa\x08\xb0\x33\x46\x6d\x87\xba\x50\xb0\xe6" +
+		"\xbb\xfa\x26\xa3\x14\xe8\xd6\x9e\x0e\x3e\x2e\xb7\xde\x75\x35\xd8\xb4\x0a\x6c\x5b\x04\xfe\xf1\x4c\x2d\xc0\x68\xf5\xe8\x4b\x0f\x2d" +
+		"\x6e\x99\xc4\xb6\x68\x70\xc7\x5c\xb0\x30\x4c\x51\xb5\xc3\xab\xfc\xb9\x27\x54\x79\xa0\xd4\x72\xb0\xa7\xd0\xcd\x2e\x44\x29\x98\x48" +
+		"\xd0\xb8\x2a\x5c\x0d\x9f\x4f\x7e\x27\xc0\xbb\x5d\x28\x1e\xa5\xa6\x9c\x10\xa9\x97\x1a\xa9\x11\x02\x97\x2e\x74\xac\xc1\x4c\xa3\xfa" +
+		"\xe8\xf5\xc7\xa8\x2f\x00\x64\x2e\x07\xef\xbf\x47\x46\xbe\x0e\xec\xe8\x24\xf8\xa4\x40\x5d\xd5\x59\x60\xcf\x6a\xb0\xfa\x07\xaa\xb3" +
+		"\xd7\x82\x9b\x1e\x91\xe1\xd3\xa9\x11\xfc\xf4\xf9\xe0\x9b\x47\x09\xee\xa3\x5a\x21\xe9\x51\xda\xef\xb9\x06\x2c\xfb\xa2\x3a\xea\x6d" +
+		"\x46\xeb\x20\x7b\x56\xa8\xe5\x53\x36\x4f\x5f\x2e\xfd\x4c\xde\x64\x7d\x4b\x90\x55\x2a\xc5\xc5\x41\x59\x0b\xde\x77\x29\xf8\xbb\x35" +
+		"\xfa\xb6\x69\x6a\x02\xd8\x3d\x43\xcd\xb4\x6c\x3f\xd8\x39\x47\x75\x5b\xae\xa5\xdc\x5d\xe6\x7c\x79\x84\x03\xbf\x7d\x11\xce\xab\x11" +
+		"\xc3\xe1\x8e\xa7\xd2\xe5\x55\x2b\x57\xf6\xa0\x6b\x2e\xd8\x3e\x0b\x6c\x09\x55\xfd\xf3\x60\xa5\x51\x1b\xa6\x29\x5f\xc0\xf7\x8b\xe0" +
+		"\xcb\xc7\x09\xee\xf8\x2e\x78\x2c\x19\xdc\x75\x3b\xd8\xbe\x12\xec\x5a\xa1\xa5\x3b\xc2\x6a\xfb\x92\x34\xbf\xd1\xf3\x1d\x35\x57\xfe" +
+		"\xba\x02\xdc\x7b\x86\x9a\x2d\x9b\x6f\x05\x1f\xbf\x48\xab\xf4\x2b\x8d\xe6\x84\x8f\x4b\xd6\x61\xa6\x96\x81\x67\x18\x8d\x6b\x8a\xb8" +
+		"\xd7\x7f\x0b\xdc\x2a\x45\xda\xe1\xe0\x0d\xe0\xdb\xc5\x46\xeb\xad\x8f\xf8\x14\x22\xaa\x97\x69\xa0\x2b\xd7\xd6\x6a\xd5\xfd\xb3\x1d" +
+		"\xc1\x14\x5f\x6a\x39\x58\xf7\x2f\xca\x37\x2f\x7f\xee\xe6\xa8\xea\xbd\x9a\xb5\x28\x0a\xd1\xa0\x5a\x5e\x9c\xc6\xf2\x0b\xd6\x82\x95" +
+		"\x6b\xc0\xd7\x1b\x65\xab\x64\xbf\xee\x97\x10\xfe\x95\xfa\x2a\x58\xfb\x2a\xf0\x89\x57\x09\xee\x39\x0d\x2c\x88\x07\x5f\x5a\xaf\xb5" +
+		"\x2e\xb5\xe7\x83\xfd\x5f\x05\x9f\x6d\x72\xdf\x33\xeb\x8f\xd3\x9a\xbb\x56\x0b\x1c\x58\xac\xb1\xb9\x54\x03\x76\xac\xd7\xf8\x90\x6c" +
+		"\xc9\xd1\x65\x4a\xd9\xb4\x39\xe0\x6b\xaf\x50\x5f\xee\xd8\xbd\x1c\xec\x22\xf8\xfe\x55\xaa\x0f\x76\x84\x81\x9d\x2f\x11\x7c\xb3\x90" +
+		"\x60\x79\x32\x78\xe0\x74\x7d\x65\x51\xa8\xd2\xbc\x11\x7c\xec\x30\xc1\xec\x4b\xb5\x3c\xb0\x55\xca\xb8\x67\xe8\xbb\x7b\x77\x0a\x0a" +
+		"\xd6\xcd\xd3\x85\x49\xde\xbd\xff\xb7\x04\xab\x96\x83\x0f\xbd\x47\xb0\xfa\x22\xb0\xe6\x5e\x71\x09\x3e\x25\x78\x20\x54\x5f\x02\x2c" +
+		"\x8b\x51\x0d\x2f\xfb\x33\x14\xa2\x25\x51\xd5\x04\x3b\x62\xc1\x3c\x3f\x38\x1c\xa9\xef\x14\xca\x2b\x96\x1d\xf2\x6e\xcc\x62\xf0\x5d" +
+		"\x41\xc8\xda\x38\x70\x68\x03\x98\xbb\x4c\x3d\xdd\xec\x9b\x34\xcf\xd2\x1a\xa2\x71\x92\xbf\xe5\xba\x01\xc1\xba\x2f\x82\x7f\xf8\x32" +
+		"\x58\x25\x3e\xe0\x0a\x4d\xfb\x66\xce\x54\x37\x6b\x5b\xac\xba\xc6\xf2\x42\xcd\xe1\x2f\xea\xfb\x24\x6f\x55\x12\x6c\xd8\xa0\x39\x95" +
+		"\xce\x0b\xc0\x67\xd2\x08\x6e\xbf\x58\x15\xd6\xd1\x4b\xc1\xec\x0d\x60\xea\x42\xb7\xb8\xdb\xaf\xb9\xb3\xfa\x99\x1a\x82\xf9\xc3\xe9" +
+		"\xe0\x6f\x5e\xa7\xbe\xdc\x37\x34\x1f\xac\xf9\x91\x06\xcc\xff\xd2\x46\xdd\xa2\x6d\xe1\xaa\xc3\xaf\x06\xfb\xe4\x25\x8f\xef\x83\x19" +
+		"\x31\x60\xfe\xf9\x5a\x60\xeb\xa8\xd9\xa1\x88\x91\x6f\xd8\x3c\xee\x55\x10\xcc\xf5\xeb\x3b\x99\xc5\x7e\x35\xc9\x96\x2b\x6a\xe6\x2f" +
+		"\x54\x6b\x7e\xeb\x5c\x30\x2d\xa0\xaf\x81\x14\x52\x5d\xad\x0c\x5b\xff\xae\x8a\xd4\x6b\xfa\x66\x28\xea\x15\x27\xea\xeb\x9a\xbf\xf9" +
+		"\x58\x2e\x9c\xeb\x00\xea\xb1\xf9\x60\xb7\xbc\xa7\x98\x49\x8d\xd9\xb7\xaf\x51\x8b\x2a\x35\x51\x93\x9f\x52\x43\x5a\x9f\x00\x66\x4b" +
+		"\x79\xfc\x39\x60\x61\x14\x58\xb0\x0a\x14\x87\xdb\x0b\xde\xb5\x55\xb6\xe4\x41\x82\xbf\xb9\x10\xdc\x9f\xe1\x56\x6c\xd4\xae\xd2\xfa" +
+		"\xdb\x77\x8e\x51\xb3\x09\x39\x57\xb8\xd1\xc3\x4b\xc1\x92\xd9\x5a\x01\x76\x7f\xb6\x01\x33\x56\x6a\x7c\x36\x67\xad\xae\x53\xac\xf5" +
+		"\x6b\x35\x29\xd7\x2b\x3e\xf5\x8d\xe0\xc3\x4f\x1a\xb0\x5d\x60\xff\x6d\x01\xc5\x3c\xaf\xc6\x00\x9b\x25\x6f\x12\x0f\x36\xcd\x04\x33" +
+		"\x44\x75\xcc\x02\x1b\xcf\x01\x3b\x23\xc1\x4a\xc9\x40\x49\xe6\x60\x86\xda\x14\x77\x8b\xce\x3c\x24\x29\x89\x27\xa8\x85\xee\xf2\x45" +
+		"\xb3\xbf\xbf\xdb\x28\x23\x6e\x04\xf7\x4f\x53\x5b\x78\x6b\xa8\x5a\x54\xc7\xa9\xaf\x20\x54\x19\x98\x86\xeb\x35\x02\x24\x84\x7d\x41" +
+		"\x22\xd5\x45\xa2\x58\xee\x15\x32\x76\xf8\xb5\x44\xb2\xd5\x38\xba\xf7\xc8\x75\xaa\xc2\xc5\x99\x2e\x88\x01\x7b\x36\xe8\x36\xa4\xcf" +
+		"\x01\xdf\x7e\xdc\xa8\xfd\x9c\x97\x49\xcd\x58\xa6\x9e\xed\xa8\xcf\x2d\x06\xfc\x68\x87\xd1\xa0\xcb\x81\x65\x6a\x50\x16\x86\xa9\xbd" +
+		"\x2a\x1e\x4b\x59\xac\x7a\x2b\x92\xdb\x69\xb6\xc1\xdc\x8b\x14\xb7\x76\xd8\x60\xd5\xbf\x80\x7b\x77\x0b\xa8\xd9\x9a\xf8\xa9\x0f\xd5" +
+		"\x28\xce\x0e\xbf\x3a\x28\x52\xdf\xdb\x29\xe5\xce\x51\x5a\x96\x50\x76\x93\xc6\x6f\xe5\x81\xdd\x54\xf1\x68\x5d\xa0\xbe\xd3\x47\x22" +
+		"\xc9\xfd\xff\x0c\xfe\xfe\x66\xb0\xf1\x4c\xad\x19\xed\xfd\x0f\x30\x2b\x0e\x7c\xa1\x8b\xaa\x0c\x25\xf3\xdb\xfb\x13\xb0\xf3\x27\x60" +
+		"\xcb\x0c\x8d\x1f\x39\x2f\xb8\xd3\xad\x50\x97\x0a\xa8\xc1\x2a\x82\x65\x91\xe0\xdf\x76\x09\xc5\x17\x68\xfd\x47\xf9\x0f\xc0\x07\x73" +
+		"\x08\xee\x7b\x92\xfa\x82\xf0\x5d\xe9\x46\x2d\xd5\xd6\x1f\x80\xc3\x8b\xdc\x22\xc0\xfa\xb5\x1a\x1f\x2f\x0a\x55\x4f\x68\x67\x92\x86" +
+		"\x5f\xf6\x68\x6e\x2c\xcb\x35\x17\x2f\x03\x7b\x12\x9c\x44\x7c\x9a\x47\x5f\x76\x91\xdc\xe6\xce\x18\x8d\x6a\xcb\xbb\x82\xcf\xf5\xcb" +
+		"\xc3\xa4\xa8\xed\x0c\x30\xed\x3c\xf0\x2f\x72\xe2\x4f\xcb\xd4\xfb\x10\xf3\xe1\x44\x04\x98\x36\x5d\xd1\x53\x0a\xfd\xf3\xd7\xeb\xd7" +
+		"\xc2\x89\x2c\x9c\xa3\x86\xd3\x7d\xa2\x04\xaa\x03\xea\x89\x48\xce\xac\x7c\x36\x58\x7f\xba\x7e\x5d\xc2\x71\xf7\x0b\x05\xea\xdc\xd7" +
+		"\x3b\xcb\x43\x14\x81\x1b\xce\x56\xcb\xeb\x84\xd1\xf2\xd5\x4a\xa3\x05\x99\xf2\x22\xcc\x47\x82\x28\xcd\x67\xa8\x16\xc9\x11\x8a\xc9" +
+		"\x74\x7f\xa9\x19\x50\x79\xd5\xb7\x3a\x01\xfc\xb4\xc8\x68\x46\x42\x66\xb9\x27\xda\xe1\xd2\x0c\xa3\x26\xdc\xd1\x10\x45\xec\xf9\xea" +
+		"\x60\xd5\x50\x2b\x0e\x86\xbd\x1a\xbf\xc8\x31\x1a\x5c\xa8\x36\x9a\x3b\x89\x51\x2c\x91\x6a\x82\x52\xaf\x43\xc1\x6c\xf7\xbd\xe5\xdc" +
+		"\x50\xad\xa3\x2a\xf1\x6a\x59\x40\x96\x4f\xff\x3b\xd1\x22\x7d\x59\xae\xd4\xd2\xb2\x87\x2e\x8f\xbe\xec\x50\x12\xad\x41\x83\xc7\x3a" +
+		"\xa9\x86\x76\xc3\xf3\x04\x9f\xed\x20\xd8\xbd\x02\x6c\x9a\x0d\xe6\x50\x5f\x39\xcc\x28\x20\x78\xcf\x07\x54\xcb\xab\xfb\x16\xf0\xc1" +
+		"\x87\xdc\x60\x68\xf6\xe9\xca\x7e\xe5\xee\xd7\x27\xb4\xaf\xd4\xe8\xad\x18\x1d\x9d\x57\x80\x2f\x96\x18\x75\x9a\x04\x2b\xbb\xcf\xd1" +
+		"\x17\xfd\x45\x7b\x56\x44\x39\xb5\xdf\x5d\xf3\x34\x02\x9b\x9e\xa4\x51\x1d\x29\x12\xcf\x97\x2f\x42\x7e\x91\xe0\x6d\x30\x3b\xae\x52" +
+		"\x91\x78\xb7\x82\x60\xa3\x28\x92\x08\xb5\x76\x4b\x43\xb5\x86\x51\xc8\x98\x3d\x0b\xfc\x24\x56\xcb\xf3\x9c\xb8\x7d\xb8\x9b\xf3\x98" +
+		"\x0b\x0e\x5e\x09\x1e\x58\x04\x0e\xda\xe0\x6b\xcf\x51\x91\x49\x5c\xc0\x8c\x6f\xeb\x57\x8a\x3c\x23\xf1\xc9\xea\x55\x60\xb5\x57\x2b" +
+		"\x79\x9b\x8d\xe3\x04\xaf\x87\x11\xc8\x69\x90\x6d\x0c\xd3\x77\xef\x5a\xa8\xf9\xdb\x16\x37\x0e\xde\xea\x53\xcd\x7d\x90\x5a\x97\x2e" +
+		"\x79\x97\xfe\x79\xe0\xa0\x64\x71\xe6\x80\x8f\x95\x1b\xf5\xab\x6b\xe6\xa8\xa0\x56\x78\x1c\x8f\x4d\x84\xbe\x33\x5a\xfd\x13\x79\x2b" +
+		"\xbf\x28\x5c\xc3\x5f\xe9\x1e\x85\x9d\xd4\xe5\x5a\xc8\xd1\xe3\xd5\x6c\x91\x44\xed\x25\xf4\x73\xe7\x5f\x08\x76\xde\x25\x1e\xcb\x4d" +
+		"\x60\xe9\xd9\xe0\x9e\x62\x82\x4f\x0c\x53\xab\x55\x24\x1e\xd5\xba\xc8\xf1\x93\x25\x10\x57\xe6\x53\x3d\xfc\x63\xcd\x0a\xf7\x9d\xa9" +
+		"\xaf\x26\x55\xcc\x03\x9f\x2b\x35\xba\x9c\xb4\x08\xe5\xa9\xaf\x69\xa4\xff\xe7\xfa\x4d\x2f\xf2\xe7\x91\x59\x9a\xdd\x97\x57\x74\x9a" +
+		"\x7e\x08\xbe\xd6\xaf\x6e\xe0\x11\xd7\x8e\xee\xb9\x59\xab\xd0\xda\x08\x76\x5f\xa5\xf2\xed\x60\x42\xfd\xaf\xc1\x3b\xeb\x09\xd6\x5c" +
+		"\x08\x66\x2f\x03\x0f\x5d\x0e\xbe\xf2\x7b\xea\x8b\x9a\x4d\x37\x80\xaf\xac\x02\xf7\x53\x93\x2d\x22\xa0\xc3\x4b\xc1\x96\xef\x68\x1a" +
+		"\xbe\xe1\x5c\xf0\x8d\x3a\xd9\x86\xeb\x34\xa1\x57\x15\x03\xa6\x5d\x04\x6e\x72\x0a\x2c\x04\xf7\x5b\x36\x82\x6f\xef\x31\x5a\x1f\x5b" +
+		"\x64\x81\x1d\xf2\x8e\xcd\x97\xc1\x3d\x3f\x03\x3f\x71\xbe\x36\x44\xde\xc5\x17\xf7\x3d\x0e\x7c\xf4\x6e\x6a\xf5\x5f\x41\x1a\xc1\x1d" +
+		"\x6b\xc1\xf7\x7f\xe5\x44\x0a\x63\xc0\x87\xbf\x06\xf6\xae\x04\xb7\xad\x01\x2b\x36\xaa\x33\xf1\x4e\x23\xb5\x90\x6b\x38\x42\x43\xf3" +
+		"\x72\x5c\x17\xa6\x21\xa1\x8c\x30\x7d\x17\xcc\x65\x84\xf6\x28\x85\xfd\xae\xa5\x30\x1b\x34\x4c\x9e\x9a\xac\xe1\x3b\x09\x59\x0f\x2f" +
+		"\x85\x79\xf3\x2f\x06\x7c\xf6\x3c\xf0\xaf\xb7\x83\x4d\xb7\x6b\xc9\x69\x5f\xbf\x0d\xf3\xc0\xf3\xb6\xf3\x1d\xe2\xb2\x80\xe7\xec\xb1" +
+		"\x2f\xc3\xb3\x13\x41\x05\x2f\x0b\xd6\x7a\x7c\xe3\xb3\x61\x9f\x7e\xf7\xf6\xe7\x77\x80\x17\x82\xa9\x9b\x09\xda\x96\xe9\x5c\x03\x13" +
+		"\x02\xe3\x85\x79\x61\x2f\x0d\xcc\xe2\xc5\x80\x59\xb2\x04\xa3\x7f\x19\x43\x63\xda\xf7\x12\x16\xc1\xcf\xea\x08\x5a\x86\x0d\xbf\x21" +
+		"\x28\x1c\x94\x08\x02\x86\x64\x8f\xcf\xf0\xaf\x3e\xd0\x07\x73\xcf\x4f\xc1\xcf\x7d\x60\xce\x26\x92\x6f\x6c\x22\xd8\x28\xcd\xe3\x77" +
+		"\x13\x46\x8c\xf3\x4c\xc2\xfc\xc1\x07\x6e\x4a\x75\x06\x33\x6d\x19\xd4\x48\xa6\x28\xd2\x17\x7f\x01\xde\x15\x41\x4f\x6a\x3d\x3d\xac" +
+		"\xff\x99\xe1\x70\x9c\xe1\x33\x07\x0d\x9c\x39\xf0\xf5\xbd\x24\xdb\x65\x33\xde\x90\xa6\xf8\x73\x1f\xcc\x27\x7b\x6d\x4c\x32\x71\x98" +
+		"\x67\xf6\x52\x6f\x33\xe1\x06\x66\x55\xe7\xf9\x80\x59\xed\xb4\x6b\x9c\x76\xad\xd3\x9e\xee\xb4\xeb\x9c\xf6\x0c\xa7\x5d\xef\xb4\x67" +
+		"\x4a\x6b\x7d\x75\xd5\xca\xaf\x3b\xdd\x2a\xed\x56\x6b\xb7\x46\xbb\xb5\xda\x9d\xae\xdd\x3a\xed\xce\xd0\x6e\xbd\x76\x67\x3a\xdd\xea" +
+		"\x95\x5f\x07\x7f\xfb\x3c\x0d\xfb\x32\x09\xfe\xd5\xe0\xff\x82\x09\x05\x8a\xa7\x05\xe0\x59\x7c\xfe\x12\xc0\xb3\xf8\x02\xa7\xbd\xd0" +
+		"\x69\x2f\x72\xda\x8b\x9d\xf6\x12\xa7\xbd\xd4\x69\x2f\x73\xda\x2f\x39\xed\x46\xa7\xbd\xdc\x69\xaf\x70\xda\x2b\x9d\xf6\x2a\xa7\xfd" +
+		"\xb2\xd3\x5e\xed\xb4\xd7\x38\xed\xb5\x4e\xfb\x4f\x4e\x7b\x9d\xd3\xfe\xb3\xd3\x5e\xef\xb4\xff\xe2\xb4\x37\x38\xed\x57\x9c\xf6\xc6" +
+		"\x25\x48\x7a\x36\xf4\xff\x06\x32\xfd\xff\x3f\x85\x69\x22\x4f\x86\x20\x61\x1e\x26\xac\xb9\xe4\x0a\xd2\xc0\x2c\x01\x2d\x98\x06\x5a" +
+		"\xfc\x48\xbf\xd3\xdf\x03\x06\xc8\x6a\x82\x11\x84\x59\xd9\x79\xfe\xff\x6e\xf6\x0c\xe9\xb6\x01\x23\x9c\x61\x84\x31\x8c\xf0\x85\x11" +
+		"\xb6\x30\xc2\x15\x46\x98\xc2\x08\x4f\x18\x61\x09\x23\x1c\x61\x84\x21\x8c\xf0\x83\x11\x76\x30\xc2\x0d\x46\x98\xc1\x08\x2f\x18\x61" +
+		"\x05\x23\x9c\x60\x84\x11\x8c\xf0\x81\x11\x36\x30\xc2\x05\x46\x98\xc0\x08\x0f\x18\x61\x01\x23\x1c\x60\x6e\x94\xe6\x5f\xa5\xf9\xa6" +
+		"\x34\xdf\x92\xe6\xdb\xd2\xfc\x9b\x34\xdf\x91\xe6\xbb\xd2\xdc\x22\xcd\xad\xd2\x7c\x4f\x9a\x7f\x97\xe6\xfb\xd2\xfc\x40\x9a\xdb\xa4" +
+		"\xf9\x0f\x69\x7e\x28\xcd\x8f\xa4\xf9\xb1\x34\xff\x29\xcd\x4f\xa4\xf9\xa9\x34\xff\x25\xcd\xcf\xa4\xf9\xb9\x34\xbf\x90\xe6\x97\x4b" +
+		"\xc0\x7b\xef\x77\x53\x65\xf7\x1b\x30\x04\x2c\x4a\x25\x60\x2d\x5e\x7d\xc6\x12\xa7\x5b\xaf\xdd\x99\x4e\xb7\x66\xe5\x12\xc3\x45\x80" +
+		"\xd9\xb9\x61\xe7\x06\xc0\xec\xda\xb0\x6b\x03\xe0\x91\xa3\x9d\x1b\xc0\x0f\x6f\x07\xef\xd6\x34\x26\x0c\x2b\x52\x09\x3e\x95\xca\xe0" +
+		"\xbf\x7a\xff\x5b\x6e\xbe\xfc\x2d\xd9\x85\x3f\x5e\xfe\xd6\xf9\x84\xd9\x25\x47\xd6\xc1\xf3\xc0\x37\x17\x81\x96\x91\x0f\x3a\xf5\x03" +
+		"\x67\xb3\xf6\x5d\xde\x79\xbe\x05\xf3\xcc\xe5\x6f\xca\xd1\x1e\xe9\x1c\xdf\xca\x47\xbe\x6c\x79\x79\xf1\x34\xfe\x21\xce\x30\x3b\x9e" +
+		"\x04\x08\x0f\x8f\x2c\x62\xe0\xb5\x94\x80\x4c\x7a\x95\xce\x7d\xb5\x76\x6b\xb4\x5b\xab\xdd\xe9\xda\xad\x5b\x02\x7e\x6a\x81\x4f\x47" +
+		"\x80\xdb\xd6\x81\xaf\x2f\x01\xa3\xc0\x6f\x81\xcf\x07\xe8\xbc\x1d\x03\x0f\xd7\x1a\xde\x19\xe9\xc6\x48\x49\xf3\xa1\x87\xbc\x11\xf4" +
+		"\xc0\x2c\x05\x1f\x23\x3c\xe9\x06\x30\x9f\x50\x66\x36\x44\xc3\x77\x0c\xb9\x23\x9c\xdc\x1e\xe1\x18\x0c\x7f\xdd\x44\xf0\xe8\x3d\x04" +
+		"\x5f\x96\xeb\x76\x5f\x5c\x6d\xd3\xdc\x3a\x52\xda\xfb\x31\x41\x5b\x75\xf4\x8b\x59\x04\x33\xef\x71\xe3\x53\xbf\xdf\x4c\x2f\xfb\x5f" +
+		"\x24\xf9\xfb\x2f\x01\x66\xee\x79\x84\x39\xef\x3c\x75\x5b\x45\xbf\x6e\x0a\xd3\xef\xd9\x38\x1d\x8c\x05\xe3\xc0\x69\x60\x36\x9d\xe3" +
+		"\x75\xe0\x17\xc0\x79\xce\x07\x7e\xe7\x33\xa9\x11\x7f\xd2\x0b\x7e\x9d\xbc\x4e\x8b\x89\xa2\x34\x25\x7c\xdf\x17\x61\xa5\xe0\x1f\x27" +
+		"\xd6\x29\x38\x03\xbc\x27\x0c\xc6\xe3\x7e\x69\x38\x6f\x03\x09\x7a\xc0\x08\x1f\x53\x13\x42\x19\x15\xca\x73\x2c\xbe\x9a\x00\x5a\xe4" +
+		"\xd6\x04\x0f\x61\x9c\x23\x1f\x69\x83\x3e\xd0\x0e\xf0\x3b\xa0\x05\xd2\x69\xbf\x02\xfa\x2d\x02\xf4\x59\x26\x0a\x30\xe7\xaf\x10\x51" +
+		"\x95\xe6\x42\x69\x2e\x92\xe6\x62\x69\x2e\x91\xe6\x52\x69\x2e\x93\xe6\x4b\xd2\x6c\x94\xe6\x72\x69\xae\x90\xe6\x4a\x69\xae\x92\xe6" +
+		"\xcb\xd2\x5c\x2d\xcd\x35\xd2\x5c\x2b\xcd\x3f\x49\x73\x9d\x34\xff\x2c\xcd\xf5\xd2\xfc\x8b\x34\x37\x48\xf3\x15\x69\x6e\x94\xe6\x5f" +
+		"\xa5\xf9\xa6\x34\xdf\x92\xe6\xdb\xd2\xfc\x9b\x34\xdf\x91\xe6\xbb\xd2\xdc\x22\xcd\xad\xd2\x7c\x4f\x9a\x7f\x97\xe6\xfb\xd2\xfc\x40" +
+		"\x9a\xdb\xa4\xf9\x0f\x69\x7e\x28\xcd\x8f\xa4\xf9\xb1\x34\xff\x29\xcd\x4f\xa4\xf9\xa9\x34\xff\x25\xcd\xcf\xa4\xf9\xb9\x34\xbf\x90" +
+		"\xe6\x97\xd2\x54\xde\xeb\x71\x64\xe2\xe3\x77\x8d\xd3\x0f\xdf\xaf\xc7\x9b\x3e\xd6\xe3\xd4\x2e\xed\x1f\x2c\xd3\xf3\xa9\x9f\xe9\x71" +
+		"\xfa\x16\x3d\xbe\xdf\xed\xf3\xd3\xb5\x7f\xe3\x3d\xfd\xbc\x71\x58\xfb\xfc\x41\xed\xb7\xdc\xa7\x9f\xd7\xef\xd2\x7e\x4b\x9b\x9e\xff" +
+		"\x5b\x96\x1e\x0f\xe6\x68\xff\xdc\x71\x3d\xdf\x5b\x4c\x3d\xce\xd3\xe3\xcc\x6c\xfd\xfc\x91\x06\x3d\xbf\xf3\x51\xed\x5b\x06\xb4\x7f" +
+		"\x67\xbb\xf6\x4f\x56\xe8\xf5\xaf\xef\xd6\xe3\x7b\x4b\xb4\x3f\xfe\x81\xf6\x2f\x3f\xa6\x7d\xc7\xfd\x7a\x5d\xd9\x21\x3d\xfe\x6b\x91" +
+		"\xf6\xf7\x3d\xaf\xfd\xa3\x3b\xf5\xf3\x0f\xeb\xf4\x78\xf3\x23\xda\x6f\x79\x53\xfb\xa6\x2a\x77\x3c\xf7\xbe\xfa\x4f\xb4\xdf\xfd\x47" +
+		"\xed\x1f\x77\x9f\x7f\xf7\x3e\xed\x5b\xdd\xf9\xd5\xb5\x6b\x7f\xc4\x1d\xb7\xd7\xa5\x5f\xf5\x80\x99\x74\x1f\x46\xe8\x31\x42\x87\xa6" +
+		"\x76\xed\x3f\xd8\xac\x9f\x1f\x74\xe9\xf6\xa0\x3b\x4e\x7f\xa9\x67\x1c\xbd\xfa\xb7\x78\x1c\x94\x1b\x3e\x6e\x9c\x7e\xff\x47\xda\xe7" +
+		"\x0d\x6a\x5f\x76\xa7\x7e\xde\x98\xa5\xfd\x96\xfb\xb4\x2f\xc9\xd7\xbe\xbf\x40\xfb\xfa\x5d\xda\x67\x17\x6b\x9f\xfb\x8e\xde\x5f\xfe" +
+		"\xb6\xf6\x9b\x3e\xd6\x3e\xb5\x4b\xfb\x07\xcb\xf4\xba\xd4\xcf\xf4\x38\xdd\x9d\xc7\xfd\x6e\x9f\x9f\xae\xfd\x1b\xef\xe9\xe7\x8d\xc3" +
+		"\xda\x67\xbf\xa1\x7d\xbe\x3b\xbf\x7b\xef\xd2\xeb\x06\x73\xdc\xfe\x53\x3d\xff\x37\x77\xbe\x0f\xb9\xe3\xec\x74\x3f\x4f\x6d\xd7\xcf" +
+		"\x77\x3e\x2a\xe0\xe9\x1d\x0e\xfc\x4f\x90\x48\x76\xc3\xb9\xfb\x03\x77\x94\x2d\x6d\xda\xf7\x16\xd3\xe9\xdf\xd9\xa2\xc7\x4f\x1c\xd2" +
+		"\xe3\x5e\xf7\xf3\x8f\xdf\xd5\xbe\xde\x9d\xdd\x0b\x2e\x55\x6b\xd3\xb4\x3f\xbc\x49\xfb\x9e\xcd\xda\x67\x66\x6b\xdf\x74\xaf\x4b\x15" +
+		"\x97\xea\xa9\xb9\xda\x3f\xeb\x52\x7b\xd0\xbd\xae\xc3\xa5\x66\xba\xfb\xbc\x87\xdc\xfe\xa8\x3b\xee\x23\x0d\xee\xfc\x46\xfa\xed\xda" +
+		"\xbf\xbe\x5b\x7a\x6e\x5b\xaf\x9c\xff\xa6\xcb\xe9\x4e\xdf\x7d\x97\x8e\xf1\xb2\x7b\xfc\xd9\x93\x7a\xbc\xfb\x8f\x7a\xfc\xf8\x6e\xed" +
+		"\x8f\xbd\xa1\xfd\xef\xdd\xfb\xcb\xdc\xb5\x57\xbc\xa4\xfd\x89\xf7\xb4\x3f\x52\xa7\xfd\x03\x4f\xe8\x38\x9b\xcb\xe5\x98\x29\xca\xfc" +
+		"\x4a\x06\x77\x93\x3b\xdc\x69\x6f\x72\x97\x5d\xeb\x6e\xde\x41\xb7\x7f\xe1\x01\x77\xd9\x25\x2e\x59\xdc\xe5\xe6\xbb\xcc\x76\xd4\x25" +
+		"\x77\x5b\xa9\xe7\xff\x28\x53\x8f\x8c\xd7\x38\x3c\x7e\x5b\x47\xe6\x3d\xc2\x7c\xc7\xde\x35\xe3\xb6\xb7\x63\x8a\xed\x9d\xb8\xae\x91" +
+		"\xf5\x16\xb8\x6c\xd6\xbf\x65\xfc\xb6\x8f\xac\xb7\xdf\x9d\xc7\x96\x77\xcd\xa4\xc2\x36\x22\x1c\x53\xd1\x65\x44\xd8\x5d\xf6\x99\x5a" +
+		"\xc1\xce\xe4\xae\xe5\xa1\xd6\x7b\xf1\xe4\x70\xbc\xe1\xc7\xf1\xa3\xca\x32\xce\xa9\x71\xb0\xcc\xd8\x5a\xab\x5d\xda\x65\xbb\x02\x79" +
+		"\xbf\x3b\xe7\x61\x77\x6d\xfd\x6e\x5f\x59\xee\x79\xf3\x7f\x69\x41\xff\x9f\x76\x41\x52\x0b\x14\x63\xb3\x5d\xec\xbd\xc7\x3d\xfe\xf3" +
+		"\x93\xda\xe7\xb9\xe7\x3f\x28\xd4\xfe\x2e\xf7\xf3\xf7\xdd\xe3\x37\xdd\xe3\x17\x54\x17\x59\x5f\x7d\xa1\x98\xa9\x05\xfc\xba\xfb\x67" +
+		"\xf6\xf6\xd1\x3f\xef\x19\x3b\xfb\xe7\x27\x47\xff\xcc\x1b\xbb\xe0\x83\xc2\xd1\x3f\xef\x1a\xbb\xf6\xfd\xb1\xb3\x6f\xba\x67\xb3\xb7" +
+		"\xf3\x85\x62\xfe\xbf\x3f\xfb\x77\x5f\xd2\xa7\x67\xba\xfa\xf5\xfd\xc3\xda\x57\xb6\x69\x5f\xe2\x9e\x7f\xf5\x51\xd5\x67\x8f\x94\xcb" +
+		"\x31\x8f\xac\x82\x1d\xfa\xbf\xbe\xdd\xda\x14\x00\x5f\x5e\xef\x9a\x8c\xbc\xf7\x6e\x82\x43\x7b\x09\x7a\xc0\x97\xcf\x03\x9f\xf8\x6f" +
+		"\xb0\xa1\x8e\xe4\x5e\x39\x99\x5a\x4f\xf0\x2f\xf2\x57\xd9\x5e\xc2\xa4\xf6\x7a\x60\x2c\x78\x03\x60\x8c\xfb\x8d\xe4\x3e\x0b\xb4\x61" +
+		"\xfb\x61\x6c\x39\x0c\x58\xa0\x05\x5b\x7f\xd8\xc0\x42\x6c\xd0\x2f\x0a\x78\x47\x7f\x4e\x20\x30\xee\x46\xfa\xe5\x02\x9f\x3d\xf2\xab" +
+		"\x06\x23\xf7\xfa\x47\x7e\xc8\x61\x74\x84\xe0\x5f\xa9\xf2\xda\xee\x13\xe5\xef\x91\x4f\xed\xa0\x7b\x8d\x05\x7b\xe4\x1a\xdf\x64\x17" +
+		"\x04\x0f\x6e\x05\x26\x0e\x1b\xea\xdc\x1e\x33\x72\xcd\xe8\x20\xfe\x93\x96\x66\xfb\xc7\x06\x9f\x71\xd2\x7a\xc7\x7e\x42\xc3\x3f\x6e" +
+		"\xb6\x9e\x91\x5f\xf3\x0b\x26\x85\x6d\xe3\xb4\x91\x2b\x83\x09\x1b\xaa\x0b\x0f\x9a\x7f\x40\x7f\x2a\x66\xe4\x89\x74\x3e\x1d\x5d\xa6" +
+		"\xe5\x0c\xa5\x4b\x88\xb5\xc7\xee\xf2\x39\x93\x19\x9d\xa7\xc7\x19\x70\x74\xe1\xbe\x91\x55\x8f\xce\xca\x38\x27\x47\xef\x0d\xd8\xe3" +
+		"\x1e\x47\xcb\xfd\x2d\x0d\x3a\x17\xcc\xb2\x26\xa1\x36\xfd\x23\x0c\x63\x83\x61\xee\x8f\xf9\x9c\xcc\x36\x3e\x67\xa8\x14\xcb\xfd\x6d" +
+		"\x03\xd7\x99\xb1\xe1\xf5\x4f\x79\xbd\x19\x99\xa7\xc7\x42\x82\x35\x09\x33\x58\x96\x10\x59\xb7\x72\x5e\xd0\x2a\x74\x13\x8d\x3d\xc6" +
+		"\x06\x3a\x55\x63\x8f\xf1\xa1\x9e\x89\x75\xae\x9f\x31\x9e\x02\xb6\x3d\x46\x46\x1d\xc4\xeb\x1f\xb7\xd1\xc1\xec\x71\xf2\x94\x46\x7f" +
+		"\x76\xc5\x13\xe6\x6c\x74\xd8\xc4\x05\x9e\x31\x72\x01\x6d\x97\x08\xc1\x3f\x9c\x33\x81\x61\xf4\x59\xba\x6b\xa3\x74\x18\x15\x16\x8f" +
+		"\x35\x76\xbb\x8e\x30\x3a\x37\x5d\xda\x04\x9a\xcc\x98\x6c\x07\xf5\xca\xb8\x93\x64\x27\x98\x1b\x75\xfb\xec\xc9\x98\x3f\x58\x28\x94" +
+		"\xb6\xde\xc0\xb8\xa7\x4c\xd8\xe2\x73\xfd\x63\x74\x9b\x8a\x07\x62\x9d\x0d\xd5\xcb\xc2\x9c\x47\xf8\x9c\x79\xea\x4e\xad\x09\xe2\x6d" +
+		"\xcb\x46\xca\xc8\xf9\x51\xfe\x4f\x19\x3f\x1f\xff\xf8\xfd\x0d\xa6\xc9\x28\x63\x8c\x9e\xf1\x04\xdc\x1f\x44\x09\x66\xc8\x08\x67\xb6" +
+		"\x29\xd6\xa9\xd6\x75\xf2\x50\xba\x7d\x61\x41\x40\x74\x32\x52\x45\x59\x48\x3a\x69\xa7\x38\xc5\x5e\x4c\xb5\x77\xc1\xdb\x6a\xa6\xbe" +
+		"\x78\xc2\x19\x63\x63\xa6\x3d\xf9\x65\xb4\xe0\x0d\x1b\xbb\x6c\x82\x50\x7b\x03\xb0\x6d\x84\x05\x11\x64\x9c\x08\xd8\x93\x4b\x74\xc0" +
+		"\x61\x7b\xcb\x99\xed\x28\x08\x4c\x00\xf6\x48\x0b\x29\xf6\x94\x4c\xe8\x0f\xfa\xe1\x9f\x31\xcc\xb1\xdc\x09\x78\xec\x71\xd7\xcc\x08" +
+		"\x92\x85\x51\xda\xba\x3f\xa6\x1a\x74\x38\xff\x64\x96\xf6\x8f\xfd\xde\x8f\x15\x36\xf6\x88\x09\x60\x3e\x0a\xef\x61\x16\x16\x4e\x26" +
+		"\x17\x5e\x7b\x1c\x13\x8e\xd2\x41\x67\x38\xaa\x07\x13\xc6\xab\x83\x60\x52\xf8\x46\xf6\xd7\xd8\xe3\xb8\x77\x14\x04\xc6\x51\x60\x04" +
+		"\xde\x83\xf9\x21\x6c\xbc\x08\x4f\xca\x1b\xf3\x27\xdd\x44\xff\xc4\x93\x27\x0b\x69\x84\x8d\x45\x41\x82\xa6\x8a\x52\x61\x24\xc2\x59" +
+		"\x72\x4a\x10\x98\x7b\x6c\x9c\xeb\x4c\x2c\x6e\xfc\x04\x74\x4a\x37\x8e\xc7\x1f\x9f\x85\x85\x93\x71\x26\x9d\x93\xba\xea\x49\xd5\xeb" +
+		"\x54\xc6\xc0\x18\x7c\x85\x8d\xa1\xae\xe5\x07\x4f\x39\xc2\x28\xfc\x86\x8d\x68\xba\x09\x54\x0a\xe6\x8a\x93\x49\xed\xb3\x27\x57\x49" +
+		"\x13\x64\x2d\x58\x25\xb9\xff\xec\x89\xfb\x6e\x4d\x21\xd1\xb6\x3d\x46\xc3\xd1\x0b\xec\x91\xd1\x46\x61\xd0\x1e\xe1\x6a\x6b\x64\x7c" +
+		"\x8f\xed\x8e\xef\x0d\x92\x56\x63\x63\x86\x3d\x51\xa8\x8d\xed\xaa\x1e\x05\xde\x84\xf1\xac\x3e\xaa\x25\x83\xd9\xf5\x64\x73\x22\xd8" +
+		"\x32\x09\xd6\xb9\xcb\x83\xa6\xad\xd3\x0b\xf8\xc7\x6e\x0f\x9d\x82\x62\xf4\xbb\xbf\x67\xa6\xbf\x4d\x3a\xe9\x0e\x1a\x5d\xec\x64\xf8" +
+		"\x10\xd0\x41\x94\x91\x46\x7f\x9a\x65\x32\xc3\x66\x54\x49\x79\x22\xc6\xd9\xb4\xbe\x91\x87\x8e\xfd\x30\xdb\xc8\xfc\x4f\x9e\x4c\xe8" +
+		"\xff\x04\x93\x4f\x36\x39\x82\xaf\xd1\xbd\x98\x40\xcf\xbf\x3b\x66\xf0\xc4\xbc\x23\x0c\xa0\x3f\x44\x19\x8c\x8a\x7a\x81\x4b\xd8\xc0" +
+		"\x18\xf2\xd3\x3f\x82\x00\x81\x49\x56\x37\x6a\x97\x5a\x27\x39\x05\x13\x84\xc5\x6b\x9f\x4a\x3c\x75\x69\x33\x26\xbb\xd1\xe3\x97\x67" +
+		"\x05\xab\x83\x58\x6b\x8c\x27\x27\x2c\x3c\x6a\x0a\x1a\x9e\xac\x28\x27\x18\x06\x27\x33\x5b\xca\x64\xa8\xae\x9c\x63\x82\x6c\x36\x6b" +
+		"\x6a\xe8\x88\x3a\xc9\x66\x18\x45\xc8\x60\x0b\x67\xc2\x4e\xa9\x61\x7f\xb2\x34\xcd\x1e\xbf\xdd\xa3\xd2\x94\xe2\xd0\x6d\x86\xa3\x32" +
+		"\xcc\x49\x86\x8a\xc7\x76\x35\x72\xb0\x07\x71\xf2\x56\x7a\xac\x71\x5b\x99\xf2\x8f\x41\x6b\xe4\xc8\x0c\xa3\xa6\x60\xe6\x51\xfc\x4c" +
+		"\x99\xcc\xc2\x89\x9c\x42\xc6\x47\x17\x9e\x30\xb5\x5d\x34\x15\x2a\xa6\x4c\xe6\xb5\x9d\x5a\x46\xc4\xe0\x3c\xc9\x68\x3c\xb5\x36\xd1" +
+		"\x0b\x66\x9c\x72\x64\x9d\x5e\xdc\x78\xf3\xf5\x14\x24\xb2\x4f\x52\x8e\x27\x3f\x37\x74\xfc\xce\xa6\x4c\x3d\x01\xdb\x9e\x9c\x2d\xe3" +
+		"\x26\x33\xdb\xfe\xee\xfe\x26\x9c\xf4\x20\x6b\xe4\xe7\xb2\x13\x46\xc0\x3c\xc2\x1a\xe7\x0e\xfc\x5d\x4b\xd5\x63\xff\x7d\xff\x62\x82" +
+		"\xe9\x7e\x0a\x94\x3e\x85\xd3\xbd\xce\xfe\x1f\xd8\x09\x66\xc4\x01\xf4\x4f\x6d\xb7\xc7\x8d\xf0\x73\xc2\xd4\xa1\x0c\xef\x64\xd7\x4f" +
+		"\x65\x85\x9e\xcc\x5d\xf3\xa7\xde\x59\xcb\x82\x39\xe9\xe4\xa9\xf7\xf7\x1f\x94\x02\x8f\x35\x4e\x45\x4e\x78\x56\xb0\xb5\x13\x08\xd2" +
+		"\x68\x11\xe3\xb5\xdb\x29\xec\xf6\xe0\x31\x8d\x75\x2a\x71\x0e\xfb\x7b\x13\x0e\x56\xa9\xf2\x47\x60\xdc\x24\x27\x04\x43\x4e\x1e\xe7" +
+		"\xa6\x4d\x9d\x36\xd8\x9a\x4a\xf0\x69\x03\xbe\x7c\x3b\xd8\x77\x27\xc1\x6d\x3e\x7c\x63\xfb\x66\x06\x55\x3c\xfd\x7f\x03\x00",
+}
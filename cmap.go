@@ -0,0 +1,586 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// maxRangeCodes is the most codes of a bfrange or cidrange that are added to a cmap one by
+// one, and maxExpandedCodes the most codes all its ranges add. Other ranges are kept as
+// ranges, so a few bytes of a cmap can't make a huge map.
+const (
+	maxRangeCodes    = 1 << 16
+	maxExpandedCodes = 1 << 20
+)
+
+// cmap is a CMap: the character codes of a font mapped to Unicode or to CIDs, with the
+// codespace ranges that say how many bytes each code in a string takes up (section 9.7.6.2).
+// Codes are kept as the bytes they are in strings.
+type cmap struct {
+	codespace  codespace
+	chars      map[string]string // Unicode by code
+	bfRanges   []bfRange         // bfranges with more than maxRangeCodes codes
+	cids       map[string]int    // CIDs by code
+	cidRanges  []cidRange        // cidranges with more than maxRangeCodes codes
+	predefined *predefinedCMap   // the predefined CMap this one uses
+	useRef     string            // the embedded CMap this one uses
+	parent     *cmap             // the cmap for useRef once it's linked
+	expanded   int               // the codes ranges have added one by one
+}
+
+type bfRange struct {
+	low, high []byte
+	dst       []byte // the UTF-16BE text of low
+}
+
+type cidRange struct {
+	low, high []byte
+	cid       int // the CID of low
+}
+
+// lookup returns the Unicode for a code, or "" if the cmap and the cmaps it uses don't
+// have it
+func (c *cmap) lookup(code []byte) string {
+	for depth := 0; c != nil && depth < maxDepth; c, depth = c.parent, depth+1 {
+		if text, ok := c.chars[string(code)]; ok {
+			return text
+		}
+		for i := len(c.bfRanges) - 1; i >= 0; i-- { // later mappings override earlier ones
+			r := c.bfRanges[i]
+			if offset, ok := rangeOffset(r.low, r.high, code); ok {
+				return utf16Text(incrementLastByte(r.dst, offset))
+			}
+		}
+		if c.predefined != nil {
+			return c.predefined.text(code)
+		}
+	}
+	return ""
+}
+
+// cid returns the CID for a code and whether the cmap and the cmaps it uses have it
+func (c *cmap) cid(code []byte) (int, bool) {
+	for depth := 0; c != nil && depth < maxDepth; c, depth = c.parent, depth+1 {
+		if cid, ok := c.cids[string(code)]; ok {
+			return cid, true
+		}
+		for i := len(c.cidRanges) - 1; i >= 0; i-- {
+			r := c.cidRanges[i]
+			if offset, ok := rangeOffset(r.low, r.high, code); ok {
+				return r.cid + int(offset), true
+			}
+		}
+		if c.predefined != nil {
+			return c.predefined.cid(code)
+		}
+	}
+	return 0, false
+}
+
+// getCmap reads a CMap (section 9.7.5.4) or a ToUnicode cmap (section 9.10.3). Mapping
+// sections are read up to their end operators, since their counts aren't always right.
+// Errors are located at their offset in data.
+func getCmap(data []byte) (*cmap, error) {
+	p := newBytesParser(data)
+	p.content = true
+	defer p.close()
+	c, err := readCmap(p)
+	if err != nil {
+		return nil, newError(PhaseCMap, "", p.l.Offset(), err)
+	}
+	return c, nil
+}
+
+func readCmap(p *parser) (*cmap, error) {
+	c := &cmap{chars: make(map[string]string), cids: make(map[string]int)}
+	var prev interface{}
+
+	for {
+		item, err := p.item()
+		if err == io.EOF {
+			return c, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := item.(type) {
+		case token:
+			switch v {
+			case "begincodespacerange":
+				err = readCodespaceRanges(p, c)
+			case "beginbfchar":
+				err = readbfchar(p, c)
+			case "beginbfrange":
+				err = readbfrange(p, c)
+			case "begincidchar":
+				err = readcidchar(p, c)
+			case "begincidrange":
+				err = readcidrange(p, c)
+			case "usecmap":
+				if n, ok := prev.(name); ok {
+					c.use(n)
+				}
+			case "endcmap":
+				return c, nil
+			}
+			if err == io.EOF { // a cmap cut short keeps the mappings before the end
+				return c, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		prev = item
+	}
+}
+
+// use makes the cmap use a predefined CMap, or an embedded one once it's linked, for
+// the codespace ranges and mappings it doesn't have itself
+func (c *cmap) use(v interface{}) {
+	switch u := v.(type) {
+	case name:
+		if pc := lookupPredefinedCMap(u); pc != nil {
+			c.predefined = pc
+			c.codespace = append(c.codespace, pc.codespace...)
+		}
+	case *objectref:
+		c.useRef = u.refString
+	}
+}
+
+// link makes the cmap use the cmap it refers to
+func (c *cmap) link(parent *cmap) {
+	if c.parent == nil && parent != nil && parent != c {
+		c.parent = parent
+		c.codespace = append(c.codespace, parent.codespace...)
+	}
+}
+
+// nextEntry returns the next item of a mapping section, or nil at the operator that ends it
+func nextEntry(p *parser, endToken token) (interface{}, error) {
+	for {
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		if item == endToken {
+			return nil, nil
+		}
+		if _, ok := item.(comment); !ok {
+			return item, nil
+		}
+	}
+}
+
+// readCodespaceRanges reads pairs of low and high codes. Ranges whose codes aren't one to
+// four bytes long or don't have the same length are skipped.
+func readCodespaceRanges(p *parser, c *cmap) error {
+	var low []byte
+	for i := 0; ; i++ {
+		item, err := nextEntry(p, "endcodespacerange")
+		if err != nil || item == nil {
+			return err
+		}
+		h, ok := item.(hexdata)
+		if !ok {
+			return errors.New("invalid codespacerange data")
+		}
+		if i%2 == 0 {
+			low = hexBytes(string(h))
+		} else if high := hexBytes(string(h)); validRange(low, high) {
+			c.codespace = append(c.codespace, codespaceRange{low: low, high: high})
+		}
+	}
+}
+
+// readbfchar reads pairs of a code and its Unicode, which is UTF-16BE text or the name of
+// a glyph
+func readbfchar(p *parser, c *cmap) error {
+	var src []byte
+	for i := 0; ; i++ {
+		item, err := nextEntry(p, "endbfchar")
+		if err != nil || item == nil {
+			return err
+		}
+		switch v := item.(type) {
+		case hexdata:
+			if i%2 == 0 {
+				src = hexBytes(string(v))
+			} else {
+				c.chars[string(src)] = utf16Text(hexBytes(string(v)))
+			}
+		case name:
+			if i%2 == 0 {
+				return errors.New("invalid bfchar data")
+			}
+			c.chars[string(src)] = glyphText(strings.TrimPrefix(string(v), "/"))
+		default:
+			return errors.New("invalid bfchar data")
+		}
+	}
+}
+
+// readbfrange reads a low and a high code followed by either the Unicode of the low code
+// or an array with the Unicode of each code. The codes after the low one have the last
+// byte of its Unicode incremented (section 9.10.3).
+func readbfrange(p *parser, c *cmap) error {
+	var low, high []byte
+	for i := 0; ; i++ {
+		item, err := nextEntry(p, "endbfrange")
+		if err != nil || item == nil {
+			return err
+		}
+		switch v := item.(type) {
+		case hexdata:
+			switch i % 3 {
+			case 0:
+				low = hexBytes(string(v))
+			case 1:
+				high = hexBytes(string(v))
+			case 2:
+				c.addBfRange(low, high, hexBytes(string(v)))
+			}
+		case array:
+			if i%3 != 2 {
+				return fmt.Errorf("unexpected array at position %d", i)
+			}
+			if !validRange(low, high) {
+				continue
+			}
+			code := codeValue(low)
+			for _, dst := range v { // the array may be shorter than the range
+				if code > codeValue(high) {
+					break
+				}
+				if h, ok := dst.(hexdata); ok {
+					c.chars[string(codeBytes(code, len(low)))] = utf16Text(hexBytes(string(h)))
+				}
+				code++
+			}
+		default:
+			return errors.New("invalid bfrange data")
+		}
+	}
+}
+
+func (c *cmap) addBfRange(low, high, dst []byte) {
+	if !validRange(low, high) || len(dst) == 0 {
+		return
+	}
+	n := codeValue(high) - codeValue(low)
+	if !c.expand(n) {
+		c.bfRanges = append(c.bfRanges, bfRange{low: low, high: high, dst: dst})
+		return
+	}
+	for offset := uint64(0); offset <= n; offset++ {
+		c.chars[string(codeBytes(codeValue(low)+offset, len(low)))] = utf16Text(incrementLastByte(dst, offset))
+	}
+}
+
+// readcidchar reads pairs of a code and its CID
+func readcidchar(p *parser, c *cmap) error {
+	var src []byte
+	for i := 0; ; i++ {
+		item, err := nextEntry(p, "endcidchar")
+		if err != nil || item == nil {
+			return err
+		}
+		switch v := item.(type) {
+		case hexdata:
+			if i%2 == 1 {
+				return errors.New("invalid cidchar data")
+			}
+			src = hexBytes(string(v))
+		case integer:
+			if i%2 == 0 {
+				return errors.New("invalid cidchar data")
+			}
+			c.cids[string(src)] = int(v)
+		default:
+			return errors.New("invalid cidchar data")
+		}
+	}
+}
+
+// readcidrange reads a low and a high code followed by the CID of the low code
+func readcidrange(p *parser, c *cmap) error {
+	var low, high []byte
+	for i := 0; ; i++ {
+		item, err := nextEntry(p, "endcidrange")
+		if err != nil || item == nil {
+			return err
+		}
+		switch v := item.(type) {
+		case hexdata:
+			if i%3 == 2 {
+				return errors.New("invalid cidrange data")
+			}
+			if i%3 == 0 {
+				low = hexBytes(string(v))
+			} else {
+				high = hexBytes(string(v))
+			}
+		case integer:
+			if i%3 != 2 {
+				return errors.New("invalid cidrange data")
+			}
+			if !validRange(low, high) {
+				continue
+			}
+			n := codeValue(high) - codeValue(low)
+			if !c.expand(n) {
+				c.cidRanges = append(c.cidRanges, cidRange{low: low, high: high, cid: int(v)})
+				continue
+			}
+			for offset := uint64(0); offset <= n; offset++ {
+				c.cids[string(codeBytes(codeValue(low)+offset, len(low)))] = int(v) + int(offset)
+			}
+		default:
+			return errors.New("invalid cidrange data")
+		}
+	}
+}
+
+// expand checks whether a range that adds n more codes than its first one is small enough
+// to be added one code at a time
+func (c *cmap) expand(n uint64) bool {
+	if n >= maxRangeCodes || c.expanded+int(n) >= maxExpandedCodes {
+		return false
+	}
+	c.expanded += int(n) + 1
+	return true
+}
+
+// validRange checks that low and high are codes of one to four bytes with the same length
+// and that low isn't after high
+func validRange(low, high []byte) bool {
+	return len(low) > 0 && len(low) <= 4 && len(high) == len(low) && bytes.Compare(low, high) <= 0
+}
+
+// rangeOffset returns how far a code is from the low code of a range, and whether the
+// range has it
+func rangeOffset(low, high, code []byte) (uint64, bool) {
+	if len(code) != len(low) || bytes.Compare(code, low) < 0 || bytes.Compare(code, high) > 0 {
+		return 0, false
+	}
+	return codeValue(code) - codeValue(low), true
+}
+
+// codeValue returns the value of a code's bytes read as a big-endian number
+func codeValue(code []byte) uint64 {
+	var v uint64
+	for _, b := range code {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
+
+// codeBytes returns the n bytes of a code with a value
+func codeBytes(v uint64, n int) []byte {
+	code := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		code[i] = byte(v)
+		v >>= 8
+	}
+	return code
+}
+
+// incrementLastByte adds to the last byte of a bfrange destination. Files that overflow it,
+// which the rules don't allow, carry into the bytes before it.
+func incrementLastByte(dst []byte, offset uint64) []byte {
+	if offset == 0 {
+		return dst
+	}
+	next := append([]byte(nil), dst...)
+	for i := len(next) - 1; i >= 0 && offset > 0; i-- {
+		sum := uint64(next[i]) + offset
+		next[i] = byte(sum)
+		offset = sum >> 8
+	}
+	return next
+}
+
+// utf16Text decodes the UTF-16BE text of a ToUnicode destination. A destination with an
+// odd number of bytes has a zero byte in front.
+func utf16Text(dst []byte) string {
+	if len(dst)%2 == 1 {
+		dst = append([]byte{0}, dst...)
+	}
+	return decodeUTF16(dst)
+}
+
+// codespaceRange holds the codes from low to high, which have the same number of bytes
+type codespaceRange struct {
+	low, high []byte
+}
+
+// contains checks whether each byte of the code is within the range's bytes for that
+// position, which is how codespace ranges are matched (section 9.7.6.2)
+func (r codespaceRange) contains(code []byte) bool {
+	if len(code) != len(r.low) {
+		return false
+	}
+	for i, b := range code {
+		if b < r.low[i] || b > r.high[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type codespace []codespaceRange
+
+// identityCodespace is the codespace of the Identity-H and Identity-V CMaps
+var identityCodespace = codespace{{low: []byte{0x00, 0x00}, high: []byte{0xff, 0xff}}}
+
+// codeLength returns the number of bytes of the code at the start of data. Codes are
+// matched against the ranges one byte longer at a time. A code that doesn't match any
+// range takes up the length of the shortest range its first byte matches, or of the
+// shortest range if none does (section 9.7.6.3).
+func (c codespace) codeLength(data []byte) int {
+	for n := 1; n <= 4 && n <= len(data); n++ {
+		for _, r := range c {
+			if r.contains(data[:n]) {
+				return n
+			}
+		}
+	}
+	n := c.shortest(func(r codespaceRange) bool { return r.low[0] <= data[0] && data[0] <= r.high[0] })
+	if n == 0 {
+		n = c.shortest(func(codespaceRange) bool { return true })
+	}
+	if n == 0 {
+		n = 1
+	}
+	if n > len(data) { // a code cut short at the end of the string
+		n = len(data)
+	}
+	return n
+}
+
+// shortest returns the length of the shortest range that matches, or 0 if none does
+func (c codespace) shortest(match func(codespaceRange) bool) int {
+	n := 0
+	for _, r := range c {
+		if match(r) && (n == 0 || len(r.low) < n) {
+			n = len(r.low)
+		}
+	}
+	return n
+}
+
+// cidSystemInfo names the character collection of a CIDFont (section 9.7.3)
+type cidSystemInfo struct {
+	Registry string
+	Ordering string
+}
+
+// newCIDSystemInfo reads a /CIDSystemInfo dictionary. It returns nil for anything else.
+func newCIDSystemInfo(v interface{}) *cidSystemInfo {
+	d, ok := v.(dictionary)
+	if !ok {
+		return nil
+	}
+	return &cidSystemInfo{Registry: string(stringBytes(d.get("/Registry"))), Ordering: string(stringBytes(d.get("/Ordering")))}
+}
+
+// collection returns the CID-to-Unicode table of the character collection, or nil if it
+// isn't one of the bundled Adobe collections
+func (info *cidSystemInfo) collection() *collection {
+	if info == nil || info.Registry != "Adobe" {
+		return nil
+	}
+	return lookupCollection(info.Ordering)
+}
+
+// predefinedCMap is one of the CMaps a Type0 font's /Encoding can name (section 9.7.5.2).
+// The codes of the Unicode CMaps are in a Unicode encoding, so they are decoded as they
+// are. Those of the others map to CIDs of an Adobe character collection, whose
+// CID-to-Unicode table gives their text.
+type predefinedCMap struct {
+	codespace  codespace
+	decode     func(code []byte) string // nil for CMaps that aren't Unicode
+	collection string                   // the ordering of the collection the CIDs are in
+	cids       []cidRun                 // the codes mapped to CIDs, by length and then by code
+	parent     *predefinedCMap          // the CMap this one uses
+}
+
+// text returns the Unicode for a code of a Unicode CMap, or "" if the CMap doesn't give it
+func (c *predefinedCMap) text(code []byte) string {
+	if c.decode == nil {
+		return ""
+	}
+	return c.decode(code)
+}
+
+// lookupPredefinedCMap returns the predefined CMap with a name, or nil if there isn't one.
+// Identity-H and Identity-V aren't included, since their codes are the CIDs.
+func lookupPredefinedCMap(n name) *predefinedCMap {
+	if c := unicodeCMaps[n]; c != nil {
+		return c
+	}
+	return lookupCIDCMap(n)
+}
+
+// newCodespace builds a codespace from pairs of low and high hexadecimal codes
+func newCodespace(codes ...string) codespace {
+	var c codespace
+	for i := 0; i+1 < len(codes); i += 2 {
+		c = append(c, codespaceRange{low: hexBytes(codes[i]), high: hexBytes(codes[i+1])})
+	}
+	return c
+}
+
+func decodeUTF16(code []byte) string {
+	u := make([]uint16, len(code)/2)
+	for i := range u {
+		u[i] = uint16(code[2*i])<<8 | uint16(code[2*i+1])
+	}
+	return string(utf16.Decode(u))
+}
+
+func decodeUTF8(code []byte) string {
+	if !utf8.Valid(code) {
+		return ""
+	}
+	return string(code)
+}
+
+func decodeUTF32(code []byte) string {
+	if len(code) != 4 {
+		return ""
+	}
+	r := rune(code[0])<<24 | rune(code[1])<<16 | rune(code[2])<<8 | rune(code[3])
+	if !utf8.ValidRune(r) {
+		return ""
+	}
+	return string(r)
+}
+
+// unicodeCMaps are the predefined CMaps whose codes are Unicode, in both their horizontal
+// (-H) and vertical (-V) versions
+var unicodeCMaps = func() map[name]*predefinedCMap {
+	uniUCS2 := &predefinedCMap{codespace: newCodespace("0000", "FFFF"), decode: decodeUTF16}
+	uniUTF16 := &predefinedCMap{codespace: newCodespace("0000", "D7FF", "D800DC00", "DBFFDFFF", "E000", "FFFF"), decode: decodeUTF16}
+	uniUTF8 := &predefinedCMap{codespace: newCodespace("00", "7F", "C280", "DFBF", "E08080", "EFBFBF", "F0808080", "F4BFBFBF"), decode: decodeUTF8}
+	uniUTF32 := &predefinedCMap{codespace: newCodespace("00000000", "0010FFFF"), decode: decodeUTF32}
+
+	cmaps := make(map[name]*predefinedCMap)
+	for c, names := range map[*predefinedCMap][]string{
+		uniUCS2:  {"UniJIS-UCS2", "UniJIS-UCS2-HW", "UniGB-UCS2", "UniCNS-UCS2", "UniKS-UCS2"},
+		uniUTF16: {"UniJIS-UTF16", "UniJIS2004-UTF16", "UniGB-UTF16", "UniCNS-UTF16", "UniKS-UTF16"},
+		uniUTF8:  {"UniJIS-UTF8", "UniJIS2004-UTF8", "UniGB-UTF8", "UniCNS-UTF8", "UniKS-UTF8"},
+		uniUTF32: {"UniJIS-UTF32", "UniJIS2004-UTF32", "UniJISX0213-UTF32", "UniJISX02132004-UTF32", "UniGB-UTF32", "UniCNS-UTF32", "UniKS-UTF32"},
+	} {
+		for _, n := range names {
+			cmaps[name("/"+n+"-H")], cmaps[name("/"+n+"-V")] = c, c
+		}
+	}
+	return cmaps
+}()
@@ -0,0 +1,294 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestCodeLength(t *testing.T) {
+	mixed := codespace{ // the codespace of a Shift-JIS CMap
+		{low: []byte{0x00}, high: []byte{0x80}},
+		{low: []byte{0x81, 0x40}, high: []byte{0x9f, 0xfc}},
+		{low: []byte{0xa0}, high: []byte{0xdf}},
+		{low: []byte{0xe0, 0x40}, high: []byte{0xfc, 0xfc}},
+	}
+	tests := []struct {
+		codespace codespace
+		data      []byte
+		expected  int
+	}{
+		{identityCodespace, []byte{0x00, 0x41, 0x00}, 2},
+		{identityCodespace, []byte{0x41}, 1}, // cut short
+		{mixed, []byte{0x41, 0x81}, 1},
+		{mixed, []byte{0x81, 0x40}, 2},
+		{mixed, []byte{0xb1}, 1},
+		{mixed, []byte{0xe0, 0x30, 0x41}, 2}, // the first byte matches a two byte range
+		{mixed, []byte{0xfd, 0x41}, 1},       // no range matches, so the shortest
+		{codespace{{low: []byte{0x00, 0x00, 0x00}, high: []byte{0xff, 0xff, 0xff}}}, []byte("abcd"), 3},
+		{nil, []byte("ab"), 1},
+	}
+	for _, test := range tests {
+		if n := test.codespace.codeLength(test.data); n != test.expected {
+			t.Errorf("% x: expected %d, got %d", test.data, test.expected, n)
+		}
+	}
+}
+
+func TestGetCmapCodespace(t *testing.T) {
+	c, err := getCmap([]byte("2 begincodespacerange <00> <80> <8140> <9ffc> endcodespacerange\n" +
+		"1 begincodespacerange <00> <0000> endcodespacerange\n1 beginbfchar <8140> <3000> endbfchar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := codespace{{low: []byte{0x00}, high: []byte{0x80}}, {low: []byte{0x81, 0x40}, high: []byte{0x9f, 0xfc}}}
+	if !reflect.DeepEqual(c.codespace, expected) {
+		t.Errorf("expected %v, got %v", expected, c.codespace)
+	}
+	if text := c.lookup([]byte{0x81, 0x40}); text != "　" {
+		t.Errorf("unexpected text %q", text)
+	}
+	if _, err := getCmap([]byte("1 begincodespacerange <00> /FF endcodespacerange")); err == nil {
+		t.Error("expected invalid codespacerange data")
+	}
+}
+
+func TestType0Fonts(t *testing.T) {
+	toUnicode := "1 begincodespacerange <0000> <ffff> endcodespacerange\n3 beginbfchar <0024> <0041> <0025> <0042> <0100> <00e9> endbfchar"
+	encoding := "/CIDInit /ProcSet findresource begin 12 dict begin begincmap\n" +
+		"2 begincodespacerange <00> <80> <8140> <9ffc> endcodespacerange\n1 begincidrange <00> <80> 0 endcidrange\nendcmap"
+	mixed := "1 begincodespacerange <00> <80> endcodespacerange 1 begincodespacerange <8140> <9ffc> endcodespacerange\n" +
+		"2 beginbfchar <41> <0041> <889f> <4e9c> endbfchar"
+	pdf := singlePagePDF("BT /F1 12 Tf <00240025 0100> Tj (\000\044) Tj /F2 12 Tf <41889F41> Tj ET", "/F1 5 0 R /F2 8 0 R",
+		"<</Type /Font /Subtype /Type0 /BaseFont /Font1 /Encoding /Identity-H /DescendantFonts [7 0 R] /ToUnicode 6 0 R>>",
+		streamObject(toUnicode),
+		"<</Type /Font /Subtype /CIDFontType2 /BaseFont /Font1 /CIDSystemInfo <</Registry (Adobe) /Ordering (Identity) /Supplement 0>>>>",
+		"<</Type /Font /Subtype /Type0 /BaseFont /Font2 /Encoding 9 0 R /DescendantFonts [<</Type /Font /Subtype /CIDFontType0"+
+			" /CIDSystemInfo 11 0 R>>] /ToUnicode 10 0 R>>",
+		streamObject(encoding), streamObject(mixed), "<</Registry (Adobe) /Ordering (Japan1) /Supplement 6>>")
+	if text := pageText(t, pdf); text != "ABéAA亜A\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+
+	d, err := parse(bytes.NewReader(pdf), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := d.pageList["3 0"]
+	if c := d.fontDecoder(p, "/F1").collection; c != nil {
+		t.Error("expected no character collection for Adobe-Identity")
+	}
+	if c := d.fontDecoder(p, "/F2").collection; c == nil || c != lookupCollection("Japan1") {
+		t.Error("expected the Adobe-Japan1 character collection")
+	}
+	if dec := d.fontDecoder(p, "/F2"); !reflect.DeepEqual(dec.codespace, d.cmaps["9 0"].codespace) {
+		t.Errorf("expected the codespace of the embedded CMap, got %v", dec.codespace)
+	}
+}
+
+func TestCIDSystemInfo(t *testing.T) {
+	encoding := "1 begincodespacerange <0000> <ffff> endcodespacerange\n1 begincidrange <0000> <ffff> 0 endcidrange"
+	pdf := singlePagePDF("BT /F1 12 Tf <04650022> Tj /F2 12 Tf <0465> Tj /F3 12 Tf <03ac> Tj /F4 12 Tf (\xb0\xa1) Tj ET",
+		"/F1 5 0 R /F2 6 0 R /F3 7 0 R /F4 9 0 R",
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [<</Type /Font /Subtype /CIDFontType0"+
+			" /CIDSystemInfo <</Registry (Adobe) /Ordering (Japan1) /Supplement 6>>>>]>>",
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [<</Type /Font /Subtype /CIDFontType2"+
+			" /CIDSystemInfo <</Registry (Adobe) /Ordering (Identity) /Supplement 0>>>>]>>",
+		"<</Type /Font /Subtype /Type0 /Encoding 8 0 R /DescendantFonts [<</Type /Font /Subtype /CIDFontType0"+
+			" /CIDSystemInfo <</Registry (Adobe) /Ordering (GB1) /Supplement 5>>>>]>>",
+		streamObject(encoding),
+		"<</Type /Font /Subtype /Type0 /Encoding /KSCms-UHC-H /DescendantFonts [<</Type /Font /Subtype /CIDFontType0>>]>>")
+	if text := pageText(t, pdf); text != "亜A啊가\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestPredefinedCMaps(t *testing.T) {
+	tests := []struct {
+		cmap     name
+		data     []byte
+		expected string
+	}{
+		{"/UniJIS-UCS2-H", []byte("\x4e\x9c\x00\x41"), "亜A"},
+		{"/UniGB-UTF16-H", []byte("\xd8\x40\xdc\x0b\x55\x4a"), "𠀋啊"},
+		{"/UniKS-UTF8-H", []byte("\xea\xb0\x80A"), "가A"},
+		{"/UniCNS-UTF32-H", []byte("\x00\x02\x00\x0b\x00\x00\x4e\x00"), "𠀋一"},
+		{"/UniJIS-UTF8-V", []byte("\xff\xe3\x81\x82"), "あ"}, // invalid codes are left out
+		{"/90ms-RKSJ-H", []byte("\x88\x9fA\xb1\x82\xa0\x5c"), "亜Aｱあ¥"},
+		{"/90ms-RKSJ-V", []byte("\x81\x5b\x88\x9f"), "ー亜"}, // with the codes of 90ms-RKSJ-H
+		{"/H", []byte("\x30\x21\x24\x22"), "亜あ"},
+		{"/GBK-EUC-H", []byte("\xb0\xa1\x81\x40A"), "啊丂A"},
+		{"/GBK2K-H", []byte("\x81\x30\x84\x36\xb0\xa1"), "¥啊"},
+		{"/B5pc-H", []byte("\xa4\x40\xa7\x41"), "一你"},
+		{"/CNS-EUC-V", []byte("\xc4\xa1"), "一"},
+		{"/KSCms-UHC-H", []byte("\xb0\xa1\x81\x41\xc7\xd1"), "가갂한"},
+		{"/KSC-EUC-H", []byte("\xb1\xdb"), "글"},
+	}
+	for _, test := range tests {
+		c := lookupPredefinedCMap(test.cmap)
+		if c == nil {
+			t.Errorf("%s: expected a predefined CMap", test.cmap)
+			continue
+		}
+		dec := &fontDecoder{codespace: c.codespace, predefined: c, collection: lookupCollection(c.collection)}
+		var buf bytes.Buffer
+		dec.decode(&buf, test.data)
+		if buf.String() != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.cmap, test.expected, buf.String())
+		}
+	}
+
+	// codes are split up by the codespace of the CMap before they are looked up
+	codeLengths := []struct {
+		cmap    name
+		data    []byte
+		lengths []int
+	}{
+		{"/90ms-RKSJ-H", []byte("\x88\x9fA\xb1\x82\xa0"), []int{2, 1, 1, 2}},
+		{"/EUC-V", []byte("\xb0\xa1a\x8e\xb1"), []int{2, 1, 2}},
+		{"/H", []byte("\x30\x21\x24\x22"), []int{2, 2}},
+		{"/GBK2K-H", []byte("\x81\x30\x81\x30\xb0\xa1"), []int{4, 2}},
+		{"/ETen-B5-H", []byte("\xa4\x40x"), []int{2, 1}},
+		{"/KSCms-UHC-H", []byte("\x81\x41"), []int{2}},
+	}
+	for _, test := range codeLengths {
+		c := lookupPredefinedCMap(test.cmap)
+		if c == nil || c.decode != nil || c.collection == "" {
+			t.Errorf("%s: expected a predefined CMap that maps codes to CIDs", test.cmap)
+			continue
+		}
+		var lengths []int
+		for data := test.data; len(data) > 0; {
+			n := c.codespace.codeLength(data)
+			lengths = append(lengths, n)
+			data = data[n:]
+		}
+		if !reflect.DeepEqual(lengths, test.lengths) {
+			t.Errorf("%s: expected codes of %v bytes, got %v", test.cmap, test.lengths, lengths)
+		}
+	}
+
+	toUnicode := "1 begincodespacerange <0000> <ffff> endcodespacerange\n1 beginbfchar <4e9c> <0058> endbfchar"
+	pdf := singlePagePDF("BT /F1 12 Tf (\x88\x9f\x82\xa0) Tj /F2 12 Tf <4E9C3042> Tj ET", "/F1 5 0 R /F2 6 0 R",
+		"<</Type /Font /Subtype /Type0 /BaseFont /MS-Mincho /Encoding /90ms-RKSJ-H /DescendantFonts [8 0 R]>>",
+		"<</Type /Font /Subtype /Type0 /BaseFont /MS-Mincho /Encoding /UniJIS-UCS2-H /DescendantFonts [8 0 R] /ToUnicode 7 0 R>>",
+		streamObject(toUnicode),
+		"<</Type /Font /Subtype /CIDFontType0 /BaseFont /MS-Mincho /CIDSystemInfo <</Registry (Adobe) /Ordering (Japan1) /Supplement 2>>>>")
+	if text := pageText(t, pdf); text != "亜あXあ\n" { // the ToUnicode cmap comes first
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestCollections(t *testing.T) {
+	tests := []struct {
+		ordering string
+		cid      int
+		expected string
+	}{
+		{"Japan1", 1125, "亜"},
+		{"Japan1", 7641, "𨳝"},
+		{"Japan1", 0, ""}, // .notdef
+		{"GB1", 940, "啊"},
+		{"GB1", 22048, "𠂇"},
+		{"CNS1", 595, "一"},
+		{"CNS1", 14000, "𠃌"},
+		{"Korea1", 1086, "가"},
+		{"Korea1", 8207, "(("},
+		{"Korea1", 1 << 20, ""},
+		{"Identity", 1, ""},
+	}
+	for _, test := range tests {
+		if text := lookupCollection(test.ordering).text(test.cid); text != test.expected {
+			t.Errorf("%s CID %d: expected %q, got %q", test.ordering, test.cid, test.expected, text)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	c, err := getCmap([]byte("/CIDInit /ProcSet findresource begin\n" +
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange\n" +
+		"2 beginbfchar <0001> <F0B7> <0002> <D835DC9C> %comment\n<0003> <006600660069> <0004> /f_f_l endbfchar\n" +
+		"1 beginbfchar <0005> <0041> <0006> <0042> endbfchar\n" + // the count is wrong
+		"3 beginbfrange <0010> <0012> <00660066> <0020> <0021> [<D835DC9C> <0041>] <00FE> <0101> <00FE> endbfrange\n" +
+		"1 beginbfrange <1000> <FFFF> <1000> endbfrange\n" +
+		"1 begincidchar <0030> 100 endcidchar 1 begincidrange <0040> <0041> 200 <10000000> <1FFFFFFF> 5 endcidrange\n" +
+		"endcmap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"\x00\x01":     "",
+		"\x00\x02":     "𝒜",
+		"\x00\x03":     "ffi",
+		"\x00\x04":     "ffl",
+		"\x00\x06":     "B",
+		"\x00\x10":     "ff",
+		"\x00\x12":     "fh", // only the last byte is incremented
+		"\x00\x20":     "𝒜",
+		"\x00\x21":     "A",
+		"\x00\xff":     "ÿ",
+		"\x01\x00":     "Ā", // carried for files that overflow the last byte
+		"\x01\x01":     "ā",
+		"\x80\x00":     "耀",
+		"\xff\xfd":     "�",
+		"\x00\x13":     "",
+		"\x00\x00\x01": "",
+	}
+	for code, expected := range tests {
+		if text := c.lookup([]byte(code)); text != expected {
+			t.Errorf("% x: expected %q, got %q", code, expected, text)
+		}
+	}
+	if len(c.chars) > maxRangeCodes {
+		t.Errorf("expected large ranges to be kept as ranges, got %d codes", len(c.chars))
+	}
+	for code, expected := range map[string]int{"\x00\x30": 100, "\x00\x41": 201, "\x10\x00\x00\x01": 6} {
+		if cid, ok := c.cid([]byte(code)); !ok || cid != expected {
+			t.Errorf("% x: expected CID %d, got %d", code, expected, cid)
+		}
+	}
+	if _, ok := c.cid([]byte("\x00\x42")); ok {
+		t.Error("expected no CID")
+	}
+
+	var ranges bytes.Buffer
+	for i := 0; i < 17; i++ {
+		fmt.Fprintf(&ranges, "<%02X0000> <%02XFFFE> <4E00>\n", i, i)
+	}
+	c, err = getCmap([]byte("17 beginbfrange\n" + ranges.String() + "endbfrange"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.chars) > maxExpandedCodes || c.lookup([]byte("\x10\x00\x01")) != "丁" {
+		t.Errorf("expected ranges past the limit to be kept as ranges, got %d codes", len(c.chars))
+	}
+
+	c, err = getCmap([]byte("/90ms-RKSJ-H usecmap 1 beginbfchar <889F> <0058> endbfchar\n2 beginbfchar <41> <0061>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := c.lookup([]byte("\x88\x9f")) + c.lookup([]byte("\x88\xa0")) + c.lookup([]byte("A")); text != "Xa" {
+		t.Errorf("expected no text from the used CMap for the codes the cmap doesn't have, got %q", text)
+	}
+	if len(c.codespace) != 4 {
+		t.Errorf("expected the codespace of the used CMap, got %v", c.codespace)
+	}
+	if cid, ok := c.cid([]byte("\x88\x9f")); !ok || cid != 1125 {
+		t.Errorf("expected the CID of the used CMap, got %d", cid)
+	}
+}
+
+func TestSharedToUnicode(t *testing.T) {
+	toUnicode := "1 begincodespacerange <00> <FF> endcodespacerange\n1 beginbfchar <01> <0048> endbfchar"
+	used := "1 begincodespacerange <00> <FF> endcodespacerange\n1 beginbfchar <02> <0069> endbfchar"
+	pdf := singlePagePDF("BT /F1 12 Tf <0102> Tj /F2 12 Tf <0102> Tj ET", "/F1 6 0 R /F2 7 0 R",
+		"<</Length "+strconv.Itoa(len(toUnicode))+" /UseCMap 8 0 R>> stream\n"+toUnicode+"\nendstream",
+		"<</Type /Font /Subtype /TrueType /ToUnicode 5 0 R>>",
+		"<</Type /Font /Subtype /TrueType /ToUnicode 5 0 R>>",
+		streamObject(used))
+	if text := pageText(t, pdf); text != "HiHi\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
@@ -0,0 +1,186 @@
+package pdf2txt
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// encoding maps the single byte character codes of a simple font to Unicode. Codes that
+// aren't defined map to 0.
+type encoding [256]rune
+
+// decode returns the Unicode for a character code. Codes without one are returned as is,
+// which treats them as Latin-1.
+func (e *encoding) decode(b byte) rune {
+	if e != nil && e[b] != 0 {
+		return e[b]
+	}
+	return rune(b)
+}
+
+// textEncoding is the encoding of a simple font: a base encoding with the changes from
+// its /Differences array on top (section 9.6.6)
+type textEncoding struct {
+	base        *encoding
+	differences map[byte]string
+}
+
+// newTextEncoding reads a font's /Encoding, which is either the name of a base encoding or
+// an encoding dictionary, on top of the font's built-in encoding if it's known. It returns
+// nil if neither gives an encoding. The glyph names of a ZapfDingbats font are looked up
+// in its own glyph list.
+func newTextEncoding(v interface{}, builtin *encoding, dingbats bool) *textEncoding {
+	switch e := v.(type) {
+	case name:
+		if base, ok := baseEncodings[e]; ok {
+			return &textEncoding{base: base}
+		}
+	case dictionary:
+		enc := &textEncoding{base: standardEncoding} // the default for nonsymbolic fonts
+		if builtin != nil {
+			enc.base = builtin
+		}
+		if n, _ := e.get("/BaseEncoding").(name); baseEncodings[n] != nil {
+			enc.base = baseEncodings[n]
+		}
+		if differences, ok := e.get("/Differences").(array); ok {
+			enc.differences = readDifferences(differences, dingbats)
+		}
+		return enc
+	}
+	if builtin != nil {
+		return &textEncoding{base: builtin}
+	}
+	return nil
+}
+
+// readDifferences reads the text of the glyphs in a /Differences array. Glyphs that have
+// no known Unicode are left to the base encoding.
+func readDifferences(a array, dingbats bool) map[byte]string {
+	text := glyphText
+	if dingbats {
+		text = dingbatsGlyphText
+	}
+	differences := make(map[byte]string)
+	for code, n := range differenceNames(a) {
+		if text := text(strings.TrimPrefix(string(n), "/")); text != "" {
+			differences[code] = text
+		}
+	}
+	return differences
+}
+
+// differenceNames reads the glyph names in a /Differences array, in which each code is
+// followed by the names of the glyphs for it and the codes after it
+func differenceNames(a array) map[byte]name {
+	names := make(map[byte]name)
+	code := -1
+	for _, item := range a {
+		switch v := item.(type) {
+		case integer:
+			code = int(v)
+		case name:
+			if code >= 0 && code < 256 {
+				names[byte(code)] = v
+				code++
+			}
+		}
+	}
+	return names
+}
+
+// decode returns the text for a character code. Without an encoding, codes are Latin-1.
+func (e *textEncoding) decode(b byte) string {
+	if e == nil {
+		return string(rune(b))
+	}
+	if text, ok := e.differences[b]; ok {
+		return text
+	}
+	return string(e.base.decode(b))
+}
+
+// latinEncoding builds an encoding that matches ASCII from 0x20 to 0x7E and maps the codes
+// from 0x80 up to the runes of high, with the changes applied on top. \x00 in high leaves
+// a code undefined.
+func latinEncoding(high string, changes map[byte]rune) *encoding {
+	var e encoding
+	for b := ' '; b <= '~'; b++ {
+		e[b] = b
+	}
+	for i, code := 0, 0x80; i < len(high); code++ {
+		r, size := utf8.DecodeRuneInString(high[i:])
+		e[code] = r
+		i += size
+	}
+	for code, r := range changes {
+		e[code] = r
+	}
+	return &e
+}
+
+// baseEncodings are the encodings a simple font's /Encoding can name (Annex D)
+var baseEncodings = map[name]*encoding{
+	"/StandardEncoding":  standardEncoding,
+	"/WinAnsiEncoding":   winAnsiEncoding,
+	"/MacRomanEncoding":  macRomanEncoding,
+	"/MacExpertEncoding": macExpertEncoding,
+	"/PDFDocEncoding":    pdfDocEncoding,
+}
+
+var standardEncoding = latinEncoding("", map[byte]rune{
+	0x27: '’', 0x60: '‘',
+	0xa1: '¡', 0xa2: '¢', 0xa3: '£', 0xa4: '⁄', 0xa5: '¥', 0xa6: 'ƒ', 0xa7: '§', 0xa8: '¤',
+	0xa9: '\'', 0xaa: '“', 0xab: '«', 0xac: '‹', 0xad: '›', 0xae: 'ﬁ', 0xaf: 'ﬂ',
+	0xb1: '–', 0xb2: '†', 0xb3: '‡', 0xb4: '·', 0xb6: '¶', 0xb7: '•', 0xb8: '‚', 0xb9: '„',
+	0xba: '”', 0xbb: '»', 0xbc: '…', 0xbd: '‰', 0xbf: '¿',
+	0xc1: '`', 0xc2: '´', 0xc3: 'ˆ', 0xc4: '˜', 0xc5: '¯', 0xc6: '˘', 0xc7: '˙', 0xc8: '¨',
+	0xca: '˚', 0xcb: '¸', 0xcd: '˝', 0xce: '˛', 0xcf: 'ˇ',
+	0xd0: '—',
+	0xe1: 'Æ', 0xe3: 'ª', 0xe8: 'Ł', 0xe9: 'Ø', 0xea: 'Œ', 0xeb: 'º',
+	0xf1: 'æ', 0xf5: 'ı', 0xf8: 'ł', 0xf9: 'ø', 0xfa: 'œ', 0xfb: 'ß',
+})
+
+// winAnsiEncoding is Windows code page 1252. The non-breaking space and soft hyphen codes
+// are the space and hyphen glyphs.
+var winAnsiEncoding = latinEncoding("€\x00‚ƒ„…†‡ˆ‰Š‹Œ\x00Ž\x00\x00‘’“”•–—˜™š›œ\x00žŸ ¡¢£¤¥¦§¨©ª«¬-®¯°±²³´µ¶·¸¹º»¼½¾¿"+
+	"ÀÁÂÃÄÅÆÇÈÉÊËÌÍÎÏÐÑÒÓÔÕÖ×ØÙÚÛÜÝÞßàáâãäåæçèéêëìíîïðñòóôõö÷øùúûüýþÿ", nil)
+
+// macRomanEncoding is Mac OS Roman without the mathematical symbols and the Apple logo,
+// and with the currency sign instead of the euro
+var macRomanEncoding = latinEncoding("ÄÅÇÉÑÖÜáàâäãåçéèêëíìîïñóòôöõúùûü†°¢£§•¶ß®©™´¨\x00ÆØ\x00±\x00\x00¥µ\x00\x00\x00\x00\x00ªº\x00æø"+
+	"¿¡¬\x00ƒ\x00\x00«»… ÀÃÕŒœ–—“”‘’÷\x00ÿŸ⁄¤‹›ﬁﬂ‡·‚„‰ÂÊÁËÈÍÎÏÌÓÔ\x00ÒÚÛÙıˆ˜¯˘˙˚¸˝˛ˇ", nil)
+
+var pdfDocEncoding = latinEncoding("•†‡…—–ƒ⁄‹›−‰„“”‘’‚™ﬁﬂŁŒŠŸŽıłœšž\x00€¡¢£¤¥¦§¨©ª«¬\x00®¯°±²³´µ¶·¸¹º»¼½¾¿"+
+	"ÀÁÂÃÄÅÆÇÈÉÊËÌÍÎÏÐÑÒÓÔÕÖ×ØÙÚÛÜÝÞßàáâãäåæçèéêëìíîïðñòóôõö÷øùúûüýþÿ", map[byte]rune{
+	0x09: '\t', 0x0a: '\n', 0x0d: '\r',
+	0x18: '˘', 0x19: 'ˇ', 0x1a: 'ˆ', 0x1b: '˙', 0x1c: '˝', 0x1d: '˛', 0x1e: '˚', 0x1f: '˜',
+})
+
+// macExpertEncoding holds small capitals, old style figures, superiors, inferiors and
+// fractions. Since most of them have no Unicode of their own, they map to the characters
+// they are styles of, such as a for Asmall and 1 for oneoldstyle.
+var macExpertEncoding = &encoding{
+	0x20: ' ', 0x21: '!', 0x22: '˝', 0x23: '¢', 0x24: '$', 0x25: '$', 0x26: '&', 0x27: '´',
+	0x28: '⁽', 0x29: '⁾', 0x2a: '‥', 0x2b: '․', 0x2c: ',', 0x2d: '-', 0x2e: '.', 0x2f: '⁄',
+	0x30: '0', 0x31: '1', 0x32: '2', 0x33: '3', 0x34: '4', 0x35: '5', 0x36: '6', 0x37: '7',
+	0x38: '8', 0x39: '9', 0x3a: ':', 0x3b: ';', 0x3d: '—', 0x3f: '?',
+	0x44: 'ð', 0x47: '¼', 0x48: '½', 0x49: '¾', 0x4a: '⅛', 0x4b: '⅜', 0x4c: '⅝', 0x4d: '⅞',
+	0x4e: '⅓', 0x4f: '⅔', 0x56: 'ﬀ', 0x57: 'ﬁ', 0x58: 'ﬂ', 0x59: 'ﬃ', 0x5a: 'ﬄ', 0x5b: '₍',
+	0x5d: '₎', 0x5e: 'ˆ', 0x5f: '-', 0x60: '`',
+	0x61: 'a', 0x62: 'b', 0x63: 'c', 0x64: 'd', 0x65: 'e', 0x66: 'f', 0x67: 'g', 0x68: 'h',
+	0x69: 'i', 0x6a: 'j', 0x6b: 'k', 0x6c: 'l', 0x6d: 'm', 0x6e: 'n', 0x6f: 'o', 0x70: 'p',
+	0x71: 'q', 0x72: 'r', 0x73: 's', 0x74: 't', 0x75: 'u', 0x76: 'v', 0x77: 'w', 0x78: 'x',
+	0x79: 'y', 0x7a: 'z', 0x7b: '₡', 0x7c: '1', 0x7e: '˜',
+	0x81: 'a', 0x82: '¢', 0x89: 'á', 0x8a: 'à', 0x8b: 'â', 0x8c: 'ä', 0x8d: 'ã', 0x8e: 'å',
+	0x8f: 'ç', 0x90: 'é', 0x91: 'è', 0x92: 'ê', 0x93: 'ë', 0x94: 'í', 0x95: 'ì', 0x96: 'î',
+	0x97: 'ï', 0x98: 'ñ', 0x99: 'ó', 0x9a: 'ò', 0x9b: 'ô', 0x9c: 'ö', 0x9d: 'õ', 0x9e: 'ú',
+	0x9f: 'ù', 0xa0: 'û', 0xa1: 'ü', 0xa3: '⁸', 0xa4: '₄', 0xa5: '₃', 0xa6: '₆', 0xa7: '₈',
+	0xa8: '₇', 0xa9: 'š', 0xab: '¢', 0xac: '₂', 0xae: '¨', 0xb0: 'ˇ', 0xb1: 'o', 0xb2: '₅',
+	0xb4: ',', 0xb5: '.', 0xb6: 'ý', 0xb8: '$', 0xbb: 'þ', 0xbd: '₉', 0xbe: '₀', 0xbf: 'ž',
+	0xc0: 'æ', 0xc1: 'ø', 0xc2: '¿', 0xc3: '₁', 0xc4: 'ł', 0xcb: '¸', 0xd1: 'œ', 0xd2: '‒',
+	0xd3: '-', 0xd8: '¡', 0xda: 'ÿ', 0xdc: '¹', 0xdd: '²', 0xde: '³', 0xdf: '⁴', 0xe0: '⁵',
+	0xe1: '⁶', 0xe2: '⁷', 0xe3: '⁹', 0xe4: '⁰', 0xe6: 'e', 0xe7: 'r', 0xe8: 't', 0xeb: 'i',
+	0xec: 's', 0xed: 'd', 0xf3: 'l', 0xf4: '˛', 0xf5: '˘', 0xf6: '¯', 0xf7: 'b', 0xf8: 'ⁿ',
+	0xf9: 'm', 0xfa: ',', 0xfb: '.', 0xfc: '˙', 0xfd: '˚',
+}
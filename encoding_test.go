@@ -0,0 +1,98 @@
+package pdf2txt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBaseEncodings(t *testing.T) {
+	tests := []struct {
+		enc      *encoding
+		code     byte
+		expected rune
+	}{
+		{standardEncoding, 'A', 'A'},
+		{standardEncoding, 0x27, '’'},
+		{standardEncoding, 0xa4, '⁄'},
+		{standardEncoding, 0xe1, 'Æ'},
+		{standardEncoding, 0xfb, 'ß'},
+		{standardEncoding, 0xa0, 0},
+		{winAnsiEncoding, 0x80, '€'},
+		{winAnsiEncoding, 0x81, 0},
+		{winAnsiEncoding, 0x93, '“'},
+		{winAnsiEncoding, 0x95, '•'},
+		{winAnsiEncoding, 0xa0, ' '},
+		{winAnsiEncoding, 0xe9, 'é'},
+		{winAnsiEncoding, 0xff, 'ÿ'},
+		{macRomanEncoding, 0x80, 'Ä'},
+		{macRomanEncoding, 0xa5, '•'},
+		{macRomanEncoding, 0xad, 0},
+		{macRomanEncoding, 0xd2, '“'},
+		{macRomanEncoding, 0xdb, '¤'},
+		{macRomanEncoding, 0xf0, 0},
+		{macRomanEncoding, 0xff, 'ˇ'},
+		{pdfDocEncoding, 0x18, '˘'},
+		{pdfDocEncoding, 0x80, '•'},
+		{pdfDocEncoding, 0x9e, 'ž'},
+		{pdfDocEncoding, 0x9f, 0},
+		{pdfDocEncoding, 0xa0, '€'},
+		{pdfDocEncoding, 0xad, 0},
+		{pdfDocEncoding, 0xff, 'ÿ'},
+		{macExpertEncoding, 0x31, '1'},
+		{macExpertEncoding, 0x56, 'ﬀ'},
+		{macExpertEncoding, 0x61, 'a'},
+		{macExpertEncoding, 0xdc, '¹'},
+		{macExpertEncoding, 0xfd, '˚'},
+	}
+	for _, test := range tests {
+		if actual := test.enc[test.code]; actual != test.expected {
+			t.Errorf("code %#x: expected %q, got %q", test.code, test.expected, actual)
+		}
+	}
+	if r := (*encoding)(nil).decode(0xe9); r != 'é' {
+		t.Error("expected codes without an encoding to be Latin-1", r)
+	}
+	if r := winAnsiEncoding.decode(0x81); r != 0x81 {
+		t.Error("expected undefined codes to be kept", r)
+	}
+}
+
+func TestFontEncoding(t *testing.T) {
+	pdf := singlePagePDF("BT /F1 12 Tf (\x93Hi\x94 \x96 \x95) Tj <93E9> Tj /F2 12 Tf (\xd2\x8e) Tj ET", "/F1 5 0 R /F2 6 0 R",
+		"<</Type /Font /Subtype /Type1 /Encoding /WinAnsiEncoding>>", "<</Type /Font /Subtype /Type1 /Encoding /MacRomanEncoding>>")
+	if text := pageText(t, pdf); text != "“Hi” – •“é“é\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestDifferences(t *testing.T) {
+	differences := readDifferences(array{integer(39), name("/quotesingle"), integer(65), name("/B"), name("/f_f_i"), name("/g7"), name("/uni20AC"),
+		integer(300), name("/A"), integer(255), name("/Z"), name("/Y")}, false)
+	expected := map[byte]string{39: "'", 65: "B", 66: "ffi", 68: "€", 255: "Z"}
+	if !reflect.DeepEqual(differences, expected) {
+		t.Errorf("expected %q, got %q", expected, differences)
+	}
+	differences = readDifferences(array{integer(65), name("/a20"), name("/A"), name("/a71")}, true)
+	if expected = map[byte]string{65: "✔", 67: "●"}; !reflect.DeepEqual(differences, expected) {
+		t.Errorf("expected ZapfDingbats names %q, got %q", expected, differences)
+	}
+
+	enc := newTextEncoding(dictionary{{"/Differences", array{integer(65), name("/B")}}}, nil, false)
+	if enc.decode('A') != "B" || enc.decode(0x27) != "’" || enc.decode(0x93) != "\u0093" {
+		t.Error("expected StandardEncoding to be the base", enc.decode('A'), enc.decode(0x27), enc.decode(0x93))
+	}
+	enc = newTextEncoding(dictionary{{"/BaseEncoding", name("/WinAnsiEncoding")}, {"/Differences", array{integer(65), name("/B")}}}, nil, false)
+	if enc.decode('A') != "B" || enc.decode(0x93) != "“" {
+		t.Error("expected WinAnsiEncoding to be the base", enc.decode('A'), enc.decode(0x93))
+	}
+	if newTextEncoding(name("/Identity-H"), nil, false) != nil || newTextEncoding(nil, nil, false) != nil {
+		t.Error("expected no encoding")
+	}
+
+	diffs := "<</Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences [1 /T_h /e 65 /quotedblleft]>>"
+	pdf := singlePagePDF("BT /F1 12 Tf (\001\002 A\223) Tj /F2 12 Tf (\001\002 A\223) Tj ET", "/F1 5 0 R /F2 6 0 R",
+		"<</Type /Font /Subtype /Type1 /Encoding 7 0 R>>", "<</Type /Font /Subtype /Type1 /Encoding "+diffs+">>", diffs)
+	if text := pageText(t, pdf); text != "The ““The ““\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
@@ -0,0 +1,492 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rc4"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrPasswordRequired is returned when a PDF file is encrypted and can't be
+// opened without a password
+var ErrPasswordRequired error = encryptionError("password required to decrypt PDF file")
+
+// ErrWrongPassword is returned when the supplied password is neither the user
+// nor the owner password of an encrypted PDF file
+var ErrWrongPassword error = encryptionError("wrong password for encrypted PDF file")
+
+// ErrExtractionNotAllowed is returned when permissions are enforced and an encrypted
+// PDF file doesn't allow its content to be copied or extracted
+var ErrExtractionNotAllowed error = encryptionError("PDF file doesn't allow content extraction")
+
+// Permissions reports the operations allowed by the /P entry of an encrypted PDF
+// file (section 7.6.3.2). Files that aren't encrypted allow everything.
+type Permissions struct {
+	Encrypted        bool
+	Print            bool // bit 3
+	Modify           bool // bit 4
+	Copy             bool // bit 5: copy or otherwise extract text and graphics
+	Annotate         bool // bit 6: add or modify annotations and fill in form fields
+	FillForms        bool // bit 9
+	Accessibility    bool // bit 10: extract text and graphics for accessibility
+	Assemble         bool // bit 11: insert, rotate or delete pages
+	PrintHighQuality bool // bit 12
+}
+
+// ReadPermissions reads the permissions of a PDF file. No password is needed since
+// the permission flags aren't encrypted. Files encrypted for certificate recipients
+// keep their flags inside the recipient envelopes, so they only report Encrypted.
+func ReadPermissions(r io.Reader) (*Permissions, error) {
+	d := newDocument()
+	items, err := d.readItems(r)
+	if err != nil {
+		return nil, err
+	}
+	if d.trailer.encryptRef == "" {
+		return &Permissions{Print: true, Modify: true, Copy: true, Annotate: true, FillForms: true,
+			Accessibility: true, Assemble: true, PrintHighQuality: true}, nil
+	}
+	encrypt, err := d.encryptionDictionary(items)
+	if err != nil {
+		return nil, err
+	}
+	if encrypt.name("/Filter") == "/Adobe.PubSec" {
+		return &Permissions{Encrypted: true}, nil
+	}
+	p := newPermissions(int32(encrypt.int("/P")), encrypt.int("/R"))
+	return &p, nil
+}
+
+// newPermissions decodes the permission flags. Revision 2 doesn't define bits 9 to 12,
+// so they follow the flags that covered those operations before revision 3.
+func newPermissions(p int32, revision int) Permissions {
+	bit := func(n uint) bool { return p&(1<<(n-1)) != 0 }
+	perms := Permissions{
+		Encrypted: true,
+		Print:     bit(3),
+		Modify:    bit(4),
+		Copy:      bit(5),
+		Annotate:  bit(6),
+	}
+	if revision < 3 {
+		perms.FillForms = perms.Annotate
+		perms.Accessibility = perms.Copy
+		perms.Assemble = perms.Modify
+		perms.PrintHighQuality = perms.Print
+		return perms
+	}
+	perms.FillForms = bit(9)
+	perms.Accessibility = bit(10)
+	perms.Assemble = bit(11)
+	perms.PrintHighQuality = perms.Print && bit(12)
+	return perms
+}
+
+// padding used to extend passwords to 32 bytes (Algorithm 2, step a)
+var passwordPadding = []byte{
+	0x28, 0xBF, 0x4E, 0x5E, 0x4E, 0x75, 0x8A, 0x41, 0x64, 0x00, 0x4E, 0x56, 0xFF, 0xFA, 0x01, 0x08,
+	0x2E, 0x2E, 0x00, 0xB6, 0xD0, 0x68, 0x3E, 0x80, 0x2F, 0x0C, 0xA9, 0xFE, 0x64, 0x53, 0x69, 0x7A,
+}
+
+// crypt filter methods
+const (
+	cryptNone  = "None"
+	cryptRC4   = "V2"
+	cryptAESV2 = "AESV2"
+	cryptAESV3 = "AESV3"
+)
+
+// securityHandler holds the file encryption key of an encrypted PDF file along with the
+// crypt filters needed to decrypt its streams and strings (section 7.6)
+type securityHandler struct {
+	key             []byte
+	v, r            int
+	o, u            []byte
+	p               int32
+	id              []byte
+	stmF, strF      string
+	encryptMetadata bool
+	isOwner         bool
+}
+
+// newCryptFilters reads the crypt filters and the key length shared by all security handlers
+func newCryptFilters(encrypt *object) (*securityHandler, int) {
+	s := &securityHandler{
+		v:               encrypt.int("/V"),
+		r:               encrypt.int("/R"),
+		stmF:            cryptRC4,
+		strF:            cryptRC4,
+		encryptMetadata: encrypt.search("/EncryptMetadata") != boolean(false),
+	}
+
+	keyLength := 5
+	if s.v >= 2 {
+		if l := encrypt.int("/Length"); l >= 40 && l <= 128 {
+			keyLength = l / 8
+		}
+	}
+	if s.v >= 4 {
+		cf, _ := encrypt.search("/CF").(dictionary)
+		s.stmF = cryptFilterMethod(cf, encrypt.name("/StmF"))
+		s.strF = cryptFilterMethod(cf, encrypt.name("/StrF"))
+		if s.stmF == cryptAESV2 || s.strF == cryptAESV2 {
+			keyLength = 16
+		}
+		if s.stmF == cryptAESV3 || s.strF == cryptAESV3 {
+			keyLength = 32
+		}
+	}
+	return s, keyLength
+}
+
+// newStandardSecurityHandler reads the encryption dictionary and authenticates the password
+// as either the user or the owner password
+func newStandardSecurityHandler(encrypt *object, id array, password string) (*securityHandler, error) {
+	s, keyLength := newCryptFilters(encrypt)
+	s.o = stringBytes(encrypt.search("/O"))
+	s.u = stringBytes(encrypt.search("/U"))
+	s.p = int32(encrypt.int("/P"))
+	if len(id) > 0 {
+		s.id = stringBytes(id[0])
+	}
+	if len(s.o) < 32 || len(s.u) < 32 {
+		return nil, errors.New("invalid encryption dictionary")
+	}
+
+	switch {
+	case s.r >= 2 && s.r <= 4:
+		if s.authenticateUser(s.legacyPassword(password), keyLength) {
+			return s, nil
+		}
+		if s.authenticateOwner(s.legacyPassword(password), keyLength) {
+			s.isOwner = true
+			return s, nil
+		}
+	case s.r == 5 || s.r == 6:
+		pw := []byte(password)
+		if len(pw) > 127 {
+			pw = pw[:127]
+		}
+		oe := stringBytes(encrypt.search("/OE"))
+		ue := stringBytes(encrypt.search("/UE"))
+		if s.authenticateAES256(pw, ue, oe) {
+			return s, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported standard security handler revision %d", s.r)
+	}
+
+	if password == "" {
+		return nil, ErrPasswordRequired
+	}
+	return nil, ErrWrongPassword
+}
+
+func (s *securityHandler) permissions() Permissions {
+	return newPermissions(s.p, s.r)
+}
+
+// cryptFilterMethod resolves the method used by the named crypt filter
+func cryptFilterMethod(cf dictionary, filter name) string {
+	switch filter {
+	case "\x00", "/Identity":
+		return cryptNone
+	}
+	f, _ := cf.get(filter).(dictionary)
+	switch f.get("/CFM") {
+	case name("/V2"):
+		return cryptRC4
+	case name("/AESV2"):
+		return cryptAESV2
+	case name("/AESV3"):
+		return cryptAESV3
+	}
+	return cryptNone
+}
+
+// legacyPassword converts the password to the single byte encoding used by revisions 2-4
+func (s *securityHandler) legacyPassword(password string) []byte {
+	var pw []byte
+	for _, c := range password {
+		if c < 256 {
+			pw = append(pw, byte(c))
+		}
+	}
+	return pw
+}
+
+// computeKey computes the file encryption key from a user password (Algorithm 2)
+func (s *securityHandler) computeKey(password []byte, keyLength int) []byte {
+	h := md5.New()
+	h.Write(padPassword(password))
+	h.Write(s.o[:32])
+	h.Write([]byte{byte(s.p), byte(s.p >> 8), byte(s.p >> 16), byte(s.p >> 24)})
+	h.Write(s.id)
+	if s.r >= 4 && !s.encryptMetadata {
+		h.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	}
+	key := h.Sum(nil)
+	if s.r >= 3 {
+		for i := 0; i < 50; i++ {
+			sum := md5.Sum(key[:keyLength])
+			key = sum[:]
+		}
+	}
+	return key[:keyLength]
+}
+
+// authenticateUser checks the user password and stores the file key when it matches (Algorithms 4, 5 and 6)
+func (s *securityHandler) authenticateUser(password []byte, keyLength int) bool {
+	if s.r == 2 {
+		keyLength = 5
+	}
+	key := s.computeKey(password, keyLength)
+	var u []byte
+	if s.r == 2 {
+		u = rc4Crypt(key, passwordPadding)
+	} else {
+		h := md5.New()
+		h.Write(passwordPadding)
+		h.Write(s.id)
+		u = h.Sum(nil)
+		for i := 0; i < 20; i++ {
+			u = rc4Crypt(xorKey(key, byte(i)), u)
+		}
+	}
+	if !bytes.Equal(u[:16], s.u[:16]) {
+		return false
+	}
+	s.key = key
+	return true
+}
+
+// authenticateOwner recovers the user password from the owner password and then
+// authenticates as the user (Algorithm 7)
+func (s *securityHandler) authenticateOwner(password []byte, keyLength int) bool {
+	if s.r == 2 {
+		keyLength = 5
+	}
+	sum := md5.Sum(padPassword(password))
+	key := sum[:]
+	if s.r >= 3 {
+		for i := 0; i < 50; i++ {
+			sum = md5.Sum(key)
+			key = sum[:]
+		}
+	}
+	key = key[:keyLength]
+
+	user := s.o[:32]
+	if s.r == 2 {
+		user = rc4Crypt(key, user)
+	} else {
+		for i := 19; i >= 0; i-- {
+			user = rc4Crypt(xorKey(key, byte(i)), user)
+		}
+	}
+	return s.authenticateUser(user, keyLength)
+}
+
+// authenticateAES256 checks the password against the owner and user hashes and decrypts
+// the file key from /OE or /UE when one of them matches (Algorithms 2.A and 2.B)
+func (s *securityHandler) authenticateAES256(password, ue, oe []byte) bool {
+	if len(s.o) < 48 || len(s.u) < 48 {
+		return false
+	}
+	var intermediate, encryptedKey []byte
+	if bytes.Equal(s.hash(password, s.o[32:40], s.u[:48]), s.o[:32]) {
+		intermediate = s.hash(password, s.o[40:48], s.u[:48])
+		encryptedKey = oe
+		s.isOwner = true
+	} else if bytes.Equal(s.hash(password, s.u[32:40], nil), s.u[:32]) {
+		intermediate = s.hash(password, s.u[40:48], nil)
+		encryptedKey = ue
+	} else {
+		return false
+	}
+	if len(encryptedKey) != 32 {
+		return false
+	}
+
+	block, _ := aes.NewCipher(intermediate)
+	s.key = make([]byte, 32)
+	cipher.NewCBCDecrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(s.key, encryptedKey)
+	return true
+}
+
+// hash computes the revision 5 or revision 6 password hash (Algorithm 2.B)
+func (s *securityHandler) hash(password, salt, udata []byte) []byte {
+	h := sha256.New()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(udata)
+	k := h.Sum(nil)
+	if s.r == 5 {
+		return k
+	}
+
+	for round := 0; ; round++ {
+		var k1 []byte
+		for i := 0; i < 64; i++ {
+			k1 = append(k1, password...)
+			k1 = append(k1, k...)
+			k1 = append(k1, udata...)
+		}
+		block, _ := aes.NewCipher(k[:16])
+		e := make([]byte, len(k1))
+		cipher.NewCBCEncrypter(block, k[16:32]).CryptBlocks(e, k1)
+
+		var mod int // the first 16 bytes of e as a big-endian number mod 3
+		for _, b := range e[:16] {
+			mod += int(b)
+		}
+		switch mod % 3 {
+		case 0:
+			sum := sha256.Sum256(e)
+			k = sum[:]
+		case 1:
+			sum := sha512.Sum384(e)
+			k = sum[:]
+		case 2:
+			sum := sha512.Sum512(e)
+			k = sum[:]
+		}
+		if round >= 63 && int(e[len(e)-1]) <= round-31 {
+			return k[:32]
+		}
+	}
+}
+
+// objectKey computes the key used to encrypt a single object (Algorithm 1)
+func (s *securityHandler) objectKey(refString string, method string) []byte {
+	if method == cryptAESV3 {
+		return s.key
+	}
+	var number, generation int
+	if parts := strings.Fields(refString); len(parts) == 2 {
+		number, _ = strconv.Atoi(parts[0])
+		generation, _ = strconv.Atoi(parts[1])
+	}
+	h := md5.New()
+	h.Write(s.key)
+	h.Write([]byte{byte(number), byte(number >> 8), byte(number >> 16), byte(generation), byte(generation >> 8)})
+	if method == cryptAESV2 {
+		h.Write([]byte("sAlT"))
+	}
+	key := h.Sum(nil)
+	if len(s.key)+5 < 16 {
+		return key[:len(s.key)+5]
+	}
+	return key
+}
+
+// decrypt decrypts data that belongs to the object with the given reference
+func (s *securityHandler) decrypt(refString string, method string, data []byte) ([]byte, error) {
+	switch method {
+	case cryptRC4:
+		return rc4Crypt(s.objectKey(refString, method), data), nil
+	case cryptAESV2, cryptAESV3:
+		return aesDecrypt(s.objectKey(refString, method), data)
+	}
+	return data, nil
+}
+
+// decryptObject decrypts the stream of an object in place. Strings within dictionaries
+// aren't needed for text extraction, so they are left encrypted.
+func (s *securityHandler) decryptObject(o *object) error {
+	if o.isDecrypted || o.stream == nil {
+		return nil
+	}
+	o.isDecrypted = true
+	switch o.name("/Type") {
+	case "/XRef":
+		return nil
+	case "/Metadata":
+		if !s.encryptMetadata {
+			return nil
+		}
+	}
+	if o.name("/Filter") == "/Crypt" { // stream uses its own crypt filter (only Identity is predefined)
+		return nil
+	}
+	stream, err := s.decrypt(o.refString, s.stmF, o.stream)
+	if err != nil {
+		return err
+	}
+	o.stream = stream
+	return nil
+}
+
+// stringBytes returns the raw bytes of a literal or hexadecimal string
+func stringBytes(v interface{}) []byte {
+	switch t := v.(type) {
+	case hexdata:
+		return hexBytes(string(t))
+	case text:
+		return []byte(t)
+	}
+	return nil
+}
+
+func hexBytes(s string) []byte {
+	if len(s)%2 == 1 {
+		s += "0"
+	}
+	b, _ := hex.DecodeString(s)
+	return b
+}
+
+func padPassword(password []byte) []byte {
+	padded := make([]byte, 0, 32)
+	if len(password) > 32 {
+		password = password[:32]
+	}
+	padded = append(padded, password...)
+	return append(padded, passwordPadding[:32-len(password)]...)
+}
+
+func xorKey(key []byte, x byte) []byte {
+	k := make([]byte, len(key))
+	for i := range key {
+		k[i] = key[i] ^ x
+	}
+	return k
+}
+
+func rc4Crypt(key, data []byte) []byte {
+	c, _ := rc4.NewCipher(key)
+	out := make([]byte, len(data))
+	c.XORKeyStream(out, data)
+	return out
+}
+
+// aesDecrypt decrypts AES-CBC data that starts with a 16 byte initialization vector
+// and ends with PKCS#5 padding
+func aesDecrypt(key, data []byte) ([]byte, error) {
+	if len(data) < 2*aes.BlockSize {
+		if len(data) == aes.BlockSize { // only an initialization vector, so empty
+			return []byte{}, nil
+		}
+		return nil, errors.New("invalid AES encrypted data")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	data = data[:len(data)-len(data)%aes.BlockSize] // ignore stray bytes such as a trailing EOL
+	out := make([]byte, len(data)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, data[:aes.BlockSize]).CryptBlocks(out, data[aes.BlockSize:])
+	if pad := int(out[len(out)-1]); pad > 0 && pad <= aes.BlockSize && pad <= len(out) {
+		out = out[:len(out)-pad]
+	}
+	return out, nil
+}
@@ -0,0 +1,267 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+var testFileID = []byte("0123456789abcdef")
+
+// rc4Encryption builds a revision 3 encryption dictionary for the given passwords (Algorithms 3 and 5)
+func rc4Encryption(user, owner string, p int32) (*object, []byte) {
+	sum := md5.Sum(padPassword([]byte(owner)))
+	key := sum[:]
+	for i := 0; i < 50; i++ {
+		sum = md5.Sum(key)
+		key = sum[:]
+	}
+	o := rc4Crypt(key, padPassword([]byte(user)))
+	for i := 1; i <= 19; i++ {
+		o = rc4Crypt(xorKey(key, byte(i)), o)
+	}
+
+	s := &securityHandler{r: 3, o: o, p: p, id: testFileID}
+	fileKey := s.computeKey([]byte(user), 16)
+	h := md5.New()
+	h.Write(passwordPadding)
+	h.Write(testFileID)
+	u := h.Sum(nil)
+	for i := 0; i < 20; i++ {
+		u = rc4Crypt(xorKey(fileKey, byte(i)), u)
+	}
+	u = append(u, make([]byte, 16)...)
+
+	return &object{refString: "9 0", dict: dictionary{{"/Filter", name("/Standard")}, {"/V", integer(2)}, {"/R", integer(3)},
+		{"/Length", integer(128)}, {"/P", integer(p)}, {"/O", hexdata(hex.EncodeToString(o))}, {"/U", hexdata(hex.EncodeToString(u))}}}, fileKey
+}
+
+// aes256Encryption builds a revision 6 encryption dictionary for the given passwords and file key
+func aes256Encryption(user, owner string, fileKey []byte) *object {
+	s := &securityHandler{r: 6}
+	encryptKey := func(k []byte) []byte {
+		block, _ := aes.NewCipher(k)
+		out := make([]byte, len(fileKey))
+		cipher.NewCBCEncrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(out, fileKey)
+		return out
+	}
+	u := append(s.hash([]byte(user), []byte("uvsaltuv"), nil), []byte("uvsaltuvuksaltuk")...)
+	ue := encryptKey(s.hash([]byte(user), []byte("uksaltuk"), nil))
+	o := append(s.hash([]byte(owner), []byte("ovsaltov"), u), []byte("ovsaltovoksaltok")...)
+	oe := encryptKey(s.hash([]byte(owner), []byte("oksaltok"), u))
+
+	return &object{refString: "9 0", dict: dictionary{{"/Filter", name("/Standard")}, {"/V", integer(5)}, {"/R", integer(6)},
+		{"/Length", integer(256)}, {"/P", integer(-4)}, {"/StmF", name("/StdCF")}, {"/StrF", name("/StdCF")},
+		{"/CF", dictionary{{"/StdCF", dictionary{{"/CFM", name("/AESV3")}}}}},
+		{"/O", hexdata(hex.EncodeToString(o))}, {"/U", hexdata(hex.EncodeToString(u))},
+		{"/OE", hexdata(hex.EncodeToString(oe))}, {"/UE", hexdata(hex.EncodeToString(ue))}}}
+}
+
+func TestRC4SecurityHandler(t *testing.T) {
+	encrypt, fileKey := rc4Encryption("user", "owner", -4)
+	id := array{hexdata(hex.EncodeToString(testFileID))}
+
+	for _, password := range []string{"user", "owner"} {
+		s, err := newStandardSecurityHandler(encrypt, id, password)
+		if err != nil {
+			t.Fatal("expected success", password, err)
+		}
+		if !bytes.Equal(s.key, fileKey) {
+			t.Error("expected matching file key", password, s.key, fileKey)
+		}
+	}
+
+	if _, err := newStandardSecurityHandler(encrypt, id, ""); err != ErrPasswordRequired {
+		t.Error("expected password required", err)
+	}
+	if _, err := newStandardSecurityHandler(encrypt, id, "wrong"); err != ErrWrongPassword {
+		t.Error("expected wrong password", err)
+	}
+}
+
+func TestAES256SecurityHandler(t *testing.T) {
+	fileKey := []byte("0123456789abcdef0123456789abcdef")
+	encrypt := aes256Encryption("user", "owner", fileKey)
+
+	for _, password := range []string{"user", "owner"} {
+		s, err := newStandardSecurityHandler(encrypt, nil, password)
+		if err != nil {
+			t.Fatal("expected success", password, err)
+		}
+		if !bytes.Equal(s.key, fileKey) {
+			t.Error("expected matching file key", password, s.key, fileKey)
+		}
+	}
+
+	if _, err := newStandardSecurityHandler(encrypt, nil, "wrong"); err != ErrWrongPassword {
+		t.Error("expected wrong password", err)
+	}
+
+	// stream encrypted with a 16 byte IV and PKCS#5 padding
+	s, _ := newStandardSecurityHandler(encrypt, nil, "user")
+	iv := []byte("fedcba9876543210")
+	plain := append([]byte("BT [(Hello)] TJ ET"), bytes.Repeat([]byte{14}, 14)...)
+	block, _ := aes.NewCipher(fileKey)
+	encrypted := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+	o := &object{refString: "4 0", dict: dictionary{}, stream: append(iv, encrypted...)}
+	if err := s.decryptObject(o); err != nil || string(o.stream) != "BT [(Hello)] TJ ET" {
+		t.Error("expected decrypted stream", string(o.stream), err)
+	}
+}
+
+// encryptedPDF builds a single page PDF file encrypted with RC4 and the given passwords
+func encryptedPDF(user, owner string, p int32) []byte {
+	encrypt, _ := rc4Encryption(user, owner, p)
+	s, _ := newStandardSecurityHandler(encrypt, array{hexdata(hex.EncodeToString(testFileID))}, user)
+	content, _ := s.decrypt("4 0", cryptRC4, []byte("BT [(Hello)] TJ ET")) // RC4 is symmetric
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	pdf.WriteString("1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n")
+	pdf.WriteString("2 0 obj <</Type /Pages /Kids [3 0 R] /Count 1>> endobj\n")
+	pdf.WriteString("3 0 obj <</Type /Page /Parent 2 0 R /Contents 4 0 R>> endobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj <</Length %d>> stream\n%s\nendstream endobj\n", len(content), content)
+	fmt.Fprintf(&pdf, "9 0 obj <</Filter /Standard /V 2 /R 3 /Length 128 /P %d /O <%s> /U <%s>>> endobj\n", p, encrypt.dict.get("/O"), encrypt.dict.get("/U"))
+	fmt.Fprintf(&pdf, "trailer <</Root 1 0 R /Encrypt 9 0 R /ID [<%x> <%x>]>>\n", testFileID, testFileID)
+	return pdf.Bytes()
+}
+
+func TestTextWithPassword(t *testing.T) {
+	pdf := encryptedPDF("secret", "owner", -4)
+
+	if _, err := Text(bytes.NewReader(pdf)); err != ErrPasswordRequired {
+		t.Error("expected password required", err)
+	}
+	if _, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: "wrong"}); err != ErrWrongPassword {
+		t.Error("expected wrong password", err)
+	}
+	for _, password := range []string{"secret", "owner"} {
+		r, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: password})
+		if err != nil {
+			t.Fatal("expected success", err)
+		}
+		if b, _ := ioutil.ReadAll(r); string(b) != "Hello \n" {
+			t.Errorf("expected decrypted text, got %q", b)
+		}
+	}
+}
+
+func TestReadPermissions(t *testing.T) {
+	f, _ := os.Open(`testData/ProfotoUserGuide.pdf`)
+	defer f.Close()
+
+	p, err := ReadPermissions(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Permissions{Encrypted: true, Print: true, Annotate: true, FillForms: true, Accessibility: true, PrintHighQuality: true}
+	if *p != expected {
+		t.Errorf("expected %+v, got %+v", expected, *p)
+	}
+
+	f, _ = os.Open(`testData/Kicker.pdf`)
+	defer f.Close()
+	if p, err = ReadPermissions(f); err != nil || p.Encrypted || !p.Copy {
+		t.Error("expected unencrypted file to allow everything", p, err)
+	}
+
+	if p := newPermissions(-4&^(1<<4), 2); p.Copy || p.Accessibility || !p.Assemble {
+		t.Error("expected revision 2 accessibility to follow copy", p)
+	}
+}
+
+func TestEnforcePermissions(t *testing.T) {
+	pdf := encryptedPDF("", "owner", -4&^(1<<4)) // copy not allowed
+
+	if _, err := Text(bytes.NewReader(pdf)); err != nil {
+		t.Error("expected success without enforcement", err)
+	}
+	if _, err := TextWithOptions(bytes.NewReader(pdf), Options{EnforcePermissions: true}); err != ErrExtractionNotAllowed {
+		t.Error("expected extraction not allowed", err)
+	}
+	if _, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: "owner", EnforcePermissions: true}); err != nil {
+		t.Error("expected owner to bypass permissions", err)
+	}
+}
+
+// knownEncryptions are files encrypted by other tools with the user password "user" and the
+// owner password "owner". Their page shows "Hello" with the content stream 4 0, and /P -3901
+// doesn't allow copying. The R3 file was made with Python's cryptography package, the others
+// with pdfcpu.
+var knownEncryptions = []struct {
+	name, encrypt, id, stream string
+}{
+	{"R2 RC4 40-bit", "<</Filter/Standard/O<94e8094419662a774442fb072e3d9f19e9d130ec09a4d0061e78fe920f7ab62f>/P -3901/R 2" +
+		"/U<a892b9eb2edd20870be6ebcf84260df23f872292eca9044e686c03abb7c69ed4>/V 1>>",
+		"4db23a3ab3a33a48699ee7a2ba24e71d", "a141e3daec6b746b24ca32003bd1ace5ba3bd3e10fd7835a398930f6e39b2346afcd0916"},
+	{"R3 RC4 128-bit", "<</Filter/Standard/V 2/R 3/Length 128/O<0ba3835f88f90388e74e54584125ce142be0de24c6b0d37746e075b891756671>" +
+		"/P -3901/U<7b4acc491716df86381306186f08ab17000102030405060708090a0b0c0d0e0f>>>",
+		"5a1e00c4d3a9b0e1f2e3d4c5b6a79880", "61610e133d59af1c9459fa8be606b8780f4b5e4bb93fc71dcdf4c366efd2d40354f1ed80"},
+	{"R4 RC4 128-bit", "<</CF<</StdCF<</AuthEvent/DocOpen/CFM/V2/Length 128>>>>/Filter/Standard/Length 128" +
+		"/O<0ba3835f88f90388e74e54584125ce142be0de24c6b0d37746e075b891756671>/P -3901/R 4/StmF/StdCF/StrF/StdCF" +
+		"/U<9da1544b96ce60296968588685914c1300000000000000000000000000000000>/V 4>>",
+		"6a29710217416953e5b545759b65b250", "6bd5feff4e1939d2f1f40a2241f7781ff0c4d6d8749d04ffcb9a6348f13490f5fe4c9561"},
+	{"R4 AESV2", "<</CF<</StdCF<</AuthEvent/DocOpen/CFM/AESV2/Length 128>>>>/Filter/Standard/Length 128" +
+		"/O<0ba3835f88f90388e74e54584125ce142be0de24c6b0d37746e075b891756671>/P -3901/R 4/StmF/StdCF/StrF/StdCF" +
+		"/U<8d282fb54069d683d7134c44a5f1f12800000000000000000000000000000000>/V 4>>",
+		"0fc1bd59c786899c9e2b3e10ee52e8b8", "ceaffd1f31d86115219b48955d0e0667451cf1780bcc0ce4712435079e0e88a1" +
+			"8961fb528afc5a33c61bf0e1c839aadae299dd13ee01c54803f8ec104030f52a"},
+	{"R6 AESV3", "<</CF<</StdCF<</AuthEvent/DocOpen/CFM/AESV3/Length 32>>>>/Filter/Standard/Length 256" +
+		"/O<96ac8af17c6044c380cd07ea4116da74c622a968bdb03d861e8079f0d175c23ac1d968b95bcb3543649a2f2e77b21318>" +
+		"/OE<e8379e45d220a9b20dcbc42e038725a3c1b410d050a3f8df9a1f609bec355d7d>/P -3901/Perms<c509d0ba37e871492b689b8f6939a61b>" +
+		"/R 6/StmF/StdCF/StrF/StdCF/U<5f86a62361db6476029ea147719aaf406798c016626c069e74585130dd82e9ea786257cdde3cd642634cd20902fab6d8>" +
+		"/UE<9f6d833af9e1194e11b07970ad32fd28e7c83e77df790d3e4133e81fa9cdf2ec>/V 5>>",
+		"bbcfeaa364256f36f3a5e109fdff2e02", "9ea9a6ced8ed2c17d482ff14229b065febfa5e2814fdfce75da81aa9541155d8" +
+			"e6c6d7d30e9b2cd1911a45199848088dcc065329c38d285b89c591a8858433fc"},
+}
+
+// knownEncryptionPDF builds a single page PDF file from an encryption dictionary, file ID
+// and encrypted content stream
+func knownEncryptionPDF(encrypt, id, stream string) []byte {
+	content, _ := hex.DecodeString(stream)
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.7\n")
+	pdf.WriteString("1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n")
+	pdf.WriteString("2 0 obj <</Type /Pages /Kids [3 0 R] /Count 1>> endobj\n")
+	pdf.WriteString("3 0 obj <</Type /Page /Parent 2 0 R /Resources <</Font <</F1 5 0 R>>>> /Contents 4 0 R>> endobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj <</Length %d>> stream\n%s\nendstream endobj\n", len(content), content)
+	pdf.WriteString("5 0 obj <</Type /Font /Subtype /Type1 /BaseFont /Helvetica>> endobj\n")
+	fmt.Fprintf(&pdf, "7 0 obj %s endobj\n", encrypt)
+	fmt.Fprintf(&pdf, "trailer <</Root 1 0 R /Encrypt 7 0 R /ID [<%s> <%s>]>>\n", id, id)
+	return pdf.Bytes()
+}
+
+func TestKnownEncryptions(t *testing.T) {
+	for _, k := range knownEncryptions {
+		pdf := knownEncryptionPDF(k.encrypt, k.id, k.stream)
+		for _, password := range []string{"user", "owner"} {
+			r, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: password})
+			if err != nil {
+				t.Errorf("%s %s: expected success, got %v", k.name, password, err)
+				continue
+			}
+			if b, _ := ioutil.ReadAll(r); string(b) != "Hello\n" {
+				t.Errorf("%s %s: expected decrypted text, got %q", k.name, password, b)
+			}
+		}
+		if _, err := Text(bytes.NewReader(pdf)); err != ErrPasswordRequired {
+			t.Errorf("%s: expected password required, got %v", k.name, err)
+		}
+		if _, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: "wrong"}); err != ErrWrongPassword {
+			t.Errorf("%s: expected wrong password, got %v", k.name, err)
+		}
+		if _, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: "user", EnforcePermissions: true}); err != ErrExtractionNotAllowed {
+			t.Errorf("%s: expected extraction not allowed, got %v", k.name, err)
+		}
+		if _, err := TextWithOptions(bytes.NewReader(pdf), Options{Password: "owner", EnforcePermissions: true}); err != nil {
+			t.Errorf("%s: expected owner to bypass permissions, got %v", k.name, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package pdf2txt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrNoCatalog is returned when the trailer's /Root doesn't lead to a document catalog
+var ErrNoCatalog = errors.New("unable to find catalog")
+
+// ErrEncrypted matches, with errors.Is, every error caused by a PDF file's encryption,
+// such as ErrPasswordRequired, ErrWrongPassword and ErrCertificateRequired
+var ErrEncrypted = errors.New("PDF file is encrypted")
+
+// ErrUnsupportedFilter is returned when a stream that is needed to extract text is
+// encoded with a filter that isn't supported
+var ErrUnsupportedFilter = errors.New("unsupported filter")
+
+// encryptionError is an error that also matches ErrEncrypted
+type encryptionError string
+
+func (e encryptionError) Error() string {
+	return string(e)
+}
+
+func (e encryptionError) Is(target error) bool {
+	return target == ErrEncrypted
+}
+
+// Phase is the stage of extraction in which an error happened
+type Phase int
+
+// Phases of extraction
+const (
+	PhaseLexing   Phase = iota // tokenizing the file or an object stream
+	PhaseDecoding              // decrypting and decoding streams
+	PhaseCMap                  // parsing a ToUnicode CMap
+	PhaseContent               // parsing a page content stream
+)
+
+var phaseNames = []string{"lexing", "decoding", "cmap", "content"}
+
+func (p Phase) String() string {
+	if p >= 0 && int(p) < len(phaseNames) {
+		return phaseNames[p]
+	}
+	return "Phase(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Error describes where reading a PDF file failed. Use errors.As to get it from the
+// error returned by Text and errors.Is to compare the underlying error.
+type Error struct {
+	Phase Phase
+
+	// Offset is the byte offset at which the failure happened, or -1 if unknown. Lexing
+	// errors are offsets in the file, or in the decoded object stream if Ref is one. CMap
+	// and content errors are offsets in the decoded stream of Ref.
+	Offset int64
+
+	// Ref is the object being parsed when the failure happened, such as "12 0", or empty
+	Ref string
+
+	Err error
+}
+
+func (e *Error) Error() string {
+	msg := e.Phase.String() + " error"
+	if e.Ref != "" {
+		msg += " in object " + e.Ref
+	}
+	if e.Offset >= 0 {
+		msg += fmt.Sprintf(" at offset %d", e.Offset)
+	}
+	return msg + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// newError adds the phase, object and offset to err unless it already has them. Errors
+// from the lexer already have an offset, so they only get the phase and object.
+func newError(phase Phase, ref string, offset int64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		if e.Ref == "" {
+			e.Phase, e.Ref = phase, ref
+		}
+		return err
+	}
+	return &Error{Phase: phase, Offset: offset, Ref: ref, Err: err}
+}
@@ -0,0 +1,58 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/EndFirstCorp/peekingReader"
+)
+
+func TestErrorLocation(t *testing.T) {
+	o := &object{refString: "7 0", stream: []byte("1 beginbfchar /X <41> endbfchar")}
+	err := o.saveCmap(make(map[string]*cmap))
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatal("expected located error", err)
+	}
+	if e.Phase != PhaseCMap || e.Ref != "7 0" || e.Offset != 16 {
+		t.Errorf("expected cmap error in 7 0 at 16, got %+v", e)
+	}
+	if err.Error() != "cmap error in object 7 0 at offset 16: invalid bfchar data" {
+		t.Error("unexpected message", err)
+	}
+
+	tokenizeEach(peekingReader.NewMemReader([]byte("1 0 obj <</A 1>> endobj\n2 0 obj stream\rX")), func(item interface{}) {
+		err, _ = item.(error)
+	})
+	if !errors.As(err, &e) || e.Phase != PhaseLexing || e.Ref != "2 0" || e.Offset != 40 {
+		t.Errorf("expected lexing error in 2 0 at 40, got %v", err)
+	}
+
+	o = &object{refString: "3 0", dict: dictionary{{"/Filter", name("/LZWDecode")}}, stream: []byte{0}}
+	if err := o.decodeStream(); !errors.Is(err, ErrUnsupportedFilter) || !errors.As(err, &e) || e.Phase != PhaseDecoding {
+		t.Error("expected unsupported filter", err)
+	}
+	o = &object{refString: "3 0", dict: dictionary{{"/Filter", name("/FlateDecode")}}, stream: []byte("junk")}
+	if err := o.decodeStream(); !errors.As(err, &e) || e.Ref != "3 0" || e.Offset != -1 {
+		t.Error("expected decoding error for 3 0", err)
+	}
+}
+
+func TestErrorSentinels(t *testing.T) {
+	for _, err := range []error{ErrPasswordRequired, ErrWrongPassword, ErrCertificateRequired, ErrWrongCertificate, ErrExtractionNotAllowed} {
+		if !errors.Is(err, ErrEncrypted) {
+			t.Error("expected encryption error", err)
+		}
+	}
+	if errors.Is(io.EOF, ErrEncrypted) || errors.Is(ErrNoCatalog, ErrEncrypted) {
+		t.Error("expected only encryption errors to match")
+	}
+
+	pdf := singlePagePDF("BT ET", "")
+	pdf = bytes.Replace(pdf, []byte("/Root 1 0 R"), []byte("/Root 9 0 R"), 1)
+	if _, err := Text(bytes.NewReader(pdf)); err != ErrNoCatalog {
+		t.Error("expected no catalog", err)
+	}
+}
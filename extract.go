@@ -1,6 +1,7 @@
 package pdf2txt
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -26,13 +27,13 @@ func extract(filename string) error {
 	toUnicode := []string{}
 	var decodeError error
 
-	tchan := make(chan interface{}, 15)
-	go tokenize(peekingReader.NewBufReader(f), tchan)
-
-	for t := range tchan {
+	tokenizeEach(peekingReader.NewBufReader(f), func(t interface{}) {
+		if err != nil { // stop at the first error
+			return
+		}
 		switch v := t.(type) {
 		case error:
-			return v
+			err = v
 
 		case *object:
 			oType := v.name("/Type")
@@ -54,20 +55,20 @@ func extract(filename string) error {
 				if decodeError == nil {
 					decodeError = v.decodeStream()
 				}
-				if err := ioutil.WriteFile(path.Join(outDir, fmt.Sprintf("objStm %s.txt", v.refString)), v.stream, 0644); err != nil {
-					return err
+				if err = ioutil.WriteFile(path.Join(outDir, fmt.Sprintf("objStm %s.txt", v.refString)), v.stream, 0644); err != nil {
+					return
 				}
 				if decodeError != nil {
-					continue
+					return
 				}
-				objs, err := v.getObjectStream()
-				if err != nil {
-					return err
+				var objs []*object
+				if objs, err = v.getObjectStream(); err != nil {
+					return
 				}
 				for i := range objs {
-					err := ioutil.WriteFile(path.Join(outDir, fmt.Sprintf("decoded %s.txt", objs[i].refString)), []byte(fmt.Sprintf("%v", objs[i])), 0644)
+					err = ioutil.WriteFile(path.Join(outDir, fmt.Sprintf("decoded %s.txt", objs[i].refString)), []byte(fmt.Sprintf("%v", objs[i])), 0644)
 					if err != nil {
-						return err
+						return
 					}
 				}
 
@@ -75,6 +76,9 @@ func extract(filename string) error {
 				uncategorized[v.refString] = v
 			}
 		}
+	})
+	if err != nil {
+		return err
 	}
 
 	for i := range toUnicode {
@@ -100,7 +104,7 @@ func extract(filename string) error {
 	}
 
 	for ref, item := range uncategorized {
-		if err := item.decodeStream(); err != nil {
+		if err := item.decodeStream(); err != nil && !errors.Is(err, ErrUnsupportedFilter) { // images are written as is
 			return err
 		}
 		if err := ioutil.WriteFile(path.Join(outDir, "uncategorized "+ref+".txt"), item.stream, 0644); err != nil {
@@ -0,0 +1,410 @@
+//go:build ignore
+
+// gencjk writes cjkdata.go from the CMap files of Adobe's cmap-resources
+// (https://github.com/adobe-type-tools/cmap-resources, BSD 3-Clause License, copyright
+// Adobe). It packs the predefined CMaps that map codes to the CIDs of the Adobe-Japan1,
+// Adobe-GB1, Adobe-CNS1 and Adobe-Korea1 collections, and the Adobe-*-UCS2 CMaps that map
+// the CIDs of each collection to Unicode:
+//
+//	go run gencjk.go -dir path/to/cmap-resources
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// orderings are the character collections whose tables are packed
+var orderings = []string{"Japan1", "GB1", "CNS1", "Korea1"}
+
+// cmapNames are the predefined CMaps that map codes to CIDs (table 118 of ISO 32000-1 and
+// the Adobe CMaps those use), without their -H and -V
+var cmapNames = []string{
+	"83pv-RKSJ", "90ms-RKSJ", "90msp-RKSJ", "90pv-RKSJ", "Add-RKSJ", "EUC", "Ext-RKSJ", "",
+	"GB-EUC", "GBpc-EUC", "GBK-EUC", "GBKp-EUC", "GBK2K",
+	"B5pc", "HKscs-B5", "ETen-B5", "ETenms-B5", "CNS-EUC",
+	"KSC-EUC", "KSCms-UHC", "KSCms-UHC-HW", "KSCpc-EUC",
+}
+
+type cmapFile struct {
+	name, use, ordering string
+	codespace           [][2][]byte
+	cids                map[string]int // CIDs by code
+	unicode             map[int]string // Unicode by CID, for the UCS2 CMaps
+}
+
+func main() {
+	dir := flag.String("dir", "", "the directory with Adobe's CMap files")
+	out := flag.String("o", "cjkdata.go", "the file to write")
+	flag.Parse()
+
+	files := make(map[string]string)
+	err := filepath.Walk(*dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files[info.Name()] = path
+		}
+		return err
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	cmaps := make(map[string]*cmapFile)
+	var read func(name string) *cmapFile
+	read = func(name string) *cmapFile {
+		if c := cmaps[name]; c != nil {
+			return c
+		}
+		path, ok := files[name]
+		if !ok {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c := parse(name, data)
+		cmaps[name] = c
+		if c.use != "" && read(c.use) == nil {
+			log.Fatalf("%s uses %s, which is missing", name, c.use)
+		}
+		return c
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gencjk.go from Adobe's cmap-resources; DO NOT EDIT.\n\npackage pdf2txt\n\n")
+	buf.WriteString("// cidCMapData are the predefined CMaps that map codes to CIDs, by name. Each is packed by\n")
+	buf.WriteString("// gencjk.go and compressed with flate, and is read by readCIDCMap.\n")
+	buf.WriteString("var cidCMapData = map[name]string{\n")
+	var names []string
+	for _, n := range cmapNames {
+		for _, suffix := range []string{"-H", "-V"} {
+			name := strings.TrimPrefix(n+suffix, "-")
+			if read(name) != nil {
+				names = append(names, name)
+			}
+		}
+	}
+	for i := 0; i < len(names); i++ { // and the CMaps they use
+		if use := cmaps[names[i]].use; use != "" && !contains(names, use) {
+			names = append(names, use)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeData(&buf, "/"+name, packCMap(cmaps[name], cmaps))
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// collectionData are the CID-to-Unicode tables of the Adobe character collections, by\n")
+	buf.WriteString("// ordering. Each is packed by gencjk.go from the Adobe-<ordering>-UCS2 CMap and compressed\n")
+	buf.WriteString("// with flate, and is read by readCollection.\n")
+	buf.WriteString("var collectionData = map[string]string{\n")
+	for _, ordering := range orderings {
+		c := read("Adobe-" + ordering + "-UCS2")
+		if c == nil {
+			log.Fatalf("Adobe-%s-UCS2 is missing", ordering)
+		}
+		writeData(&buf, ordering, packCollection(c))
+	}
+	buf.WriteString("}\n")
+	if err := os.WriteFile(*out, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+var tokenPattern = regexp.MustCompile(`<[0-9A-Fa-f]*>|\([^)]*\)|/[^\s/<>\[\]()]+|[^\s/<>\[\]()]+`)
+
+// parse reads the codespace ranges, cidchars, cidranges, bfchars and bfranges of a CMap
+func parse(name string, data []byte) *cmapFile {
+	c := &cmapFile{name: name, cids: make(map[string]int), unicode: make(map[int]string)}
+	tokens := tokenPattern.FindAllString(string(data), -1)
+	section := ""
+	var operands []string
+	for i, t := range tokens {
+		switch {
+		case t == "usecmap" && i > 0:
+			c.use = strings.TrimPrefix(tokens[i-1], "/")
+		case t == "/Ordering" && i+1 < len(tokens):
+			c.ordering = strings.Trim(tokens[i+1], "()")
+		case strings.HasPrefix(t, "begin"):
+			section, operands = t, nil
+		case strings.HasPrefix(t, "end"):
+			section = ""
+		case section != "":
+			operands = append(operands, t)
+			switch section {
+			case "begincodespacerange":
+				if len(operands) == 2 {
+					c.codespace = append(c.codespace, [2][]byte{hexBytes(operands[0]), hexBytes(operands[1])})
+					operands = nil
+				}
+			case "begincidchar":
+				if len(operands) == 2 {
+					c.cids[string(hexBytes(operands[0]))] = number(operands[1])
+					operands = nil
+				}
+			case "begincidrange":
+				if len(operands) == 3 {
+					low, high, cid := hexBytes(operands[0]), hexBytes(operands[1]), number(operands[2])
+					for code := codeValue(low); code <= codeValue(high); code, cid = code+1, cid+1 {
+						c.cids[string(codeBytes(code, len(low)))] = cid
+					}
+					operands = nil
+				}
+			case "beginbfchar":
+				if len(operands) == 2 {
+					c.unicode[int(codeValue(hexBytes(operands[0])))] = utf16Text(hexBytes(operands[1]))
+					operands = nil
+				}
+			case "beginbfrange":
+				if len(operands) == 3 {
+					low, high, dst := codeValue(hexBytes(operands[0])), codeValue(hexBytes(operands[1])), hexBytes(operands[2])
+					for code := low; code <= high; code++ { // carrying past the last byte, as Adobe's files do
+						c.unicode[int(code)] = utf16Text(codeBytes(codeValue(dst)+code-low, len(dst)))
+					}
+					operands = nil
+				}
+			}
+		}
+	}
+	return c
+}
+
+func hexBytes(t string) []byte {
+	t = strings.Trim(t, "<>")
+	b := make([]byte, len(t)/2)
+	for i := range b {
+		v, err := strconv.ParseUint(t[2*i:2*i+2], 16, 8)
+		if err != nil {
+			log.Fatal(err)
+		}
+		b[i] = byte(v)
+	}
+	return b
+}
+
+func number(t string) int {
+	n, err := strconv.Atoi(t)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return n
+}
+
+func codeValue(code []byte) uint64 {
+	var v uint64
+	for _, b := range code {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
+
+func codeBytes(v uint64, n int) []byte {
+	code := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		code[i] = byte(v)
+		v >>= 8
+	}
+	return code
+}
+
+func utf16Text(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+	return string(utf16.Decode(u))
+}
+
+// resolved returns the CIDs a CMap maps codes to, with those of the CMaps it uses
+func resolved(c *cmapFile, cmaps map[string]*cmapFile) map[string]int {
+	cids := make(map[string]int)
+	if c.use != "" {
+		for code, cid := range resolved(cmaps[c.use], cmaps) {
+			cids[code] = cid
+		}
+	}
+	for code, cid := range c.cids {
+		cids[code] = cid
+	}
+	return cids
+}
+
+type packer struct{ bytes.Buffer }
+
+func (p *packer) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	p.Write(b[:binary.PutUvarint(b[:], v)])
+}
+
+func (p *packer) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	p.Write(b[:binary.PutVarint(b[:], v)])
+}
+
+func (p *packer) string(s string) {
+	p.uvarint(uint64(len(s)))
+	p.WriteString(s)
+}
+
+// packCMap packs a CMap as the name of the CMap it uses, its ordering, its codespace
+// ranges as a length and a low and a high code, and runs of codes that map to consecutive
+// CIDs. Each run is the code length, how far its first code is past the end of the run
+// before it, the number of codes after the first one and how far its first CID is from the
+// CID after the run before it. Only the mappings that differ from those of the used CMap
+// are packed.
+func packCMap(c *cmapFile, cmaps map[string]*cmapFile) []byte {
+	var p packer
+	p.string(c.use)
+	p.string(c.ordering)
+	p.uvarint(uint64(len(c.codespace)))
+	for _, r := range c.codespace {
+		p.WriteByte(byte(len(r[0])))
+		p.Write(r[0])
+		p.Write(r[1])
+	}
+
+	var parent map[string]int
+	if c.use != "" {
+		parent = resolved(cmaps[c.use], cmaps)
+	}
+	var codes []string
+	for code, cid := range c.cids {
+		if pcid, ok := parent[code]; !ok || pcid != cid {
+			codes = append(codes, code)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		if len(codes[i]) != len(codes[j]) {
+			return len(codes[i]) < len(codes[j])
+		}
+		return codes[i] < codes[j]
+	})
+	type run struct {
+		length     int
+		low, count uint64
+		cid        int
+	}
+	var runs []run
+	for _, code := range codes {
+		v, cid := codeValue([]byte(code)), c.cids[code]
+		if n := len(runs); n > 0 {
+			r := &runs[n-1]
+			if r.length == len(code) && r.low+r.count+1 == v && r.cid+int(r.count)+1 == cid {
+				r.count++
+				continue
+			}
+		}
+		runs = append(runs, run{length: len(code), low: v, cid: cid})
+	}
+	p.uvarint(uint64(len(runs)))
+	var end uint64
+	nextCID, length := 0, 0
+	for _, r := range runs {
+		if r.length != length {
+			end, length = 0, r.length
+		}
+		p.WriteByte(byte(r.length))
+		p.uvarint(r.low - end)
+		p.uvarint(r.count)
+		p.varint(int64(r.cid - nextCID))
+		end, nextCID = r.low+r.count+1, r.cid+int(r.count)+1
+	}
+	return p.Bytes()
+}
+
+// packCollection packs the CID-to-Unicode table of a collection as runs of CIDs, each of
+// them how far its first CID is past the end of the run before it and its number of CIDs.
+// A run of CIDs whose text is one character, each the character after the one before,
+// then has how far its first character is from the last character of the run before it. A
+// run of 0 CIDs is one CID whose text is more than one character, followed by the number
+// of characters and the characters.
+func packCollection(c *cmapFile) []byte {
+	var cids []int
+	for cid := range c.unicode {
+		if cid != 0 { // .notdef
+			cids = append(cids, cid)
+		}
+	}
+	sort.Ints(cids)
+	var p packer
+	var runs [][]int
+	for _, cid := range cids {
+		r := []rune(c.unicode[cid])
+		if n := len(runs); n > 0 && len(r) == 1 {
+			last := runs[n-1]
+			prev := last[len(last)-1]
+			if pr := []rune(c.unicode[prev]); len(pr) == 1 && prev+1 == cid && pr[0]+1 == r[0] {
+				runs[n-1] = append(last, cid)
+				continue
+			}
+		}
+		runs = append(runs, []int{cid})
+	}
+	end := 0
+	var last rune
+	for _, run := range runs {
+		p.uvarint(uint64(run[0] - end))
+		r := []rune(c.unicode[run[0]])
+		if len(r) == 1 {
+			p.uvarint(uint64(len(run)))
+			p.varint(int64(r[0] - last))
+			last = r[0] + rune(len(run)) - 1
+		} else {
+			p.uvarint(0)
+			p.uvarint(uint64(len(r)))
+			for _, c := range r {
+				p.uvarint(uint64(c))
+			}
+		}
+		end = run[len(run)-1] + 1
+	}
+	return p.Bytes()
+}
+
+// writeData writes a map entry with data compressed with flate, as a string split over
+// lines
+func writeData(buf *bytes.Buffer, key string, data []byte) {
+	var z bytes.Buffer
+	w, _ := flate.NewWriter(&z, flate.BestCompression)
+	w.Write(data)
+	w.Close()
+	fmt.Fprintf(buf, "\t%q: \"\" +\n", key)
+	b := z.Bytes()
+	for len(b) > 0 {
+		n := 32
+		if n > len(b) {
+			n = len(b)
+		}
+		buf.WriteString("\t\t\"")
+		for _, c := range b[:n] {
+			fmt.Fprintf(buf, "\\x%02x", c)
+		}
+		b = b[n:]
+		if len(b) > 0 {
+			buf.WriteString("\" +\n")
+		} else {
+			buf.WriteString("\",\n")
+		}
+	}
+}
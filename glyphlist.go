@@ -0,0 +1,78 @@
+package pdf2txt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// glyphText returns the Unicode text for a glyph name, or "" if the name isn't known. It
+// follows the Adobe Glyph List Specification: anything from the first period on is a
+// suffix that is ignored, ligatures list their components separated by underscores, and
+// uniXXXX and uXXXX to uXXXXXX name characters by their hexadecimal code points.
+func glyphText(glyph string) string {
+	return listGlyphText(glyph, false)
+}
+
+// dingbatsGlyphText returns the Unicode text for a glyph name of the ZapfDingbats font,
+// which uses the names of its own list instead of the Adobe Glyph List
+func dingbatsGlyphText(glyph string) string {
+	return listGlyphText(glyph, true)
+}
+
+func listGlyphText(glyph string, dingbats bool) string {
+	if i := strings.IndexByte(glyph, '.'); i >= 0 {
+		glyph = glyph[:i]
+	}
+	var text []rune
+	for _, component := range strings.Split(glyph, "_") {
+		if r, ok := zapfDingbatsGlyphList[component]; ok && dingbats {
+			text = append(text, r)
+		} else if r, ok := glyphList[component]; ok && !dingbats {
+			text = append(text, r)
+		} else if s, ok := glyphSequences[component]; ok && !dingbats {
+			text = append(text, []rune(s)...)
+		} else if runes := glyphCodePoints(component); runes != nil {
+			text = append(text, runes...)
+		}
+	}
+	return string(text)
+}
+
+// glyphCodePoints reads the code points of a uniXXXX or uXXXX name. uni is followed by one
+// or more groups of four hexadecimal digits, while u is followed by four to six digits.
+func glyphCodePoints(component string) []rune {
+	switch {
+	case strings.HasPrefix(component, "uni"):
+		digits := component[3:]
+		if len(digits) == 0 || len(digits)%4 != 0 {
+			return nil
+		}
+		var runes []rune
+		for i := 0; i < len(digits); i += 4 {
+			r, ok := codePoint(digits[i : i+4])
+			if !ok {
+				return nil
+			}
+			runes = append(runes, r)
+		}
+		return runes
+	case strings.HasPrefix(component, "u"):
+		digits := component[1:]
+		if len(digits) < 4 || len(digits) > 6 {
+			return nil
+		}
+		if r, ok := codePoint(digits); ok {
+			return []rune{r}
+		}
+	}
+	return nil
+}
+
+// codePoint parses hexadecimal digits as a Unicode scalar value, which excludes surrogates
+func codePoint(digits string) (rune, bool) {
+	v, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || v > 0x10ffff || (v >= 0xd800 && v <= 0xdfff) {
+		return 0, false
+	}
+	return rune(v), true
+}
@@ -0,0 +1,57 @@
+package pdf2txt
+
+import "testing"
+
+func TestGlyphText(t *testing.T) {
+	tests := map[string]string{
+		"A":                   "A",
+		"quoteright":          "’",
+		"fi":                  "ﬁ",
+		"Lslash":              "Ł",
+		"Scommaaccent":        "Ș",
+		"Tcommaaccent":        "Ţ",
+		"Omega":               "\u2126", // the AGL maps Omega to the ohm sign
+		"Omegagreek":          "Ω",
+		"alpha":               "α",
+		"Euro":                "€",
+		"a.sc":                "a",
+		"one.oldstyle":        "1",
+		"f_f_i":               "ffi",
+		"T_h":                 "Th",
+		"uni20AC":             "€",
+		"uni00410042":         "AB",
+		"uni20ac":             "€",
+		"u1F600":              "😀",
+		"u0041":               "A",
+		"uniD800":             "",
+		"u110000":             "",
+		"uni004":              "",
+		"u12":                 "",
+		"g123":                "",
+		".notdef":             "",
+		"union":               "∪",
+		"uni0066_uni69":       "f",
+		"afii10017":           "А",
+		"afii10101":           "є",
+		"afii57409":           "ء",
+		"afii57664":           "א",
+		"shinshindot":         "\ufb2a",
+		"lamedholamdagesh":    "\u05dc\u05b9\u05bc",
+		"qofqubutshebrew":     "\u05e7\u05bb",
+		"rehyehaleflamarabic": "\u0631\ufef3\ufe8e\u0644",
+		"dotlessj":            "ȷ",
+		"a20":                 "",
+	}
+	for glyph, expected := range tests {
+		if actual := glyphText(glyph); actual != expected {
+			t.Errorf("%s: expected %q, got %q", glyph, expected, actual)
+		}
+	}
+
+	dingbats := map[string]string{"a1": "✁", "a20": "✔", "a71": "●", "a89": "❨", "a120": "①", "a161": "→", "space": " ", "A": "", "uni2713": "✓"}
+	for glyph, expected := range dingbats {
+		if actual := dingbatsGlyphText(glyph); actual != expected {
+			t.Errorf("%s: expected ZapfDingbats %q, got %q", glyph, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,516 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/EndFirstCorp/peekingReader"
+)
+
+// TokenKind is the type of a lexical token
+type TokenKind int
+
+// Kinds of lexical tokens (section 7.2)
+const (
+	TokenInteger TokenKind = iota
+	TokenReal
+	TokenBoolean
+	TokenNull
+	TokenName
+	TokenString
+	TokenHexString
+	TokenComment
+	TokenKeyword
+	TokenArrayStart
+	TokenArrayEnd
+	TokenDictStart
+	TokenDictEnd
+	TokenProcStart
+	TokenProcEnd
+)
+
+var tokenKindNames = []string{"Integer", "Real", "Boolean", "Null", "Name", "String", "HexString", "Comment",
+	"Keyword", "ArrayStart", "ArrayEnd", "DictStart", "DictEnd", "ProcStart", "ProcEnd"}
+
+func (k TokenKind) String() string {
+	if k >= 0 && int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Token is a lexical token along with where it was found in the input
+type Token struct {
+	Kind   TokenKind
+	Offset int64 // byte offset of the first byte of the token
+	Length int64 // number of bytes the token takes up in the input
+
+	// Value holds the decoded bytes of strings and hex strings, names including their
+	// leading '/', comments without their '%', keywords, numbers and delimiters. It points
+	// into the lexer's buffer, so it is only valid until the next call to Next.
+	Value []byte
+	Int   int     // value of an integer
+	Real  float64 // value of a real
+	Bool  bool    // value of a boolean
+}
+
+// maxStreamChunk is the most stream data read at once, since stream lengths can't be trusted
+const maxStreamChunk = 1 << 20
+
+// maxPooledBuffer keeps buffers grown by very large tokens out of the pool
+const maxPooledBuffer = 64 * 1024
+
+var bufferPool = sync.Pool{New: func() interface{} {
+	b := make([]byte, 0, 512)
+	return &b
+}}
+
+// Lexer splits a PDF file or content stream into lexical tokens. It reuses a single
+// token and buffer, so reading tokens doesn't allocate.
+type Lexer struct {
+	r      countingReader
+	buf    *[]byte
+	tok    Token
+	rawHex bool // keep hex strings as uppercase hex digits instead of decoding them
+}
+
+// NewLexer creates a lexer that reads from r. The lexer reads ahead, so r can't be used
+// for anything else afterwards. Call Close when done with it.
+func NewLexer(r peekingReader.Reader) *Lexer {
+	return &Lexer{r: countingReader{src: r}, buf: bufferPool.Get().(*[]byte)}
+}
+
+// newBytesLexer creates a lexer that reads data without copying it
+func newBytesLexer(data []byte) *Lexer {
+	return &Lexer{r: countingReader{buf: data}, buf: bufferPool.Get().(*[]byte)}
+}
+
+// Close returns the lexer's buffer to the pool. Neither the lexer nor the tokens it
+// returned can be used afterwards.
+func (l *Lexer) Close() {
+	if l.buf != nil && cap(*l.buf) <= maxPooledBuffer {
+		bufferPool.Put(l.buf)
+	}
+	l.buf = nil
+}
+
+// Offset returns the number of bytes read so far
+func (l *Lexer) Offset() int64 {
+	return l.r.offset()
+}
+
+// Next returns the next token. The token and its value are overwritten by the following
+// call. Next returns io.EOF when the input ends between tokens and an *Error wrapping
+// io.ErrUnexpectedEOF when it ends inside one. After a stream keyword, ReadStream reads
+// the stream data.
+func (l *Lexer) Next() (*Token, error) {
+	r := &l.r
+	if err := r.skipSpaces(); err != nil {
+		return nil, err
+	}
+	start := r.offset()
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &l.tok
+	*t = Token{Offset: start}
+	v := (*l.buf)[:0]
+	switch b {
+	case '(':
+		t.Kind = TokenString
+		v, err = appendText(v, r)
+	case '<':
+		if r.skip('<') {
+			t.Kind, v = TokenDictStart, append(v, '<', '<')
+			break
+		}
+		t.Kind = TokenHexString
+		v, err = appendHexdata(v, r)
+		if err == nil && !l.rawHex {
+			v = decodeHexInPlace(v)
+		}
+	case '>':
+		if !r.skip('>') {
+			return nil, &Error{Phase: PhaseLexing, Offset: start, Err: unexpectedByteError(b)}
+		}
+		t.Kind, v = TokenDictEnd, append(v, '>', '>')
+	case '[':
+		t.Kind, v = TokenArrayStart, append(v, b)
+	case ']':
+		t.Kind, v = TokenArrayEnd, append(v, b)
+	case '{':
+		t.Kind, v = TokenProcStart, append(v, b)
+	case '}':
+		t.Kind, v = TokenProcEnd, append(v, b)
+	case '/':
+		t.Kind = TokenName
+		if v, err = appendName(v, r); err == io.EOF { // end of file also ends the name
+			err = nil
+		}
+	case '%':
+		t.Kind = TokenComment
+		v, err = appendComment(v, r)
+	case ')':
+		return nil, &Error{Phase: PhaseLexing, Offset: start, Err: unexpectedByteError(b)}
+	default:
+		if v, err = appendToken(append(v, b), r); err != nil {
+			break
+		}
+		t.Length = int64(len(v)) // the stream keyword also consumes its EOL
+		switch string(v) {
+		case "true", "false":
+			t.Kind, t.Bool = TokenBoolean, v[0] == 't'
+		case "null":
+			t.Kind = TokenNull
+		case "stream":
+			t.Kind = TokenKeyword
+			err = unexpectedEOF(readStreamEOL(r))
+		default:
+			var isReal, ok bool
+			if t.Int, t.Real, isReal, ok = scanNumber(v); !ok {
+				t.Kind = TokenKeyword
+			} else if isReal {
+				t.Kind = TokenReal
+			}
+		}
+	}
+	*l.buf = v[:0] // keep the buffer if it grew
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, newError(PhaseLexing, "", r.offset(), err)
+	}
+
+	t.Value = v
+	if t.Length == 0 {
+		t.Length = r.offset() - start
+	}
+	return t, nil
+}
+
+// ReadStream reads the data of a stream after its stream keyword. If length is
+// negative, the data is read up to the endstream keyword instead. It returns
+// io.ErrUnexpectedEOF if the input ends first.
+func (l *Lexer) ReadStream(length int) ([]byte, error) {
+	if length >= 0 {
+		if length <= maxStreamChunk {
+			b, err := l.r.ReadBytes(length)
+			return b, unexpectedEOF(err)
+		}
+		var data []byte // read a chunk at a time so a bad length can't allocate more than the file
+		for length > 0 {
+			n := length
+			if n > maxStreamChunk {
+				n = maxStreamChunk
+			}
+			b, err := l.r.ReadBytes(n)
+			data = append(data, b...)
+			if err != nil {
+				return data, unexpectedEOF(err)
+			}
+			length -= n
+		}
+		return data, nil
+	}
+	var data []byte
+	for {
+		p, err := l.r.Peek(9)
+		if err == nil && string(p) == "endstream" {
+			return bytes.TrimRight(data, "\r\n"), nil
+		}
+		b, err := l.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		data = append(data, b)
+	}
+}
+
+// readRaw reads the bytes up to the end byte without tokenizing them. The end byte is
+// consumed but not returned, and the bytes are only valid until the next call to Next.
+// It returns io.ErrUnexpectedEOF if the input ends first.
+func (l *Lexer) readRaw(end byte) ([]byte, error) {
+	v := (*l.buf)[:0]
+	defer func() { *l.buf = v[:0] }()
+	for {
+		b, err := l.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if b == end {
+			return v, nil
+		}
+		v = append(v, b)
+	}
+}
+
+// unexpectedByteError is a closing delimiter that doesn't close anything
+type unexpectedByteError byte
+
+func (e unexpectedByteError) Error() string {
+	return fmt.Sprintf("unexpected '%c'", byte(e))
+}
+
+// Name is a PDF name object including its leading '/'
+type Name string
+
+// Keyword is a bare keyword found where an object was expected
+type Keyword string
+
+// Ref is an indirect object reference such as 12 0 R
+type Ref struct {
+	Number     int
+	Generation int
+}
+
+// IndirectObject is an object defined with "obj" and "endobj" along with where it was
+// found in the input. Values are dictionaries (map[Name]interface{}), arrays ([]interface{}),
+// names (Name), strings ([]byte), integers (int), reals (float64), booleans (bool),
+// references (Ref), keywords (Keyword) or nil for null.
+type IndirectObject struct {
+	Ref    Ref
+	Offset int64 // byte offset of the object number
+	Length int64 // number of bytes through the end of endobj
+	Value  interface{}
+	Stream []byte // raw stream data, still encoded with its filters
+}
+
+// ObjectScanner reads the indirect objects of a PDF file one at a time
+type ObjectScanner struct {
+	p *parser
+}
+
+// NewObjectScanner creates an object scanner that reads from r. Call Close when done with it.
+func NewObjectScanner(r peekingReader.Reader) *ObjectScanner {
+	return &ObjectScanner{p: newParser(r)}
+}
+
+// Close releases the scanner's buffers. The scanner can't be used afterwards.
+func (s *ObjectScanner) Close() {
+	s.p.close()
+}
+
+// Next returns the next indirect object. It returns io.EOF when there are no more objects.
+// Anything between objects, such as cross reference tables and trailers, is skipped.
+func (s *ObjectScanner) Next() (*IndirectObject, error) {
+	for {
+		item, err := s.p.item()
+		if err != nil {
+			return nil, err
+		}
+		v, ok := item.(*objectref)
+		if !ok || v.refType != "obj" {
+			continue
+		}
+		start := s.p.start
+		o, err := readObject(s.p, v)
+		if err != nil {
+			return nil, err
+		}
+		obj := &IndirectObject{Ref: exportRef(v), Offset: start, Length: s.p.end - start, Stream: o.stream}
+		if o.dict != nil {
+			obj.Value = exportValue(o.dict)
+		} else if len(o.values) > 0 {
+			obj.Value = exportValue(o.values[0])
+		}
+		return obj, nil
+	}
+}
+
+func exportRef(o *objectref) Ref {
+	var ref Ref
+	parts := bytes.Fields([]byte(o.refString))
+	if len(parts) == 2 {
+		ref.Number, _ = strconv.Atoi(string(parts[0]))
+		ref.Generation, _ = strconv.Atoi(string(parts[1]))
+	}
+	return ref
+}
+
+// exportValue converts a parsed value into the exported types used by IndirectObject
+func exportValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case dictionary:
+		d := make(map[Name]interface{}, len(t))
+		for _, e := range t {
+			d[Name(e.key)] = exportValue(e.value)
+		}
+		return d
+	case array:
+		a := make([]interface{}, 0, len(t))
+		for i := range t {
+			switch t[i].(type) {
+			case comment, end:
+				continue
+			}
+			a = append(a, exportValue(t[i]))
+		}
+		return a
+	case name:
+		return Name(t)
+	case text:
+		return []byte(t)
+	case hexdata:
+		return hexBytes(string(t))
+	case integer:
+		return int(t)
+	case real:
+		return float64(t)
+	case boolean:
+		return bool(t)
+	case *objectref:
+		return exportRef(t)
+	case token:
+		return Keyword(t)
+	case codestream:
+		return string(t)
+	}
+	return nil
+}
+
+// readBufferSize is how much of a reader's input a lexer buffers at once
+const readBufferSize = 32 * 1024
+
+// countingReader buffers the input of a lexer, so that bytes are taken from a slice rather
+// than read through an interface, and keeps track of how many bytes have been read
+type countingReader struct {
+	src  io.Reader // where buf is refilled from, or nil when buf holds the whole input
+	buf  []byte    // the unread bytes are buf[pos:]
+	pos  int
+	base int64 // offset of buf[0] in the input
+	err  error // error that ended src
+}
+
+// offset returns the number of bytes read so far
+func (c *countingReader) offset() int64 {
+	return c.base + int64(c.pos)
+}
+
+// fill makes n bytes available to read unless the input ends first
+func (c *countingReader) fill(n int) {
+	for len(c.buf)-c.pos < n && c.src != nil && c.err == nil {
+		unread := len(c.buf) - c.pos
+		if c.buf == nil || n > cap(c.buf) {
+			size := readBufferSize
+			if n > size {
+				size = n
+			}
+			b := make([]byte, unread, size)
+			copy(b, c.buf[c.pos:])
+			c.buf = b
+		} else {
+			copy(c.buf, c.buf[c.pos:])
+			c.buf = c.buf[:unread]
+		}
+		c.base += int64(c.pos)
+		c.pos = 0
+		m, err := c.src.Read(c.buf[unread:cap(c.buf)])
+		c.buf = c.buf[:unread+m]
+		c.err = err
+	}
+}
+
+// readErr is the error for having no more bytes to read
+func (c *countingReader) readErr() error {
+	if c.err != nil {
+		return c.err
+	}
+	return io.EOF
+}
+
+// Peek returns the next n bytes without reading them. If there are fewer, it returns those
+// along with the error that ended the input.
+func (c *countingReader) Peek(n int) ([]byte, error) {
+	if len(c.buf)-c.pos < n {
+		c.fill(n)
+		if len(c.buf)-c.pos < n {
+			return c.buf[c.pos:], c.readErr()
+		}
+	}
+	return c.buf[c.pos : c.pos+n], nil
+}
+
+// peekByte returns the next byte without reading it, or false at the end of the input
+func (c *countingReader) peekByte() (byte, bool) {
+	if c.pos < len(c.buf) {
+		return c.buf[c.pos], true
+	}
+	c.fill(1)
+	if c.pos < len(c.buf) {
+		return c.buf[c.pos], true
+	}
+	return 0, false
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	if c.pos < len(c.buf) {
+		b := c.buf[c.pos]
+		c.pos++
+		return b, nil
+	}
+	c.fill(1)
+	if c.pos < len(c.buf) {
+		b := c.buf[c.pos]
+		c.pos++
+		return b, nil
+	}
+	return 0, c.readErr()
+}
+
+// ReadBytes reads the next n bytes. Bytes read from a reader are copied, while those of a
+// byte slice aren't.
+func (c *countingReader) ReadBytes(n int) ([]byte, error) {
+	if c.src == nil {
+		if len(c.buf)-c.pos < n {
+			return nil, io.EOF
+		}
+		b := c.buf[c.pos : c.pos+n : c.pos+n]
+		c.pos += n
+		return b, nil
+	}
+	b := make([]byte, n)
+	m := copy(b, c.buf[c.pos:])
+	c.pos += m
+	if m < n && c.err == nil { // read the rest directly rather than through the buffer
+		k, err := io.ReadFull(c.src, b[m:])
+		c.base, c.buf, c.pos = c.offset()+int64(k), c.buf[:0], 0
+		m += k
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		c.err = err
+	}
+	if m < n {
+		return b[:m], c.readErr()
+	}
+	return b, nil
+}
+
+// skip moves past the next byte if it matches
+func (c *countingReader) skip(b byte) bool {
+	if next, ok := c.peekByte(); ok && next == b {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpaces moves past whitespace. It returns an error if the input ends.
+func (c *countingReader) skipSpaces() error {
+	for {
+		for ; c.pos < len(c.buf); c.pos++ {
+			if !isWhitespace(c.buf[c.pos]) {
+				return nil
+			}
+		}
+		if _, ok := c.peekByte(); !ok {
+			return c.readErr()
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/EndFirstCorp/peekingReader"
+)
+
+func TestLexer(t *testing.T) {
+	input := "%PDF\n<</Type /Page /N 12 /R -1.5 /B true /Z null>>\n[(a\\)b) <4142>] {q} BT"
+	expected := []Token{
+		{Kind: TokenComment, Offset: 0, Length: 4, Value: []byte("PDF")},
+		{Kind: TokenDictStart, Offset: 5, Length: 2, Value: []byte("<<")},
+		{Kind: TokenName, Offset: 7, Length: 5, Value: []byte("/Type")},
+		{Kind: TokenName, Offset: 13, Length: 5, Value: []byte("/Page")},
+		{Kind: TokenName, Offset: 19, Length: 2, Value: []byte("/N")},
+		{Kind: TokenInteger, Offset: 22, Length: 2, Value: []byte("12"), Int: 12},
+		{Kind: TokenName, Offset: 25, Length: 2, Value: []byte("/R")},
+		{Kind: TokenReal, Offset: 28, Length: 4, Value: []byte("-1.5"), Real: -1.5},
+		{Kind: TokenName, Offset: 33, Length: 2, Value: []byte("/B")},
+		{Kind: TokenBoolean, Offset: 36, Length: 4, Value: []byte("true"), Bool: true},
+		{Kind: TokenName, Offset: 41, Length: 2, Value: []byte("/Z")},
+		{Kind: TokenNull, Offset: 44, Length: 4, Value: []byte("null")},
+		{Kind: TokenDictEnd, Offset: 48, Length: 2, Value: []byte(">>")},
+		{Kind: TokenArrayStart, Offset: 51, Length: 1, Value: []byte("[")},
+		{Kind: TokenString, Offset: 52, Length: 6, Value: []byte("a)b")},
+		{Kind: TokenHexString, Offset: 59, Length: 6, Value: []byte("AB")},
+		{Kind: TokenArrayEnd, Offset: 65, Length: 1, Value: []byte("]")},
+		{Kind: TokenProcStart, Offset: 67, Length: 1, Value: []byte("{")},
+		{Kind: TokenKeyword, Offset: 68, Length: 1, Value: []byte("q")},
+		{Kind: TokenProcEnd, Offset: 69, Length: 1, Value: []byte("}")},
+		{Kind: TokenKeyword, Offset: 71, Length: 2, Value: []byte("BT")},
+	}
+
+	l := NewLexer(peekingReader.NewMemReader([]byte(input)))
+	for i := range expected {
+		tok, err := l.Next()
+		if err != nil {
+			t.Fatal("expected token", i, err)
+		}
+		if !reflect.DeepEqual(*tok, expected[i]) {
+			t.Errorf("expected %+v, got %+v", expected[i], tok)
+		}
+		if string(input[tok.Offset]) != string(input[expected[i].Offset]) {
+			t.Error("expected offset to point at token", i)
+		}
+	}
+	if _, err := l.Next(); err != io.EOF {
+		t.Error("expected EOF", err)
+	}
+	l.Close()
+
+	if _, err := NewLexer(peekingReader.NewMemReader([]byte(") "))).Next(); err == nil {
+		t.Error("expected error on unbalanced parenthesis")
+	}
+	for _, in := range []string{"(abc", "<4142", `(abc\`} {
+		var e *Error
+		if _, err := NewLexer(peekingReader.NewMemReader([]byte(in))).Next(); !errors.As(err, &e) || e.Phase != PhaseLexing || !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("%q: expected unexpected EOF lexing error, got %v", in, err)
+		}
+	}
+}
+
+func TestLexerReadStream(t *testing.T) {
+	l := NewLexer(peekingReader.NewMemReader([]byte("stream\r\nBT ET\nendstream")))
+	if tok, err := l.Next(); err != nil || tok.Kind != TokenKeyword || string(tok.Value) != "stream" || tok.Length != 6 {
+		t.Fatal("expected stream keyword", tok, err)
+	}
+	if data, err := l.ReadStream(-1); err != nil || string(data) != "BT ET" {
+		t.Errorf("expected stream data, got %q %v", data, err)
+	}
+	if tok, err := l.Next(); err != nil || string(tok.Value) != "endstream" || tok.Offset != 14 {
+		t.Error("expected endstream keyword", tok, err)
+	}
+
+	l = NewLexer(peekingReader.NewMemReader([]byte("stream\nabc endstream")))
+	l.Next()
+	if data, err := l.ReadStream(3); err != nil || string(data) != "abc" || l.Offset() != 10 {
+		t.Errorf("expected stream data, got %q %v", data, err)
+	}
+
+	for _, length := range []int{-1, 20} {
+		l = NewLexer(peekingReader.NewMemReader([]byte("stream\nabc")))
+		l.Next()
+		if _, err := l.ReadStream(length); err != io.ErrUnexpectedEOF {
+			t.Errorf("length %d: expected unexpected EOF, got %v", length, err)
+		}
+	}
+	if _, err := NewLexer(peekingReader.NewMemReader([]byte("stream"))).Next(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Error("expected unexpected EOF after the stream keyword", err)
+	}
+}
+
+func TestObjectScanner(t *testing.T) {
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	pdf.WriteString("1 0 obj <</Type /Catalog /Pages 2 0 R /Ids [(a) <62>]>> endobj\n")
+	pdf.WriteString("2 0 obj 42 endobj\n")
+	pdf.WriteString("xref\n0 1\n0000000000 65535 f \n")
+	pdf.WriteString("3 1 obj <</Length 5>> stream\nBT ET\nendstream endobj\n")
+	pdf.WriteString("4 0 obj <</Length 5 0 R>> stream\n(<<\nendstream endobj\n")
+	pdf.WriteString("trailer <</Root 1 0 R>>\nstartxref\n0\n%%EOF\n")
+
+	s := NewObjectScanner(peekingReader.NewMemReader(pdf.Bytes()))
+	expected := []IndirectObject{
+		{Ref: Ref{1, 0}, Offset: 9, Length: 62, Value: map[Name]interface{}{"/Type": Name("/Catalog"),
+			"/Pages": Ref{2, 0}, "/Ids": []interface{}{[]byte("a"), []byte("b")}}},
+		{Ref: Ref{2, 0}, Offset: 72, Length: 17, Value: 42},
+		{Ref: Ref{3, 1}, Offset: 119, Length: 51, Value: map[Name]interface{}{"/Length": 5}, Stream: []byte("BT ET")},
+		{Ref: Ref{4, 0}, Offset: 171, Length: 53, Value: map[Name]interface{}{"/Length": Ref{5, 0}}, Stream: []byte("(<<")},
+	}
+	for i := range expected {
+		o, err := s.Next()
+		if err != nil {
+			t.Fatal("expected object", i, err)
+		}
+		if !reflect.DeepEqual(*o, expected[i]) {
+			t.Errorf("expected %+v, got %+v", expected[i], *o)
+		}
+		if !bytes.HasSuffix(pdf.Bytes()[:o.Offset+o.Length], []byte("endobj")) {
+			t.Error("expected object to end with endobj", i)
+		}
+	}
+	if _, err := s.Next(); err != io.EOF {
+		t.Error("expected EOF", err)
+	}
+}
+
+func TestLexerFiles(t *testing.T) {
+	for _, file := range []string{"testData/132_0.txt", "testData/bfrange.txt"} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l := NewLexer(peekingReader.NewMemReader(data))
+		count := 0
+		for {
+			_, err := l.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(file, err)
+			}
+			count++
+		}
+		l.Close()
+		if count == 0 {
+			t.Error("expected tokens in", file)
+		}
+	}
+}
+
+func TestObjectScannerFiles(t *testing.T) {
+	for _, file := range []string{"testData/Kicker.pdf", "testData/Profoto.pdf", "testData/ProfotoUserGuide.pdf"} {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := NewObjectScanner(peekingReader.NewBufReader(f))
+		count := 0
+		for {
+			_, err := s.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(file, err)
+			}
+			count++
+		}
+		s.Close()
+		f.Close()
+		if count == 0 {
+			t.Error("expected objects in", file)
+		}
+	}
+
+	f, err := os.Open("testData/catalog.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := NewObjectScanner(peekingReader.NewBufReader(f))
+	defer s.Close()
+	o, err := s.Next()
+	if d, ok := o.Value.(map[Name]interface{}); err != nil || o.Ref != (Ref{7967, 0}) || !ok || d["/Type"] != Name("/Catalog") {
+		t.Error("expected the catalog", o, err)
+	}
+}
+
+func BenchmarkLexer(b *testing.B) {
+	benchmarkPDFs(b, func(data []byte) {
+		l := NewLexer(peekingReader.NewMemReader(data))
+		for { // binary stream data is lexed too, so skip stray delimiters
+			var u unexpectedByteError
+			if _, err := l.Next(); err != nil && !errors.As(err, &u) {
+				break
+			}
+		}
+		l.Close()
+	})
+}
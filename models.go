@@ -4,19 +4,36 @@ import (
 	"bytes"
 	"compress/zlib"
 	"fmt"
-	"strconv"
-
-	"github.com/EndFirstCorp/peekingReader"
 )
 
 func (o *object) getFont() *font {
-	font := font{Encoding: o.name("/Encoding")}
+	font := font{Subtype: o.name("/Subtype"), BaseFont: o.name("/BaseFont"), Encoding: o.search("/Encoding"),
+		DescendantFonts: o.array("/DescendantFonts"), CIDSystemInfo: o.search("/CIDSystemInfo"), CIDToGIDMap: o.search("/CIDToGIDMap"),
+		FontMatrix: o.array("/FontMatrix"), FirstChar: o.int("/FirstChar"), Widths: o.search("/Widths"), CharProcs: o.search("/CharProcs"),
+		Resources: o.search("/Resources")}
 	if u := o.objectref("/ToUnicode"); u != nil {
 		font.ToUnicode = u.refString
 	}
+	if fd := o.objectref("/FontDescriptor"); fd != nil {
+		font.FontDescriptor = fd.refString
+	}
 	return &font
 }
 
+func (o *object) getFontDescriptor() *fontDescriptor {
+	fd := fontDescriptor{Flags: o.int("/Flags")}
+	if ff := o.objectref("/FontFile"); ff != nil {
+		fd.FontFile = ff.refString
+	}
+	if ff := o.objectref("/FontFile2"); ff != nil {
+		fd.FontFile2 = ff.refString
+	}
+	if ff := o.objectref("/FontFile3"); ff != nil {
+		fd.FontFile3 = ff.refString
+	}
+	return &fd
+}
+
 func (o *object) getPages() *pages {
 	k := o.array("/Kids")
 	kids := make([]string, len(k))
@@ -31,12 +48,8 @@ func (o *object) getPages() *pages {
 func (o *object) getPage() *page {
 	page := page{Fonts: make(map[name]string)}
 	if res, ok := o.search("/Resources").(dictionary); ok {
-		if fonts, ok := res["/Font"].(dictionary); ok {
-			for key, value := range fonts {
-				if oref, ok := value.(*objectref); ok {
-					page.Fonts[key] = oref.refString
-				}
-			}
+		if fonts, ok := res.get("/Font").(dictionary); ok {
+			page.Fonts = fontRefs(fonts)
 		}
 	}
 	// Contents can be either a single object reference
@@ -58,13 +71,35 @@ func (o *object) getPage() *page {
 	return &page
 }
 
+// fontRefs returns the references of the fonts in a /Font resource dictionary by name
+func fontRefs(fonts dictionary) map[name]string {
+	refs := make(map[name]string)
+	for _, e := range fonts {
+		if oref, ok := e.value.(*objectref); ok {
+			refs[e.key] = oref.refString
+		}
+	}
+	return refs
+}
+
+// get returns the value of the key, or nil if the dictionary doesn't have it. Dictionaries
+// are small, so they are searched rather than hashed, and a repeated key takes its last value.
+func (d dictionary) get(key name) interface{} {
+	for i := len(d) - 1; i >= 0; i-- {
+		if d[i].key == key {
+			return d[i].value
+		}
+	}
+	return nil
+}
+
 func (d dictionary) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("<<")
-	for key := range d {
+	for _, e := range d {
 		buf.WriteString("\n  ")
-		buf.WriteString(string(key))
-		buf.WriteString(fmt.Sprintf(" %v", d[key]))
+		buf.WriteString(string(e.key))
+		buf.WriteString(fmt.Sprintf(" %v", e.value))
 	}
 	buf.WriteString("\n>>")
 	return buf.String()
@@ -106,21 +141,41 @@ func (o *object) name(n name) name {
 }
 
 func (o *object) int(n name) int {
-	if v, ok := o.search(n).(token); ok {
-		i, _ := strconv.Atoi(string(v))
-		return i
+	switch v := o.search(n).(type) {
+	case integer:
+		return int(v)
+	case real:
+		return int(v)
 	}
 	return 0
 }
 
-func (o *object) search(name name) interface{} {
-	if o.dict == nil {
+// number returns the value of an integer or real
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case integer:
+		return float64(n), true
+	case real:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// numbers returns the numbers of an array, or nil if v isn't one. Other items are 0.
+func numbers(v interface{}) []float64 {
+	a, _ := v.(array)
+	if a == nil {
 		return nil
 	}
-	if v, ok := o.dict[name]; ok {
-		return v
+	n := make([]float64, len(a))
+	for i := range a {
+		n[i], _ = number(a[i])
 	}
-	return nil
+	return n
+}
+
+func (o *object) search(name name) interface{} {
+	return o.dict.get(name)
 }
 
 func (o *object) streamLength() int {
@@ -142,12 +197,12 @@ func (o *object) decodeStream() error {
 		buf := bytes.NewReader(o.stream)
 		r, err := zlib.NewReader(buf)
 		if err != nil {
-			return err
+			return newError(PhaseDecoding, o.refString, -1, err)
 		}
 
 		var out bytes.Buffer
 		if _, err := out.ReadFrom(r); err != nil {
-			return err
+			return newError(PhaseDecoding, o.refString, -1, err)
 		}
 		o.isStreamDecoded = true
 		o.stream = out.Bytes()
@@ -159,8 +214,10 @@ func (o *object) decodeStream() error {
 	//case "/DCTDecode":
 	//case "/JPXDecode":
 	//case "/Crypt":
-	default:
+	case "\x00": // no filter
 		return nil
+	default:
+		return newError(PhaseDecoding, o.refString, -1, fmt.Errorf("%w %s", ErrUnsupportedFilter, filter))
 	}
 }
 
@@ -169,23 +226,30 @@ func (o *object) isTrailer() bool {
 }
 
 func (o *object) getObjectStream() ([]*object, error) {
-	n := o.search("/N").(token)
-	numObjs, _ := strconv.Atoi(string(n))
+	numObjs := o.int("/N")
+	if numObjs < 0 || numObjs > len(o.stream) { // every object takes at least a byte
+		return nil, newError(PhaseLexing, o.refString, -1, fmt.Errorf("invalid object count %d", numObjs))
+	}
 
 	objs := make([]*object, numObjs)
-	r := peekingReader.NewMemReader(o.stream)
+	p := newBytesParser(o.stream)
+	defer p.close()
 	for i := 0; i < numObjs; i++ {
-		number := readNext(r)
+		number, err := p.item()
+		if err != nil {
+			return nil, newError(PhaseLexing, o.refString, p.l.Offset(), err)
+		}
 		refString := fmt.Sprintf("%v 0", number)
-		objs[i] = &object{refString: refString}
+		objs[i] = &object{refString: refString, isDecrypted: true} // already decrypted with the object stream
 
-		readNext(r) // offset info (we don't need)
+		p.item() // offset info (we don't need)
 	}
 	for i := 0; i < numObjs; i++ {
-		obj := readNext(r)
+		obj, err := p.item()
+		if err != nil {
+			return nil, newError(PhaseLexing, o.refString, p.l.Offset(), err)
+		}
 		switch v := obj.(type) {
-		case error:
-			return nil, v
 		case dictionary:
 			objs[i].dict = v
 		default:
@@ -195,26 +259,28 @@ func (o *object) getObjectStream() ([]*object, error) {
 	return objs, nil
 }
 
-func (o *object) saveContents(contents map[string][]textsection) error {
-	err := o.decodeStream()
-	if err != nil {
-		return err
-	}
-	sections, err := getTextSections(peekingReader.NewMemReader(o.stream))
-	if err != nil {
+// saveContents keeps the decoded content stream. It is tokenized once all the content
+// streams of its page are available, since they make up a single stream.
+func (o *object) saveContents(contents map[string][]byte) error {
+	if err := o.decodeStream(); err != nil {
 		return err
 	}
-	contents[o.refString] = sections
+	contents[o.refString] = o.stream
 	return nil
 }
 
-func (o *object) saveCmap(cmaps map[string]cmap) error {
+// saveCmap reads the cmap of a stream. The CMap it uses can be named in the stream
+// dictionary as well as by the usecmap operator.
+func (o *object) saveCmap(cmaps map[string]*cmap) error {
 	if err := o.decodeStream(); err != nil {
 		return err
 	}
-	cmap, err := getCmap(peekingReader.NewMemReader(o.stream))
+	cmap, err := getCmap(o.stream)
 	if err != nil {
-		return err
+		return newError(PhaseCMap, o.refString, -1, err)
+	}
+	if u := o.search("/UseCMap"); u != nil {
+		cmap.use(u)
 	}
 	cmaps[o.refString] = cmap
 	return nil
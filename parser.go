@@ -0,0 +1,371 @@
+package pdf2txt
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/EndFirstCorp/peekingReader"
+)
+
+// maxDepth limits how deeply arrays and dictionaries can be nested, so that hostile input
+// can't overflow the stack
+const maxDepth = 256
+
+var errTooDeep = errors.New("arrays and dictionaries nested too deeply")
+
+// parser builds objects such as dictionaries, arrays and object references from the
+// tokens of a Lexer. Object references are recognized by reading up to two tokens ahead
+// of an integer.
+type parser struct {
+	l          *Lexer
+	ahead      []Token  // tokens read ahead, last one first
+	saved      [][]byte // values of the tokens read ahead
+	cur        Token    // token taken from ahead
+	value      []byte   // value of cur
+	err        error    // error found while reading ahead
+	peeked     []Token  // tokens read while looking for R or obj
+	peekedVals [][]byte // values of the peeked tokens
+	names      map[string]name
+	entries    []dictEntry   // entries of the dictionaries being read, innermost last
+	arena      []dictEntry   // storage shared by the dictionaries that have been read
+	elements   []interface{} // elements of the arrays being read, innermost last
+	elemArena  []interface{} // storage shared by the arrays that have been read
+	depth      int           // number of arrays and dictionaries being read
+	start      int64         // offset of the first token of the last item
+	end        int64         // offset just past the last token
+
+	// content is set for content streams and CMaps, which don't have object references,
+	// so that integers are returned without reading ahead
+	content bool
+}
+
+func newParser(r peekingReader.Reader) *parser {
+	return newLexerParser(NewLexer(r))
+}
+
+// newBytesParser creates a parser that reads data without copying it
+func newBytesParser(data []byte) *parser {
+	return newLexerParser(newBytesLexer(data))
+}
+
+func newLexerParser(l *Lexer) *parser {
+	l.rawHex = true // hexdata is kept as hex digits
+	return &parser{l: l, names: make(map[string]name)}
+}
+
+func (p *parser) close() {
+	p.l.Close()
+}
+
+// reader returns the reader past the last token, which is only correct when no tokens have
+// been read ahead. That is always the case after a keyword or anything else that isn't
+// an integer.
+func (p *parser) reader() *countingReader {
+	return &p.l.r
+}
+
+// next returns the next token. Like Lexer.Next, the token is only valid until the next call.
+func (p *parser) next() (*Token, error) {
+	var t *Token
+	if i := len(p.ahead) - 1; i >= 0 {
+		p.cur = p.ahead[i]
+		p.ahead = p.ahead[:i]
+		p.saved[i], p.value = p.value, p.saved[i] // keep cur's value until the next call
+		t = &p.cur
+	} else if p.err != nil {
+		err := p.err
+		p.err = nil
+		return nil, err
+	} else {
+		var err error
+		if t, err = p.l.Next(); err != nil {
+			return nil, err
+		}
+	}
+	p.end = t.Offset + t.Length
+	return t, nil
+}
+
+// unread puts a token back to be returned again by next, copying its value
+func (p *parser) unread(t *Token) {
+	i := len(p.ahead)
+	if i == len(p.saved) {
+		p.saved = append(p.saved, nil)
+	}
+	p.saved[i] = append(p.saved[i][:0], t.Value...)
+	p.ahead = append(p.ahead, *t)
+	p.ahead[i].Value = p.saved[i]
+}
+
+// item reads the next complete item such as a dictionary, an array, an object reference
+// or a single token. Closing delimiters that don't close anything are returned as end.
+func (p *parser) item() (interface{}, error) {
+	t, err := p.next()
+	if err != nil {
+		var u unexpectedByteError
+		if errors.As(err, &u) {
+			return end(u), nil
+		}
+		return nil, err
+	}
+	p.start = t.Offset
+	return p.itemFrom(t)
+}
+
+// itemFrom builds the item that starts with the token
+func (p *parser) itemFrom(t *Token) (interface{}, error) {
+	switch t.Kind {
+	case TokenInteger:
+		if p.content {
+			return integer(t.Int), nil
+		}
+		return p.integerOrRef(t)
+	case TokenReal:
+		return real(t.Real), nil
+	case TokenBoolean:
+		return boolean(t.Bool), nil
+	case TokenNull:
+		return null(true), nil
+	case TokenName:
+		return p.name(t.Value), nil
+	case TokenString:
+		return text(t.Value), nil
+	case TokenHexString:
+		return hexdata(t.Value), nil
+	case TokenComment:
+		return comment(t.Value), nil
+	case TokenKeyword:
+		return keyword(t.Value), nil
+	case TokenArrayStart:
+		return p.array()
+	case TokenDictStart:
+		return p.dictionary()
+	case TokenProcStart:
+		v, err := p.l.readRaw('}')
+		if err != nil {
+			return nil, err
+		}
+		return codestream(v), nil
+	case TokenDictEnd:
+		return end('>'), nil
+	}
+	return end(t.Value[0]), nil // ] and }
+}
+
+// name interns names since the same few keys and values make up most of a file
+func (p *parser) name(v []byte) name {
+	if n, ok := p.names[string(v)]; ok {
+		return n
+	}
+	n := name(v)
+	p.names[string(n)] = n
+	return n
+}
+
+// keyword avoids allocating the keywords that come up in every file
+func keyword(v []byte) token {
+	switch string(v) {
+	case "obj":
+		return "obj"
+	case "endobj":
+		return "endobj"
+	case "stream":
+		return "stream"
+	case "endstream":
+		return "endstream"
+	case "R":
+		return "R"
+	case "n":
+		return "n"
+	case "f":
+		return "f"
+	}
+	return token(v)
+}
+
+// integerOrRef reads an object reference such as "12 0 R" or "12 0 obj" if the integer
+// starts one and returns the integer otherwise (section 7.3.10). Comments between the
+// parts of a reference count as whitespace.
+func (p *parser) integerOrRef(t *Token) (interface{}, error) {
+	number := t.Int
+	defer func() { p.peeked = p.peeked[:0] }()
+	g, err := p.peek()
+	if err != nil || g.Kind != TokenInteger || !isNumber(g.Value[0]) {
+		p.putBack(err)
+		return integer(number), nil
+	}
+	generation := g.Int
+	r, err := p.peek()
+	if err != nil {
+		p.putBack(err)
+		return integer(number), nil
+	}
+	var refType string
+	switch string(r.Value) {
+	case "R":
+		refType = "R"
+	case "obj":
+		refType = "obj"
+	default:
+		p.putBack(nil)
+		return integer(number), nil
+	}
+	var buf [40]byte
+	ref := strconv.AppendInt(buf[:0], int64(number), 10)
+	ref = append(ref, ' ')
+	ref = strconv.AppendInt(ref, int64(generation), 10)
+	return &objectref{refString: string(ref), refType: refType}, nil
+}
+
+// peek reads the next token that isn't a comment, keeping a copy of every token read so
+// that putBack can return them. The token is only valid until the next call.
+func (p *parser) peek() (*Token, error) {
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		i := len(p.peeked)
+		if i == len(p.peekedVals) {
+			p.peekedVals = append(p.peekedVals, nil)
+		}
+		p.peekedVals[i] = append(p.peekedVals[i][:0], t.Value...)
+		p.peeked = append(p.peeked, *t)
+		p.peeked[i].Value = p.peekedVals[i]
+		if t.Kind != TokenComment {
+			return &p.peeked[i], nil
+		}
+	}
+}
+
+// putBack unreads the peeked tokens so they are read again in order, followed by err
+func (p *parser) putBack(err error) {
+	if err != nil {
+		p.err = err
+	}
+	for i := len(p.peeked) - 1; i >= 0; i-- {
+		p.unread(&p.peeked[i])
+	}
+}
+
+// array reads the items up to the closing ]. Comments and closing delimiters that don't
+// close anything aren't elements, so they are skipped.
+func (p *parser) array() (array, error) {
+	if p.depth++; p.depth > maxDepth {
+		return nil, errTooDeep
+	}
+	start := len(p.elements)
+	defer func() {
+		p.depth--
+		for i := start; i < len(p.elements); i++ {
+			p.elements[i] = nil // don't keep the elements alive
+		}
+		p.elements = p.elements[:start]
+	}()
+	for {
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		switch item.(type) {
+		case comment:
+			continue
+		case end:
+			if item == end(']') {
+				return p.newArray(start), nil
+			}
+			continue
+		}
+		p.elements = append(p.elements, item)
+	}
+}
+
+// newArray moves the elements read since start into the arena, like newDictionary
+func (p *parser) newArray(start int) array {
+	n := len(p.elements) - start
+	if n == 0 {
+		return array{}
+	}
+	if n > cap(p.elemArena)-len(p.elemArena) {
+		size := 256
+		if n > size {
+			size = n
+		}
+		p.elemArena = make([]interface{}, 0, size)
+	}
+	i := len(p.elemArena)
+	p.elemArena = append(p.elemArena, p.elements[start:]...)
+	return array(p.elemArena[i:len(p.elemArena):len(p.elemArena)])
+}
+
+// dictionary reads the key and value pairs up to the closing >>. Anything in place of a
+// key that isn't a name is skipped, and a key without a value is null.
+func (p *parser) dictionary() (dictionary, error) {
+	if p.depth++; p.depth > maxDepth {
+		return nil, errTooDeep
+	}
+	start := len(p.entries)
+	defer func() {
+		p.depth--
+		for i := start; i < len(p.entries); i++ {
+			p.entries[i] = dictEntry{} // don't keep the values alive
+		}
+		p.entries = p.entries[:start]
+	}()
+	for {
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := item.(name)
+		if !ok {
+			if item == end('>') {
+				return p.newDictionary(start), nil
+			}
+			continue
+		}
+
+		value, err := p.dictValue()
+		if err != nil {
+			return nil, err
+		}
+		switch value.(type) {
+		case end:
+			if value == end('>') {
+				p.entries = append(p.entries, dictEntry{key, null(true)})
+				return p.newDictionary(start), nil
+			}
+			continue // a stray ] or } isn't a value
+		}
+		p.entries = append(p.entries, dictEntry{key, value})
+	}
+}
+
+// newDictionary moves the entries read since start into the arena, so that dictionaries
+// share a few large allocations rather than each allocating its own
+func (p *parser) newDictionary(start int) dictionary {
+	n := len(p.entries) - start
+	if n == 0 {
+		return dictionary{}
+	}
+	if n > cap(p.arena)-len(p.arena) {
+		size := 256
+		if n > size {
+			size = n
+		}
+		p.arena = make([]dictEntry, 0, size)
+	}
+	i := len(p.arena)
+	p.arena = append(p.arena, p.entries[start:]...)
+	return dictionary(p.arena[i:len(p.arena):len(p.arena)])
+}
+
+// dictValue reads the next item that isn't a comment
+func (p *parser) dictValue() (interface{}, error) {
+	for {
+		item, err := p.item()
+		if _, ok := item.(comment); !ok || err != nil {
+			return item, err
+		}
+	}
+}
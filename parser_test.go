@@ -0,0 +1,93 @@
+package pdf2txt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/EndFirstCorp/peekingReader"
+)
+
+func TestContainerConformance(t *testing.T) {
+	ref := func(s string) *objectref { return &objectref{refString: s, refType: "R"} }
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		// dictionaries
+		{"<<>>", dictionary{}},
+		{"<< >>", dictionary{}},
+		{"<</A<<>>>>", dictionary{{"/A", dictionary{}}}},
+		{"<</A 1>>", dictionary{{"/A", integer(1)}}},
+		{"<</A 1.5>>", dictionary{{"/A", real(1.5)}}},
+		{"<</A true>>", dictionary{{"/A", boolean(true)}}},
+		{"<</A/B>>", dictionary{{"/A", name("/B")}}},
+		{"<</A(x)>>", dictionary{{"/A", text("x")}}},
+		{"<</A<41>>>", dictionary{{"/A", hexdata("41")}}},
+		{"<</A[1 2]>>", dictionary{{"/A", array{integer(1), integer(2)}}}},
+		{"<</A 1 0 R>>", dictionary{{"/A", ref("1 0")}}},
+		{"<</A 1 0 R/B 2>>", dictionary{{"/A", ref("1 0")}, {"/B", integer(2)}}},
+		{"<</A null>>", dictionary{{"/A", null(true)}}},
+		{"<</A>>", dictionary{{"/A", null(true)}}},
+		{"<</A 1 /B>>", dictionary{{"/A", integer(1)}, {"/B", null(true)}}},
+		{"<</>>", dictionary{{"/", null(true)}}},
+		{"<<%c\n/A 1>>", dictionary{{"/A", integer(1)}}},
+		{"<</A %c\n1 /B 2>>", dictionary{{"/A", integer(1)}, {"/B", integer(2)}}},
+		{"<</A 1%c\n>>", dictionary{{"/A", integer(1)}}},
+		{"<</A %c\n>>", dictionary{{"/A", null(true)}}},
+		{"<</A 1 %c\n0 %d\nR>>", dictionary{{"/A", ref("1 0")}}},
+		{"<</A ] /B 1>>", dictionary{{"/B", integer(1)}}},
+		{"<<1 /A 2>>", dictionary{{"/A", integer(2)}}},
+		{"<</A (>>) /B 1>>", dictionary{{"/A", text(">>")}, {"/B", integer(1)}}},
+		{"<</A (%c) /B 1>>", dictionary{{"/A", text("%c")}, {"/B", integer(1)}}},
+
+		// arrays
+		{"[]", array{}},
+		{"[ ]", array{}},
+		{"[[][]]", array{array{}, array{}}},
+		{"[(a)(b)]", array{text("a"), text("b")}},
+		{"[1 (a b) 2]", array{integer(1), text("a b"), integer(2)}},
+		{"[<41><42>]", array{hexdata("41"), hexdata("42")}},
+		{"[/A/B]", array{name("/A"), name("/B")}},
+		{"[1 %c\n2]", array{integer(1), integer(2)}},
+		{"[%c\n]", array{}},
+		{"[1 0 R 2]", array{ref("1 0"), integer(2)}},
+		{"[1 2 3 R]", array{integer(1), ref("2 3")}},
+		{"[1 2 3]", array{integer(1), integer(2), integer(3)}},
+		{"[1 %c\n0 R]", array{ref("1 0")}},
+		{"[1 2 %c\n]", array{integer(1), integer(2)}},
+		{"[1 >> } 2]", array{integer(1), integer(2)}},
+		{"[<</A 1>>]", array{dictionary{{"/A", integer(1)}}}},
+		{"[true false null]", array{boolean(true), boolean(false), null(true)}},
+		{"[-1 +2 .5]", array{integer(-1), integer(2), real(.5)}},
+	}
+	for _, test := range tests {
+		p := newParser(peekingReader.NewMemReader([]byte(test.input)))
+		actual, err := p.item()
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%q: expected %#v, got %#v", test.input, test.expected, actual)
+		}
+		if _, err := p.item(); err == nil {
+			t.Errorf("%q: expected the container to take up the whole input", test.input)
+		}
+	}
+}
+
+func TestLookaheadOrder(t *testing.T) {
+	p := newParser(peekingReader.NewMemReader([]byte("1 %a\n2 %b\n3 4 0 R")))
+	var actual []interface{}
+	for {
+		item, err := p.item()
+		if err != nil {
+			break
+		}
+		actual = append(actual, item)
+	}
+	expected := []interface{}{integer(1), comment("a"), integer(2), comment("b"), integer(3), &objectref{refString: "4 0", refType: "R"}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,250 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/rc4"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrCertificateRequired is returned when a PDF file is encrypted for certificate
+// recipients and no certificate and private key were supplied
+var ErrCertificateRequired error = encryptionError("certificate and private key required to decrypt PDF file")
+
+// ErrWrongCertificate is returned when the supplied certificate isn't one of the
+// recipients of a PDF file encrypted for certificate recipients
+var ErrWrongCertificate error = encryptionError("certificate isn't a recipient of encrypted PDF file")
+
+var (
+	oidEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidDESEDE3CBC    = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+	oidRC4           = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 4}
+	oidAES128CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// PKCS#7 structures needed to open the recipient envelopes (RFC 2315, section 10)
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type pkcs7EnvelopedData struct {
+	Version              int
+	RecipientInfos       []pkcs7RecipientInfo `asn1:"set"`
+	EncryptedContentInfo pkcs7EncryptedContentInfo
+}
+
+type pkcs7RecipientInfo struct {
+	Version                int
+	IssuerAndSerialNumber  pkcs7IssuerAndSerialNumber
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type pkcs7IssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type pkcs7EncryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           asn1.RawValue `asn1:"optional"`
+}
+
+// newPublicKeySecurityHandler opens the recipient envelope meant for the certificate to
+// recover the seed and derives the file key from it (section 7.6.5)
+func newPublicKeySecurityHandler(encrypt *object, cert *x509.Certificate, key crypto.PrivateKey) (*securityHandler, error) {
+	if cert == nil || key == nil {
+		return nil, ErrCertificateRequired
+	}
+	decrypter, ok := key.(crypto.Decrypter)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	s, keyLength := newCryptFilters(encrypt)
+	lists := []interface{}{encrypt.search("/Recipients")}
+	if s.v >= 4 { // adbe.pkcs7.s5 keeps the recipients in the crypt filters, the stream filter's first
+		cf, _ := encrypt.search("/CF").(dictionary)
+		stmF := encrypt.name("/StmF")
+		filter, _ := cf.get(stmF).(dictionary)
+		lists = []interface{}{filter.get("/Recipients")}
+		for _, entry := range cf {
+			if f, ok := entry.value.(dictionary); ok && entry.key != stmF {
+				lists = append(lists, f.get("/Recipients"))
+			}
+		}
+		if filter.get("/EncryptMetadata") == boolean(false) {
+			s.encryptMetadata = false
+		}
+	}
+
+	// an envelope that can't be opened may be meant for another key with the same issuer
+	// and serial number, so an error is only returned if none opens
+	var seed []byte
+	var envelopes [][]byte
+	var firstErr error
+	count := 0
+	for _, recipients := range lists {
+		envelopes = recipientEnvelopes(recipients)
+		count += len(envelopes)
+		for i := 0; i < len(envelopes) && seed == nil; i++ {
+			content, err := openEnvelope(envelopes[i], cert, decrypter)
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			seed = content
+		}
+		if seed != nil {
+			break
+		}
+	}
+	switch {
+	case count == 0:
+		return nil, errors.New("invalid encryption dictionary. Recipients not found")
+	case seed == nil && firstErr != nil:
+		return nil, firstErr
+	case seed == nil:
+		return nil, ErrWrongCertificate
+	}
+	if len(seed) < 24 {
+		return nil, errors.New("invalid recipient envelope")
+	}
+	s.p = int32(uint32(seed[20])<<24 | uint32(seed[21])<<16 | uint32(seed[22])<<8 | uint32(seed[23]))
+	s.r = 3 // permission flags follow the revision 3 meanings
+
+	h := sha1.New()
+	if keyLength == 32 {
+		h = sha256.New()
+	}
+	h.Write(seed[:20])
+	for i := range envelopes {
+		h.Write(envelopes[i])
+	}
+	if !s.encryptMetadata {
+		h.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	}
+	s.key = h.Sum(nil)
+	if keyLength < len(s.key) {
+		s.key = s.key[:keyLength]
+	}
+	return s, nil
+}
+
+// recipientEnvelopes returns the PKCS#7 envelopes of a /Recipients entry
+func recipientEnvelopes(recipients interface{}) [][]byte {
+	var envelopes [][]byte
+	switch v := recipients.(type) {
+	case array:
+		for i := range v {
+			envelopes = append(envelopes, stringBytes(v[i]))
+		}
+	case nil:
+	default:
+		envelopes = append(envelopes, stringBytes(v))
+	}
+	return envelopes
+}
+
+// openEnvelope decrypts the content of a PKCS#7 enveloped data object. It returns nil
+// if the certificate isn't one of the envelope's recipients, or the first error if none of
+// the recipient infos for it can be opened.
+func openEnvelope(envelope []byte, cert *x509.Certificate, key crypto.Decrypter) ([]byte, error) {
+	var ci pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(envelope, &ci); err != nil {
+		return nil, err
+	}
+	if !ci.ContentType.Equal(oidEnvelopedData) {
+		return nil, fmt.Errorf("unsupported recipient content type %v", ci.ContentType)
+	}
+	var env pkcs7EnvelopedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &env); err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+	for _, ri := range env.RecipientInfos {
+		if !bytes.Equal(ri.IssuerAndSerialNumber.Issuer.FullBytes, cert.RawIssuer) ||
+			ri.IssuerAndSerialNumber.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+			continue
+		}
+		contentKey, err := key.Decrypt(nil, ri.EncryptedKey, nil)
+		if err == nil {
+			var content []byte
+			if content, err = decryptEnvelopeContent(env.EncryptedContentInfo, contentKey); err == nil {
+				return content, nil
+			}
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+func decryptEnvelopeContent(eci pkcs7EncryptedContentInfo, key []byte) ([]byte, error) {
+	data := eci.EncryptedContent.Bytes
+	if eci.EncryptedContent.IsCompound { // constructed octet string, so join the parts
+		var buf bytes.Buffer
+		for rest := data; len(rest) > 0; {
+			var part []byte
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &part); err != nil {
+				return nil, err
+			}
+			buf.Write(part)
+		}
+		data = buf.Bytes()
+	}
+
+	alg := eci.ContentEncryptionAlgorithm
+	if alg.Algorithm.Equal(oidRC4) {
+		c, err := rc4.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]byte, len(data))
+		c.XORKeyStream(out, data)
+		return out, nil
+	}
+
+	var block cipher.Block
+	var err error
+	switch {
+	case alg.Algorithm.Equal(oidDESEDE3CBC):
+		block, err = des.NewTripleDESCipher(key)
+	case alg.Algorithm.Equal(oidAES128CBC), alg.Algorithm.Equal(oidAES192CBC), alg.Algorithm.Equal(oidAES256CBC):
+		block, err = aes.NewCipher(key)
+	default:
+		return nil, fmt.Errorf("unsupported recipient encryption algorithm %v", alg.Algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(alg.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("invalid recipient envelope")
+	}
+	out := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+	if pad := int(out[len(out)-1]); pad > 0 && pad <= block.BlockSize() {
+		out = out[:len(out)-pad]
+	}
+	return out, nil
+}
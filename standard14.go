@@ -0,0 +1,160 @@
+package pdf2txt
+
+import "strings"
+
+// standardFont is one of the 14 fonts every PDF reader provides, which documents may use
+// without embedding them (section 9.6.2.2). Their built-in encodings are what decode text
+// in fonts that don't have an /Encoding of their own.
+type standardFont struct {
+	encoding   *encoding
+	widths     map[rune]int // glyph widths in thousandths of a unit of text space
+	codeWidths *[256]int    // the widths of a symbolic font by code in its built-in encoding
+	fixedWidth int          // the width of every glyph of a monospaced font
+}
+
+// width returns the width of the glyph a code shows, whose text is r, or 0 if the font's
+// metrics don't include it
+func (f *standardFont) width(code byte, r rune) int {
+	switch {
+	case f.fixedWidth != 0:
+		return f.fixedWidth
+	case f.codeWidths != nil:
+		return f.codeWidths[code]
+	}
+	return f.widths[r]
+}
+
+// lookupStandardFont returns the standard font a /BaseFont names, or nil if it isn't one.
+// The six letter tag of a font subset is ignored, as are the names Windows uses for
+// the TrueType fonts that match Helvetica, Times and Courier.
+func lookupStandardFont(baseFont name) *standardFont {
+	if i := strings.IndexByte(string(baseFont), '+'); i == 7 {
+		baseFont = "/" + baseFont[8:]
+	}
+	if alias, ok := standardFontAliases[baseFont]; ok {
+		baseFont = alias
+	}
+	return standardFonts[baseFont]
+}
+
+var (
+	courier         = &standardFont{encoding: standardEncoding, fixedWidth: 600}
+	helvetica       = &standardFont{encoding: standardEncoding, widths: helveticaWidths}
+	helveticaBold   = &standardFont{encoding: standardEncoding, widths: helveticaBoldWidths}
+	timesRoman      = &standardFont{encoding: standardEncoding, widths: timesRomanWidths}
+	timesBold       = &standardFont{encoding: standardEncoding, widths: timesBoldWidths}
+	timesItalic     = &standardFont{encoding: standardEncoding, widths: timesItalicWidths}
+	timesBoldItalic = &standardFont{encoding: standardEncoding, widths: timesBoldItalicWidths}
+	symbol          = &standardFont{encoding: symbolEncoding, codeWidths: symbolWidths}
+	zapfDingbats    = &standardFont{encoding: zapfDingbatsEncoding, codeWidths: zapfDingbatsWidths}
+)
+
+// standardFonts are the standard 14 fonts by name. The oblique and italic styles of
+// Helvetica and Courier have the same metrics as the upright ones.
+var standardFonts = map[name]*standardFont{
+	"/Courier":               courier,
+	"/Courier-Bold":          courier,
+	"/Courier-Oblique":       courier,
+	"/Courier-BoldOblique":   courier,
+	"/Helvetica":             helvetica,
+	"/Helvetica-Bold":        helveticaBold,
+	"/Helvetica-Oblique":     helvetica,
+	"/Helvetica-BoldOblique": helveticaBold,
+	"/Times-Roman":           timesRoman,
+	"/Times-Bold":            timesBold,
+	"/Times-Italic":          timesItalic,
+	"/Times-BoldItalic":      timesBoldItalic,
+	"/Symbol":                symbol,
+	"/ZapfDingbats":          zapfDingbats,
+}
+
+// standardFontAliases are the other names of the standard fonts (section 9.6.2.2 and the
+// PostScript names of the matching TrueType fonts)
+var standardFontAliases = map[name]name{
+	"/Arial":                        "/Helvetica",
+	"/Arial,Bold":                   "/Helvetica-Bold",
+	"/Arial,Italic":                 "/Helvetica-Oblique",
+	"/Arial,BoldItalic":             "/Helvetica-BoldOblique",
+	"/ArialMT":                      "/Helvetica",
+	"/Arial-BoldMT":                 "/Helvetica-Bold",
+	"/Arial-ItalicMT":               "/Helvetica-Oblique",
+	"/Arial-BoldItalicMT":           "/Helvetica-BoldOblique",
+	"/TimesNewRoman":                "/Times-Roman",
+	"/TimesNewRoman,Bold":           "/Times-Bold",
+	"/TimesNewRoman,Italic":         "/Times-Italic",
+	"/TimesNewRoman,BoldItalic":     "/Times-BoldItalic",
+	"/TimesNewRomanPSMT":            "/Times-Roman",
+	"/TimesNewRomanPS-BoldMT":       "/Times-Bold",
+	"/TimesNewRomanPS-ItalicMT":     "/Times-Italic",
+	"/TimesNewRomanPS-BoldItalicMT": "/Times-BoldItalic",
+	"/CourierNew":                   "/Courier",
+	"/CourierNew,Bold":              "/Courier-Bold",
+	"/CourierNew,Italic":            "/Courier-Oblique",
+	"/CourierNew,BoldItalic":        "/Courier-BoldOblique",
+	"/CourierNewPSMT":               "/Courier",
+	"/CourierNewPS-BoldMT":          "/Courier-Bold",
+	"/CourierNewPS-ItalicMT":        "/Courier-Oblique",
+	"/CourierNewPS-BoldItalicMT":    "/Courier-BoldOblique",
+	"/Symbol,Bold":                  "/Symbol",
+	"/Symbol,Italic":                "/Symbol",
+	"/Symbol,BoldItalic":            "/Symbol",
+}
+
+// symbolEncoding is the built-in encoding of the Symbol font. The pieces that build up
+// large brackets, braces and integrals map to the Unicode characters for them, and the
+// serif and sans serif variants of the registered, copyright and trademark signs map to
+// the plain signs.
+var symbolEncoding = &encoding{
+	0x20: ' ', 0x21: '!', 0x22: '∀', 0x23: '#', 0x24: '∃', 0x25: '%', 0x26: '&', 0x27: '∋',
+	0x28: '(', 0x29: ')', 0x2a: '∗', 0x2b: '+', 0x2c: ',', 0x2d: '−', 0x2e: '.', 0x2f: '/',
+	0x30: '0', 0x31: '1', 0x32: '2', 0x33: '3', 0x34: '4', 0x35: '5', 0x36: '6', 0x37: '7',
+	0x38: '8', 0x39: '9', 0x3a: ':', 0x3b: ';', 0x3c: '<', 0x3d: '=', 0x3e: '>', 0x3f: '?',
+	0x40: '≅', 0x41: 'Α', 0x42: 'Β', 0x43: 'Χ', 0x44: 'Δ', 0x45: 'Ε', 0x46: 'Φ', 0x47: 'Γ',
+	0x48: 'Η', 0x49: 'Ι', 0x4a: 'ϑ', 0x4b: 'Κ', 0x4c: 'Λ', 0x4d: 'Μ', 0x4e: 'Ν', 0x4f: 'Ο',
+	0x50: 'Π', 0x51: 'Θ', 0x52: 'Ρ', 0x53: 'Σ', 0x54: 'Τ', 0x55: 'Υ', 0x56: 'ς', 0x57: 'Ω',
+	0x58: 'Ξ', 0x59: 'Ψ', 0x5a: 'Ζ', 0x5b: '[', 0x5c: '∴', 0x5d: ']', 0x5e: '⊥', 0x5f: '_',
+	0x60: '‾', 0x61: 'α', 0x62: 'β', 0x63: 'χ', 0x64: 'δ', 0x65: 'ε', 0x66: 'φ', 0x67: 'γ',
+	0x68: 'η', 0x69: 'ι', 0x6a: 'ϕ', 0x6b: 'κ', 0x6c: 'λ', 0x6d: 'μ', 0x6e: 'ν', 0x6f: 'ο',
+	0x70: 'π', 0x71: 'θ', 0x72: 'ρ', 0x73: 'σ', 0x74: 'τ', 0x75: 'υ', 0x76: 'ϖ', 0x77: 'ω',
+	0x78: 'ξ', 0x79: 'ψ', 0x7a: 'ζ', 0x7b: '{', 0x7c: '|', 0x7d: '}', 0x7e: '∼',
+	0xa0: '€', 0xa1: 'ϒ', 0xa2: '′', 0xa3: '≤', 0xa4: '⁄', 0xa5: '∞', 0xa6: 'ƒ', 0xa7: '♣',
+	0xa8: '♦', 0xa9: '♥', 0xaa: '♠', 0xab: '↔', 0xac: '←', 0xad: '↑', 0xae: '→', 0xaf: '↓',
+	0xb0: '°', 0xb1: '±', 0xb2: '″', 0xb3: '≥', 0xb4: '×', 0xb5: '∝', 0xb6: '∂', 0xb7: '•',
+	0xb8: '÷', 0xb9: '≠', 0xba: '≡', 0xbb: '≈', 0xbc: '…', 0xbd: '⏐', 0xbe: '⎯', 0xbf: '↵',
+	0xc0: 'ℵ', 0xc1: 'ℑ', 0xc2: 'ℜ', 0xc3: '℘', 0xc4: '⊗', 0xc5: '⊕', 0xc6: '∅', 0xc7: '∩',
+	0xc8: '∪', 0xc9: '⊃', 0xca: '⊇', 0xcb: '⊄', 0xcc: '⊂', 0xcd: '⊆', 0xce: '∈', 0xcf: '∉',
+	0xd0: '∠', 0xd1: '∇', 0xd2: '®', 0xd3: '©', 0xd4: '™', 0xd5: '∏', 0xd6: '√', 0xd7: '⋅',
+	0xd8: '¬', 0xd9: '∧', 0xda: '∨', 0xdb: '⇔', 0xdc: '⇐', 0xdd: '⇑', 0xde: '⇒', 0xdf: '⇓',
+	0xe0: '◊', 0xe1: '〈', 0xe2: '®', 0xe3: '©', 0xe4: '™', 0xe5: '∑', 0xe6: '⎛', 0xe7: '⎜',
+	0xe8: '⎝', 0xe9: '⎡', 0xea: '⎢', 0xeb: '⎣', 0xec: '⎧', 0xed: '⎨', 0xee: '⎩', 0xef: '⎪',
+	0xf1: '〉', 0xf2: '∫', 0xf3: '⌠', 0xf4: '⎮', 0xf5: '⌡', 0xf6: '⎞', 0xf7: '⎟', 0xf8: '⎠',
+	0xf9: '⎤', 0xfa: '⎥', 0xfb: '⎦', 0xfc: '⎫', 0xfd: '⎬', 0xfe: '⎭',
+}
+
+// zapfDingbatsEncoding is the built-in encoding of the ZapfDingbats font. Most of its
+// glyphs are in the same order as the Unicode Dingbats block, which was based on it; the
+// rest were given places in other blocks.
+var zapfDingbatsEncoding = func() *encoding {
+	var e encoding
+	e[' '] = ' '
+	dingbats := func(from, to byte, r rune) {
+		for code := int(from); code <= int(to); code, r = code+1, r+1 {
+			e[code] = r
+		}
+	}
+	dingbats(0x21, 0x7e, '✁')
+	dingbats(0x80, 0x8d, '❨')
+	dingbats(0xa1, 0xa7, '❡')
+	dingbats(0xac, 0xb5, '①')
+	dingbats(0xb6, 0xd4, '❶')
+	dingbats(0xd8, 0xef, '➘')
+	dingbats(0xf1, 0xfe, '➱')
+	for code, r := range map[byte]rune{
+		0x25: '☎', 0x2a: '☛', 0x2b: '☞', 0x48: '★', 0x6c: '●', 0x6e: '■', 0x73: '▲',
+		0x74: '▼', 0x75: '◆', 0x77: '◗', 0xa8: '♣', 0xa9: '♦', 0xaa: '♥', 0xab: '♠',
+		0xd5: '→', 0xd6: '↔', 0xd7: '↕',
+	} {
+		e[code] = r
+	}
+	return &e
+}()
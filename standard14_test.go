@@ -0,0 +1,101 @@
+package pdf2txt
+
+import "testing"
+
+func TestStandardFonts(t *testing.T) {
+	tests := []struct {
+		baseFont name
+		code     byte
+		r        rune
+		width    int
+	}{
+		{"/Helvetica", 'a', 'a', 556},
+		{"/Helvetica-Oblique", 'W', 'W', 944},
+		{"/Helvetica-Bold", '\'', '’', 278},
+		{"/Helvetica", 0xa9, '\'', 191},
+		{"/Helvetica", 0xe9, 'é', 556},
+		{"/Times-Roman", ' ', ' ', 250},
+		{"/Times-Roman", 0x80, '€', 500},
+		{"/Times-Bold", '%', '%', 1000},
+		{"/Times-Italic", 'z', 'z', 389},
+		{"/Times-BoldItalic", '@', '@', 832},
+		{"/Times-BoldItalic", 0, 'Ł', 611},
+		{"/Courier-BoldOblique", 'i', 'i', 600},
+		{"/ABCDEF+Helvetica", 'm', 'm', 833},
+		{"/Arial,Bold", 'b', 'b', 611},
+		{"/TimesNewRomanPSMT", 'e', 'e', 444},
+		{"/CourierNew", 'W', 'W', 600},
+		{"/Symbol", 'a', 'α', 631},
+		{"/Symbol", 0xd4, '™', 890},
+		{"/Symbol", 0xe4, '™', 786},
+		{"/ZapfDingbats", 0x34, '✔', 846},
+		{"/Helvetica", 0, '✔', 0},
+	}
+	for _, test := range tests {
+		f := lookupStandardFont(test.baseFont)
+		if f == nil {
+			t.Errorf("%s: expected a standard font", test.baseFont)
+			continue
+		}
+		if w := f.width(test.code, test.r); w != test.width {
+			t.Errorf("%s: expected %q to be %d wide, got %d", test.baseFont, test.r, test.width, w)
+		}
+	}
+	for _, baseFont := range []name{"/Verdana", "/ABC+Helvetica", "\x00"} {
+		if lookupStandardFont(baseFont) != nil {
+			t.Errorf("%q: expected no standard font", baseFont)
+		}
+	}
+}
+
+func TestBuiltinEncodings(t *testing.T) {
+	tests := []struct {
+		enc      *encoding
+		code     byte
+		expected rune
+	}{
+		{symbolEncoding, 'a', 'α'},
+		{symbolEncoding, 'W', 'Ω'},
+		{symbolEncoding, 0x22, '∀'},
+		{symbolEncoding, 0x2d, '−'},
+		{symbolEncoding, 0xa3, '≤'},
+		{symbolEncoding, 0xe5, '∑'},
+		{symbolEncoding, 0xf0, 0},
+		{symbolEncoding, 0xfe, '⎭'},
+		{zapfDingbatsEncoding, 0x21, '✁'},
+		{zapfDingbatsEncoding, 0x25, '☎'},
+		{zapfDingbatsEncoding, 0x33, '✓'},
+		{zapfDingbatsEncoding, 0x34, '✔'},
+		{zapfDingbatsEncoding, 0x48, '★'},
+		{zapfDingbatsEncoding, 0x6e, '■'},
+		{zapfDingbatsEncoding, 0x7e, '❞'},
+		{zapfDingbatsEncoding, 0x80, '❨'},
+		{zapfDingbatsEncoding, 0x8e, 0},
+		{zapfDingbatsEncoding, 0xa8, '♣'},
+		{zapfDingbatsEncoding, 0xac, '①'},
+		{zapfDingbatsEncoding, 0xd4, '➔'},
+		{zapfDingbatsEncoding, 0xd5, '→'},
+		{zapfDingbatsEncoding, 0xd8, '➘'},
+		{zapfDingbatsEncoding, 0xf0, 0},
+		{zapfDingbatsEncoding, 0xfe, '➾'},
+	}
+	for _, test := range tests {
+		if actual := test.enc[test.code]; actual != test.expected {
+			t.Errorf("code %#x: expected %q, got %q", test.code, test.expected, actual)
+		}
+	}
+}
+
+func TestStandardFontText(t *testing.T) {
+	pdf := singlePagePDF("BT /F1 12 Tf (a+b) Tj /F2 12 Tf (34n) Tj /F3 12 Tf (it's) Tj /F4 12 Tf (ab) Tj /F5 12 Tf (ab) Tj /F6 12 Tf (AB) Tj ET",
+		"/F1 5 0 R /F2 6 0 R /F3 7 0 R /F4 8 0 R /F5 9 0 R /F6 10 0 R",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Symbol>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /ZapfDingbats>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Times-Roman>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Symbol /Encoding <</Differences [98 /gamma]>>>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Symbol /Encoding /WinAnsiEncoding>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /ZapfDingbats /Encoding <</Differences [65 /a71 /a20]>>>>")
+	if text := pageText(t, pdf); text != "α+β✓✔■it’sαγab●✔\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
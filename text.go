@@ -2,11 +2,14 @@ package pdf2txt
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/EndFirstCorp/peekingReader"
 )
@@ -16,14 +19,24 @@ type document struct {
 	pagesList     map[string]*pages
 	pageList      map[string]*page
 	fonts         map[string]*font
-	cmaps         map[string]cmap
-	contents      map[string][]textsection
+	descriptors   map[string]*fontDescriptor
+	decoders      map[decoderKey]*fontDecoder
+	glyphTexts    map[glyphKey]string // the text shown by the Type3 glyph procedures read so far
+	reading       map[glyphKey]bool   // the Type3 glyph procedures being read
+	cmaps         map[string]*cmap
+	contents      map[string][]byte
 	uncategorized map[string]*object
 	objectstreams map[string]*object
 	trailer       *trailer
+	security      *securityHandler
 	decodeError   error
 }
 
+type inlineImage struct {
+	dict dictionary
+	data []byte
+}
+
 type catalog struct {
 	Pages string
 }
@@ -40,15 +53,59 @@ type page struct {
 }
 
 type font struct {
-	Encoding  name
-	ToUnicode string
+	Subtype         name
+	BaseFont        name
+	Encoding        interface{} // base encoding name, encoding dictionary or reference to one, or the CMap of a Type0 font
+	ToUnicode       string
+	DescendantFonts array       // the CIDFont of a Type0 font
+	CIDSystemInfo   interface{} // the character collection of a CIDFont
+	CIDToGIDMap     interface{} // /Identity or a reference to the stream mapping a CIDFontType2's CIDs to glyphs
+	FontDescriptor  string
+	FontMatrix      array // the glyph space of a Type3 font
+	FirstChar       int
+	Widths          interface{} // the glyph widths from FirstChar on, or a reference to them
+	CharProcs       interface{} // the glyph procedures of a Type3 font by glyph name
+	Resources       interface{} // the resources its glyph procedures use
+}
+
+// symbolicFont is the flag of a font descriptor for fonts with glyphs outside the standard
+// Latin character set, whose codes select glyphs through the font's own encoding
+const symbolicFont = 1 << 2
+
+type fontDescriptor struct {
+	Flags     int
+	FontFile  string // an embedded Type1 font
+	FontFile2 string // an embedded TrueType font
+	FontFile3 string // an embedded font program in CFF or OpenType form
+}
+
+// Options configures how text is extracted from a PDF file
+type Options struct {
+	// Password opens an encrypted PDF file. It is tried as both the user and the owner password.
+	Password string
+
+	// Certificate and PrivateKey open a PDF file encrypted for certificate recipients
+	// (Adobe.PubSec). The private key must implement crypto.Decrypter, as *rsa.PrivateKey does.
+	Certificate *x509.Certificate
+	PrivateKey  crypto.PrivateKey
+
+	// EnforcePermissions refuses to extract text with ErrExtractionNotAllowed when an encrypted
+	// PDF file doesn't allow copying its content, unless it was opened with the owner password.
+	EnforcePermissions bool
 }
 
 // Text extracts text from an io.Reader stream of a PDF file
 // and outputs it into a new io.Reader filled with the text
 // contained in the PDF file.
 func Text(r io.Reader) (io.Reader, error) {
-	d, err := parse(r)
+	return TextWithOptions(r, Options{})
+}
+
+// TextWithOptions extracts text like Text does, but uses the supplied options. Encrypted
+// PDF files return ErrPasswordRequired if they can't be opened without a password and
+// ErrWrongPassword if the password doesn't match.
+func TextWithOptions(r io.Reader, opts Options) (io.Reader, error) {
+	d, err := parse(r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -61,15 +118,23 @@ func Text(r io.Reader) (io.Reader, error) {
 	return d.getText()
 }
 
-func parse(r io.Reader) (*document, error) {
-	doc := &document{catalogs: make(map[string]*catalog), pagesList: make(map[string]*pages), pageList: make(map[string]*page),
-		fonts: make(map[string]*font), cmaps: make(map[string]cmap), contents: make(map[string][]textsection),
-		objectstreams: make(map[string]*object), uncategorized: make(map[string]*object), trailer: &trailer{}}
+func parse(r io.Reader, opts Options) (*document, error) {
+	doc := newDocument()
+	items, err := doc.readItems(r)
+	if err != nil {
+		return nil, err
+	}
 
-	tchan := make(chan interface{}, 100)
-	go tokenize(peekingReader.NewBufReader(r), tchan)
+	if doc.trailer.encryptRef != "" {
+		if err := doc.setSecurityHandler(items, opts); err != nil {
+			return nil, err
+		}
+		if opts.EnforcePermissions && !doc.security.isOwner && !doc.security.permissions().Copy {
+			return nil, ErrExtractionNotAllowed
+		}
+	}
 
-	for t := range tchan {
+	for _, t := range items {
 		if err := parseItem(t, doc); err != nil {
 			return nil, err
 		}
@@ -77,21 +142,83 @@ func parse(r io.Reader) (*document, error) {
 	return doc, nil
 }
 
+func newDocument() *document {
+	return &document{catalogs: make(map[string]*catalog), pagesList: make(map[string]*pages), pageList: make(map[string]*page),
+		fonts: make(map[string]*font), descriptors: make(map[string]*fontDescriptor), decoders: make(map[decoderKey]*fontDecoder), glyphTexts: make(map[glyphKey]string), reading: make(map[glyphKey]bool), cmaps: make(map[string]*cmap), contents: make(map[string][]byte),
+		objectstreams: make(map[string]*object), uncategorized: make(map[string]*object), trailer: &trailer{}}
+}
+
+// readItems tokenizes the whole file. The trailer is usually at the end of the file, but it
+// is needed to find out whether the file is encrypted before any streams can be decoded.
+func (d *document) readItems(r io.Reader) ([]interface{}, error) {
+	var items []interface{}
+	var err error
+	tokenizeEach(peekingReader.NewBufReader(r), func(t interface{}) {
+		switch v := t.(type) {
+		case error:
+			err = v
+		case *trailer:
+			d.trailer.merge(v)
+		default:
+			items = append(items, t)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (t *trailer) merge(v *trailer) {
+	if v.rootRef != "" {
+		t.rootRef = v.rootRef
+	}
+	if v.decodeParms != nil {
+		t.decodeParms = v.decodeParms
+	}
+	if v.encryptRef != "" {
+		t.encryptRef = v.encryptRef
+	}
+	if v.id != nil {
+		t.id = v.id
+	}
+}
+
+func (d *document) encryptionDictionary(items []interface{}) (*object, error) {
+	for i := range items {
+		if o, ok := items[i].(*object); ok && o.refString == d.trailer.encryptRef {
+			return o, nil
+		}
+	}
+	return nil, errors.New("unable to find encryption dictionary")
+}
+
+func (d *document) setSecurityHandler(items []interface{}, opts Options) error {
+	encrypt, err := d.encryptionDictionary(items)
+	if err != nil {
+		return err
+	}
+	switch filter := encrypt.name("/Filter"); filter {
+	case "/Standard":
+		d.security, err = newStandardSecurityHandler(encrypt, d.trailer.id, opts.Password)
+	case "/Adobe.PubSec":
+		d.security, err = newPublicKeySecurityHandler(encrypt, opts.Certificate, opts.PrivateKey)
+	default:
+		err = fmt.Errorf("%w: unsupported security handler %s", ErrEncrypted, filter)
+	}
+	return err
+}
+
 func parseItem(item interface{}, doc *document) error {
 	switch v := item.(type) {
 	case error:
 		return v
-	case *trailer:
-		if v.rootRef != "" {
-			doc.trailer.rootRef = v.rootRef
-		}
-		if v.decodeParms != nil {
-			doc.trailer.decodeParms = v.decodeParms
-		}
-		if v.encryptRef != "" {
-			doc.trailer.encryptRef = v.encryptRef
-		}
 	case *object:
+		if doc.security != nil && v.refString != doc.trailer.encryptRef {
+			if err := doc.security.decryptObject(v); err != nil {
+				return newError(PhaseDecoding, v.refString, -1, err)
+			}
+		}
 		oType := v.name("/Type")
 		switch oType {
 		case "/Catalog":
@@ -121,9 +248,14 @@ func parseItem(item interface{}, doc *document) error {
 			if doc.decodeError != nil {
 				return nil
 			}
-			if err := handleToUnicode(f, doc.cmaps, doc.uncategorized); err != nil {
+			if err := handleCmap(f.ToUnicode, doc.cmaps, doc.uncategorized); err != nil {
 				doc.decodeError = err
 			}
+			if r, ok := f.Encoding.(*objectref); ok && f.Subtype == "/Type0" { // an embedded CMap
+				if err := handleCmap(r.refString, doc.cmaps, doc.uncategorized); err != nil {
+					doc.decodeError = err
+				}
+			}
 
 		case "/ObjStm":
 			if doc.decodeError != nil {
@@ -144,8 +276,10 @@ func parseItem(item interface{}, doc *document) error {
 				parseItem(objs[i], doc)
 			}
 
+		case "/FontDescriptor":
+			doc.descriptors[v.refString] = v.getFontDescriptor()
+
 		case "/XObject": // we don't need
-		case "/FontDescriptor": // we don't need
 		default:
 			// something has already referenced this as content so save as content
 			if _, ok := doc.contents[v.refString]; ok && doc.decodeError == nil {
@@ -157,6 +291,8 @@ func parseItem(item interface{}, doc *document) error {
 			} else if _, ok := doc.cmaps[v.refString]; ok && doc.decodeError == nil {
 				if err := v.saveCmap(doc.cmaps); err != nil {
 					doc.decodeError = err
+				} else if err := handleCmap(doc.cmaps[v.refString].useRef, doc.cmaps, doc.uncategorized); err != nil {
+					doc.decodeError = err
 				}
 			} else {
 				doc.uncategorized[v.refString] = v
@@ -172,22 +308,31 @@ func (d *document) getText() (io.Reader, error) {
 	var buf bytes.Buffer
 	catalog, ok := d.catalogs[d.trailer.rootRef]
 	if !ok {
-		return nil, errors.New("unable to find catalog")
+		return nil, ErrNoCatalog
 	}
 
-	for _, page := range d.getPages(catalog.Pages) { // get page objects
-		buf.WriteString(d.getPageText(page))
+	for _, page := range d.getPages(catalog.Pages, make(map[string]bool)) { // get page objects
+		text, err := d.getPageText(page)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(text)
 		buf.WriteString("\n")
 	}
 	return &buf, nil
 }
 
-// Loop through pages and page nodes to get all the pages
-func (d *document) getPages(refString string) []*page {
+// Loop through pages and page nodes to get all the pages. Nodes that were already
+// visited are skipped so a page tree with a cycle doesn't recurse forever.
+func (d *document) getPages(refString string, visited map[string]bool) []*page {
+	if visited[refString] {
+		return nil
+	}
+	visited[refString] = true
 	if node, ok := d.pagesList[refString]; ok { // this is a pages node so loop through kids
 		var pages []*page
 		for i := range node.Kids {
-			pages = append(pages, d.getPages(node.Kids[i])...)
+			pages = append(pages, d.getPages(node.Kids[i], visited)...)
 		}
 		return pages
 	} else if node, ok := d.pageList[refString]; ok { // this is a page node so return page
@@ -196,44 +341,535 @@ func (d *document) getPages(refString string) []*page {
 	return []*page{}
 }
 
-func (d *document) getPageText(p *page) string {
+func (d *document) getPageText(p *page) (string, error) {
 	var buf bytes.Buffer
-	if p == nil || p.Contents == nil {
-		fmt.Println("nil p")
-	}
-	for _, cref := range p.Contents { // get content
-		c := d.contents[cref]
-		for sIndex := range c { // get text sections
-			section := c[sIndex]
-			for ai := range section.textArray {
-				item := section.textArray[ai]
-				switch t := item.(type) {
-				case hexdata:
-					font := d.fonts[p.Fonts[section.fontName]]
-					var cmap map[hexdata]string
-					if font != nil && font.ToUnicode != "" && d.cmaps[font.ToUnicode] != nil {
-						cmap = d.cmaps[font.ToUnicode]
-					}
-					for ci := 0; ci+2 <= len(t); ci += 2 {
-						if cmap != nil {
-							buf.WriteString(cmap[t[ci:ci+2]])
-						} else {
-							c, _ := strconv.ParseInt(string(t[ci:ci+2]), 16, 16)
-							buf.WriteString(fmt.Sprintf("%c", c))
-						}
-					}
-				case string:
-					buf.WriteString(t)
+	if p == nil {
+		return "", nil
+	}
+	c, err := d.pageTextSections(p)
+	if err != nil {
+		return "", err
+	}
+	st := newTextState()
+	for sIndex := range c { // get text sections
+		section := c[sIndex]
+		st.apply(section)
+		for ai := range section.textArray {
+			item := section.textArray[ai]
+			switch t := item.(type) {
+			case hexdata, text:
+				if st.space && buf.Len() > 0 && !isWhitespace(buf.Bytes()[buf.Len()-1]) {
+					buf.WriteByte(' ')
+				}
+				st.space = false
+				dec := d.fontDecoder(p, section.fontName)
+				dec.decode(&buf, stringBytes(t))
+				st.show(dec, stringBytes(t))
+			case integer, real:
+				n, _ := number(t)
+				st.adjust(n)
+			case string:
+				buf.WriteString(t)
+			}
+		}
+	}
+	return buf.String(), nil
+}
+
+// pageTextSections tokenizes the page's content streams as a single stream, since an
+// operator or even a text object can be split across them (section 7.8.2). Errors are
+// located in the content stream they happened in.
+func (d *document) pageTextSections(p *page) ([]textsection, error) {
+	var data []byte
+	var starts []int64
+	var refs []string
+	for _, cref := range p.Contents {
+		c, ok := d.contents[cref]
+		if !ok || c == nil {
+			continue
+		}
+		starts = append(starts, int64(len(data)))
+		refs = append(refs, cref)
+		data = append(append(data, c...), '\n') // whitespace between parts
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	sections, err := getTextSections(data)
+	if err != nil {
+		var e *Error
+		errors.As(err, &e) // located in data
+		offset, err := e.Offset, e.Err
+		i := sort.Search(len(starts), func(i int) bool { return starts[i] > offset }) - 1
+		return nil, newError(PhaseContent, refs[i], offset-starts[i], err)
+	}
+	return sections, nil
+}
+
+// fontDecoder turns the strings shown with a font into text. The codes of simple fonts are
+// single bytes, while those of Type0 fonts are split up by the codespace ranges of their
+// CMap (section 9.7.6.2). Codes are looked up in the ToUnicode cmap if there is one, then
+// in the predefined Unicode CMap a Type0 font uses. Otherwise the CID of a code is looked
+// up in the CID-to-Unicode table of its character collection, and then in the glyphs of
+// the embedded font.
+type fontDecoder struct {
+	codespace  codespace // nil for simple fonts
+	toUnicode  *cmap
+	predefined *predefinedCMap
+	collection *collection // the CID-to-Unicode table of a Type0 font's CIDs
+	encoding   *textEncoding
+	cids       *cmap      // the embedded CMap of a Type0 font, which maps codes to CIDs
+	identity   bool       // whether a Type0 font's CIDs are its codes, as with Identity-H
+	glyphs     *cidGlyphs // the embedded font of a Type0 font's CIDFont
+	type3      *type3Font
+	charProcs  func(code byte) (string, bool) // the text a Type3 font's glyph procedure shows for a code
+	firstChar  int
+	widths     []float64     // the glyph widths of a simple font from firstChar on
+	standard   *standardFont // the metrics of a standard font, for codes without a width
+}
+
+// decode writes the text for a string. Codes of a Type0 font that neither cmap maps to
+// Unicode are left out.
+func (dec *fontDecoder) decode(buf *bytes.Buffer, s []byte) {
+	for len(s) > 0 {
+		n := 1
+		if dec.codespace != nil {
+			n = dec.codespace.codeLength(s)
+		}
+		var text string
+		if dec.toUnicode != nil {
+			text = dec.toUnicode.lookup(s[:n])
+		}
+		if text == "" && dec.predefined != nil {
+			text = dec.predefined.text(s[:n])
+		}
+		if text == "" && (dec.collection != nil || dec.glyphs != nil) {
+			if cid, ok := dec.cid(s[:n]); ok {
+				text = dec.collection.text(cid)
+				if text == "" && dec.glyphs != nil {
+					text = dec.glyphs.text(cid)
+				}
+			}
+		}
+		if text == "" && dec.codespace == nil && dec.toUnicode == nil {
+			text = dec.simpleText(s[0])
+		}
+		buf.WriteString(text)
+		s = s[n:]
+	}
+}
+
+// simpleText returns the text for a code of a simple font, which is that of its Type3 glyph
+// procedure if it shows any
+func (dec *fontDecoder) simpleText(code byte) string {
+	if dec.charProcs != nil {
+		if text, ok := dec.charProcs(code); ok {
+			return text
+		}
+	}
+	return dec.encoding.decode(code)
+}
+
+// width returns the width of the glyph for a code of a simple font in thousandths of a unit
+// of text space and whether it is known. Codes outside the font's /Widths, as when it has
+// none, take the width a standard font gives their glyph.
+func (dec *fontDecoder) width(code byte) (float64, bool) {
+	if dec.type3 != nil {
+		return dec.type3.width(code)
+	}
+	if dec.codespace != nil {
+		return 0, false
+	}
+	if i := int(code) - dec.firstChar; i >= 0 && i < len(dec.widths) {
+		return dec.widths[i], true
+	}
+	if dec.standard != nil {
+		r, _ := utf8.DecodeRuneInString(dec.encoding.decode(code))
+		if w := dec.standard.width(code, r); w != 0 {
+			return float64(w), true
+		}
+	}
+	return 0, false
+}
+
+// cid returns the CID a Type0 font's CMap maps a code to and whether it is known
+func (dec *fontDecoder) cid(code []byte) (int, bool) {
+	switch {
+	case dec.cids != nil:
+		return dec.cids.cid(code)
+	case dec.predefined != nil:
+		return dec.predefined.cid(code)
+	}
+	return int(codeValue(code)), dec.identity
+}
+
+// maxDecoders is the most font decoders a document makes. Past it fonts show no text.
+const maxDecoders = 1 << 16
+
+// fontDecoder returns the decoder for the named page font. Decoders are made the first time
+// each font is used, once all objects are available.
+func (d *document) fontDecoder(p *page, fontName name) *fontDecoder {
+	ref := p.Fonts[fontName]
+	key := d.decoderKey(ref, p)
+	if dec, ok := d.decoders[key]; ok {
+		return dec
+	}
+	if len(d.decoders) >= maxDecoders {
+		return &fontDecoder{codespace: identityCodespace}
+	}
+	dec := &fontDecoder{}
+	if f := d.fonts[ref]; f != nil {
+		dec.toUnicode = d.cmap(f.ToUnicode)
+		if f.Subtype == "/Type0" {
+			switch e := f.Encoding.(type) {
+			case name:
+				dec.predefined = lookupPredefinedCMap(e)
+				dec.identity = e == "/Identity-H" || e == "/Identity-V"
+				if dec.predefined != nil && dec.predefined.decode == nil {
+					dec.collection = lookupCollection(dec.predefined.collection)
 				}
+			case *objectref:
+				dec.cids = d.cmap(e.refString)
+			}
+			dec.codespace = d.codespace(f, dec.toUnicode)
+			if cidFont := d.descendantFont(f); cidFont != nil {
+				// the collection of the CIDFont comes before that of a predefined CMap, and is
+				// the only one for Identity-H and embedded CMaps
+				if c := newCIDSystemInfo(d.resolve(cidFont.CIDSystemInfo)).collection(); c != nil {
+					dec.collection = c
+				}
+				dec.glyphs = d.cidGlyphs(cidFont)
+			}
+		} else {
+			dec.encoding = d.fontEncoding(f)
+			dec.firstChar, dec.widths = f.FirstChar, numbers(d.resolve(f.Widths))
+			dec.standard = lookupStandardFont(f.BaseFont)
+			if dec.toUnicode == nil && f.Subtype == "/TrueType" {
+				dec.encoding = d.trueTypeEncoding(f, dec.encoding)
+			}
+			if f.Subtype == "/Type3" {
+				dec.type3 = d.type3Font(f, p)
+				if dec.toUnicode == nil {
+					dec.charProcs = d.type3Text(ref, f, dec.type3, dec.encoding)
+				}
+			}
+		}
+	}
+	d.decoders[key] = dec
+	return dec
+}
+
+// decoderKey is what the decoder of a font depends on besides the font: the page a Type3
+// font without resources of its own is used on
+type decoderKey struct {
+	ref  string
+	page *page
+}
+
+func (d *document) decoderKey(ref string, p *page) decoderKey {
+	key := decoderKey{ref: ref}
+	if f := d.fonts[ref]; f != nil && f.Subtype == "/Type3" && d.type3Resources(f) == nil {
+		key.page = p
+	}
+	return key
+}
+
+// fontEncoding returns the encoding of a simple font or nil if it doesn't have one. The
+// built-in encoding of an embedded Type1 font comes before that of a standard font.
+func (d *document) fontEncoding(f *font) *textEncoding {
+	builtin := d.type1Encoding(f)
+	std := lookupStandardFont(f.BaseFont)
+	if builtin == nil && std != nil {
+		builtin = std.encoding
+	}
+	return newTextEncoding(d.resolve(f.Encoding), builtin, std == zapfDingbats)
+}
+
+// type1Encoding returns the built-in encoding of a Type1 font's embedded font program, in
+// Type1 (/FontFile) or CFF (/FontFile3) form, or nil if it doesn't have one that can be read
+func (d *document) type1Encoding(f *font) *encoding {
+	fd := d.descriptors[f.FontDescriptor]
+	if fd == nil || f.Subtype != "/Type1" && f.Subtype != "/MMType1" {
+		return nil
+	}
+	if o := d.uncategorized[fd.FontFile]; o != nil && o.decodeStream() == nil {
+		return readType1Encoding(o.stream)
+	}
+	if c := d.cff(fd.FontFile3); c != nil {
+		return c.encoding
+	}
+	return nil
+}
+
+// trueTypeEncoding adds the Unicode an embedded TrueType font gives its glyphs to the
+// encoding of a symbolic font or of one without an /Encoding. Their codes select glyphs
+// through the font's cmap table rather than by glyph name (section 9.6.6.4). Codes in
+// /Differences keep the text of the glyph names given for them.
+func (d *document) trueTypeEncoding(f *font, enc *textEncoding) *textEncoding {
+	fd := d.descriptors[f.FontDescriptor]
+	if fd == nil || fd.Flags&symbolicFont == 0 && f.Encoding != nil {
+		return enc
+	}
+	tt := d.trueType(fd.FontFile2)
+	if tt == nil {
+		return enc
+	}
+	return mergeDifferences(enc, tt.text)
+}
+
+// mergeDifferences returns an encoding with the text a font gives for the codes that aren't
+// in the differences of enc added to them
+func mergeDifferences(enc *textEncoding, text func(code byte) string) *textEncoding {
+	merged := &textEncoding{differences: make(map[byte]string)}
+	if enc != nil {
+		merged.base = enc.base
+		for code, text := range enc.differences {
+			merged.differences[code] = text
+		}
+	}
+	for code := 0; code < 256; code++ {
+		if _, ok := merged.differences[byte(code)]; !ok {
+			if text := text(byte(code)); text != "" {
+				merged.differences[byte(code)] = text
+			}
+		}
+	}
+	return merged
+}
+
+// type3Font returns the metrics and glyph procedures of a Type3 font. Glyph procedures
+// without resources of their own use the page's (section 9.6.5).
+func (d *document) type3Font(f *font, p *page) *type3Font {
+	widths, _ := d.resolve(f.Widths).(array)
+	t := newType3Font(f.FontMatrix, f.FirstChar, widths)
+	t.charProcs, _ = d.resolve(f.CharProcs).(dictionary)
+	t.page = p
+	if fonts := d.type3Resources(f); fonts != nil {
+		t.page = &page{Fonts: fontRefs(fonts)}
+	}
+	return t
+}
+
+// type3Resources returns the fonts of a Type3 font's own resources, or nil if it has none
+func (d *document) type3Resources(f *font) dictionary {
+	res, _ := d.resolve(f.Resources).(dictionary)
+	fonts, _ := d.resolve(res.get("/Font")).(dictionary)
+	return fonts
+}
+
+// maxCharProcText is the most text, in bytes, a Type3 glyph procedure shows
+const maxCharProcText = 256
+
+// glyphKey is a Type3 glyph procedure with the resources its text is shown with
+type glyphKey struct {
+	page  *page
+	font  string
+	glyph name
+}
+
+// type3Text returns the text the glyph procedure of a Type3 font shows for a code whose
+// glyph name has no known Unicode, and whether it decides the code's text. Each glyph
+// procedure is read the first time its code is used. Glyph procedures that show text with
+// a glyph whose procedure is being read, as when a font uses itself, leave that glyph's
+// text out.
+func (d *document) type3Text(ref string, f *font, t *type3Font, enc *textEncoding) func(code byte) (string, bool) {
+	e, _ := d.resolve(f.Encoding).(dictionary)
+	differences, _ := e.get("/Differences").(array)
+	names := differenceNames(differences)
+	return func(code byte) (string, bool) {
+		n, ok := names[code]
+		if !ok {
+			return "", false
+		}
+		if enc != nil {
+			if _, ok := enc.differences[code]; ok {
+				return "", false
+			}
+		}
+		key := glyphKey{page: t.page, font: ref, glyph: n}
+		text, ok := d.glyphTexts[key]
+		if !ok {
+			if d.reading[key] || len(d.reading) >= maxDepth {
+				return "", true
+			}
+			d.reading[key] = true
+			text = d.charProcText(t, t.charProcs.get(n))
+			delete(d.reading, key)
+			d.glyphTexts[key] = text
+		}
+		return text, text != ""
+	}
+}
+
+// charProcText returns the text a Type3 glyph procedure shows, or "" if it doesn't show
+// any or can't be read
+func (d *document) charProcText(t *type3Font, v interface{}) string {
+	r, ok := v.(*objectref)
+	if !ok {
+		return ""
+	}
+	o := d.uncategorized[r.refString]
+	if o == nil || o.decodeStream() != nil {
+		return ""
+	}
+	sections, err := getTextSections(o.stream)
+	if err != nil {
+		return ""
+	}
+	p := t.page
+	var buf bytes.Buffer
+	for _, section := range sections {
+		if p.Fonts[section.fontName] == "" {
+			continue
+		}
+		for _, item := range section.textArray {
+			switch s := item.(type) {
+			case hexdata, text:
+				d.fontDecoder(p, section.fontName).decode(&buf, stringBytes(s))
+			}
+			if buf.Len() > maxCharProcText {
+				// glyphs that show other glyphs mustn't add up to more than a glyph's worth
+				return strings.ToValidUTF8(buf.String()[:maxCharProcText], "")
 			}
 		}
 	}
 	return buf.String()
 }
 
-func handlePageContents(pItem *page, contents map[string][]textsection, uncategorized map[string]*object) error {
+// trueType reads an embedded TrueType font. Fonts that can't be decoded or read give no
+// Unicode rather than failing the document.
+func (d *document) trueType(ref string) *trueType {
+	o := d.uncategorized[ref]
+	if o == nil || o.decodeStream() != nil {
+		return nil
+	}
+	tt, err := parseTrueType(o.stream)
+	if err != nil {
+		return nil
+	}
+	return tt
+}
+
+// cidGlyphs returns the glyphs of a CIDFont's embedded font, or nil if the font or its
+// CIDToGIDMap can't be read
+func (d *document) cidGlyphs(f *font) *cidGlyphs {
+	fd := d.descriptors[f.FontDescriptor]
+	if fd == nil {
+		return nil
+	}
+	c := &cidGlyphs{}
+	switch f.Subtype {
+	case "/CIDFontType2":
+		c.trueType = d.trueType(fd.FontFile2)
+		if r, ok := f.CIDToGIDMap.(*objectref); ok {
+			o := d.uncategorized[r.refString]
+			if o == nil || o.decodeStream() != nil {
+				return nil
+			}
+			c.cidToGID = append([]byte{}, o.stream...)
+		}
+	case "/CIDFontType0":
+		c.cff = d.cff(fd.FontFile3)
+		if o := d.uncategorized[fd.FontFile3]; o != nil && o.name("/Subtype") == "/OpenType" {
+			c.trueType = d.trueType(fd.FontFile3)
+		}
+	}
+	if c.trueType == nil && c.cff == nil {
+		return nil
+	}
+	return c
+}
+
+// cff reads the CFF font of an embedded font program (/FontFile3), which is either on its
+// own or the CFF table of an OpenType font. Fonts that can't be decoded or read give no
+// Unicode rather than failing the document.
+func (d *document) cff(ref string) *cff {
+	o := d.uncategorized[ref]
+	if o == nil || o.decodeStream() != nil {
+		return nil
+	}
+	data := o.stream
+	if o.name("/Subtype") == "/OpenType" {
+		tables, err := readTables(data)
+		if err != nil {
+			return nil
+		}
+		data = tables["CFF "]
+	}
+	c, err := parseCFF(data)
+	if err != nil {
+		return nil
+	}
+	return c
+}
+
+// codespace returns the codespace ranges of a Type0 font's CMap. CMaps that are neither
+// embedded nor predefined fall back to the ranges of the ToUnicode cmap, and then to two
+// byte codes.
+func (d *document) codespace(f *font, toUnicode *cmap) codespace {
+	switch e := f.Encoding.(type) {
+	case *objectref:
+		if c := d.cmap(e.refString); c != nil && len(c.codespace) > 0 {
+			return c.codespace
+		}
+	case name:
+		if e == "/Identity-H" || e == "/Identity-V" {
+			return identityCodespace
+		}
+		if c := lookupPredefinedCMap(e); c != nil {
+			return c.codespace
+		}
+	}
+	if toUnicode != nil && len(toUnicode.codespace) > 0 {
+		return toUnicode.codespace
+	}
+	return identityCodespace
+}
+
+// cmap returns the cmap saved for a reference, linked to the cmaps it uses, or nil if
+// there isn't one
+func (d *document) cmap(ref string) *cmap {
+	c := d.cmaps[ref]
+	for u, depth := c, 0; u != nil && u.useRef != "" && u.parent == nil && depth < maxDepth; u, depth = u.parent, depth+1 {
+		u.link(d.cmaps[u.useRef])
+	}
+	return c
+}
+
+// descendantFont returns the CIDFont of a Type0 font or nil if it can't be found
+func (d *document) descendantFont(f *font) *font {
+	if len(f.DescendantFonts) == 0 {
+		return nil
+	}
+	switch v := f.DescendantFonts[0].(type) {
+	case *objectref:
+		return d.fonts[v.refString]
+	case dictionary:
+		return (&object{dict: v}).getFont()
+	}
+	return nil
+}
+
+// resolve returns the dictionary or other value a reference points to, or the value itself
+// if it isn't a reference to one
+func (d *document) resolve(v interface{}) interface{} {
+	if r, ok := v.(*objectref); ok {
+		if o := d.uncategorized[r.refString]; o != nil && o.dict != nil {
+			return o.dict
+		} else if o != nil && len(o.values) > 0 { // an array or other value, such as /Widths
+			return o.values[0]
+		}
+	}
+	return v
+}
+
+func handlePageContents(pItem *page, contents map[string][]byte, uncategorized map[string]*object) error {
 	for i := range pItem.Contents {
 		cref := pItem.Contents[i]
+		if _, ok := contents[cref]; ok { // already saved or flagged, as when pages share a content stream
+			continue
+		}
 		// contents already available, so get text
 		if cObj, ok := uncategorized[cref]; ok {
 			if err := cObj.saveContents(contents); err != nil {
@@ -261,180 +897,194 @@ func handlePageParent(pItem *page, pageRef string, pagesList map[string]*pages)
 	}
 }
 
-func handleToUnicode(f *font, cmaps map[string]cmap, uncategorized map[string]*object) error {
-	if f.ToUnicode != "" {
-		// cmap already available, so create
-		if u, ok := uncategorized[f.ToUnicode]; ok {
-			if err := u.saveCmap(cmaps); err != nil {
-				return err
-			}
-			delete(uncategorized, f.ToUnicode)
+// handleCmap saves the cmap for a reference, or flags it for when it's read. The cmaps it
+// uses are handled too.
+func handleCmap(ref string, cmaps map[string]*cmap, uncategorized map[string]*object) error {
+	if _, ok := cmaps[ref]; ok || ref == "" { // already saved or flagged, as when fonts share a cmap
+		return nil
+	}
+	u, ok := uncategorized[ref]
+	if !ok { // haven't seen cmap yet, so just flag for later
+		cmaps[ref] = nil
+		return nil
+	}
+	// cmap already available, so create
+	if err := u.saveCmap(cmaps); err != nil {
+		return err
+	}
+	delete(uncategorized, ref)
+	return handleCmap(cmaps[ref].useRef, cmaps, uncategorized)
+}
 
-			// haven't seen cmap yet, so just flag for later
-		} else {
-			cmaps[f.ToUnicode] = nil
-		}
+// getTextSections reads the text showing operators of a content stream. Errors are
+// located at their offset in data.
+func getTextSections(data []byte) ([]textsection, error) {
+	p := newBytesParser(data)
+	p.content = true
+	defer p.close()
+	sections, err := readTextSections(p)
+	if err != nil {
+		return nil, newError(PhaseContent, "", p.l.Offset(), err)
 	}
-	return nil
+	return sections, nil
 }
 
-func getTextSections(r peekingReader.Reader) ([]textsection, error) {
+func readTextSections(p *parser) ([]textsection, error) {
 	sections := []textsection{}
 	var font name
+	var fontSize float64
 	var prevArray array
 	var prev interface{}
 	var prevName name
+	var operands []float64 // the numbers since the last operator
 
-	for {
-		item := readNext(r)
-
-		switch v := item.(type) {
-		case error:
-			if v == io.EOF {
+	for { // work on tokens, so the many numeric operands are never converted to items
+		t, err := p.next()
+		if err != nil {
+			var u unexpectedByteError
+			if errors.As(err, &u) { // skip closing delimiters that don't close anything
+				continue
+			}
+			if err == io.EOF {
 				return sections, nil
 			}
-			return nil, v
+			return nil, err
+		}
 
-		case token:
-			switch v {
+		switch t.Kind {
+		case TokenKeyword:
+			switch op := string(t.Value); op {
 			case "Tf":
 				font = prevName
+				if len(operands) > 0 {
+					fontSize = operands[len(operands)-1]
+				}
 			case "TJ":
-				sections = append(sections, textsection{fontName: font, textArray: append(prevArray, " ")})
+				sections = append(sections, textsection{fontName: font, fontSize: fontSize, textArray: append(prevArray, " ")})
 			case "T*":
-				sections = append(sections, textsection{fontName: font, textArray: []interface{}{"\n"}})
+				sections = append(sections, textsection{fontName: font, fontSize: fontSize, textArray: []interface{}{"\n"}, operator: op})
 			case "Tj":
-				sections = append(sections, textsection{fontName: font, textArray: []interface{}{prev}})
+				sections = append(sections, textsection{fontName: font, fontSize: fontSize, textArray: []interface{}{prev}})
+			case "BT", "Td", "TD", "Tm", "TL", "Tc", "Tw", "Tz":
+				sections = append(sections, textsection{fontName: font, fontSize: fontSize, operator: op,
+					operands: append([]float64(nil), operands...)})
+			case "BI": // skip inline images so their data isn't read as operators
+				if _, err := readInlineImage(p); err != nil {
+					return nil, err
+				}
 			}
+			operands = operands[:0]
+		case TokenInteger:
+			operands = append(operands, float64(t.Int))
+		case TokenReal:
+			operands = append(operands, t.Real)
 
-		case array:
-			prevArray = v
-		case text, hexdata:
-			prev = v
-		case name:
-			prevName = v
+		case TokenArrayStart:
+			if prevArray, err = p.array(); err != nil {
+				return nil, err
+			}
+		case TokenDictStart: // property lists aren't needed
+			if _, err := p.dictionary(); err != nil {
+				return nil, err
+			}
+		case TokenProcStart:
+			if _, err := p.l.readRaw('}'); err != nil {
+				return nil, err
+			}
+		case TokenString:
+			prev = text(t.Value)
+		case TokenHexString:
+			prev = hexdata(t.Value)
+		case TokenName:
+			prevName = p.name(t.Value)
 		}
 	}
 }
 
-func getCmap(r peekingReader.Reader) (cmap, error) {
-	cmap := make(cmap)
-	var prev token
-
+// readInlineImage reads an inline image from after the BI operator through the EI operator
+// (section 8.9.7). The image data is read using /L or /Length when the dictionary has one.
+// Otherwise the data ends at the first whitespace-delimited EI that is followed by text
+// rather than more binary data.
+func readInlineImage(p *parser) (*inlineImage, error) {
+	img := &inlineImage{}
+	var key name
 	for {
-		item := readNext(r)
-
-		switch v := item.(type) {
-		case error:
-			if v == io.EOF {
-				return cmap, nil
-			}
-			return nil, v
-
-		case token:
-			switch v {
-			case "begincodespacerange":
-				length, _ := strconv.Atoi(string(prev))
-				for i := 0; i < length*2; i++ {
-
-				}
-			case "beginbfchar":
-				bfc, err := readbfchar(r, prev)
-				if err != nil {
-					return nil, err
-				}
-				for key, value := range bfc {
-					cmap[key] = value
-				}
-			case "beginbfrange":
-				bfr, err := readbfrange(r, prev)
-				if err != nil {
-					return nil, err
-				}
-				for key, value := range bfr {
-					cmap[key] = value
-				}
-			case "endcmap":
-				return cmap, nil
-			default:
-				prev = v
-			}
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		if item == token("ID") {
+			break
+		}
+		if n, ok := item.(name); ok && key == "" {
+			key = n
+			continue
+		}
+		if key != "" {
+			img.dict = append(img.dict, dictEntry{key, item})
+			key = ""
 		}
 	}
-}
+	r := p.reader() // the image data is read as is
 
-func readbfchar(r peekingReader.Reader, length token) (cmap, error) {
-	cmap := make(cmap)
-	l, _ := strconv.Atoi(string(length))
-	var lastKey hexdata
-	for i := 0; i < l*2; i++ {
-		item := readNext(r)
-		switch v := item.(type) {
-		case error:
-			return nil, v
+	if _, err := r.ReadByte(); err != nil { // single whitespace after ID
+		return nil, err
+	}
 
-		case hexdata:
-			switch i % 2 {
-			case 0: // first item is the key
-				lastKey = v
-				cmap[v] = ""
-			case 1: // second item is the value
-				num, _ := strconv.ParseInt(string(v), 16, 16)
-				repl := fmt.Sprintf("%c", num)
-				cmap[lastKey] = repl
+	length := img.dict.get("/L")
+	if length == nil {
+		length = img.dict.get("/Length")
+	}
+	if l, ok := length.(integer); ok && l >= 0 {
+		data, err := r.ReadBytes(int(l))
+		if err != nil {
+			return nil, err
+		}
+		img.data = data
+		for { // move past EI
+			item, err := p.item()
+			if err != nil {
+				return nil, err
 			}
+			if item == token("EI") {
+				return img, nil
+			}
+		}
+	}
 
-		default:
-			return nil, errors.New("invalid bfchar data")
+	var data []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b)
+		n := len(data)
+		if n < 2 || data[n-2] != 'E' || data[n-1] != 'I' || (n > 2 && !isWhitespace(data[n-3])) {
+			continue
 		}
+		if next, err := r.Peek(1); err == nil && len(next) == 1 && !isWhitespace(next[0]) && !isDelimiter(next[0]) {
+			continue
+		}
+		if !isTextAhead(r) {
+			continue
+		}
+		if n > 2 {
+			n-- // whitespace before EI isn't part of the data
+		}
+		img.data = data[:n-2]
+		return img, nil
 	}
-	return cmap, nil
 }
 
-func readbfrange(r peekingReader.Reader, length token) (cmap, error) {
-	cmap := make(cmap)
-	l, _ := strconv.Atoi(string(length))
-	var start, end int64
-	var digits int
-
-	for i := 0; i < l*3; i++ {
-		item := readNext(r)
-		switch v := item.(type) {
-		case error:
-			return nil, v
-
-		case hexdata:
-			switch i % 3 {
-			case 0: // range start
-				digits = len(string(v))
-				start, _ = strconv.ParseInt(string(v), 16, 16)
-			case 1: // range end
-				end, _ = strconv.ParseInt(string(v), 16, 16)
-			case 2: // values
-				repl, _ := strconv.ParseInt(string(v), 16, 16)
-				var count int64
-				format := fmt.Sprintf("%%0%dx", digits) // format for however many digits we originally had
-				for j := start; j <= end; j++ {
-					cmap[hexdata(strings.ToUpper(fmt.Sprintf(format, j)))] = fmt.Sprintf("%c", repl+count)
-					count++
-				}
-			}
-
-		case array:
-			if i%3 != 2 {
-				return nil, fmt.Errorf("unexpected array at position %d", i)
-			}
-			format := fmt.Sprintf("%%0%dx", digits) // format for however many digits we originally had
-			count := 0
-			for j := start; j <= end; j++ {
-				repl, _ := strconv.ParseInt(string(v[count].(hexdata)), 16, 16)
-				cmap[hexdata(strings.ToUpper(fmt.Sprintf(format, j)))] = fmt.Sprintf("%c", repl)
-				count++
-			}
-
-		default:
-			return nil, errors.New("invalid bfrange data")
+// isTextAhead checks whether the next bytes in a content stream look like operators and
+// operands instead of binary image data
+func isTextAhead(r *countingReader) bool {
+	next, _ := r.Peek(32)
+	for _, b := range next {
+		if b >= 0x80 || (b < ' ' && !isWhitespace(b)) {
+			return false
 		}
 	}
-	return cmap, nil
+	return true
 }
@@ -2,9 +2,11 @@ package pdf2txt
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/EndFirstCorp/pdflib"
@@ -47,7 +49,7 @@ func TestFindCatalog(t *testing.T) {
 func TestGetTextsection(t *testing.T) {
 	b, _ := ioutil.ReadFile(`testData/contents-v1.4.txt`)
 
-	s, err := getTextSections(peekingReader.NewMemReader(b))
+	s, err := getTextSections(b)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -57,7 +59,7 @@ func TestGetTextsection(t *testing.T) {
 func TestGetText(t *testing.T) {
 	b, _ := ioutil.ReadFile(`testData/132_0.txt`)
 
-	s, err := getTextSections(peekingReader.NewMemReader(b))
+	s, err := getTextSections(b)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,7 +80,7 @@ func TestProfoto(t *testing.T) {
 
 func TestGetTextSections(t *testing.T) {
 	b, _ := ioutil.ReadFile(`testData/textSection.txt`)
-	_, err := getTextSections(peekingReader.NewMemReader(b))
+	_, err := getTextSections(b)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -111,9 +113,141 @@ func TestCh5thru8(t *testing.T) {
 	}
 }
 
+// singlePagePDF builds a PDF file with one page that shows the content using the
+// font resources and extra objects supplied
+func singlePagePDF(content, fonts string, objects ...string) []byte {
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	pdf.WriteString("1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n")
+	pdf.WriteString("2 0 obj <</Type /Pages /Kids [3 0 R] /Count 1>> endobj\n")
+	fmt.Fprintf(&pdf, "3 0 obj <</Type /Page /Parent 2 0 R /Resources <</Font <<%s>>>> /Contents 4 0 R>> endobj\n", fonts)
+	fmt.Fprintf(&pdf, "4 0 obj <</Length %d>> stream\n%s\nendstream endobj\n", len(content), content)
+	for i := range objects {
+		fmt.Fprintf(&pdf, "%d 0 obj %s endobj\n", i+5, objects[i])
+	}
+	pdf.WriteString("trailer <</Root 1 0 R>>\nstartxref\n0\n%%EOF\n")
+	return pdf.Bytes()
+}
+
+func streamObject(data string) string {
+	return fmt.Sprintf("<</Length %d>> stream\n%s\nendstream", len(data), data)
+}
+
+func pageText(t *testing.T, pdf []byte) string {
+	r, err := Text(bytes.NewReader(pdf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(r)
+	return string(b)
+}
+
+func TestLiteralStrings(t *testing.T) {
+	pdf := singlePagePDF(`BT /F1 12 Tf (f\(x\) = \(a\)) Tj [(nested \(\)\(parens\)) (caf\351)] TJ ET`, "/F1 5 0 R", "<</Type /Font /Subtype /Type1>>")
+	if text := pageText(t, pdf); text != "f(x) = (a)nested ()(parens)café \n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestHexStrings(t *testing.T) {
+	toUnicode := "1 begincodespacerange <00> <ff> endcodespacerange\n2 beginbfchar\n<0a> <0048>\n<0b> <0069>\nendbfchar"
+	pdf := singlePagePDF("BT /F1 12 Tf <0A\n0b> Tj <0a0B0> Tj ET", "/F1 5 0 R", "<</Type /Font /ToUnicode 6 0 R>>", streamObject(toUnicode))
+	if text := pageText(t, pdf); text != "HiHi\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestEscapedFontNames(t *testing.T) {
+	toUnicode := "1 beginbfchar\n<01> <0041>\nendbfchar"
+	pdf := singlePagePDF("BT /F#20Bold 12 Tf <01> Tj /F1 12 Tf <01> Tj ET", "/F#31 5 0 R /F#20Bold 5 0 R",
+		"<</Type /Font /ToUnicode 6 0 R>>", streamObject(toUnicode))
+	if text := pageText(t, pdf); text != "AA\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestInlineImages(t *testing.T) {
+	binary := "(\x00\xff[ Tj EI\x90\x91 ]\x00)"
+	content := "BT /F1 12 Tf (before) Tj ET\n" +
+		"BI /W 4 /H 2 /CS /G /BPC 8 ID " + binary + " EI\n" + // EI heuristic
+		"BI /W 4 /H 2 /CS /G /BPC 8 /L 5 ID \xffEI \xff\nEI\n" + // explicit length
+		"BI /W 0 /H 0 ID EI Q\n" + // empty image
+		"BT /F1 12 Tf (after) Tj ET"
+	sections, err := getTextSections([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 4 || sections[1].textArray[0] != text("before") || sections[3].textArray[0] != text("after") { // each after its BT
+		t.Error("expected inline images to be skipped", sections)
+	}
+
+	img, err := readInlineImage(newParser(peekingReader.NewMemReader([]byte("/W 4 /H 2 ID " + binary + " EI Q"))))
+	if err != nil || string(img.data) != binary || img.dict.get("/W") != integer(4) {
+		t.Errorf("expected inline image data %q, got %q %v", binary, img.data, err)
+	}
+	img, err = readInlineImage(newParser(peekingReader.NewMemReader([]byte("/L 5 ID \xffEI \xff\nEI\n"))))
+	if err != nil || string(img.data) != "\xffEI \xff" {
+		t.Errorf("expected inline image data from /L, got %q %v", img.data, err)
+	}
+}
+
+func TestContentsArray(t *testing.T) {
+	// the parts are split between tokens, and an operator's operands may be in the part
+	// before it
+	pdf := "%PDF-1.4\n1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n2 0 obj <</Type /Pages /Kids [3 0 R] /Count 1>> endobj\n" +
+		"4 0 obj " + streamObject("Tj ET") + " endobj\n" + // last part comes before the page
+		"3 0 obj <</Type /Page /Parent 2 0 R /Contents [5 0 R 6 0 R 4 0 R]>> endobj\n" +
+		"5 0 obj " + streamObject("BT (Hello) Tj") + " endobj\n" +
+		"6 0 obj " + streamObject("( World)") + " endobj\n" +
+		"trailer <</Root 1 0 R>>\n"
+	if text := pageText(t, []byte(pdf)); text != "Hello World\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+
+	// errors give the part they're in and the offset in it
+	tests := []struct {
+		part, replacement, ref string
+		offset                 int64
+	}{
+		{"BT (Hello) Tj", "BT stream\rQ", "5 0", 11},
+		{"( World)", "( World) stream\rQ", "6 0", 17},
+		{"Tj ET", "Tj ET stream\rQ", "4 0", 14},
+	}
+	for _, test := range tests {
+		bad := strings.Replace(pdf, streamObject(test.part), streamObject(test.replacement), 1)
+		_, err := Text(strings.NewReader(bad))
+		var e *Error
+		if !errors.As(err, &e) || e.Phase != PhaseContent || e.Ref != test.ref || e.Offset != test.offset {
+			t.Errorf("expected content error in %s at %d, got %v", test.ref, test.offset, err)
+		}
+	}
+}
+
+func TestSharedContents(t *testing.T) {
+	pdf := "%PDF-1.4\n1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n2 0 obj <</Type /Pages /Kids [3 0 R 5 0 R] /Count 2>> endobj\n" +
+		"4 0 obj " + streamObject("BT (Same) Tj ET") + " endobj\n" + // shared stream comes before both pages
+		"3 0 obj <</Type /Page /Parent 2 0 R /Contents 4 0 R>> endobj\n" +
+		"5 0 obj <</Type /Page /Parent 2 0 R /Contents 4 0 R>> endobj\n" +
+		"trailer <</Root 1 0 R>>\n"
+	if text := pageText(t, []byte(pdf)); text != "Same\nSame\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestWordGaps(t *testing.T) {
+	content := "BT /F1 10 Tf 72 700 Td (Hello) Tj 30 0 Td (World) Tj T* (Hel) Tj 15.01 0 Td (lo) Tj [(a) -300 (b) -50 (c)] TJ T*" +
+		" /F2 10 Tf (AB) Tj 15 0 Td (A) Tj 8 0 Td (B) Tj 0 -12 Td (C) Tj T* ET" +
+		" BT /F1 10 Tf 1 0 0 1 200 600 Tm (X) Tj 1 0 0 1 220 600 Tm (Y) Tj 2 Tc 50 Tz 1 0 0 1 230 600 Tm (X) Tj 5 0 Td (Y) Tj ET"
+	pdf := singlePagePDF(content, "/F1 5 0 R /F2 6 0 R", "<</Type /Font /Subtype /Type1 /BaseFont /Helvetica>>",
+		"<</Type /Font /Subtype /TrueType /BaseFont /Arial /FirstChar 65 /LastChar 66 /Widths 7 0 R>>", "[500 1000]")
+	if text := pageText(t, pdf); text != "Hello World\nHelloa bc \nABA BC\nX Y XY\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
 func TestGetObjectStream(t *testing.T) {
 	b, _ := ioutil.ReadFile(`testData/objectstream.txt`)
-	o := &object{dict: dictionary{"/Type": name("/ObjStm"), "/N": token("5"), "/First": token("34")}}
+	o := &object{dict: dictionary{{"/Type", name("/ObjStm")}, {"/N", integer(5)}, {"/First", integer(34)}}}
 	o.stream = b
 	_, err := o.getObjectStream()
 	if err != nil {
@@ -122,14 +256,73 @@ func TestGetObjectStream(t *testing.T) {
 }
 
 func TestGetCmap(t *testing.T) {
-	f, _ := os.Open(`testData/bfrange.txt`)
-	r := peekingReader.NewBufReader(f)
-	_, err := getCmap(r)
+	b, _ := ioutil.ReadFile(`testData/bfrange.txt`)
+	_, err := getCmap(b)
 	if err != nil {
 		t.Error("expected success", err)
 	}
 }
 
+func TestMalformedInput(t *testing.T) {
+	tests := map[string][]byte{
+		"negative object count": []byte("1 0 obj <</Type /ObjStm /N -1 /First 0 /Length 1>> stream\nx\nendstream endobj"),
+		"huge object count":     []byte("1 0 obj <</Type /ObjStm /N 99999999999 /First 0 /Length 1>> stream\nx\nendstream endobj"),
+		"huge stream length":    []byte("1 0 obj <</Length 9999999999999>> stream\nx\nendstream endobj"),
+		"nested arrays":         bytes.Repeat([]byte("["), 100000),
+		"cyclic page tree": []byte("1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n2 0 obj <</Type /Pages /Kids [2 0 R 3 0 R]>> endobj\n" +
+			"3 0 obj <</Type /Page /Parent 3 0 R>> endobj\ntrailer <</Root 1 0 R>>"),
+		"short bfrange array": singlePagePDF("BT /F1 12 Tf <01> Tj ET", "/F1 5 0 R", "<</Type /Font /ToUnicode 6 0 R>>",
+			streamObject("1 beginbfrange\n<01> <05> [<0041> 7]\nendbfrange")),
+		"huge codespacerange count": singlePagePDF("BT /F1 12 Tf <01> Tj ET", "/F1 5 0 R", "<</Type /Font /ToUnicode 6 0 R>>",
+			streamObject("9999999999 begincodespacerange <00> <ff> endcodespacerange")),
+	}
+	for name, pdf := range tests {
+		t.Run(name, func(t *testing.T) {
+			Text(bytes.NewReader(pdf)) // must not panic or hang
+		})
+	}
+
+	_, err := newParser(peekingReader.NewMemReader(bytes.Repeat([]byte("<<"), 1000))).dictionary()
+	if err != errTooDeep {
+		t.Error("expected nesting error", err)
+	}
+}
+
+// maxFuzzText is the largest input FuzzText reads, which keeps each run quick
+const maxFuzzText = 1 << 14
+
+// FuzzText is seeded with small files that cover the font kinds rather than the testData
+// files, which are too big to fuzz quickly
+func FuzzText(f *testing.F) {
+	f.Add(singlePagePDF("BT /F1 12 Tf (Hello) Tj 0 -14 Td [(Wor) -250 (ld)] TJ ET", "/F1 5 0 R", "<</Type /Font /Subtype /Type1 /BaseFont /Helvetica>>"))
+	f.Add(singlePagePDF("BT /F1 12 Tf <01> Tj ET", "/F1 5 0 R", "<</Type /Font /ToUnicode 6 0 R>>", streamObject("1 beginbfchar\n<01> <0041>\nendbfchar")))
+	f.Add(singlePagePDF("BT /F1 12 Tf <0465> Tj ET", "/F1 5 0 R", "<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [6 0 R]>>",
+		"<</Type /Font /Subtype /CIDFontType0 /CIDSystemInfo <</Registry (Adobe) /Ordering (Japan1) /Supplement 6>>>>"))
+	f.Add(singlePagePDF("BT /F1 12 Tf <889f> Tj ET", "/F1 5 0 R", "<</Type /Font /Subtype /Type0 /Encoding /90ms-RKSJ-H>>"))
+	f.Add(type3PDF("BT /F1 12 Tf (ABCD) Tj /F2 12 Tf (A) Tj ET"))
+	f.Add(encryptedPDF("", "owner", -4))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > maxFuzzText {
+			return
+		}
+		Text(bytes.NewReader(data))
+	})
+}
+
+func FuzzGetTextSections(f *testing.F) {
+	addSeeds(f, "*.txt")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		getTextSections(data)
+	})
+}
+
+func FuzzGetCmap(f *testing.F) {
+	addSeeds(f, "*.txt")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		getCmap(data)
+	})
+}
+
 func BenchmarkUnidoc(t *testing.B) {
 	f, err := os.Open(`testData/Kicker.pdf`)
 	if err != nil {
@@ -201,6 +394,12 @@ func BenchmarkEndFirst(t *testing.B) {
 	Text(f)
 }
 
+func BenchmarkText(b *testing.B) {
+	benchmarkPDFs(b, func(data []byte) {
+		Text(bytes.NewReader(data))
+	})
+}
+
 func BenchmarkPdfLib(t *testing.B) {
 	r, err := pdflib.ExtractText(`testData/Kicker.pdf`, nil)
 	if err != nil {
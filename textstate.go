@@ -0,0 +1,116 @@
+package pdf2txt
+
+import "math"
+
+// wordGap is how far, as a fraction of the font size, text has to start from where the text
+// before it on the same line ended to be taken as a new word
+const wordGap = 0.2
+
+// textState follows where text is shown (section 9.4.2), as far as is needed to tell where
+// a word starts that is set apart by moving the text position rather than by a space. The
+// current transformation matrix isn't followed, so positions are in text space as the text
+// matrix places it.
+type textState struct {
+	fontSize    float64
+	charSpacing float64
+	wordSpacing float64
+	scale       float64 // horizontal scaling, 1 for 100%
+	leading     float64
+	lineMatrix  [6]float64 // the text line matrix
+	x           float64    // how far text has moved along the line, in unscaled text space
+	lost        bool       // whether x isn't known, since the width of a glyph wasn't
+	endX, endY  float64    // where the last text shown ended
+	ended       bool       // whether endX and endY are known
+	space       bool       // whether the next text starts a new word
+}
+
+func newTextState() *textState {
+	return &textState{scale: 1, lineMatrix: identityMatrix}
+}
+
+var identityMatrix = [6]float64{1, 0, 0, 1, 0, 0}
+
+// apply carries out the text positioning or text state operator of a section
+func (st *textState) apply(section textsection) {
+	st.fontSize = section.fontSize
+	v := section.operands
+	switch {
+	case section.operator == "BT":
+		st.lineMatrix, st.x, st.lost = identityMatrix, 0, false
+	case section.operator == "T*":
+		st.moveLine(0, -st.leading)
+	case len(v) == 1:
+		switch section.operator {
+		case "Tc":
+			st.charSpacing = v[0]
+		case "Tw":
+			st.wordSpacing = v[0]
+		case "Tz":
+			st.scale = v[0] / 100
+		case "TL":
+			st.leading = v[0]
+		}
+	case len(v) == 2 && section.operator == "Td":
+		st.moveLine(v[0], v[1])
+	case len(v) == 2 && section.operator == "TD":
+		st.leading = -v[1]
+		st.moveLine(v[0], v[1])
+	case len(v) == 6 && section.operator == "Tm":
+		st.setLine([6]float64{v[0], v[1], v[2], v[3], v[4], v[5]})
+	}
+}
+
+// moveLine starts a new line offset from the start of the current one
+func (st *textState) moveLine(tx, ty float64) {
+	m := st.lineMatrix
+	m[4] += tx*m[0] + ty*m[2]
+	m[5] += tx*m[1] + ty*m[3]
+	st.setLine(m)
+}
+
+// setLine starts a new line, which starts a new word when it begins far enough to the right
+// of where the last text ended on the same line. Only lines that aren't rotated or skewed
+// are compared.
+func (st *textState) setLine(m [6]float64) {
+	if st.ended && m[0] > 0 && m[1] == 0 && m[2] == 0 {
+		gap := (m[4] - st.endX) / m[0]
+		if math.Abs(m[5]-st.endY) < st.fontSize*math.Abs(m[3])/2 {
+			st.space = st.space || gap > wordGap*st.fontSize*st.scale
+		}
+	}
+	st.lineMatrix, st.x, st.lost = m, 0, false
+}
+
+// show moves past the glyphs of a string. Once a glyph's width isn't known, neither is
+// where text on the line ends.
+func (st *textState) show(dec *fontDecoder, s []byte) {
+	var tx float64
+	for _, code := range s {
+		w, ok := dec.width(code)
+		if !ok || st.lost {
+			st.lost, st.ended = true, false
+			return
+		}
+		tx += w/1000*st.fontSize + st.charSpacing
+		if code == ' ' {
+			tx += st.wordSpacing
+		}
+	}
+	st.move(tx * st.scale)
+}
+
+// adjust applies a number in a TJ array, which moves the next glyph left by thousandths of
+// the font size. Moving it right by enough starts a new word.
+func (st *textState) adjust(n float64) {
+	tx := -n / 1000 * st.fontSize * st.scale
+	st.space = st.space || tx > wordGap*st.fontSize*st.scale
+	if !st.lost {
+		st.move(tx)
+	}
+}
+
+func (st *textState) move(tx float64) {
+	st.x += tx
+	m := st.lineMatrix
+	st.endX, st.endY, st.ended = m[4]+st.x*m[0], m[5]+st.x*m[1], true
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 
 	"github.com/EndFirstCorp/peekingReader"
@@ -12,18 +13,20 @@ import (
 )
 
 type comment string
-type dictionary map[name]interface{}
+type dictionary []dictEntry
 type stream []byte
-type text []interface{}
+type text string
 type array []interface{}
 type hexdata string
 type name string
 type codestream string
 type token string
+type integer int
+type real float64
+type boolean bool
 type null bool
 type end byte
 type xref map[string]xrefItem
-type cmap map[hexdata]string
 type objectref struct {
 	refString string
 	refType   string
@@ -32,6 +35,7 @@ type trailer struct {
 	rootRef     string
 	decodeParms dictionary
 	encryptRef  string
+	id          array
 }
 type object struct {
 	refString       string
@@ -39,6 +43,11 @@ type object struct {
 	dict            dictionary
 	stream          []byte
 	isStreamDecoded bool
+	isDecrypted     bool
+}
+type dictEntry struct {
+	key   name
+	value interface{}
 }
 type xrefItem struct {
 	byteOffset int
@@ -46,18 +55,16 @@ type xrefItem struct {
 }
 type textsection struct {
 	fontName  name
+	fontSize  float64
 	textArray array
+	operator  string    // the text positioning or text state operator of a section without text
+	operands  []float64 // the operator's numbers
 }
 
-var spaceChars = []byte{'\x00', '\t', '\f', ' ', '\n', '\r'}
-var eolChars = []byte{'\r', '\n'}
-var delimChars = append(spaceChars, '(', ')', '<', '>', '[', ']', '{', '}', '/', '%')
-
-// tokenize reads through the entire PDF document and adds a token
-// to the tChan every time it encounters a token making it possible to process
-// tokens in parallel
+// tokenizeEach reads through the entire PDF document and calls emit with each item it
+// encounters. An error that stops it is emitted last.
 //
-// Types of tokens supported:
+// Types of items supported:
 //   - comment       : from % to end of line (\r or \n)
 //   - dictionary    : from << to >>
 //   - stream        : uses length from dictionary. Data is from stream to endstream
@@ -66,28 +73,36 @@ var delimChars = append(spaceChars, '(', ')', '<', '>', '[', ']', '{', '}', '/',
 //   - hexdata       : from < to >
 //   - name          : from / to space, EOL or other delimiter
 //   - codestream    : from { to }
+//   - integer       : whole number such as 42 or -17
+//   - real          : number with a decimal point such as 612.0, .5 or -.002
+//   - boolean       : true or false
+//   - null          : the null keyword
 //   - token         : any other text delimited by space, EOL or other delimiter
 //   - object        : from "x x obj" to endobj (e.g. 250 0 obj is the 250th object)
 //   - xref          : from xref to however many records are needed
 //   - objectref     : three subsequent tokens "x x R" or "x x obj" (e.g. 250 0 obj)
 //   - textsection   : from BT to ET
 //   - cmap          : from begincmap to endcmap
-func tokenize(r peekingReader.Reader, tChan chan interface{}) {
+func tokenizeEach(r peekingReader.Reader, emit func(item interface{})) {
+	p := newParser(r)
+	defer p.close()
 	var err error
+	var ref string // object being read, for error reporting
 
 Loop:
 	for {
-		item := readNext(r)
+		ref = ""
+		var item interface{}
+		if item, err = p.item(); err != nil {
+			break
+		}
 
 		switch v := item.(type) {
-		case error:
-			err = v
-			break Loop
-
 		case *objectref:
 			if v.refType == "obj" {
+				ref = v.refString
 				var obj *object
-				obj, err = readObject(r, v)
+				obj, err = readObject(p, v)
 				if err != nil {
 					break Loop
 				}
@@ -102,63 +117,64 @@ Loop:
 					if r := obj.objectref("/Root"); r != nil {
 						t.rootRef = r.refString
 					}
-					tChan <- t
+					t.id = obj.array("/ID")
+					emit(t)
 					continue
 				}
-				tChan <- obj
+				emit(obj)
 			}
 
 		case token:
 			switch v {
 			case "xref":
 				var xref xref
-				xref, err = readXref(r)
+				xref, err = readXref(p)
 				if err != nil {
 					break Loop
 				}
-				tChan <- xref
+				emit(xref)
 
 			case "trailer":
 				var pdfTrailer *trailer
-				pdfTrailer, err = readTrailer(r)
+				pdfTrailer, err = readTrailer(p)
 				if err != nil {
 					break Loop
 				}
-				tChan <- pdfTrailer
+				emit(pdfTrailer)
 			default: // send out other tokens
-				tChan <- v
+				emit(v)
 			}
 
 		default: // send out other item types
-			tChan <- item
+			emit(item)
 		}
 	}
 	if err != nil && err != io.EOF {
-		tChan <- err
+		emit(newError(PhaseLexing, ref, p.l.Offset(), err))
 	}
-
-	close(tChan)
 }
 
-func readObject(r peekingReader.Reader, ref *objectref) (*object, error) {
+func readObject(p *parser, ref *objectref) (*object, error) {
 	o := object{refString: ref.refString}
 	for {
-		item := readNext(r)
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
 		switch v := item.(type) {
-		case error:
-			return nil, v
-
 		case token:
 			switch v {
 			case "stream":
-				if l := o.streamLength(); l > 0 {
-					s, err := r.ReadBytes(l)
-					if err != nil {
-						return nil, err
-					}
-					o.stream = s
-					continue
+				l := o.streamLength()
+				if l <= 0 { // an indirect /Length isn't known yet, so read up to endstream
+					l = -1
+				}
+				s, err := p.l.ReadStream(l)
+				if err != nil {
+					return nil, err
 				}
+				o.stream = s
+				continue
 			case "endstream":
 				continue
 			case "endobj":
@@ -174,7 +190,7 @@ func readObject(r peekingReader.Reader, ref *objectref) (*object, error) {
 	}
 }
 
-func readXref(r peekingReader.Reader) (xref, error) {
+func readXref(p *parser) (xref, error) {
 	var xrefStart, xrefEnd int
 	var xref xref
 	var number int
@@ -183,12 +199,14 @@ func readXref(r peekingReader.Reader) (xref, error) {
 	xrefCount := 1
 
 	for {
-		item := readNext(r)
-		switch v := item.(type) {
-		case error:
-			return nil, v
-
-		case token:
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		if i, ok := item.(integer); ok { // offsets and numbers are handled as text like the xref type
+			item = token(strconv.Itoa(int(i)))
+		}
+		if v, ok := item.(token); ok {
 			if xrefCount == 1 {
 				xrefStart, _ = strconv.Atoi(string(v))
 			} else if xrefCount == 2 {
@@ -216,104 +234,85 @@ func readXref(r peekingReader.Reader) (xref, error) {
 	}
 }
 
-func readTrailer(r peekingReader.Reader) (*trailer, error) {
+func readTrailer(p *parser) (*trailer, error) {
 	for {
-		item := readNext(r)
-		switch v := item.(type) {
-		case error:
-			return nil, v
-
-		case dictionary:
+		item, err := p.item()
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := item.(dictionary); ok {
 			t := &trailer{}
-			if d, ok := v["/DecodeParms"].(dictionary); ok {
+			if d, ok := v.get("/DecodeParms").(dictionary); ok {
 				t.decodeParms = d
 			}
-			if r, ok := v["/Root"].(*objectref); ok {
+			if r, ok := v.get("/Root").(*objectref); ok {
 				t.rootRef = r.refString
 			}
-			if e, ok := v["/Encrypt"].(*objectref); ok {
+			if e, ok := v.get("/Encrypt").(*objectref); ok {
 				t.encryptRef = e.refString
 			}
+			if id, ok := v.get("/ID").(array); ok {
+				t.id = id
+			}
 			return t, nil
 		}
 	}
 }
 
-func readNext(r peekingReader.Reader) interface{} {
-	b, err := r.ReadByte()
-	if err != nil {
-		return err
+// pow10 holds the powers of ten that are exactly representable as a float64
+var pow10 = [...]float64{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11,
+	1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19, 1e20, 1e21, 1e22}
+
+// scanNumber parses b if it is a valid PDF number, which is an integer or a real with an
+// optional sign and decimal point (section 7.3.3). Integers too large for an int are reals.
+func scanNumber(b []byte) (i int, f float64, isReal, ok bool) {
+	j, neg := 0, false
+	if len(b) > 0 && (b[0] == '+' || b[0] == '-') {
+		j, neg = 1, b[0] == '-'
 	}
-	switch b {
-	case '(':
-		t, err := readText(r)
-		if err != nil {
-			return err
-		}
-		return t
-	case '<':
-		n, err := r.Peek(1)
-		if err != nil {
-			return err
-		}
-		if n[0] == '<' {
-			r.ReadByte() // read the "<" we peeked
-			d, err := readDictionary(r)
-			if err != nil {
-				return err
+	var mantissa uint64
+	digits, decimals, overflow := 0, -1, false
+	for ; j < len(b); j++ {
+		switch c := b[j]; {
+		case isNumber(c):
+			digits++
+			if decimals >= 0 {
+				decimals++
 			}
-			return d
-		}
-
-		v, err := peekingReader.ReadUntil(r, '>')
-		if err != nil {
-			return err
-		}
-		r.ReadByte() // move read pointer past the '>'
-		return hexdata(v)
-	case '[':
-		v, err := readArray(r)
-		if err != nil {
-			return err
-		}
-		return v
-	case '{':
-		v, err := peekingReader.ReadUntil(r, '}')
-		if err != nil {
-			return err
-		}
-		r.ReadByte() // move read pointer past the '}'
-		return codestream(v)
-	case '/':
-		v, err := readName(r)
-		if err != nil {
-			return err
-		}
-		return v
-	case '%':
-		v, err := peekingReader.ReadUntilAny(r, eolChars) // make into readComment
-		if err != nil {
-			return err
-		}
-		return comment(v)
-	case '\x00', '\t', '\f', ' ', '\n', '\r':
-		err := peekingReader.SkipSpaces(r)
-		if err != nil {
-			return err
-		}
-		return readNext(r)
-	case ']', ')', '>', '}':
-		return end(b)
-	default:
-		token, oref, err := readTokenOrObjectReference(b, r)
-		if err != nil {
-			return err
+			if mantissa > (math.MaxInt64-uint64(c-'0'))/10 {
+				overflow = true
+			} else {
+				mantissa = mantissa*10 + uint64(c-'0')
+			}
+		case c == '.' && decimals < 0:
+			decimals = 0
+		default:
+			return 0, 0, false, false
 		}
-		if oref == nil {
-			return token
+	}
+	if digits == 0 {
+		return 0, 0, false, false
+	}
+	if decimals < 0 && !overflow {
+		i = int(mantissa)
+		if neg {
+			i = -i
 		}
-		return oref
+		return i, 0, false, true
+	}
+
+	if overflow || mantissa >= 1<<53 || decimals >= len(pow10) { // not exact, so leave it to strconv
+		f, err := strconv.ParseFloat(string(b), 64)
+		return 0, f, true, err == nil
+	}
+	f = float64(mantissa)
+	if decimals > 0 {
+		f /= pow10[decimals]
 	}
+	if neg {
+		f = -f
+	}
+	return 0, f, true, true
 }
 
 func isWhitespace(b byte) bool {
@@ -329,235 +328,212 @@ func isNumber(b byte) bool {
 	return b >= '0' && b <= '9'
 }
 
-func readArray(r peekingReader.Reader) (array, error) {
-	items := array{}
+// appendText reads a literal string after its '(' up to its balancing ')' and appends the
+// bytes of the string to dst with its escape sequences decoded (section 7.3.4.2)
+func appendText(dst []byte, r *countingReader) ([]byte, error) {
+	depth := 1
 	for {
-		item := readNext(r)
-		switch v := item.(type) {
-		case error:
-			return nil, v
-
-		case text:
-			items = append(items, v...)
-
-		case end:
-			if v == ']' {
-				return items, nil
+		b, err := r.ReadByte()
+		if err != nil {
+			return dst, unexpectedEOF(err)
+		}
+		switch b {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return dst, nil
 			}
-			items = append(items, v)
-		default:
-			items = append(items, item)
+		case '\r': // an unescaped EOL of any kind is read as \n
+			r.skip('\n')
+			b = '\n'
+		case '\\':
+			if b, err = r.ReadByte(); err != nil {
+				return dst, unexpectedEOF(err)
+			}
+			switch b {
+			case 'n':
+				b = '\n'
+			case 'r':
+				b = '\r'
+			case 't':
+				b = '\t'
+			case 'b':
+				b = '\b'
+			case 'f':
+				b = '\f'
+			case '\r': // line continuation
+				r.skip('\n')
+				continue
+			case '\n':
+				continue
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				b = readOctal(r, b)
+			}
+			// any other escaped character, including ( ) and \, stands for itself
 		}
+		dst = append(dst, b)
 	}
 }
 
-func readText(r peekingReader.Reader) (text, error) {
-	v, err := peekingReader.ReadUntil(r, ')')
-	if err != nil {
-		return nil, err
+// unexpectedEOF turns the end of the input in the middle of a token into io.ErrUnexpectedEOF,
+// since io.EOF means the input ended cleanly between tokens
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
 	}
-	r.ReadByte()                          // move read pointer past the ')'
-	for bytes.HasSuffix(v, []byte(`\`)) { // ends with escape character so go to next end
-		n, err := peekingReader.ReadUntil(r, ')')
-		if err != nil {
-			return nil, err
+	return err
+}
+
+// readOctal reads the rest of a 1 to 3 digit octal escape. High-order overflow is ignored.
+func readOctal(r *countingReader, first byte) byte {
+	v := first - '0'
+	for i := 0; i < 2; i++ {
+		b, ok := r.peekByte()
+		if !ok || b < '0' || b > '7' {
+			break
 		}
-		v = append(v, ')')  // add the escaped ')'
-		v = append(v, n...) // add the next characters too
+		v = v<<3 + b - '0'
+		r.pos++
 	}
-	return separateUnicode(v), nil
+	return v
 }
 
-func separateUnicode(t []byte) text {
-	var result text
-	start := bytes.IndexByte(t, '\\')
-	var lastChar byte
-	if start != -1 {
-		v := string(t[:start])
-		result = append(result, v) // include up to, but not including '\'
-		var num bytes.Buffer
-		var str bytes.Buffer
-		isUnicode := true
-		for i := start + 1; i < len(t); i++ {
-			c := t[i]
-			switch c {
-			case '\\':
-				if lastChar == '\\' && isUnicode {
-					isUnicode = false // escaping backslash
-					str.WriteByte(c)
-
-				} else {
-					isUnicode = true
-					if str.Len() != 0 {
-						result = append(result, str.String())
-						str.Reset()
-					}
-				}
-			case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-				if isUnicode {
-					num.WriteByte(c)
-				} else {
-					str.WriteByte(c)
-				}
-			default:
-				if isUnicode { // changing from Unicode to string, so send out unicode info
-					unicode, _ := strconv.ParseInt(num.String(), 8, 32)
-					result = append(result, hexdata(fmt.Sprintf("%02dx", unicode)))
-					isUnicode = false
-					num.Reset()
-				}
-				str.WriteByte(c)
-			}
-			lastChar = c
+// appendHexdata reads a hexadecimal string after its '<' up to the '>' and appends it to dst
+// as an even number of uppercase digits. Whitespace and any other non-hex characters are
+// ignored and an odd final digit is padded with 0 (section 7.3.4.3).
+func appendHexdata(dst []byte, r *countingReader) ([]byte, error) {
+	start := len(dst)
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return dst, unexpectedEOF(err)
 		}
-		if isUnicode {
-			unicode, _ := strconv.ParseInt(num.String(), 8, 32)
-			result = append(result, hexdata(fmt.Sprintf("%02x", unicode)))
-		} else {
-			result = append(result, str.String())
+		switch {
+		case b == '>':
+			if (len(dst)-start)%2 == 1 {
+				dst = append(dst, '0')
+			}
+			return dst, nil
+		case isNumber(b), b >= 'A' && b <= 'F':
+			dst = append(dst, b)
+		case b >= 'a' && b <= 'f':
+			dst = append(dst, b-'a'+'A')
 		}
-		return result
 	}
-	return text{string(t)}
 }
 
-func readName(r peekingReader.Reader) (name, error) {
-	p, err := r.Peek(1)
-	if err != nil {
-		return "\x00", err
-	}
-	if p[0] == '/' {
-		r.ReadByte() // read past first '/'
-	}
-	v, err := peekingReader.ReadUntilAny(r, delimChars)
-	if err != nil {
-		return "\x00", err
+// decodeHexInPlace turns an even number of hex digits into the bytes they stand for
+func decodeHexInPlace(h []byte) []byte {
+	for i := 0; i+1 < len(h); i += 2 {
+		h[i/2] = hexValue(h[i])<<4 | hexValue(h[i+1])
 	}
-	if !bytes.HasPrefix(v, []byte{'/'}) { // include leading '/' if not included
-		v = append([]byte{'/'}, v...)
-	}
-
-	return name(v), err
+	return h[:len(h)/2]
 }
 
-func readDictionary(r peekingReader.Reader) (dictionary, error) {
-	d := make(dictionary)
+// appendName reads a name after its '/' up to the next delimiter and appends it to dst with
+// its leading '/' and its #xx escapes decoded. It returns io.EOF along with the name if
+// the name ends the input.
+func appendName(dst []byte, r *countingReader) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, '/')
+	var err error
 	for {
-		if err := peekingReader.SkipSpaces(r); err != nil {
-			return nil, err
-		}
-		name, err := readName(r)
-		if err != nil {
-			return nil, err
-		}
-
-		item := readNext(r)
-		if err, ok := item.(error); ok {
-			return nil, err
-		}
-		if end, ok := item.(end); ok && end == '>' {
-			d[name] = null(true)
-			r.ReadByte() // skip the second '>' too
-			return d, nil
-		}
-		d[name] = item
-
-		if err = peekingReader.SkipSpaces(r); err != nil {
-			return nil, err
-		}
-		p, err := r.Peek(2)
-		if err != nil {
-			return d, err
+		b, ok := r.peekByte()
+		if !ok {
+			err = r.readErr()
+			break
 		}
-		if string(p) == ">>" {
-			r.ReadBytes(2) // move forward read pointer
-			return d, nil
+		if isWhitespace(b) || isDelimiter(b) {
+			break
 		}
+		dst = append(dst, b)
+		r.pos++
 	}
+	n := decodeNameInPlace(dst[start+1:])
+	return dst[:start+1+len(n)], err
 }
 
-// readTokenOrObjectReference attempts to read an object reference if possible and returns
-// a token if not possible. Object references consist of 3 parts:
-//   1. number, 2. generation and 3. "R" or "obj"
-func readTokenOrObjectReference(b byte, r peekingReader.Reader) (token, *objectref, error) {
-	tok, err := readToken(b, r)
-	if err != nil {
-		return "\x00", nil, err
+// decodeNameInPlace replaces #xx escapes with the byte they stand for (section 7.3.5). A '#'
+// that isn't followed by two hex digits is kept as is.
+func decodeNameInPlace(v []byte) []byte {
+	if bytes.IndexByte(v, '#') == -1 {
+		return v
 	}
-	number, err := strconv.Atoi(string(tok))
-	if err != nil {
-		return tok, nil, nil
+	n := 0
+	for i := 0; i < len(v); i++ {
+		if v[i] == '#' && i+2 < len(v) && isHex(v[i+1]) && isHex(v[i+2]) {
+			v[n] = hexValue(v[i+1])<<4 | hexValue(v[i+2])
+			i += 2
+		} else {
+			v[n] = v[i]
+		}
+		n++
 	}
+	return v[:n]
+}
 
-	buf, err := r.Peek(8) // should be enough for generation and refType
-	if err != nil {
-		return tok, nil, nil
+func isHex(b byte) bool {
+	return isNumber(b) || b >= 'A' && b <= 'F' || b >= 'a' && b <= 'f'
+}
+
+func hexValue(b byte) byte {
+	switch {
+	case b >= 'a':
+		return b - 'a' + 10
+	case b >= 'A':
+		return b - 'A' + 10
 	}
+	return b - '0'
+}
 
-	var g []byte
-	var count = 0
-	for _, n := range buf {
-		count++
-		if isNumber(n) { // can only be numbers
-			g = append(g, n)
-		} else if isWhitespace(n) {
-			if len(g) != 0 {
-				break
-			}
-		} else { // must be numeric or whitespace (before or after token)
-			return tok, nil, nil
+// appendComment reads a comment after its '%' up to the end of the line and appends it to dst
+func appendComment(dst []byte, r *countingReader) ([]byte, error) {
+	for {
+		b, ok := r.peekByte()
+		if !ok || b == '\r' || b == '\n' {
+			return dst, nil
 		}
+		dst = append(dst, b)
+		r.pos++
 	}
+}
 
-	var t []byte
-	for i := count; i < 8; i++ {
-		n := buf[i]
-		count++
-		if n == 'R' || n == 'o' || n == 'b' || n == 'j' { // only R or obj characters allowed
-			t = append(t, n)
-		} else if isWhitespace(n) { // can be whitespace before or after
-			if len(t) != 0 {
-				break
+// appendToken reads the rest of a keyword or number up to the next delimiter or the end
+// of the input and appends it to dst
+func appendToken(dst []byte, r *countingReader) ([]byte, error) {
+	for {
+		b, ok := r.peekByte()
+		if !ok {
+			if err := r.readErr(); err != io.EOF {
+				return dst, err
 			}
-		} else if isDelimiter(n) {
-			count--
-			break
-		} else {
-			return tok, nil, nil
+			return dst, nil
 		}
+		if isWhitespace(b) || isDelimiter(b) {
+			return dst, nil
+		}
+		dst = append(dst, b)
+		r.pos++
 	}
-
-	refType := string(t)
-	if refType != "R" && refType != "obj" {
-		return tok, nil, nil
-	}
-	generation, _ := strconv.Atoi(string(g))
-	r.ReadBytes(count) // consume the bytes we used in the object reference
-	return "\x00", &objectref{refString: fmt.Sprintf("%d %d", number, generation), refType: refType}, nil
 }
 
-func readToken(b byte, r peekingReader.Reader) (token, error) {
-	tok, err := peekingReader.ReadUntilAny(r, delimChars)
+// readStreamEOL reads the end of line after the stream keyword, which is either \n or \r\n
+// (section 7.3.8.1)
+func readStreamEOL(r *countingReader) error {
+	next, err := r.ReadByte()
 	if err != nil {
-		return "\x00", err
+		return err
 	}
-	tok = append([]byte{b}, tok...)
-
-	if string(tok) == "stream" { // special case for stream
-		next, err := r.ReadByte()
+	if next == '\r' { // EOL is \r, so take \n as well
+		next, err = r.ReadByte()
 		if err != nil {
-			return "\x00", err
+			return err
 		}
-		if next == '\r' { // EOL is \r, so take \n as well (section 3.2.7)
-			next, err = r.ReadByte()
-			if err != nil {
-				return "\x00", err
-			}
-			if next != '\n' { // doesn't follow spec
-				return "\x00", errors.New("expected \r\n EOL delimiter")
-			}
+		if next != '\n' { // doesn't follow spec
+			return errors.New("expected \r\n EOL delimiter")
 		}
 	}
-
-	return token(tok), nil
+	return nil
 }
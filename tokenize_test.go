@@ -1,136 +1,122 @@
 package pdf2txt
 
 import (
+	"bytes"
 	"io"
-	"os"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/EndFirstCorp/peekingReader"
 )
 
-func TestTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/Kicker.pdf`)
-
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
-
-	count := 0
-	for c := range tChan {
-		//fmt.Printf("%d - %T |%v|\n", count, c, c)
-		if c != nil {
-
-		}
-		count++
-	}
-}
-
 func TestReadDictionary(t *testing.T) {
-	// dictionary string without leading << since those have been removed before readDictionary gets it
+	// dictionary string without leading << since those have been read before dictionary gets it
 	dict := `/BleedBox[0.0 0.0 839.055 595.276]/Contents 2 0 R/CropBox[0.0 0.0 839.055 595.276]/MediaBox[0.0 0.0 839.055 595.276]/Parent 37 0 R/Resources<</ExtGState<</GS0 35 0 R/GS1 57 0 R>>/Font<</T1_0 32 0 R/T1_1 59 0 R>>/ProcSet[/PDF/Text/ImageC]/XObject<</Im0 3 0 R/Im1 4 0 R>>>>/Rotate 0/TrimBox[0.0 0.0 839.055 595.276]/Type/Page>> `
-	actual, err := readDictionary(peekingReader.NewMemReader([]byte(dict)))
+	actual, err := newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
 	if err != nil && err != io.EOF {
 		t.Fatal("expected success", err)
 	}
 
-	if a, ok := actual["/BleedBox"].(array); !ok || len(a) != 4 || a[0] != token("0.0") || a[1] != token("0.0") || a[2] != token("839.055") || a[3] != token("595.276") {
+	if a, ok := actual.get("/BleedBox").(array); !ok || len(a) != 4 || a[0] != real(0) || a[1] != real(0) || a[2] != real(839.055) || a[3] != real(595.276) {
 		t.Error("expected valid /BleedBox", a, actual)
 	}
 
-	if c, ok := actual["/Contents"].(*objectref); !ok || c == nil || c.refString != "2 0" {
+	if c, ok := actual.get("/Contents").(*objectref); !ok || c == nil || c.refString != "2 0" {
 		t.Error("invalid /Contents", c)
 	}
 
-	if a, ok := actual["/CropBox"].(array); !ok || len(a) != 4 || a[0] != token("0.0") || a[1] != token("0.0") || a[2] != token("839.055") || a[3] != token("595.276") {
+	if a, ok := actual.get("/CropBox").(array); !ok || len(a) != 4 || a[0] != real(0) || a[1] != real(0) || a[2] != real(839.055) || a[3] != real(595.276) {
 		t.Error("expected valid /CropBox", a)
 	}
 
-	if a, ok := actual["/MediaBox"].(array); !ok || len(a) != 4 || a[0] != token("0.0") || a[1] != token("0.0") || a[2] != token("839.055") || a[3] != token("595.276") {
+	if a, ok := actual.get("/MediaBox").(array); !ok || len(a) != 4 || a[0] != real(0) || a[1] != real(0) || a[2] != real(839.055) || a[3] != real(595.276) {
 		t.Error("expected valid /MediaBox", a)
 	}
 
-	if p, ok := actual["/Parent"].(*objectref); !ok || p == nil || p.refString != "37 0" {
+	if p, ok := actual.get("/Parent").(*objectref); !ok || p == nil || p.refString != "37 0" {
 		t.Error("expected valid /Parent", p)
 	}
 
-	r, ok := actual["/Resources"].(dictionary)
+	r, ok := actual.get("/Resources").(dictionary)
 	if !ok {
 		t.Error("expected valid /Resources", r)
 	}
 
-	gs, ok := r["/ExtGState"].(dictionary)
+	gs, ok := r.get("/ExtGState").(dictionary)
 	if !ok {
 		t.Error("expected valid /Resources ExtGState dictionary", gs, r)
 	}
-	if gs0, ok := gs["/GS0"].(*objectref); !ok || gs0.refString != "35 0" {
+	if gs0, ok := gs.get("/GS0").(*objectref); !ok || gs0.refString != "35 0" {
 		t.Error("expected valid GS0 value in /Resources ExtGState dictionary", gs, gs0)
 	}
-	if gs1, ok := gs["/GS1"].(*objectref); !ok || gs1.refString != "57 0" {
+	if gs1, ok := gs.get("/GS1").(*objectref); !ok || gs1.refString != "57 0" {
 		t.Error("expected valid GS1 value in /Resources ExtGState dictionary", gs, gs1)
 	}
 
-	font, ok := r["/Font"].(dictionary)
+	font, ok := r.get("/Font").(dictionary)
 	if !ok {
 		t.Error("expected valid /Font", font)
 	}
-	if t10, ok := font["/T1_0"].(*objectref); !ok || t10.refString != "32 0" {
+	if t10, ok := font.get("/T1_0").(*objectref); !ok || t10.refString != "32 0" {
 		t.Error("expected valid T1_0 value", t10)
 	}
-	if t11, ok := font["/T1_1"].(*objectref); !ok || t11.refString != "59 0" {
+	if t11, ok := font.get("/T1_1").(*objectref); !ok || t11.refString != "59 0" {
 		t.Error("expected valid T1_1 value", t11)
 	}
 
-	if ps, ok := r["/ProcSet"].(array); !ok || len(ps) != 3 || ps[0] != name("/PDF") || ps[1] != name("/Text") || ps[2] != name("/ImageC") {
+	if ps, ok := r.get("/ProcSet").(array); !ok || len(ps) != 3 || ps[0] != name("/PDF") || ps[1] != name("/Text") || ps[2] != name("/ImageC") {
 		t.Error("expected valid /ProcSet")
 	}
 
-	xo, ok := r["/XObject"].(dictionary)
+	xo, ok := r.get("/XObject").(dictionary)
 	if !ok {
 		t.Error("expected valid /XObject", font)
 	}
-	if im0, ok := xo["/Im0"].(*objectref); !ok || im0.refString != "3 0" {
+	if im0, ok := xo.get("/Im0").(*objectref); !ok || im0.refString != "3 0" {
 		t.Error("expected valid Im0 value", im0)
 	}
-	if im1, ok := xo["/Im1"].(*objectref); !ok || im1.refString != "4 0" {
+	if im1, ok := xo.get("/Im1").(*objectref); !ok || im1.refString != "4 0" {
 		t.Error("expected valid Im1 value", im1)
 	}
 
-	if rot, ok := actual["/Rotate"].(token); !ok || rot != "0" {
+	if rot, ok := actual.get("/Rotate").(integer); !ok || rot != 0 {
 		t.Error("expected valid /Rotate", rot)
 	}
 
-	if tb, ok := actual["/TrimBox"].(array); !ok || len(tb) != 4 || tb[0] != token("0.0") || tb[1] != token("0.0") || tb[2] != token("839.055") || tb[3] != token("595.276") {
+	if tb, ok := actual.get("/TrimBox").(array); !ok || len(tb) != 4 || tb[0] != real(0) || tb[1] != real(0) || tb[2] != real(839.055) || tb[3] != real(595.276) {
 		t.Error("expected valid /TrimBox", tb)
 	}
 
-	if tp, ok := actual["/Type"].(name); !ok || tp != "/Page" {
+	if tp, ok := actual.get("/Type").(name); !ok || tp != "/Page" {
 		t.Error("expected valid /Type", tp)
 	}
 
 	// check for empty value
 	dict = `/Empty>> `
-	actual, err = readDictionary(peekingReader.NewMemReader([]byte(dict)))
+	actual, err = newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
 
-	if c, ok := actual["/Empty"].(null); !ok || c != null(true) {
+	if c, ok := actual.get("/Empty").(null); !ok || c != null(true) {
 		t.Error("expected valid /Empty")
 	}
 
-	// error on readName
+	// error on reading the key
 	dict = `/Empty`
-	actual, err = readDictionary(peekingReader.NewMemReader([]byte(dict)))
+	actual, err = newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
 	if err != io.EOF {
 		t.Error("expected error")
 	}
 
-	// error on readNext
+	// error on reading the value
 	dict = `/Empty 5  0`
-	actual, err = readDictionary(peekingReader.NewMemReader([]byte(dict)))
+	actual, err = newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
 	if err != io.EOF {
 		t.Error("expected error")
 	}
 
 	// error on Peek
 	dict = `/Empty 5  `
-	actual, err = readDictionary(peekingReader.NewMemReader([]byte(dict)))
+	actual, err = newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
 	if err != io.EOF {
 		t.Error("expected error")
 	}
@@ -140,88 +126,212 @@ func TestReadDictionary(t *testing.T) {
 /Outlines 2 0 R
 /Pages 6 0 R
 >> `
-	actual, _ = readDictionary(peekingReader.NewMemReader([]byte(dict)))
-	if actual == nil || actual["/Type"] != name("/Catalog") {
+	actual, _ = newParser(peekingReader.NewMemReader([]byte(dict))).dictionary()
+	if actual == nil || actual.get("/Type") != name("/Catalog") {
 		t.Error("expected valid dictionary", actual)
 	}
-	if o, ok := actual["/Outlines"].(*objectref); !ok || o.refString != "2 0" {
+	if o, ok := actual.get("/Outlines").(*objectref); !ok || o.refString != "2 0" {
 		t.Error("expected valid refString", o)
 	}
-	if o, ok := actual["/Pages"].(*objectref); !ok || o.refString != "6 0" {
+	if o, ok := actual.get("/Pages").(*objectref); !ok || o.refString != "6 0" {
 		t.Error("expected valid refString", o)
 	}
 }
 
-func TestTextTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/132_0.txt`)
-
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
-
-	count := 0
-	for c := range tChan {
-		//fmt.Printf("%d - %T |%v|\n", count, c, c)
-		if c != nil {
+func TestReadText(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected text
+	}{
+		{`simple)`, "simple"},
+		{`a(b)c)`, "a(b)c"},
+		{`a(b(c))d) extra`, "a(b(c))d"},
+		{`a\(b\)c)`, "a(b)c"},
+		{`unbalanced \( ok)`, "unbalanced ( ok"},
+		{`\n\r\t\b\f\\)`, "\n\r\t\b\f\\"},
+		{"line\\\ncontinued)", "linecontinued"},
+		{"line\\\r\ncontinued)", "linecontinued"},
+		{"line\\\rcontinued)", "linecontinued"},
+		{"eol\r\nnormalized\rto lf)", "eol\nnormalized\nto lf"},
+		{`\101\60\0601)`, "A001"},
+		{`\0053)`, "\x053"},
+		{`\7)`, "\x07"},
+		{`\777)`, "\xff"},
+		{`\q)`, "q"},
+		{`it\222s)`, "it\x92s"},
+	}
+	for _, test := range tests {
+		actual, err := appendText(nil, &countingReader{buf: []byte(test.in)})
+		if err != nil || text(actual) != test.expected {
+			t.Errorf("appendText(%q) = %q, %v; expected %q", test.in, actual, err, test.expected)
+		}
+	}
 
+	for _, in := range []string{`a(b)`, `a\`} {
+		if _, err := appendText(nil, &countingReader{buf: []byte(in)}); err != io.ErrUnexpectedEOF {
+			t.Errorf("appendText(%q): expected unexpected EOF for unterminated string, got %v", in, err)
 		}
-		count++
 	}
 }
 
-func TestCmapTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/257_0.txt`)
-
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
+func TestReadHexdata(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected hexdata
+	}{
+		{`>`, ""},
+		{`0a1B>`, "0A1B"},
+		{"00 41\r\n00\t42>", "00410042"},
+		{`901FA>`, "901FA0"},
+		{`7>`, "70"},
+		{`0G1>`, "01"},
+	}
+	for _, test := range tests {
+		actual, err := appendHexdata(nil, &countingReader{buf: []byte(test.in)})
+		if err != nil || hexdata(actual) != test.expected {
+			t.Errorf("appendHexdata(%q) = %q, %v; expected %q", test.in, actual, err, test.expected)
+		}
+	}
 
-	count := 0
-	for c := range tChan {
-		//fmt.Printf("%d - %T |%v|\n", count, c, c)
-		if c != nil {
+	if _, err := appendHexdata(nil, &countingReader{buf: []byte(`0A1`)}); err != io.ErrUnexpectedEOF {
+		t.Error("expected unexpected EOF for unterminated hex string", err)
+	}
+}
 
+func TestReadName(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected name
+	}{
+		{`/Type `, "/Type"},
+		{`/F#20Bold `, "/F Bold"},
+		{`/A#2fB/`, "/A/B"},
+		{`/#2Fslash `, "//slash"},
+		{`/Lime#20Green>>`, "/Lime Green"},
+		{`/paired#28#29parentheses `, "/paired()parentheses"},
+		{`/bad#2 `, "/bad#2"},
+		{`/bad#zz `, "/bad#zz"},
+		{`/trailing# `, "/trailing#"},
+	}
+	for _, test := range tests {
+		actual, err := newParser(peekingReader.NewMemReader([]byte(test.in))).item()
+		if err != nil || actual != test.expected {
+			t.Errorf("item(%q) = %q, %v; expected %q", test.in, actual, err, test.expected)
 		}
-		count++
 	}
-}
 
-func TestProfotoTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/Profoto.pdf`)
+	d, err := newParser(peekingReader.NewMemReader([]byte(`/F#31 5 0 R /Type /Font#44escriptor>> `))).dictionary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o, ok := d.get("/F1").(*objectref); !ok || o.refString != "5 0" || d.get("/Type") != name("/FontDescriptor") {
+		t.Error("expected decoded dictionary keys and values", d)
+	}
+}
 
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
+func TestTypedTokens(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected interface{}
+	}{
+		{"42 ", integer(42)},
+		{"-17 ", integer(-17)},
+		{"+5 ", integer(5)},
+		{"612.0 ", real(612)},
+		{".5 ", real(0.5)},
+		{"-.002 ", real(-0.002)},
+		{"4. ", real(4)},
+		{"99999999999999999999 ", real(99999999999999999999)},
+		{"true ", boolean(true)},
+		{"false]", boolean(false)},
+		{"null ", null(true)},
+		{"Tj ", token("Tj")},
+		{"1.2.3 ", token("1.2.3")},
+		{"- ", token("-")},
+		{"3-4 ", token("3-4")},
+		{"1e5 ", token("1e5")},
+	}
+	for _, test := range tests {
+		actual, err := newParser(peekingReader.NewMemReader([]byte(test.in))).item()
+		if err != nil || actual != test.expected {
+			t.Errorf("item(%q) = %#v, %v; expected %#v", test.in, actual, err, test.expected)
+		}
+	}
 
-	count := 0
-	for c := range tChan {
-		//fmt.Printf("%d - %T |%v|\n", count, c, c)
-		if c != nil {
+	o := &object{dict: dictionary{{"/Width", real(612.5)}, {"/Count", integer(3)}}}
+	width, _ := number(o.search("/Width"))
+	count, _ := number(o.search("/Count"))
+	if o.int("/Width") != 612 || width != 612.5 || o.int("/Count") != 3 || count != 3 {
+		t.Error("expected numeric accessors to handle integers and reals")
+	}
+}
 
+// addSeeds adds the testData files that match pattern to the seed corpus
+func addSeeds(f *testing.F, pattern string) {
+	files, err := filepath.Glob(filepath.Join("testData", pattern))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
 		}
-		count++
+		f.Add(b)
 	}
 }
 
-func TestProfotoUGTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/ProfotoUserGuide.pdf`)
+func FuzzItem(f *testing.F) {
+	addSeeds(f, "*.txt")
+	addSeeds(f, "*.pdf")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p := newParser(peekingReader.NewMemReader(data))
+		defer p.close()
+		for {
+			if _, err := p.item(); err != nil {
+				return
+			}
+		}
+	})
+}
 
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
+func FuzzTokenize(f *testing.F) {
+	addSeeds(f, "*.pdf")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tokenizeEach(peekingReader.NewMemReader(data), func(interface{}) {})
+	})
+}
 
-	count := 0
-	for c := range tChan {
-		//fmt.Printf("%d - %T |%v|\n", count, c, c)
-		if c != nil {
+func FuzzDictionary(f *testing.F) {
+	addSeeds(f, "*.txt")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p := newParser(peekingReader.NewMemReader(data))
+		defer p.close()
+		p.dictionary()
+	})
+}
+
+var benchmarkFiles = []string{"Kicker", "Profoto", "ProfotoUserGuide", "SheetMusic", "financial_accounting", "pdfFile"}
 
+// benchmarkPDFs runs fn on each of the benchmark files in testData
+func benchmarkPDFs(b *testing.B, fn func(data []byte)) {
+	for _, f := range benchmarkFiles {
+		data, err := ioutil.ReadFile("testData/" + f + ".pdf")
+		if err != nil {
+			b.Fatal(err)
 		}
-		count++
+		b.Run(f, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				fn(data)
+			}
+		})
 	}
 }
 
-func TestCatalogTokenize(t *testing.T) {
-	f, _ := os.Open(`testData/catalog.txt`)
-	tChan := make(chan interface{})
-	go tokenize(peekingReader.NewBufReader(f), tChan)
-	tok := <-tChan
-	if obj, ok := tok.(*object); !ok || obj.refString != "7967 0" || obj.dict["/Type"] != name("/Catalog") {
-		t.Error("unable to parse", obj)
-	}
+func BenchmarkTokenize(b *testing.B) {
+	benchmarkPDFs(b, func(data []byte) {
+		tokenizeEach(peekingReader.NewBufReader(bytes.NewReader(data)), func(interface{}) {})
+	})
 }
@@ -0,0 +1,308 @@
+package pdf2txt
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// maxCmapChars is the most characters read from a cmap subtable of a TrueType font, so a
+// few bytes of a font file can't make a huge map or take long to read
+const maxCmapChars = 1 << 20
+
+var errInvalidTrueType = errors.New("invalid TrueType font")
+
+// trueType holds what's needed from an embedded TrueType font (/FontFile2) to find the
+// Unicode of its glyphs: the character to glyph mappings of its cmap table and the glyph
+// names of its post table.
+type trueType struct {
+	symbol       map[rune]uint16 // the (3,0) subtable, whose codes may be offset by 0xF000
+	mac          map[rune]uint16 // the (1,0) subtable, whose codes are bytes
+	glyphUnicode map[uint16]rune // the (3,1), (3,10) or (0,x) subtable the other way round
+	glyphNames   []string        // by glyph ID
+}
+
+// readTables reads the table directory of a TrueType or OpenType font file and returns its
+// tables by tag
+func readTables(data []byte) (map[string][]byte, error) {
+	if len(data) < 12 {
+		return nil, errInvalidTrueType
+	}
+	tables := make(map[string][]byte)
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < numTables; i++ {
+		record := 12 + 16*i
+		if record+16 > len(data) {
+			return nil, errInvalidTrueType
+		}
+		offset := int64(binary.BigEndian.Uint32(data[record+8:]))
+		length := int64(binary.BigEndian.Uint32(data[record+12:]))
+		if offset+length > int64(len(data)) {
+			return nil, errInvalidTrueType
+		}
+		tables[string(data[record:record+4])] = data[offset : offset+length]
+	}
+	return tables, nil
+}
+
+// parseTrueType reads the cmap and post tables of a TrueType or OpenType font file. A font
+// without them is read without error, but gives no Unicode.
+func parseTrueType(data []byte) (*trueType, error) {
+	tables, err := readTables(data)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &trueType{}
+	var unicode map[rune]uint16
+	if cmap := tables["cmap"]; len(cmap) >= 4 {
+		numSubtables := int(binary.BigEndian.Uint16(cmap[2:]))
+		for i := 0; i < numSubtables && 4+8*i+8 <= len(cmap); i++ {
+			record := cmap[4+8*i:]
+			platformID, encodingID := binary.BigEndian.Uint16(record), binary.BigEndian.Uint16(record[2:])
+			offset := int64(binary.BigEndian.Uint32(record[4:]))
+			if offset >= int64(len(cmap)) {
+				continue
+			}
+			switch {
+			case platformID == 3 && encodingID == 0 && t.symbol == nil:
+				t.symbol = readCmapSubtable(cmap[offset:])
+			case platformID == 1 && encodingID == 0 && t.mac == nil:
+				t.mac = readCmapSubtable(cmap[offset:])
+			case (platformID == 3 && (encodingID == 1 || encodingID == 10) || platformID == 0) && unicode == nil:
+				unicode = readCmapSubtable(cmap[offset:])
+			}
+		}
+	}
+	t.glyphUnicode = make(map[uint16]rune)
+	for r, g := range unicode { // the lowest character if several map to a glyph
+		if u, ok := t.glyphUnicode[g]; !isPrivateUse(string(r)) && (!ok || r < u) {
+			t.glyphUnicode[g] = r
+		}
+	}
+	t.glyphNames = readGlyphNames(tables["post"])
+	return t, nil
+}
+
+// readCmapSubtable reads the characters and glyphs of a cmap subtable in format 0, 4, 6 or
+// 12. It returns nil for other formats and a partial map for a subtable cut short.
+func readCmapSubtable(s []byte) map[rune]uint16 {
+	if len(s) < 2 {
+		return nil
+	}
+	u16 := func(off int) int {
+		if off < 0 || off+2 > len(s) {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(s[off:]))
+	}
+	u32 := func(off int) int64 {
+		if off < 0 || off+4 > len(s) {
+			return 0
+		}
+		return int64(binary.BigEndian.Uint32(s[off:]))
+	}
+	glyphs := make(map[rune]uint16)
+	n := 0 // characters read
+	switch u16(0) {
+	case 0: // byte encoding table
+		for c := 0; c < 256 && 6+c < len(s); c++ {
+			glyphs[rune(c)] = uint16(s[6+c])
+		}
+	case 4: // segment mapping to delta values
+		segCount := u16(6) / 2
+		endCodes, startCodes := 14, 16+2*segCount
+		idDeltas, idRangeOffsets := 16+4*segCount, 16+6*segCount
+		for i := 0; i < segCount; i++ {
+			start, end := u16(startCodes+2*i), u16(endCodes+2*i)
+			delta, rangeOffset := u16(idDeltas+2*i), u16(idRangeOffsets+2*i)
+			for c := start; c <= end && c != 0xffff && n < maxCmapChars; c, n = c+1, n+1 {
+				glyph := c + delta
+				if rangeOffset != 0 {
+					if glyph = u16(idRangeOffsets + 2*i + rangeOffset + 2*(c-start)); glyph != 0 {
+						glyph += delta
+					}
+				}
+				glyphs[rune(c)] = uint16(glyph)
+			}
+		}
+	case 6: // trimmed table mapping
+		first, count := u16(6), u16(8)
+		for i := 0; i < count && 10+2*i+2 <= len(s); i++ {
+			glyphs[rune(first+i)] = uint16(u16(10 + 2*i))
+		}
+	case 12: // segmented coverage
+		numGroups := u32(12)
+		for i := 0; int64(i) < numGroups && 16+12*i+12 <= len(s); i++ {
+			start, end, glyph := u32(16+12*i), u32(20+12*i), u32(24+12*i)
+			for c := start; c <= end && c <= 0x10ffff && n < maxCmapChars; c, glyph, n = c+1, glyph+1, n+1 {
+				glyphs[rune(c)] = uint16(glyph)
+			}
+		}
+	default:
+		return nil
+	}
+	return glyphs
+}
+
+// readGlyphNames reads the glyph names of a post table in format 1 or 2. Other formats
+// don't have names.
+func readGlyphNames(post []byte) []string {
+	if len(post) < 32 {
+		return nil
+	}
+	switch binary.BigEndian.Uint32(post) {
+	case 0x00010000:
+		return macGlyphNames[:]
+	case 0x00020000:
+		if len(post) < 34 {
+			return nil
+		}
+		numGlyphs := int(binary.BigEndian.Uint16(post[32:]))
+		if 34+2*numGlyphs > len(post) {
+			return nil
+		}
+		var names []string // the Pascal strings after the indexes
+		for data := post[34+2*numGlyphs:]; len(data) > 0 && 1+int(data[0]) <= len(data); data = data[1+int(data[0]):] {
+			names = append(names, string(data[1:1+int(data[0])]))
+		}
+		glyphNames := make([]string, numGlyphs)
+		for i := range glyphNames {
+			index := int(binary.BigEndian.Uint16(post[34+2*i:]))
+			if index < len(macGlyphNames) {
+				glyphNames[i] = macGlyphNames[index]
+			} else if index-len(macGlyphNames) < len(names) {
+				glyphNames[i] = names[index-len(macGlyphNames)]
+			}
+		}
+		return glyphNames
+	}
+	return nil
+}
+
+// glyph returns the glyph a symbolic font shows for a single byte code. The (3,0) subtable
+// maps codes to glyphs either as they are or offset into the private use area.
+func (t *trueType) glyph(code byte) (uint16, bool) {
+	if t.symbol != nil {
+		for _, offset := range []rune{0xf000, 0xf100, 0xf200, 0} {
+			if g := t.symbol[offset+rune(code)]; g != 0 {
+				return g, true
+			}
+		}
+	}
+	if g := t.mac[rune(code)]; g != 0 {
+		return g, true
+	}
+	return 0, false
+}
+
+// text returns the Unicode of the glyph for a code, or "" if the font doesn't say
+func (t *trueType) text(code byte) string {
+	if g, ok := t.glyph(code); ok {
+		return t.unicode(g)
+	}
+	return ""
+}
+
+// unicode returns the Unicode of a glyph, or "" if the font doesn't say. The glyph's name
+// comes first, then the character the Unicode subtable maps to it. Private use characters
+// aren't Unicode that can be extracted, so they are skipped.
+func (t *trueType) unicode(g uint16) string {
+	if int(g) < len(t.glyphNames) {
+		if text := glyphText(t.glyphNames[g]); text != "" && !isPrivateUse(text) {
+			return text
+		}
+	}
+	if r, ok := t.glyphUnicode[g]; ok {
+		return string(r)
+	}
+	return ""
+}
+
+// cidGlyphs finds the Unicode of the CIDs of a CIDFont through the glyphs of its embedded
+// font. The glyphs of a CIDFontType2 are TrueType, and its /CIDToGIDMap stream holds the
+// two byte glyph ID of each CID, while the CIDs are the glyph IDs when it is /Identity or
+// missing (section 9.7.4.2). The glyphs of a CIDFontType0 are CFF, whose charset maps
+// glyphs to CIDs, possibly in an OpenType font with cmap and post tables of its own.
+type cidGlyphs struct {
+	trueType *trueType
+	cff      *cff
+	cidToGID []byte // nil for /Identity
+}
+
+// text returns the Unicode of the glyph for a CID, or "" if the font doesn't say
+func (c *cidGlyphs) text(cid int) string {
+	g, ok := c.glyph(cid)
+	if !ok || g <= 0 || g > 0xffff {
+		return ""
+	}
+	if c.cff != nil {
+		if text := glyphText(c.cff.glyphName(g)); text != "" && !isPrivateUse(text) {
+			return text
+		}
+	}
+	if c.trueType != nil {
+		return c.trueType.unicode(uint16(g))
+	}
+	return ""
+}
+
+// glyph returns the glyph ID for a CID and whether the font has one
+func (c *cidGlyphs) glyph(cid int) (int, bool) {
+	if c.cff != nil {
+		return c.cff.glyph(cid)
+	}
+	if c.cidToGID != nil {
+		if cid < 0 || cid >= len(c.cidToGID)/2 {
+			return 0, false
+		}
+		return int(binary.BigEndian.Uint16(c.cidToGID[2*cid:])), true
+	}
+	return cid, true
+}
+
+func isPrivateUse(text string) bool {
+	for _, r := range text {
+		if r < 0xe000 || r > 0xf8ff {
+			return false
+		}
+	}
+	return true
+}
+
+// macGlyphNames are the names of the 258 glyphs of the standard Macintosh character set,
+// which post tables refer to by index
+var macGlyphNames = [258]string{
+	".notdef", ".null", "nonmarkingreturn", "space", "exclam", "quotedbl", "numbersign", "dollar",
+	"percent", "ampersand", "quotesingle", "parenleft", "parenright", "asterisk", "plus", "comma",
+	"hyphen", "period", "slash", "zero", "one", "two", "three", "four",
+	"five", "six", "seven", "eight", "nine", "colon", "semicolon", "less",
+	"equal", "greater", "question", "at", "A", "B", "C", "D",
+	"E", "F", "G", "H", "I", "J", "K", "L",
+	"M", "N", "O", "P", "Q", "R", "S", "T",
+	"U", "V", "W", "X", "Y", "Z", "bracketleft", "backslash",
+	"bracketright", "asciicircum", "underscore", "grave", "a", "b", "c", "d",
+	"e", "f", "g", "h", "i", "j", "k", "l",
+	"m", "n", "o", "p", "q", "r", "s", "t",
+	"u", "v", "w", "x", "y", "z", "braceleft", "bar",
+	"braceright", "asciitilde", "Adieresis", "Aring", "Ccedilla", "Eacute", "Ntilde", "Odieresis",
+	"Udieresis", "aacute", "agrave", "acircumflex", "adieresis", "atilde", "aring", "ccedilla",
+	"eacute", "egrave", "ecircumflex", "edieresis", "iacute", "igrave", "icircumflex", "idieresis",
+	"ntilde", "oacute", "ograve", "ocircumflex", "odieresis", "otilde", "uacute", "ugrave",
+	"ucircumflex", "udieresis", "dagger", "degree", "cent", "sterling", "section", "bullet",
+	"paragraph", "germandbls", "registered", "copyright", "trademark", "acute", "dieresis", "notequal",
+	"AE", "Oslash", "infinity", "plusminus", "lessequal", "greaterequal", "yen", "mu",
+	"partialdiff", "summation", "product", "pi", "integral", "ordfeminine", "ordmasculine", "Omega",
+	"ae", "oslash", "questiondown", "exclamdown", "logicalnot", "radical", "florin", "approxequal",
+	"Delta", "guillemotleft", "guillemotright", "ellipsis", "nonbreakingspace", "Agrave", "Atilde", "Otilde",
+	"OE", "oe", "endash", "emdash", "quotedblleft", "quotedblright", "quoteleft", "quoteright",
+	"divide", "lozenge", "ydieresis", "Ydieresis", "fraction", "currency", "guilsinglleft", "guilsinglright",
+	"fi", "fl", "daggerdbl", "periodcentered", "quotesinglbase", "quotedblbase", "perthousand", "Acircumflex",
+	"Ecircumflex", "Aacute", "Edieresis", "Egrave", "Iacute", "Icircumflex", "Idieresis", "Igrave",
+	"Oacute", "Ocircumflex", "apple", "Ograve", "Uacute", "Ucircumflex", "Ugrave", "dotlessi",
+	"circumflex", "tilde", "macron", "breve", "dotaccent", "ring", "cedilla", "hungarumlaut",
+	"ogonek", "caron", "Lslash", "lslash", "Scaron", "scaron", "Zcaron", "zcaron",
+	"brokenbar", "Eth", "eth", "Yacute", "yacute", "Thorn", "thorn", "minus",
+	"multiply", "onesuperior", "twosuperior", "threesuperior", "onehalf", "onequarter", "threequarters", "franc",
+	"Gbreve", "gbreve", "Idotaccent", "Scedilla", "scedilla", "Cacute", "cacute", "Ccaron",
+	"ccaron", "dcroat",
+}
@@ -0,0 +1,191 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"testing"
+)
+
+// buildTrueType builds a font file with the tables given
+func buildTrueType(tables map[string][]byte) []byte {
+	var tags []string
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	var header, data bytes.Buffer
+	binary.Write(&header, binary.BigEndian, []uint32{0x00010000})
+	binary.Write(&header, binary.BigEndian, []uint16{uint16(len(tags)), 0, 0, 0})
+	offset := 12 + 16*len(tags)
+	for _, tag := range tags {
+		header.WriteString(tag)
+		binary.Write(&header, binary.BigEndian, []uint32{0, uint32(offset + data.Len()), uint32(len(tables[tag]))})
+		data.Write(tables[tag])
+	}
+	return append(header.Bytes(), data.Bytes()...)
+}
+
+// cmapSegment maps the characters from start to end to glyphs, either by adding delta or
+// with the glyphs listed
+type cmapSegment struct {
+	start, end, delta int
+	glyphs            []uint16
+}
+
+// cmapFormat4 builds a format 4 cmap subtable
+func cmapFormat4(segments ...cmapSegment) []byte {
+	segments = append(segments, cmapSegment{start: 0xffff, end: 0xffff, delta: 1})
+	n := len(segments)
+	ends, starts, deltas, rangeOffsets := make([]uint16, n), make([]uint16, n), make([]uint16, n), make([]uint16, n)
+	var glyphs []uint16
+	for i, s := range segments {
+		ends[i], starts[i], deltas[i] = uint16(s.end), uint16(s.start), uint16(s.delta)
+		if s.glyphs != nil {
+			rangeOffsets[i] = uint16(2*(n-i) + 2*len(glyphs))
+			glyphs = append(glyphs, s.glyphs...)
+		}
+	}
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, []uint16{4, uint16(16 + 8*n + 2*len(glyphs)), 0, uint16(2 * n), 0, 0, 0})
+	binary.Write(&b, binary.BigEndian, ends)
+	binary.Write(&b, binary.BigEndian, uint16(0))
+	for _, a := range [][]uint16{starts, deltas, rangeOffsets, glyphs} {
+		binary.Write(&b, binary.BigEndian, a)
+	}
+	return b.Bytes()
+}
+
+// cmapTable builds a cmap table with subtables for platform and encoding IDs
+func cmapTable(subtables map[[2]uint16][]byte) []byte {
+	var ids [][2]uint16
+	for id := range subtables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i][0] < ids[j][0] || ids[i][0] == ids[j][0] && ids[i][1] < ids[j][1] })
+	var header, data bytes.Buffer
+	binary.Write(&header, binary.BigEndian, []uint16{0, uint16(len(ids))})
+	for _, id := range ids {
+		binary.Write(&header, binary.BigEndian, id)
+		binary.Write(&header, binary.BigEndian, uint32(4+8*len(ids)+data.Len()))
+		data.Write(subtables[id])
+	}
+	return append(header.Bytes(), data.Bytes()...)
+}
+
+// postTable builds a format 2 post table with glyph names
+func postTable(names ...string) []byte {
+	var b, strings bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(0x00020000))
+	b.Write(make([]byte, 28))
+	binary.Write(&b, binary.BigEndian, uint16(len(names)))
+	count := 0 // names in the Pascal strings
+	for _, n := range names {
+		index := -1
+		for i, m := range macGlyphNames {
+			if m == n {
+				index = i
+			}
+		}
+		if index < 0 {
+			index = len(macGlyphNames) + count
+			count++
+			strings.WriteByte(byte(len(n)))
+			strings.WriteString(n)
+		}
+		binary.Write(&b, binary.BigEndian, uint16(index))
+	}
+	return append(b.Bytes(), strings.Bytes()...)
+}
+
+func symbolicTrueType() []byte {
+	return buildTrueType(map[string][]byte{
+		"cmap": cmapTable(map[[2]uint16][]byte{
+			{3, 0}: cmapFormat4(cmapSegment{start: 0xf041, end: 0xf042, delta: 1 - 0xf041},
+				cmapSegment{start: 0xf043, end: 0xf043, delta: 4 - 0xf043}, cmapSegment{start: 0xf0b7, end: 0xf0b7, glyphs: []uint16{3}}),
+			{3, 1}: cmapFormat4(cmapSegment{start: 0x2605, end: 0x2605, delta: 4 - 0x2605}),
+		}),
+		"post": postTable(".notdef", "A", "uni2713", "bullet", "g4"),
+		"glyf": {0, 0, 0, 0},
+	})
+}
+
+func TestParseTrueType(t *testing.T) {
+	tt, err := parseTrueType(symbolicTrueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for code, expected := range map[byte]string{'A': "A", 'B': "✓", 'C': "★", 0xb7: "•", 'D': ""} {
+		if text := tt.text(code); text != expected {
+			t.Errorf("code %#x: expected %q, got %q", code, expected, text)
+		}
+	}
+
+	mac := make([]byte, 6+256)
+	mac['x'+6], mac['y'+6] = 36, 1
+	tt, err = parseTrueType(buildTrueType(map[string][]byte{
+		"cmap": cmapTable(map[[2]uint16][]byte{{1, 0}: mac}),
+		"post": append([]byte{0, 1, 0, 0}, make([]byte, 28)...),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := tt.text('x') + tt.text('y') + tt.text('z'); text != "A" {
+		t.Errorf("expected the Macintosh glyph names to give %q, got %q", "A", text)
+	}
+
+	for _, data := range [][]byte{nil, []byte("true\x00\x01"), buildTrueType(map[string][]byte{"cmap": {0}})[:20]} {
+		if _, err := parseTrueType(data); err == nil {
+			t.Errorf("% x: expected an invalid font", data)
+		}
+	}
+}
+
+func TestTrueTypeFonts(t *testing.T) {
+	font := symbolicTrueType()
+	pdf := singlePagePDF("BT /F1 12 Tf (ABC\xb7D) Tj /F2 12 Tf (B) Tj /F3 12 Tf (AB) Tj ET", "/F1 5 0 R /F2 7 0 R /F3 9 0 R",
+		"<</Type /Font /Subtype /TrueType /BaseFont /ABCDEF+Symbols /FontDescriptor 6 0 R>>",
+		"<</Type /FontDescriptor /FontName /ABCDEF+Symbols /Flags 4 /FontFile2 10 0 R>>",
+		"<</Type /Font /Subtype /TrueType /Encoding /WinAnsiEncoding /FontDescriptor 8 0 R>>",
+		"<</Type /FontDescriptor /Flags 32 /FontFile2 10 0 R>>",
+		"<</Type /Font /Subtype /TrueType /Encoding <</Differences [65 /Z]>> /FontDescriptor 6 0 R>>",
+		streamObject(string(font)))
+	if text := pageText(t, pdf); text != "A✓★•DBZ✓\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestCIDFontType2(t *testing.T) {
+	font := buildTrueType(map[string][]byte{
+		"cmap": cmapTable(map[[2]uint16][]byte{
+			{3, 1}: cmapFormat4(cmapSegment{start: 0x41, end: 0x41, delta: 1 - 0x41}, cmapSegment{start: 0x4e9c, end: 0x4e9c, delta: 2 - 0x4e9c}),
+		}),
+		"post": postTable(".notdef", "A", "g2", "uniF041", "bullet"),
+	})
+	toUnicode := "1 begincodespacerange <0000> <ffff> endcodespacerange\n1 beginbfchar <0002> <005a> endbfchar"
+	encoding := "1 begincodespacerange <00> <ff> endcodespacerange\n1 begincidrange <41> <42> 1 endcidrange"
+	pdf := singlePagePDF("BT /F1 12 Tf <0001000200030004> Tj /F2 12 Tf <000100020005> Tj /F3 12 Tf (AB) Tj ET", "/F1 5 0 R /F2 9 0 R /F3 13 0 R",
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [6 0 R]>>",
+		"<</Type /Font /Subtype /CIDFontType2 /FontDescriptor 7 0 R /CIDToGIDMap /Identity>>",
+		"<</Type /FontDescriptor /Flags 4 /FontFile2 8 0 R>>",
+		streamObject(string(font)),
+		"<</Type /Font /Subtype /Type0 /Encoding /Identity-H /DescendantFonts [10 0 R] /ToUnicode 12 0 R>>",
+		"<</Type /Font /Subtype /CIDFontType2 /FontDescriptor 7 0 R /CIDToGIDMap 11 0 R>>",
+		streamObject("\x00\x00\x00\x04\x00\x01"), streamObject(toUnicode),
+		"<</Type /Font /Subtype /Type0 /Encoding 14 0 R /DescendantFonts [<</Type /Font /Subtype /CIDFontType2 /FontDescriptor 7 0 R>>]>>",
+		streamObject(encoding))
+	if text := pageText(t, pdf); text != "A亜••ZA亜\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func FuzzTrueType(f *testing.F) {
+	f.Add(symbolicTrueType())
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if tt, err := parseTrueType(data); err == nil {
+			for code := 0; code < 256; code++ {
+				tt.text(byte(code))
+			}
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package pdf2txt
+
+import (
+	"bytes"
+	"strconv"
+	"unicode/utf8"
+)
+
+// readType1Encoding reads the built-in encoding of a Type1 font program from the /Encoding
+// in its cleartext portion, the part before eexec. It is either StandardEncoding or an
+// array of glyph names filled in with "dup code /name put". Glyphs that have no known
+// Unicode are left undefined. It returns nil if the program doesn't have an encoding.
+func readType1Encoding(data []byte) *encoding {
+	if len(data) >= 6 && data[0] == 0x80 && data[1] == 1 { // the segment header of a PFB file
+		data = data[6:]
+	}
+	if i := bytes.Index(data, []byte("eexec")); i >= 0 {
+		data = data[:i]
+	}
+	i := bytes.Index(data, []byte("/Encoding"))
+	if i < 0 {
+		return nil
+	}
+	tokens := postScriptTokens(data[i+len("/Encoding"):])
+	if len(tokens) > 0 && tokens[0] == "StandardEncoding" {
+		return standardEncoding
+	}
+	var e encoding
+	found := false
+	for i := 0; i+3 < len(tokens); i++ {
+		if tokens[i] != "dup" || tokens[i+3] != "put" || tokens[i+2][0] != '/' {
+			continue
+		}
+		code, err := strconv.Atoi(tokens[i+1])
+		if err != nil || code < 0 || code > 255 {
+			continue
+		}
+		found = true
+		if text := glyphText(tokens[i+2][1:]); utf8.RuneCountInString(text) == 1 {
+			e[code], _ = utf8.DecodeRuneInString(text)
+		}
+	}
+	if !found {
+		return nil
+	}
+	return &e
+}
+
+// postScriptTokens splits PostScript code into tokens up to the first def. Names start
+// new tokens, procedure and array brackets are tokens of their own, and comments are
+// left out.
+func postScriptTokens(data []byte) []string {
+	var tokens []string
+	start := -1
+	end := func(i int) bool {
+		if start >= 0 {
+			tokens = append(tokens, string(data[start:i]))
+			start = -1
+		}
+		return len(tokens) > 0 && tokens[len(tokens)-1] == "def"
+	}
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; c {
+		case ' ', '\t', '\r', '\n', '\f', 0:
+			if end(i) {
+				return tokens
+			}
+		case '%':
+			if end(i) {
+				return tokens
+			}
+			for i < len(data) && data[i] != '\r' && data[i] != '\n' {
+				i++
+			}
+		case '{', '}', '[', ']':
+			if end(i) {
+				return tokens
+			}
+			tokens = append(tokens, string(c))
+		case '/':
+			if end(i) {
+				return tokens
+			}
+			start = i
+		default:
+			if start < 0 {
+				start = i
+			}
+		}
+	}
+	end(len(data))
+	return tokens
+}
@@ -0,0 +1,48 @@
+package pdf2txt
+
+import "testing"
+
+const customType1 = "%!PS-AdobeFont-1.0: Custom 001.000\n/FontName /Custom def\n/Encoding 256 array\n" +
+	"0 1 255 {1 index exch /.notdef put} for\ndup 65 /alpha put\ndup 66/B put % comment\ndup 67 /uni2713 put\n" +
+	"dup 68 /g68 put\nreadonly def\ncurrentfile eexec\n\xd9\xd6\x6f\x63dup 69 /E put"
+
+func TestReadType1Encoding(t *testing.T) {
+	e := readType1Encoding([]byte(customType1))
+	if e == nil {
+		t.Fatal("expected an encoding")
+	}
+	for code, expected := range map[byte]rune{'A': 'α', 'B': 'B', 'C': '✓', 'D': 0, 'E': 0, 'a': 0} {
+		if e[code] != expected {
+			t.Errorf("code %#x: expected %q, got %q", code, expected, e[code])
+		}
+	}
+	if e := readType1Encoding([]byte("\x80\x01\x00\x01\x00\x00%!FontType1-1.0: Std\n/Encoding StandardEncoding def")); e != standardEncoding {
+		t.Errorf("expected StandardEncoding, got %v", e)
+	}
+	for _, data := range []string{"", "/FontName /Custom def currentfile eexec /Encoding", "/Encoding 256 array readonly def dup 65 /A put"} {
+		if e := readType1Encoding([]byte(data)); e != nil {
+			t.Errorf("%q: expected no encoding", data)
+		}
+	}
+}
+
+func TestType1Fonts(t *testing.T) {
+	pdf := singlePagePDF("BT /F1 12 Tf (ABCD) Tj /F2 12 Tf (ABC) Tj /F3 12 Tf (a) Tj ET", "/F1 5 0 R /F2 8 0 R /F3 9 0 R",
+		"<</Type /Font /Subtype /Type1 /BaseFont /ABCDEF+Custom /FontDescriptor 6 0 R>>",
+		"<</Type /FontDescriptor /FontName /ABCDEF+Custom /Flags 4 /FontFile 7 0 R>>",
+		streamObject(customType1),
+		"<</Type /Font /Subtype /Type1 /BaseFont /ABCDEF+Custom /Encoding <</Differences [66 /Z]>> /FontDescriptor 6 0 R>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Symbol /FontDescriptor 10 0 R>>",
+		"<</Type /FontDescriptor /FontName /Symbol /Flags 4 /FontFile 11 0 R>>",
+		streamObject("%!FontType1-1.0: Symbol\n/Encoding StandardEncoding def\ncurrentfile eexec\n"))
+	if text := pageText(t, pdf); text != "αB✓DαZ✓a\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func FuzzType1Encoding(f *testing.F) {
+	f.Add([]byte(customType1))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		readType1Encoding(data)
+	})
+}
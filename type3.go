@@ -0,0 +1,50 @@
+package pdf2txt
+
+// defaultFontMatrix maps 1000 units of glyph space to one unit of text space, as the
+// glyph space of every font but Type3 fonts does
+var defaultFontMatrix = [6]float64{0.001, 0, 0, 0.001, 0, 0}
+
+// type3Font is what's needed from a Type3 font (section 9.6.5), whose glyphs are drawn by
+// the procedures of its /CharProcs. Its /FontMatrix maps its glyph space, in which its
+// widths are given, to text space. It is often not the usual 1000 units to one.
+type type3Font struct {
+	matrix    [6]float64
+	firstChar int
+	widths    []float64  // in glyph space, from firstChar on
+	charProcs dictionary // the glyph procedures by glyph name
+	page      *page      // whose fonts the glyph procedures use
+}
+
+// newType3Font reads the FontMatrix and widths of a Type3 font. A FontMatrix that isn't an
+// array of six numbers is left as the default.
+func newType3Font(fontMatrix array, firstChar int, widths array) *type3Font {
+	t := &type3Font{matrix: defaultFontMatrix, firstChar: firstChar}
+	if len(fontMatrix) == len(t.matrix) {
+		var m [6]float64
+		valid := true
+		for i, v := range fontMatrix {
+			var ok bool
+			m[i], ok = number(v)
+			valid = valid && ok
+		}
+		if valid {
+			t.matrix = m
+		}
+	}
+	for _, w := range widths {
+		v, _ := number(w)
+		t.widths = append(t.widths, v)
+	}
+	return t
+}
+
+// width returns the width of the glyph for a code in thousandths of a unit of text space,
+// like the widths of other fonts, and whether the font has one. It is the horizontal
+// displacement the FontMatrix maps the glyph's width to.
+func (t *type3Font) width(code byte) (float64, bool) {
+	i := int(code) - t.firstChar
+	if i < 0 || i >= len(t.widths) {
+		return 0, false
+	}
+	return t.widths[i] * t.matrix[0] * 1000, true
+}
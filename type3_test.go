@@ -0,0 +1,109 @@
+package pdf2txt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestType3Metrics(t *testing.T) {
+	f := newType3Font(array{real(0.01), integer(0), integer(0), real(0.02), integer(1), integer(2)}, 65, array{integer(50), real(100)})
+	for code, expected := range map[byte]float64{'A': 500, 'B': 1000} {
+		if w, ok := f.width(code); !ok || w != expected {
+			t.Errorf("code %#x: expected a width of %v, got %v", code, expected, w)
+		}
+	}
+	for _, code := range []byte{'C', '@'} {
+		if _, ok := f.width(code); ok {
+			t.Errorf("code %#x: expected no width", code)
+		}
+	}
+
+	f = newType3Font(array{integer(1), integer(0), integer(0)}, 0, array{integer(1000)})
+	if w, _ := f.width(0); f.matrix != defaultFontMatrix || w != 1000 {
+		t.Errorf("expected the default FontMatrix, got %v", f.matrix)
+	}
+}
+
+func TestType3Fonts(t *testing.T) {
+	// the glyph procedures for C and D show their own glyphs, which are left out, and D's
+	// shows F2, whose glyph procedure shows the B of F1
+	tests := map[string]string{
+		"BT /F1 12 Tf (ABCD) Tj /F2 12 Tf (A) Tj ET":           "AxCyxx\n",
+		"BT /F2 12 Tf (A) Tj /F1 12 Tf (ABCD) Tj ET":           "xAxCyx\n",
+		"BT /F1 12 Tf (A) Tj 12 0 Td (A) Tj 15 0 Td (A) Tj ET": "AA A\n", // A is 12 wide
+	}
+	for content, expected := range tests {
+		pdf := type3PDF(content)
+		if text := pageText(t, pdf); text != expected {
+			t.Errorf("%s: expected %q, got %q", content, expected, text)
+		}
+	}
+}
+
+func type3PDF(content string) []byte {
+	return singlePagePDF(content, "/F1 5 0 R /F2 11 0 R",
+		"<</Type /Font /Subtype /Type3 /FontBBox [0 0 100 100] /FontMatrix [0.01 0 0 0.01 0 0] /FirstChar 65 /LastChar 68"+
+			" /Widths [100 100 100 100] /Encoding <</Type /Encoding /Differences [65 /A /g2 /g3 /g4]>>"+
+			" /CharProcs <</A 7 0 R /g2 8 0 R /g3 9 0 R /g4 10 0 R>> /Resources <</Font <</F9 6 0 R /T3 5 0 R /U 11 0 R>>>>>>",
+		"<</Type /Font /Subtype /Type1 /BaseFont /Helvetica>>",
+		streamObject("100 0 d0 0 0 100 100 re f"),
+		streamObject("100 0 d0 BT /F9 1 Tf (x) Tj ET"),
+		streamObject("100 0 d0 BT /T3 1 Tf (C) Tj ET"),
+		streamObject("100 0 d0 BT /F9 1 Tf (y) Tj /T3 1 Tf (D) Tj /U 1 Tf (A) Tj ET"),
+		"<</Type /Font /Subtype /Type3 /FontBBox [0 0 100 100] /FontMatrix [0.01 0 0 0.01 0 0] /FirstChar 65 /LastChar 65"+
+			" /Widths [100] /Encoding <</Differences [65 /h1]>> /CharProcs <</h1 12 0 R>> /Resources <</Font <</T1 5 0 R>>>>>>",
+		streamObject("100 0 d0 BT /T1 1 Tf (B) Tj ET"))
+}
+
+func TestType3PageResources(t *testing.T) {
+	// T3 has no resources, so its glyph procedure uses the F9 of each page
+	pdf := "%PDF-1.4\n1 0 obj <</Type /Catalog /Pages 2 0 R>> endobj\n2 0 obj <</Type /Pages /Kids [3 0 R 4 0 R] /Count 2>> endobj\n" +
+		"3 0 obj <</Type /Page /Parent 2 0 R /Resources <</Font <</T3 6 0 R /F9 7 0 R>>>> /Contents 5 0 R>> endobj\n" +
+		"4 0 obj <</Type /Page /Parent 2 0 R /Resources <</Font <</T3 6 0 R /F9 8 0 R>>>> /Contents 5 0 R>> endobj\n" +
+		"5 0 obj " + streamObject("BT /T3 12 Tf (A) Tj ET") + " endobj\n" +
+		"6 0 obj <</Type /Font /Subtype /Type3 /FontMatrix [0.01 0 0 0.01 0 0] /FirstChar 65 /LastChar 65 /Widths [100]" +
+		" /Encoding <</Differences [65 /g1]>> /CharProcs <</g1 9 0 R>>>> endobj\n" +
+		"7 0 obj <</Type /Font /Subtype /Type1 /BaseFont /Helvetica>> endobj\n" +
+		"8 0 obj <</Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding <</Differences [120 /z]>>>> endobj\n" +
+		"9 0 obj " + streamObject("100 0 d0 BT /F9 1 Tf (x) Tj ET") + " endobj\n" +
+		"trailer <</Root 1 0 R>>\n"
+	if text := pageText(t, []byte(pdf)); text != "x\nz\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
+
+func TestType3Recursion(t *testing.T) {
+	// each glyph procedure of each font shows every glyph of every font
+	const n = 12
+	var fonts, show strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&fonts, "/T%d %d 0 R ", i, 5+i)
+		fmt.Fprintf(&show, "/T%d 1 Tf (ABCDEFGHIJKL) Tj ", i)
+	}
+	var objects []string
+	for i := 0; i < n; i++ {
+		var procs strings.Builder
+		for j := 0; j < n; j++ {
+			fmt.Fprintf(&procs, "/g%d %d 0 R ", j, 6+n+i*n+j)
+		}
+		objects = append(objects, "<</Type /Font /Subtype /Type3 /FontMatrix [0.01 0 0 0.01 0 0] /FirstChar 65 /LastChar 76"+
+			" /Encoding <</Differences [65 /g0 /g1 /g2 /g3 /g4 /g5 /g6 /g7 /g8 /g9 /g10 /g11]>>"+
+			" /CharProcs <<"+procs.String()+">> /Resources <</Font <<"+fonts.String()+fmt.Sprintf("/F9 %d 0 R>>>>>>", 5+n))
+	}
+	objects = append(objects, "<</Type /Font /Subtype /Type1 /BaseFont /Helvetica>>")
+	for i := 0; i < n*n; i++ {
+		objects = append(objects, streamObject("100 0 d0 BT /F9 1 Tf (x) Tj "+show.String()+"ET"))
+	}
+	pdf := singlePagePDF("BT "+show.String()+"ET", fonts.String(), objects...)
+
+	start := time.Now()
+	text := pageText(t, pdf)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("took %v", elapsed)
+	}
+	if len(text) == 0 || len(text) > n*n*maxCharProcText+1 {
+		t.Errorf("unexpected text length %d", len(text))
+	}
+}